@@ -38,11 +38,13 @@ import (
 	rocksmqimpl "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	internalmetrics "github.com/milvus-io/milvus/internal/util/metrics"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/tracer"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/generic"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -59,6 +61,7 @@ func init() {
 	metrics.RegisterMetaMetrics(Registry.GoRegistry)
 	metrics.RegisterMsgStreamMetrics(Registry.GoRegistry)
 	metrics.RegisterStorageMetrics(Registry.GoRegistry)
+	metrics.RegisterPebblemqMetrics(Registry.GoRegistry)
 }
 
 func stopRocksmq() {
@@ -238,6 +241,7 @@ func (mr *MilvusRoles) setupLogger() {
 			MaxDays:    params.LogCfg.MaxAge.GetAsInt(),
 			MaxBackups: params.LogCfg.MaxBackups.GetAsInt(),
 		},
+		RedactKeys: params.LogCfg.RedactKeys.GetAsStrings(),
 	}
 	id := paramtable.GetNodeID()
 	roleName := paramtable.GetRole()
@@ -253,9 +257,11 @@ func (mr *MilvusRoles) setupLogger() {
 
 // Register serves prometheus http service
 func setupPrometheusHTTPServer(r *internalmetrics.MilvusRegistry) {
+	// exemplars are exposed in the OpenMetrics format only
+	opts := promhttp.HandlerOpts{EnableOpenMetrics: paramtable.Get().MetricsCfg.ExemplarEnabled.GetAsBool()}
 	http.Register(&http.Handler{
 		Path:    "/metrics",
-		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
+		Handler: promhttp.HandlerFor(r, opts),
 	})
 	http.Register(&http.Handler{
 		Path:    "/metrics_default",
@@ -263,6 +269,93 @@ func setupPrometheusHTTPServer(r *internalmetrics.MilvusRegistry) {
 	})
 }
 
+// setupOTLPMetricsExporter pushes the metrics to the OpenTelemetry collector if the otlp exporter is selected,
+// returns nil if it's not selected or fails to start.
+func setupOTLPMetricsExporter(r *internalmetrics.MilvusRegistry) *metrics.OTLPExporter {
+	params := paramtable.Get()
+	if params.MetricsCfg.Exporter.GetValue() != "otlp" {
+		return nil
+	}
+	exporter, err := metrics.NewOTLPExporter(r, metrics.OTLPExporterConfig{
+		Endpoint:    params.MetricsCfg.OtlpEndpoint.GetValue(),
+		Insecure:    params.MetricsCfg.OtlpInsecure.GetAsBool(),
+		Interval:    params.MetricsCfg.OtlpInterval.GetAsDuration(time.Second),
+		Timeout:     params.MetricsCfg.OtlpTimeout.GetAsDuration(time.Second),
+		ServiceName: paramtable.GetRole(),
+		NodeID:      paramtable.GetNodeID(),
+	})
+	if err != nil {
+		log.Warn("Init otlp metrics exporter failed", zap.Error(err))
+		return nil
+	}
+	exporter.Start()
+	log.Info("Init otlp metrics exporter finished", zap.String("endpoint", params.MetricsCfg.OtlpEndpoint.GetValue()))
+	return exporter
+}
+
+// setupMQHealthReporter pushes the health of the embedded pebblemq to the QuotaCenter of RootCoord in standalone
+// mode, which denies the writes while pebblemq is throttling, returns a no-op if pebblemq isn't used.
+func setupMQHealthReporter() func() {
+	if _, ok := pebblemqimpl.GetPmqHealth(); !ok {
+		return func() {}
+	}
+	etcdConfig := &paramtable.Get().EtcdCfg
+	etcdCli, err := etcd.GetEtcdClient(
+		etcdConfig.UseEmbedEtcd.GetAsBool(),
+		etcdConfig.EtcdUseSSL.GetAsBool(),
+		etcdConfig.Endpoints.GetAsStrings(),
+		etcdConfig.EtcdTLSCert.GetValue(),
+		etcdConfig.EtcdTLSKey.GetValue(),
+		etcdConfig.EtcdTLSCACert.GetValue(),
+		etcdConfig.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		log.Warn("MQ health reporter connect to etcd failed", zap.Error(err))
+		return func() {}
+	}
+	reporter := sessionutil.NewHealthReporter(etcdCli, etcdConfig.MetaRootPath.GetValue(), sessionutil.MQHealthServerName,
+		paramtable.GetNodeID(), func() *sessionutil.NodeHealth {
+			stat, _ := pebblemqimpl.GetPmqHealth()
+			return &sessionutil.NodeHealth{
+				Score:           sessionutil.HealthScore(stat.Throttled, stat.DiskWatermark, stat.WritePressure),
+				QueueDepth:      stat.InflightProduces,
+				MemoryWatermark: hardware.GetMemoryUseRatio(),
+				DiskWatermark:   stat.DiskWatermark,
+				Throttled:       stat.Throttled,
+			}
+		})
+	reporter.Start()
+	return func() {
+		reporter.Stop()
+		etcdCli.Close()
+	}
+}
+
+// setupMQAdminHTTPServer serves the per-topic configs of the embedded pebblemq, it does nothing if pebblemq isn't used.
+func setupMQAdminHTTPServer() {
+	if _, ok := pebblemqimpl.GetPmqHealth(); !ok {
+		return
+	}
+	http.Register(&http.Handler{
+		Path:    http.PebblemqTopicConfigRouterPath,
+		Handler: pebblemqimpl.TopicConfigHandler(),
+	})
+}
+
+// reloadConfigs re-reads the yaml files on SIGHUP, the refreshable params take effect via their watchers,
+// e.g. the build slots of IndexNode and the intervals of pebblemq.
+func reloadConfigs() {
+	events, err := paramtable.Get().ReloadFiles()
+	if err != nil {
+		log.Warn("failed to reload configs on SIGHUP", zap.Int("changed", len(events)), zap.Error(err))
+		return
+	}
+	keys := make([]string, 0, len(events))
+	for _, e := range events {
+		keys = append(keys, e.Key)
+	}
+	log.Info("configs reloaded on SIGHUP", zap.Strings("changed", keys))
+}
+
 func (mr *MilvusRoles) handleSignals() func() {
 	sign := make(chan struct{})
 	done := make(chan struct{})
@@ -282,11 +375,15 @@ func (mr *MilvusRoles) handleSignals() func() {
 				log.Info("All cleanup done, handleSignals goroutine quit")
 				return
 			case sig := <-sc:
+				if sig == syscall.SIGHUP {
+					reloadConfigs()
+					continue
+				}
 				log.Warn("Get signal to exit", zap.String("signal", sig.String()))
 				mr.once.Do(func() {
 					close(mr.closed)
-					// reset other signals, only handle SIGINT from now
-					signal.Reset(syscall.SIGQUIT, syscall.SIGHUP, syscall.SIGTERM)
+					// reset other signals, only handle SIGINT and SIGHUP from now
+					signal.Reset(syscall.SIGQUIT, syscall.SIGTERM)
 				})
 			}
 		}
@@ -384,6 +481,12 @@ func (mr *MilvusRoles) Run(alias string) {
 	mr.setupLogger()
 	tracer.Init()
 	setupPrometheusHTTPServer(Registry)
+	otlpExporter := setupOTLPMetricsExporter(Registry)
+	stopMQHealthReporter := func() {}
+	if mr.Local {
+		stopMQHealthReporter = setupMQHealthReporter()
+		setupMQAdminHTTPServer()
+	}
 
 	paramtable.SetCreateTime(time.Now())
 	paramtable.SetUpdateTime(time.Now())
@@ -427,5 +530,10 @@ func (mr *MilvusRoles) Run(alias string) {
 		log.Info("proxy stopped")
 	}
 
+	if otlpExporter != nil {
+		otlpExporter.Stop()
+	}
+	stopMQHealthReporter()
+
 	log.Info("Milvus components graceful stop done")
 }
@@ -56,8 +56,13 @@ func collectRecursive(params *paramtable.ComponentParam, data *[]DocContent, val
 		subVal := val.Field(j)
 		tag := val.Type().Field(j).Tag
 		t := val.Type().Field(j).Type.String()
-		if t == "paramtable.ParamItem" {
-			item := subVal.Interface().(paramtable.ParamItem) //nolint:govet
+		if t == "paramtable.ParamItem" || t == "paramtable.FeatureFlag" {
+			var item paramtable.ParamItem
+			if flag, ok := subVal.Interface().(paramtable.FeatureFlag); ok { //nolint:govet
+				item = flag.ParamItem //nolint:govet
+			} else {
+				item = subVal.Interface().(paramtable.ParamItem) //nolint:govet
+			}
 			refreshable := tag.Get("refreshable")
 			defaultValue := params.GetWithDefault(item.Key, item.DefaultValue)
 			log.Debug("got key", zap.String("key", item.Key), zap.Any("value", defaultValue), zap.String("variable", val.Type().Field(j).Name))
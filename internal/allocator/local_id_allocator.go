@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allocator
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// DefaultLocalIDBatchSize is the number of ids reserved by a save of the high-water mark.
+const DefaultLocalIDBatchSize = 10000
+
+var _ Interface = (*LocalIDAllocator)(nil)
+
+// LocalIDAllocator allocates the monotonic ids of an embedded component, e.g. the message ids of pebblemq,
+// without a TSO. The ids are allocated from an in-memory counter, and the high-water mark is persisted in the kv
+// per batch, the ids below the mark may have been allocated, so they're skipped after a restart.
+type LocalIDAllocator struct {
+	key       string
+	base      kv.BaseKV
+	batchSize int64
+	floor     UniqueID
+
+	mu          sync.Mutex
+	initialized bool
+	// next is the next id to allocate, the ids in [next, limit) are reserved
+	next  UniqueID
+	limit UniqueID
+}
+
+// LocalIDAllocatorOption is the option of LocalIDAllocator.
+type LocalIDAllocatorOption func(*LocalIDAllocator)
+
+// WithLocalIDBatchSize sets the number of ids reserved by a save of the high-water mark.
+func WithLocalIDBatchSize(size int64) LocalIDAllocatorOption {
+	return func(a *LocalIDAllocator) {
+		if size > 0 {
+			a.batchSize = size
+		}
+	}
+}
+
+// WithLocalIDFloor makes the ids start from floor at least, e.g. to continue the ids allocated by another allocator,
+// the ids start from 1 if floor isn't positive.
+func WithLocalIDFloor(floor UniqueID) LocalIDAllocatorOption {
+	return func(a *LocalIDAllocator) {
+		if floor > 0 {
+			a.floor = floor
+		}
+	}
+}
+
+// NewLocalIDAllocator creates LocalIDAllocator persisting the high-water mark in base by key.
+func NewLocalIDAllocator(key string, base kv.BaseKV, opts ...LocalIDAllocatorOption) *LocalIDAllocator {
+	a := &LocalIDAllocator{
+		key:       key,
+		base:      base,
+		batchSize: DefaultLocalIDBatchSize,
+		floor:     1,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Initialize loads the high-water mark, the ids are allocated from it.
+func (a *LocalIDAllocator) Initialize() error {
+	// the kvs differ in loading an absent key, pebblekv returns an empty value while the others return an error
+	value, err := a.base.Load(a.key)
+	if err != nil && !common.IsKeyNotExistError(err) {
+		return err
+	}
+	mark := a.floor
+	if value != "" {
+		saved, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid high-water mark %s of id allocator %s", value, a.key)
+		}
+		if saved > mark {
+			mark = saved
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.next, a.limit = mark, mark
+	a.initialized = true
+	return nil
+}
+
+// Alloc allocates the ids in [idStart, idEnd) of the count number.
+func (a *LocalIDAllocator) Alloc(count uint32) (UniqueID, UniqueID, error) {
+	if count == 0 {
+		return 0, 0, errors.New("id count should be positive")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.initialized {
+		return 0, 0, fmt.Errorf("id allocator %s is not initialized", a.key)
+	}
+	idStart, idEnd := a.next, a.next+int64(count)
+	if idEnd > a.limit {
+		// the mark is saved before the ids are handed out, so they're never allocated again after a restart
+		limit := idEnd + a.batchSize
+		if err := a.base.Save(a.key, strconv.FormatInt(limit, 10)); err != nil {
+			return 0, 0, errors.Wrapf(err, "failed to save high-water mark of id allocator %s", a.key)
+		}
+		a.limit = limit
+	}
+	a.next = idEnd
+	return idStart, idEnd, nil
+}
+
+// AllocOne allocates one id.
+func (a *LocalIDAllocator) AllocOne() (UniqueID, error) {
+	idStart, _, err := a.Alloc(1)
+	return idStart, err
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package allocator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+)
+
+func TestLocalIDAllocator(t *testing.T) {
+	base := memkv.NewMemoryKV()
+
+	a := NewLocalIDAllocator("local_id", base, WithLocalIDBatchSize(10))
+	_, _, err := a.Alloc(1)
+	assert.Error(t, err)
+	require.NoError(t, a.Initialize())
+
+	_, _, err = a.Alloc(0)
+	assert.Error(t, err)
+	idStart, idEnd, err := a.Alloc(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, idStart)
+	assert.EqualValues(t, 6, idEnd)
+	id, err := a.AllocOne()
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, id)
+	// the mark is saved per batch
+	mark, err := base.Load("local_id")
+	require.NoError(t, err)
+	assert.Equal(t, "16", mark)
+
+	// a batch larger than the batch size
+	idStart, idEnd, err = a.Alloc(20)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, idStart)
+	assert.EqualValues(t, 27, idEnd)
+
+	// the ids below the mark are skipped after a restart
+	a = NewLocalIDAllocator("local_id", base, WithLocalIDBatchSize(10))
+	require.NoError(t, a.Initialize())
+	id, err = a.AllocOne()
+	require.NoError(t, err)
+	assert.EqualValues(t, 37, id)
+
+	// concurrent allocations never overlap
+	allocated := make([][2]UniqueID, 100)
+	wg := sync.WaitGroup{}
+	for i := range allocated {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start, end, err := a.Alloc(3)
+			assert.NoError(t, err)
+			allocated[i] = [2]UniqueID{start, end}
+		}(i)
+	}
+	wg.Wait()
+	seen := make(map[UniqueID]struct{})
+	for _, ids := range allocated {
+		for id := ids[0]; id < ids[1]; id++ {
+			_, ok := seen[id]
+			assert.False(t, ok)
+			seen[id] = struct{}{}
+		}
+	}
+	assert.Len(t, seen, 300)
+}
+
+func TestLocalIDAllocatorFloor(t *testing.T) {
+	base := memkv.NewMemoryKV()
+
+	a := NewLocalIDAllocator("local_id", base, WithLocalIDFloor(1000))
+	require.NoError(t, a.Initialize())
+	id, err := a.AllocOne()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1000, id)
+
+	// the saved mark wins over a lower floor
+	a = NewLocalIDAllocator("local_id", base, WithLocalIDFloor(10))
+	require.NoError(t, a.Initialize())
+	id, err = a.AllocOne()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1000+DefaultLocalIDBatchSize+1, id)
+
+	require.NoError(t, base.Save("invalid", "x"))
+	assert.Error(t, NewLocalIDAllocator("invalid", base).Initialize())
+}
@@ -53,6 +53,7 @@ enum ErrorCode {
     DataFormatBroken = 2024,
     JsonKeyInvalid = 2025,
     MetricTypeInvalid = 2026,
+    BuildCancelled = 2027,
     UnistdError = 2030,
     KnowhereError = 2100,
 };
@@ -85,6 +85,9 @@ typedef struct CStorageConfig {
     bool useSSL;
     bool useIAM;
     bool useVirtualHost;
+    bool requester_pays;
+    // json object of the headers sent with every request
+    const char* custom_headers;
 } CStorageConfig;
 
 typedef struct CTraceConfig {
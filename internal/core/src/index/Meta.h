@@ -46,6 +46,9 @@ constexpr const char* INDEX_BUILD_ID = "index_build_id";
 constexpr const char* INDEX_ID = "index_id";
 constexpr const char* INDEX_VERSION = "index_version";
 
+// streaming build params
+constexpr const char* STREAM_BUILD_BATCH_FILES = "stream_build_batch_files";
+
 // DiskAnn build params
 constexpr const char* DISK_ANN_PREFIX_PATH = "index_prefix";
 constexpr const char* DISK_ANN_RAW_DATA_PATH = "data_path";
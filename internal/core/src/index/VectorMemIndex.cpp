@@ -17,9 +17,13 @@
 #include "index/VectorMemIndex.h"
 
 #include <unistd.h>
+#include <algorithm>
+#include <charconv>
 #include <cmath>
 #include <filesystem>
+#include <functional>
 #include <memory>
+#include <random>
 #include <string>
 #include <unordered_map>
 #include <unordered_set>
@@ -233,15 +237,13 @@ VectorMemIndex::BuildWithDataset(const DatasetPtr& dataset,
     SetDim(index_.Dim());
 }
 
-void
-VectorMemIndex::Build(const Config& config) {
-    auto insert_files =
-        GetValueFromConfig<std::vector<std::string>>(config, "insert_files");
-    AssertInfo(insert_files.has_value(),
-               "insert file paths is empty when build disk ann index");
-    auto field_datas =
-        file_manager_->CacheRawDataToMemory(insert_files.value());
-
+namespace {
+// MergeFieldDatas turns the field datas into one dataset, `holder` keeps the
+// memory referenced by the dataset alive. A single field data is used as is,
+// otherwise the field datas are copied into one buffer and released.
+DatasetPtr
+MergeFieldDatas(std::vector<storage::FieldDataPtr>& field_datas,
+                std::shared_ptr<void>& holder) {
     int64_t total_size = 0;
     int64_t total_num_rows = 0;
     int64_t dim = 0;
@@ -253,6 +255,13 @@ VectorMemIndex::Build(const Config& config) {
         dim = data->get_dim();
     }
 
+    if (field_datas.size() == 1) {
+        auto data = field_datas[0]->Data();
+        holder = field_datas[0];
+        field_datas.clear();
+        return GenDataset(total_num_rows, dim, data);
+    }
+
     auto buf = std::shared_ptr<uint8_t[]>(new uint8_t[total_size]);
     int64_t offset = 0;
     for (auto data : field_datas) {
@@ -261,13 +270,182 @@ VectorMemIndex::Build(const Config& config) {
         data.reset();
     }
     field_datas.clear();
+    holder = buf;
+    return GenDataset(total_num_rows, dim, buf.get());
+}
+
+// ParseInt64 parses the whole `str` as a decimal integer without throwing
+bool
+ParseInt64(const std::string& str, int64_t& value) {
+    auto end = str.data() + str.size();
+    auto [ptr, ec] = std::from_chars(str.data(), end, value);
+    return ec == std::errc() && ptr == end && !str.empty();
+}
+
+// LogIDLess orders the binlogs by the log id in their file names, the file
+// names which are not a log id are ordered as strings after them
+bool
+LogIDLess(const std::string& a, const std::string& b) {
+    int64_t a_id = 0;
+    int64_t b_id = 0;
+    auto a_ok = ParseInt64(a.substr(a.find_last_of("/") + 1), a_id);
+    auto b_ok = ParseInt64(b.substr(b.find_last_of("/") + 1), b_id);
+    if (a_ok && b_ok) {
+        return a_id < b_id;
+    }
+    if (a_ok != b_ok) {
+        return a_ok;
+    }
+    return a < b;
+}
+
+// TrainSample keeps a uniform sample of at most `capacity` rows out of all
+// the streamed field datas by reservoir sampling, so that the index is
+// trained on rows of every batch rather than on the first batch only.
+class TrainSample {
+ public:
+    explicit TrainSample(int64_t capacity) : capacity_(capacity), rng_(42) {
+    }
+
+    void
+    Add(const storage::FieldDataPtr& data) {
+        auto rows = data->get_num_rows();
+        if (rows == 0) {
+            return;
+        }
+        if (dim_ == 0) {
+            dim_ = data->get_dim();
+            row_size_ = data->Size() / rows;
+            buf_.reserve(capacity_ * row_size_);
+        }
+        AssertInfo(dim_ == data->get_dim(),
+                   "inconsistent dim value between field datas!");
+        auto src = static_cast<const uint8_t*>(data->Data());
+        for (int64_t i = 0; i < rows; ++i, ++seen_) {
+            auto row = src + i * row_size_;
+            if (seen_ < capacity_) {
+                buf_.insert(buf_.end(), row, row + row_size_);
+                continue;
+            }
+            std::uniform_int_distribution<int64_t> dist(0, seen_);
+            auto j = dist(rng_);
+            if (j < capacity_) {
+                std::memcpy(buf_.data() + j * row_size_, row, row_size_);
+            }
+        }
+    }
+
+    // Dataset references the sampled rows, it's valid while the sample is
+    DatasetPtr
+    Dataset() {
+        auto rows = row_size_ == 0 ? 0 : buf_.size() / row_size_;
+        return GenDataset(rows, dim_, buf_.data());
+    }
+
+ private:
+    int64_t capacity_;
+    int64_t seen_ = 0;
+    int64_t dim_ = 0;
+    int64_t row_size_ = 0;
+    std::vector<uint8_t> buf_;
+    std::mt19937_64 rng_;
+};
+}  // namespace
+
+void
+VectorMemIndex::Build(const Config& config) {
+    auto insert_files =
+        GetValueFromConfig<std::vector<std::string>>(config, "insert_files");
+    AssertInfo(insert_files.has_value(),
+               "insert file paths is empty when build disk ann index");
 
     Config build_config;
     build_config.update(config);
     build_config.erase("insert_files");
 
-    auto dataset = GenDataset(total_num_rows, dim, buf.get());
-    BuildWithDataset(dataset, build_config);
+    int64_t batch_files = 0;
+    auto batch_files_str =
+        GetValueFromConfig<std::string>(config, STREAM_BUILD_BATCH_FILES);
+    if (batch_files_str.has_value()) {
+        if (!ParseInt64(batch_files_str.value(), batch_files)) {
+            LOG_SEGCORE_WARNING_ << "invalid " << STREAM_BUILD_BATCH_FILES
+                                 << ": " << batch_files_str.value()
+                                 << ", build index without streaming";
+            batch_files = 0;
+        }
+        build_config.erase(STREAM_BUILD_BATCH_FILES);
+    }
+
+    auto files = insert_files.value();
+    if (batch_files <= 0 || files.size() <= static_cast<size_t>(batch_files)) {
+        auto field_datas = file_manager_->CacheRawDataToMemory(files);
+        std::shared_ptr<void> holder;
+        auto dataset = MergeFieldDatas(field_datas, holder);
+        BuildWithDataset(dataset, build_config);
+        return;
+    }
+
+    // stream the binlogs in log id order so that only one batch of raw data
+    // is in memory at a time. The binlogs are read twice: the first pass
+    // samples as many rows as a batch holds out of all batches to train the
+    // index, the second pass appends every batch to the trained index
+    std::sort(files.begin(), files.end(), LogIDLess);
+    auto for_each_batch =
+        [&](const std::function<void(std::vector<storage::FieldDataPtr>&,
+                                     size_t)>& fn) {
+            for (size_t begin = 0; begin < files.size();
+                 begin += batch_files) {
+                file_manager_->CheckCancelled();
+                auto end = std::min(files.size(), begin + batch_files);
+                auto field_datas = file_manager_->CacheRawDataToMemory(
+                    std::vector<std::string>(files.begin() + begin,
+                                             files.begin() + end));
+                fn(field_datas, end);
+            }
+        };
+
+    std::unique_ptr<TrainSample> sample;
+    for_each_batch(
+        [&](std::vector<storage::FieldDataPtr>& field_datas, size_t) {
+            if (sample == nullptr) {
+                int64_t capacity = 0;
+                for (auto& data : field_datas) {
+                    capacity += data->get_num_rows();
+                }
+                sample = std::make_unique<TrainSample>(capacity);
+            }
+            for (auto& data : field_datas) {
+                sample->Add(data);
+            }
+        });
+    TrainWithDataset(sample->Dataset(), build_config);
+    sample.reset();
+
+    for_each_batch(
+        [&](std::vector<storage::FieldDataPtr>& field_datas, size_t end) {
+            std::shared_ptr<void> holder;
+            auto dataset = MergeFieldDatas(field_datas, holder);
+            AddWithDataset(dataset, build_config);
+            LOG_SEGCORE_INFO_ << "streamed " << end << "/" << files.size()
+                              << " binlogs into index";
+        });
+    SetDim(index_.Dim());
+}
+
+void
+VectorMemIndex::TrainWithDataset(const DatasetPtr& dataset,
+                                 const Config& config) {
+    knowhere::Json index_config;
+    index_config.update(config);
+
+    SetDim(dataset->GetDim());
+
+    knowhere::TimeRecorder rc("TrainWithDataset", 1);
+    auto stat = index_.Train(*dataset, index_config);
+    if (stat != knowhere::Status::success)
+        PanicCodeInfo(ErrorCode::IndexBuildError,
+                      "failed to train index, " + KnowhereStatusString(stat));
+    rc.ElapseFromBegin("Done");
 }
 
 void
@@ -79,6 +79,9 @@ class VectorMemIndex : public VectorIndex {
     void
     LoadFromFile(const Config& config);
 
+    void
+    TrainWithDataset(const DatasetPtr& dataset, const Config& config);
+
  protected:
     Config config_;
     knowhere::Index<knowhere::IndexNode> index_;
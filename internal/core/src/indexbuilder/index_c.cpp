@@ -107,16 +107,24 @@ CreateIndexV2(CIndex* res_index, CBuildIndexInfo c_build_index_info) {
         auto file_manager = milvus::storage::CreateFileManager(
             index_info.index_type, field_meta, index_meta, chunk_manager);
         AssertInfo(file_manager != nullptr, "create file manager failed!");
+        file_manager->SetCancelled(build_index_info->cancelled);
+        file_manager->SetContentAddressed(build_index_info->content_addressed);
 
         auto index =
             milvus::indexbuilder::IndexFactory::GetInstance().CreateIndex(
                 build_index_info->field_type, config, file_manager);
         index->Build();
+        file_manager->CheckCancelled();
         *res_index = index.release();
         auto status = CStatus();
         status.error_code = Success;
         status.error_msg = "";
         return status;
+    } catch (SegcoreError& e) {
+        auto status = CStatus();
+        status.error_code = e.get_error_code();
+        status.error_msg = strdup(e.what());
+        return status;
     } catch (std::exception& e) {
         auto status = CStatus();
         status.error_code = UnexpectedError;
@@ -302,6 +310,9 @@ NewBuildIndexInfo(CBuildIndexInfo* c_build_index_info,
         storage_config.useIAM = c_storage_config.useIAM;
         storage_config.region = c_storage_config.region;
         storage_config.useVirtualHost = c_storage_config.useVirtualHost;
+        storage_config.requester_pays = c_storage_config.requester_pays;
+        storage_config.custom_headers = milvus::storage::ParseCustomHeaders(
+            c_storage_config.custom_headers);
 
         *c_build_index_info = build_index_info.release();
         auto status = CStatus();
@@ -322,6 +333,19 @@ DeleteBuildIndexInfo(CBuildIndexInfo c_build_index_info) {
     delete info;
 }
 
+void
+CancelBuildIndex(CBuildIndexInfo c_build_index_info) {
+    auto info = (BuildIndexInfo*)c_build_index_info;
+    info->cancelled->store(true);
+}
+
+void
+SetContentAddressed(CBuildIndexInfo c_build_index_info,
+                    bool content_addressed) {
+    auto info = (BuildIndexInfo*)c_build_index_info;
+    info->content_addressed = content_addressed;
+}
+
 CStatus
 AppendBuildIndexParam(CBuildIndexInfo c_build_index_info,
                       const uint8_t* serialized_index_params,
@@ -446,6 +470,27 @@ AppendInsertFilePath(CBuildIndexInfo c_build_index_info,
     }
 }
 
+CStatus
+AppendObjectTag(CBuildIndexInfo c_build_index_info,
+                const char* key,
+                const char* value) {
+    try {
+        auto build_index_info = (BuildIndexInfo*)c_build_index_info;
+        build_index_info->storage_config.object_tags[std::string(key)] =
+            std::string(value);
+
+        auto status = CStatus();
+        status.error_code = Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
+
 CStatus
 SerializeIndexAndUpLoad(CIndex index, CBinarySet* c_binary_set) {
     auto status = CStatus();
@@ -59,6 +59,18 @@ NewBuildIndexInfo(CBuildIndexInfo* c_build_index_info,
 void
 DeleteBuildIndexInfo(CBuildIndexInfo c_build_index_info);
 
+// CancelBuildIndex aborts the running CreateIndexV2 of the build info at the
+// next cancellation check, i.e. between the batches of binlogs loaded or
+// after the knowhere build. The knowhere build itself isn't interrupted.
+void
+CancelBuildIndex(CBuildIndexInfo c_build_index_info);
+
+// SetContentAddressed uploads the memory index files of the build to the
+// content-addressed keys, which are the keys of the binary set uploaded.
+void
+SetContentAddressed(CBuildIndexInfo c_build_index_info,
+                    bool content_addressed);
+
 CStatus
 AppendBuildIndexParam(CBuildIndexInfo c_build_index_info,
                       const uint8_t* serialized_type_params,
@@ -86,6 +98,11 @@ AppendIndexMetaInfo(CBuildIndexInfo c_build_index_info,
 CStatus
 AppendInsertFilePath(CBuildIndexInfo c_build_index_info, const char* file_path);
 
+CStatus
+AppendObjectTag(CBuildIndexInfo c_build_index_info,
+                const char* key,
+                const char* value);
+
 CStatus
 CreateIndexV2(CIndex* res_index, CBuildIndexInfo c_build_index_info);
 
@@ -27,3 +27,12 @@ IndexBuilderSetSimdType(const char* value) {
     ret[real_type.length()] = 0;
     return ret;
 }
+
+bool
+IndexBuilderGpuEnabled() {
+#ifdef MILVUS_GPU_VERSION
+    return true;
+#else
+    return false;
+#endif
+}
@@ -11,6 +11,8 @@
 
 #pragma once
 
+#include <stdbool.h>
+
 #ifdef __cplusplus
 extern "C" {
 #endif
@@ -22,6 +24,10 @@ IndexBuilderInit(const char*);
 char*
 IndexBuilderSetSimdType(const char*);
 
+// whether the index builder is built with the GPU indexes
+bool
+IndexBuilderGpuEnabled();
+
 #ifdef __cplusplus
 };
 #endif
@@ -15,6 +15,8 @@
 // limitations under the License.
 
 #include <stdint.h>
+#include <atomic>
+#include <memory>
 #include <string>
 #include <vector>
 #include "common/Types.h"
@@ -33,4 +35,9 @@ struct BuildIndexInfo {
     std::vector<std::string> insert_files;
     milvus::storage::StorageConfig storage_config;
     milvus::Config config;
+    // set by CancelBuildIndex, the build is aborted at the next check
+    std::shared_ptr<std::atomic<bool>> cancelled =
+        std::make_shared<std::atomic<bool>>(false);
+    // upload the index files to the content-addressed keys
+    bool content_addressed = false;
 };
\ No newline at end of file
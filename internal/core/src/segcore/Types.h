@@ -42,6 +42,9 @@ struct LoadIndexInfo {
     std::map<std::string, std::string> index_params;
     std::vector<std::string> index_files;
     index::IndexBasePtr index;
+    // the object storage of the collection, empty if it's the global one
+    std::string storage_address;
+    bool storage_requester_pays = false;
 };
 
 }  // namespace milvus::segcore
@@ -134,7 +134,8 @@ appendVecIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
                                               load_index_info->index_version};
         auto remote_chunk_manager =
             milvus::storage::RemoteChunkManagerSingleton::GetInstance()
-                .GetRemoteChunkManager();
+                .GetRemoteChunkManager(load_index_info->storage_address,
+                                       load_index_info->storage_requester_pays);
         auto file_manager =
             milvus::storage::CreateFileManager(index_info.index_type,
                                                field_meta,
@@ -240,7 +241,8 @@ AppendIndexV2(CLoadIndexInfo c_load_index_info) {
                                               load_index_info->index_version};
         auto remote_chunk_manager =
             milvus::storage::RemoteChunkManagerSingleton::GetInstance()
-                .GetRemoteChunkManager();
+                .GetRemoteChunkManager(load_index_info->storage_address,
+                                       load_index_info->storage_requester_pays);
         auto file_manager =
             milvus::storage::CreateFileManager(index_info.index_type,
                                                field_meta,
@@ -324,6 +326,28 @@ AppendIndexInfo(CLoadIndexInfo c_load_index_info,
     }
 }
 
+CStatus
+AppendStorageInfo(CLoadIndexInfo c_load_index_info,
+                  const char* address,
+                  bool requester_pays) {
+    try {
+        auto load_index_info =
+            (milvus::segcore::LoadIndexInfo*)c_load_index_info;
+        load_index_info->storage_address = std::string(address);
+        load_index_info->storage_requester_pays = requester_pays;
+
+        auto status = CStatus();
+        status.error_code = milvus::Success;
+        status.error_msg = "";
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = milvus::UnexpectedError;
+        status.error_msg = strdup(e.what());
+        return status;
+    }
+}
+
 CStatus
 CleanLoadedIndex(CLoadIndexInfo c_load_index_info) {
     try {
@@ -49,6 +49,11 @@ AppendIndexInfo(CLoadIndexInfo c_load_index_info,
                 int64_t build_id,
                 int64_t version);
 
+CStatus
+AppendStorageInfo(CLoadIndexInfo c_load_index_info,
+                  const char* address,
+                  bool requester_pays);
+
 CStatus
 AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set);
 
@@ -66,6 +66,9 @@ generateConfig(const StorageConfig& storage_config) {
 
 AwsChunkManager::AwsChunkManager(const StorageConfig& storage_config) {
     default_bucket_name_ = storage_config.bucket_name;
+    object_tagging_ = EncodeObjectTagging(storage_config.object_tags);
+    requester_pays_ = storage_config.requester_pays;
+    custom_headers_ = storage_config.custom_headers;
 
     InitSDKAPIDefault(storage_config.log_level);
 
@@ -98,6 +101,9 @@ AwsChunkManager::AwsChunkManager(const StorageConfig& storage_config) {
 
 GcpChunkManager::GcpChunkManager(const StorageConfig& storage_config) {
     default_bucket_name_ = storage_config.bucket_name;
+    object_tagging_ = EncodeObjectTagging(storage_config.object_tags);
+    requester_pays_ = storage_config.requester_pays;
+    custom_headers_ = storage_config.custom_headers;
 
     if (storage_config.useIAM) {
         sdk_options_.httpOptions.httpClientFactory_create_fn = []() {
@@ -130,6 +136,9 @@ GcpChunkManager::GcpChunkManager(const StorageConfig& storage_config) {
 
 AliyunChunkManager::AliyunChunkManager(const StorageConfig& storage_config) {
     default_bucket_name_ = storage_config.bucket_name;
+    object_tagging_ = EncodeObjectTagging(storage_config.object_tags);
+    requester_pays_ = storage_config.requester_pays;
+    custom_headers_ = storage_config.custom_headers;
 
     InitSDKAPIDefault(storage_config.log_level);
 
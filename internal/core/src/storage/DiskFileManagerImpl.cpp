@@ -149,6 +149,7 @@ DiskFileManagerImpl::AddBatchIndexFiles(
         data_slices.emplace_back(res.get());
     }
 
+    // never content addressed, the slices are reassembled by the common prefix
     auto res = PutIndexData(rcm_.get(),
                             data_slices,
                             remote_file_sizes,
@@ -270,6 +271,7 @@ DiskFileManagerImpl::CacheRawDataToDisk(std::vector<std::string> remote_files) {
     int64_t write_offset = sizeof(num_rows) + sizeof(dim);
 
     auto FetchRawData = [&]() {
+        CheckCancelled();
         auto field_datas = GetObjectData(rcm_.get(), batch_files);
         int batch_size = batch_files.size();
         for (int i = 0; i < batch_size; ++i) {
@@ -16,12 +16,14 @@
 
 #pragma once
 
+#include <atomic>
 #include <string>
 #include <optional>
 #include <memory>
 
 #include "knowhere/file_manager.h"
 #include "common/Consts.h"
+#include "common/EasyAssert.h"
 #include "storage/ChunkManager.h"
 #include "storage/Types.h"
 #include "log/Log.h"
@@ -99,6 +101,30 @@ class FileManagerImpl : public knowhere::FileManager {
         return index_meta_;
     }
 
+    // SetCancelled sets the flag of the build, the raw data loading checks it
+    // between batches and aborts once it's set. The knowhere build doesn't
+    // check it, it's checked once more after the build returns.
+    void
+    SetCancelled(std::shared_ptr<std::atomic<bool>> cancelled) {
+        cancelled_ = std::move(cancelled);
+    }
+
+    // SetContentAddressed uploads the memory index files to the
+    // content-addressed keys named by their hash, which are returned by
+    // GetRemotePathsToFileSize. The slices of the disk index files are
+    // reassembled by their common prefix, so they stay on the build paths.
+    void
+    SetContentAddressed(bool content_addressed) {
+        content_addressed_ = content_addressed;
+    }
+
+    void
+    CheckCancelled() const {
+        if (cancelled_ != nullptr && cancelled_->load()) {
+            throw SegcoreError(BuildCancelled, "index build is cancelled");
+        }
+    }
+
     virtual std::string
     GetRemoteIndexObjectPrefix() const {
         return rcm_->GetRootPath() + "/" + std::string(INDEX_ROOT_PATH) + "/" +
@@ -115,6 +141,9 @@ class FileManagerImpl : public knowhere::FileManager {
     // index meta
     IndexMeta index_meta_;
     ChunkManagerPtr rcm_;
+
+    std::shared_ptr<std::atomic<bool>> cancelled_;
+    bool content_addressed_ = false;
 };
 
 using FileManagerImplPtr = std::shared_ptr<FileManagerImpl>;
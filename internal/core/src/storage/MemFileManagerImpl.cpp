@@ -48,7 +48,8 @@ MemFileManagerImpl::AddFile(const BinarySet& binary_set) {
                                 slice_sizes,
                                 slice_names,
                                 field_meta_,
-                                index_meta_);
+                                index_meta_,
+                                content_addressed_);
         for (auto& [file, size] : res) {
             remote_paths_to_size_[file] = size;
         }
@@ -135,6 +136,7 @@ MemFileManagerImpl::CacheRawDataToMemory(
     std::vector<FieldDataPtr> field_datas;
 
     auto FetchRawData = [&]() {
+        CheckCancelled();
         auto raw_datas = GetObjectData(rcm_.get(), batch_files);
         for (auto& data : raw_datas) {
             field_datas.emplace_back(data);
@@ -14,10 +14,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+#include <algorithm>
+#include <cmath>
 #include <fstream>
 #include <aws/core/auth/AWSCredentials.h>
 #include <aws/core/auth/AWSCredentialsProviderChain.h>
 #include <aws/core/auth/STSCredentialsProvider.h>
+#include <aws/core/utils/StringUtils.h>
 #include <aws/core/utils/logging/ConsoleLogSystem.h>
 #include <aws/s3/model/CreateBucketRequest.h>
 #include <aws/s3/model/DeleteBucketRequest.h>
@@ -51,6 +54,32 @@ namespace milvus::storage {
 
 std::atomic<size_t> MinioChunkManager::init_count_(0);
 std::mutex MinioChunkManager::client_mutex_;
+std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+    MinioChunkManager::request_limiter_;
+std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+    MinioChunkManager::bandwidth_limiter_;
+
+void
+MinioChunkManager::SetRateLimits(double request_rate, int64_t bandwidth) {
+    std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+        request_limiter;
+    if (request_rate > 0) {
+        // the limiter counts the whole requests, the rate below 1 is rounded up
+        request_limiter =
+            std::make_shared<Aws::Utils::RateLimits::DefaultRateLimiter<>>(
+                std::max<int64_t>(1, std::llround(request_rate)));
+    }
+    std::atomic_store(&request_limiter_, request_limiter);
+
+    std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+        bandwidth_limiter;
+    if (bandwidth > 0) {
+        bandwidth_limiter =
+            std::make_shared<Aws::Utils::RateLimits::DefaultRateLimiter<>>(
+                bandwidth);
+    }
+    std::atomic_store(&bandwidth_limiter_, bandwidth_limiter);
+}
 
 static void
 SwallowHandler(int signal) {
@@ -285,9 +314,28 @@ MinioChunkManager::BuildGoogleCloudClient(
     }
 }
 
+std::string
+MinioChunkManager::EncodeObjectTagging(
+    const std::map<std::string, std::string>& tags) {
+    std::string tagging;
+    for (const auto& [key, value] : tags) {
+        if (!tagging.empty()) {
+            tagging += "&";
+        }
+        tagging += std::string(Aws::Utils::StringUtils::URLEncode(key.c_str()));
+        tagging += "=";
+        tagging +=
+            std::string(Aws::Utils::StringUtils::URLEncode(value.c_str()));
+    }
+    return tagging;
+}
+
 MinioChunkManager::MinioChunkManager(const StorageConfig& storage_config)
     : default_bucket_name_(storage_config.bucket_name) {
     remote_root_path_ = storage_config.root_path;
+    object_tagging_ = EncodeObjectTagging(storage_config.object_tags);
+    requester_pays_ = storage_config.requester_pays;
+    custom_headers_ = storage_config.custom_headers;
     RemoteStorageType storageType;
     if (storage_config.address.find("google") != std::string::npos) {
         storageType = RemoteStorageType::GOOGLE_CLOUD;
@@ -317,6 +365,10 @@ MinioChunkManager::MinioChunkManager(const StorageConfig& storage_config)
         config.region = ConvertToAwsString(storage_config.region);
     }
 
+    // the reads and the writes of all the clients share the bandwidth
+    config.readRateLimiter = std::atomic_load(&bandwidth_limiter_);
+    config.writeRateLimiter = config.readRateLimiter;
+
     if (storageType == RemoteStorageType::S3) {
         BuildS3Client(storage_config, config);
     } else if (storageType == RemoteStorageType::ALIYUN_CLOUD) {
@@ -456,6 +508,7 @@ MinioChunkManager::ObjectExists(const std::string& bucket_name,
     Aws::S3::Model::HeadObjectRequest request;
     request.SetBucket(bucket_name.c_str());
     request.SetKey(object_name.c_str());
+    PrepareObjectRequest(request);
 
     auto outcome = client_->HeadObject(request);
 
@@ -477,6 +530,7 @@ MinioChunkManager::GetObjectSize(const std::string& bucket_name,
     Aws::S3::Model::HeadObjectRequest request;
     request.SetBucket(bucket_name.c_str());
     request.SetKey(object_name.c_str());
+    PrepareObjectRequest(request);
 
     auto outcome = client_->HeadObject(request);
     if (!outcome.IsSuccess()) {
@@ -491,6 +545,7 @@ MinioChunkManager::DeleteObject(const std::string& bucket_name,
     Aws::S3::Model::DeleteObjectRequest request;
     request.SetBucket(bucket_name.c_str());
     request.SetKey(object_name.c_str());
+    PrepareObjectRequest(request);
 
     auto outcome = client_->DeleteObject(request);
 
@@ -512,12 +567,16 @@ MinioChunkManager::PutObjectBuffer(const std::string& bucket_name,
     Aws::S3::Model::PutObjectRequest request;
     request.SetBucket(bucket_name.c_str());
     request.SetKey(object_name.c_str());
+    PrepareObjectRequest(request);
 
     const std::shared_ptr<Aws::IOStream> input_data =
         Aws::MakeShared<Aws::StringStream>("");
 
     input_data->write(reinterpret_cast<char*>(buf), size);
     request.SetBody(input_data);
+    if (!object_tagging_.empty()) {
+        request.SetTagging(object_tagging_.c_str());
+    }
 
     auto outcome = client_->PutObject(request);
 
@@ -574,6 +633,7 @@ MinioChunkManager::GetObjectBuffer(const std::string& bucket_name,
     Aws::S3::Model::GetObjectRequest request;
     request.SetBucket(bucket_name.c_str());
     request.SetKey(object_name.c_str());
+    PrepareObjectRequest(request);
 
     request.SetResponseStreamFactory([buf, size]() {
     // For macOs, pubsetbuf interface not implemented
@@ -603,6 +663,7 @@ MinioChunkManager::ListObjects(const char* bucket_name, const char* prefix) {
     if (prefix != nullptr) {
         request.SetPrefix(prefix);
     }
+    PrepareObjectRequest(request);
 
     auto outcome = client_->ListObjects(request);
 
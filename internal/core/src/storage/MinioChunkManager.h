@@ -24,7 +24,9 @@
 #include <aws/core/http/curl/CurlHttpClient.h>
 #include <aws/core/http/standard/StandardHttpRequest.h>
 #include <aws/core/utils/logging/FormattedLogSystem.h>
+#include <aws/core/utils/ratelimiter/DefaultRateLimiter.h>
 #include <aws/s3/S3Client.h>
+#include <aws/s3/model/RequestPayer.h>
 #include <google/cloud/credentials.h>
 #include <google/cloud/internal/oauth2_credentials.h>
 #include <google/cloud/internal/oauth2_google_credentials.h>
@@ -189,17 +191,51 @@ class MinioChunkManager : public ChunkManager {
     BuildGoogleCloudClient(const StorageConfig& storage_config,
                            const Aws::Client::ClientConfiguration& config);
 
+    // encode the tags as the url query of x-amz-tagging header
+    static std::string
+    EncodeObjectTagging(const std::map<std::string, std::string>& tags);
+
+    // set the request rate and the bandwidth in bytes per second of the
+    // object storage shared by all the chunk managers of the process, not
+    // positive means unlimited. The bandwidth applies to the clients built
+    // after it, so it should be set before creating the chunk managers.
+    static void
+    SetRateLimits(double request_rate, int64_t bandwidth);
+
  protected:
     void
     BuildAccessKeyClient(const StorageConfig& storage_config,
                          const Aws::Client::ClientConfiguration& config);
 
+    // set the request payer and custom headers of the object requests
+    template <typename Request>
+    void
+    PrepareObjectRequest(Request& request) const {
+        auto request_limiter = std::atomic_load(&request_limiter_);
+        if (request_limiter != nullptr) {
+            request_limiter->ApplyAndPayForCost(1);
+        }
+        if (requester_pays_) {
+            request.SetRequestPayer(Aws::S3::Model::RequestPayer::requester);
+        }
+        for (const auto& [key, value] : custom_headers_) {
+            request.SetAdditionalCustomHeaderValue(key.c_str(), value.c_str());
+        }
+    }
+
     Aws::SDKOptions sdk_options_;
     static std::atomic<size_t> init_count_;
     static std::mutex client_mutex_;
+    static std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+        request_limiter_;
+    static std::shared_ptr<Aws::Utils::RateLimits::RateLimiterInterface>
+        bandwidth_limiter_;
     std::shared_ptr<Aws::S3::S3Client> client_;
     std::string default_bucket_name_;
     std::string remote_root_path_;
+    std::string object_tagging_;
+    bool requester_pays_ = false;
+    std::map<std::string, std::string> custom_headers_;
 };
 
 class AwsChunkManager : public MinioChunkManager {
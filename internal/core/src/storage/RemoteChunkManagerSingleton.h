@@ -16,8 +16,12 @@
 
 #pragma once
 
+#include <map>
 #include <memory>
+#include <mutex>
 #include <shared_mutex>
+#include <string>
+#include <utility>
 
 #include "storage/Util.h"
 
@@ -42,6 +46,7 @@ class RemoteChunkManagerSingleton {
     void
     Init(const StorageConfig& storage_config) {
         if (rcm_ == nullptr) {
+            storage_config_ = storage_config;
             rcm_ = CreateChunkManager(storage_config);
         }
     }
@@ -55,8 +60,38 @@ class RemoteChunkManagerSingleton {
         return rcm_;
     }
 
+    // GetRemoteChunkManager returns the chunk manager of the object storage
+    // of a collection, which is the global one but the address and
+    // requester-pays, the empty address means the global one.
+    ChunkManagerPtr
+    GetRemoteChunkManager(const std::string& address, bool requester_pays) {
+        if (address.empty() ||
+            (address == storage_config_.address &&
+             requester_pays == storage_config_.requester_pays)) {
+            return rcm_;
+        }
+        std::lock_guard<std::mutex> lock(mutex_);
+        auto key = std::make_pair(address, requester_pays);
+        auto it = collection_rcms_.find(key);
+        if (it != collection_rcms_.end()) {
+            return it->second;
+        }
+        auto storage_config = storage_config_;
+        storage_config.address = address;
+        storage_config.requester_pays = requester_pays;
+        auto rcm = CreateChunkManager(storage_config);
+        collection_rcms_.emplace(key, rcm);
+        return rcm;
+    }
+
  private:
     ChunkManagerPtr rcm_ = nullptr;
+    StorageConfig storage_config_;
+
+    std::mutex mutex_;
+    // the chunk managers of the object storages of the collections,
+    // by the address and requester-pays
+    std::map<std::pair<std::string, bool>, ChunkManagerPtr> collection_rcms_;
 };
 
 }  // namespace milvus::storage
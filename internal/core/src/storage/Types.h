@@ -16,6 +16,7 @@
 
 #pragma once
 
+#include <map>
 #include <string>
 
 #include "common/Types.h"
@@ -95,6 +96,12 @@ struct StorageConfig {
     bool useSSL = false;
     bool useIAM = false;
     bool useVirtualHost = false;
+    // tags attached to the uploaded objects
+    std::map<std::string, std::string> object_tags;
+    // the requester is charged for the requests and transfer of the bucket
+    bool requester_pays = false;
+    // headers sent with every request
+    std::map<std::string, std::string> custom_headers;
 };
 
 }  // namespace milvus::storage
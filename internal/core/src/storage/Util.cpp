@@ -16,7 +16,9 @@
 // limitations under the License.
 
 #include "storage/Util.h"
+#include <cstring>
 #include <memory>
+#include <aws/core/utils/HashingUtils.h>
 #include "arrow/array/builder_binary.h"
 #include "arrow/type_fwd.h"
 #include "common/EasyAssert.h"
@@ -406,13 +408,34 @@ DownloadAndDecodeRemoteFile(ChunkManager* chunk_manager,
     return DeserializeFileData(buf, fileSize);
 }
 
+std::string
+ContentAddressedIndexObjectKey(ChunkManager* chunk_manager,
+                               const uint8_t* buf,
+                               int64_t size,
+                               const std::string& object_key) {
+    // the raw slice is hashed rather than the encoded one, which carries the
+    // meta of the build
+    auto hash = Aws::Utils::HashingUtils::HexEncode(
+        Aws::Utils::HashingUtils::CalculateSHA256(
+            Aws::String(reinterpret_cast<const char*>(buf), size)));
+    auto name = object_key.substr(object_key.find_last_of('/') + 1);
+    return chunk_manager->GetRootPath() + "/" +
+           std::string(INDEX_CAS_ROOT_PATH) + "/" +
+           std::string(hash.c_str(), hash.size()) + "/" + name;
+}
+
 std::pair<std::string, size_t>
 EncodeAndUploadIndexSlice(ChunkManager* chunk_manager,
                           uint8_t* buf,
                           int64_t batch_size,
                           IndexMeta index_meta,
                           FieldDataMeta field_meta,
-                          std::string object_key) {
+                          std::string object_key,
+                          bool content_addressed) {
+    if (content_addressed) {
+        object_key = ContentAddressedIndexObjectKey(
+            chunk_manager, buf, batch_size, object_key);
+    }
     auto field_data = CreateFieldData(DataType::INT8);
     field_data->FillFieldData(buf, batch_size);
     auto indexData = std::make_shared<IndexData>(field_data);
@@ -508,7 +531,8 @@ PutIndexData(ChunkManager* remote_chunk_manager,
              const std::vector<int64_t>& slice_sizes,
              const std::vector<std::string>& slice_names,
              FieldDataMeta& field_meta,
-             IndexMeta& index_meta) {
+             IndexMeta& index_meta,
+             bool content_addressed) {
     auto& pool = ThreadPools::GetThreadPool(milvus::ThreadPoolPriority::MIDDLE);
     std::vector<std::future<std::pair<std::string, size_t>>> futures;
     AssertInfo(data_slices.size() == slice_sizes.size(),
@@ -523,7 +547,8 @@ PutIndexData(ChunkManager* remote_chunk_manager,
                                       slice_sizes[i],
                                       index_meta,
                                       field_meta,
-                                      slice_names[i]));
+                                      slice_names[i],
+                                      content_addressed));
     }
 
     std::map<std::string, int64_t> remote_paths_to_size;
@@ -569,6 +594,19 @@ ReleaseArrowUnused() {
     }
 }
 
+std::map<std::string, std::string>
+ParseCustomHeaders(const char* custom_headers) {
+    std::map<std::string, std::string> headers;
+    if (custom_headers == nullptr || std::strlen(custom_headers) == 0) {
+        return headers;
+    }
+    auto json = nlohmann::json::parse(custom_headers);
+    for (auto& [key, value] : json.items()) {
+        headers[key] = value.get<std::string>();
+    }
+    return headers;
+}
+
 ChunkManagerPtr
 CreateChunkManager(const StorageConfig& storage_config) {
     auto storage_type = ChunkManagerType_Map[storage_config.storage_type];
@@ -86,13 +86,26 @@ std::unique_ptr<DataCodec>
 DownloadAndDecodeRemoteFile(ChunkManager* chunk_manager,
                             const std::string& file);
 
+// ContentAddressedIndexObjectKey returns the key of the index slice under
+// the content-addressed root, named by the sha256 of the slice and the slice
+// name, so the identical slices of the rebuilds are stored once.
+std::string
+ContentAddressedIndexObjectKey(ChunkManager* chunk_manager,
+                               const uint8_t* buf,
+                               int64_t size,
+                               const std::string& object_key);
+
+// EncodeAndUploadIndexSlice uploads the slice to object_key, or to the
+// content-addressed key of it if content_addressed is set, the key uploaded
+// to is returned with the size.
 std::pair<std::string, size_t>
 EncodeAndUploadIndexSlice(ChunkManager* chunk_manager,
                           uint8_t* buf,
                           int64_t batch_size,
                           IndexMeta index_meta,
                           FieldDataMeta field_meta,
-                          std::string object_key);
+                          std::string object_key,
+                          bool content_addressed);
 
 std::pair<std::string, size_t>
 EncodeAndUploadFieldSlice(ChunkManager* chunk_manager,
@@ -112,7 +125,8 @@ PutIndexData(ChunkManager* remote_chunk_manager,
              const std::vector<int64_t>& slice_sizes,
              const std::vector<std::string>& slice_names,
              FieldDataMeta& field_meta,
-             IndexMeta& index_meta);
+             IndexMeta& index_meta,
+             bool content_addressed = false);
 
 int64_t
 GetTotalNumRowsForFieldDatas(const std::vector<FieldDataPtr>& field_datas);
@@ -129,6 +143,10 @@ ReleaseArrowUnused();
 ChunkManagerPtr
 CreateChunkManager(const StorageConfig& storage_config);
 
+// parse the json object of custom headers, empty if it's null or empty
+std::map<std::string, std::string>
+ParseCustomHeaders(const char* custom_headers);
+
 FileManagerImplPtr
 CreateFileManager(IndexType index_type,
                   const FieldDataMeta& field_meta,
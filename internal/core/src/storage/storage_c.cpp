@@ -18,6 +18,8 @@
 #include "storage/RemoteChunkManagerSingleton.h"
 #include "storage/LocalChunkManagerSingleton.h"
 #include "storage/ChunkCacheSingleton.h"
+#include "storage/Util.h"
+#include "storage/MinioChunkManager.h"
 
 CStatus
 GetLocalUsedSize(const char* c_dir, int64_t* size) {
@@ -71,6 +73,9 @@ InitRemoteChunkManagerSingleton(CStorageConfig c_storage_config) {
         storage_config.useIAM = c_storage_config.useIAM;
         storage_config.useVirtualHost = c_storage_config.useVirtualHost;
         storage_config.region = c_storage_config.region;
+        storage_config.requester_pays = c_storage_config.requester_pays;
+        storage_config.custom_headers = milvus::storage::ParseCustomHeaders(
+            c_storage_config.custom_headers);
         milvus::storage::RemoteChunkManagerSingleton::GetInstance().Init(
             storage_config);
 
@@ -94,3 +99,8 @@ void
 CleanRemoteChunkManagerSingleton() {
     milvus::storage::RemoteChunkManagerSingleton::GetInstance().Release();
 }
+
+void
+SetStorageRateLimits(double request_rate, int64_t bandwidth) {
+    milvus::storage::MinioChunkManager::SetRateLimits(request_rate, bandwidth);
+}
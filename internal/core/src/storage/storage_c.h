@@ -36,6 +36,12 @@ InitChunkCacheSingleton(const char* c_dir_path);
 void
 CleanRemoteChunkManagerSingleton();
 
+// SetStorageRateLimits limits the requests per second and the bytes per
+// second to the object storage of all the chunk managers of the process,
+// not positive means unlimited.
+void
+SetStorageRateLimits(double request_rate, int64_t bandwidth);
+
 #ifdef __cplusplus
 };
 #endif
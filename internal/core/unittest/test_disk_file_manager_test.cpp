@@ -98,6 +98,24 @@ TEST_F(DiskAnnFileManagerTest, AddFilePositiveParallel) {
     }
 }
 
+TEST_F(DiskAnnFileManagerTest, CacheRawDataCancelled) {
+    FieldDataMeta field_data_meta = {1, 2, 3, 100};
+    IndexMeta index_meta = {3, 100, 1000, 1, "index"};
+    auto file_manager =
+        std::make_shared<DiskFileManagerImpl>(field_data_meta, index_meta, cm_);
+    EXPECT_NO_THROW(file_manager->CheckCancelled());
+
+    auto cancelled = std::make_shared<std::atomic<bool>>(false);
+    file_manager->SetCancelled(cancelled);
+    EXPECT_NO_THROW(file_manager->CheckCancelled());
+
+    cancelled->store(true);
+    EXPECT_THROW(file_manager->CheckCancelled(), SegcoreError);
+    EXPECT_THROW(
+        file_manager->CacheRawDataToDisk({"/tmp/diskann/insert_log/1"}),
+        SegcoreError);
+}
+
 int
 test_worker(string s) {
     std::cout << s << std::endl;
@@ -18,17 +18,21 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/minio/minio-go/v7"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -44,6 +48,8 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	// client of the object storage of the collections not using the global one, nil if they're not recycled
+	collectionCli func(ctx context.Context, cs storage.CollectionStorage) (storage.ChunkManager, error)
 }
 
 // garbageCollector handles garbage files in object storage
@@ -98,6 +104,7 @@ func (gc *garbageCollector) work() {
 			gc.recycleUnusedSegIndexes()
 			gc.scan()
 			gc.recycleUnusedIndexFiles()
+			gc.recycleUnusedCASIndexFiles()
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
 			return
@@ -384,10 +391,28 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 	log.Info("start recycleUnusedIndexFiles")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	gc.recycleUnusedIndexFilesOf(ctx, gc.option.cli)
+	if gc.option.collectionCli == nil {
+		return
+	}
+	// the index files of the collections on their own object storage are built there
+	for _, cs := range gc.meta.GetCollectionStorages() {
+		cli, err := gc.option.collectionCli(ctx, cs)
+		if err != nil {
+			log.Warn("garbageCollector recycleUnusedIndexFiles failed to connect the object storage of collections",
+				zap.String("endpoint", cs.Endpoint), zap.Error(err))
+			continue
+		}
+		gc.recycleUnusedIndexFilesOf(ctx, cli)
+	}
+}
+
+// recycleUnusedIndexFilesOf deletes the index files no longer existing in the meta from the object storage of cli.
+func (gc *garbageCollector) recycleUnusedIndexFilesOf(ctx context.Context, cli storage.ChunkManager) {
 	startTs := time.Now()
-	prefix := path.Join(gc.option.cli.RootPath(), common.SegmentIndexPath) + "/"
+	prefix := path.Join(cli.RootPath(), common.SegmentIndexPath) + "/"
 	// list dir first
-	keys, _, err := gc.option.cli.ListWithPrefix(ctx, prefix, false)
+	keys, _, err := cli.ListWithPrefix(ctx, prefix, false)
 	if err != nil {
 		log.Warn("garbageCollector recycleUnusedIndexFiles list keys from chunk manager failed", zap.Error(err))
 		return
@@ -412,7 +437,7 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			// buildID no longer exists in meta, remove all index files
 			log.Info("garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files",
 				zap.Int64("buildID", buildID))
-			err = gc.option.cli.RemoveWithPrefix(ctx, key)
+			err = cli.RemoveWithPrefix(ctx, key)
 			if err != nil {
 				log.Warn("garbageCollector recycleUnusedIndexFiles remove index files failed",
 					zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
@@ -424,11 +449,17 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 		}
 		filesMap := make(map[string]struct{})
 		for _, fileID := range segIdx.IndexFileKeys {
-			filepath := metautil.BuildSegmentIndexFilePath(gc.option.cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			filepath := metautil.BuildSegmentIndexFilePath(cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
 				segIdx.PartitionID, segIdx.SegmentID, fileID)
 			filesMap[filepath] = struct{}{}
 		}
-		files, _, err := gc.option.cli.ListWithPrefix(ctx, key, true)
+		manifestPath := metautil.BuildSegmentIndexManifestPath(cli.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID)
+		filesMap[manifestPath] = struct{}{}
+		uploadManifestPath := metautil.BuildSegmentIndexUploadManifestPath(cli.RootPath(), segIdx.BuildID,
+			segIdx.IndexVersion, segIdx.PartitionID, segIdx.SegmentID)
+		filesMap[uploadManifestPath] = struct{}{}
+		files, _, err := cli.ListWithPrefix(ctx, key, true)
 		if err != nil {
 			log.Warn("garbageCollector recycleUnusedIndexFiles list files failed",
 				zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
@@ -439,7 +470,7 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 		deletedFilesNum := 0
 		for _, file := range files {
 			if _, ok := filesMap[file]; !ok {
-				if err = gc.option.cli.Remove(ctx, file); err != nil {
+				if err = cli.Remove(ctx, file); err != nil {
 					log.Warn("garbageCollector recycleUnusedIndexFiles remove file failed",
 						zap.Int64("buildID", buildID), zap.String("file", file), zap.Error(err))
 					continue
@@ -451,3 +482,167 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			zap.Int("delete index files num", deletedFilesNum))
 	}
 }
+
+// recycleUnusedCASIndexFiles is used to delete those content-addressed index files no longer used by any build.
+func (gc *garbageCollector) recycleUnusedCASIndexFiles() {
+	log.Info("start recycleUnusedCASIndexFiles")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	files, err := gc.listUnusedCASIndexFiles(ctx)
+	if err != nil {
+		log.Warn("garbageCollector recycleUnusedCASIndexFiles list files failed", zap.Error(err))
+		return
+	}
+	deletedFilesNum := 0
+	for _, file := range files {
+		// the file may be uploaded again by a build since listed, stat it right before removing
+		_, modTimes, err := gc.option.cli.ListWithPrefix(ctx, file, false)
+		if err != nil {
+			log.Warn("garbageCollector recycleUnusedCASIndexFiles stat file failed", zap.String("file", file), zap.Error(err))
+			continue
+		}
+		if len(modTimes) == 0 || time.Since(modTimes[0]) <= gc.option.missingTolerance {
+			continue
+		}
+		if err := gc.option.cli.Remove(ctx, file); err != nil {
+			log.Warn("garbageCollector recycleUnusedCASIndexFiles remove file failed", zap.String("file", file), zap.Error(err))
+			continue
+		}
+		deletedFilesNum++
+	}
+	log.Info("content-addressed index files recycle success", zap.Int("delete index files num", deletedFilesNum))
+}
+
+// listUnusedCASIndexFiles lists the content-addressed index files not recorded by any build in meta nor by the
+// manifests of the builds not finished yet. The files written within missingTolerance are skipped too, they may
+// be uploaded by the builds not writing their manifests yet.
+func (gc *garbageCollector) listUnusedCASIndexFiles(ctx context.Context) ([]string, error) {
+	rootPath := gc.option.cli.RootPath()
+	usedFiles := make(map[string]struct{})
+	for _, segIdx := range gc.meta.GetAllSegIndexes() {
+		fileKeys := segIdx.IndexFileKeys
+		if segIdx.IndexState != commonpb.IndexState_Finished {
+			var err error
+			fileKeys, err = gc.readCASIndexFileManifest(ctx, segIdx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, fileKey := range fileKeys {
+			if metautil.IsContentAddressedIndexFileKey(fileKey) {
+				usedFiles[metautil.BuildContentAddressedIndexFilePath(rootPath, fileKey)] = struct{}{}
+			}
+		}
+	}
+	files, modTimes, err := gc.option.cli.ListWithPrefix(ctx, path.Join(rootPath, common.SegmentIndexCASPath)+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	unused := make([]string, 0)
+	for i, file := range files {
+		if _, ok := usedFiles[file]; ok || time.Since(modTimes[i]) <= gc.option.missingTolerance {
+			continue
+		}
+		unused = append(unused, file)
+	}
+	return unused, nil
+}
+
+// casIndexFileManifest is the manifest of the content-addressed index files written by IndexNode for a build
+type casIndexFileManifest struct {
+	Files []struct {
+		Name string `json:"name"`
+		Hash string `json:"hash"`
+	} `json:"files"`
+}
+
+// readCASIndexFileManifest returns the content-addressed file keys in the manifest of the build segIdx,
+// nil is returned if the manifest doesn't exist.
+func (gc *garbageCollector) readCASIndexFileManifest(ctx context.Context, segIdx *model.SegmentIndex) ([]string, error) {
+	manifestPath := metautil.BuildSegmentIndexManifestPath(gc.option.cli.RootPath(), segIdx.BuildID,
+		segIdx.IndexVersion, segIdx.PartitionID, segIdx.SegmentID)
+	exist, err := gc.option.cli.Exist(ctx, manifestPath)
+	if err != nil || !exist {
+		return nil, err
+	}
+	data, err := gc.option.cli.Read(ctx, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &casIndexFileManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	fileKeys := make([]string, 0, len(manifest.Files))
+	for _, file := range manifest.Files {
+		fileKeys = append(fileKeys, path.Join(file.Hash, file.Name))
+	}
+	return fileKeys, nil
+}
+
+// listOrphanIndexFiles lists the index files deletable without affecting any build, which are the files of
+// the builds unknown to meta, e.g. the tasks dropped after uploading but before their file keys are recorded,
+// the files of the stale versions of the builds in progress, and the files not recorded by the finished builds.
+// Nothing is removed, the admins decide whether to delete them.
+func (gc *garbageCollector) listOrphanIndexFiles(ctx context.Context) ([]string, error) {
+	if gc.option.cli == nil {
+		return nil, errors.New("chunk manager of garbage collector is not provided")
+	}
+	rootPath := gc.option.cli.RootPath()
+	prefix := path.Join(rootPath, common.SegmentIndexPath) + "/"
+	keys, _, err := gc.option.cli.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+	orphans := make([]string, 0)
+	for _, key := range keys {
+		buildID, err := parseBuildIDFromFilePath(key)
+		if err != nil {
+			log.Warn("listOrphanIndexFiles skip the unknown key", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		files, _, err := gc.option.cli.ListWithPrefix(ctx, key, true)
+		if err != nil {
+			return nil, err
+		}
+		segIdx, ok := gc.meta.GetIndexJob(buildID)
+		for _, file := range files {
+			if !ok || isOrphanIndexFile(rootPath, key, segIdx, file) {
+				orphans = append(orphans, file)
+			}
+		}
+	}
+	casOrphans, err := gc.listUnusedCASIndexFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orphans = append(orphans, casOrphans...)
+	return orphans, nil
+}
+
+// isOrphanIndexFile returns whether file under buildPrefix isn't used by the build segIdx
+func isOrphanIndexFile(rootPath string, buildPrefix string, segIdx *model.SegmentIndex, file string) bool {
+	if segIdx.IndexState == commonpb.IndexState_Finished {
+		if file == metautil.BuildSegmentIndexManifestPath(rootPath, segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID) {
+			return false
+		}
+		if file == metautil.BuildSegmentIndexUploadManifestPath(rootPath, segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID) {
+			return false
+		}
+		for _, fileKey := range segIdx.IndexFileKeys {
+			if file == metautil.BuildSegmentIndexFilePath(rootPath, segIdx.BuildID, segIdx.IndexVersion,
+				segIdx.PartitionID, segIdx.SegmentID, fileKey) {
+				return false
+			}
+		}
+		return true
+	}
+	// the files of the current version may be uploading by the indexnode
+	version, err := strconv.ParseInt(strings.SplitN(strings.TrimPrefix(file, buildPrefix), "/", 2)[0], 10, 64)
+	if err != nil {
+		return false
+	}
+	return version < segIdx.IndexVersion
+}
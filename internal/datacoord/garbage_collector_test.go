@@ -315,10 +315,16 @@ func initUtOSSEnv(bucket, root string, n int) (mcm *storage.MinioChunkManager, i
 		}
 		other = append(other, info.Key)
 	}
-	mcm = &storage.MinioChunkManager{
-		Client: cli,
+	mcm, err = storage.NewMinioChunkManager(context.TODO(),
+		storage.Address(Params.MinioCfg.Address.GetValue()),
+		storage.AccessKeyID(Params.MinioCfg.AccessKeyID.GetValue()),
+		storage.SecretAccessKeyID(Params.MinioCfg.SecretAccessKey.GetValue()),
+		storage.UseSSL(Params.MinioCfg.UseSSL.GetAsBool()),
+		storage.BucketName(bucket),
+		storage.RootPath(root))
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
 	}
-	mcm.SetVar(bucket, root)
 	return mcm, inserts, stats, delta, other, nil
 }
 
@@ -757,6 +763,102 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_listOrphanIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_files/", false).
+			Return([]string{"root/index_files/abc/", "root/index_files/600/", "root/index_files/601/", "root/index_files/602/"}, nil, nil)
+		cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_files/600/", true).Return([]string{
+			"root/index_files/600/0/200/500/file1",
+			"root/index_files/600/1/200/500/file1",
+			"root/index_files/600/1/200/500/file2",
+			"root/index_files/600/1/200/500/file3",
+			"root/index_files/600/1/200/500/manifest.json",
+		}, nil, nil)
+		cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_files/601/", true).Return([]string{
+			"root/index_files/601/0/200/501/file1",
+			"root/index_files/601/1/200/501/file1",
+		}, nil, nil)
+		cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_files/602/", true).Return([]string{
+			"root/index_files/602/1/200/502/file1",
+		}, nil, nil)
+		cm.EXPECT().Exist(mock.Anything, "root/index_files/601/1/200/501/manifest.json").Return(true, nil)
+		cm.EXPECT().Read(mock.Anything, "root/index_files/601/1/200/501/manifest.json").
+			Return([]byte(`{"files":[{"name":"file1","hash":"ccc","size":1}]}`), nil)
+		cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_cas/", true).Return([]string{
+			"root/index_cas/aaa/file1",
+			"root/index_cas/bbb/file1",
+			"root/index_cas/ccc/file1",
+		}, []time.Time{time.Now().Add(-time.Hour), time.Now(), time.Now().Add(-time.Hour)}, nil)
+		gc := &garbageCollector{
+			meta: createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
+			option: GcOption{
+				cli:              cm,
+				missingTolerance: time.Minute,
+			},
+		}
+		orphans, err := gc.listOrphanIndexFiles(ctx)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			// not recorded by the finished build
+			"root/index_files/600/0/200/500/file1",
+			"root/index_files/600/1/200/500/file3",
+			// the stale version of the build in progress
+			"root/index_files/601/0/200/501/file1",
+			// unknown build
+			"root/index_files/602/1/200/502/file1",
+			// content-addressed file not used by any build
+			"root/index_cas/aaa/file1",
+		}, orphans)
+	})
+
+	t.Run("list fail", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("error"))
+		gc := &garbageCollector{
+			meta: createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
+			option: GcOption{
+				cli: cm,
+			},
+		}
+		_, err := gc.listOrphanIndexFiles(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("no chunk manager", func(t *testing.T) {
+		gc := &garbageCollector{}
+		_, err := gc.listOrphanIndexFiles(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestGarbageCollector_recycleUnusedCASIndexFiles(t *testing.T) {
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("root")
+	cm.EXPECT().Exist(mock.Anything, "root/index_files/601/1/200/501/manifest.json").Return(false, nil)
+	cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_cas/", true).Return([]string{
+		"root/index_cas/aaa/file1",
+		"root/index_cas/bbb/file1",
+	}, []time.Time{time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)}, nil)
+	cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_cas/aaa/file1", false).
+		Return([]string{"root/index_cas/aaa/file1"}, []time.Time{time.Now().Add(-time.Hour)}, nil)
+	// uploaded again by a build since listed
+	cm.EXPECT().ListWithPrefix(mock.Anything, "root/index_cas/bbb/file1", false).
+		Return([]string{"root/index_cas/bbb/file1"}, []time.Time{time.Now()}, nil)
+	cm.EXPECT().Remove(mock.Anything, "root/index_cas/aaa/file1").Return(nil).Once()
+	gc := &garbageCollector{
+		meta: createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
+		option: GcOption{
+			cli:              cm,
+			missingTolerance: time.Minute,
+		},
+	}
+	gc.recycleUnusedCASIndexFiles()
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// indexArchiver moves the index files of released collections to the archive storage class,
+// and tracks the segment indexes being restored until their files are readable again.
+// The load states of the collections are read from the load info of QueryCoord in the meta store.
+type indexArchiver struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	checkInterval time.Duration
+	notifyChan    chan struct{}
+
+	meta         *meta
+	chunkManager storage.ChunkManager
+	metaKV       kv.MetaKv
+}
+
+func newIndexArchiver(ctx context.Context, metaTable *meta, chunkManager storage.ChunkManager, metaKV kv.MetaKv) *indexArchiver {
+	ctx, cancel := context.WithCancel(ctx)
+	return &indexArchiver{
+		ctx:           ctx,
+		cancel:        cancel,
+		checkInterval: Params.DataCoordCfg.IndexArchiveCheckInterval.GetAsDuration(time.Second),
+		notifyChan:    make(chan struct{}, 1),
+		meta:          metaTable,
+		chunkManager:  chunkManager,
+		metaKV:        metaKV,
+	}
+}
+
+func (ia *indexArchiver) Start() {
+	ia.wg.Add(1)
+	go ia.schedule()
+}
+
+func (ia *indexArchiver) Stop() {
+	ia.cancel()
+	ia.wg.Wait()
+}
+
+// notify is an unblocked notify function
+func (ia *indexArchiver) notify() {
+	select {
+	case ia.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+func (ia *indexArchiver) schedule() {
+	log.Ctx(ia.ctx).Info("index archiver schedule loop start")
+	defer ia.wg.Done()
+	ticker := time.NewTicker(ia.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ia.ctx.Done():
+			log.Ctx(ia.ctx).Warn("index archiver ctx done")
+			return
+		case <-ia.notifyChan:
+			ia.checkRestoring()
+		case <-ticker.C:
+			ia.checkRestoring()
+		}
+	}
+}
+
+// archiveFilePaths returns the paths of the index files which could be archived, the content-addressed
+// files are skipped since they may be shared with the builds of other collections.
+func (ia *indexArchiver) archiveFilePaths(segIdx *model.SegmentIndex) []string {
+	fileKeys := make([]string, 0, len(segIdx.IndexFileKeys))
+	for _, fileKey := range segIdx.IndexFileKeys {
+		if !metautil.IsContentAddressedIndexFileKey(fileKey) {
+			fileKeys = append(fileKeys, fileKey)
+		}
+	}
+	return metautil.BuildSegmentIndexFilePaths(ia.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+		segIdx.PartitionID, segIdx.SegmentID, fileKeys)
+}
+
+// finishedSegmentIndexes returns the finished segment indexes of the collection.
+func (ia *indexArchiver) finishedSegmentIndexes(collectionID UniqueID) []*model.SegmentIndex {
+	segIdxes := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range ia.meta.GetAllSegIndexes() {
+		if segIdx.CollectionID != collectionID || segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		segIdxes = append(segIdxes, segIdx)
+	}
+	return segIdxes
+}
+
+// isLoaded returns whether the collection or any of its partitions is loaded by QueryCoord.
+func (ia *indexArchiver) isLoaded(collectionID UniqueID) (bool, error) {
+	loaded, err := ia.metaKV.Has(querycoord.EncodeCollectionLoadInfoKey(collectionID))
+	if err != nil || loaded {
+		return loaded, err
+	}
+	return ia.metaKV.HasPrefix(fmt.Sprintf("%s/%d/", querycoord.PartitionLoadInfoPrefix, collectionID))
+}
+
+// archive moves the index files of the collection to the archive storage class. The collection
+// must be released, and restored by RestoreIndex before it's loaded again.
+func (ia *indexArchiver) archive(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	loaded, err := ia.isLoaded(collectionID)
+	if err != nil {
+		log.Warn("failed to check the load state of collection", zap.Error(err))
+		return err
+	}
+	if loaded {
+		return merr.WrapErrParameterInvalidMsg("collection %d is loaded, release it before archiving its index", collectionID)
+	}
+	segIdxes := ia.finishedSegmentIndexes(collectionID)
+
+	buildIDs := make([]UniqueID, 0, len(segIdxes))
+	filePaths := make([]string, 0)
+	for _, segIdx := range segIdxes {
+		switch segIdx.ArchiveState {
+		case indexpb.IndexArchiveState_Archived:
+			continue
+		case indexpb.IndexArchiveState_Restoring:
+			return merr.WrapErrServiceInternal(fmt.Sprintf("index of segment %d is being restored", segIdx.SegmentID),
+				"wait for the restore to finish before archiving")
+		}
+		buildIDs = append(buildIDs, segIdx.BuildID)
+		filePaths = append(filePaths, ia.archiveFilePaths(segIdx)...)
+	}
+	if len(buildIDs) == 0 {
+		log.Info("no index to archive")
+		return nil
+	}
+
+	if err := ia.chunkManager.Archive(ctx, filePaths); err != nil {
+		log.Warn("failed to archive index files", zap.Error(err))
+		return err
+	}
+	if err := ia.meta.UpdateSegmentIndexArchiveState(buildIDs, indexpb.IndexArchiveState_Archived); err != nil {
+		return err
+	}
+	log.Info("archive index files done", zap.Int("segmentIndexNum", len(buildIDs)), zap.Int("fileNum", len(filePaths)))
+	return nil
+}
+
+// restore marks the archived segment indexes as restoring, the files are restored asynchronously
+// by the schedule loop.
+func (ia *indexArchiver) restore(segIdxes []*model.SegmentIndex) error {
+	buildIDs := make([]UniqueID, 0, len(segIdxes))
+	for _, segIdx := range segIdxes {
+		if segIdx.ArchiveState == indexpb.IndexArchiveState_Archived {
+			buildIDs = append(buildIDs, segIdx.BuildID)
+		}
+	}
+	if len(buildIDs) == 0 {
+		return nil
+	}
+	if err := ia.meta.UpdateSegmentIndexArchiveState(buildIDs, indexpb.IndexArchiveState_Restoring); err != nil {
+		return err
+	}
+	ia.notify()
+	return nil
+}
+
+// restoreCollection restores all the archived segment indexes of the collection.
+func (ia *indexArchiver) restoreCollection(collectionID UniqueID) error {
+	return ia.restore(ia.finishedSegmentIndexes(collectionID))
+}
+
+// archiveState returns the archive state of the collection's index, with the number of the segment
+// indexes still archived and being restored.
+func (ia *indexArchiver) archiveState(collectionID UniqueID) (indexpb.IndexArchiveState, int64, int64) {
+	var archived, restoring int64
+	for _, segIdx := range ia.finishedSegmentIndexes(collectionID) {
+		switch segIdx.ArchiveState {
+		case indexpb.IndexArchiveState_Archived:
+			archived++
+		case indexpb.IndexArchiveState_Restoring:
+			restoring++
+		}
+	}
+	switch {
+	case restoring > 0:
+		return indexpb.IndexArchiveState_Restoring, archived, restoring
+	case archived > 0:
+		return indexpb.IndexArchiveState_Archived, archived, restoring
+	default:
+		return indexpb.IndexArchiveState_NotArchived, archived, restoring
+	}
+}
+
+// checkRestoring checks the segment indexes being restored, and marks them as not archived once
+// all of their files are back in the standard storage class.
+func (ia *indexArchiver) checkRestoring() {
+	restored := make([]UniqueID, 0)
+	for _, segIdx := range ia.meta.GetAllSegIndexes() {
+		if segIdx.IsDeleted || segIdx.ArchiveState != indexpb.IndexArchiveState_Restoring {
+			continue
+		}
+		log := log.Ctx(ia.ctx).With(zap.Int64("collectionID", segIdx.CollectionID),
+			zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("buildID", segIdx.BuildID))
+		filePaths := ia.archiveFilePaths(segIdx)
+		states, err := ia.chunkManager.ArchiveStates(ia.ctx, filePaths)
+		if err != nil {
+			log.Warn("failed to get archive states of index files", zap.Error(err))
+			continue
+		}
+		done := true
+		for _, state := range states {
+			if state != storage.ArchiveStateStandard {
+				done = false
+				break
+			}
+		}
+		if done {
+			restored = append(restored, segIdx.BuildID)
+			continue
+		}
+		// Restore requests the restore of the archived files, and moves the restored copies
+		// back to the standard storage class.
+		if err := ia.chunkManager.Restore(ia.ctx, filePaths); err != nil {
+			log.Warn("failed to restore index files", zap.Error(err))
+		}
+	}
+	if len(restored) == 0 {
+		return
+	}
+	if err := ia.meta.UpdateSegmentIndexArchiveState(restored, indexpb.IndexArchiveState_NotArchived); err != nil {
+		log.Warn("failed to mark segment indexes as restored", zap.Int64s("buildIDs", restored), zap.Error(err))
+		return
+	}
+	log.Info("index files restored", zap.Int64s("buildIDs", restored))
+}
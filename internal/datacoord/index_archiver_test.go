@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	mockkv "github.com/milvus-io/milvus/internal/kv/mocks"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newArchiverTestMeta(t *testing.T, segIdxes ...*model.SegmentIndex) *meta {
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil).Maybe()
+	m := &meta{
+		catalog:              catalog,
+		segments:             NewSegmentsInfo(),
+		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+	}
+	for _, segIdx := range segIdxes {
+		m.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	}
+	return m
+}
+
+func newArchiverTestSegmentIndex(buildID UniqueID, state indexpb.IndexArchiveState) *model.SegmentIndex {
+	return &model.SegmentIndex{
+		SegmentID:     segID + buildID,
+		CollectionID:  collID,
+		PartitionID:   partID,
+		IndexID:       indexID,
+		BuildID:       buildID,
+		IndexVersion:  1,
+		IndexState:    commonpb.IndexState_Finished,
+		IndexFileKeys: []string{"IVF", "ab/cd/index_file"},
+		ArchiveState:  state,
+	}
+}
+
+// newReleasedMetaKV returns the meta kv without the load info of any collection.
+func newReleasedMetaKV(t *testing.T) *mockkv.MetaKv {
+	metaKV := mockkv.NewMetaKv(t)
+	metaKV.EXPECT().Has(mock.Anything).Return(false, nil).Maybe()
+	metaKV.EXPECT().HasPrefix(mock.Anything).Return(false, nil).Maybe()
+	return metaKV
+}
+
+func TestIndexArchiver_Archive(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("archive", func(t *testing.T) {
+		segIdx := newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_NotArchived)
+		archived := newArchiverTestSegmentIndex(buildID+1, indexpb.IndexArchiveState_Archived)
+		m := newArchiverTestMeta(t, segIdx, archived)
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("files")
+		cm.EXPECT().Archive(mock.Anything, mock.Anything).Run(func(ctx context.Context, filePaths []string) {
+			// the content-addressed file is kept in the standard storage class
+			assert.Equal(t, []string{metautil.BuildSegmentIndexFilePath("files", buildID, 1, partID, segIdx.SegmentID, "IVF")}, filePaths)
+		}).Return(nil)
+		ia := newIndexArchiver(ctx, m, cm, newReleasedMetaKV(t))
+
+		err := ia.archive(ctx, collID)
+		assert.NoError(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_Archived, m.buildID2SegmentIndex[buildID].ArchiveState)
+
+		state, archivedNum, restoringNum := ia.archiveState(collID)
+		assert.Equal(t, indexpb.IndexArchiveState_Archived, state)
+		assert.EqualValues(t, 2, archivedNum)
+		assert.EqualValues(t, 0, restoringNum)
+	})
+
+	t.Run("loaded", func(t *testing.T) {
+		m := newArchiverTestMeta(t, newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_NotArchived))
+		metaKV := mockkv.NewMetaKv(t)
+		metaKV.EXPECT().Has(mock.Anything).Return(false, nil)
+		metaKV.EXPECT().HasPrefix(mock.Anything).Return(true, nil)
+		ia := newIndexArchiver(ctx, m, mocks.NewChunkManager(t), metaKV)
+		err := ia.archive(ctx, collID)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		metaKV = mockkv.NewMetaKv(t)
+		metaKV.EXPECT().Has(mock.Anything).Return(false, errors.New("mock error"))
+		ia = newIndexArchiver(ctx, m, mocks.NewChunkManager(t), metaKV)
+		err = ia.archive(ctx, collID)
+		assert.Error(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_NotArchived, m.buildID2SegmentIndex[buildID].ArchiveState)
+	})
+
+	t.Run("nothing to archive", func(t *testing.T) {
+		m := newArchiverTestMeta(t, newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_Archived))
+		ia := newIndexArchiver(ctx, m, mocks.NewChunkManager(t), newReleasedMetaKV(t))
+		err := ia.archive(ctx, collID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("restoring", func(t *testing.T) {
+		m := newArchiverTestMeta(t, newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_Restoring))
+		ia := newIndexArchiver(ctx, m, mocks.NewChunkManager(t), newReleasedMetaKV(t))
+		err := ia.archive(ctx, collID)
+		assert.Error(t, err)
+	})
+
+	t.Run("archive failed", func(t *testing.T) {
+		m := newArchiverTestMeta(t, newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_NotArchived))
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("files")
+		cm.EXPECT().Archive(mock.Anything, mock.Anything).Return(errors.New("mock error"))
+		ia := newIndexArchiver(ctx, m, cm, newReleasedMetaKV(t))
+
+		err := ia.archive(ctx, collID)
+		assert.Error(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_NotArchived, m.buildID2SegmentIndex[buildID].ArchiveState)
+	})
+}
+
+func TestIndexArchiver_Restore(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	m := newArchiverTestMeta(t,
+		newArchiverTestSegmentIndex(buildID, indexpb.IndexArchiveState_Archived),
+		newArchiverTestSegmentIndex(buildID+1, indexpb.IndexArchiveState_Archived),
+	)
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("files")
+	ia := newIndexArchiver(ctx, m, cm, newReleasedMetaKV(t))
+
+	err := ia.restoreCollection(collID)
+	assert.NoError(t, err)
+	state, archived, restoring := ia.archiveState(collID)
+	assert.Equal(t, indexpb.IndexArchiveState_Restoring, state)
+	assert.EqualValues(t, 0, archived)
+	assert.EqualValues(t, 2, restoring)
+
+	restoredPath := metautil.BuildSegmentIndexFilePath("files", buildID, 1, partID, segID+buildID, "IVF")
+	cm.EXPECT().ArchiveStates(mock.Anything, mock.Anything).Call.Return(func(ctx context.Context, filePaths []string) []storage.ArchiveState {
+		if filePaths[0] == restoredPath {
+			return []storage.ArchiveState{storage.ArchiveStateStandard}
+		}
+		return []storage.ArchiveState{storage.ArchiveStateRestoring}
+	}, nil)
+	cm.EXPECT().Restore(mock.Anything, mock.Anything).Return(nil).Once()
+	ia.checkRestoring()
+	assert.Equal(t, indexpb.IndexArchiveState_NotArchived, m.buildID2SegmentIndex[buildID].ArchiveState)
+	assert.Equal(t, indexpb.IndexArchiveState_Restoring, m.buildID2SegmentIndex[buildID+1].ArchiveState)
+}
+
+func TestServer_ArchiveIndex(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	segIdx := &model.SegmentIndex{
+		SegmentID:     segID,
+		CollectionID:  collID,
+		PartitionID:   partID,
+		IndexID:       indexID,
+		BuildID:       buildID,
+		IndexVersion:  1,
+		IndexState:    commonpb.IndexState_Finished,
+		IndexFileKeys: []string{"IVF"},
+	}
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil)
+	m := &meta{
+		catalog:              catalog,
+		segments:             NewSegmentsInfo(),
+		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+	}
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("files")
+	cm.EXPECT().Archive(mock.Anything, mock.Anything).Return(nil)
+	s := &Server{
+		meta:          m,
+		indexArchiver: newIndexArchiver(ctx, m, cm, newReleasedMetaKV(t)),
+	}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.ArchiveIndex(ctx, &indexpb.ArchiveIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+
+		status, err = s.RestoreIndex(ctx, &indexpb.RestoreIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+
+		resp, err := s.GetIndexArchiveState(ctx, &indexpb.GetIndexArchiveStateRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	t.Run("archive and restore", func(t *testing.T) {
+		status, err := s.ArchiveIndex(ctx, &indexpb.ArchiveIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		resp, err := s.GetIndexArchiveState(ctx, &indexpb.GetIndexArchiveStateRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, indexpb.IndexArchiveState_Archived, resp.GetState())
+		assert.EqualValues(t, 1, resp.GetArchivedSegments())
+
+		status, err = s.RestoreIndex(ctx, &indexpb.RestoreIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		resp, err = s.GetIndexArchiveState(ctx, &indexpb.GetIndexArchiveStateRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_Restoring, resp.GetState())
+		assert.EqualValues(t, 1, resp.GetRestoringSegments())
+	})
+
+	t.Run("archive while restoring", func(t *testing.T) {
+		status, err := s.ArchiveIndex(ctx, &indexpb.ArchiveIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+}
@@ -26,11 +26,14 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type indexTaskState int32
@@ -80,9 +83,13 @@ type indexBuilder struct {
 	policy       buildIndexPolicy
 	nodeManager  *IndexNodeManager
 	chunkManager storage.ChunkManager
+
+	indexEngineVersionManager *IndexEngineVersionManager
 }
 
-func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNodeManager, chunkManager storage.ChunkManager) *indexBuilder {
+func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNodeManager, chunkManager storage.ChunkManager,
+	indexEngineVersionManager *IndexEngineVersionManager,
+) *indexBuilder {
 	ctx, cancel := context.WithCancel(ctx)
 
 	ib := &indexBuilder{
@@ -95,14 +102,20 @@ func newIndexBuilder(ctx context.Context, metaTable *meta, nodeManager *IndexNod
 		policy:           defaultBuildIndexPolicy,
 		nodeManager:      nodeManager,
 		chunkManager:     chunkManager,
+
+		indexEngineVersionManager: indexEngineVersionManager,
 	}
 	ib.reloadFromKV()
 	return ib
 }
 
 func (ib *indexBuilder) Start() {
-	ib.wg.Add(1)
+	ib.wg.Add(2)
 	go ib.schedule()
+	go func() {
+		defer ib.wg.Done()
+		ib.reconcileFinishedTasks()
+	}()
 }
 
 func (ib *indexBuilder) Stop() {
@@ -126,6 +139,39 @@ func (ib *indexBuilder) reloadFromKV() {
 	}
 }
 
+// reconcileFinishedTasks verifies the index files declared by the upload manifests of the finished indexes, the
+// indexes with missing files are built again. The directory of each build is listed once, rather than checking
+// every index file at startup.
+func (ib *indexBuilder) reconcileFinishedTasks() {
+	reset := 0
+	for buildID, segIdx := range ib.meta.GetAllSegIndexes() {
+		if ib.ctx.Err() != nil {
+			return
+		}
+		if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished || len(segIdx.IndexFileKeys) == 0 {
+			continue
+		}
+		manifest, missing, err := storage.CheckIndexUploadManifest(ib.ctx, ib.chunkManager, buildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID)
+		if err != nil {
+			log.Ctx(ib.ctx).Warn("failed to check the upload manifest of index", zap.Int64("buildID", buildID), zap.Error(err))
+			continue
+		}
+		if manifest == nil || len(missing) == 0 {
+			continue
+		}
+		log.Ctx(ib.ctx).Warn("index files declared by the upload manifest are missing, build the index again",
+			zap.Int64("buildID", buildID), zap.Int64("segmentID", segIdx.SegmentID), zap.Strings("missing", missing))
+		if err := ib.meta.ResetTask(buildID); err != nil {
+			log.Ctx(ib.ctx).Warn("failed to reset the index task", zap.Int64("buildID", buildID), zap.Error(err))
+			continue
+		}
+		ib.enqueue(buildID)
+		reset++
+	}
+	log.Ctx(ib.ctx).Info("reconcile finished index tasks done", zap.Int("reset", reset))
+}
+
 // notify is an unblocked notify function
 func (ib *indexBuilder) notify() {
 	select {
@@ -190,6 +236,51 @@ func (ib *indexBuilder) run() {
 	}
 }
 
+// getBuildPriority returns the priority of the index builds of the collection set by its properties.
+func (ib *indexBuilder) getBuildPriority(collectionID UniqueID) int64 {
+	collection := ib.meta.GetCollection(collectionID)
+	if collection == nil {
+		return 0
+	}
+	priority, err := getCollectionIndexBuildPriority(collection.Properties)
+	if err != nil {
+		log.Ctx(ib.ctx).Warn("invalid index build priority of collection, use the default priority",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0
+	}
+	return priority
+}
+
+// getPrerequisiteBuilds returns the builds of the vector indexes on the same segment in progress on the node if
+// segIdx is a scalar index build, so that the scalar index is built after them and reuses the binlogs cached by them.
+func (ib *indexBuilder) getPrerequisiteBuilds(segIdx *model.SegmentIndex, nodeID UniqueID) []UniqueID {
+	collection := ib.meta.GetCollection(segIdx.CollectionID)
+	if collection == nil {
+		return nil
+	}
+	isVectorField := func(indexID UniqueID) bool {
+		fieldID := ib.meta.GetFieldIDByIndexID(segIdx.CollectionID, indexID)
+		for _, field := range collection.Schema.GetFields() {
+			if field.GetFieldID() == fieldID {
+				return typeutil.IsVectorType(field.GetDataType())
+			}
+		}
+		return false
+	}
+	if isVectorField(segIdx.IndexID) {
+		return nil
+	}
+	prerequisites := make([]UniqueID, 0)
+	for _, other := range ib.meta.GetSegmentIndexes(segIdx.SegmentID) {
+		if other.BuildID == segIdx.BuildID || other.NodeID != nodeID ||
+			other.IndexState != commonpb.IndexState_InProgress || !isVectorField(other.IndexID) {
+			continue
+		}
+		prerequisites = append(prerequisites, other.BuildID)
+	}
+	return prerequisites
+}
+
 func (ib *indexBuilder) process(buildID UniqueID) bool {
 	ib.taskMutex.RLock()
 	state := ib.tasks[buildID]
@@ -245,7 +336,12 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		}
 		// peek client
 		// if all IndexNodes are executing task, wait for one of them to finish the task.
-		nodeID, client := ib.nodeManager.PeekClient(meta)
+		nodeID, client, err := ib.nodeManager.PeekClient(meta, getIndexType(indexParams))
+		if err != nil {
+			log.Ctx(ib.ctx).WithRateGroup("dc.indexBuilder", 1, 60).RatedWarn(5, "index builder peek client error",
+				zap.Int64("buildID", buildID), zap.String("indexType", getIndexType(indexParams)), zap.Error(err))
+			return false
+		}
 		if client == nil {
 			log.Ctx(ib.ctx).WithRateGroup("dc.indexBuilder", 1, 60).RatedInfo(5, "index builder peek client error, there is no available")
 			return false
@@ -270,14 +366,26 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		typeParams := ib.meta.GetTypeParams(meta.CollectionID, meta.IndexID)
 
 		var storageConfig *indexpb.StorageConfig
-		if Params.CommonCfg.StorageType.GetValue() == "local" {
+		switch Params.CommonCfg.StorageType.GetValue() {
+		case "local":
 			storageConfig = &indexpb.StorageConfig{
 				RootPath:    Params.LocalStorageCfg.Path.GetValue(),
 				StorageType: Params.CommonCfg.StorageType.GetValue(),
 			}
-		} else {
+		case "hdfs":
 			storageConfig = &indexpb.StorageConfig{
-				Address:         Params.MinioCfg.Address.GetValue(),
+				Address:     Params.HdfsCfg.Address.GetValue(),
+				UseSSL:      Params.HdfsCfg.UseSSL.GetAsBool(),
+				RootPath:    Params.HdfsCfg.RootPath.GetValue(),
+				StorageType: Params.CommonCfg.StorageType.GetValue(),
+			}
+		default:
+			address, requesterPays := Params.MinioCfg.Address.GetValue(), Params.MinioCfg.RequesterPays.GetAsBool()
+			if cs := ib.meta.GetCollectionStorage(meta.CollectionID); cs != nil {
+				address, requesterPays = cs.Endpoint, cs.RequesterPays
+			}
+			storageConfig = &indexpb.StorageConfig{
+				Address:         address,
 				AccessKeyID:     Params.MinioCfg.AccessKeyID.GetValue(),
 				SecretAccessKey: Params.MinioCfg.SecretAccessKey.GetValue(),
 				UseSSL:          Params.MinioCfg.UseSSL.GetAsBool(),
@@ -289,18 +397,30 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 				Region:          Params.MinioCfg.Region.GetValue(),
 				UseVirtualHost:  Params.MinioCfg.UseVirtualHost.GetAsBool(),
 				CloudProvider:   Params.MinioCfg.CloudProvider.GetValue(),
+				SseType:         Params.MinioCfg.SSEType.GetValue(),
+				SseKey:          Params.MinioCfg.SSEKey.GetValue(),
+				RequesterPays:   requesterPays,
+				// the dual-stack and FIPS endpoints are resolved from the region by indexnode
+				UseDualStackEndpoint: Params.MinioCfg.UseDualStackEndpoint.GetAsBool(),
+				UseFipsEndpoint:      Params.MinioCfg.UseFIPSEndpoint.GetAsBool(),
+				CustomHeaders:        funcutil.Map2KeyValuePair(Params.MinioCfg.CustomHeaders.GetAsJSONMap()),
 			}
 		}
 		req := &indexpb.CreateJobRequest{
 			ClusterID:       Params.CommonCfg.ClusterPrefix.GetValue(),
 			IndexFilePrefix: path.Join(ib.chunkManager.RootPath(), common.SegmentIndexPath),
 			BuildID:         buildID,
+			JobType:         indexpb.JobType_JobTypeIndexJob,
 			DataPaths:       binLogs,
 			IndexVersion:    meta.IndexVersion + 1,
 			StorageConfig:   storageConfig,
 			IndexParams:     indexParams,
 			TypeParams:      typeParams,
 			NumRows:         meta.NumRows,
+
+			ReaderIndexVersion: ib.indexEngineVersionManager.GetReaderIndexVersion(),
+			DependsOn:          ib.getPrerequisiteBuilds(meta, nodeID),
+			Priority:           ib.getBuildPriority(meta.CollectionID),
 		}
 		if err := ib.assignTask(client, req); err != nil {
 			// need to release lock then reassign, so set task state to retry
@@ -362,6 +482,11 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 		// indexInfos length is always one.
 		for _, info := range response.GetIndexInfos() {
 			if info.GetBuildID() == buildID {
+				if info.GetJobType() != indexpb.JobType_JobTypeIndexJob {
+					log.Ctx(ib.ctx).Warn("this task should be retry, the job on IndexNode is not an index job",
+						zap.Int64("buildID", buildID), zap.Int64("nodeID", nodeID), zap.String("jobType", info.GetJobType().String()))
+					return indexTaskRetry
+				}
 				if info.GetState() == commonpb.IndexState_Failed || info.GetState() == commonpb.IndexState_Finished {
 					log.Ctx(ib.ctx).Info("this task has been finished", zap.Int64("buildID", info.GetBuildID()),
 						zap.String("index state", info.GetState().String()))
@@ -24,8 +24,10 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/metastore"
 	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
@@ -33,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -625,7 +628,7 @@ func TestIndexBuilder(t *testing.T) {
 	chunkManager := &mocks.ChunkManager{}
 	chunkManager.EXPECT().RootPath().Return("root")
 
-	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager)
+	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newIndexEngineVersionManager())
 
 	assert.Equal(t, 6, len(ib.tasks))
 	assert.Equal(t, indexTaskInit, ib.tasks[buildID])
@@ -1018,6 +1021,37 @@ func TestIndexBuilder_Error(t *testing.T) {
 		assert.Equal(t, indexTaskRetry, state)
 	})
 
+	t.Run("indexNode has a stats job of the build id", func(t *testing.T) {
+		ib.meta.buildID2SegmentIndex[buildID].NodeID = nodeID
+		ib.meta.catalog = sc
+		ib.nodeManager = &IndexNodeManager{
+			ctx: context.Background(),
+			nodeClients: map[UniqueID]types.IndexNode{
+				nodeID: &indexnode.Mock{
+					CallQueryJobs: func(ctx context.Context, in *indexpb.QueryJobsRequest) (*indexpb.QueryJobsResponse, error) {
+						return &indexpb.QueryJobsResponse{
+							Status: merr.Status(nil),
+							IndexInfos: []*indexpb.IndexTaskInfo{
+								{
+									BuildID: buildID,
+									JobType: indexpb.JobType_JobTypeStatsJob,
+									State:   commonpb.IndexState_Finished,
+								},
+							},
+						}, nil
+					},
+				},
+			},
+		}
+
+		ib.tasks[buildID] = indexTaskInProgress
+		ib.process(buildID)
+
+		state, ok := ib.tasks[buildID]
+		assert.True(t, ok)
+		assert.Equal(t, indexTaskRetry, state)
+	})
+
 	t.Run("node not exist", func(t *testing.T) {
 		ib.meta.buildID2SegmentIndex[buildID].NodeID = nodeID
 		ib.meta.catalog = sc
@@ -1034,3 +1068,120 @@ func TestIndexBuilder_Error(t *testing.T) {
 		assert.Equal(t, indexTaskRetry, state)
 	})
 }
+
+func TestIndexBuilder_ReconcileFinishedTasks(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes",
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	rootPath := t.TempDir()
+	chunkManager := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+	ib := &indexBuilder{
+		ctx:          ctx,
+		tasks:        make(map[int64]indexTaskState),
+		notifyChan:   make(chan struct{}, 1),
+		meta:         createMetaTable(catalog),
+		chunkManager: chunkManager,
+	}
+
+	// buildID+4 lost one of its index files, buildID+5 is intact
+	for _, id := range []UniqueID{buildID + 4, buildID + 5} {
+		fileKeys := []string{"HNSW_0", "HNSW_1"}
+		require.NoError(t, ib.meta.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:       id,
+			State:         commonpb.IndexState_Finished,
+			IndexFileKeys: fileKeys,
+		}))
+		segIdx, ok := ib.meta.GetIndexJob(id)
+		require.True(t, ok)
+		manifest := &storage.IndexUploadManifest{
+			BuildID:      id,
+			IndexVersion: segIdx.IndexVersion,
+			PartitionID:  segIdx.PartitionID,
+			SegmentID:    segIdx.SegmentID,
+			FileKeys:     fileKeys,
+		}
+		for _, filePath := range manifest.FilePaths(rootPath) {
+			require.NoError(t, chunkManager.Write(ctx, filePath, []byte("index")))
+		}
+		require.NoError(t, storage.WriteIndexUploadManifest(ctx, chunkManager, manifest))
+		if id == buildID+4 {
+			require.NoError(t, chunkManager.Remove(ctx, manifest.FilePaths(rootPath)[1]))
+		}
+	}
+
+	ib.reconcileFinishedTasks()
+
+	segIdx, ok := ib.meta.GetIndexJob(buildID + 4)
+	require.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+	assert.Empty(t, segIdx.IndexFileKeys)
+	assert.Equal(t, indexTaskInit, ib.tasks[buildID+4])
+
+	segIdx, ok = ib.meta.GetIndexJob(buildID + 5)
+	require.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+	_, ok = ib.tasks[buildID+5]
+	assert.False(t, ok)
+}
+
+func TestIndexBuilder_getPrerequisiteBuilds(t *testing.T) {
+	segIdx := func(indexID, buildID, nodeID UniqueID, state commonpb.IndexState) *model.SegmentIndex {
+		return &model.SegmentIndex{
+			SegmentID:    segID,
+			CollectionID: collID,
+			PartitionID:  partID,
+			IndexID:      indexID,
+			BuildID:      buildID,
+			NodeID:       nodeID,
+			IndexState:   state,
+		}
+	}
+	ib := &indexBuilder{
+		meta: &meta{
+			collections: map[UniqueID]*collectionInfo{
+				collID: {
+					ID: collID,
+					Schema: &schemapb.CollectionSchema{
+						Fields: []*schemapb.FieldSchema{
+							{FieldID: fieldID, DataType: schemapb.DataType_FloatVector},
+							{FieldID: fieldID + 1, DataType: schemapb.DataType_Int64},
+							{FieldID: fieldID + 2, DataType: schemapb.DataType_BinaryVector},
+						},
+					},
+				},
+			},
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				collID: {
+					indexID:     {CollectionID: collID, FieldID: fieldID, IndexID: indexID},
+					indexID + 1: {CollectionID: collID, FieldID: fieldID + 1, IndexID: indexID + 1},
+					indexID + 2: {CollectionID: collID, FieldID: fieldID + 2, IndexID: indexID + 2},
+				},
+			},
+			segments: &SegmentsInfo{
+				segments: map[UniqueID]*SegmentInfo{
+					segID: {
+						SegmentInfo: &datapb.SegmentInfo{ID: segID, CollectionID: collID, PartitionID: partID},
+						segmentIndexes: map[UniqueID]*model.SegmentIndex{
+							indexID:     segIdx(indexID, buildID, nodeID, commonpb.IndexState_InProgress),
+							indexID + 1: segIdx(indexID+1, buildID+1, nodeID, commonpb.IndexState_Unissued),
+							// on another node
+							indexID + 2: segIdx(indexID+2, buildID+2, nodeID+1, commonpb.IndexState_InProgress),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// the scalar index waits for the vector index on the same node
+	assert.Equal(t, []UniqueID{buildID},
+		ib.getPrerequisiteBuilds(segIdx(indexID+1, buildID+1, nodeID, commonpb.IndexState_Unissued), nodeID))
+	assert.Empty(t, ib.getPrerequisiteBuilds(segIdx(indexID+1, buildID+1, nodeID+2, commonpb.IndexState_Unissued), nodeID+2))
+	// the vector indexes never wait
+	assert.Empty(t, ib.getPrerequisiteBuilds(segIdx(indexID+2, buildID+2, nodeID, commonpb.IndexState_Unissued), nodeID))
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// IndexEngineVersionManager tracks the index file versions the query nodes are able to load,
+// so that index nodes do not write index files the query fleet cannot read during a rolling upgrade.
+type IndexEngineVersionManager struct {
+	lock     sync.RWMutex
+	versions map[int64]sessionutil.IndexEngineVersion
+	// reader is the version negotiated with the known query nodes, which is kept once all of them are gone
+	reader int32
+}
+
+func newIndexEngineVersionManager() *IndexEngineVersionManager {
+	return &IndexEngineVersionManager{
+		versions: make(map[int64]sessionutil.IndexEngineVersion),
+	}
+}
+
+// Startup resets the known versions with the sessions of the query nodes.
+func (m *IndexEngineVersionManager) Startup(sessions map[string]*sessionutil.Session) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.versions = make(map[int64]sessionutil.IndexEngineVersion, len(sessions))
+	for _, session := range sessions {
+		m.versions[session.ServerID] = session.IndexEngineVersion
+	}
+	m.refresh()
+	log.Info("index engine version manager startup", zap.Int("queryNodeNum", len(m.versions)),
+		zap.Int32("readerIndexVersion", m.reader))
+}
+
+// AddNode records the index file versions of a query node.
+func (m *IndexEngineVersionManager) AddNode(session *sessionutil.Session) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.versions[session.ServerID] = session.IndexEngineVersion
+	m.refresh()
+	log.Info("add query node index engine version", zap.Int64("nodeID", session.ServerID),
+		zap.Int32("minimal", session.IndexEngineVersion.MinimalIndexVersion),
+		zap.Int32("current", session.IndexEngineVersion.CurrentIndexVersion))
+}
+
+// RemoveNode forgets the index file versions of a query node.
+func (m *IndexEngineVersionManager) RemoveNode(session *sessionutil.Session) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.versions, session.ServerID)
+	m.refresh()
+	log.Info("remove query node index engine version", zap.Int64("nodeID", session.ServerID))
+}
+
+// refresh negotiates the newest index file version every query node is able to load, query nodes not
+// reporting a version only load plain index files. Without any query node, e.g. all of them are restarting
+// during a rolling upgrade, the last negotiated version is kept, or plain index files are written if
+// no query node has been known, so that the query nodes joining later are able to load them.
+// The lock must be held by caller.
+func (m *IndexEngineVersionManager) refresh() {
+	if len(m.versions) == 0 {
+		if m.reader == 0 {
+			m.reader = common.IndexFileVersionPlain
+		}
+		log.Warn("no query node is known, keep the index file version", zap.Int32("readerIndexVersion", m.reader))
+		return
+	}
+	var version int32
+	for _, v := range m.versions {
+		current := v.CurrentIndexVersion
+		if current <= 0 {
+			current = common.IndexFileVersionPlain
+		}
+		if version == 0 || current < version {
+			version = current
+		}
+	}
+	m.reader = version
+}
+
+// GetReaderIndexVersion returns the newest index file version every query node is able to load.
+// It returns 0 if the versions of the query nodes are not tracked, then the index node writes its current version.
+func (m *IndexEngineVersionManager) GetReaderIndexVersion() int32 {
+	if m == nil {
+		return 0
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.reader
+}
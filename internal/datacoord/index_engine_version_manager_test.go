@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestIndexEngineVersionManager(t *testing.T) {
+	var nilManager *IndexEngineVersionManager
+	assert.Equal(t, int32(0), nilManager.GetReaderIndexVersion())
+
+	m := newIndexEngineVersionManager()
+	assert.Equal(t, int32(0), m.GetReaderIndexVersion())
+
+	// plain index files are written until a query node is known
+	m.Startup(map[string]*sessionutil.Session{})
+	assert.Equal(t, common.IndexFileVersionPlain, m.GetReaderIndexVersion())
+
+	m.Startup(map[string]*sessionutil.Session{
+		"1": {
+			ServerID: 1,
+			IndexEngineVersion: sessionutil.IndexEngineVersion{
+				MinimalIndexVersion: common.IndexFileVersionPlain,
+				CurrentIndexVersion: common.IndexFileVersionContentAddressed,
+			},
+		},
+	})
+	assert.Equal(t, common.IndexFileVersionContentAddressed, m.GetReaderIndexVersion())
+
+	// query node of an older release does not report its index engine version
+	m.AddNode(&sessionutil.Session{ServerID: 2})
+	assert.Equal(t, common.IndexFileVersionPlain, m.GetReaderIndexVersion())
+
+	m.RemoveNode(&sessionutil.Session{ServerID: 2})
+	assert.Equal(t, common.IndexFileVersionContentAddressed, m.GetReaderIndexVersion())
+
+	// the negotiated version is kept once all the query nodes are gone
+	m.RemoveNode(&sessionutil.Session{ServerID: 1})
+	assert.Equal(t, common.IndexFileVersionContentAddressed, m.GetReaderIndexVersion())
+}
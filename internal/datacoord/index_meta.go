@@ -545,6 +545,33 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 	return nil
 }
 
+// UpdateSegmentIndexArchiveState sets the archive state of the segment indexes with the given buildIDs.
+func (m *meta) UpdateSegmentIndexArchiveState(buildIDs []UniqueID, state indexpb.IndexArchiveState) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdxes := make([]*model.SegmentIndex, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		segIdx, ok := m.buildID2SegmentIndex[buildID]
+		if !ok {
+			log.Warn("there is no index with buildID", zap.Int64("buildID", buildID))
+			continue
+		}
+		cloned := model.CloneSegmentIndex(segIdx)
+		cloned.ArchiveState = state
+		segIdxes = append(segIdxes, cloned)
+	}
+	if len(segIdxes) == 0 {
+		return nil
+	}
+	if err := m.alterSegmentIndexes(segIdxes); err != nil {
+		return err
+	}
+	log.Info("update segment index archive state success", zap.Int64s("buildIDs", buildIDs),
+		zap.String("state", state.String()))
+	return nil
+}
+
 func (m *meta) DeleteTask(buildID int64) error {
 	m.Lock()
 	defer m.Unlock()
@@ -569,6 +596,33 @@ func (m *meta) DeleteTask(buildID int64) error {
 	return nil
 }
 
+// ResetTask sets the finished index back to Unissued to build it again, e.g. its index files are missing.
+func (m *meta) ResetTask(buildID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.IndexState = commonpb.IndexState_Unissued
+		segIdx.IndexFileKeys = nil
+		segIdx.IndexSize = 0
+		segIdx.FailReason = ""
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return err
+	}
+	log.Info("meta update: reset segment index success", zap.Int64("buildID", buildID),
+		zap.Int64("segmentID", segIdx.SegmentID))
+
+	m.updateIndexTasksMetrics()
+	return nil
+}
+
 // BuildIndex set the index state to be InProgress. It means IndexNode is building the index.
 func (m *meta) BuildIndex(buildID UniqueID) error {
 	m.Lock()
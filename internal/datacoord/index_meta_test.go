@@ -1263,6 +1263,33 @@ func TestUpdateSegmentIndexNotExists(t *testing.T) {
 	})
 }
 
+func TestMeta_UpdateSegmentIndexArchiveState(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+
+	t.Run("success", func(t *testing.T) {
+		err := m.UpdateSegmentIndexArchiveState([]UniqueID{buildID}, indexpb.IndexArchiveState_Archived)
+		assert.NoError(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_Archived, m.buildID2SegmentIndex[buildID].ArchiveState)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		err := m.UpdateSegmentIndexArchiveState([]UniqueID{buildID + 1}, indexpb.IndexArchiveState_Restoring)
+		assert.NoError(t, err)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		metakv := mockkv.NewMetaKv(t)
+		metakv.EXPECT().Save(mock.Anything, mock.Anything).Return(errors.New("failed")).Maybe()
+		metakv.EXPECT().MultiSave(mock.Anything).Return(errors.New("failed")).Maybe()
+		m.catalog = &datacoord.Catalog{
+			MetaKv: metakv,
+		}
+		err := m.UpdateSegmentIndexArchiveState([]UniqueID{buildID}, indexpb.IndexArchiveState_NotArchived)
+		assert.Error(t, err)
+		assert.Equal(t, indexpb.IndexArchiveState_Archived, m.buildID2SegmentIndex[buildID].ArchiveState)
+	})
+}
+
 func TestMeta_DeleteTask_Error(t *testing.T) {
 	m := &meta{buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex)}
 	t.Run("segment index not found", func(t *testing.T) {
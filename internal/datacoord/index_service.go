@@ -45,6 +45,7 @@ func (s *Server) serverID() int64 {
 
 func (s *Server) startIndexService(ctx context.Context) {
 	s.indexBuilder.Start()
+	s.indexArchiver.Start()
 
 	s.serverLoopWg.Add(1)
 	go s.createIndexForSegmentLoop(ctx)
@@ -713,6 +714,12 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 		Status:      merr.Status(nil),
 		SegmentInfo: map[int64]*indexpb.SegmentInfo{},
 	}
+	// the index files of the collection are loaded from its object storage
+	var storageEndpoint string
+	var storageRequesterPays bool
+	if cs := s.meta.GetCollectionStorage(req.GetCollectionID()); cs != nil {
+		storageEndpoint, storageRequesterPays = cs.Endpoint, cs.RequesterPays
+	}
 
 	for _, segID := range req.GetSegmentIDs() {
 		segIdxes := s.meta.GetSegmentIndexes(segID)
@@ -725,6 +732,7 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 		if len(segIdxes) != 0 {
 			ret.SegmentInfo[segID].EnableIndex = true
 			for _, segIdx := range segIdxes {
+				// the archived indexes are reported until dropped, they're restored by RestoreIndex before loaded
 				if segIdx.IndexState == commonpb.IndexState_Finished {
 					indexFilePaths := metautil.BuildSegmentIndexFilePaths(s.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
 						segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
@@ -732,16 +740,18 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 					indexParams = append(indexParams, s.meta.GetTypeParams(segIdx.CollectionID, segIdx.IndexID)...)
 					ret.SegmentInfo[segID].IndexInfos = append(ret.SegmentInfo[segID].IndexInfos,
 						&indexpb.IndexFilePathInfo{
-							SegmentID:      segID,
-							FieldID:        s.meta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID),
-							IndexID:        segIdx.IndexID,
-							BuildID:        segIdx.BuildID,
-							IndexName:      s.meta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
-							IndexParams:    indexParams,
-							IndexFilePaths: indexFilePaths,
-							SerializedSize: segIdx.IndexSize,
-							IndexVersion:   segIdx.IndexVersion,
-							NumRows:        segIdx.NumRows,
+							SegmentID:            segID,
+							FieldID:              s.meta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID),
+							IndexID:              segIdx.IndexID,
+							BuildID:              segIdx.BuildID,
+							IndexName:            s.meta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
+							IndexParams:          indexParams,
+							IndexFilePaths:       indexFilePaths,
+							SerializedSize:       segIdx.IndexSize,
+							IndexVersion:         segIdx.IndexVersion,
+							NumRows:              segIdx.NumRows,
+							StorageEndpoint:      storageEndpoint,
+							StorageRequesterPays: storageRequesterPays,
 						})
 				}
 			}
@@ -753,6 +763,67 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 	return ret, nil
 }
 
+// ArchiveIndex moves the index files of the collection to the archive storage class.
+// The collection must be released, and its index restored by RestoreIndex before it's loaded again.
+func (s *Server) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+	log.Info("receive ArchiveIndex request")
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return s.UnhealthyStatus(), nil
+	}
+
+	if err := s.indexArchiver.archive(ctx, req.GetCollectionID()); err != nil {
+		log.Warn("ArchiveIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// RestoreIndex starts restoring the archived index files of the collection, the progress could be
+// checked with GetIndexArchiveState.
+func (s *Server) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+	log.Info("receive RestoreIndex request")
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return s.UnhealthyStatus(), nil
+	}
+
+	if err := s.indexArchiver.restoreCollection(req.GetCollectionID()); err != nil {
+		log.Warn("RestoreIndex fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Status(nil), nil
+}
+
+// GetIndexArchiveState gets the archive state of the collection's index.
+func (s *Server) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+	if s.isClosed() {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()))
+		return &indexpb.GetIndexArchiveStateResponse{
+			Status: s.UnhealthyStatus(),
+		}, nil
+	}
+
+	state, archived, restoring := s.indexArchiver.archiveState(req.GetCollectionID())
+	log.Debug("GetIndexArchiveState successfully", zap.String("state", state.String()),
+		zap.Int64("archivedSegments", archived), zap.Int64("restoringSegments", restoring))
+	return &indexpb.GetIndexArchiveStateResponse{
+		Status:            merr.Status(nil),
+		State:             state,
+		ArchivedSegments:  archived,
+		RestoringSegments: restoring,
+	}, nil
+}
+
 func (s *Server) UnhealthyStatus() *commonpb.Status {
 	code := s.stateCode.Load().(commonpb.StateCode)
 	return merr.Status(merr.WrapErrServiceNotReady(code.String()))
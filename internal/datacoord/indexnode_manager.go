@@ -18,8 +18,10 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -27,14 +29,19 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 // IndexNodeManager is used to manage the client of IndexNode.
 type IndexNodeManager struct {
 	nodeClients      map[UniqueID]types.IndexNode
 	stoppingNodes    map[UniqueID]struct{}
+	capabilities     map[UniqueID]*indexpb.GetCapabilitiesResponse
+	healths          map[UniqueID]*sessionutil.NodeHealth
 	lock             sync.RWMutex
 	ctx              context.Context
 	indexNodeCreator indexNodeCreatorFunc
@@ -45,6 +52,8 @@ func NewNodeManager(ctx context.Context, indexNodeCreator indexNodeCreatorFunc)
 	return &IndexNodeManager{
 		nodeClients:      make(map[UniqueID]types.IndexNode),
 		stoppingNodes:    make(map[UniqueID]struct{}),
+		capabilities:     make(map[UniqueID]*indexpb.GetCapabilitiesResponse),
+		healths:          make(map[UniqueID]*sessionutil.NodeHealth),
 		lock:             sync.RWMutex{},
 		ctx:              ctx,
 		indexNodeCreator: indexNodeCreator,
@@ -57,6 +66,8 @@ func (nm *IndexNodeManager) setClient(nodeID UniqueID, client types.IndexNode) {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 	nm.nodeClients[nodeID] = client
+	// the node may be restarted with another version, its capabilities are fetched again
+	delete(nm.capabilities, nodeID)
 	metrics.IndexNodeNum.WithLabelValues().Set(float64(len(nm.nodeClients)))
 	log.Debug("IndexNode IndexNodeManager setClient success", zap.Int64("nodeID", nodeID), zap.Int("IndexNode num", len(nm.nodeClients)))
 }
@@ -68,6 +79,8 @@ func (nm *IndexNodeManager) RemoveNode(nodeID UniqueID) {
 	defer nm.lock.Unlock()
 	delete(nm.nodeClients, nodeID)
 	delete(nm.stoppingNodes, nodeID)
+	delete(nm.capabilities, nodeID)
+	delete(nm.healths, nodeID)
 	metrics.IndexNodeNum.WithLabelValues().Set(float64(len(nm.nodeClients)))
 }
 
@@ -76,6 +89,31 @@ func (nm *IndexNodeManager) StoppingNode(nodeID UniqueID) {
 	nm.lock.Lock()
 	defer nm.lock.Unlock()
 	nm.stoppingNodes[nodeID] = struct{}{}
+	// the session is changed, refresh the capabilities once the node is peeked again
+	delete(nm.capabilities, nodeID)
+}
+
+// UpdateHealth updates the health pushed by the IndexNode, the health is removed if it expires,
+// then the node falls back to be checked by GetJobStats only.
+func (nm *IndexNodeManager) UpdateHealth(event *sessionutil.NodeHealthEvent) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if nm.healths == nil {
+		nm.healths = make(map[UniqueID]*sessionutil.NodeHealth)
+	}
+	if event.Health == nil {
+		delete(nm.healths, event.ServerID)
+		return
+	}
+	nm.healths[event.ServerID] = event.Health
+}
+
+// pushedThrottled reports whether the node is throttled according to its latest pushed health.
+func (nm *IndexNodeManager) pushedThrottled(nodeID UniqueID) (*sessionutil.NodeHealth, bool) {
+	nm.lock.RLock()
+	defer nm.lock.RUnlock()
+	health, ok := nm.healths[nodeID]
+	return health, ok && health.Throttled
 }
 
 // AddNode adds the client of IndexNode.
@@ -96,12 +134,67 @@ func (nm *IndexNodeManager) AddNode(nodeID UniqueID, address string) error {
 	return nil
 }
 
-// PeekClient peeks the client with the least load.
-func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, types.IndexNode) {
+// getCapabilities returns the capabilities of the node, which are cached once fetched.
+// nil is returned if they're unknown, e.g. the node is of the versions without capabilities.
+func (nm *IndexNodeManager) getCapabilities(ctx context.Context, nodeID UniqueID, client types.IndexNode) *indexpb.GetCapabilitiesResponse {
+	nm.lock.RLock()
+	capabilities, ok := nm.capabilities[nodeID]
+	nm.lock.RUnlock()
+	if ok {
+		return capabilities
+	}
+
+	resp, err := client.GetCapabilities(ctx, &indexpb.GetCapabilitiesRequest{})
+	if err != nil {
+		log.Warn("get IndexNode capabilities failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return nil
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("get IndexNode capabilities failed", zap.Int64("nodeID", nodeID),
+			zap.String("reason", resp.GetStatus().GetReason()))
+		return nil
+	}
+	log.Info("get IndexNode capabilities", zap.Int64("nodeID", nodeID), zap.Strings("indexTypes", resp.GetIndexTypes()),
+		zap.Bool("enableDisk", resp.GetEnableDisk()), zap.Bool("gpuEnabled", resp.GetGpuEnabled()),
+		zap.String("simdType", resp.GetSimdType()), zap.String("buildVersion", resp.GetBuildVersion()))
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	if _, ok := nm.nodeClients[nodeID]; ok {
+		if nm.capabilities == nil {
+			nm.capabilities = make(map[UniqueID]*indexpb.GetCapabilitiesResponse)
+		}
+		nm.capabilities[nodeID] = resp
+	}
+	return resp
+}
+
+// supportIndexType reports whether the node is able to build the index of indexType, the nodes of unknown
+// capabilities are considered to support all the index types.
+func (nm *IndexNodeManager) supportIndexType(ctx context.Context, nodeID UniqueID, client types.IndexNode, indexType string) bool {
+	// only the vector index types are advertised
+	if _, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(indexType); err != nil {
+		return true
+	}
+	capabilities := nm.getCapabilities(ctx, nodeID, client)
+	if capabilities == nil {
+		return true
+	}
+	for _, supported := range capabilities.GetIndexTypes() {
+		if supported == indexType {
+			return true
+		}
+	}
+	return false
+}
+
+// PeekClient peeks the client with the least load among the ones able to build the index of indexType.
+// A nil client without error is returned if the capable nodes are all busy, the error tells the reason
+// if there is no IndexNode online or none of them is able to build the index.
+func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex, indexType string) (UniqueID, types.IndexNode, error) {
 	allClients := nm.GetAllClients()
 	if len(allClients) == 0 {
 		log.Error("there is no IndexNode online")
-		return -1, nil
+		return -1, nil, merr.WrapErrServiceUnavailable("there is no IndexNode online")
 	}
 
 	// Note: In order to quickly end other goroutines, an error is returned when the client is successfully selected
@@ -110,6 +203,7 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 		peekNodeID = UniqueID(0)
 		nodeMutex  = sync.Mutex{}
 		wg         = sync.WaitGroup{}
+		capable    = atomic.NewInt32(0)
 	)
 
 	for nodeID, client := range allClients {
@@ -118,6 +212,19 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			if !nm.supportIndexType(ctx, nodeID, client, indexType) {
+				log.RatedDebug(5, "IndexNode doesn't support index type", zap.Int64("nodeID", nodeID), zap.String("indexType", indexType))
+				return
+			}
+			capable.Inc()
+			if health, throttled := nm.pushedThrottled(nodeID); throttled {
+				log.RatedInfo(5, "IndexNode is throttled by pushed health", zap.Int64("nodeID", nodeID),
+					zap.Float64("score", health.Score),
+					zap.Float64("memoryWatermark", health.MemoryWatermark),
+					zap.Float64("diskWatermark", health.DiskWatermark),
+					zap.Int64("queueDepth", health.QueueDepth))
+				return
+			}
 			resp, err := client.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
 			if err != nil {
 				log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID), zap.Error(err))
@@ -128,6 +235,13 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 					zap.String("reason", resp.GetStatus().GetReason()))
 				return
 			}
+			if resp.GetThrottled() {
+				log.RatedInfo(5, "IndexNode is throttled", zap.Int64("nodeID", nodeID),
+					zap.Float64("memoryWatermark", resp.GetMemoryWatermark()),
+					zap.Float64("diskWatermark", resp.GetDiskWatermark()),
+					zap.Int64("queueWaitP99", resp.GetQueueWaitP99()))
+				return
+			}
 			if resp.GetTaskSlots() > 0 {
 				nodeMutex.Lock()
 				defer nodeMutex.Unlock()
@@ -145,11 +259,15 @@ func (nm *IndexNodeManager) PeekClient(meta *model.SegmentIndex) (UniqueID, type
 	cancel()
 	if peekNodeID != 0 {
 		log.Info("peek client success", zap.Int64("nodeID", peekNodeID))
-		return peekNodeID, allClients[peekNodeID]
+		return peekNodeID, allClients[peekNodeID], nil
+	}
+	if capable.Load() == 0 {
+		return 0, nil, merr.WrapErrServiceUnavailable(
+			fmt.Sprintf("none of the %d IndexNodes is able to build index type %s", len(allClients), indexType))
 	}
 
 	log.RatedDebug(5, "peek client fail")
-	return 0, nil
+	return 0, nil, nil
 }
 
 func (nm *IndexNodeManager) ClientSupportDisk() bool {
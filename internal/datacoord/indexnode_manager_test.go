@@ -29,12 +29,14 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestIndexNodeManager_AddNode(t *testing.T) {
 	nm := NewNodeManager(context.Background(), defaultIndexNodeCreatorFunc)
-	nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+	nodeID, client, err := nm.PeekClient(&model.SegmentIndex{}, "")
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
 	assert.Equal(t, int64(-1), nodeID)
 	assert.Nil(t, client)
 
@@ -139,10 +141,155 @@ func TestIndexNodeManager_PeekClient(t *testing.T) {
 			},
 		}
 
-		nodeID, client := nm.PeekClient(&model.SegmentIndex{})
+		nodeID, client, err := nm.PeekClient(&model.SegmentIndex{}, "")
+		assert.NoError(t, err)
 		assert.NotNil(t, client)
 		assert.Contains(t, []UniqueID{8, 9}, nodeID)
 	})
+
+	t.Run("throttled IndexNode", func(t *testing.T) {
+		nm := &IndexNodeManager{
+			ctx: context.TODO(),
+			nodeClients: map[UniqueID]types.IndexNode{
+				1: &indexnode.Mock{
+					CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+						return &indexpb.GetJobStatsResponse{
+							TaskSlots:       10,
+							MemoryWatermark: 0.95,
+							Throttled:       true,
+							Status:          merr.Status(nil),
+						}, nil
+					},
+				},
+				2: &indexnode.Mock{
+					CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+						return &indexpb.GetJobStatsResponse{
+							TaskSlots: 1,
+							Status:    merr.Status(nil),
+						}, nil
+					},
+				},
+			},
+		}
+
+		nodeID, client, err := nm.PeekClient(&model.SegmentIndex{}, "")
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+		assert.Equal(t, UniqueID(2), nodeID)
+	})
+
+	t.Run("throttled by pushed health", func(t *testing.T) {
+		nm := &IndexNodeManager{
+			ctx: context.TODO(),
+			nodeClients: map[UniqueID]types.IndexNode{
+				1: &indexnode.Mock{
+					CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+						return &indexpb.GetJobStatsResponse{
+							TaskSlots: 10,
+							Status:    merr.Status(nil),
+						}, nil
+					},
+				},
+				2: &indexnode.Mock{
+					CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+						return &indexpb.GetJobStatsResponse{
+							TaskSlots: 1,
+							Status:    merr.Status(nil),
+						}, nil
+					},
+				},
+			},
+		}
+		nm.UpdateHealth(&sessionutil.NodeHealthEvent{
+			ServerID: 1,
+			Health:   &sessionutil.NodeHealth{ServerID: 1, DiskWatermark: 1, Throttled: true},
+		})
+
+		nodeID, client, err := nm.PeekClient(&model.SegmentIndex{}, "")
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+		assert.Equal(t, UniqueID(2), nodeID)
+
+		// the expired health falls back to GetJobStats
+		nm.UpdateHealth(&sessionutil.NodeHealthEvent{ServerID: 1})
+		nm.nodeClients[2] = &indexnode.Mock{
+			CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+				return &indexpb.GetJobStatsResponse{
+					TaskSlots: 0,
+					Status:    merr.Status(nil),
+				}, nil
+			},
+		}
+		nodeID, client, err = nm.PeekClient(&model.SegmentIndex{}, "")
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+		assert.Equal(t, UniqueID(1), nodeID)
+	})
+}
+
+func TestIndexNodeManager_PeekClientByCapabilities(t *testing.T) {
+	newNode := func(indexTypes ...string) *indexnode.Mock {
+		node := &indexnode.Mock{
+			CallGetJobStats: func(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
+				return &indexpb.GetJobStatsResponse{
+					TaskSlots: 1,
+					Status:    merr.Status(nil),
+				}, nil
+			},
+		}
+		if indexTypes != nil {
+			node.CallGetCapabilities = func(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+				return &indexpb.GetCapabilitiesResponse{
+					Status:     merr.Status(nil),
+					IndexTypes: indexTypes,
+				}, nil
+			}
+		}
+		return node
+	}
+	nm := NewNodeManager(context.TODO(), defaultIndexNodeCreatorFunc)
+	nm.setClient(1, newNode("HNSW"))
+	nm.setClient(2, newNode("HNSW", "DISKANN"))
+
+	nodeID, client, err := nm.PeekClient(&model.SegmentIndex{}, "DISKANN")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(2), nodeID)
+	assert.Len(t, nm.capabilities, 2)
+
+	// the reason is returned if none of the nodes is able to build the index
+	nodeID, client, err = nm.PeekClient(&model.SegmentIndex{}, "GPU_IVF_FLAT")
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	assert.Contains(t, err.Error(), "GPU_IVF_FLAT")
+	assert.Nil(t, client)
+	assert.Equal(t, UniqueID(0), nodeID)
+
+	// the scalar indexes aren't advertised
+	_, client, err = nm.PeekClient(&model.SegmentIndex{}, "STL_SORT")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	// the capabilities are refreshed once the node registers again
+	nm.setClient(1, newNode("HNSW", "GPU_IVF_FLAT"))
+	assert.Len(t, nm.capabilities, 1)
+	nodeID, client, err = nm.PeekClient(&model.SegmentIndex{}, "GPU_IVF_FLAT")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, UniqueID(1), nodeID)
+
+	// and once its session is changed
+	nm.StoppingNode(1)
+	assert.Len(t, nm.capabilities, 1)
+
+	// the node of unknown capabilities supports all the index types
+	nm.setClient(3, newNode())
+	nodeID, client, err = nm.PeekClient(&model.SegmentIndex{}, "DISKANN")
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Contains(t, []UniqueID{2, 3}, nodeID)
+
+	nm.RemoveNode(2)
+	assert.NotContains(t, nm.capabilities, UniqueID(2))
 }
 
 func TestIndexNodeManager_ClientSupportDisk(t *testing.T) {
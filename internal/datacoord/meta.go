@@ -194,6 +194,37 @@ func (m *meta) GetCollection(collectionID UniqueID) *collectionInfo {
 	return collection
 }
 
+// GetCollectionStorage returns the object storage of the index files of the collection,
+// nil if the collection uses the global one or its storage properties are invalid
+func (m *meta) GetCollectionStorage(collectionID UniqueID) *storage.CollectionStorage {
+	collection := m.GetCollection(collectionID)
+	if collection == nil {
+		return nil
+	}
+	cs, err := storage.GetCollectionStorage(Params, collection.Properties)
+	if err != nil {
+		log.Warn("invalid storage properties of collection, use the global object storage",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	return cs
+}
+
+// GetCollectionStorages returns the distinct object storages of the collections not using the global one
+func (m *meta) GetCollectionStorages() []storage.CollectionStorage {
+	m.RLock()
+	defer m.RUnlock()
+	storages := make(map[storage.CollectionStorage]struct{})
+	for _, collection := range m.collections {
+		cs, err := storage.GetCollectionStorage(Params, collection.Properties)
+		if err != nil || cs == nil {
+			continue
+		}
+		storages[*cs] = struct{}{}
+	}
+	return lo.Keys(storages)
+}
+
 func (m *meta) GetClonedCollectionInfo(collectionID UniqueID) *collectionInfo {
 	m.RLock()
 	defer m.RUnlock()
@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -198,6 +199,30 @@ func (suite *MetaBasicSuite) TestCollection() {
 	suite.MetricsEqual(metrics.DataCoordNumCollections.WithLabelValues(), 1)
 }
 
+func (suite *MetaBasicSuite) TestCollectionStorage() {
+	meta := suite.meta
+
+	info := suite.getCollectionInfo(suite.partIDs...)
+	meta.AddCollection(info)
+	suite.Nil(meta.GetCollectionStorage(suite.collID))
+	suite.Nil(meta.GetCollectionStorage(suite.collID + 1))
+	suite.Empty(meta.GetCollectionStorages())
+
+	info = suite.getCollectionInfo(suite.partIDs...)
+	info.Properties = map[string]string{common.CollectionStorageEndpointKey: "s3.us-west-2.amazonaws.com"}
+	meta.AddCollection(info)
+	cs := meta.GetCollectionStorage(suite.collID)
+	suite.Require().NotNil(cs)
+	suite.Equal("s3.us-west-2.amazonaws.com", cs.Endpoint)
+	suite.Equal([]storage.CollectionStorage{*cs}, meta.GetCollectionStorages())
+
+	// the invalid properties fall back to the global object storage
+	info = suite.getCollectionInfo(suite.partIDs...)
+	info.Properties = map[string]string{common.CollectionStorageRequesterPaysKey: "bad_value"}
+	meta.AddCollection(info)
+	suite.Nil(meta.GetCollectionStorage(suite.collID))
+}
+
 func TestMeta(t *testing.T) {
 	suite.Run(t, new(MetaBasicSuite))
 	suite.Run(t, new(MetaReloadSuite))
@@ -18,8 +18,10 @@ package datacoord
 
 import (
 	"context"
+	"sort"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -27,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -105,6 +108,67 @@ func (s *Server) getSystemInfoMetrics(
 	return resp, nil
 }
 
+// getIndexOrphanFiles lists the orphan index files for the admins to reconcile
+func (s *Server) getIndexOrphanFiles(ctx context.Context) (*milvuspb.GetMetricsResponse, error) {
+	files, err := s.garbageCollector.listOrphanIndexFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("list orphan index files done", zap.Int("num", len(files)))
+	resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.IndexOrphanFilesInfos{Files: files})
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Status(nil),
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID()),
+	}, nil
+}
+
+// getIndexNodeSnapshots collects the goroutine dumps or heap profile summaries of the IndexNodes,
+// the nodes failing to take the snapshot are recorded with the error
+func (s *Server) getIndexNodeSnapshots(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	metricType, err := metricsinfo.ParseMetricType(req.GetRequest())
+	if err != nil {
+		return nil, err
+	}
+	indexNodes := s.indexNodeManager.GetAllClients()
+	nodeIDs := lo.Keys(indexNodes)
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	snapshots := metricsinfo.ProfileSnapshots{Snapshots: make([]metricsinfo.ProfileSnapshot, 0, len(nodeIDs))}
+	for _, nodeID := range nodeIDs {
+		snapshot := metricsinfo.ProfileSnapshot{
+			Name: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, nodeID),
+			Type: metricType,
+		}
+		resp, err := indexNodes[nodeID].GetMetrics(ctx, req)
+		if err == nil {
+			err = merr.Error(resp.GetStatus())
+		}
+		if err == nil {
+			err = metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &snapshot)
+		}
+		if err != nil {
+			log.Warn("fails to get the snapshot of IndexNode", zap.Int64("nodeID", nodeID),
+				zap.String("metricType", metricType), zap.Error(err))
+			snapshot.Error = err.Error()
+		}
+		snapshots.Snapshots = append(snapshots.Snapshots, snapshot)
+	}
+
+	resp, err := metricsinfo.MarshalComponentInfos(snapshots)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Status(nil),
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID()),
+	}, nil
+}
+
 // getDataCoordMetrics composes datacoord infos
 func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 	ret := metricsinfo.DataCoordInfos{
@@ -207,3 +207,41 @@ func TestGetIndexNodeMetrics(t *testing.T) {
 	assert.False(t, info.HasError)
 	assert.Equal(t, metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, 100), info.BaseComponentInfos.Name)
 }
+
+func TestGetIndexNodeSnapshots(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	ctx := context.Background()
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.GoroutineSnapshotMetrics)
+	assert.NoError(t, err)
+
+	svr.indexNodeManager = NewNodeManager(ctx, defaultIndexNodeCreatorFunc)
+	svr.indexNodeManager.setClient(2, &mockMetricIndexNodeClient{mock: func() (*milvuspb.GetMetricsResponse, error) {
+		return nil, errors.New("mock error")
+	}})
+	svr.indexNodeManager.setClient(1, &mockMetricIndexNodeClient{mock: func() (*milvuspb.GetMetricsResponse, error) {
+		resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.ProfileSnapshot{
+			Name:       metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, 1),
+			Type:       metricsinfo.GoroutineSnapshotMetrics,
+			Profile:    []byte("profile"),
+			Goroutines: 10,
+		})
+		return &milvuspb.GetMetricsResponse{Status: merr.Status(nil), Response: resp}, err
+	}})
+
+	resp, err := svr.GetMetrics(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+
+	var snapshots metricsinfo.ProfileSnapshots
+	assert.NoError(t, metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &snapshots))
+	assert.Len(t, snapshots.Snapshots, 2)
+	assert.Equal(t, metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, 1), snapshots.Snapshots[0].Name)
+	assert.Equal(t, []byte("profile"), snapshots.Snapshots[0].Profile)
+	assert.Equal(t, 10, snapshots.Snapshots[0].Goroutines)
+	assert.Empty(t, snapshots.Snapshots[0].Error)
+	assert.Equal(t, metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, 2), snapshots.Snapshots[1].Name)
+	assert.Equal(t, metricsinfo.GoroutineSnapshotMetrics, snapshots.Snapshots[1].Type)
+	assert.NotEmpty(t, snapshots.Snapshots[1].Error)
+}
@@ -134,6 +134,9 @@ type Server struct {
 	icSession *sessionutil.Session
 	dnEventCh <-chan *sessionutil.SessionEvent
 	inEventCh <-chan *sessionutil.SessionEvent
+	// inHealthCh receives the health pushed by the IndexNodes
+	inHealthCh <-chan *sessionutil.NodeHealthEvent
+	qnEventCh  <-chan *sessionutil.SessionEvent
 	// qcEventCh <-chan *sessionutil.SessionEvent
 
 	enableActiveStandBy bool
@@ -145,8 +148,10 @@ type Server struct {
 	// indexCoord             types.IndexCoord
 
 	// segReferManager  *SegmentReferenceManager
-	indexBuilder     *indexBuilder
-	indexNodeManager *IndexNodeManager
+	indexBuilder              *indexBuilder
+	indexArchiver             *indexArchiver
+	indexNodeManager          *IndexNodeManager
+	indexEngineVersionManager *IndexEngineVersionManager
 
 	// manage ways that data coord access other coord
 	broker Broker
@@ -217,6 +222,8 @@ func CreateServer(ctx context.Context, factory dependency.Factory, opts ...Optio
 		helper:                 defaultServerHelper(),
 		metricsCacheManager:    metricsinfo.NewMetricsCacheManager(),
 		enableActiveStandBy:    Params.DataCoordCfg.EnableActiveStandby.GetAsBool(),
+
+		indexEngineVersionManager: newIndexEngineVersionManager(),
 	}
 
 	for _, opt := range opts {
@@ -364,6 +371,7 @@ func (s *Server) initDataCoord() error {
 
 	s.initGarbageCollection(storageCli)
 	s.initIndexBuilder(storageCli)
+	s.initIndexArchiver(storageCli)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
@@ -471,6 +479,7 @@ func (s *Server) newChunkManagerFactory() (storage.ChunkManager, error) {
 func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 	s.garbageCollector = newGarbageCollector(s.meta, s.handler, GcOption{
 		cli:              cli,
+		collectionCli:    storage.NewChunkManagerFactoryWithParam(Params).NewCollectionChunkManager,
 		enabled:          Params.DataCoordCfg.EnableGarbageCollection.GetAsBool(),
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
@@ -523,6 +532,24 @@ func (s *Server) initServiceDiscovery() error {
 	}
 	s.inEventCh = s.session.WatchServices(typeutil.IndexNodeRole, inRevision+1, nil)
 
+	inHealths, inHealthRevision, err := s.session.GetNodeHealths(typeutil.IndexNodeRole)
+	if err != nil {
+		log.Warn("DataCoord get IndexNode healths failed", zap.Error(err))
+		return err
+	}
+	for nodeID, health := range inHealths {
+		s.indexNodeManager.UpdateHealth(&sessionutil.NodeHealthEvent{ServerID: nodeID, Health: health})
+	}
+	s.inHealthCh = s.session.WatchNodeHealth(typeutil.IndexNodeRole, inHealthRevision+1)
+
+	qnSessions, qnRevision, err := s.session.GetSessions(typeutil.QueryNodeRole)
+	if err != nil {
+		log.Warn("DataCoord get QueryNode sessions failed", zap.Error(err))
+		return err
+	}
+	s.indexEngineVersionManager.Startup(qnSessions)
+	s.qnEventCh = s.session.WatchServices(typeutil.QueryNodeRole, qnRevision+1, nil)
+
 	return nil
 }
 
@@ -567,7 +594,13 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 
 func (s *Server) initIndexBuilder(manager storage.ChunkManager) {
 	if s.indexBuilder == nil {
-		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager)
+		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager, s.indexEngineVersionManager)
+	}
+}
+
+func (s *Server) initIndexArchiver(manager storage.ChunkManager) {
+	if s.indexArchiver == nil {
+		s.indexArchiver = newIndexArchiver(s.ctx, s.meta, manager, s.kv)
 	}
 }
 
@@ -818,6 +851,27 @@ func (s *Server) watchService(ctx context.Context) {
 				}()
 				return
 			}
+		case event, ok := <-s.inHealthCh:
+			if !ok {
+				// the pushed health is an optimization only, the IndexNodes are still checked by GetJobStats
+				log.Warn("watch IndexNode health stopped")
+				s.inHealthCh = nil
+				continue
+			}
+			s.indexNodeManager.UpdateHealth(event)
+		case event, ok := <-s.qnEventCh:
+			if !ok {
+				s.stopServiceWatch()
+				return
+			}
+			if err := s.handleSessionEvent(ctx, typeutil.QueryNodeRole, event); err != nil {
+				go func() {
+					if err := s.Stop(); err != nil {
+						log.Warn("DataCoord server stop error", zap.Error(err))
+					}
+				}()
+				return
+			}
 		}
 	}
 }
@@ -881,6 +935,24 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			log.Warn("receive unknown service event type",
 				zap.Any("type", event.EventType))
 		}
+	case typeutil.QueryNodeRole:
+		switch event.EventType {
+		case sessionutil.SessionAddEvent:
+			log.Info("received querynode register",
+				zap.String("address", event.Session.Address),
+				zap.Int64("serverID", event.Session.ServerID))
+			s.indexEngineVersionManager.AddNode(event.Session)
+		case sessionutil.SessionDelEvent:
+			log.Info("received querynode unregister",
+				zap.String("address", event.Session.Address),
+				zap.Int64("serverID", event.Session.ServerID))
+			s.indexEngineVersionManager.RemoveNode(event.Session)
+		case sessionutil.SessionUpdateEvent:
+			log.Info("received querynode SessionUpdateEvent", zap.Int64("serverID", event.Session.ServerID))
+		default:
+			log.Warn("receive unknown service event type",
+				zap.Any("type", event.EventType))
+		}
 	}
 
 	return nil
@@ -995,6 +1067,7 @@ func (s *Server) Stop() error {
 		s.stopCompactionHandler()
 	}
 	s.indexBuilder.Stop()
+	s.indexArchiver.Stop()
 
 	if s.session != nil {
 		s.session.Stop()
@@ -1041,6 +1041,28 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.IndexOrphanFiles {
+		metrics, err := s.getIndexOrphanFiles(ctx)
+		if err != nil {
+			log.Warn("DataCoord GetMetrics failed", zap.Int64("nodeID", paramtable.GetNodeID()), zap.Error(err))
+			return &milvuspb.GetMetricsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		return metrics, nil
+	}
+
+	if metricType == metricsinfo.GoroutineSnapshotMetrics || metricType == metricsinfo.HeapSnapshotMetrics {
+		metrics, err := s.getIndexNodeSnapshots(ctx, req)
+		if err != nil {
+			log.Warn("DataCoord GetMetrics failed", zap.Int64("nodeID", paramtable.GetNodeID()), zap.Error(err))
+			return &milvuspb.GetMetricsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		return metrics, nil
+	}
+
 	log.RatedWarn(60.0, "DataCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
@@ -185,6 +185,15 @@ func getCollectionAutoCompactionEnabled(properties map[string]string) (bool, err
 	return Params.DataCoordCfg.EnableAutoCompaction.GetAsBool(), nil
 }
 
+// getCollectionIndexBuildPriority returns the priority of the index builds of the collection, 0 if not set.
+func getCollectionIndexBuildPriority(properties map[string]string) (int64, error) {
+	v, ok := properties[common.CollectionIndexBuildPriorityKey]
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
 func getIndexType(indexParams []*commonpb.KeyValuePair) string {
 	for _, param := range indexParams {
 		if param.Key == common.IndexTypeKey {
@@ -184,3 +184,20 @@ func (suite *UtilSuite) TestGetCollectionAutoCompactionEnabled() {
 	suite.NoError(err)
 	suite.Equal(Params.DataCoordCfg.EnableAutoCompaction.GetAsBool(), enabled)
 }
+
+func (suite *UtilSuite) TestGetCollectionIndexBuildPriority() {
+	priority, err := getCollectionIndexBuildPriority(map[string]string{
+		common.CollectionIndexBuildPriorityKey: "10",
+	})
+	suite.NoError(err)
+	suite.Equal(int64(10), priority)
+
+	_, err = getCollectionIndexBuildPriority(map[string]string{
+		common.CollectionIndexBuildPriorityKey: "bad_value",
+	})
+	suite.Error(err)
+
+	priority, err = getCollectionIndexBuildPriority(map[string]string{})
+	suite.NoError(err)
+	suite.Equal(int64(0), priority)
+}
@@ -92,6 +92,10 @@ func (mtm *mockTtMsgStream) Seek(ctx context.Context, offset []*msgpb.MsgPositio
 	return nil
 }
 
+func (mtm *mockTtMsgStream) SeekByTime(ctx context.Context, channel string, ts typeutil.Timestamp) error {
+	return nil
+}
+
 func (mtm *mockTtMsgStream) GetLatestMsgID(channel string) (msgstream.MessageID, error) {
 	return nil, nil
 }
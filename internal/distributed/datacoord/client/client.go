@@ -619,6 +619,27 @@ func (c *Client) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	})
 }
 
+// ArchiveIndex moves the index files of a released collection to the archive storage class.
+func (c *Client) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.ArchiveIndex(ctx, req)
+	})
+}
+
+// RestoreIndex starts restoring the archived index files of a collection.
+func (c *Client) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.RestoreIndex(ctx, req)
+	})
+}
+
+// GetIndexArchiveState gets the archive state of the index files of a collection.
+func (c *Client) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.GetIndexArchiveStateResponse, error) {
+		return client.GetIndexArchiveState(ctx, req)
+	})
+}
+
 // DropIndex sends the drop index request to IndexCoord.
 func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
@@ -213,6 +213,15 @@ func Test_NewClient(t *testing.T) {
 
 		r41, err := client.GetIndexStatistics(ctx, nil)
 		retCheck(retNotNil, r41, err)
+
+		r42, err := client.ArchiveIndex(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.RestoreIndex(ctx, nil)
+		retCheck(retNotNil, r43, err)
+
+		r44, err := client.GetIndexArchiveState(ctx, nil)
+		retCheck(retNotNil, r44, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[datapb.DataCoordClient]{
@@ -173,6 +173,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			otelgrpc.UnaryServerInterceptor(opts...),
 			logutil.UnaryTraceLoggerInterceptor,
 			interceptor.ClusterValidationUnaryServerInterceptor(),
+			interceptor.DeadlineUnaryServerInterceptor(Params.MethodDeadlines),
 			interceptor.ServerIDValidationUnaryServerInterceptor(func() int64 {
 				if s.serverID.Load() == 0 {
 					s.serverID.Store(paramtable.GetNodeID())
@@ -470,6 +471,21 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	return s.dataCoord.GetIndexBuildProgress(ctx, req)
 }
 
+// ArchiveIndex moves the index files of a released collection to the archive storage class.
+func (s *Server) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ArchiveIndex(ctx, req)
+}
+
+// RestoreIndex starts restoring the archived index files of a collection.
+func (s *Server) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	return s.dataCoord.RestoreIndex(ctx, req)
+}
+
+// GetIndexArchiveState gets the archive state of the index files of a collection.
+func (s *Server) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return s.dataCoord.GetIndexArchiveState(ctx, req)
+}
+
 func (s *Server) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.ReportDataNodeTtMsgs(ctx, req)
 }
@@ -84,6 +84,9 @@ type MockDataCoord struct {
 	getIndexBuildProgressResp *indexpb.GetIndexBuildProgressResponse
 	getSegmentIndexStateResp  *indexpb.GetSegmentIndexStateResponse
 	getIndexInfosResp         *indexpb.GetIndexInfoResponse
+	archiveIndexResp          *commonpb.Status
+	restoreIndexResp          *commonpb.Status
+	getIndexArchiveStateResp  *indexpb.GetIndexArchiveStateResponse
 }
 
 func (m *MockDataCoord) Init() error {
@@ -290,6 +293,18 @@ func (m *MockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.dropIndexResp, m.err
 }
 
+func (m *MockDataCoord) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	return m.archiveIndexResp, m.err
+}
+
+func (m *MockDataCoord) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	return m.restoreIndexResp, m.err
+}
+
+func (m *MockDataCoord) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return m.getIndexArchiveStateResp, m.err
+}
+
 func Test_NewServer(t *testing.T) {
 	paramtable.Init()
 	parameters := []string{"tikv", "etcd"}
@@ -657,6 +672,33 @@ func Test_NewServer(t *testing.T) {
 			assert.NotNil(t, ret)
 		})
 
+		t.Run("ArchiveIndex", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				archiveIndexResp: merr.Status(nil),
+			}
+			ret, err := server.ArchiveIndex(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, ret)
+		})
+
+		t.Run("RestoreIndex", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				restoreIndexResp: merr.Status(nil),
+			}
+			ret, err := server.RestoreIndex(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, ret)
+		})
+
+		t.Run("GetIndexArchiveState", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				getIndexArchiveStateResp: &indexpb.GetIndexArchiveStateResponse{},
+			}
+			ret, err := server.GetIndexArchiveState(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, ret)
+		})
+
 		t.Run("GetSegmentIndexState", func(t *testing.T) {
 			server.dataCoord = &MockDataCoord{
 				getSegmentIndexStateResp: &indexpb.GetSegmentIndexStateResponse{},
@@ -144,6 +144,20 @@ func (c *Client) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	})
 }
 
+// GetCapabilities gets the capabilities of the index node.
+func (c *Client) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*indexpb.GetCapabilitiesResponse, error) {
+		return client.GetCapabilities(ctx, req)
+	})
+}
+
+// UpdateLogConfig changes the log level and the rate groups of the rated logs of the index node at runtime.
+func (c *Client) UpdateLogConfig(ctx context.Context, req *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client indexpb.IndexNodeClient) (*indexpb.UpdateLogConfigResponse, error) {
+		return client.UpdateLogConfig(ctx, req)
+	})
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (c *Client) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	req = typeutil.Clone(req)
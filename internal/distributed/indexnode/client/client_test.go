@@ -218,6 +218,20 @@ func TestIndexNodeClient(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	})
 
+	t.Run("GetCapabilities", func(t *testing.T) {
+		req := &indexpb.GetCapabilitiesRequest{}
+		resp, err := inc.GetCapabilities(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("UpdateLogConfig", func(t *testing.T) {
+		req := &indexpb.UpdateLogConfigRequest{}
+		resp, err := inc.UpdateLogConfig(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
 	err = ins.Stop()
 	assert.NoError(t, err)
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -34,6 +35,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -46,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/interceptor"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
 )
 
 // Server is the grpc wrapper of IndexNode.
@@ -112,7 +115,9 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			otelgrpc.UnaryServerInterceptor(opts...),
 			logutil.UnaryTraceLoggerInterceptor,
+			slowlog.UnaryServerInterceptor,
 			interceptor.ClusterValidationUnaryServerInterceptor(),
+			interceptor.DeadlineUnaryServerInterceptor(Params.MethodDeadlines),
 			interceptor.ServerIDValidationUnaryServerInterceptor(func() int64 {
 				if s.serverID.Load() == 0 {
 					s.serverID.Store(paramtable.GetNodeID())
@@ -186,10 +191,41 @@ func (s *Server) init() error {
 		log.Error("IndexNode Init failed", zap.Error(err))
 		return err
 	}
+	registerProfileHandler()
+	registerLifetimeHandler(s.indexnode)
 
 	return nil
 }
 
+var registerProfileOnce sync.Once
+
+// registerProfileHandler registers the profile endpoint of the index builds to the management http server.
+func registerProfileHandler() {
+	registerProfileOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:    management.IndexNodeProfileRouterPath,
+			Handler: indexnode.ProfileHandler(),
+		})
+	})
+}
+
+var registerLifetimeOnce sync.Once
+
+// registerLifetimeHandler registers the endpoint suspending and resuming IndexNode to the management http server,
+// if the component serves it.
+func registerLifetimeHandler(component types.IndexNodeComponent) {
+	h, ok := component.(interface{ LifetimeHandler() http.Handler })
+	if !ok {
+		return
+	}
+	registerLifetimeOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:    management.IndexNodeLifetimeRouterPath,
+			Handler: h.LifetimeHandler(),
+		})
+	})
+}
+
 // start starts IndexNode's grpc service.
 func (s *Server) start() error {
 	err := s.indexnode.Start()
@@ -266,6 +302,16 @@ func (s *Server) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReques
 	return s.indexnode.GetJobStats(ctx, req)
 }
 
+// GetCapabilities gets the capabilities of indexnode
+func (s *Server) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	return s.indexnode.GetCapabilities(ctx, req)
+}
+
+// UpdateLogConfig changes the log level and the rate groups of the rated logs of indexnode at runtime
+func (s *Server) UpdateLogConfig(ctx context.Context, req *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+	return s.indexnode.UpdateLogConfig(ctx, req)
+}
+
 // ShowConfigurations gets specified configurations para of IndexNode
 func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	return s.indexnode.ShowConfigurations(ctx, req)
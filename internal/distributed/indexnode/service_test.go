@@ -125,6 +125,20 @@ func TestIndexNodeServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	})
 
+	t.Run("GetCapabilities", func(t *testing.T) {
+		req := &indexpb.GetCapabilitiesRequest{}
+		resp, err := server.GetCapabilities(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("UpdateLogConfig", func(t *testing.T) {
+		req := &indexpb.UpdateLogConfigRequest{}
+		resp, err := server.UpdateLogConfig(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
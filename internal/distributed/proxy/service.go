@@ -314,6 +314,7 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 			otelgrpc.UnaryServerInterceptor(opts...),
 			logutil.UnaryTraceLoggerInterceptor,
 			interceptor.ClusterValidationUnaryServerInterceptor(),
+			interceptor.DeadlineUnaryServerInterceptor(Params.MethodDeadlines),
 			interceptor.ServerIDValidationUnaryServerInterceptor(func() int64 {
 				if s.serverID.Load() == 0 {
 					s.serverID.Store(paramtable.GetNodeID())
@@ -500,6 +500,18 @@ func (m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.
 	return nil, nil
 }
 
+func (m *MockDataCoord) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
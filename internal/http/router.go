@@ -22,5 +22,23 @@ const HealthzRouterPath = "/healthz"
 // LogLevelRouterPath is path for Get and Update log level at runtime.
 const LogLevelRouterPath = "/log/level"
 
+// LogRateGroupRouterPath is path for Get and Update the rate groups of the rated logs at runtime.
+const LogRateGroupRouterPath = "/log/rate_groups"
+
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
+
+// IndexNodeProfileRouterPath is path for the profiles labeled by the index builds of IndexNode.
+const IndexNodeProfileRouterPath = "/indexnode/profile"
+
+// IndexNodeLifetimeRouterPath is path for Get the lifetime state of IndexNode and Suspend or Resume it at runtime.
+const IndexNodeLifetimeRouterPath = "/indexnode/lifetime"
+
+// PebblemqTopicConfigRouterPath is path for Get and Update the per-topic configs of the embedded pebblemq at runtime.
+const PebblemqTopicConfigRouterPath = "/pebblemq/topic_config"
+
+// FeatureFlagRouterPath is path for Get and Update the feature flags at runtime.
+const FeatureFlagRouterPath = "/feature_flags"
+
+// ConfigReloadRouterPath is path for reloading the yaml files of the configs at runtime.
+const ConfigReloadRouterPath = "/config/reload"
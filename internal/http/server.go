@@ -17,6 +17,7 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -49,6 +50,18 @@ func registerDefaults() {
 			log.Level().ServeHTTP(w, req)
 		},
 	})
+	Register(&Handler{
+		Path:        LogRateGroupRouterPath,
+		HandlerFunc: handleRateGroups,
+	})
+	Register(&Handler{
+		Path:        FeatureFlagRouterPath,
+		HandlerFunc: handleFeatureFlags,
+	})
+	Register(&Handler{
+		Path:        ConfigReloadRouterPath,
+		HandlerFunc: handleConfigReload,
+	})
 	Register(&Handler{
 		Path:    HealthzRouterPath,
 		Handler: healthz.Handler(),
@@ -60,6 +73,96 @@ func registerDefaults() {
 	})
 }
 
+// handleRateGroups returns the rate groups of the rated logs, and updates the ones in the body of PUT requests,
+// which is a json array of the rate groups, e.g. [{"name":"global","creditPerSecond":10,"maxBalance":100}].
+func handleRateGroups(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var groups []log.RateGroup
+		if err := json.NewDecoder(req.Body).Decode(&groups); err != nil {
+			http.Error(w, fmt.Sprintf("invalid rate groups: %v", err), http.StatusBadRequest)
+			return
+		}
+		for _, group := range groups {
+			if err := log.UpdateRateGroup(group); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			log.Info("log rate group updated", zap.String("name", group.Name),
+				zap.Float64("creditPerSecond", group.CreditPerSecond), zap.Float64("maxBalance", group.MaxBalance))
+		}
+	default:
+		http.Error(w, "only GET and PUT are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(log.RateGroups())
+}
+
+// handleFeatureFlags returns the feature flags, and toggles the ones in the body of PUT requests,
+// which is a json object of the flags by the names, e.g. {"mqPayloadCompression":false}.
+// The flags toggled here only take effect on this node, and are lost on restart.
+func handleFeatureFlags(w http.ResponseWriter, req *http.Request) {
+	params := paramtable.Get()
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var flags map[string]bool
+		if err := json.NewDecoder(req.Body).Decode(&flags); err != nil {
+			http.Error(w, fmt.Sprintf("invalid feature flags: %v", err), http.StatusBadRequest)
+			return
+		}
+		for name := range flags {
+			if params.FeatureFlagCfg.Get(name) == nil {
+				http.Error(w, fmt.Sprintf("unknown feature flag %s", name), http.StatusBadRequest)
+				return
+			}
+		}
+		for name, enabled := range flags {
+			params.Save(params.FeatureFlagCfg.Get(name).Key, strconv.FormatBool(enabled))
+			log.Info("feature flag toggled", zap.String("name", name), zap.Bool("enabled", enabled))
+		}
+	default:
+		http.Error(w, "only GET and PUT are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	flags := make(map[string]bool)
+	for _, flag := range params.FeatureFlagCfg.Flags() {
+		flags[flag.Name()] = flag.GetAsBool()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(flags)
+}
+
+// configChange is a change applied by reloading the configs, the values are left out as they may be secrets.
+type configChange struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+}
+
+// handleConfigReload reloads the yaml files of the configs on POST requests, as SIGHUP does,
+// and returns the changed keys. Only the refreshable params take effect without a restart.
+func handleConfigReload(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	events, err := paramtable.Get().ReloadFiles()
+	if err != nil {
+		log.Warn("failed to reload configs", zap.Int("changed", len(events)), zap.Error(err))
+		http.Error(w, fmt.Sprintf("failed to reload configs: %v", err), http.StatusInternalServerError)
+		return
+	}
+	changes := make([]configChange, 0, len(events))
+	for _, e := range events {
+		changes = append(changes, configChange{Key: e.Key, Type: e.EventType})
+	}
+	log.Info("configs reloaded", zap.Int("changed", len(changes)))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(changes)
+}
+
 func Register(h *Handler) {
 	if h.HandlerFunc != nil {
 		http.HandleFunc(h.Path, h.HandlerFunc)
@@ -90,6 +90,90 @@ func (suite *HTTPServerTestSuite) TestDefaultLogHandler() {
 	suite.Equal(zap.ErrorLevel, log.GetLevel())
 }
 
+func (suite *HTTPServerTestSuite) TestRateGroupHandler() {
+	url := suite.server.URL + LogRateGroupRouterPath
+	client := suite.server.Client()
+	getGroups := func(resp *http.Response) []log.RateGroup {
+		defer resp.Body.Close()
+		suite.Equal(http.StatusOK, resp.StatusCode)
+		var groups []log.RateGroup
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&groups))
+		return groups
+	}
+
+	resp, err := client.Get(url)
+	suite.Require().NoError(err)
+	suite.Contains(getGroups(resp), log.RateGroup{Name: log.GlobalRateGroup, CreditPerSecond: 1, MaxBalance: 60})
+
+	payload, err := json.Marshal([]log.RateGroup{{Name: "http.test", CreditPerSecond: 10, MaxBalance: 100}})
+	suite.Require().NoError(err)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(payload))
+	suite.Require().NoError(err)
+	resp, err = client.Do(req)
+	suite.Require().NoError(err)
+	suite.Contains(getGroups(resp), log.RateGroup{Name: "http.test", CreditPerSecond: 10, MaxBalance: 100})
+
+	payload, err = json.Marshal([]log.RateGroup{{Name: "http.test", CreditPerSecond: 0, MaxBalance: 100}})
+	suite.Require().NoError(err)
+	req, err = http.NewRequest(http.MethodPut, url, bytes.NewBuffer(payload))
+	suite.Require().NoError(err)
+	resp, err = client.Do(req)
+	suite.Require().NoError(err)
+	resp.Body.Close()
+	suite.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (suite *HTTPServerTestSuite) TestFeatureFlagHandler() {
+	url := suite.server.URL + FeatureFlagRouterPath
+	client := suite.server.Client()
+	getFlags := func(resp *http.Response) map[string]bool {
+		defer resp.Body.Close()
+		suite.Equal(http.StatusOK, resp.StatusCode)
+		var flags map[string]bool
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&flags))
+		return flags
+	}
+
+	resp, err := client.Get(url)
+	suite.Require().NoError(err)
+	suite.Equal(true, getFlags(resp)["mqPayloadCompression"])
+
+	params := paramtable.Get()
+	defer params.Reset(params.FeatureFlagCfg.MQPayloadCompression.Key)
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{"mqPayloadCompression":false}`))
+	suite.Require().NoError(err)
+	resp, err = client.Do(req)
+	suite.Require().NoError(err)
+	suite.Equal(false, getFlags(resp)["mqPayloadCompression"])
+	suite.False(params.FeatureFlagCfg.MQPayloadCompression.Enabled())
+
+	req, err = http.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{"notExist":true}`))
+	suite.Require().NoError(err)
+	resp, err = client.Do(req)
+	suite.Require().NoError(err)
+	resp.Body.Close()
+	suite.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (suite *HTTPServerTestSuite) TestConfigReloadHandler() {
+	url := suite.server.URL + ConfigReloadRouterPath
+	client := suite.server.Client()
+
+	resp, err := client.Get(url)
+	suite.Require().NoError(err)
+	resp.Body.Close()
+	suite.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+
+	// the files are not changed
+	resp, err = client.Post(url, "application/json", nil)
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	var changes []configChange
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&changes))
+	suite.Empty(changes)
+}
+
 func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	url := suite.server.URL + "/healthz"
 	client := suite.server.Client()
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -24,7 +27,7 @@ func NewChunkMgrFactory() *chunkMgrFactory {
 }
 
 func (m *chunkMgrFactory) NewChunkManager(ctx context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
-	chunkManagerFactory := storage.NewChunkManagerFactory(config.GetStorageType(),
+	chunkManagerFactory := storage.NewChunkManagerFactory(storageType(config),
 		storage.RootPath(config.GetRootPath()),
 		storage.Address(config.GetAddress()),
 		storage.AccessKeyID(config.GetAccessKeyID()),
@@ -36,11 +39,79 @@ func (m *chunkMgrFactory) NewChunkManager(ctx context.Context, config *indexpb.S
 		storage.IAMEndpoint(config.GetIAMEndpoint()),
 		storage.UseVirtualHost(config.GetUseVirtualHost()),
 		storage.Region(config.GetRegion()),
+		storage.ServerSideEncryption(config.GetSseType(), config.GetSseKey()),
+		storage.RequesterPays(config.GetRequesterPays()),
+		storage.S3Endpoint(config.GetUseDualStackEndpoint(), config.GetUseFipsEndpoint()),
+		storage.CustomHeaders(funcutil.KeyValuePair2Map(config.GetCustomHeaders())),
+		// StorageConfig carries no SAS token, GCS or HDFS credentials, they're taken from the config of indexnode
+		storage.SASToken(Params.MinioCfg.SASToken.GetValue()),
+		storage.GcpCredentialJSON(Params.MinioCfg.GcpCredentialJSON.GetValue()),
+		storage.GcpKMSKeyName(Params.MinioCfg.GcpKMSKeyName.GetValue()),
+		storage.HdfsUser(Params.HdfsCfg.User.GetValue()),
+		storage.HdfsDelegationToken(Params.HdfsCfg.DelegationToken.GetValue()),
+		storage.HdfsDelegationTokenFile(Params.HdfsCfg.DelegationTokenFile.GetValue()),
+		storage.HdfsReplication(Params.HdfsCfg.Replication.GetAsInt()),
+		storage.ReadPartSize(Params.MinioCfg.ReadPartSize.GetAsInt64()<<20),
+		storage.ReadConcurrency(Params.MinioCfg.ReadConcurrency.GetAsInt()),
+		// the limits are per node, shared by all the jobs of indexnode
+		storage.RequestRateLimit(Params.MinioCfg.RequestRateLimit.GetAsFloat()),
+		storage.BandwidthLimit(Params.MinioCfg.BandwidthLimit.GetAsInt64()<<20),
+		storage.RequestRetryAttempts(Params.MinioCfg.RequestRetryAttempts.GetAsInt()),
+		storage.DiskCache(Params.MinioCfg.CachePath.GetValue(), Params.MinioCfg.CacheCapacity.GetAsInt64()<<30, Params.MinioCfg.CacheWriteBack.GetAsBool()),
+		// the temporary credentials are refreshed by indexnode itself for the long-running tasks
+		storage.SessionToken(Params.MinioCfg.SessionToken.GetValue()),
+		storage.RoleARN(Params.MinioCfg.RoleARN.GetValue()),
+		storage.STSEndpoint(Params.MinioCfg.STSEndpoint.GetValue()),
+		storage.CredentialsFile(Params.MinioCfg.CredentialsFile.GetValue()),
 		storage.CreateBucket(true),
 	)
 	return chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
 }
 
-func (m *chunkMgrFactory) cacheKey(storageType, bucket, address string) string {
-	return fmt.Sprintf("%s/%s/%s", storageType, bucket, address)
+// storageType returns the chunk manager engine for config,
+// Azure Blob and native GCS are only accessible by the remote chunk manager as they don't speak S3
+func storageType(config *indexpb.StorageConfig) string {
+	if config.GetStorageType() != "minio" {
+		return config.GetStorageType()
+	}
+	switch config.GetCloudProvider() {
+	case storage.CloudProviderAzure, storage.CloudProviderGCPNative:
+		return "remote"
+	}
+	return config.GetStorageType()
+}
+
+// validateStorageConfig checks the storage config of the job before creating the chunk manager,
+// so the misconfigurations fail the job immediately rather than during uploading the index files
+func validateStorageConfig(config *indexpb.StorageConfig) error {
+	if err := storage.ValidateServerSideEncryption(storageType(config), config.GetCloudProvider(), config.GetSseType(), config.GetSseKey()); err != nil {
+		return err
+	}
+	return storage.ValidateS3RequestOptions(storageType(config), config.GetCloudProvider(), config.GetAddress(), config.GetRegion(),
+		config.GetUseSSL(), config.GetRequesterPays(), config.GetUseDualStackEndpoint(), config.GetUseFipsEndpoint(), funcutil.KeyValuePair2Map(config.GetCustomHeaders()))
+}
+
+// resolveStorageSecrets returns a copy of config with the credentials resolved if they reference the secrets,
+// for segcore which takes the credentials as they are
+func resolveStorageSecrets(ctx context.Context, config *indexpb.StorageConfig) (*indexpb.StorageConfig, error) {
+	if !storage.IsSecretRef(config.GetAccessKeyID()) && !storage.IsSecretRef(config.GetSecretAccessKey()) {
+		return config, nil
+	}
+	accessKeyID, err := storage.ResolveSecret(ctx, config.GetAccessKeyID())
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := storage.ResolveSecret(ctx, config.GetSecretAccessKey())
+	if err != nil {
+		return nil, err
+	}
+	resolved := proto.Clone(config).(*indexpb.StorageConfig)
+	resolved.AccessKeyID = accessKeyID
+	resolved.SecretAccessKey = secretAccessKey
+	return resolved, nil
+}
+
+// storageKey identifies the object storage of config
+func storageKey(config *indexpb.StorageConfig) string {
+	return fmt.Sprintf("%s/%s/%s", storageType(config), config.GetBucketName(), config.GetAddress())
 }
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestStorageType(t *testing.T) {
+	assert.Equal(t, "local", storageType(&indexpb.StorageConfig{StorageType: "local"}))
+	assert.Equal(t, "minio", storageType(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderAWS}))
+	assert.Equal(t, "remote", storageType(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderAzure}))
+	assert.Equal(t, "remote", storageType(&indexpb.StorageConfig{StorageType: "remote", CloudProvider: storage.CloudProviderAzure}))
+	assert.Equal(t, "remote", storageType(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderGCPNative}))
+	assert.Equal(t, "local", storageType(&indexpb.StorageConfig{StorageType: "local", CloudProvider: storage.CloudProviderGCPNative}))
+	assert.Equal(t, "hdfs", storageType(&indexpb.StorageConfig{StorageType: "hdfs"}))
+}
+
+func TestValidateStorageConfig(t *testing.T) {
+	assert.NoError(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderAWS}))
+	assert.NoError(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", SseType: storage.SSETypeKMS, SseKey: "key"}))
+	assert.Error(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", SseType: "unknown"}))
+	// azure is accessed by the remote chunk manager
+	assert.Error(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderAzure, SseType: storage.SSETypeS3}))
+
+	assert.NoError(t, validateStorageConfig(&indexpb.StorageConfig{
+		StorageType: "minio", CloudProvider: storage.CloudProviderAWS, Address: "s3.amazonaws.com", Region: "us-east-1",
+		UseSSL: true, RequesterPays: true, UseDualStackEndpoint: true, UseFipsEndpoint: true,
+		CustomHeaders: []*commonpb.KeyValuePair{{Key: "X-Request-Source", Value: "milvus"}},
+	}))
+	assert.Error(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", CloudProvider: storage.CloudProviderGCP, RequesterPays: true}))
+	assert.Error(t, validateStorageConfig(&indexpb.StorageConfig{StorageType: "minio", Address: "localhost:9000", UseFipsEndpoint: true}))
+	assert.Error(t, validateStorageConfig(&indexpb.StorageConfig{
+		StorageType: "minio", CustomHeaders: []*commonpb.KeyValuePair{{Key: "X-Amz-Request-Payer", Value: "requester"}},
+	}))
+}
+
+func TestResolveStorageSecrets(t *testing.T) {
+	ctx := context.Background()
+	config := &indexpb.StorageConfig{StorageType: "minio", AccessKeyID: "minioadmin", SecretAccessKey: "minioadmin"}
+	resolved, err := resolveStorageSecrets(ctx, config)
+	assert.NoError(t, err)
+	assert.Same(t, config, resolved)
+
+	t.Setenv("TEST_INDEXNODE_SECRET", "secret")
+	config.SecretAccessKey = "env://TEST_INDEXNODE_SECRET"
+	resolved, err = resolveStorageSecrets(ctx, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "minioadmin", resolved.GetAccessKeyID())
+	assert.Equal(t, "secret", resolved.GetSecretAccessKey())
+	// the request keeps the reference
+	assert.Equal(t, "env://TEST_INDEXNODE_SECRET", config.GetSecretAccessKey())
+
+	config.SecretAccessKey = "env://TEST_INDEXNODE_SECRET_NOT_SET"
+	_, err = resolveStorageSecrets(ctx, config)
+	assert.Error(t, err)
+}
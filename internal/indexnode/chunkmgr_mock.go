@@ -118,6 +118,20 @@ func (c *mockChunkmgr) Exist(ctx context.Context, filePath string) (bool, error)
 	return false, errNotImplErr
 }
 
+func (c *mockChunkmgr) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	exists := make([]bool, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		_, ok := c.segmentData.Load(filePath)
+		exists = append(exists, ok)
+	}
+	return exists, nil
+}
+
+func (c *mockChunkmgr) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	// TODO
+	return nil, errNotImplErr
+}
+
 func (c *mockChunkmgr) Read(ctx context.Context, filePath string) ([]byte, error) {
 	value, ok := c.segmentData.Load(filePath)
 	if !ok {
@@ -171,6 +185,26 @@ func (c *mockChunkmgr) RemoveWithPrefix(ctx context.Context, prefix string) erro
 	return errNotImplErr
 }
 
+func (c *mockChunkmgr) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	// TODO
+	return "", errNotImplErr
+}
+
+func (c *mockChunkmgr) Archive(ctx context.Context, filePaths []string) error {
+	// TODO
+	return errNotImplErr
+}
+
+func (c *mockChunkmgr) Restore(ctx context.Context, filePaths []string) error {
+	// TODO
+	return errNotImplErr
+}
+
+func (c *mockChunkmgr) ArchiveStates(ctx context.Context, filePaths []string) ([]storage.ArchiveState, error) {
+	// TODO
+	return nil, errNotImplErr
+}
+
 func (c *mockChunkmgr) mockFieldData(numrows, dim int, collectionID, partitionID, segmentID int64) {
 	idList := make([]int64, 0, numrows)
 	tsList := make([]int64, 0, numrows)
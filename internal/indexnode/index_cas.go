@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// indexFileManifest lists the content-addressed index files of a build, it's the reference of the build to the
+// files, which is written before the build is reported, so GC keeps the files of the builds not finished yet
+type indexFileManifest struct {
+	BuildID      UniqueID            `json:"buildID"`
+	IndexVersion int64               `json:"indexVersion"`
+	PartitionID  UniqueID            `json:"partitionID"`
+	SegmentID    UniqueID            `json:"segmentID"`
+	Files        []indexManifestFile `json:"files"`
+}
+
+type indexManifestFile struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// indexFileKeys returns the file keys of the uploaded index files and the content-addressed ones among them.
+// The files are uploaded to the paths named by their sha256 by segcore if the build is content-addressed,
+// the keys of them are the content-addressed keys, the keys of the others are the file names.
+func indexFileKeys(cm storage.ChunkManager, filePath2Size map[string]int64) ([]string, []indexManifestFile) {
+	casPrefix := path.Join(cm.RootPath(), common.SegmentIndexCASPath) + "/"
+	filePaths := make([]string, 0, len(filePath2Size))
+	for filePath := range filePath2Size {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	fileKeys := make([]string, 0, len(filePaths))
+	files := make([]indexManifestFile, 0)
+	for _, filePath := range filePaths {
+		if !strings.HasPrefix(filePath, casPrefix) {
+			fileKeys = append(fileKeys, path.Base(filePath))
+			continue
+		}
+		fileKey := strings.TrimPrefix(filePath, casPrefix)
+		fileKeys = append(fileKeys, fileKey)
+		files = append(files, indexManifestFile{
+			Name: path.Base(fileKey),
+			Hash: path.Dir(fileKey),
+			Size: filePath2Size[filePath],
+		})
+	}
+	return fileKeys, files
+}
+
+// writeIndexFileManifest writes the manifest of the content-addressed index files of a build
+func writeIndexFileManifest(ctx context.Context, cm storage.ChunkManager, buildID UniqueID, indexVersion int64,
+	partitionID, segmentID UniqueID, files []indexManifestFile,
+) error {
+	manifest, err := json.Marshal(&indexFileManifest{
+		BuildID:      buildID,
+		IndexVersion: indexVersion,
+		PartitionID:  partitionID,
+		SegmentID:    segmentID,
+		Files:        files,
+	})
+	if err != nil {
+		return err
+	}
+	manifestPath := metautil.BuildSegmentIndexManifestPath(cm.RootPath(), buildID, indexVersion, partitionID, segmentID)
+	return cm.Write(ctx, manifestPath, manifest)
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+func TestIndexFileKeys(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	hash0 := "0a1b2c"
+	hash1 := "3d4e5f"
+	filePath2Size := map[string]int64{
+		metautil.BuildContentAddressedIndexFilePath(rootPath, path.Join(hash0, "HNSW_0")): 6,
+		metautil.BuildContentAddressedIndexFilePath(rootPath, path.Join(hash1, "HNSW_1")): 7,
+		metautil.BuildSegmentIndexFilePath(rootPath, 1000, 1, 10, 100, "index_type"):      8,
+	}
+	fileKeys, files := indexFileKeys(cm, filePath2Size)
+	assert.Equal(t, []string{
+		path.Join(hash0, "HNSW_0"),
+		path.Join(hash1, "HNSW_1"),
+		"index_type",
+	}, fileKeys)
+	assert.Equal(t, []indexManifestFile{
+		{Name: "HNSW_0", Hash: hash0, Size: 6},
+		{Name: "HNSW_1", Hash: hash1, Size: 7},
+	}, files)
+	for _, fileKey := range fileKeys[:2] {
+		assert.True(t, metautil.IsContentAddressedIndexFileKey(fileKey))
+	}
+
+	require.NoError(t, writeIndexFileManifest(ctx, cm, 1000, 1, 10, 100, files))
+	data, err := cm.Read(ctx, metautil.BuildSegmentIndexManifestPath(rootPath, 1000, 1, 10, 100))
+	require.NoError(t, err)
+	manifest := &indexFileManifest{}
+	require.NoError(t, json.Unmarshal(data, manifest))
+	assert.Equal(t, UniqueID(1000), manifest.BuildID)
+	assert.Equal(t, int64(1), manifest.IndexVersion)
+	assert.Equal(t, files, manifest.Files)
+
+	// the plain builds have no content-addressed files
+	fileKeys, files = indexFileKeys(cm, map[string]int64{
+		metautil.BuildSegmentIndexFilePath(rootPath, 1001, 1, 10, 100, "HNSW_0"): 6,
+	})
+	assert.Equal(t, []string{"HNSW_0"}, fileKeys)
+	assert.Empty(t, files)
+}
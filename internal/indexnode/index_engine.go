@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// BuildContext carries the inputs of an index build to the IndexEngine.
+type BuildContext struct {
+	Req          *indexpb.CreateJobRequest
+	CollectionID UniqueID
+	PartitionID  UniqueID
+	SegmentID    UniqueID
+	FieldID      UniqueID
+	FieldType    schemapb.DataType
+	TypeParams   map[string]string
+	IndexParams  map[string]string
+	// ChunkManager is the storage of the job.
+	ChunkManager storage.ChunkManager
+	// ObjectTags are attached to the uploaded index files, nil if object tagging is disabled.
+	ObjectTags map[string]string
+	// ContentAddressed uploads the index files to the content-addressed paths if the engine supports it,
+	// see metautil.BuildContentAddressedIndexFilePath.
+	ContentAddressed bool
+}
+
+// IndexEngine builds the index of a segment field. The returned index uploads the index files in UpLoad,
+// and is released by Delete once uploaded.
+type IndexEngine interface {
+	Name() string
+	Build(ctx context.Context, bc *BuildContext) (indexcgowrapper.CodecIndex, error)
+}
+
+// IndexEngineMgr manages the index engines registered by index type.
+type IndexEngineMgr interface {
+	// Register registers the engine to build the indexes of indexType, replacing the one registered before.
+	Register(indexType string, engine IndexEngine)
+	// Unregister removes the engine of indexType, the default engine is used for it afterwards.
+	Unregister(indexType string)
+	// GetEngine returns the engine of indexType, or the default knowhere engine if none is registered.
+	GetEngine(indexType string) IndexEngine
+	// Engines returns the names of the registered engines by index type.
+	Engines() map[string]string
+}
+
+type indexEngineMgrImpl struct {
+	mu            sync.RWMutex
+	engines       map[string]IndexEngine
+	defaultEngine IndexEngine
+}
+
+func (mgr *indexEngineMgrImpl) Register(indexType string, engine IndexEngine) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	mgr.engines[indexType] = engine
+	log.Info("register index engine", zap.String("indexType", indexType), zap.String("engine", engine.Name()))
+}
+
+func (mgr *indexEngineMgrImpl) Unregister(indexType string) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	delete(mgr.engines, indexType)
+}
+
+func (mgr *indexEngineMgrImpl) GetEngine(indexType string) IndexEngine {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+	if engine, ok := mgr.engines[indexType]; ok {
+		return engine
+	}
+	return mgr.defaultEngine
+}
+
+func (mgr *indexEngineMgrImpl) Engines() map[string]string {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+	engines := make(map[string]string, len(mgr.engines))
+	for indexType, engine := range mgr.engines {
+		engines[indexType] = engine.Name()
+	}
+	return engines
+}
+
+func newIndexEngineMgr() *indexEngineMgrImpl {
+	return &indexEngineMgrImpl{
+		engines:       make(map[string]IndexEngine),
+		defaultEngine: &knowhereEngine{},
+	}
+}
+
+var (
+	indexEngineMgr            IndexEngineMgr
+	getIndexEngineMgrInstOnce sync.Once
+)
+
+// GetIndexEngineMgrInstance gets the instance of IndexEngineMgr.
+func GetIndexEngineMgrInstance() IndexEngineMgr {
+	getIndexEngineMgrInstOnce.Do(func() {
+		indexEngineMgr = newIndexEngineMgr()
+	})
+	return indexEngineMgr
+}
+
+// knowhereEngine builds the index with knowhere through segcore.
+type knowhereEngine struct{}
+
+func (e *knowhereEngine) Name() string {
+	return "knowhere"
+}
+
+func (e *knowhereEngine) Build(ctx context.Context, bc *BuildContext) (indexcgowrapper.CodecIndex, error) {
+	storageConfig, err := resolveStorageSecrets(ctx, bc.Req.GetStorageConfig())
+	if err != nil {
+		log.Ctx(ctx).Warn("resolve storage secrets failed", zap.Error(err))
+		return nil, err
+	}
+	buildIndexInfo, err := indexcgowrapper.NewBuildIndexInfo(storageConfig)
+	defer indexcgowrapper.DeleteBuildIndexInfo(buildIndexInfo)
+	if err != nil {
+		log.Ctx(ctx).Warn("create build index info failed", zap.Error(err))
+		return nil, err
+	}
+	err = buildIndexInfo.AppendFieldMetaInfo(bc.CollectionID, bc.PartitionID, bc.SegmentID, bc.FieldID, bc.FieldType)
+	if err != nil {
+		log.Ctx(ctx).Warn("append field meta failed", zap.Error(err))
+		return nil, err
+	}
+
+	err = buildIndexInfo.AppendIndexMetaInfo(bc.Req.GetIndexID(), bc.Req.GetBuildID(), bc.Req.GetIndexVersion())
+	if err != nil {
+		log.Ctx(ctx).Warn("append index meta failed", zap.Error(err))
+		return nil, err
+	}
+
+	if bc.ObjectTags != nil {
+		err = buildIndexInfo.AppendObjectTags(bc.ObjectTags)
+		if err != nil {
+			log.Ctx(ctx).Warn("append object tags failed", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	buildIndexInfo.SetContentAddressed(bc.ContentAddressed)
+
+	err = buildIndexInfo.AppendBuildIndexParam(bc.IndexParams)
+	if err != nil {
+		log.Ctx(ctx).Warn("append index params failed", zap.Error(err))
+		return nil, err
+	}
+
+	err = buildIndexInfo.AppendBuildTypeParam(bc.TypeParams)
+	if err != nil {
+		log.Ctx(ctx).Warn("append type params failed", zap.Error(err))
+		return nil, err
+	}
+
+	for _, path := range bc.Req.GetDataPaths() {
+		err = buildIndexInfo.AppendInsertFile(path)
+		if err != nil {
+			log.Ctx(ctx).Warn("append insert binlog path failed", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return indexcgowrapper.CreateIndex(ctx, buildIndexInfo)
+}
+
+// objectTags returns the tags attached to the index files of the build.
+func objectTags(clusterID string, collectionID UniqueID, req *indexpb.CreateJobRequest) map[string]string {
+	return map[string]string{
+		"clusterID":    clusterID,
+		"collectionID": strconv.FormatInt(collectionID, 10),
+		"buildID":      strconv.FormatInt(req.GetBuildID(), 10),
+		"indexVersion": strconv.FormatInt(req.GetIndexVersion(), 10),
+	}
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+type fakeIndexEngine struct {
+	bc    *BuildContext
+	index indexcgowrapper.CodecIndex
+}
+
+func (e *fakeIndexEngine) Name() string {
+	return "fake"
+}
+
+func (e *fakeIndexEngine) Build(ctx context.Context, bc *BuildContext) (indexcgowrapper.CodecIndex, error) {
+	e.bc = bc
+	return e.index, nil
+}
+
+func TestIndexEngineMgr(t *testing.T) {
+	mgr := newIndexEngineMgr()
+	assert.Equal(t, "knowhere", mgr.GetEngine("HNSW").Name())
+
+	engine := &fakeIndexEngine{}
+	mgr.Register("HNSW", engine)
+	assert.Equal(t, engine, mgr.GetEngine("HNSW"))
+	assert.Equal(t, "knowhere", mgr.GetEngine("IVF_FLAT").Name())
+
+	mgr.Unregister("HNSW")
+	assert.Equal(t, "knowhere", mgr.GetEngine("HNSW").Name())
+
+	assert.NotNil(t, GetIndexEngineMgrInstance())
+}
+
+func TestIndexBuildTask_ExecuteWithEngine(t *testing.T) {
+	paramtable.Init()
+	const indexType = "FAKE_INDEX"
+	engine := &fakeIndexEngine{index: &indexcgowrapper.CgoIndex{}}
+	GetIndexEngineMgrInstance().Register(indexType, engine)
+	defer GetIndexEngineMgrInstance().Unregister(indexType)
+
+	cm := &mockChunkmgr{}
+	cm.mockFieldData(100, 8, 1, 2, 3)
+	req := &indexpb.CreateJobRequest{
+		ClusterID: "cluster",
+		BuildID:   10,
+		DataPaths: []string{dataPath(1, 2, 3)},
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: common.IndexTypeKey, Value: indexType},
+		},
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.DimKey, Value: "8"},
+		},
+	}
+	it := &indexBuildTask{
+		ctx:       context.Background(),
+		BuildID:   req.GetBuildID(),
+		ClusterID: req.GetClusterID(),
+		req:       req,
+		cm:        cm,
+		tr:        timerecord.NewTimeRecorder("test"),
+	}
+	require.NoError(t, it.Prepare(context.Background()))
+	require.NoError(t, it.Execute(context.Background()))
+
+	assert.Equal(t, engine.index, it.index)
+	require.NotNil(t, engine.bc)
+	assert.Equal(t, req, engine.bc.Req)
+	assert.Equal(t, UniqueID(3), engine.bc.SegmentID)
+	assert.Equal(t, UniqueID(vecFieldID), engine.bc.FieldID)
+	assert.Equal(t, schemapb.DataType_FloatVector, engine.bc.FieldType)
+	assert.Equal(t, indexType, engine.bc.IndexParams[common.IndexTypeKey])
+	assert.Equal(t, "8", engine.bc.TypeParams[common.DimKey])
+}
@@ -33,7 +33,6 @@ import (
 	"math/rand"
 	"os"
 	"path"
-	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -42,9 +41,11 @@ import (
 	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -53,11 +54,11 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
-	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -81,7 +82,7 @@ type taskKey struct {
 
 // IndexNode is a component that executes the task of building indexes.
 type IndexNode struct {
-	lifetime lifetime.Lifetime[commonpb.StateCode]
+	lifetime *lifetime.StateMachine
 
 	loopCtx    context.Context
 	loopCancel func()
@@ -93,14 +94,26 @@ type IndexNode struct {
 
 	factory        dependency.Factory
 	storageFactory StorageFactory
+	storageHealth  *storageHealthChecker
+	jobLimiter     *ratelimitutil.HierarchicalLimiter
+	tempDirs       *tempDirManager
 	session        *sessionutil.Session
+	healthReporter *sessionutil.HealthReporter
 
 	etcdCli *clientv3.Client
 	address string
 
-	initOnce  sync.Once
+	remoteBuildConn       *grpc.ClientConn
+	remoteBuildIndexTypes []string
+
+	// simdType is the SIMD instruction set picked by knowhere
+	simdType   string
+	gpuEnabled bool
+
+	initOnce sync.Once
+	// stateLock guards the fields of the task infos
 	stateLock sync.Mutex
-	tasks     map[taskKey]*taskInfo
+	tasks     *typeutil.ConcurrentMap[taskKey, *taskInfo]
 }
 
 // NewIndexNode creates a new IndexNode component.
@@ -113,9 +126,18 @@ func NewIndexNode(ctx context.Context, factory dependency.Factory) *IndexNode {
 		loopCancel:     cancel,
 		factory:        factory,
 		storageFactory: NewChunkMgrFactory(),
-		tasks:          map[taskKey]*taskInfo{},
-		lifetime:       lifetime.NewLifetime(commonpb.StateCode_Abnormal),
+		storageHealth:  newStorageHealthChecker(ctx1),
+		jobLimiter:     newJobLimiter(),
+		tempDirs:       newTempDirManager(tempDirRoot()),
+		tasks:          typeutil.NewConcurrentMap[taskKey, *taskInfo](),
+		lifetime:       lifetime.NewStateMachine(typeutil.IndexNodeRole),
 	}
+	b.lifetime.OnEnter(lifetime.Suspended, func(from, to lifetime.State) {
+		log.Info("IndexNode suspended, new jobs are rejected until resumed")
+	})
+	b.lifetime.OnExit(lifetime.Suspended, func(from, to lifetime.State) {
+		log.Info("IndexNode left suspended", zap.Stringer("state", to))
+	})
 	sc := NewTaskScheduler(b.loopCtx)
 
 	b.sched = sc
@@ -151,8 +173,11 @@ func (i *IndexNode) initSegcore() {
 
 	// override index builder SIMD type
 	cSimdType := C.CString(Params.CommonCfg.SimdType.GetValue())
-	C.IndexBuilderSetSimdType(cSimdType)
+	cRealSimdType := C.IndexBuilderSetSimdType(cSimdType)
+	i.simdType = C.GoString(cRealSimdType)
+	C.free(unsafe.Pointer(cRealSimdType))
 	C.free(unsafe.Pointer(cSimdType))
+	i.gpuEnabled = bool(C.IndexBuilderGpuEnabled())
 
 	// override segcore index slice size
 	cIndexSliceSize := C.int64_t(Params.CommonCfg.IndexSliceSize.GetAsInt64())
@@ -172,8 +197,8 @@ func (i *IndexNode) initSegcore() {
 	cKnowhereThreadPoolSize := C.uint32_t(hardware.GetCPUNum() * paramtable.DefaultKnowhereThreadPoolNumRatioInBuild)
 	C.SegcoreSetKnowhereBuildThreadPoolNum(cKnowhereThreadPoolSize)
 
-	localDataRootPath := filepath.Join(Params.LocalStorageCfg.Path.GetValue(), typeutil.IndexNodeRole)
-	initcore.InitLocalChunkManager(localDataRootPath)
+	initcore.InitLocalChunkManager(i.tempDirs.root)
+	initcore.InitStorageRateLimits(paramtable.Get())
 }
 
 func (i *IndexNode) CloseSegcore() {
@@ -193,8 +218,13 @@ func (i *IndexNode) initSession() error {
 func (i *IndexNode) Init() error {
 	var initErr error
 	i.initOnce.Do(func() {
-		i.UpdateStateCode(commonpb.StateCode_Initializing)
+		i.lifetime.SetState(lifetime.Initializing)
 		log.Info("IndexNode init", zap.String("state", i.lifetime.GetState().String()))
+		if err := Params.IndexNodeCfg.Validate(); err != nil {
+			log.Error("invalid index node configs", zap.Error(err))
+			initErr = err
+			return
+		}
 		err := i.initSession()
 		if err != nil {
 			log.Error("failed to init session", zap.Error(err))
@@ -204,6 +234,15 @@ func (i *IndexNode) Init() error {
 		log.Info("IndexNode init session successful", zap.Int64("serverID", i.session.ServerID))
 
 		i.initSegcore()
+		if Params.IndexNodeCfg.TempDirCleanOnStartup.GetAsBool() {
+			i.tempDirs.cleanOrphans()
+		}
+
+		if err := i.initRemoteBuild(); err != nil {
+			log.Error("failed to init remote build", zap.Error(err))
+			initErr = err
+			return
+		}
 	})
 
 	log.Info("Init IndexNode finished", zap.Error(initErr))
@@ -215,9 +254,16 @@ func (i *IndexNode) Init() error {
 func (i *IndexNode) Start() error {
 	var startErr error
 	i.once.Do(func() {
+		if i.etcdCli != nil {
+			i.sched.estimatorKV = etcdkv.NewEtcdKV(i.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
+		}
 		startErr = i.sched.Start()
+		i.storageHealth.start()
+		i.healthReporter = sessionutil.NewHealthReporter(i.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
+			typeutil.IndexNodeRole, i.session.ServerID, i.collectHealth)
+		i.healthReporter.Start()
 
-		i.UpdateStateCode(commonpb.StateCode_Healthy)
+		i.lifetime.SetState(lifetime.Healthy)
 		log.Info("IndexNode", zap.Any("State", i.lifetime.GetState().String()))
 	})
 
@@ -228,7 +274,7 @@ func (i *IndexNode) Start() error {
 // Stop closes the server.
 func (i *IndexNode) Stop() error {
 	i.stopOnce.Do(func() {
-		i.UpdateStateCode(commonpb.StateCode_Stopping)
+		i.lifetime.SetState(lifetime.Stopping)
 		log.Info("Index node stopping")
 		err := i.session.GoingStop()
 		if err != nil {
@@ -238,7 +284,7 @@ func (i *IndexNode) Stop() error {
 		}
 
 		// https://github.com/milvus-io/milvus/issues/12282
-		i.UpdateStateCode(commonpb.StateCode_Abnormal)
+		i.lifetime.SetState(lifetime.Stopped)
 		i.lifetime.Wait()
 		log.Info("Index node abnormal")
 		// cleanup all running tasks
@@ -252,19 +298,62 @@ func (i *IndexNode) Stop() error {
 		if i.sched != nil {
 			i.sched.Close()
 		}
+		if i.storageHealth != nil {
+			i.storageHealth.close()
+		}
+		if i.healthReporter != nil {
+			i.healthReporter.Stop()
+		}
 		if i.session != nil {
 			i.session.Stop()
 		}
 
+		i.closeRemoteBuild()
 		i.CloseSegcore()
 		log.Info("Index node stopped.")
 	})
 	return nil
 }
 
+// stateCode returns the component state code reported of the lifetime state, a suspended IndexNode is still healthy,
+// but reports no slots for new jobs.
+func stateCode(state lifetime.State) commonpb.StateCode {
+	switch state {
+	case lifetime.Initializing:
+		return commonpb.StateCode_Initializing
+	case lifetime.Healthy, lifetime.Suspended:
+		return commonpb.StateCode_Healthy
+	case lifetime.Stopping:
+		return commonpb.StateCode_Stopping
+	default:
+		return commonpb.StateCode_Abnormal
+	}
+}
+
 // UpdateStateCode updates the component state of IndexNode.
 func (i *IndexNode) UpdateStateCode(code commonpb.StateCode) {
-	i.lifetime.SetState(code)
+	switch code {
+	case commonpb.StateCode_Initializing:
+		i.lifetime.SetState(lifetime.Initializing)
+	case commonpb.StateCode_Healthy:
+		i.lifetime.SetState(lifetime.Healthy)
+	case commonpb.StateCode_Stopping:
+		i.lifetime.SetState(lifetime.Stopping)
+	case commonpb.StateCode_Abnormal:
+		i.lifetime.SetState(lifetime.Stopped)
+	default:
+		log.Warn("IndexNode ignores the unsupported state code", zap.String("stateCode", code.String()))
+	}
+}
+
+// Suspend stops IndexNode accepting new jobs, the existing jobs are still built and served.
+func (i *IndexNode) Suspend() error {
+	return i.lifetime.Transit(lifetime.Suspended)
+}
+
+// Resume makes the suspended IndexNode accept new jobs again.
+func (i *IndexNode) Resume() error {
+	return i.lifetime.Transit(lifetime.Healthy)
 }
 
 // SetEtcdClient assigns parameter client to its member etcdCli
@@ -283,7 +372,7 @@ func (i *IndexNode) GetComponentStates(ctx context.Context) (*milvuspb.Component
 		// NodeID:    Params.NodeID, // will race with i.Register()
 		NodeID:    nodeID,
 		Role:      typeutil.IndexNodeRole,
-		StateCode: i.lifetime.GetState(),
+		StateCode: stateCode(i.lifetime.GetState()),
 	}
 
 	ret := &milvuspb.ComponentStates{
@@ -322,7 +411,7 @@ func (i *IndexNode) GetNodeID() int64 {
 
 // ShowConfigurations returns the configurations of indexNode matching req.Pattern
 func (i *IndexNode) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
-	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
 		log.Warn("IndexNode.ShowConfigurations failed",
 			zap.Int64("nodeId", paramtable.GetNodeID()),
 			zap.String("req", req.Pattern),
@@ -337,6 +426,8 @@ func (i *IndexNode) ShowConfigurations(ctx context.Context, req *internalpb.Show
 		}, nil
 	}
 
+	defer i.lifetime.Done()
+
 	configList := make([]*commonpb.KeyValuePair, 0)
 	for key, value := range Params.GetComponentConfigurations("indexnode", req.Pattern) {
 		configList = append(configList,
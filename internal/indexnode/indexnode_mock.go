@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -53,6 +54,9 @@ type Mock struct {
 	CallDropJobs    func(ctx context.Context, in *indexpb.DropJobsRequest) (*commonpb.Status, error)
 	CallGetJobStats func(ctx context.Context, in *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
 
+	CallGetCapabilities func(ctx context.Context, in *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)
+	CallUpdateLogConfig func(ctx context.Context, in *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error)
+
 	CallGetMetrics         func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	CallShowConfigurations func(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 }
@@ -132,6 +136,20 @@ func NewIndexNodeMock() *Mock {
 				},
 			}, nil
 		},
+		CallGetCapabilities: func(ctx context.Context, in *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+			return &indexpb.GetCapabilitiesResponse{
+				Status:     merr.Status(nil),
+				IndexTypes: supportedIndexTypes(true, false, nil),
+				EnableDisk: true,
+				SimdType:   Params.CommonCfg.SimdType.GetValue(),
+			}, nil
+		},
+		CallUpdateLogConfig: func(ctx context.Context, in *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+			return &indexpb.UpdateLogConfigResponse{
+				Status: merr.Status(nil),
+				Level:  log.GetLevel().String(),
+			}, nil
+		},
 		CallGetMetrics: func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 			return getMockSystemInfoMetrics(ctx, req, nil)
 		},
@@ -197,6 +215,18 @@ func (m *Mock) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest)
 	return m.CallGetJobStats(ctx, req)
 }
 
+func (m *Mock) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	// behave as the nodes of the versions without capabilities if it's not mocked
+	if m.CallGetCapabilities == nil {
+		return nil, merr.WrapErrServiceUnavailable("GetCapabilities not implemented")
+	}
+	return m.CallGetCapabilities(ctx, req)
+}
+
+func (m *Mock) UpdateLogConfig(ctx context.Context, req *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+	return m.CallUpdateLogConfig(ctx, req)
+}
+
 func (m *Mock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.CallGetMetrics(ctx, req)
 }
@@ -19,6 +19,7 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/golang/protobuf/proto"
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -33,7 +35,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
-	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -42,7 +45,7 @@ import (
 )
 
 func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest) (*commonpb.Status, error) {
-	if !i.lifetime.Add(commonpbutil.IsHealthy) {
+	if !i.lifetime.Add(lifetime.AcceptsTasks) {
 		stateCode := i.lifetime.GetState()
 		log.Ctx(ctx).Warn("index node not ready",
 			zap.String("state", stateCode.String()),
@@ -52,8 +55,33 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		return merr.Status(merr.WrapErrServiceNotReady(stateCode.String())), nil
 	}
 	defer i.lifetime.Done()
+	if err := validateStorageConfig(req.GetStorageConfig()); err != nil {
+		log.Ctx(ctx).Warn("invalid storage config", zap.String("clusterID", req.GetClusterID()),
+			zap.Int64("indexBuildID", req.GetBuildID()), zap.Error(err))
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    "invalid storage config, error: " + err.Error(),
+		}, nil
+	}
+	if req.GetJobType() == indexpb.JobType_JobTypeStatsJob {
+		if err := validateStatsJob(req); err != nil {
+			log.Ctx(ctx).Warn("invalid stats job", zap.String("clusterID", req.GetClusterID()),
+				zap.Int64("jobID", req.GetBuildID()), zap.Error(err))
+			metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+			return merr.Status(err), nil
+		}
+	}
+	indexFileVersion, err := negotiateIndexFileVersion(req.GetReaderIndexVersion())
+	if err != nil {
+		log.Ctx(ctx).Warn("index file version not supported by query nodes", zap.String("clusterID", req.GetClusterID()),
+			zap.Int64("indexBuildID", req.GetBuildID()), zap.Int32("readerIndexVersion", req.GetReaderIndexVersion()), zap.Error(err))
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
 	log.Ctx(ctx).Info("IndexNode building index ...",
 		zap.String("clusterID", req.GetClusterID()),
+		zap.String("jobType", req.GetJobType().String()),
 		zap.Int64("indexBuildID", req.GetBuildID()),
 		zap.Int64("indexID", req.GetIndexID()),
 		zap.String("indexName", req.GetIndexName()),
@@ -63,21 +91,40 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 		zap.Any("typeParams", req.GetTypeParams()),
 		zap.Any("indexParams", req.GetIndexParams()),
 		zap.Int64("numRows", req.GetNumRows()),
+		zap.Int32("indexFileVersion", indexFileVersion),
 	)
 	ctx, sp := otel.Tracer(typeutil.IndexNodeRole).Start(ctx, "IndexNode-CreateIndex", trace.WithAttributes(
 		attribute.Int64("indexBuildID", req.GetBuildID()),
 		attribute.String("clusterID", req.GetClusterID()),
 	))
 	defer sp.End()
-	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.TotalLabel).Inc()
+	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), clusterIDLabel(req.GetClusterID()), metrics.TotalLabel).Inc()
+
+	if err := i.storageHealth.check(storageKey(req.GetStorageConfig())); err != nil {
+		log.Ctx(ctx).Warn("storage unreachable", zap.String("clusterID", req.GetClusterID()),
+			zap.Int64("indexBuildID", req.GetBuildID()), zap.Error(err))
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := i.checkJobRate(req.GetClusterID()); err != nil {
+		log.Ctx(ctx).Warn("job rate limited", zap.String("clusterID", req.GetClusterID()),
+			zap.Int64("indexBuildID", req.GetBuildID()), zap.Error(err))
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
 
 	taskCtx, taskCancel := context.WithCancel(i.loopCtx)
+	// the task runs after CreateJob returns, it's linked to the trace of CreateJob as a remote parent,
+	// so that the build latency exemplars refer to the trace
+	taskCtx = trace.ContextWithRemoteSpanContext(taskCtx, sp.SpanContext())
 	if oldInfo := i.loadOrStoreTask(req.GetClusterID(), req.GetBuildID(), &taskInfo{
-		cancel: taskCancel,
-		state:  commonpb.IndexState_InProgress,
+		cancel:  taskCancel,
+		jobType: req.GetJobType(),
+		state:   commonpb.IndexState_InProgress,
 	}); oldInfo != nil {
 		log.Ctx(ctx).Warn("duplicated index build task", zap.String("clusterID", req.GetClusterID()), zap.Int64("buildID", req.GetBuildID()))
-		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_BuildIndexError,
 			Reason:    "duplicated index build task",
@@ -86,40 +133,58 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 	cm, err := i.storageFactory.NewChunkManager(i.loopCtx, req.GetStorageConfig())
 	if err != nil {
 		log.Ctx(ctx).Error("create chunk manager failed", zap.String("bucket", req.GetStorageConfig().GetBucketName()),
-			zap.String("accessKey", req.GetStorageConfig().GetAccessKeyID()),
 			zap.String("clusterID", req.GetClusterID()), zap.Int64("indexBuildID", req.GetBuildID()),
 			zap.Error(err),
 		)
 		i.deleteTaskInfos(ctx, []taskKey{{ClusterID: req.GetClusterID(), BuildID: req.GetBuildID()}})
-		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_BuildIndexError,
 			Reason:    "create chunk manager failed, error: " + err.Error(),
 		}, nil
 	}
-	task := &indexBuildTask{
-		ident:          fmt.Sprintf("%s/%d", req.ClusterID, req.BuildID),
-		ctx:            taskCtx,
-		cancel:         taskCancel,
-		BuildID:        req.GetBuildID(),
-		ClusterID:      req.GetClusterID(),
-		node:           i,
-		req:            req,
-		cm:             cm,
-		nodeID:         i.GetNodeID(),
-		tr:             timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildID: %d, ClusterID: %s", req.BuildID, req.ClusterID)),
-		serializedSize: 0,
+	i.storageHealth.register(storageKey(req.GetStorageConfig()), cm)
+	if err := checkDataPaths(ctx, cm, req.GetDataPaths()); err != nil {
+		log.Ctx(ctx).Warn("check data paths failed", zap.String("clusterID", req.GetClusterID()),
+			zap.Int64("indexBuildID", req.GetBuildID()), zap.Error(err))
+		i.deleteTaskInfos(ctx, []taskKey{{ClusterID: req.GetClusterID(), BuildID: req.GetBuildID()}})
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_BuildIndexError,
+			Reason:    "check data paths failed, error: " + err.Error(),
+		}, nil
+	}
+	var task task
+	switch req.GetJobType() {
+	case indexpb.JobType_JobTypeStatsJob:
+		task = newStatsTask(taskCtx, taskCancel, req, cm, i)
+	default:
+		task = &indexBuildTask{
+			ident:          fmt.Sprintf("%s/%d", req.ClusterID, req.BuildID),
+			ctx:            taskCtx,
+			cancel:         taskCancel,
+			BuildID:        req.GetBuildID(),
+			ClusterID:      req.GetClusterID(),
+			node:           i,
+			req:            req,
+			cm:             cm,
+			nodeID:         i.GetNodeID(),
+			tr:             timerecord.NewTimeRecorderWithCheckpoints(fmt.Sprintf("IndexBuildID: %d, ClusterID: %s", req.BuildID, req.ClusterID)),
+			serializedSize: 0,
+
+			indexFileVersion: indexFileVersion,
+		}
 	}
 	ret := merr.Status(nil)
-	if err := i.sched.IndexBuildQueue.Enqueue(task); err != nil {
+	if err := i.sched.Enqueue(task); err != nil {
 		log.Ctx(ctx).Warn("IndexNode failed to schedule", zap.Int64("indexBuildID", req.GetBuildID()),
 			zap.String("clusterID", req.GetClusterID()), zap.Error(err))
 		ret.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		ret.Reason = err.Error()
-		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.FailLabel).Inc()
+		metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), clusterIDLabel(req.GetClusterID()), metrics.FailLabel).Inc()
 		return ret, nil
 	}
-	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SuccessLabel).Inc()
+	metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(req.GetClusterID()), metrics.SuccessLabel).Inc()
 	log.Ctx(ctx).Info("IndexNode successfully scheduled", zap.Int64("indexBuildID", req.GetBuildID()),
 		zap.String("clusterID", req.GetClusterID()), zap.String("indexName", req.GetIndexName()))
 	return ret, nil
@@ -129,7 +194,7 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 	log := log.Ctx(ctx).With(
 		zap.String("clusterID", req.GetClusterID()),
 	).WithRateGroup("in.queryJobs", 1, 60)
-	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
 		stateCode := i.lifetime.GetState()
 		log.Warn("index node not ready", zap.String("state", stateCode.String()))
 		return &indexpb.QueryJobsResponse{
@@ -141,11 +206,15 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 		if ClusterID == req.GetClusterID() {
 			infos[buildID] = &taskInfo{
+				jobType:        info.jobType,
 				state:          info.state,
 				fileKeys:       common.CloneStringList(info.fileKeys),
 				serializedSize: info.serializedSize,
 				failReason:     info.failReason,
 			}
+			if info.statsResult != nil {
+				infos[buildID].statsResult = proto.Clone(info.statsResult).(*indexpb.StatsJobResult)
+			}
 		}
 	})
 	ret := &indexpb.QueryJobsResponse{
@@ -165,6 +234,8 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 			ret.IndexInfos[i].IndexFileKeys = info.fileKeys
 			ret.IndexInfos[i].SerializedSize = info.serializedSize
 			ret.IndexInfos[i].FailReason = info.failReason
+			ret.IndexInfos[i].JobType = info.jobType
+			ret.IndexInfos[i].StatsResult = info.statsResult
 			log.RatedDebug(5, "querying index build task",
 				zap.Int64("indexBuildID", buildID),
 				zap.String("state", info.state.String()),
@@ -180,7 +251,7 @@ func (i *IndexNode) DropJobs(ctx context.Context, req *indexpb.DropJobsRequest)
 		zap.String("clusterID", req.ClusterID),
 		zap.Int64s("indexBuildIDs", req.BuildIDs),
 	)
-	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
 		stateCode := i.lifetime.GetState()
 		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()), zap.String("clusterID", req.ClusterID))
 		return merr.Status(merr.WrapErrServiceNotReady(stateCode.String())), nil
@@ -202,7 +273,7 @@ func (i *IndexNode) DropJobs(ctx context.Context, req *indexpb.DropJobsRequest)
 }
 
 func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error) {
-	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
 		stateCode := i.lifetime.GetState()
 		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
 		return &indexpb.GetJobStatsResponse{
@@ -210,21 +281,27 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 		}, nil
 	}
 	defer i.lifetime.Done()
-	unissued, active := i.sched.IndexBuildQueue.GetTaskNum()
+	unissued, active := i.sched.GetTaskNum()
 	jobInfos := make([]*indexpb.JobInfo, 0)
 	i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 		if info.statistic != nil {
 			jobInfos = append(jobInfos, proto.Clone(info.statistic).(*indexpb.JobInfo))
 		}
 	})
+	// a suspended IndexNode has no slots for the new jobs
 	slots := 0
-	if i.sched.buildParallel > unissued+active {
-		slots = i.sched.buildParallel - unissued - active
+	if lifetime.AcceptsTasks(i.lifetime.GetState()) {
+		slots = i.sched.freeSlots()
 	}
+	load := i.loadOf()
 	log.Ctx(ctx).Info("Get Index Job Stats",
 		zap.Int("unissued", unissued),
 		zap.Int("active", active),
 		zap.Int("slot", slots),
+		zap.Float64("memoryWatermark", load.memoryWatermark),
+		zap.Float64("diskWatermark", load.diskWatermark),
+		zap.Duration("queueWaitP99", load.queueWaitP99),
+		zap.Bool("throttled", load.throttled),
 	)
 	return &indexpb.GetJobStatsResponse{
 		Status:           merr.Status(nil),
@@ -234,13 +311,92 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 		TaskSlots:        int64(slots),
 		JobInfos:         jobInfos,
 		EnableDisk:       Params.IndexNodeCfg.EnableDisk.GetAsBool(),
+		MemoryWatermark:  load.memoryWatermark,
+		DiskWatermark:    load.diskWatermark,
+		QueueWaitP99:     load.queueWaitP99.Milliseconds(),
+		Throttled:        load.throttled,
+	}, nil
+}
+
+// GetCapabilities returns the capabilities of IndexNode, so that the coordinator assigns the jobs to the nodes
+// able to build them, e.g. the disk indexes to the nodes with local disk.
+func (i *IndexNode) GetCapabilities(ctx context.Context, req *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
+		stateCode := i.lifetime.GetState()
+		log.Ctx(ctx).Warn("index node not ready", zap.String("state", stateCode.String()))
+		return &indexpb.GetCapabilitiesResponse{
+			Status: merr.Status(merr.WrapErrServiceNotReady(stateCode.String())),
+		}, nil
+	}
+	defer i.lifetime.Done()
+
+	enableDisk := Params.IndexNodeCfg.EnableDisk.GetAsBool()
+	var diskCapacity int64
+	if enableDisk {
+		diskCapacity = int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+	}
+	engines := GetIndexEngineMgrInstance().Engines()
+	return &indexpb.GetCapabilitiesResponse{
+		Status:       merr.Status(nil),
+		IndexTypes:   supportedIndexTypes(enableDisk, i.gpuEnabled, engines),
+		EnableDisk:   enableDisk,
+		DiskCapacity: diskCapacity,
+		GpuEnabled:   i.gpuEnabled,
+		SimdType:     i.simdType,
+		IndexEngines: funcutil.Map2KeyValuePair(engines),
+		BuildVersion: os.Getenv(metricsinfo.GitBuildTagsEnvKey),
+	}, nil
+}
+
+// UpdateLogConfig changes the log level and the rate groups of the rated logs at runtime, so that a stuck build
+// can be debugged without restarting the node. It's served in any state, the empty request returns the current config.
+func (i *IndexNode) UpdateLogConfig(ctx context.Context, req *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+	if req.GetLevel() != "" {
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(req.GetLevel())); err != nil {
+			log.Ctx(ctx).Warn("invalid log level", zap.String("level", req.GetLevel()), zap.Error(err))
+			return &indexpb.UpdateLogConfigResponse{
+				Status: merr.Status(merr.WrapErrParameterInvalid("debug, info, warn, error...", req.GetLevel(), "invalid log level")),
+			}, nil
+		}
+		log.SetLevel(level)
+		log.Ctx(ctx).Info("log level updated", zap.String("level", level.String()))
+	}
+	for _, group := range req.GetRateGroups() {
+		if err := log.UpdateRateGroup(log.RateGroup{
+			Name:            group.GetName(),
+			CreditPerSecond: group.GetCreditPerSecond(),
+			MaxBalance:      group.GetMaxBalance(),
+		}); err != nil {
+			log.Ctx(ctx).Warn("invalid log rate group", zap.Error(err))
+			return &indexpb.UpdateLogConfigResponse{
+				Status: merr.Status(merr.WrapErrParameterInvalidMsg("%s", err.Error())),
+			}, nil
+		}
+		log.Ctx(ctx).Info("log rate group updated", zap.String("name", group.GetName()),
+			zap.Float64("creditPerSecond", group.GetCreditPerSecond()), zap.Float64("maxBalance", group.GetMaxBalance()))
+	}
+
+	groups := log.RateGroups()
+	rateGroups := make([]*indexpb.RateGroup, 0, len(groups))
+	for _, group := range groups {
+		rateGroups = append(rateGroups, &indexpb.RateGroup{
+			Name:            group.Name,
+			CreditPerSecond: group.CreditPerSecond,
+			MaxBalance:      group.MaxBalance,
+		})
+	}
+	return &indexpb.UpdateLogConfigResponse{
+		Status:     merr.Status(nil),
+		Level:      log.GetLevel().String(),
+		RateGroups: rateGroups,
 	}, nil
 }
 
 // GetMetrics gets the metrics info of IndexNode.
 // TODO(dragondriver): cache the Metrics and set a retention to the cache
 func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	if !i.lifetime.Add(commonpbutil.IsHealthyOrStopping) {
+	if !i.lifetime.Add(lifetime.AcceptsRequests) {
 		log.Ctx(ctx).Warn("IndexNode.GetMetrics failed",
 			zap.Int64("nodeID", paramtable.GetNodeID()),
 			zap.String("req", req.GetRequest()),
@@ -281,6 +437,18 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.SlowLogMetrics {
+		return getSlowLogMetrics(), nil
+	}
+
+	if metricType == metricsinfo.ConfigChangesMetrics {
+		return getConfigChangesMetrics(metricsinfo.ParseMetricParam(req.GetRequest(), metricsinfo.ConfigKeyPrefixKey)), nil
+	}
+
+	if metricType == metricsinfo.GoroutineSnapshotMetrics || metricType == metricsinfo.HeapSnapshotMetrics {
+		return getProfileSnapshotMetrics(metricType, metricsinfo.ParseMetricParam(req.GetRequest(), metricsinfo.SnapshotBuildIDKey)), nil
+	}
+
 	log.Ctx(ctx).RatedWarn(60, "IndexNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.GetRequest()),
@@ -17,17 +17,32 @@
 package indexnode
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"io"
+	"runtime/pprof"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
 )
 
 func TestAbnormalIndexNode(t *testing.T) {
@@ -51,6 +66,10 @@ func TestAbnormalIndexNode(t *testing.T) {
 	assert.NoError(t, err)
 	assert.ErrorIs(t, merr.Error(jobNumRsp.GetStatus()), merr.ErrServiceNotReady)
 
+	capabilitiesResp, err := in.GetCapabilities(ctx, &indexpb.GetCapabilitiesRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(capabilitiesResp.GetStatus()), merr.ErrServiceNotReady)
+
 	metricsResp, err := in.GetMetrics(ctx, &milvuspb.GetMetricsRequest{})
 	assert.NoError(t, err)
 	assert.Equal(t, metricsResp.GetStatus().GetErrorCode(), commonpb.ErrorCode_UnexpectedError)
@@ -60,6 +79,40 @@ func TestAbnormalIndexNode(t *testing.T) {
 	assert.Equal(t, configurationResp.GetStatus().GetErrorCode(), commonpb.ErrorCode_UnexpectedError)
 }
 
+func TestSuspendedIndexNode(t *testing.T) {
+	ctx := context.TODO()
+	in, err := NewMockIndexNodeComponent(ctx)
+	assert.NoError(t, err)
+	defer in.Stop()
+	node := in.(*mockIndexNodeComponent).IndexNode
+
+	assert.NoError(t, node.Suspend())
+	status, err := in.CreateJob(ctx, &indexpb.CreateJobRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+
+	// the existing jobs are still served, but no slots for the new ones
+	jobNumRsp, err := in.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(jobNumRsp.GetStatus()))
+	assert.EqualValues(t, 0, jobNumRsp.GetTaskSlots())
+	qresp, err := in.QueryJobs(ctx, &indexpb.QueryJobsRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(qresp.GetStatus()))
+	states, err := in.GetComponentStates(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.StateCode_Healthy, states.GetState().GetStateCode())
+
+	assert.NoError(t, node.Resume())
+	jobNumRsp, err = in.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
+	assert.NoError(t, err)
+	assert.Less(t, int64(0), jobNumRsp.GetTaskSlots())
+
+	// not suspended once stopping
+	node.UpdateStateCode(commonpb.StateCode_Stopping)
+	assert.Error(t, node.Suspend())
+}
+
 func TestGetMetrics(t *testing.T) {
 	var (
 		ctx          = context.TODO()
@@ -74,6 +127,132 @@ func TestGetMetrics(t *testing.T) {
 	t.Logf("Component: %s, Metrics: %s", resp.ComponentName, resp.Response)
 }
 
+func TestGetSlowLogMetrics(t *testing.T) {
+	ctx := context.TODO()
+	in, err := NewMockIndexNodeComponent(ctx)
+	assert.NoError(t, err)
+	defer in.Stop()
+
+	slowlog.Record(ctx, slowlog.KindBuildPhase, "execute", time.Hour, slowlog.F("buildID", "1"))
+	metricReq, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SlowLogMetrics)
+	assert.NoError(t, err)
+	resp, err := in.GetMetrics(ctx, metricReq)
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+
+	var entries []slowlog.Entry
+	assert.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &entries))
+	assert.NotEmpty(t, entries)
+	last := entries[len(entries)-1]
+	assert.Equal(t, slowlog.KindBuildPhase, last.Kind)
+	assert.Equal(t, "execute", last.Op)
+	assert.Equal(t, "1", last.Fields["buildID"])
+}
+
+func TestGetConfigChangesMetrics(t *testing.T) {
+	ctx := context.TODO()
+	in, err := NewMockIndexNodeComponent(ctx)
+	assert.NoError(t, err)
+	defer in.Stop()
+
+	params := paramtable.Get()
+	params.Save(Params.IndexNodeCfg.TaskWaitSLO.Key, "60")
+	defer params.Reset(Params.IndexNodeCfg.TaskWaitSLO.Key)
+
+	resp, err := in.GetMetrics(ctx, &milvuspb.GetMetricsRequest{
+		Request: `{"metric_type": "config_changes", "key_prefix": "indexNode.scheduler.waitSLO"}`,
+	})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	var changes []config.ChangeRecord
+	assert.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &changes))
+	assert.NotEmpty(t, changes)
+	last := changes[len(changes)-1]
+	assert.Equal(t, Params.IndexNodeCfg.TaskWaitSLO.Key, last.Key)
+	assert.Equal(t, config.RuntimeSource, last.Source)
+	assert.Equal(t, "60", last.NewValue)
+}
+
+func TestGetProfileSnapshotMetrics(t *testing.T) {
+	ctx := context.TODO()
+	in, err := NewMockIndexNodeComponent(ctx)
+	assert.NoError(t, err)
+	defer in.Stop()
+
+	started, done := make(chan struct{}), make(chan struct{})
+	go pprof.Do(ctx, pprof.Labels(profileLabelBuildID, "10"), func(context.Context) {
+		close(started)
+		<-done
+	})
+	defer close(done)
+	<-started
+
+	snapshot := func(request string) metricsinfo.ProfileSnapshot {
+		resp, err := in.GetMetrics(ctx, &milvuspb.GetMetricsRequest{Request: request})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		var snapshot metricsinfo.ProfileSnapshot
+		assert.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), &snapshot))
+		return snapshot
+	}
+	decompress := func(profile []byte) string {
+		r, err := gzip.NewReader(bytes.NewReader(profile))
+		assert.NoError(t, err)
+		raw, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		return string(raw)
+	}
+
+	goroutines := snapshot(`{"metric_type": "goroutine_snapshot", "build_id": "10"}`)
+	assert.Equal(t, metricsinfo.GoroutineSnapshotMetrics, goroutines.Type)
+	assert.Equal(t, 1, goroutines.Goroutines)
+	raw := decompress(goroutines.Profile)
+	assert.Len(t, raw, goroutines.RawSize)
+	assert.Contains(t, raw, `"buildID":"10"`)
+
+	heap := snapshot(`{"metric_type": "heap_snapshot"}`)
+	assert.Equal(t, metricsinfo.HeapSnapshotMetrics, heap.Type)
+	assert.NotZero(t, heap.HeapAlloc)
+	assert.Contains(t, decompress(heap.Profile), "heap profile")
+}
+
+func TestUpdateLogConfig(t *testing.T) {
+	ctx := context.TODO()
+	in := &IndexNode{}
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+
+	resp, err := in.UpdateLogConfig(ctx, &indexpb.UpdateLogConfigRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	assert.Equal(t, level.String(), resp.GetLevel())
+
+	resp, err = in.UpdateLogConfig(ctx, &indexpb.UpdateLogConfigRequest{
+		Level:      "debug",
+		RateGroups: []*indexpb.RateGroup{{Name: "indexnode.test", CreditPerSecond: 10, MaxBalance: 100}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	assert.Equal(t, "debug", resp.GetLevel())
+	assert.Equal(t, zapcore.DebugLevel, log.GetLevel())
+	group, ok := lo.Find(resp.GetRateGroups(), func(group *indexpb.RateGroup) bool {
+		return group.GetName() == "indexnode.test"
+	})
+	assert.True(t, ok)
+	assert.Equal(t, float64(10), group.GetCreditPerSecond())
+	assert.Equal(t, float64(100), group.GetMaxBalance())
+
+	resp, err = in.UpdateLogConfig(ctx, &indexpb.UpdateLogConfigRequest{Level: "invalid"})
+	assert.NoError(t, err)
+	assert.False(t, merr.Ok(resp.GetStatus()))
+
+	resp, err = in.UpdateLogConfig(ctx, &indexpb.UpdateLogConfigRequest{
+		RateGroups: []*indexpb.RateGroup{{Name: "indexnode.test", CreditPerSecond: -1, MaxBalance: 100}},
+	})
+	assert.NoError(t, err)
+	assert.False(t, merr.Ok(resp.GetStatus()))
+}
+
 func TestGetMetricsError(t *testing.T) {
 	ctx := context.TODO()
 
@@ -96,8 +275,64 @@ func TestGetMetricsError(t *testing.T) {
 	assert.Equal(t, resp.GetStatus().GetReason(), metricsinfo.MsgUnimplementedMetric)
 }
 
+func TestGetCapabilities(t *testing.T) {
+	ctx := context.TODO()
+	in, err := NewMockIndexNodeComponent(ctx)
+	assert.NoError(t, err)
+	defer in.Stop()
+
+	resp, err := in.GetCapabilities(ctx, &indexpb.GetCapabilitiesRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	assert.Equal(t, Params.IndexNodeCfg.EnableDisk.GetAsBool(), resp.GetEnableDisk())
+	assert.Equal(t, resp.GetEnableDisk(), lo.Contains(resp.GetIndexTypes(), indexparamcheck.IndexDISKANN))
+	assert.Equal(t, resp.GetEnableDisk(), resp.GetDiskCapacity() > 0)
+	assert.Contains(t, resp.GetIndexTypes(), indexparamcheck.IndexHNSW)
+	assert.NotEmpty(t, resp.GetSimdType())
+}
+
+func TestSupportedIndexTypes(t *testing.T) {
+	indexTypes := supportedIndexTypes(false, false, nil)
+	assert.Equal(t, cpuIndexTypes, indexTypes)
+
+	indexTypes = supportedIndexTypes(true, false, map[string]string{indexparamcheck.IndexRaftIvfPQ: "remote"})
+	assert.Contains(t, indexTypes, indexparamcheck.IndexDISKANN)
+	assert.Contains(t, indexTypes, indexparamcheck.IndexRaftIvfPQ)
+	assert.NotContains(t, indexTypes, indexparamcheck.IndexRaftIvfFlat)
+
+	indexTypes = supportedIndexTypes(false, true, nil)
+	assert.NotContains(t, indexTypes, indexparamcheck.IndexDISKANN)
+	assert.Contains(t, indexTypes, indexparamcheck.IndexRaftIvfFlat)
+	assert.Contains(t, indexTypes, indexparamcheck.IndexRaftIvfPQ)
+}
+
+func TestNegotiateIndexFileVersion(t *testing.T) {
+	version, err := negotiateIndexFileVersion(0)
+	assert.NoError(t, err)
+	assert.Equal(t, common.CurrentIndexFileVersion, version)
+
+	version, err = negotiateIndexFileVersion(common.IndexFileVersionPlain)
+	assert.NoError(t, err)
+	assert.Equal(t, common.IndexFileVersionPlain, version)
+
+	version, err = negotiateIndexFileVersion(common.CurrentIndexFileVersion + 1)
+	assert.NoError(t, err)
+	assert.Equal(t, common.CurrentIndexFileVersion, version)
+}
+
 func TestMockFieldData(t *testing.T) {
 	chunkMgr := NewMockChunkManager()
 
 	chunkMgr.mockFieldData(100000, 8, 0, 0, 1)
 }
+
+func TestCheckDataPaths(t *testing.T) {
+	ctx := context.TODO()
+	cm := &mockChunkmgr{}
+	cm.segmentData.Store("a", &storage.Blob{})
+	cm.segmentData.Store("b", &storage.Blob{})
+
+	assert.NoError(t, checkDataPaths(ctx, cm, []string{"a", "b"}))
+	err := checkDataPaths(ctx, cm, []string{"a", "b", "c"})
+	assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+}
@@ -0,0 +1,44 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+// newJobLimiter creates the limiter of the jobs created on the node, the node bucket is shared by all the clusters.
+func newJobLimiter() *ratelimitutil.HierarchicalLimiter {
+	return ratelimitutil.NewHierarchicalLimiter("indexnode_create_job", "node", "cluster")
+}
+
+// checkJobRate rejects the job if the jobs created on the node or by the cluster exceed the rate,
+// the rates are refreshed from the params, so they're updated without restart.
+func (i *IndexNode) checkJobRate(clusterID string) error {
+	params := paramtable.Get()
+	i.jobLimiter.SetLevelLimit(0, ratelimitutil.Limit(params.IndexNodeCfg.JobRateLimitNode.GetAsFloat()))
+	i.jobLimiter.SetLevelLimit(1, ratelimitutil.Limit(params.IndexNodeCfg.JobRateLimitCluster.GetAsFloat()))
+	if !i.jobLimiter.AllowN(time.Now(), 1, clusterID) {
+		return errors.Wrapf(merr.ErrServiceRateLimit, "too many jobs created on the node or by cluster %s", clusterID)
+	}
+	return nil
+}
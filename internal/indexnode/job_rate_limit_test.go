@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCheckJobRate(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	node := &IndexNode{jobLimiter: newJobLimiter()}
+
+	// unlimited by default
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, node.checkJobRate("c1"))
+	}
+
+	// the bucket is allowed to go into debt by a job, so the third job in a second is rejected
+	params.Save(Params.IndexNodeCfg.JobRateLimitCluster.Key, "1")
+	defer params.Reset(Params.IndexNodeCfg.JobRateLimitCluster.Key)
+	assert.NoError(t, node.checkJobRate("c1"))
+	assert.NoError(t, node.checkJobRate("c1"))
+	err := node.checkJobRate("c1")
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	assert.True(t, merr.IsRetriable(err))
+	// the other clusters aren't affected
+	assert.NoError(t, node.checkJobRate("c2"))
+
+	// the node bucket is shared by all the clusters
+	params.Reset(Params.IndexNodeCfg.JobRateLimitCluster.Key)
+	params.Save(Params.IndexNodeCfg.JobRateLimitNode.Key, "1")
+	defer params.Reset(Params.IndexNodeCfg.JobRateLimitNode.Key)
+	assert.NoError(t, node.checkJobRate("c1"))
+	assert.NoError(t, node.checkJobRate("c2"))
+	assert.ErrorIs(t, node.checkJobRate("c3"), merr.ErrServiceRateLimit)
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// the actions of the lifetime endpoint
+const (
+	lifetimeActionSuspend = "suspend"
+	lifetimeActionResume  = "resume"
+)
+
+type lifetimeResponse struct {
+	State          string  `json:"state"`
+	SecondsInState float64 `json:"seconds_in_state"`
+}
+
+// LifetimeHandler serves the lifetime of IndexNode, GET returns current state and how long IndexNode has been in it,
+// POST with the query parameter action=suspend stops IndexNode accepting new jobs, and action=resume accepts them again.
+func (i *IndexNode) LifetimeHandler() http.Handler {
+	return http.HandlerFunc(i.serveLifetime)
+}
+
+func (i *IndexNode) serveLifetime(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var err error
+		switch action := req.URL.Query().Get("action"); action {
+		case lifetimeActionSuspend:
+			err = i.Suspend()
+		case lifetimeActionResume:
+			err = i.Resume()
+		default:
+			http.Error(w, fmt.Sprintf("unknown action %q, should be %s or %s", action, lifetimeActionSuspend, lifetimeActionResume), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		log.Info("IndexNode lifetime changed by the management api", zap.String("state", i.lifetime.GetState().String()))
+	default:
+		http.Error(w, fmt.Sprintf("method %s not allowed", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(lifetimeResponse{
+		State:          i.lifetime.GetState().String(),
+		SecondsInState: i.lifetime.TimeInState().Seconds(),
+	})
+}
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/lifetime"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestLifetimeHandler(t *testing.T) {
+	paramtable.Init()
+	in := NewIndexNode(context.TODO(), &mockFactory{chunkMgr: &mockChunkmgr{}})
+	handler := in.LifetimeHandler()
+
+	serve := func(method, target string) (int, lifetimeResponse) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		var resp lifetimeResponse
+		if w.Code == http.StatusOK {
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w.Code, resp
+	}
+
+	code, resp := serve(http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, lifetime.Stopped.String(), resp.State)
+
+	// a stopped IndexNode can't be suspended
+	code, _ = serve(http.MethodPost, "/?action=suspend")
+	assert.Equal(t, http.StatusConflict, code)
+
+	in.UpdateStateCode(commonpb.StateCode_Initializing)
+	in.UpdateStateCode(commonpb.StateCode_Healthy)
+	code, resp = serve(http.MethodPost, "/?action=suspend")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, lifetime.Suspended.String(), resp.State)
+	assert.False(t, in.lifetime.Add(lifetime.AcceptsTasks))
+
+	code, resp = serve(http.MethodPost, "/?action=resume")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, lifetime.Healthy.String(), resp.State)
+
+	code, _ = serve(http.MethodPost, "/?action=restart")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = serve(http.MethodDelete, "/")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -17,14 +17,21 @@
 package indexnode
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"runtime"
+	"runtime/pprof"
+	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/util/watchdog"
 )
 
 // TODO(dragondriver): maybe IndexNode should be an interface so that we can mock it in the test cases
@@ -75,3 +82,107 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// getSlowLogMetrics returns the latest slow operations recorded by the node, from the oldest to the newest
+func getSlowLogMetrics() *milvuspb.GetMetricsResponse {
+	resp, err := metricsinfo.MarshalComponentInfos(slowlog.Tail(0))
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Status(nil),
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
+	}
+}
+
+// getConfigChangesMetrics returns the latest changes of the configs with the key prefix, from the oldest to the newest
+func getConfigChangesMetrics(prefix string) *milvuspb.GetMetricsResponse {
+	resp, err := metricsinfo.MarshalComponentInfos(paramtable.GetBaseTable().ConfigChanges(prefix))
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Status(nil),
+		Response:      resp,
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
+	}
+}
+
+// getProfileSnapshotMetrics returns the compressed goroutine dump or heap profile summary of the node,
+// the goroutine dump is filtered by the build if buildID isn't empty.
+func getProfileSnapshotMetrics(metricType string, buildID string) *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID())
+	snapshot, err := takeProfileSnapshot(metricType, buildID)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	snapshot.Name = componentName
+	resp, err := metricsinfo.MarshalComponentInfos(snapshot)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Status(nil),
+		Response:      resp,
+		ComponentName: componentName,
+	}
+}
+
+func takeProfileSnapshot(metricType string, buildID string) (*metricsinfo.ProfileSnapshot, error) {
+	snapshot := &metricsinfo.ProfileSnapshot{
+		Type:        metricType,
+		CreatedTime: time.Now().String(),
+	}
+	var raw bytes.Buffer
+	switch metricType {
+	case metricsinfo.GoroutineSnapshotMetrics:
+		if err := pprof.Lookup("goroutine").WriteTo(&raw, 1); err != nil {
+			return nil, err
+		}
+		snapshot.Goroutines = runtime.NumGoroutine()
+		if buildID != "" {
+			var filtered []byte
+			filtered, snapshot.Goroutines = watchdog.FilterGoroutineProfile(raw.Bytes(), profileLabelBuildID, buildID)
+			raw.Reset()
+			raw.Write(filtered)
+		}
+	case metricsinfo.HeapSnapshotMetrics:
+		// the heap profile of debug level 1 lists the allocation sites, followed by the runtime.MemStats
+		if err := pprof.Lookup("heap").WriteTo(&raw, 1); err != nil {
+			return nil, err
+		}
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		snapshot.HeapAlloc = stats.HeapAlloc
+		snapshot.HeapInuse = stats.HeapInuse
+		snapshot.HeapObjects = stats.HeapObjects
+		snapshot.NumGC = stats.NumGC
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unknown snapshot type %s", metricType)
+	}
+
+	snapshot.RawSize = raw.Len()
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	if _, err := w.Write(raw.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	snapshot.Profile = compressed.Bytes()
+	return snapshot, nil
+}
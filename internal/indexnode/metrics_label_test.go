@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestClusterIDLabel(t *testing.T) {
+	paramtable.Init()
+	assert.Equal(t, "", clusterIDLabel("c1"))
+
+	params := paramtable.Get()
+	params.Save(Params.IndexNodeCfg.MetricsClusterIDLabel.Key, "true")
+	defer params.Reset(Params.IndexNodeCfg.MetricsClusterIDLabel.Key)
+	params.Save(Params.IndexNodeCfg.MetricsLabelLimit.Key, "1")
+	defer params.Reset(Params.IndexNodeCfg.MetricsLabelLimit.Key)
+
+	assert.Equal(t, "c1", clusterIDLabel("c1"))
+	assert.Equal(t, metrics.OtherLabelValue, clusterIDLabel("c2"))
+}
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+)
+
+// waitWindowSize is the max number of the recent queue waits kept to compute the percentiles
+const waitWindowSize = 1024
+
+// waitWindowDuration is how long a queue wait is kept, so the waits decay once the node is idle
+// and a node throttled by the waits isn't throttled forever after it gets no new jobs.
+const waitWindowDuration = time.Minute
+
+type waitSample struct {
+	at   time.Time
+	wait time.Duration
+}
+
+// waitWindow keeps the time the recent jobs waited in the build queue.
+type waitWindow struct {
+	mu      sync.Mutex
+	samples []waitSample
+}
+
+func newWaitWindow() *waitWindow {
+	return &waitWindow{samples: make([]waitSample, 0, waitWindowSize)}
+}
+
+func (w *waitWindow) observe(wait time.Duration) {
+	w.observeAt(time.Now(), wait)
+}
+
+func (w *waitWindow) observeAt(now time.Time, wait time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.expire(now)
+	if len(w.samples) >= waitWindowSize {
+		w.samples = w.samples[1:]
+	}
+	w.samples = append(w.samples, waitSample{at: now, wait: wait})
+}
+
+// expire removes the waits older than waitWindowDuration, the samples are ordered by the time observed.
+func (w *waitWindow) expire(now time.Time) {
+	i := 0
+	for i < len(w.samples) && now.Sub(w.samples[i].at) > waitWindowDuration {
+		i++
+	}
+	if i > 0 {
+		w.samples = append(w.samples[:0], w.samples[i:]...)
+	}
+}
+
+// percentile returns the p-th (0 < p <= 1) percentile of the recent waits, 0 if no job waited recently.
+func (w *waitWindow) percentile(p float64) time.Duration {
+	return w.percentileAt(time.Now(), p)
+}
+
+func (w *waitWindow) percentileAt(now time.Time, p float64) time.Duration {
+	w.mu.Lock()
+	w.expire(now)
+	sorted := make([]time.Duration, 0, len(w.samples))
+	for _, sample := range w.samples {
+		sorted = append(sorted, sample.wait)
+	}
+	w.mu.Unlock()
+
+	if len(sorted) == 0 {
+		return 0
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(float64(len(sorted))*p+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// processMemoryRatio returns the ratio of the memory used by the process to the memory of the node or container,
+// 0 if it's unknown.
+func processMemoryRatio() float64 {
+	usage, err := hardware.GetProcessUsage()
+	if err != nil || usage.RSSBytes <= 0 {
+		return 0
+	}
+	total := hardware.GetMemoryCount()
+	if total == 0 {
+		return 0
+	}
+	return float64(usage.RSSBytes) / float64(total)
+}
+
+// nodeLoad is the load of the node reported to the coordinator by GetJobStats.
+type nodeLoad struct {
+	memoryWatermark float64
+	diskWatermark   float64
+	queueWaitP99    time.Duration
+	throttled       bool
+}
+
+// loadOf returns the current load of the node, the node is throttled if any of the memory and disk watermarks
+// reaches its threshold, or the jobs waited in the queue longer than the SLO. The queue wait is the larger one of
+// the p99 of the waits in the last minute and the wait of the oldest queued job, so it drops once the queue drains.
+func (i *IndexNode) loadOf() nodeLoad {
+	load := nodeLoad{
+		memoryWatermark: processMemoryRatio(),
+		diskWatermark:   i.tempDirs.watermark(),
+		queueWaitP99:    i.sched.waits.percentile(0.99),
+	}
+	if oldest := i.sched.IndexBuildQueue.oldestWait(); oldest > load.queueWaitP99 {
+		load.queueWaitP99 = oldest
+	}
+	if threshold := Params.IndexNodeCfg.ThrottleMemoryWatermark.GetAsFloat(); threshold > 0 && load.memoryWatermark >= threshold {
+		load.throttled = true
+	}
+	if threshold := Params.IndexNodeCfg.ThrottleDiskWatermark.GetAsFloat(); threshold > 0 && load.diskWatermark >= threshold {
+		load.throttled = true
+	}
+	if slo := Params.IndexNodeCfg.TaskWaitSLO.GetAsDuration(time.Second); slo > 0 && load.queueWaitP99 > slo {
+		load.throttled = true
+	}
+	return load
+}
+
+// collectHealth returns the health pushed to the coordinators, the queue wait is scored by its ratio to the SLO.
+func (i *IndexNode) collectHealth() *sessionutil.NodeHealth {
+	load := i.loadOf()
+	unissued, active := i.sched.IndexBuildQueue.GetTaskNum()
+	waitRatio := 0.0
+	if slo := Params.IndexNodeCfg.TaskWaitSLO.GetAsDuration(time.Second); slo > 0 {
+		waitRatio = float64(load.queueWaitP99) / float64(slo)
+	}
+	return &sessionutil.NodeHealth{
+		Score:           sessionutil.HealthScore(load.throttled, load.memoryWatermark, load.diskWatermark, waitRatio),
+		QueueDepth:      int64(unissued + active),
+		MemoryWatermark: load.memoryWatermark,
+		DiskWatermark:   load.diskWatermark,
+		Throttled:       load.throttled,
+	}
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestWaitWindow(t *testing.T) {
+	w := newWaitWindow()
+	assert.Equal(t, time.Duration(0), w.percentile(0.99))
+
+	for i := 1; i <= 100; i++ {
+		w.observe(time.Duration(i) * time.Second)
+	}
+	assert.Equal(t, 99*time.Second, w.percentile(0.99))
+	assert.Equal(t, 50*time.Second, w.percentile(0.5))
+
+	// the oldest waits are evicted
+	for i := 0; i < waitWindowSize; i++ {
+		w.observe(time.Second)
+	}
+	assert.Equal(t, time.Second, w.percentile(0.99))
+
+	// the waits decay once no job is started
+	now := time.Now()
+	w.observeAt(now, time.Hour)
+	assert.Equal(t, time.Hour, w.percentileAt(now, 1))
+	assert.Equal(t, time.Duration(0), w.percentileAt(now.Add(waitWindowDuration+time.Second), 1))
+}
+
+func TestIndexNodeLoad(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(Params.IndexNodeCfg.ThrottleMemoryWatermark.Key, "0")
+	defer params.Reset(Params.IndexNodeCfg.ThrottleMemoryWatermark.Key)
+	params.Save(Params.IndexNodeCfg.TempDirQuota.Key, "1")
+	defer params.Reset(Params.IndexNodeCfg.TempDirQuota.Key)
+	params.Save(Params.IndexNodeCfg.TaskWaitSLO.Key, "10")
+	defer params.Reset(Params.IndexNodeCfg.TaskWaitSLO.Key)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node := &IndexNode{
+		tempDirs: newTempDirManager(t.TempDir()),
+		sched:    NewTaskScheduler(ctx),
+	}
+	assert.False(t, node.loadOf().throttled)
+
+	// disk
+	dir, err := node.tempDirs.acquire("c/1", 1, 10, 100, 1024*1024)
+	require.NoError(t, err)
+	load := node.loadOf()
+	assert.Equal(t, 1.0, load.diskWatermark)
+	assert.True(t, load.throttled)
+	node.tempDirs.release(dir)
+	assert.False(t, node.loadOf().throttled)
+
+	// queue wait
+	node.sched.waits.observe(time.Minute)
+	load = node.loadOf()
+	assert.Equal(t, time.Minute, load.queueWaitP99)
+	assert.True(t, load.throttled)
+	node.sched.waits = newWaitWindow()
+	assert.False(t, node.loadOf().throttled)
+
+	// the oldest queued job throttles the node until it's started
+	queue := node.sched.IndexBuildQueue.(*IndexTaskQueue)
+	require.NoError(t, queue.addUnissuedTask(&fakeTask{id: 1, ctx: ctx}))
+	queue.waiting["fake-task-1"].enqueuedAt = time.Now().Add(-time.Minute)
+	load = node.loadOf()
+	assert.GreaterOrEqual(t, load.queueWaitP99, time.Minute)
+	assert.True(t, load.throttled)
+	assert.NotNil(t, queue.PopUnissuedTask())
+	node.sched.waits = newWaitWindow()
+	assert.False(t, node.loadOf().throttled)
+}
@@ -0,0 +1,308 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/tracer"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+var errRemoteIndexUnsupported = errors.New("operation is not supported by remote built index")
+
+// remoteEngine delegates the index builds to the external builder service, e.g. a shared GPU farm.
+// The builder gets no storage credentials: it reads the binlogs by the presigned data urls,
+// and writes the index files under the index file path prefix of the segment with its own credentials,
+// the files are verified by index node on UpLoad.
+type remoteEngine struct {
+	client indexpb.IndexBuilderClient
+}
+
+func newRemoteEngine(client indexpb.IndexBuilderClient) *remoteEngine {
+	return &remoteEngine{client: client}
+}
+
+func (e *remoteEngine) Name() string {
+	return "remote"
+}
+
+func (e *remoteEngine) Build(ctx context.Context, bc *BuildContext) (indexcgowrapper.CodecIndex, error) {
+	cm := bc.ChunkManager
+	// BuildSegmentIndexFilePath with an empty file key is the directory of the index files
+	resultPrefix := metautil.BuildSegmentIndexFilePath(cm.RootPath(), bc.Req.GetBuildID(), bc.Req.GetIndexVersion(),
+		bc.PartitionID, bc.SegmentID, "") + "/"
+	index := &remoteIndex{
+		ctx:          ctx,
+		cm:           cm,
+		bc:           bc,
+		resultPrefix: resultPrefix,
+	}
+
+	timeout := Params.IndexNodeCfg.RemoteBuildTimeout.GetAsDuration(time.Second)
+	job, dataURLs, err := remoteBuildJob(ctx, bc, timeout)
+	if err != nil {
+		return nil, err
+	}
+	buildCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := e.client.BuildIndex(buildCtx, &indexpb.RemoteBuildRequest{
+		Job:          job,
+		CollectionID: bc.CollectionID,
+		PartitionID:  bc.PartitionID,
+		SegmentID:    bc.SegmentID,
+		FieldID:      bc.FieldID,
+		IndexParams:  funcutil.Map2KeyValuePair(bc.IndexParams),
+		TypeParams:   funcutil.Map2KeyValuePair(bc.TypeParams),
+		ResultPrefix: resultPrefix,
+		DataUrls:     dataURLs,
+		ObjectTags:   funcutil.Map2KeyValuePair(bc.ObjectTags),
+	})
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("remote build failed", zap.String("resultPrefix", resultPrefix), zap.Error(err))
+		index.clean()
+		return nil, err
+	}
+
+	if err := validateRemoteIndexFiles(resultPrefix, resp.GetFiles()); err != nil {
+		log.Ctx(ctx).Warn("remote build returned invalid index files", zap.String("resultPrefix", resultPrefix), zap.Error(err))
+		index.clean()
+		return nil, err
+	}
+	index.files = resp.GetFiles()
+	log.Ctx(ctx).Info("remote build done", zap.String("resultPrefix", resultPrefix), zap.Int("files", len(index.files)))
+	return index, nil
+}
+
+// remoteBuildJob returns the job sent to the builder with the storage credentials and the encryption key removed,
+// and the presigned urls of the data paths, which expire with the build.
+func remoteBuildJob(ctx context.Context, bc *BuildContext, expiry time.Duration) (*indexpb.CreateJobRequest, []string, error) {
+	job := proto.Clone(bc.Req).(*indexpb.CreateJobRequest)
+	if config := job.GetStorageConfig(); config != nil {
+		// the index files written without the customer key can't be read by the other nodes
+		if config.GetSseType() == "SSE-C" {
+			return nil, nil, errors.New("remote build is not supported with SSE-C")
+		}
+		config.AccessKeyID = ""
+		config.SecretAccessKey = ""
+		config.SseKey = ""
+		config.CustomHeaders = nil
+	}
+	dataURLs := make([]string, 0, len(job.GetDataPaths()))
+	for _, dataPath := range job.GetDataPaths() {
+		url, err := bc.ChunkManager.PresignedURL(ctx, dataPath, expiry)
+		if err != nil {
+			return nil, nil, err
+		}
+		dataURLs = append(dataURLs, url)
+	}
+	return job, dataURLs, nil
+}
+
+// validateRemoteIndexFiles checks that the builder returned the index files directly under the result prefix with distinct names.
+func validateRemoteIndexFiles(resultPrefix string, files []*indexpb.RemoteIndexFile) error {
+	if len(files) == 0 {
+		return errors.New("no index file returned by the builder")
+	}
+	names := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		if path.Dir(file.GetPath())+"/" != resultPrefix {
+			return fmt.Errorf("index file %s is not under the result prefix %s", file.GetPath(), resultPrefix)
+		}
+		if file.GetSize() < 0 || file.GetSha256() == "" {
+			return fmt.Errorf("invalid size or sha256 of index file %s", file.GetPath())
+		}
+		name := path.Base(file.GetPath())
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("duplicate index file name %s", name)
+		}
+		names[name] = struct{}{}
+	}
+	return nil
+}
+
+// remoteIndex is the index built by the builder service, which is only uploaded and deleted by index node.
+type remoteIndex struct {
+	ctx          context.Context
+	cm           storage.ChunkManager
+	bc           *BuildContext
+	resultPrefix string
+	files        []*indexpb.RemoteIndexFile
+	uploaded     bool
+}
+
+var _ indexcgowrapper.CodecIndex = (*remoteIndex)(nil)
+
+func (index *remoteIndex) Build(*indexcgowrapper.Dataset) error {
+	return errRemoteIndexUnsupported
+}
+
+func (index *remoteIndex) Serialize() ([]*indexcgowrapper.Blob, error) {
+	return nil, errRemoteIndexUnsupported
+}
+
+func (index *remoteIndex) GetIndexFileInfo() ([]*indexcgowrapper.IndexFileInfo, error) {
+	return nil, errRemoteIndexUnsupported
+}
+
+func (index *remoteIndex) Load([]*indexcgowrapper.Blob) error {
+	return errRemoteIndexUnsupported
+}
+
+// UpLoad verifies the sizes and sha256 of the index files written by the builder,
+// the files are read as streams and stay where the builder wrote them.
+func (index *remoteIndex) UpLoad() (map[string]int64, error) {
+	filePath2Size := make(map[string]int64, len(index.files))
+	for _, file := range index.files {
+		if err := verifyRemoteIndexFile(index.ctx, index.cm, file); err != nil {
+			return nil, err
+		}
+		filePath2Size[file.GetPath()] = file.GetSize()
+	}
+	index.uploaded = true
+	return filePath2Size, nil
+}
+
+func verifyRemoteIndexFile(ctx context.Context, cm storage.ChunkManager, file *indexpb.RemoteIndexFile) error {
+	reader, err := cm.Reader(ctx, file.GetPath())
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, reader)
+	if err != nil {
+		return err
+	}
+	if size != file.GetSize() {
+		return fmt.Errorf("size of index file %s mismatch, expected %d, got %d", file.GetPath(), file.GetSize(), size)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != file.GetSha256() {
+		return fmt.Errorf("sha256 of index file %s mismatch", file.GetPath())
+	}
+	return nil
+}
+
+// Delete removes the index files written by the builder unless they're verified by UpLoad, it's idempotent.
+func (index *remoteIndex) Delete() error {
+	if index.uploaded {
+		return nil
+	}
+	return index.cm.RemoveWithPrefix(index.ctx, index.resultPrefix)
+}
+
+func (index *remoteIndex) clean() {
+	if err := index.Delete(); err != nil {
+		log.Ctx(index.ctx).Warn("failed to remove the index files written by the builder",
+			zap.String("resultPrefix", index.resultPrefix), zap.Error(err))
+	}
+}
+
+func (index *remoteIndex) CleanLocalData() error {
+	return nil
+}
+
+// initRemoteBuild registers the remote engine for the index types delegated to the builder service.
+func (i *IndexNode) initRemoteBuild() error {
+	if !Params.IndexNodeCfg.RemoteBuildEnabled.GetAsBool() {
+		return nil
+	}
+	address := Params.IndexNodeCfg.RemoteBuildAddress.GetValue()
+	if address == "" {
+		return errors.New("remote build is enabled without the address of the builder service")
+	}
+	tlsConfig, err := remoteBuildTLSConfig()
+	if err != nil {
+		return err
+	}
+	opts := tracer.GetInterceptorOpts()
+	conn, err := grpc.DialContext(i.loopCtx, address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor(opts...)),
+	)
+	if err != nil {
+		return err
+	}
+	i.remoteBuildConn = conn
+	i.remoteBuildIndexTypes = Params.IndexNodeCfg.RemoteBuildIndexTypes.GetAsStrings()
+
+	engine := newRemoteEngine(indexpb.NewIndexBuilderClient(conn))
+	for _, indexType := range i.remoteBuildIndexTypes {
+		GetIndexEngineMgrInstance().Register(indexType, engine)
+	}
+	log.Info("remote build enabled", zap.String("address", address), zap.Strings("indexTypes", i.remoteBuildIndexTypes))
+	return nil
+}
+
+// remoteBuildTLSConfig returns the TLS config to dial the builder service, the job carries the data urls
+// and the builder writes the index files, so the connection is always authenticated and encrypted.
+func remoteBuildTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caPemPath := Params.IndexNodeCfg.RemoteBuildCaPemPath.GetValue(); caPemPath != "" {
+		caPem, err := os.ReadFile(caPemPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CA certificate of the builder service")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no certificate found in %s", caPemPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if pemPath := Params.IndexNodeCfg.RemoteBuildPemPath.GetValue(); pemPath != "" {
+		cert, err := tls.LoadX509KeyPair(pemPath, Params.IndexNodeCfg.RemoteBuildKeyPath.GetValue())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the client certificate of remote build")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func (i *IndexNode) closeRemoteBuild() {
+	for _, indexType := range i.remoteBuildIndexTypes {
+		GetIndexEngineMgrInstance().Unregister(indexType)
+	}
+	if i.remoteBuildConn != nil {
+		i.remoteBuildConn.Close()
+	}
+}
@@ -0,0 +1,216 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type fakeIndexBuilder struct {
+	cm    storage.ChunkManager
+	req   *indexpb.RemoteBuildRequest
+	build func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error)
+}
+
+func (b *fakeIndexBuilder) BuildIndex(ctx context.Context, req *indexpb.RemoteBuildRequest, opts ...grpc.CallOption) (*indexpb.RemoteBuildResponse, error) {
+	b.req = req
+	return b.build(req)
+}
+
+// writeFiles writes the files under the result prefix like the builder service does.
+func (b *fakeIndexBuilder) writeFiles(ctx context.Context, req *indexpb.RemoteBuildRequest, files map[string][]byte) []*indexpb.RemoteIndexFile {
+	ret := make([]*indexpb.RemoteIndexFile, 0, len(files))
+	for name, data := range files {
+		filePath := path.Join(req.GetResultPrefix(), name)
+		if err := b.cm.Write(ctx, filePath, data); err != nil {
+			panic(err)
+		}
+		sum := sha256.Sum256(data)
+		ret = append(ret, &indexpb.RemoteIndexFile{
+			Path:   filePath,
+			Size:   int64(len(data)),
+			Sha256: hex.EncodeToString(sum[:]),
+		})
+	}
+	return ret
+}
+
+// presignChunkManager presigns fake urls for the local files.
+type presignChunkManager struct {
+	storage.ChunkManager
+}
+
+func (cm *presignChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	return "https://presigned/" + filePath, nil
+}
+
+func TestRemoteEngine(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cm := &presignChunkManager{storage.NewLocalChunkManager(storage.RootPath(rootPath))}
+	files := map[string][]byte{
+		"GPU_IVF_PQ_0": []byte("slice0"),
+		"GPU_IVF_PQ_1": []byte("slice1"),
+	}
+	bc := &BuildContext{
+		Req: &indexpb.CreateJobRequest{
+			ClusterID:    "cluster",
+			BuildID:      1000,
+			IndexVersion: 1,
+			DataPaths:    []string{"insert_log/1/10/100/101/1"},
+			StorageConfig: &indexpb.StorageConfig{
+				BucketName:      "bucket",
+				AccessKeyID:     "ak",
+				SecretAccessKey: "sk",
+				SseType:         "SSE-KMS",
+				SseKey:          "kms-key",
+			},
+		},
+		CollectionID: 1,
+		PartitionID:  10,
+		SegmentID:    100,
+		FieldID:      101,
+		IndexParams:  map[string]string{"index_type": "GPU_IVF_PQ"},
+		ObjectTags:   map[string]string{"cluster": "cluster"},
+		ChunkManager: cm,
+	}
+	filesExist := func(prefix string) bool {
+		keys, _, err := cm.ListWithPrefix(ctx, prefix, true)
+		require.NoError(t, err)
+		return len(keys) > 0
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		builder := &fakeIndexBuilder{cm: cm}
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			return &indexpb.RemoteBuildResponse{Status: merr.Status(nil), Files: builder.writeFiles(ctx, req, files)}, nil
+		}
+		index, err := newRemoteEngine(builder).Build(ctx, bc)
+		require.NoError(t, err)
+		job := builder.req.GetJob()
+		assert.Equal(t, int64(1000), job.GetBuildID())
+		assert.Empty(t, job.GetStorageConfig().GetAccessKeyID())
+		assert.Empty(t, job.GetStorageConfig().GetSecretAccessKey())
+		assert.Empty(t, job.GetStorageConfig().GetSseKey())
+		assert.Equal(t, "bucket", job.GetStorageConfig().GetBucketName())
+		assert.Equal(t, "sk", bc.Req.GetStorageConfig().GetSecretAccessKey())
+		assert.Equal(t, []string{"https://presigned/insert_log/1/10/100/101/1"}, builder.req.GetDataUrls())
+		assert.Equal(t, "cluster", builder.req.GetObjectTags()[0].GetValue())
+		assert.Equal(t, int64(100), builder.req.GetSegmentID())
+		assert.Equal(t, int64(101), builder.req.GetFieldID())
+		assert.Equal(t, "GPU_IVF_PQ", builder.req.GetIndexParams()[0].GetValue())
+
+		filePath2Size, err := index.UpLoad()
+		require.NoError(t, err)
+		assert.Len(t, filePath2Size, len(files))
+		for name, data := range files {
+			filePath := metautil.BuildSegmentIndexFilePath(rootPath, 1000, 1, 10, 100, name)
+			assert.Equal(t, int64(len(data)), filePath2Size[filePath])
+		}
+
+		// the verified index files are kept
+		assert.NoError(t, index.Delete())
+		assert.True(t, filesExist(builder.req.GetResultPrefix()))
+		require.NoError(t, cm.RemoveWithPrefix(ctx, builder.req.GetResultPrefix()))
+	})
+
+	t.Run("build failed", func(t *testing.T) {
+		builder := &fakeIndexBuilder{cm: cm}
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			builder.writeFiles(ctx, req, files)
+			return &indexpb.RemoteBuildResponse{Status: merr.Status(errors.New("out of gpu memory"))}, nil
+		}
+		_, err := newRemoteEngine(builder).Build(ctx, bc)
+		assert.Error(t, err)
+		assert.False(t, filesExist(builder.req.GetResultPrefix()))
+
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			return nil, errors.New("unavailable")
+		}
+		_, err = newRemoteEngine(builder).Build(ctx, bc)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		builder := &fakeIndexBuilder{cm: cm}
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			indexFiles := builder.writeFiles(ctx, req, files)
+			indexFiles[0].Path = path.Join(rootPath, "elsewhere")
+			return &indexpb.RemoteBuildResponse{Status: merr.Status(nil), Files: indexFiles}, nil
+		}
+		_, err := newRemoteEngine(builder).Build(ctx, bc)
+		assert.Error(t, err)
+		assert.False(t, filesExist(builder.req.GetResultPrefix()))
+
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			return &indexpb.RemoteBuildResponse{Status: merr.Status(nil)}, nil
+		}
+		_, err = newRemoteEngine(builder).Build(ctx, bc)
+		assert.Error(t, err)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		builder := &fakeIndexBuilder{cm: cm}
+		builder.build = func(req *indexpb.RemoteBuildRequest) (*indexpb.RemoteBuildResponse, error) {
+			indexFiles := builder.writeFiles(ctx, req, files)
+			indexFiles[0].Sha256 = indexFiles[1].Sha256
+			return &indexpb.RemoteBuildResponse{Status: merr.Status(nil), Files: indexFiles}, nil
+		}
+		index, err := newRemoteEngine(builder).Build(ctx, bc)
+		require.NoError(t, err)
+		_, err = index.UpLoad()
+		assert.Error(t, err)
+		assert.NoError(t, index.Delete())
+		assert.False(t, filesExist(builder.req.GetResultPrefix()))
+	})
+
+	t.Run("SSE-C", func(t *testing.T) {
+		sseC := *bc
+		sseC.Req = &indexpb.CreateJobRequest{StorageConfig: &indexpb.StorageConfig{SseType: "SSE-C", SseKey: "key"}}
+		_, err := newRemoteEngine(&fakeIndexBuilder{cm: cm}).Build(ctx, &sseC)
+		assert.Error(t, err)
+	})
+}
+
+func TestRemoteBuildTLSConfig(t *testing.T) {
+	paramtable.Init()
+	tlsConfig, err := remoteBuildTLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig.RootCAs)
+
+	paramtable.Get().Save(Params.IndexNodeCfg.RemoteBuildCaPemPath.Key, path.Join(t.TempDir(), "missing.pem"))
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.RemoteBuildCaPemPath.Key)
+	_, err = remoteBuildTLSConfig()
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// resourceSampleInterval is the interval to sample the rss of the process during a build.
+const resourceSampleInterval = time.Second
+
+// runningBuilds is the number of the builds tracked on the node.
+var runningBuilds = atomic.NewInt32(0)
+
+// resourceTracker accounts the resource usage of the node during a build by the differences of the cumulative
+// usage of the process at the start and the end, and the peak of the rss sampled periodically.
+// The engine builds on the shared thread pools, so the usage isn't attributed to the builds, it's the usage of
+// the node reported with the max number of the builds running concurrently, which is the build's own only if it's 1.
+type resourceTracker struct {
+	start      *hardware.ProcessUsage
+	peakRSS    *atomic.Int64
+	concurrent *atomic.Int32
+	closeCh    chan struct{}
+	wg         sync.WaitGroup
+}
+
+// startResourceTracker starts tracking the resource usage, nil is returned if the usage of the process is unavailable.
+func startResourceTracker() *resourceTracker {
+	start, err := hardware.GetProcessUsage()
+	if err != nil {
+		log.Debug("resource usage of the process is unavailable", zap.Error(err))
+		return nil
+	}
+	t := &resourceTracker{
+		start:      start,
+		peakRSS:    atomic.NewInt64(start.RSSBytes),
+		concurrent: atomic.NewInt32(runningBuilds.Inc()),
+		closeCh:    make(chan struct{}),
+	}
+	t.wg.Add(1)
+	go t.sample()
+	return t
+}
+
+func (t *resourceTracker) sample() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(resourceSampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.closeCh:
+			return
+		case <-ticker.C:
+			if usage, err := hardware.GetProcessUsage(); err == nil {
+				t.observeRSS(usage.RSSBytes)
+			}
+			t.observeConcurrent(runningBuilds.Load())
+		}
+	}
+}
+
+func (t *resourceTracker) observeRSS(rss int64) {
+	for {
+		peak := t.peakRSS.Load()
+		if rss <= peak || t.peakRSS.CompareAndSwap(peak, rss) {
+			return
+		}
+	}
+}
+
+func (t *resourceTracker) observeConcurrent(n int32) {
+	for {
+		max := t.concurrent.Load()
+		if n <= max || t.concurrent.CompareAndSwap(max, n) {
+			return
+		}
+	}
+}
+
+// stop stops tracking and returns the resource usage during the tracking, it returns nil for a nil tracker.
+func (t *resourceTracker) stop() *indexpb.ResourceUsage {
+	if t == nil {
+		return nil
+	}
+	close(t.closeCh)
+	t.wg.Wait()
+	runningBuilds.Dec()
+
+	end, err := hardware.GetProcessUsage()
+	if err != nil {
+		log.Warn("failed to get the resource usage of the process", zap.Error(err))
+		return nil
+	}
+	t.observeRSS(end.RSSBytes)
+	return &indexpb.ResourceUsage{
+		CpuSeconds:     end.CPUSeconds - t.start.CPUSeconds,
+		PeakRssBytes:   t.peakRSS.Load(),
+		DiskReadBytes:  end.DiskReadBytes - t.start.DiskReadBytes,
+		DiskWriteBytes: end.DiskWriteBytes - t.start.DiskWriteBytes,
+		NetRecvBytes:   end.NetRecvBytes - t.start.NetRecvBytes,
+		NetSendBytes:   end.NetSendBytes - t.start.NetSendBytes,
+		ConcurrentJobs: t.concurrent.Load(),
+	}
+}
+
+// observeResourceUsage records the peak rss of the node during a build to the metrics labeled by the index type,
+// the cumulative usage of the node is exported by the process collector.
+func observeResourceUsage(indexType string, usage *indexpb.ResourceUsage) {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.IndexNodeBuildPeakRSS.WithLabelValues(nodeID, indexType).Observe(float64(usage.GetPeakRssBytes()) / 1024 / 1024)
+}
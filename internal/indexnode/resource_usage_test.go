@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestResourceTracker(t *testing.T) {
+	paramtable.Init()
+	var tracker *resourceTracker
+	assert.Nil(t, tracker.stop())
+
+	tracker = startResourceTracker()
+	if runtime.GOOS != "linux" {
+		assert.Nil(t, tracker)
+		return
+	}
+	require.NotNil(t, tracker)
+	tracker.observeRSS(1 << 50)
+	tracker.observeRSS(1)
+
+	// the usage of the concurrent builds isn't attributed, it's reported with the number of them
+	concurrent := startResourceTracker()
+	tracker.observeConcurrent(runningBuilds.Load())
+	require.NotNil(t, concurrent.stop())
+
+	usage := tracker.stop()
+	require.NotNil(t, usage)
+	assert.Equal(t, int64(1<<50), usage.GetPeakRssBytes())
+	assert.EqualValues(t, 2, usage.GetConcurrentJobs())
+	assert.EqualValues(t, 0, runningBuilds.Load())
+	assert.GreaterOrEqual(t, usage.GetCpuSeconds(), float64(0))
+
+	observeResourceUsage("HNSW", usage)
+	observeResourceUsage("HNSW", &indexpb.ResourceUsage{})
+}
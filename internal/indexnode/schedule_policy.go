@@ -0,0 +1,398 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"container/heap"
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	fifoPolicy      = "fifo"
+	priorityPolicy  = "priority"
+	sjfPolicy       = "sjf"
+	fairSharePolicy = "fairShare"
+)
+
+// SchedulePolicy decides the order to issue the unissued tasks of IndexBuildQueue.
+// It's guarded by the lock of the queue.
+type SchedulePolicy interface {
+	Push(t task)
+	// Pop removes and returns the next task to issue, nil if there is no task.
+	Pop() task
+	Len() int
+}
+
+func newSchedulePolicy(name string, estimator *buildDurationEstimator) SchedulePolicy {
+	switch name {
+	case fifoPolicy:
+		return newFIFOPolicy()
+	case priorityPolicy:
+		// the task is promoted by one priority level per agingInterval it waits, so that the tasks of
+		// the low priority are not starved
+		return newHeapPolicy(func(t task, enqueued time.Duration) int64 {
+			agingInterval := Params.IndexNodeCfg.PriorityAgingInterval.GetAsDuration(time.Second)
+			if agingInterval <= 0 {
+				return -t.GetRequest().GetPriority()
+			}
+			return -t.GetRequest().GetPriority() + int64(enqueued/agingInterval)
+		})
+	case sjfPolicy:
+		// the time the task waits is deducted from its estimated duration, so that the long tasks are not starved
+		return newHeapPolicy(func(t task, enqueued time.Duration) int64 {
+			return int64(estimator.estimate(t.GetRequest()) + enqueued)
+		})
+	case fairSharePolicy:
+		return newFairSharePolicy(estimator)
+	default:
+		log.Warn("unknown schedule policy, fallback to fifo", zap.String("policy", name))
+		return newFIFOPolicy()
+	}
+}
+
+// fifoSchedulePolicy issues the tasks in the order they are enqueued.
+type fifoSchedulePolicy struct {
+	tasks *list.List
+}
+
+func newFIFOPolicy() *fifoSchedulePolicy {
+	return &fifoSchedulePolicy{tasks: list.New()}
+}
+
+func (p *fifoSchedulePolicy) Push(t task) {
+	p.tasks.PushBack(t)
+}
+
+func (p *fifoSchedulePolicy) Pop() task {
+	front := p.tasks.Front()
+	if front == nil {
+		return nil
+	}
+	p.tasks.Remove(front)
+	return front.Value.(task)
+}
+
+func (p *fifoSchedulePolicy) Len() int {
+	return p.tasks.Len()
+}
+
+type keyedTask struct {
+	t   task
+	key int64
+	seq uint64
+}
+
+// keyedTaskHeap orders the tasks by key, and by the enqueue order for the same key.
+type keyedTaskHeap []*keyedTask
+
+func (h keyedTaskHeap) Len() int { return len(h) }
+
+func (h keyedTaskHeap) Less(i, j int) bool {
+	if h[i].key != h[j].key {
+		return h[i].key < h[j].key
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h keyedTaskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *keyedTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*keyedTask))
+}
+
+func (h *keyedTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// heapSchedulePolicy issues the task of the smallest key first, the key is evaluated when the task is enqueued
+// with the time it's enqueued since the policy is created. The tasks age by growing the keys of the later enqueued
+// ones, which keeps the order of the queued tasks unchanged as time goes by.
+type heapSchedulePolicy struct {
+	tasks   keyedTaskHeap
+	seq     uint64
+	start   time.Time
+	now     func() time.Time
+	keyFunc func(t task, enqueued time.Duration) int64
+}
+
+func newHeapPolicy(keyFunc func(t task, enqueued time.Duration) int64) *heapSchedulePolicy {
+	return &heapSchedulePolicy{start: time.Now(), now: time.Now, keyFunc: keyFunc}
+}
+
+func (p *heapSchedulePolicy) Push(t task) {
+	p.seq++
+	heap.Push(&p.tasks, &keyedTask{t: t, key: p.keyFunc(t, p.now().Sub(p.start)), seq: p.seq})
+}
+
+func (p *heapSchedulePolicy) Pop() task {
+	if p.tasks.Len() == 0 {
+		return nil
+	}
+	return heap.Pop(&p.tasks).(*keyedTask).t
+}
+
+func (p *heapSchedulePolicy) Len() int {
+	return p.tasks.Len()
+}
+
+// fairShareSchedulePolicy issues the tasks of the cluster which consumed the least estimated build time first,
+// and the tasks of a cluster in the order they are enqueued. A cluster starts from the least consumed time
+// of the other pending clusters when it has tasks again, so that it can't claim the time it was idle.
+type fairShareSchedulePolicy struct {
+	estimator *buildDurationEstimator
+	tasks     map[string]*list.List
+	consumed  map[string]time.Duration
+	num       int
+}
+
+func newFairSharePolicy(estimator *buildDurationEstimator) *fairShareSchedulePolicy {
+	return &fairShareSchedulePolicy{
+		estimator: estimator,
+		tasks:     make(map[string]*list.List),
+		consumed:  make(map[string]time.Duration),
+	}
+}
+
+func (p *fairShareSchedulePolicy) Push(t task) {
+	clusterID := t.GetRequest().GetClusterID()
+	tasks, ok := p.tasks[clusterID]
+	if !ok {
+		tasks = list.New()
+		p.consumed[clusterID] = p.leastConsumed()
+		p.tasks[clusterID] = tasks
+	}
+	tasks.PushBack(t)
+	p.num++
+}
+
+func (p *fairShareSchedulePolicy) leastConsumed() time.Duration {
+	first := true
+	var least time.Duration
+	for _, consumed := range p.consumed {
+		if first || consumed < least {
+			least = consumed
+			first = false
+		}
+	}
+	return least
+}
+
+func (p *fairShareSchedulePolicy) Pop() task {
+	var (
+		next  string
+		found bool
+	)
+	for clusterID, consumed := range p.consumed {
+		if !found || consumed < p.consumed[next] || (consumed == p.consumed[next] && clusterID < next) {
+			next = clusterID
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	tasks := p.tasks[next]
+	t := tasks.Remove(tasks.Front()).(task)
+	p.consumed[next] += p.estimator.estimate(t.GetRequest())
+	if tasks.Len() == 0 {
+		delete(p.tasks, next)
+		delete(p.consumed, next)
+	}
+	p.num--
+	return t
+}
+
+func (p *fairShareSchedulePolicy) Len() int {
+	return p.num
+}
+
+// estimatorSmoothing is the weight of the latest build in the moving average of the durations per row.
+const estimatorSmoothing = 0.2
+
+// buildDurationEstimator estimates the durations of the jobs by the exponential moving average of the durations
+// per row of the builds done by the node, per job type and index type.
+type buildDurationEstimator struct {
+	mu sync.RWMutex
+	// nanoseconds per row by estimator key
+	perRow map[string]float64
+	// nanoseconds per row of all the builds, zero if no build is done yet
+	overall float64
+	// version is increased by each observed build
+	version uint64
+}
+
+func newBuildDurationEstimator() *buildDurationEstimator {
+	return &buildDurationEstimator{perRow: make(map[string]float64)}
+}
+
+func estimatorKey(req *indexpb.CreateJobRequest) string {
+	if req.GetJobType() == indexpb.JobType_JobTypeStatsJob {
+		return "stats"
+	}
+	for _, kv := range req.GetIndexParams() {
+		if kv.GetKey() == common.IndexTypeKey {
+			return kv.GetValue()
+		}
+	}
+	return ""
+}
+
+func movingAverage(old, latest float64) float64 {
+	if old == 0 {
+		return latest
+	}
+	return old*(1-estimatorSmoothing) + latest*estimatorSmoothing
+}
+
+// observe records the duration of a finished job.
+func (e *buildDurationEstimator) observe(req *indexpb.CreateJobRequest, duration time.Duration) {
+	if req.GetNumRows() <= 0 || duration <= 0 {
+		return
+	}
+	perRow := float64(duration) / float64(req.GetNumRows())
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key := estimatorKey(req)
+	e.perRow[key] = movingAverage(e.perRow[key], perRow)
+	e.overall = movingAverage(e.overall, perRow)
+	e.version++
+}
+
+// estimate returns the estimated duration of the job, the average of all the builds is used if no job
+// of the same kind is done yet, and the jobs are estimated by their number of rows if no job is done at all.
+func (e *buildDurationEstimator) estimate(req *indexpb.CreateJobRequest) time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	perRow, ok := e.perRow[estimatorKey(req)]
+	if !ok {
+		perRow = e.overall
+	}
+	if perRow == 0 {
+		perRow = 1
+	}
+	return time.Duration(float64(req.GetNumRows()) * perRow)
+}
+
+// historical returns the duration of the job by the builds of the same kind only, zero if no such build is done yet.
+func (e *buildDurationEstimator) historical(req *indexpb.CreateJobRequest) time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return time.Duration(float64(req.GetNumRows()) * e.perRow[estimatorKey(req)])
+}
+
+// buildDurationEstimatorKey is the etcd key of the persisted estimator, shared by all the IndexNodes so that
+// the restarted and the new nodes estimate the durations by the history builds of the cluster.
+const buildDurationEstimatorKey = "indexnode/build-duration-estimator"
+
+// estimatorPersistInterval is the interval to persist the estimator if any build is observed since last time.
+const estimatorPersistInterval = time.Minute
+
+type estimatorSnapshot struct {
+	PerRow  map[string]float64 `json:"perRow"`
+	Overall float64            `json:"overall"`
+}
+
+// snapshot returns the persisted form of the estimator and its version.
+func (e *buildDurationEstimator) snapshot() (string, uint64, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	data, err := json.Marshal(&estimatorSnapshot{PerRow: e.perRow, Overall: e.overall})
+	if err != nil {
+		return "", 0, err
+	}
+	return string(data), e.version, nil
+}
+
+// restore replaces the history of the estimator by the persisted one.
+func (e *buildDurationEstimator) restore(data string) error {
+	snapshot := &estimatorSnapshot{}
+	if err := json.Unmarshal([]byte(data), snapshot); err != nil {
+		return err
+	}
+	if snapshot.PerRow == nil {
+		snapshot.PerRow = make(map[string]float64)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.perRow = snapshot.PerRow
+	e.overall = snapshot.Overall
+	return nil
+}
+
+// loadEstimator restores the estimator from the persisted one if any.
+func (sched *TaskScheduler) loadEstimator() {
+	exist, err := sched.estimatorKV.Has(buildDurationEstimatorKey)
+	if err != nil || !exist {
+		if err != nil {
+			log.Warn("failed to load the build duration estimator", zap.Error(err))
+		}
+		return
+	}
+	data, err := sched.estimatorKV.Load(buildDurationEstimatorKey)
+	if err == nil {
+		err = sched.estimator.restore(data)
+	}
+	if err != nil {
+		log.Warn("failed to load the build duration estimator", zap.Error(err))
+	}
+}
+
+// persistEstimator saves the estimator if it has changed since the version persisted last time,
+// and returns the version persisted.
+func (sched *TaskScheduler) persistEstimator(persisted uint64) uint64 {
+	data, version, err := sched.estimator.snapshot()
+	if err != nil || version == persisted {
+		return persisted
+	}
+	if err := sched.estimatorKV.Save(buildDurationEstimatorKey, data); err != nil {
+		log.Warn("failed to persist the build duration estimator", zap.Error(err))
+		return persisted
+	}
+	return version
+}
+
+func (sched *TaskScheduler) persistEstimatorLoop() {
+	defer sched.wg.Done()
+	ticker := time.NewTicker(estimatorPersistInterval)
+	defer ticker.Stop()
+	var persisted uint64
+	for {
+		select {
+		case <-sched.ctx.Done():
+			sched.persistEstimator(persisted)
+			return
+		case <-ticker.C:
+			persisted = sched.persistEstimator(persisted)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newScheduleTestTask(id int, clusterID string, indexType string, numRows, priority int64) *fakeTask {
+	return &fakeTask{
+		id: id,
+		req: &indexpb.CreateJobRequest{
+			ClusterID: clusterID,
+			NumRows:   numRows,
+			Priority:  priority,
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: indexType},
+			},
+		},
+	}
+}
+
+func popAll(p SchedulePolicy) []int {
+	ids := make([]int, 0)
+	for t := p.Pop(); t != nil; t = p.Pop() {
+		ids = append(ids, t.(*fakeTask).id)
+	}
+	return ids
+}
+
+func TestSchedulePolicy(t *testing.T) {
+	estimator := newBuildDurationEstimator()
+
+	t.Run("fifo", func(t *testing.T) {
+		p := newSchedulePolicy(fifoPolicy, estimator)
+		assert.Nil(t, p.Pop())
+		for i := 0; i < 3; i++ {
+			p.Push(newScheduleTestTask(i, "c", "HNSW", int64(3-i), int64(i)))
+		}
+		assert.Equal(t, 3, p.Len())
+		assert.Equal(t, []int{0, 1, 2}, popAll(p))
+		assert.Equal(t, 0, p.Len())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, ok := newSchedulePolicy("unknown", estimator).(*fifoSchedulePolicy)
+		assert.True(t, ok)
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		p := newSchedulePolicy(priorityPolicy, estimator)
+		assert.Nil(t, p.Pop())
+		p.Push(newScheduleTestTask(0, "c", "HNSW", 1, 0))
+		p.Push(newScheduleTestTask(1, "c", "HNSW", 1, 10))
+		p.Push(newScheduleTestTask(2, "c", "HNSW", 1, 0))
+		p.Push(newScheduleTestTask(3, "c", "HNSW", 1, 5))
+		assert.Equal(t, 4, p.Len())
+		assert.Equal(t, []int{1, 3, 0, 2}, popAll(p))
+	})
+
+	t.Run("sjf", func(t *testing.T) {
+		estimator := newBuildDurationEstimator()
+		estimator.observe(newScheduleTestTask(0, "c", "DISKANN", 100, 0).req, 100*time.Second)
+		estimator.observe(newScheduleTestTask(0, "c", "IVF_FLAT", 100, 0).req, time.Second)
+
+		p := newSchedulePolicy(sjfPolicy, estimator)
+		// 10s, 100s, 5s
+		p.Push(newScheduleTestTask(0, "c", "DISKANN", 10, 0))
+		p.Push(newScheduleTestTask(1, "c", "IVF_FLAT", 10000, 0))
+		p.Push(newScheduleTestTask(2, "c", "DISKANN", 5, 0))
+		assert.Equal(t, []int{2, 0, 1}, popAll(p))
+	})
+
+	t.Run("priority aging", func(t *testing.T) {
+		p := newSchedulePolicy(priorityPolicy, estimator).(*heapSchedulePolicy)
+		now := p.start
+		p.now = func() time.Time { return now }
+		p.Push(newScheduleTestTask(0, "c", "HNSW", 1, 0))
+		now = now.Add(time.Minute)
+		p.Push(newScheduleTestTask(1, "c", "HNSW", 1, 1))
+		now = now.Add(time.Minute)
+		p.Push(newScheduleTestTask(2, "c", "HNSW", 1, 1))
+		// task 0 waited a minute longer than task 1, which makes up for the priority level
+		assert.Equal(t, []int{0, 1, 2}, popAll(p))
+
+		paramtable.Get().Save(Params.IndexNodeCfg.PriorityAgingInterval.Key, "0")
+		defer paramtable.Get().Reset(Params.IndexNodeCfg.PriorityAgingInterval.Key)
+		p.Push(newScheduleTestTask(0, "c", "HNSW", 1, 0))
+		now = now.Add(time.Hour)
+		p.Push(newScheduleTestTask(1, "c", "HNSW", 1, 1))
+		assert.Equal(t, []int{1, 0}, popAll(p))
+	})
+
+	t.Run("sjf aging", func(t *testing.T) {
+		estimator := newBuildDurationEstimator()
+		estimator.observe(newScheduleTestTask(0, "c", "HNSW", 100, 0).req, 100*time.Second)
+
+		p := newSchedulePolicy(sjfPolicy, estimator).(*heapSchedulePolicy)
+		now := p.start
+		p.now = func() time.Time { return now }
+		// 100s
+		p.Push(newScheduleTestTask(0, "c", "HNSW", 100, 0))
+		now = now.Add(2 * time.Minute)
+		// 10s, but task 0 waited longer than the difference
+		p.Push(newScheduleTestTask(1, "c", "HNSW", 10, 0))
+		assert.Equal(t, []int{0, 1}, popAll(p))
+	})
+
+	t.Run("fair share", func(t *testing.T) {
+		p := newSchedulePolicy(fairSharePolicy, estimator)
+		assert.Nil(t, p.Pop())
+		for i := 0; i < 4; i++ {
+			p.Push(newScheduleTestTask(i, "a", "HNSW", 100, 0))
+		}
+		p.Push(newScheduleTestTask(10, "b", "HNSW", 100, 0))
+		p.Push(newScheduleTestTask(11, "b", "HNSW", 100, 0))
+		assert.Equal(t, 6, p.Len())
+
+		assert.Equal(t, 0, p.Pop().(*fakeTask).id)
+		assert.Equal(t, 10, p.Pop().(*fakeTask).id)
+		assert.Equal(t, 1, p.Pop().(*fakeTask).id)
+		// a late cluster starts from the least consumed time instead of zero
+		p.Push(newScheduleTestTask(20, "c", "HNSW", 100, 0))
+		assert.Equal(t, []int{11, 20, 2, 3}, popAll(p))
+		assert.Equal(t, 0, p.Len())
+	})
+}
+
+func TestBuildDurationEstimator(t *testing.T) {
+	e := newBuildDurationEstimator()
+	hnsw := newScheduleTestTask(0, "c", "HNSW", 1000, 0).req
+	ivf := newScheduleTestTask(0, "c", "IVF_FLAT", 1000, 0).req
+	stats := &indexpb.CreateJobRequest{JobType: indexpb.JobType_JobTypeStatsJob, NumRows: 1000}
+
+	// estimated by the number of rows without history
+	assert.Equal(t, time.Duration(1000), e.estimate(hnsw))
+	assert.Zero(t, e.historical(hnsw))
+
+	e.observe(hnsw, time.Second)
+	assert.Equal(t, time.Second, e.estimate(hnsw))
+	assert.Equal(t, time.Second, e.historical(hnsw))
+	// the average of all builds is used for the unseen kinds
+	assert.Equal(t, time.Second, e.estimate(ivf))
+	assert.Zero(t, e.historical(ivf))
+	assert.Equal(t, time.Second, e.estimate(stats))
+
+	e.observe(stats, time.Millisecond)
+	assert.Equal(t, time.Millisecond, e.estimate(stats))
+	e.observe(hnsw, 2*time.Second)
+	assert.InDelta(t, float64(1200*time.Millisecond), float64(e.estimate(hnsw)), float64(time.Millisecond))
+
+	// ignored
+	e.observe(&indexpb.CreateJobRequest{}, time.Hour)
+	assert.InDelta(t, float64(1200*time.Millisecond), float64(e.estimate(hnsw)), float64(time.Millisecond))
+}
+
+func TestBuildDurationEstimatorPersistence(t *testing.T) {
+	hnsw := newScheduleTestTask(0, "c", "HNSW", 1000, 0).req
+
+	sched := NewTaskScheduler(context.TODO())
+	sched.estimatorKV = memkv.NewMemoryKV()
+	// nothing persisted yet
+	sched.loadEstimator()
+	assert.Equal(t, uint64(0), sched.persistEstimator(0))
+	exist, err := sched.estimatorKV.Has(buildDurationEstimatorKey)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	sched.estimator.observe(hnsw, time.Second)
+	assert.Equal(t, uint64(1), sched.persistEstimator(0))
+
+	// a restarted node starts from the persisted history
+	restarted := NewTaskScheduler(context.TODO())
+	restarted.estimatorKV = sched.estimatorKV
+	restarted.loadEstimator()
+	assert.Equal(t, time.Second, restarted.estimator.historical(hnsw))
+
+	assert.NoError(t, sched.estimatorKV.Save(buildDurationEstimatorKey, "invalid"))
+	restarted = NewTaskScheduler(context.TODO())
+	restarted.estimatorKV = sched.estimatorKV
+	restarted.loadEstimator()
+	assert.Zero(t, restarted.estimator.historical(hnsw))
+}
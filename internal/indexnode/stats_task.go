@@ -0,0 +1,250 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// validateStatsJob checks the stats job request before scheduling it.
+func validateStatsJob(req *indexpb.CreateJobRequest) error {
+	info := req.GetStatsInfo()
+	if info == nil {
+		return merr.WrapErrParameterInvalidMsg("stats info is missing in stats job")
+	}
+	if len(info.GetStatsTypes()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no stats type specified in stats job")
+	}
+	for _, statsType := range info.GetStatsTypes() {
+		switch statsType {
+		case indexpb.StatsType_PrimaryKeyStats:
+			if info.GetStatsLogPath() == "" {
+				return merr.WrapErrParameterInvalidMsg("stats log path is required by primary key stats")
+			}
+		default:
+			return merr.WrapErrParameterInvalidMsg("stats type %s is not supported by IndexNode", statsType.String())
+		}
+	}
+	if len(req.GetDataPaths()) == 0 {
+		return ErrEmptyInsertPaths
+	}
+	return nil
+}
+
+// statsTask computes the statistics of a segment from the binlogs of its primary key field.
+type statsTask struct {
+	ident  string
+	cancel context.CancelFunc
+	ctx    context.Context
+
+	cm        storage.ChunkManager
+	req       *indexpb.CreateJobRequest
+	BuildID   UniqueID
+	ClusterID string
+	node      *IndexNode
+	tr        *timerecord.TimeRecorder
+	queueDur  time.Duration
+	statistic indexpb.JobInfo
+
+	statsTypes typeutil.Set[indexpb.StatsType]
+	pkStats    *storage.PrimaryKeyStats
+	result     *indexpb.StatsJobResult
+}
+
+func newStatsTask(ctx context.Context, cancel context.CancelFunc, req *indexpb.CreateJobRequest,
+	cm storage.ChunkManager, node *IndexNode,
+) *statsTask {
+	return &statsTask{
+		ident:     fmt.Sprintf("%s/%d", req.GetClusterID(), req.GetBuildID()),
+		ctx:       ctx,
+		cancel:    cancel,
+		cm:        cm,
+		req:       req,
+		BuildID:   req.GetBuildID(),
+		ClusterID: req.GetClusterID(),
+		node:      node,
+		tr:        timerecord.NewTimeRecorderWithCheckpoints(fmt.Sprintf("StatsJobID: %d, ClusterID: %s", req.GetBuildID(), req.GetClusterID())),
+	}
+}
+
+func (st *statsTask) Reset() {
+	st.ident = ""
+	st.cancel = nil
+	st.ctx = nil
+	st.cm = nil
+	st.req = nil
+	st.tr = nil
+	st.node = nil
+	st.pkStats = nil
+	st.result = nil
+}
+
+// Ctx is the context of stats tasks.
+func (st *statsTask) Ctx() context.Context {
+	return st.ctx
+}
+
+// Name is the name of task to compute the segment statistics.
+func (st *statsTask) Name() string {
+	return st.ident
+}
+
+func (st *statsTask) SetState(state commonpb.IndexState, failReason string) {
+	st.node.storeTaskState(st.ClusterID, st.BuildID, state, failReason)
+}
+
+func (st *statsTask) GetState() commonpb.IndexState {
+	return st.node.loadTaskState(st.ClusterID, st.BuildID)
+}
+
+func (st *statsTask) GetRequest() *indexpb.CreateJobRequest {
+	return st.req
+}
+
+func (st *statsTask) OnEnqueue(ctx context.Context) error {
+	st.queueDur = 0
+	st.tr.RecordSpan()
+	st.statistic.StartTime = time.Now().UnixMicro()
+	st.statistic.PodID = st.node.GetNodeID()
+	log.Ctx(ctx).Info("IndexNode StatsTask Enqueue", zap.Int64("jobID", st.BuildID))
+	return nil
+}
+
+func (st *statsTask) Prepare(ctx context.Context) error {
+	st.queueDur = st.tr.RecordCheckpoint("wait in queue")
+	st.statsTypes = typeutil.NewSet(st.req.GetStatsInfo().GetStatsTypes()...)
+	st.result = &indexpb.StatsJobResult{}
+	log.Ctx(ctx).Info("Successfully prepare statsTask", zap.Int64("jobID", st.BuildID),
+		zap.Any("statsTypes", st.req.GetStatsInfo().GetStatsTypes()))
+	return nil
+}
+
+// Execute loads the primary key binlogs and computes the requested statistics.
+func (st *statsTask) Execute(ctx context.Context) error {
+	dataPaths := st.req.GetDataPaths()
+	blobs := make([]*Blob, len(dataPaths))
+	loadKey := func(idx int) error {
+		value, err := st.cm.Read(ctx, dataPaths[idx])
+		if err != nil {
+			if errors.Is(err, ErrNoSuchKey) {
+				return ErrNoSuchKey
+			}
+			return err
+		}
+		blobs[idx] = &Blob{Key: dataPaths[idx], Value: value}
+		return nil
+	}
+	err := funcutil.ProcessFuncParallel(len(dataPaths), runtime.GOMAXPROCS(0), loadKey, "loadKey")
+	if err != nil {
+		log.Ctx(ctx).Warn("loadKey failed", zap.Error(err))
+		return err
+	}
+
+	var insertCodec storage.InsertCodec
+	_, _, segmentID, insertData, err := insertCodec.DeserializeAll(blobs)
+	if err != nil {
+		return err
+	}
+	if len(insertData.Data) != 1 {
+		return errors.New("we expect only one field in deserialized insert data")
+	}
+	var (
+		pkFieldID int64
+		pkData    storage.FieldData
+	)
+	for fieldID, data := range insertData.Data {
+		pkFieldID, pkData = fieldID, data
+	}
+
+	var (
+		pkType schemapb.DataType
+		sorted bool
+	)
+	switch data := pkData.(type) {
+	case *storage.Int64FieldData:
+		pkType = schemapb.DataType_Int64
+		sorted = sort.SliceIsSorted(data.Data, func(i, j int) bool { return data.Data[i] < data.Data[j] })
+	case *storage.StringFieldData:
+		pkType = schemapb.DataType_VarChar
+		sorted = sort.SliceIsSorted(data.Data, func(i, j int) bool { return data.Data[i] < data.Data[j] })
+	default:
+		return merr.WrapErrParameterInvalidMsg("field %d is not a primary key field", pkFieldID)
+	}
+
+	rowNum := int64(pkData.RowNum())
+	st.result.NumRows = rowNum
+	st.statistic.NumRows = rowNum
+	if st.statsTypes.Contain(indexpb.StatsType_PrimaryKeyStats) {
+		st.result.Sorted = sorted
+		st.pkStats = storage.NewPrimaryKeyStats(pkFieldID, int64(pkType), rowNum)
+		st.pkStats.UpdateByMsgs(pkData)
+	}
+
+	st.tr.RecordCheckpoint("compute stats")
+	log.Ctx(ctx).Info("Successfully compute segment stats", zap.Int64("jobID", st.BuildID),
+		zap.Int64("segmentID", segmentID), zap.Int64("numRows", rowNum), zap.Bool("sorted", sorted))
+	return nil
+}
+
+// PostExecute writes the primary key statslog and reports the result.
+func (st *statsTask) PostExecute(ctx context.Context) error {
+	var serializedSize uint64
+	if st.pkStats != nil {
+		sw := &storage.StatsWriter{}
+		if err := sw.Generate(st.pkStats); err != nil {
+			log.Ctx(ctx).Warn("failed to serialize primary key stats", zap.Error(err))
+			return err
+		}
+		statsLogPath := st.req.GetStatsInfo().GetStatsLogPath()
+		if err := st.cm.Write(ctx, statsLogPath, sw.GetBuffer()); err != nil {
+			log.Ctx(ctx).Warn("failed to write primary key statslog", zap.String("path", statsLogPath), zap.Error(err))
+			return err
+		}
+		st.result.StatsLogPath = statsLogPath
+		serializedSize = uint64(len(sw.GetBuffer()))
+	}
+	if err := flushWriteBack(ctx, st.cm); err != nil {
+		log.Ctx(ctx).Warn("failed to flush the written back statslog", zap.Error(err))
+		return err
+	}
+
+	st.statistic.EndTime = time.Now().UnixMicro()
+	st.tr.RecordCheckpoint("write stats")
+	exportTimeRecords(ctx, "IndexNode-SegmentStats", st.tr, &st.statistic)
+	st.node.storeStatsResult(st.ClusterID, st.BuildID, st.result, serializedSize, &st.statistic)
+	st.tr.Elapse("segment stats all done")
+	log.Ctx(ctx).Info("Successfully save segment stats", zap.Int64("jobID", st.BuildID),
+		zap.String("statsLogPath", st.result.GetStatsLogPath()))
+	return nil
+}
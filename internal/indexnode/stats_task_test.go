@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func writePkBinlog(ctx context.Context, t *testing.T, cm storage.ChunkManager, pks []int64) string {
+	const pkFieldID = 100
+	rowIDs := make([]int64, 0, len(pks))
+	tss := make([]int64, 0, len(pks))
+	for i := range pks {
+		rowIDs = append(rowIDs, int64(i))
+		tss = append(tss, int64(i)+1)
+	}
+	insertCodec := &storage.InsertCodec{
+		Schema: &etcdpb.CollectionMeta{
+			ID: 1,
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: pkFieldID, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				},
+			},
+		},
+	}
+	blobs, err := insertCodec.Serialize(2, 3, &storage.InsertData{
+		Data: map[int64]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{Data: rowIDs},
+			common.TimeStampField: &storage.Int64FieldData{Data: tss},
+			pkFieldID:             &storage.Int64FieldData{Data: pks},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blobs))
+	binlogPath := path.Join(cm.RootPath(), "insert_log", "1", "2", "3", "100", "1")
+	require.NoError(t, cm.Write(ctx, binlogPath, blobs[0].GetValue()))
+	return binlogPath
+}
+
+func TestValidateStatsJob(t *testing.T) {
+	req := &indexpb.CreateJobRequest{
+		JobType:   indexpb.JobType_JobTypeStatsJob,
+		DataPaths: []string{"binlog"},
+		StatsInfo: &indexpb.StatsJobInfo{
+			StatsTypes:   []indexpb.StatsType{indexpb.StatsType_PrimaryKeyStats},
+			StatsLogPath: "statslog",
+		},
+	}
+	assert.NoError(t, validateStatsJob(req))
+
+	assert.Error(t, validateStatsJob(&indexpb.CreateJobRequest{DataPaths: []string{"binlog"}}))
+	assert.Error(t, validateStatsJob(&indexpb.CreateJobRequest{
+		DataPaths: []string{"binlog"},
+		StatsInfo: &indexpb.StatsJobInfo{},
+	}))
+	assert.Error(t, validateStatsJob(&indexpb.CreateJobRequest{
+		DataPaths: []string{"binlog"},
+		StatsInfo: &indexpb.StatsJobInfo{StatsTypes: []indexpb.StatsType{indexpb.StatsType_PrimaryKeyStats}},
+	}))
+	assert.Error(t, validateStatsJob(&indexpb.CreateJobRequest{
+		DataPaths: []string{"binlog"},
+		StatsInfo: &indexpb.StatsJobInfo{StatsTypes: []indexpb.StatsType{indexpb.StatsType(2)}},
+	}))
+	assert.ErrorIs(t, validateStatsJob(&indexpb.CreateJobRequest{
+		StatsInfo: &indexpb.StatsJobInfo{
+			StatsTypes:   []indexpb.StatsType{indexpb.StatsType_PrimaryKeyStats},
+			StatsLogPath: "statslog",
+		},
+	}), ErrEmptyInsertPaths)
+}
+
+func TestStatsTask(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	in := NewIndexNode(ctx, &mockFactory{chunkMgr: &mockChunkmgr{}})
+
+	run := func(buildID UniqueID, pks []int64, statsTypes ...indexpb.StatsType) *taskInfo {
+		req := &indexpb.CreateJobRequest{
+			ClusterID: "cluster",
+			BuildID:   buildID,
+			JobType:   indexpb.JobType_JobTypeStatsJob,
+			DataPaths: []string{writePkBinlog(ctx, t, cm, pks)},
+			StatsInfo: &indexpb.StatsJobInfo{
+				StatsTypes:   statsTypes,
+				StatsLogPath: path.Join(cm.RootPath(), "stats_log", "1", "2", "3", "100", "1"),
+			},
+		}
+		require.NoError(t, validateStatsJob(req))
+		in.loadOrStoreTask(req.GetClusterID(), buildID, &taskInfo{
+			jobType: req.GetJobType(),
+			state:   commonpb.IndexState_InProgress,
+		})
+		taskCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		st := newStatsTask(taskCtx, cancel, req, cm, in)
+		require.NoError(t, st.OnEnqueue(ctx))
+		require.NoError(t, st.Prepare(ctx))
+		require.NoError(t, st.Execute(ctx))
+		require.NoError(t, st.PostExecute(ctx))
+		info, _ := in.tasks.Get(taskKey{ClusterID: req.GetClusterID(), BuildID: buildID})
+		return info
+	}
+
+	t.Run("unsorted primary keys", func(t *testing.T) {
+		info := run(1, []int64{3, 1, 2}, indexpb.StatsType_PrimaryKeyStats)
+		assert.EqualValues(t, 3, info.statsResult.GetNumRows())
+		assert.False(t, info.statsResult.GetSorted())
+		assert.NotZero(t, info.serializedSize)
+		// the checkpoints of the job are reported with its statistic
+		records := info.statistic.GetTimeRecords()
+		for _, checkpoint := range []string{"wait in queue", "compute stats", "write stats"} {
+			assert.Contains(t, records, `"name":"`+checkpoint+`"`)
+		}
+
+		data, err := cm.Read(ctx, info.statsResult.GetStatsLogPath())
+		require.NoError(t, err)
+		stats, err := storage.DeserializeStats([]*storage.Blob{{Value: data}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stats))
+		assert.Equal(t, storage.NewInt64PrimaryKey(1), stats[0].MinPk)
+		assert.Equal(t, storage.NewInt64PrimaryKey(3), stats[0].MaxPk)
+		b := make([]byte, 8)
+		common.Endian.PutUint64(b, 2)
+		assert.True(t, stats[0].BF.Test(b))
+	})
+
+	t.Run("sorted primary keys", func(t *testing.T) {
+		info := run(2, []int64{1, 2, 3}, indexpb.StatsType_PrimaryKeyStats)
+		assert.True(t, info.statsResult.GetSorted())
+		assert.NotEmpty(t, info.statsResult.GetStatsLogPath())
+	})
+}
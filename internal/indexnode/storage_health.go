@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// storageHealthProbeKey is the object stated by the health probes, it needn't exist
+const storageHealthProbeKey = "indexnode_health_probe"
+
+// storageHealthChecker probes the object storages used by the jobs periodically and works as their circuit breakers.
+// The circuit of a storage opens after consecutive failed probes and closes once a probe succeeds again,
+// the jobs on the storage of the open circuit fail fast rather than timing out one by one.
+type storageHealthChecker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.RWMutex
+	storages map[string]*storageHealth
+}
+
+type storageHealth struct {
+	cm       storage.ChunkManager
+	failures int
+	lastErr  error
+}
+
+func newStorageHealthChecker(ctx context.Context) *storageHealthChecker {
+	ctx, cancel := context.WithCancel(ctx)
+	return &storageHealthChecker{
+		ctx:      ctx,
+		cancel:   cancel,
+		storages: make(map[string]*storageHealth),
+	}
+}
+
+// start probes the storages in background, it's disabled if the interval isn't positive
+func (c *storageHealthChecker) start() {
+	interval := Params.IndexNodeCfg.StorageHealthCheckInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	c.wg.Add(1)
+	go c.loop(interval)
+}
+
+func (c *storageHealthChecker) close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+func (c *storageHealthChecker) loop(interval time.Duration) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.probeAll(interval)
+		}
+	}
+}
+
+// register starts probing the storage of key by cm if it's not probed yet
+func (c *storageHealthChecker) register(key string, cm storage.ChunkManager) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.storages[key]; !ok {
+		c.storages[key] = &storageHealth{cm: cm}
+	}
+}
+
+// check returns ErrServiceUnavailable if the circuit of the storage of key is open
+func (c *storageHealthChecker) check(key string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	health, ok := c.storages[key]
+	if !ok || !c.isOpen(health) {
+		return nil
+	}
+	return merr.WrapErrServiceUnavailable("storage unreachable",
+		fmt.Sprintf("storage %s failed %d consecutive health probes, last error: %v", key, health.failures, health.lastErr))
+}
+
+// isOpen reports whether the circuit of the storage is open, the circuit breaker is disabled if the threshold isn't positive
+func (c *storageHealthChecker) isOpen(health *storageHealth) bool {
+	threshold := Params.IndexNodeCfg.StorageHealthCheckFailureThreshold.GetAsInt()
+	return threshold > 0 && health.failures >= threshold
+}
+
+func (c *storageHealthChecker) probeAll(timeout time.Duration) {
+	c.mu.RLock()
+	storages := make(map[string]storage.ChunkManager, len(c.storages))
+	for key, health := range c.storages {
+		storages[key] = health.cm
+	}
+	c.mu.RUnlock()
+
+	wg := sync.WaitGroup{}
+	for key, cm := range storages {
+		key, cm := key, cm
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c.ctx, timeout)
+			defer cancel()
+			_, err := cm.Exist(ctx, path.Join(cm.RootPath(), storageHealthProbeKey))
+			c.record(key, err)
+		}()
+	}
+	wg.Wait()
+}
+
+// record updates the circuit of the storage of key with the result of a probe
+func (c *storageHealthChecker) record(key string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	health, ok := c.storages[key]
+	if !ok {
+		return
+	}
+	wasOpen := c.isOpen(health)
+	if err == nil {
+		health.failures = 0
+		health.lastErr = nil
+		if wasOpen {
+			log.Info("storage recovered, circuit closed", zap.String("storage", key))
+		}
+		return
+	}
+	health.failures++
+	health.lastErr = err
+	if !wasOpen && c.isOpen(health) {
+		log.Warn("storage unreachable, circuit opened", zap.String("storage", key), zap.Int("failures", health.failures), zap.Error(err))
+	}
+}
@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// probedChunkManager fails the health probes while unreachable is set
+type probedChunkManager struct {
+	storage.ChunkManager
+	unreachable atomic.Bool
+}
+
+func (cm *probedChunkManager) RootPath() string {
+	return "root"
+}
+
+func (cm *probedChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	if cm.unreachable.Load() {
+		return false, errors.New("connection refused")
+	}
+	return false, nil
+}
+
+func TestStorageHealthChecker(t *testing.T) {
+	paramtable.Init()
+	checker := newStorageHealthChecker(context.Background())
+	defer checker.close()
+	threshold := Params.IndexNodeCfg.StorageHealthCheckFailureThreshold.GetAsInt()
+
+	cm := &probedChunkManager{}
+	checker.register("storage", cm)
+	// the unknown storages are always allowed
+	assert.NoError(t, checker.check("unknown"))
+
+	cm.unreachable.Store(true)
+	for i := 0; i < threshold-1; i++ {
+		checker.probeAll(time.Second)
+		assert.NoError(t, checker.check("storage"))
+	}
+	checker.probeAll(time.Second)
+	err := checker.check("storage")
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	assert.Contains(t, err.Error(), "storage unreachable")
+
+	// the circuit breaker is disabled by the threshold not positive
+	key := Params.IndexNodeCfg.StorageHealthCheckFailureThreshold.Key
+	paramtable.Get().Save(key, "0")
+	assert.NoError(t, checker.check("storage"))
+	paramtable.Get().Reset(key)
+
+	cm.unreachable.Store(false)
+	checker.probeAll(time.Second)
+	assert.NoError(t, checker.check("storage"))
+}
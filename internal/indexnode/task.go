@@ -23,10 +23,11 @@ import (
 	"runtime"
 	"runtime/debug"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -42,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/indexparams"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 var (
@@ -49,29 +51,36 @@ var (
 	diskUsageRatio = 4.0
 )
 
+// streamBuildBatchFilesKey is the build param telling the index engine how many binlogs to load per batch.
+const streamBuildBatchFilesKey = "stream_build_batch_files"
+
 type Blob = storage.Blob
 
 type taskInfo struct {
 	cancel         context.CancelFunc
+	jobType        indexpb.JobType
 	state          commonpb.IndexState
 	fileKeys       []string
 	serializedSize uint64
 	failReason     string
+	statsResult    *indexpb.StatsJobResult
 
 	// task statistics
 	statistic *indexpb.JobInfo
 }
 
+// task is a job scheduled on IndexNode, the scheduler runs Prepare, Execute and PostExecute in order.
 type task interface {
 	Ctx() context.Context
 	Name() string
 	Prepare(context.Context) error
-	LoadData(context.Context) error
-	BuildIndex(context.Context) error
-	SaveIndexFiles(context.Context) error
+	Execute(context.Context) error
+	PostExecute(context.Context) error
 	OnEnqueue(context.Context) error
 	SetState(state commonpb.IndexState, failReason string)
 	GetState() commonpb.IndexState
+	// GetRequest returns the request of the job, which is used to schedule the task.
+	GetRequest() *indexpb.CreateJobRequest
 	Reset()
 }
 
@@ -101,7 +110,12 @@ type indexBuildTask struct {
 	tr             *timerecord.TimeRecorder
 	queueDur       time.Duration
 	statistic      indexpb.JobInfo
+	resources      *resourceTracker
+	tempDir        *tempDir
 	node           *IndexNode
+
+	// indexFileVersion is the index file version negotiated with the query nodes
+	indexFileVersion int32
 }
 
 func (it *indexBuildTask) Reset() {
@@ -117,6 +131,12 @@ func (it *indexBuildTask) Reset() {
 	it.newTypeParams = nil
 	it.newIndexParams = nil
 	it.tr = nil
+	it.resources.stop()
+	it.resources = nil
+	if it.tempDir != nil {
+		it.node.tempDirs.release(it.tempDir)
+		it.tempDir = nil
+	}
 	it.node = nil
 }
 
@@ -138,6 +158,10 @@ func (it *indexBuildTask) GetState() commonpb.IndexState {
 	return it.node.loadTaskState(it.ClusterID, it.BuildID)
 }
 
+func (it *indexBuildTask) GetRequest() *indexpb.CreateJobRequest {
+	return it.req
+}
+
 // OnEnqueue enqueues indexing tasks.
 func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 	it.queueDur = 0
@@ -149,7 +173,10 @@ func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) Prepare(ctx context.Context) error {
-	it.queueDur = it.tr.RecordSpan()
+	it.queueDur = it.tr.RecordCheckpoint("wait in queue")
+	// the tracker of the previous attempt is left running if the task is retried locally
+	it.resources.stop()
+	it.resources = startResourceTracker()
 	log.Ctx(ctx).Info("Begin to prepare indexBuildTask", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	typeParams := make(map[string]string)
@@ -243,7 +270,8 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 	return err
 }
 
-func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
+// Execute builds the index.
+func (it *indexBuildTask) Execute(ctx context.Context) error {
 	err := it.parseFieldMetaFromBinlog(ctx)
 	if err != nil {
 		log.Ctx(ctx).Warn("parse field meta from binlog failed", zap.Error(err))
@@ -281,36 +309,25 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 			return errors.New("index node don't has enough disk size to build disk ann index")
 		}
 
+		// the temporary files of the previous attempt are removed if the task is retried locally
+		it.node.tempDirs.release(it.tempDir)
+		it.tempDir, err = it.node.tempDirs.acquire(it.Name(), it.BuildID, it.segmentID, it.fieldID,
+			int64(float64(fieldDataSize)*diskUsageRatio))
+		if err != nil {
+			log.Ctx(ctx).Warn("IndexNode failed to reserve local disk for the disk ann index", zap.Error(err))
+			return err
+		}
+
 		err = indexparams.SetDiskIndexBuildParams(it.newIndexParams, int64(fieldDataSize))
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to fill disk index params", zap.Error(err))
 			return err
 		}
-	}
-
-	var buildIndexInfo *indexcgowrapper.BuildIndexInfo
-	buildIndexInfo, err = indexcgowrapper.NewBuildIndexInfo(it.req.GetStorageConfig())
-	defer indexcgowrapper.DeleteBuildIndexInfo(buildIndexInfo)
-	if err != nil {
-		log.Ctx(ctx).Warn("create build index info failed", zap.Error(err))
-		return err
-	}
-	err = buildIndexInfo.AppendFieldMetaInfo(it.collectionID, it.partitionID, it.segmentID, it.fieldID, it.fieldType)
-	if err != nil {
-		log.Ctx(ctx).Warn("append field meta failed", zap.Error(err))
-		return err
-	}
-
-	err = buildIndexInfo.AppendIndexMetaInfo(it.req.IndexID, it.req.BuildID, it.req.IndexVersion)
-	if err != nil {
-		log.Ctx(ctx).Warn("append index meta failed", zap.Error(err))
-		return err
-	}
-
-	err = buildIndexInfo.AppendBuildIndexParam(it.newIndexParams)
-	if err != nil {
-		log.Ctx(ctx).Warn("append index params failed", zap.Error(err))
-		return err
+	} else if typeutil.IsVectorType(it.fieldType) {
+		// stream the binlogs into the in-memory vector index in batches instead of loading the whole field
+		if batchFiles := Params.IndexNodeCfg.StreamBuildBatchFiles.GetAsInt64(); batchFiles > 0 {
+			it.newIndexParams[streamBuildBatchFilesKey] = strconv.FormatInt(batchFiles, 10)
+		}
 	}
 
 	jsonIndexParams, err := json.Marshal(it.newIndexParams)
@@ -319,25 +336,29 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		return err
 	}
 
+	engine := GetIndexEngineMgrInstance().GetEngine(indexType)
 	log.Ctx(ctx).Info("index params are ready",
 		zap.Int64("buildID", it.BuildID),
+		zap.String("index engine", engine.Name()),
 		zap.String("index params", string(jsonIndexParams)))
 
-	err = buildIndexInfo.AppendBuildTypeParam(it.newTypeParams)
-	if err != nil {
-		log.Ctx(ctx).Warn("append type params failed", zap.Error(err))
-		return err
+	bc := &BuildContext{
+		Req:          it.req,
+		CollectionID: it.collectionID,
+		PartitionID:  it.partitionID,
+		SegmentID:    it.segmentID,
+		FieldID:      it.fieldID,
+		FieldType:    it.fieldType,
+		TypeParams:   it.newTypeParams,
+		IndexParams:  it.newIndexParams,
+		ChunkManager: it.cm,
+		ContentAddressed: Params.IndexNodeCfg.ContentAddressableStorage.GetAsBool() &&
+			it.indexFileVersion >= common.IndexFileVersionContentAddressed,
 	}
-
-	for _, path := range it.req.GetDataPaths() {
-		err = buildIndexInfo.AppendInsertFile(path)
-		if err != nil {
-			log.Ctx(ctx).Warn("append insert binlog path failed", zap.Error(err))
-			return err
-		}
+	if Params.IndexNodeCfg.ObjectTaggingEnabled.GetAsBool() {
+		bc.ObjectTags = objectTags(it.ClusterID, it.collectionID, it.req)
 	}
-
-	it.index, err = indexcgowrapper.CreateIndex(ctx, buildIndexInfo)
+	it.index, err = engine.Build(ctx, bc)
 	if err != nil {
 		if it.index != nil && it.index.CleanLocalData() != nil {
 			log.Ctx(ctx).Error("failed to clean cached data on disk after build index failed",
@@ -348,14 +369,16 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		return err
 	}
 
-	buildIndexLatency := it.tr.RecordSpan()
-	metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(buildIndexLatency.Seconds())
+	buildIndexLatency := it.tr.RecordCheckpoint("build index")
+	metrics.ObserveWithTrace(ctx, metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)),
+		buildIndexLatency.Seconds())
 
 	log.Ctx(ctx).Info("Successfully build index", zap.Int64("buildID", it.BuildID), zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	return nil
 }
 
-func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
+// PostExecute uploads the index files.
+func (it *indexBuildTask) PostExecute(ctx context.Context) error {
 	gcIndex := func() {
 		if err := it.index.Delete(); err != nil {
 			log.Ctx(ctx).Error("IndexNode indexBuildTask Execute CIndexDelete failed", zap.Error(err))
@@ -375,15 +398,41 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 
 	// use serialized size before encoding
 	it.serializedSize = 0
-	saveFileKeys := make([]string, 0)
-	for filePath, fileSize := range indexFilePath2Size {
+	for _, fileSize := range indexFilePath2Size {
 		it.serializedSize += uint64(fileSize)
-		parts := strings.Split(filePath, "/")
-		fileKey := parts[len(parts)-1]
-		saveFileKeys = append(saveFileKeys, fileKey)
+	}
+	saveFileKeys, casFiles := indexFileKeys(it.cm, indexFilePath2Size)
+	if len(casFiles) > 0 {
+		err = writeIndexFileManifest(ctx, it.cm, it.BuildID, it.req.GetIndexVersion(), it.partitionID, it.segmentID, casFiles)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to write the manifest of the content-addressed index files", zap.Error(err))
+			return err
+		}
+	}
+
+	// the upload manifest is written before the task is marked finished, so a finished build never declares
+	// the index files missing in the object storage
+	manifest := &storage.IndexUploadManifest{
+		BuildID:        it.BuildID,
+		IndexVersion:   it.req.GetIndexVersion(),
+		PartitionID:    it.partitionID,
+		SegmentID:      it.segmentID,
+		FileKeys:       saveFileKeys,
+		SerializedSize: it.serializedSize,
+	}
+	if err := commitUploadManifest(ctx, it.cm, manifest); err != nil {
+		log.Ctx(ctx).Warn("failed to commit the upload manifest", zap.Error(err))
+		return err
 	}
 
 	it.statistic.EndTime = time.Now().UnixMicro()
+	it.statistic.IndexFileVersion = it.indexFileVersion
+	if usage := it.resources.stop(); usage != nil {
+		it.statistic.ResourceUsage = usage
+		observeResourceUsage(it.newIndexParams[common.IndexTypeKey], usage)
+	}
+	it.resources = nil
+	exportTimeRecords(ctx, "IndexNode-BuildIndex", it.tr, &it.statistic)
 	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic)
 	log.Ctx(ctx).Debug("save index files done", zap.Strings("IndexFiles", saveFileKeys))
 	saveIndexFileDur := it.tr.RecordSpan()
@@ -434,7 +483,7 @@ func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob
 	if err2 != nil {
 		return err2
 	}
-	metrics.IndexNodeDecodeFieldLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(it.tr.RecordSpan().Seconds())
+	metrics.IndexNodeDecodeFieldLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(it.tr.RecordCheckpoint("decode field data").Seconds())
 
 	if len(insertData.Data) != 1 {
 		return errors.New("we expect only one field in deserialized insert data")
@@ -466,3 +515,18 @@ func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob
 	it.fieldData = data
 	return nil
 }
+
+// exportTimeRecords exports the checkpoints recorded by the job as the events of a span of the job,
+// which is a child of the CreateJob span, and as the time records of the job statistic.
+func exportTimeRecords(ctx context.Context, spanName string, tr *timerecord.TimeRecorder, statistic *indexpb.JobInfo) {
+	_, sp := otel.Tracer(typeutil.IndexNodeRole).Start(ctx, spanName, trace.WithTimestamp(time.UnixMicro(statistic.GetStartTime())))
+	tr.ExportSpanEvents(sp)
+	sp.End()
+
+	records, err := json.Marshal(tr)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to marshal the time records of the job", zap.Error(err))
+		return
+	}
+	statistic.TimeRecords = string(records)
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// dependencyGraph holds the tasks waiting for their prerequisites on the same node. Only the prerequisites which
+// are known to the node and not finished yet are waited for, so a job never waits for a job the node doesn't have
+// and the dependencies never form a cycle.
+type dependencyGraph struct {
+	mu         sync.Mutex
+	unfinished map[taskKey]struct{}
+	dependents map[taskKey][]task // prerequisite -> tasks waiting for it
+	waiting    map[string]int     // task name -> number of unfinished prerequisites
+}
+
+func newDependencyGraph() *dependencyGraph {
+	return &dependencyGraph{
+		unfinished: make(map[taskKey]struct{}),
+		dependents: make(map[taskKey][]task),
+		waiting:    make(map[string]int),
+	}
+}
+
+func taskKeyOf(t task) taskKey {
+	return taskKey{ClusterID: t.GetRequest().GetClusterID(), BuildID: t.GetRequest().GetBuildID()}
+}
+
+// add tracks the task as unfinished, and returns true if it has to wait for its prerequisites.
+func (g *dependencyGraph) add(t task) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := taskKeyOf(t)
+	g.unfinished[key] = struct{}{}
+	prerequisites := make([]int64, 0)
+	for _, buildID := range t.GetRequest().GetDependsOn() {
+		dep := taskKey{ClusterID: key.ClusterID, BuildID: buildID}
+		if dep == key {
+			continue
+		}
+		if _, ok := g.unfinished[dep]; ok {
+			g.dependents[dep] = append(g.dependents[dep], t)
+			prerequisites = append(prerequisites, buildID)
+		}
+	}
+	if len(prerequisites) == 0 {
+		return false
+	}
+	g.waiting[t.Name()] = len(prerequisites)
+	log.Ctx(t.Ctx()).Info("task waits for its prerequisites", zap.String("task", t.Name()),
+		zap.Int64s("prerequisites", prerequisites))
+	return true
+}
+
+// finish marks the task of the key as finished, and returns the tasks which aren't waiting for any prerequisite
+// any longer. The dependents are released no matter whether the prerequisite succeeded, since the dependency
+// only orders the jobs, e.g. to reuse the binlogs cached by the prerequisite.
+func (g *dependencyGraph) finish(key taskKey) []task {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.unfinished, key)
+	ready := make([]task, 0)
+	for _, t := range g.dependents[key] {
+		g.waiting[t.Name()]--
+		if g.waiting[t.Name()] <= 0 {
+			delete(g.waiting, t.Name())
+			ready = append(ready, t)
+		}
+	}
+	delete(g.dependents, key)
+	return ready
+}
+
+// Enqueue schedules the task once its prerequisites on the node finish, the task is put into the queue at once
+// if it doesn't depend on any unfinished task.
+func (sched *TaskScheduler) Enqueue(t task) error {
+	if sched.dependencies.add(t) {
+		return nil
+	}
+	if err := sched.enqueue(t); err != nil {
+		sched.releaseDependents(taskKeyOf(t))
+		return err
+	}
+	return nil
+}
+
+// finishTask releases the task, and schedules the dependents which aren't waiting for any prerequisite.
+func (sched *TaskScheduler) finishTask(t task) {
+	key := taskKeyOf(t)
+	sched.forgetRetry(t.Name())
+	t.Reset()
+	sched.releaseDependents(key)
+}
+
+func (sched *TaskScheduler) releaseDependents(key taskKey) {
+	for _, dependent := range sched.dependencies.finish(key) {
+		log.Ctx(dependent.Ctx()).Info("prerequisites of task finished, schedule it", zap.String("task", dependent.Name()))
+		if err := sched.enqueue(dependent); err != nil {
+			log.Ctx(dependent.Ctx()).Warn("failed to schedule task after prerequisites finished",
+				zap.String("task", dependent.Name()), zap.Error(err))
+			dependent.SetState(commonpb.IndexState_Retry, err.Error())
+			sched.finishTask(dependent)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newDependentTask(id int, buildID int64, dependsOn ...int64) *blockingTask {
+	t := newBlockingTask(id, 0)
+	t.req.ClusterID = "cluster"
+	t.req.BuildID = buildID
+	t.req.DependsOn = dependsOn
+	return t
+}
+
+func TestDependencyGraph(t *testing.T) {
+	g := newDependencyGraph()
+	vector := newDependentTask(1, 1)
+	other := newDependentTask(2, 2)
+	scalar := newDependentTask(3, 3, 1, 2, 3, 100)
+
+	assert.False(t, g.add(vector))
+	assert.False(t, g.add(other))
+	// the unknown job and the job itself aren't waited for
+	assert.True(t, g.add(scalar))
+	assert.Equal(t, 2, g.waiting[scalar.Name()])
+
+	assert.Empty(t, g.finish(taskKeyOf(vector)))
+	assert.Equal(t, []task{scalar}, g.finish(taskKeyOf(other)))
+	assert.Empty(t, g.waiting)
+	assert.Empty(t, g.dependents)
+
+	// the finished job isn't waited for
+	assert.False(t, g.add(newDependentTask(4, 4, 1)))
+}
+
+func TestTaskSchedulerDependency(t *testing.T) {
+	paramtable.Init()
+	scheduler := NewTaskScheduler(context.TODO())
+	scheduler.buildParallel = 2
+	scheduler.Start()
+	defer scheduler.Close()
+
+	vector := newDependentTask(1, 1)
+	scalar := newDependentTask(2, 2, 1)
+	close(scalar.unblock)
+	assert.NoError(t, scheduler.Enqueue(vector))
+	assert.NoError(t, scheduler.Enqueue(scalar))
+
+	select {
+	case <-scalar.released:
+		assert.FailNow(t, "task started before its prerequisite finished")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(vector.unblock)
+	<-vector.released
+	select {
+	case <-scalar.released:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "task isn't started after its prerequisite finished")
+	}
+	assert.Equal(t, commonpb.IndexState_Finished, scalar.GetState())
+	assert.Eventually(t, func() bool {
+		scheduler.dependencies.mu.Lock()
+		defer scheduler.dependencies.mu.Unlock()
+		return len(scheduler.dependencies.unfinished) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
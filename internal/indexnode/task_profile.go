@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/watchdog"
+)
+
+// the pprof labels attached to the goroutines running a task
+const (
+	profileLabelBuildID   = "buildID"
+	profileLabelClusterID = "clusterID"
+	profileLabelIndexType = "indexType"
+	profileLabelPhase     = "phase"
+)
+
+const (
+	defaultProfileSeconds = 30
+	maxProfileSeconds     = 600
+)
+
+// runWithProfileLabels runs a phase of the task with the pprof labels of the task, the labels are inherited by
+// the goroutines started by the phase, so the samples of the build can be told apart in the cpu and goroutine
+// profiles, e.g. by `go tool pprof -tagfocus buildID=<buildID>`.
+func runWithProfileLabels(ctx context.Context, t task, phase string, fn func(context.Context) error) error {
+	req := t.GetRequest()
+	labels := pprof.Labels(
+		profileLabelBuildID, strconv.FormatInt(req.GetBuildID(), 10),
+		profileLabelClusterID, req.GetClusterID(),
+		profileLabelIndexType, estimatorKey(req),
+		profileLabelPhase, phase,
+	)
+	var err error
+	pprof.Do(ctx, labels, func(ctx context.Context) {
+		err = fn(ctx)
+	})
+	return err
+}
+
+// ProfileHandler serves the profiles of the running tasks, the query parameters are:
+//   - type: cpu (default), goroutine or heap
+//   - seconds: the duration of the cpu profile, 30 by default
+//   - buildID: keep only the goroutines of the build in the goroutine profile
+//
+// The cpu profile carries the labels of the tasks, the heap profile isn't labeled by the go runtime.
+func ProfileHandler() http.Handler {
+	return http.HandlerFunc(serveProfile)
+}
+
+func serveProfile(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	switch profileType := query.Get("type"); profileType {
+	case "", "cpu":
+		seconds := defaultProfileSeconds
+		if s := query.Get("seconds"); s != "" {
+			var err error
+			seconds, err = strconv.Atoi(s)
+			if err != nil || seconds <= 0 || seconds > maxProfileSeconds {
+				http.Error(w, fmt.Sprintf("invalid seconds %q, should be in (0, %d]", s, maxProfileSeconds), http.StatusBadRequest)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+		if err := pprof.StartCPUProfile(w); err != nil {
+			http.Error(w, "failed to start cpu profile: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		select {
+		case <-time.After(time.Duration(seconds) * time.Second):
+		case <-req.Context().Done():
+		}
+		pprof.StopCPUProfile()
+	case "heap":
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="heap"`)
+		if err := pprof.Lookup("heap").WriteTo(w, 0); err != nil {
+			log.Warn("failed to write heap profile", zap.Error(err))
+		}
+	case "goroutine":
+		var buf bytes.Buffer
+		if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+			http.Error(w, "failed to write goroutine profile: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		buildID := query.Get("buildID")
+		if buildID == "" {
+			w.Write(buf.Bytes())
+			return
+		}
+		filtered, _ := watchdog.FilterGoroutineProfile(buf.Bytes(), profileLabelBuildID, buildID)
+		w.Write(filtered)
+	default:
+		http.Error(w, fmt.Sprintf("unknown profile type %q", profileType), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime/pprof"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestRunWithProfileLabels(t *testing.T) {
+	ft := &fakeTask{
+		ctx: context.Background(),
+		req: &indexpb.CreateJobRequest{
+			ClusterID: "c1",
+			BuildID:   10,
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: "HNSW"},
+			},
+		},
+	}
+	err := runWithProfileLabels(ft.Ctx(), ft, "execute", func(ctx context.Context) error {
+		for key, expected := range map[string]string{
+			profileLabelBuildID:   "10",
+			profileLabelClusterID: "c1",
+			profileLabelIndexType: "HNSW",
+			profileLabelPhase:     "execute",
+		} {
+			value, ok := pprof.Label(ctx, key)
+			assert.True(t, ok)
+			assert.Equal(t, expected, value)
+		}
+		return errCancel
+	})
+	assert.ErrorIs(t, err, errCancel)
+}
+
+func TestProfileHandler(t *testing.T) {
+	handler := ProfileHandler()
+
+	serve := func(url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusBadRequest, serve("/indexnode/profile?type=mutex").Code)
+	assert.Equal(t, http.StatusBadRequest, serve("/indexnode/profile?seconds=-1").Code)
+	assert.Equal(t, http.StatusOK, serve("/indexnode/profile?type=heap").Code)
+
+	started, done := make(chan struct{}), make(chan struct{})
+	labels := pprof.Labels(profileLabelBuildID, "10")
+	go pprof.Do(context.Background(), labels, func(context.Context) {
+		close(started)
+		<-done
+	})
+	defer close(done)
+	<-started
+	w := serve("/indexnode/profile?type=goroutine&buildID=10")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"buildID":"10"`)
+}
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// transientErrorPatterns are the messages of the errors which may disappear by retrying later, the errors raised
+// by the CGO index build are only available as messages
+var transientErrorPatterns = []string{
+	"bad_alloc",
+	"cannot allocate memory",
+	"SlowDown",
+	"RequestTimeout",
+	"ServiceUnavailable",
+	"InternalError",
+	"connection reset",
+}
+
+// isTransientError reports whether the task failed with err may succeed by retrying it later on the same node,
+// e.g. a blip of the object storage or an OOM which may disappear after the other tasks finish.
+// The errors classified by merr are retried as merr tells, the others and the errors of the CGO index build
+// are checked by their types and messages.
+func isTransientError(err error) bool {
+	if err == nil || errors.Is(err, errCancel) || errors.Is(err, context.Canceled) ||
+		errors.Is(err, ErrNoSuchKey) || storage.IsErrNoSuchKey(err) {
+		return false
+	}
+	if merr.IsRetriable(err) {
+		return true
+	}
+	// the errors raised by the CGO index build are all merr.ErrSegcore, which are told by their messages below
+	if merr.FaultDomain(err) != merr.FaultUnknown && !errors.Is(err, merr.ErrSegcore) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var respErr minio.ErrorResponse
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusTooManyRequests || respErr.StatusCode >= http.StatusInternalServerError
+	}
+	msg := err.Error()
+	for _, pattern := range transientErrorPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns the jittered backoff before the given attempt, the base backoff is doubled per attempt
+func retryBackoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	backoff := Params.IndexNodeCfg.TaskRetryBackoff.GetAsDuration(time.Second) << (attempt - 1)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// retryLocally reports whether the task failed with err should be re-enqueued for a local retry instead of reporting
+// the failure to the coordinator, it returns false if the error isn't transient or the attempts are exhausted.
+func (sched *TaskScheduler) retryLocally(t task, err error) bool {
+	maxAttempts := Params.IndexNodeCfg.TaskRetryMaxAttempts.GetAsInt()
+	if maxAttempts <= 0 || !isTransientError(err) {
+		return false
+	}
+	sched.retryMu.Lock()
+	defer sched.retryMu.Unlock()
+	attempt := sched.retryAttempts[t.Name()] + 1
+	if attempt > maxAttempts {
+		return false
+	}
+	sched.retryAttempts[t.Name()] = attempt
+	log.Ctx(t.Ctx()).Warn("task failed with transient error, retry it locally",
+		zap.String("task", t.Name()),
+		zap.Int("attempt", attempt),
+		zap.Int("maxAttempts", maxAttempts),
+		zap.Error(err))
+	metrics.IndexNodeTaskLocalRetryCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(t.GetRequest().GetClusterID())).Inc()
+	return true
+}
+
+// requeueLater re-enqueues the task after the backoff of its current attempt, the task is reported to the
+// coordinator as to retry if it's dropped or the scheduler is closed meanwhile.
+func (sched *TaskScheduler) requeueLater(t task, err error) {
+	sched.retryMu.Lock()
+	backoff := retryBackoff(sched.retryAttempts[t.Name()])
+	sched.retryMu.Unlock()
+
+	sched.wg.Add(1)
+	go func() {
+		defer sched.wg.Done()
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+		select {
+		case <-sched.ctx.Done():
+			sched.abandonRetry(t, err)
+		case <-t.Ctx().Done():
+			sched.abandonRetry(t, errCancel)
+		case <-timer.C:
+			if err := sched.enqueue(t); err != nil {
+				log.Ctx(t.Ctx()).Warn("failed to re-enqueue task for local retry", zap.String("task", t.Name()), zap.Error(err))
+				sched.abandonRetry(t, err)
+			}
+		}
+	}()
+}
+
+// abandonRetry reports the task waiting for a local retry to the coordinator as to retry and releases it
+func (sched *TaskScheduler) abandonRetry(t task, err error) {
+	t.SetState(commonpb.IndexState_Retry, err.Error())
+	sched.finishTask(t)
+}
+
+func (sched *TaskScheduler) forgetRetry(name string) {
+	sched.retryMu.Lock()
+	defer sched.retryMu.Unlock()
+	delete(sched.retryAttempts, name)
+}
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestIsTransientError(t *testing.T) {
+	assert.False(t, isTransientError(nil))
+	assert.False(t, isTransientError(errCancel))
+	assert.False(t, isTransientError(context.Canceled))
+	assert.False(t, isTransientError(ErrNoSuchKey))
+	assert.False(t, isTransientError(errors.New("auth failed")))
+	assert.False(t, isTransientError(minio.ErrorResponse{Code: "AccessDenied", StatusCode: http.StatusForbidden}))
+	assert.False(t, isTransientError(merr.WrapErrParameterInvalidMsg("invalid index params")))
+	// the temp dir quota isn't released by retrying on the same node
+	assert.False(t, isTransientError(merr.WrapErrServiceDiskLimitExceeded(2, 1)))
+
+	assert.True(t, isTransientError(context.DeadlineExceeded))
+	assert.True(t, isTransientError(merr.WrapErrIoFailed("key", "read failed")))
+	assert.True(t, isTransientError(merr.WrapErrServiceUnavailable("storage unreachable")))
+	assert.True(t, isTransientError(errors.Wrap(minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}, "load")))
+	assert.True(t, isTransientError(errors.New("std::bad_alloc")))
+	// the errors of the CGO index build are checked by their messages
+	assert.True(t, isTransientError(merr.WrapErrSegcore(2001, "std::bad_alloc")))
+	assert.True(t, isTransientError(errors.Wrap(merr.WrapErrSegcore(2001, "upload index: SlowDown"), "build index")))
+	assert.False(t, isTransientError(merr.WrapErrSegcore(2001, "invalid metric type")))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.TaskRetryBackoff.Key, "2")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.TaskRetryBackoff.Key)
+
+	for attempt, base := range []time.Duration{2 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		backoff := retryBackoff(attempt)
+		assert.GreaterOrEqual(t, backoff, base/2)
+		assert.LessOrEqual(t, backoff, base)
+	}
+}
+
+// flakyTask fails to execute with a transient error for the given times
+type flakyTask struct {
+	fakeTask
+	failures int
+	executed int
+	released chan struct{}
+}
+
+func (t *flakyTask) OnEnqueue(ctx context.Context) error   { return nil }
+func (t *flakyTask) Prepare(ctx context.Context) error     { return nil }
+func (t *flakyTask) PostExecute(ctx context.Context) error { return nil }
+func (t *flakyTask) Reset()                                { close(t.released) }
+
+func (t *flakyTask) Execute(ctx context.Context) error {
+	t.executed++
+	if t.executed <= t.failures {
+		return errors.New("std::bad_alloc")
+	}
+	return nil
+}
+
+func TestTaskSchedulerRetryLocally(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.TaskRetryBackoff.Key, "0.01")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.TaskRetryBackoff.Key)
+
+	run := func(failures int) *flakyTask {
+		scheduler := NewTaskScheduler(context.TODO())
+		scheduler.Start()
+		defer scheduler.Close()
+
+		task := &flakyTask{fakeTask: fakeTask{id: failures, ctx: context.TODO()}, failures: failures, released: make(chan struct{})}
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(task))
+		select {
+		case <-task.released:
+		case <-time.After(10 * time.Second):
+			assert.FailNow(t, "task isn't released")
+		}
+		assert.Empty(t, scheduler.retryAttempts)
+		return task
+	}
+
+	t.Run("succeed after retry", func(t *testing.T) {
+		task := run(2)
+		assert.Equal(t, 3, task.executed)
+		assert.Equal(t, commonpb.IndexState_Finished, task.GetState())
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		task := run(3)
+		assert.Equal(t, 3, task.executed)
+		assert.Equal(t, commonpb.IndexState_Retry, task.GetState())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexNodeCfg.TaskRetryMaxAttempts.Key, "0")
+		defer paramtable.Get().Reset(Params.IndexNodeCfg.TaskRetryMaxAttempts.Key)
+		task := run(1)
+		assert.Equal(t, 1, task.executed)
+		assert.Equal(t, commonpb.IndexState_Retry, task.GetState())
+	})
+}
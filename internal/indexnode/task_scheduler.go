@@ -17,19 +17,29 @@
 package indexnode
 
 import (
-	"container/list"
 	"context"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/crashreport"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/util/watchdog"
 )
 
 // TaskQueue is a queue used to store tasks.
@@ -43,11 +53,25 @@ type TaskQueue interface {
 	PopActiveTask(tName string) task
 	Enqueue(t task) error
 	GetTaskNum() (int, int)
+	oldestWait() time.Duration
+	checkWaitSLO()
+}
+
+// waitSLOCheckInterval is the interval at which the queued tasks are checked against the wait SLO
+var waitSLOCheckInterval = 10 * time.Second
+
+// waitingTask is an unissued task with the time it's enqueued.
+type waitingTask struct {
+	t          task
+	enqueuedAt time.Time
+	// overdue is set once the task is reported to exceed the wait SLO while it's still queued
+	overdue bool
 }
 
 // BaseTaskQueue is a basic instance of TaskQueue.
 type IndexTaskQueue struct {
-	unissuedTasks *list.List
+	unissuedTasks SchedulePolicy
+	waiting       map[string]*waitingTask
 	activeTasks   map[string]task
 	utLock        sync.Mutex
 	atLock        sync.Mutex
@@ -57,6 +81,9 @@ type IndexTaskQueue struct {
 
 	utBufChan chan int // to block scheduler
 
+	// fastPath is set for the queue of small jobs, which isn't counted in the queue depth
+	fastPath bool
+
 	sched *TaskScheduler
 }
 
@@ -79,7 +106,11 @@ func (queue *IndexTaskQueue) addUnissuedTask(t task) error {
 	if queue.utFull() {
 		return errors.New("IndexNode task queue is full")
 	}
-	queue.unissuedTasks.PushBack(t)
+	queue.unissuedTasks.Push(t)
+	queue.waiting[t.Name()] = &waitingTask{t: t, enqueuedAt: time.Now()}
+	if !queue.fastPath {
+		metrics.IndexNodeTaskQueueDepth.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(queue.unissuedTasks.Len()))
+	}
 	queue.utBufChan <- 1
 	return nil
 }
@@ -89,14 +120,78 @@ func (queue *IndexTaskQueue) PopUnissuedTask() task {
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
 
-	if queue.unissuedTasks.Len() <= 0 {
+	t := queue.unissuedTasks.Pop()
+	if t == nil {
 		return nil
 	}
+	depth := queue.unissuedTasks.Len()
+	if !queue.fastPath {
+		metrics.IndexNodeTaskQueueDepth.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(depth))
+	}
+	if w, ok := queue.waiting[t.Name()]; ok {
+		delete(queue.waiting, t.Name())
+		wait := time.Since(w.enqueuedAt)
+		if !queue.fastPath {
+			queue.sched.waits.observe(wait)
+		}
+		if !w.overdue {
+			queue.reportOverdue(t, wait, depth, false)
+		}
+	}
+	return t
+}
+
+// checkWaitSLO reports the queued tasks which have waited longer than the SLO, so that a stuck queue is
+// reported before its tasks are started. Each task is reported once.
+func (queue *IndexTaskQueue) checkWaitSLO() {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	depth := queue.unissuedTasks.Len()
+	for _, w := range queue.waiting {
+		if !w.overdue {
+			w.overdue = queue.reportOverdue(w.t, time.Since(w.enqueuedAt), depth, true)
+		}
+	}
+}
+
+// reportOverdue emits a warning event if the task has waited in the queue longer than the SLO, the latency
+// of the tasks in queue is observed by IndexNodeIndexTaskLatencyInQueue once they're finished.
+// It returns whether the SLO is exceeded, the utLock must be held by caller.
+func (queue *IndexTaskQueue) reportOverdue(t task, wait time.Duration, depth int, queued bool) bool {
+	slo := Params.IndexNodeCfg.TaskWaitSLO.GetAsDuration(time.Second)
+	if slo <= 0 || wait <= slo {
+		return false
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.IndexNodeTaskWaitSLOViolationCounter.WithLabelValues(nodeID, clusterIDLabel(t.GetRequest().GetClusterID())).Inc()
+	state := "started after waiting"
+	if queued {
+		state = "still queued after"
+	}
+	log.Ctx(t.Ctx()).Warn("IndexNode task waited in queue longer than SLO",
+		zap.String("task", t.Name()),
+		zap.Bool("queued", queued),
+		zap.Duration("wait", wait),
+		zap.Duration("slo", slo),
+		zap.Int("queueDepth", depth))
+	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+		fmt.Sprintf("IndexNode %s task %s %s %s in queue, longer than SLO %s, %d tasks are queued", nodeID, t.Name(), state, wait, slo, depth)))
+	return true
+}
 
-	ft := queue.unissuedTasks.Front()
-	queue.unissuedTasks.Remove(ft)
+// oldestWait returns how long the oldest unissued task has waited in the queue, 0 if the queue is empty.
+func (queue *IndexTaskQueue) oldestWait() time.Duration {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
 
-	return ft.Value.(task)
+	var oldest time.Duration
+	for _, w := range queue.waiting {
+		if wait := time.Since(w.enqueuedAt); wait > oldest {
+			oldest = wait
+		}
+	}
+	return oldest
 }
 
 // AddActiveTask adds a task to activeTasks.
@@ -153,13 +248,27 @@ func (queue *IndexTaskQueue) GetTaskNum() (int, int) {
 	return utNum, atNum
 }
 
-// NewIndexBuildTaskQueue creates a new IndexBuildTaskQueue.
+// NewIndexBuildTaskQueue creates a new IndexBuildTaskQueue, the unissued tasks are issued by the configured policy.
 func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 	return &IndexTaskQueue{
-		unissuedTasks: list.New(),
+		unissuedTasks: newSchedulePolicy(Params.IndexNodeCfg.SchedulePolicy.GetValue(), sched.estimator),
+		waiting:       make(map[string]*waitingTask),
+		activeTasks:   make(map[string]task),
+		maxTaskNum:    1024,
+		utBufChan:     make(chan int, 1024),
+		sched:         sched,
+	}
+}
+
+// NewSmallTaskQueue creates a new queue for the small jobs, the unissued tasks are issued in fifo order.
+func NewSmallTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
+	return &IndexTaskQueue{
+		unissuedTasks: newFIFOPolicy(),
+		waiting:       make(map[string]*waitingTask),
 		activeTasks:   make(map[string]task),
 		maxTaskNum:    1024,
 		utBufChan:     make(chan int, 1024),
+		fastPath:      true,
 		sched:         sched,
 	}
 }
@@ -167,9 +276,28 @@ func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 // TaskScheduler is a scheduler of indexing tasks.
 type TaskScheduler struct {
 	IndexBuildQueue TaskQueue
+	// SmallTaskQueue holds the index builds of tiny segments, which are run by a dedicated pool of workers
+	// instead of waiting for the build slots occupied by the big builds
+	SmallTaskQueue TaskQueue
 
+	// buildParallel is refreshed by the config changes, guarded by parallelMu
+	parallelMu    sync.RWMutex
 	buildParallel int
-	wg            sync.WaitGroup
+	smallParallel int
+	// paramsChanged signals the build loop to refresh the params
+	paramsChanged chan struct{}
+	paramsWatcher config.EventHandler
+	estimator     *buildDurationEstimator
+	// estimatorKV persists the estimator, nil if the estimator is kept in memory only
+	estimatorKV  kv.BaseKV
+	watchdog     *watchdog.Watchdog
+	dependencies *dependencyGraph
+	// waits keeps the recent waits of the jobs in the build queue
+	waits *waitWindow
+	wg    sync.WaitGroup
+
+	retryMu       sync.Mutex
+	retryAttempts map[string]int // task name -> attempts of local retry
 	ctx           context.Context
 	cancel        context.CancelFunc
 }
@@ -181,15 +309,31 @@ func NewTaskScheduler(ctx context.Context) *TaskScheduler {
 		ctx:           ctx1,
 		cancel:        cancel,
 		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		smallParallel: Params.IndexNodeCfg.SmallJobParallel.GetAsInt(),
+		estimator:     newBuildDurationEstimator(),
+		watchdog:      watchdog.New(typeutil.IndexNodeRole),
+		dependencies:  newDependencyGraph(),
+		waits:         newWaitWindow(),
+		retryAttempts: make(map[string]int),
+		paramsChanged: make(chan struct{}, 1),
 	}
+	// the watcher is called with the config lock held, so it only signals the build loop to read the params
+	s.paramsWatcher = config.NewHandler(fmt.Sprintf("indexNodeScheduler-%p", s), func(*config.Event) {
+		select {
+		case s.paramsChanged <- struct{}{}:
+		default:
+		}
+	})
 	s.IndexBuildQueue = NewIndexBuildTaskQueue(s)
+	s.SmallTaskQueue = NewSmallTaskQueue(s)
 
 	return s
 }
 
 func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 	ret := make([]task, 0)
-	for i := 0; i < sched.buildParallel; i++ {
+	buildParallel := sched.getBuildParallel()
+	for i := 0; i < buildParallel; i++ {
 		t := sched.IndexBuildQueue.PopUnissuedTask()
 		if t == nil {
 			return ret
@@ -200,30 +344,66 @@ func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 }
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
-	wrap := func(fn func(ctx context.Context) error) error {
+	wrap := func(phase string, fn func(ctx context.Context) error) error {
 		select {
 		case <-t.Ctx().Done():
 			return errCancel
 		default:
-			return fn(t.Ctx())
+			start := time.Now()
+			err := runWithProfileLabels(t.Ctx(), t, phase, fn)
+			slowlog.Record(t.Ctx(), slowlog.KindBuildPhase, phase, time.Since(start),
+				slowlog.F("buildID", strconv.FormatInt(t.GetRequest().GetBuildID(), 10)),
+				slowlog.F("clusterID", t.GetRequest().GetClusterID()),
+				slowlog.F("indexType", estimatorKey(t.GetRequest())))
+			return err
 		}
 	}
 
+	// the task failed with a transient error is kept as is and re-enqueued once it's no longer active
+	var retryErr error
 	defer func() {
-		t.Reset()
+		if retryErr != nil {
+			sched.requeueLater(t, retryErr)
+		} else {
+			sched.finishTask(t)
+		}
 		debug.FreeOSMemory()
 	}()
-	sched.IndexBuildQueue.AddActiveTask(t)
-	defer sched.IndexBuildQueue.PopActiveTask(t.Name())
+	// a panic of the task is reported as retry instead of killing the worker, so the coordinator reassigns the task,
+	// it's recovered before the task is finished
+	defer crashreport.Recover("indexnode-task", map[string]string{
+		"task":      t.Name(),
+		"buildID":   strconv.FormatInt(t.GetRequest().GetBuildID(), 10),
+		"clusterID": t.GetRequest().GetClusterID(),
+		"jobType":   t.GetRequest().GetJobType().String(),
+	}, func(err error) {
+		t.SetState(commonpb.IndexState_Retry, err.Error())
+	})
+	q.AddActiveTask(t)
+	defer q.PopActiveTask(t.Name())
+	// the goroutines of the task are found by the build id label set by runWithProfileLabels once it's stuck
+	defer sched.watchdog.Watch(t.Name(), sched.estimator.historical(t.GetRequest()),
+		profileLabelBuildID, strconv.FormatInt(t.GetRequest().GetBuildID(), 10))()
 	log.Ctx(t.Ctx()).Debug("process task", zap.String("task", t.Name()))
-	pipelines := []func(context.Context) error{t.Prepare, t.BuildIndex, t.SaveIndexFiles}
-	for _, fn := range pipelines {
-		if err := wrap(fn); err != nil {
+	start := time.Now()
+	pipelines := []struct {
+		phase string
+		fn    func(context.Context) error
+	}{
+		{"prepare", t.Prepare},
+		{"execute", t.Execute},
+		{"postExecute", t.PostExecute},
+	}
+	for _, p := range pipelines {
+		if err := wrap(p.phase, p.fn); err != nil {
 			if errors.Is(err, errCancel) {
-				log.Ctx(t.Ctx()).Warn("index build task canceled, retry it", zap.String("task", t.Name()))
+				log.Ctx(t.Ctx()).Warn("task canceled, retry it", zap.String("task", t.Name()))
 				t.SetState(commonpb.IndexState_Retry, err.Error())
-			} else if errors.Is(err, ErrNoSuchKey) {
+			} else if errors.Is(err, ErrNoSuchKey) || merr.FaultDomain(err) == merr.FaultUser {
+				// retrying the task never succeeds
 				t.SetState(commonpb.IndexState_Failed, err.Error())
+			} else if sched.retryLocally(t, err) {
+				retryErr = err
 			} else {
 				t.SetState(commonpb.IndexState_Retry, err.Error())
 			}
@@ -231,9 +411,34 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		}
 	}
 	t.SetState(commonpb.IndexState_Finished, "")
+	sched.estimator.observe(t.GetRequest(), time.Since(start))
 	if indexBuildTask, ok := t.(*indexBuildTask); ok {
-		metrics.IndexNodeBuildIndexLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(indexBuildTask.tr.ElapseSpan().Seconds())
-		metrics.IndexNodeIndexTaskLatencyInQueue.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(indexBuildTask.queueDur.Milliseconds()))
+		nodeID, clusterID := fmt.Sprint(paramtable.GetNodeID()), clusterIDLabel(t.GetRequest().GetClusterID())
+		metrics.ObserveWithTrace(t.Ctx(), metrics.IndexNodeBuildIndexLatency.WithLabelValues(nodeID, clusterID),
+			indexBuildTask.tr.ElapseSpan().Seconds())
+		metrics.IndexNodeIndexTaskLatencyInQueue.WithLabelValues(nodeID, clusterID).Observe(float64(indexBuildTask.queueDur.Milliseconds()))
+	}
+}
+
+func (sched *TaskScheduler) getBuildParallel() int {
+	sched.parallelMu.RLock()
+	defer sched.parallelMu.RUnlock()
+	return sched.buildParallel
+}
+
+// refreshBuildParallel applies the changed build parallel, which takes effect from the next round of builds.
+func (sched *TaskScheduler) refreshBuildParallel() {
+	buildParallel := Params.IndexNodeCfg.BuildParallel.GetAsInt()
+	if buildParallel < 1 {
+		log.Warn("ignore the invalid build parallel", zap.Int("buildParallel", buildParallel))
+		return
+	}
+	sched.parallelMu.Lock()
+	defer sched.parallelMu.Unlock()
+	if sched.buildParallel != buildParallel {
+		log.Info("IndexNode TaskScheduler build parallel changed",
+			zap.Int("old", sched.buildParallel), zap.Int("new", buildParallel))
+		sched.buildParallel = buildParallel
 	}
 }
 
@@ -244,6 +449,8 @@ func (sched *TaskScheduler) indexBuildLoop() {
 		select {
 		case <-sched.ctx.Done():
 			return
+		case <-sched.paramsChanged:
+			sched.refreshBuildParallel()
 		case <-sched.IndexBuildQueue.utChan():
 			tasks := sched.scheduleIndexBuildTask()
 			var wg sync.WaitGroup
@@ -259,15 +466,105 @@ func (sched *TaskScheduler) indexBuildLoop() {
 	}
 }
 
+// waitSLOLoop periodically reports the tasks stuck in the queues longer than the SLO.
+func (sched *TaskScheduler) waitSLOLoop() {
+	defer sched.wg.Done()
+	ticker := time.NewTicker(waitSLOCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sched.ctx.Done():
+			return
+		case <-ticker.C:
+			sched.IndexBuildQueue.checkWaitSLO()
+			sched.SmallTaskQueue.checkWaitSLO()
+		}
+	}
+}
+
+// smallTaskLoop is a worker of the small jobs, which runs a job once at a time.
+func (sched *TaskScheduler) smallTaskLoop() {
+	defer sched.wg.Done()
+	for {
+		select {
+		case <-sched.ctx.Done():
+			return
+		case <-sched.SmallTaskQueue.utChan():
+			if t := sched.SmallTaskQueue.PopUnissuedTask(); t != nil {
+				sched.processTask(t, sched.SmallTaskQueue)
+			}
+		}
+	}
+}
+
+// smallJobEnabled reports whether the small jobs take the fast path on their own workers.
+func (sched *TaskScheduler) smallJobEnabled() bool {
+	return sched.smallParallel > 0 && Params.IndexNodeCfg.SmallJobMaxRows.GetAsInt64() > 0
+}
+
+// isSmallTask reports whether the task is an index build of a tiny segment, which takes the fast path.
+func (sched *TaskScheduler) isSmallTask(t task) bool {
+	if !sched.smallJobEnabled() {
+		return false
+	}
+	maxRows := Params.IndexNodeCfg.SmallJobMaxRows.GetAsInt64()
+	req := t.GetRequest()
+	return req.GetJobType() == indexpb.JobType_JobTypeIndexJob && req.GetNumRows() > 0 && req.GetNumRows() < maxRows
+}
+
+// enqueue puts the task into the small job queue if it's small enough, otherwise into the build queue.
+func (sched *TaskScheduler) enqueue(t task) error {
+	if sched.isSmallTask(t) {
+		return sched.SmallTaskQueue.Enqueue(t)
+	}
+	return sched.IndexBuildQueue.Enqueue(t)
+}
+
+// GetTaskNum returns the numbers of the unissued and active tasks of both queues.
+func (sched *TaskScheduler) GetTaskNum() (int, int) {
+	unissued, active := sched.IndexBuildQueue.GetTaskNum()
+	smallUnissued, smallActive := sched.SmallTaskQueue.GetTaskNum()
+	return unissued + smallUnissued, active + smallActive
+}
+
+// freeSlots returns the number of the jobs the node is able to take, the workers of the small jobs count
+// as slots too and the small jobs occupy them, so that DataCoord doesn't overload the node with small jobs.
+func (sched *TaskScheduler) freeSlots() int {
+	capacity := sched.getBuildParallel()
+	if sched.smallJobEnabled() {
+		capacity += sched.smallParallel
+	}
+	unissued, active := sched.GetTaskNum()
+	if capacity <= unissued+active {
+		return 0
+	}
+	return capacity - unissued - active
+}
+
 // Start stats the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Start() error {
+	Params.Watch(Params.IndexNodeCfg.BuildParallel.Key, sched.paramsWatcher)
+	if sched.estimatorKV != nil {
+		sched.loadEstimator()
+		sched.wg.Add(1)
+		go sched.persistEstimatorLoop()
+	}
 	sched.wg.Add(1)
 	go sched.indexBuildLoop()
+	sched.wg.Add(1)
+	go sched.waitSLOLoop()
+	for i := 0; i < sched.smallParallel; i++ {
+		sched.wg.Add(1)
+		go sched.smallTaskLoop()
+	}
+	sched.watchdog.Start()
 	return nil
 }
 
 // Close closes the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Close() {
+	Params.Unwatch(Params.IndexNodeCfg.BuildParallel.Key, sched.paramsWatcher)
 	sched.cancel()
 	sched.wg.Wait()
+	sched.watchdog.Stop()
 }
@@ -3,13 +3,19 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -19,7 +25,6 @@ const (
 	fakeTaskInited = iota
 	fakeTaskEnqueued
 	fakeTaskPrepared
-	fakeTaskLoadedData
 	fakeTaskBuiltIndex
 	fakeTaskSavedIndexes
 )
@@ -75,6 +80,9 @@ type fakeTask struct {
 	retstate      commonpb.IndexState
 	expectedState commonpb.IndexState
 	failReason    string
+	req           *indexpb.CreateJobRequest
+	// panics makes Execute panic
+	panics bool
 }
 
 var _ task = &fakeTask{}
@@ -100,19 +108,16 @@ func (t *fakeTask) Prepare(ctx context.Context) error {
 	return t.reterr[t.state]
 }
 
-func (t *fakeTask) LoadData(ctx context.Context) error {
-	t.state = fakeTaskLoadedData
-	t.ctx.(*stagectx).setState(t.state)
-	return t.reterr[t.state]
-}
-
-func (t *fakeTask) BuildIndex(ctx context.Context) error {
+func (t *fakeTask) Execute(ctx context.Context) error {
 	t.state = fakeTaskBuiltIndex
 	t.ctx.(*stagectx).setState(t.state)
+	if t.panics {
+		panic("fake task panicked")
+	}
 	return t.reterr[t.state]
 }
 
-func (t *fakeTask) SaveIndexFiles(ctx context.Context) error {
+func (t *fakeTask) PostExecute(ctx context.Context) error {
 	t.state = fakeTaskSavedIndexes
 	t.ctx.(*stagectx).setState(t.state)
 	return t.reterr[t.state]
@@ -131,6 +136,10 @@ func (t *fakeTask) GetState() commonpb.IndexState {
 	return t.retstate
 }
 
+func (t *fakeTask) GetRequest() *indexpb.CreateJobRequest {
+	return t.req
+}
+
 var (
 	idLock sync.Mutex
 	id     = 0
@@ -169,6 +178,7 @@ func TestIndexTaskScheduler(t *testing.T) {
 		newTask(fakeTaskPrepared, nil, commonpb.IndexState_Retry),
 		newTask(fakeTaskBuiltIndex, nil, commonpb.IndexState_Retry),
 		newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished),
+		newTask(fakeTaskSavedIndexes, map[fakeTaskState]error{fakeTaskSavedIndexes: merr.WrapErrParameterInvalidMsg("invalid index params")}, commonpb.IndexState_Failed),
 		newTask(fakeTaskSavedIndexes, map[fakeTaskState]error{fakeTaskSavedIndexes: fmt.Errorf("auth failed")}, commonpb.IndexState_Retry))
 
 	for _, task := range tasks {
@@ -205,3 +215,170 @@ func TestIndexTaskScheduler(t *testing.T) {
 		assert.Equal(t, task.GetState(), commonpb.IndexState_Finished)
 	}
 }
+
+func TestIndexTaskSchedulerRecoverPanic(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	dir := t.TempDir()
+	params.Save(params.CommonCfg.CrashReportPath.Key, dir)
+	defer params.Reset(params.CommonCfg.CrashReportPath.Key)
+
+	scheduler := NewTaskScheduler(context.TODO())
+	scheduler.Start()
+	panicked := newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Retry).(*fakeTask)
+	panicked.panics = true
+	panicked.req = &indexpb.CreateJobRequest{ClusterID: "cluster", BuildID: 1}
+	assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(panicked))
+	_taskwg.Wait()
+
+	// the panicked task is retried by the coordinator, and the worker keeps running the following tasks
+	assert.Equal(t, commonpb.IndexState_Retry, panicked.GetState())
+	assert.Contains(t, panicked.failReason, "fake task panicked")
+	next := newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished)
+	assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(next))
+	_taskwg.Wait()
+	scheduler.Close()
+	scheduler.wg.Wait()
+	assert.Equal(t, commonpb.IndexState_Finished, next.GetState())
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestIndexTaskQueueWaitSLO(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.TaskWaitSLO.Key, "0.05")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.TaskWaitSLO.Key)
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	depth := metrics.IndexNodeTaskQueueDepth.WithLabelValues(nodeID)
+	violations := metrics.IndexNodeTaskWaitSLOViolationCounter.WithLabelValues(nodeID, "")
+	violated := testutil.ToFloat64(violations)
+
+	queue := NewTaskScheduler(context.TODO()).IndexBuildQueue.(*IndexTaskQueue)
+	slow := &fakeTask{id: 1, ctx: context.TODO()}
+	assert.NoError(t, queue.addUnissuedTask(slow))
+	assert.Equal(t, float64(1), testutil.ToFloat64(depth))
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, slow, queue.PopUnissuedTask())
+	assert.Equal(t, violated+1, testutil.ToFloat64(violations))
+	assert.Equal(t, float64(0), testutil.ToFloat64(depth))
+	assert.Empty(t, queue.waiting)
+
+	fast := &fakeTask{id: 2, ctx: context.TODO()}
+	assert.NoError(t, queue.addUnissuedTask(fast))
+	assert.Equal(t, fast, queue.PopUnissuedTask())
+	assert.Equal(t, violated+1, testutil.ToFloat64(violations))
+	assert.Nil(t, queue.PopUnissuedTask())
+}
+
+func TestIndexTaskQueueStuckWaitSLO(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.IndexNodeCfg.TaskWaitSLO.Key, "0.05")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.TaskWaitSLO.Key)
+
+	violations := metrics.IndexNodeTaskWaitSLOViolationCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), "")
+	violated := testutil.ToFloat64(violations)
+
+	queue := NewTaskScheduler(context.TODO()).IndexBuildQueue.(*IndexTaskQueue)
+	stuck := &fakeTask{id: 1, ctx: context.TODO()}
+	assert.NoError(t, queue.addUnissuedTask(stuck))
+	queue.checkWaitSLO()
+	assert.Equal(t, violated, testutil.ToFloat64(violations))
+
+	// the stuck task is reported while it's queued, and only once
+	time.Sleep(100 * time.Millisecond)
+	queue.checkWaitSLO()
+	assert.Equal(t, violated+1, testutil.ToFloat64(violations))
+	queue.checkWaitSLO()
+	assert.Equal(t, stuck, queue.PopUnissuedTask())
+	assert.Equal(t, violated+1, testutil.ToFloat64(violations))
+}
+
+// blockingTask executes until it's unblocked
+type blockingTask struct {
+	fakeTask
+	unblock  chan struct{}
+	released chan struct{}
+}
+
+func (t *blockingTask) OnEnqueue(ctx context.Context) error   { return nil }
+func (t *blockingTask) Prepare(ctx context.Context) error     { return nil }
+func (t *blockingTask) PostExecute(ctx context.Context) error { return nil }
+func (t *blockingTask) Reset()                                { close(t.released) }
+
+func (t *blockingTask) Execute(ctx context.Context) error {
+	<-t.unblock
+	return nil
+}
+
+func newBlockingTask(id int, numRows int64) *blockingTask {
+	return &blockingTask{
+		fakeTask: fakeTask{id: id, ctx: context.TODO(), req: &indexpb.CreateJobRequest{NumRows: numRows}},
+		unblock:  make(chan struct{}),
+		released: make(chan struct{}),
+	}
+}
+
+func TestTaskSchedulerSmallJobFastPath(t *testing.T) {
+	paramtable.Init()
+	scheduler := NewTaskScheduler(context.TODO())
+	scheduler.buildParallel = 1
+	scheduler.smallParallel = 1
+	scheduler.Start()
+	defer scheduler.Close()
+	// the small job worker counts as a slot
+	assert.Equal(t, 2, scheduler.freeSlots())
+
+	big := newBlockingTask(1, 1000000)
+	assert.False(t, scheduler.isSmallTask(big))
+	assert.NoError(t, scheduler.Enqueue(big))
+	queued := newBlockingTask(2, 1000000)
+	assert.NoError(t, scheduler.Enqueue(queued))
+
+	small := newBlockingTask(3, 2048)
+	assert.True(t, scheduler.isSmallTask(small))
+	assert.NoError(t, scheduler.Enqueue(small))
+	assert.Equal(t, 0, scheduler.freeSlots())
+	close(small.unblock)
+	select {
+	case <-small.released:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "small job is blocked by the big builds")
+	}
+	assert.Equal(t, commonpb.IndexState_Finished, small.GetState())
+	assert.Equal(t, commonpb.IndexState_IndexStateNone, queued.GetState())
+
+	close(big.unblock)
+	close(queued.unblock)
+	<-big.released
+	<-queued.released
+
+	paramtable.Get().Save(Params.IndexNodeCfg.SmallJobMaxRows.Key, "0")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.SmallJobMaxRows.Key)
+	assert.False(t, scheduler.isSmallTask(newBlockingTask(4, 2048)))
+	assert.False(t, scheduler.isSmallTask(&fakeTask{id: 5, ctx: context.TODO()}))
+	// the idle small job worker isn't a slot once the fast path is disabled
+	assert.LessOrEqual(t, scheduler.freeSlots(), 1)
+}
+
+func TestTaskSchedulerRefreshBuildParallel(t *testing.T) {
+	paramtable.Init()
+	scheduler := NewTaskScheduler(context.TODO())
+	scheduler.Start()
+	defer scheduler.Close()
+	assert.Equal(t, Params.IndexNodeCfg.BuildParallel.GetAsInt(), scheduler.getBuildParallel())
+
+	paramtable.Get().Save(Params.IndexNodeCfg.BuildParallel.Key, "3")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.BuildParallel.Key)
+	scheduler.paramsWatcher.OnEvent(&config.Event{Key: Params.IndexNodeCfg.BuildParallel.Key, Value: "3"})
+	assert.Eventually(t, func() bool {
+		return scheduler.getBuildParallel() == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the invalid value is ignored
+	paramtable.Get().Save(Params.IndexNodeCfg.BuildParallel.Key, "0")
+	scheduler.refreshBuildParallel()
+	assert.Equal(t, 3, scheduler.getBuildParallel())
+}
@@ -14,33 +14,30 @@ import (
 )
 
 func (i *IndexNode) loadOrStoreTask(ClusterID string, buildID UniqueID, info *taskInfo) *taskInfo {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	oldInfo, ok := i.tasks[key]
-	if ok {
+	oldInfo, loaded := i.tasks.GetOrInsert(key, info)
+	if loaded {
 		return oldInfo
 	}
-	i.tasks[key] = info
 	return nil
 }
 
 func (i *IndexNode) loadTaskState(ClusterID string, buildID UniqueID) commonpb.IndexState {
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	task, ok := i.tasks[key]
+	task, ok := i.tasks.Get(key)
 	if !ok {
 		return commonpb.IndexState_IndexStateNone
 	}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
 	return task.state
 }
 
 func (i *IndexNode) storeTaskState(ClusterID string, buildID UniqueID, state commonpb.IndexState, failReason string) {
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	if task, ok := i.tasks[key]; ok {
+	if task, ok := i.tasks.Get(key); ok {
+		i.stateLock.Lock()
+		defer i.stateLock.Unlock()
 		log.Debug("IndexNode store task state", zap.String("clusterID", ClusterID), zap.Int64("buildID", buildID),
 			zap.String("state", state.String()), zap.String("fail reason", failReason))
 		task.state = state
@@ -48,19 +45,21 @@ func (i *IndexNode) storeTaskState(ClusterID string, buildID UniqueID, state com
 	}
 }
 
+// foreachTaskInfo calls fn on a snapshot of the tasks, the fields of the infos are guarded by stateLock during the calls
 func (i *IndexNode) foreachTaskInfo(fn func(ClusterID string, buildID UniqueID, info *taskInfo)) {
+	tasks := i.tasks.Snapshot()
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
-	for key, info := range i.tasks {
+	for key, info := range tasks {
 		fn(key.ClusterID, key.BuildID, info)
 	}
 }
 
 func (i *IndexNode) storeIndexFilesAndStatistic(ClusterID string, buildID UniqueID, fileKeys []string, serializedSize uint64, statistic *indexpb.JobInfo) {
 	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
-	if info, ok := i.tasks[key]; ok {
+	if info, ok := i.tasks.Get(key); ok {
+		i.stateLock.Lock()
+		defer i.stateLock.Unlock()
 		info.fileKeys = common.CloneStringList(fileKeys)
 		info.serializedSize = serializedSize
 		info.statistic = proto.Clone(statistic).(*indexpb.JobInfo)
@@ -68,15 +67,23 @@ func (i *IndexNode) storeIndexFilesAndStatistic(ClusterID string, buildID Unique
 	}
 }
 
+func (i *IndexNode) storeStatsResult(ClusterID string, buildID UniqueID, result *indexpb.StatsJobResult, serializedSize uint64, statistic *indexpb.JobInfo) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	if info, ok := i.tasks.Get(key); ok {
+		i.stateLock.Lock()
+		defer i.stateLock.Unlock()
+		info.statsResult = proto.Clone(result).(*indexpb.StatsJobResult)
+		info.serializedSize = serializedSize
+		info.statistic = proto.Clone(statistic).(*indexpb.JobInfo)
+	}
+}
+
 func (i *IndexNode) deleteTaskInfos(ctx context.Context, keys []taskKey) []*taskInfo {
-	i.stateLock.Lock()
-	defer i.stateLock.Unlock()
 	deleted := make([]*taskInfo, 0, len(keys))
 	for _, key := range keys {
-		info, ok := i.tasks[key]
+		info, ok := i.tasks.GetAndRemove(key)
 		if ok {
 			deleted = append(deleted, info)
-			delete(i.tasks, key)
 			log.Ctx(ctx).Info("delete task infos",
 				zap.String("cluster_id", key.ClusterID), zap.Int64("build_id", key.BuildID))
 		}
@@ -84,23 +91,23 @@ func (i *IndexNode) deleteTaskInfos(ctx context.Context, keys []taskKey) []*task
 	return deleted
 }
 
+// deleteAllTasks deletes the tasks in a snapshot, the ones stored meanwhile are kept
 func (i *IndexNode) deleteAllTasks() []*taskInfo {
-	i.stateLock.Lock()
-	deletedTasks := i.tasks
-	i.tasks = make(map[taskKey]*taskInfo)
-	i.stateLock.Unlock()
-
-	deleted := make([]*taskInfo, 0, len(deletedTasks))
-	for _, info := range deletedTasks {
-		deleted = append(deleted, info)
+	tasks := i.tasks.Snapshot()
+	deleted := make([]*taskInfo, 0, len(tasks))
+	for key := range tasks {
+		if info, ok := i.tasks.GetAndRemove(key); ok {
+			deleted = append(deleted, info)
+		}
 	}
 	return deleted
 }
 
 func (i *IndexNode) hasInProgressTask() bool {
+	tasks := i.tasks.Snapshot()
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
-	for _, info := range i.tasks {
+	for _, info := range tasks {
 		if info.state == commonpb.IndexState_InProgress {
 			return true
 		}
@@ -127,11 +134,11 @@ func (i *IndexNode) waitTaskFinish() {
 			}
 		case <-timeoutCtx.Done():
 			log.Warn("timeout, the index node has some progress task")
-			for _, info := range i.tasks {
+			i.foreachTaskInfo(func(ClusterID string, buildID UniqueID, info *taskInfo) {
 				if info.state == commonpb.IndexState_InProgress {
-					log.Warn("progress task", zap.Any("info", info))
+					log.Warn("progress task", zap.String("clusterID", ClusterID), zap.Int64("buildID", buildID), zap.Any("info", info))
 				}
-			}
+			})
 			return
 		}
 	}
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// the local directories of the index files and the raw data of the disk builds, see internal/core/src/common/Consts.h
+const (
+	localIndexFilesDir = "index_files"
+	localRawDataDir    = "raw_datas"
+)
+
+// tempDir is the local disk space reserved by a disk build, the index files and the raw data of the build are
+// written into its per-task directories by segcore.
+type tempDir struct {
+	name  string
+	size  int64
+	paths []string
+}
+
+// tempDirManager manages the temporary files of the disk builds under the local root of segcore. The size of the
+// concurrent builds is limited by a quota, and the files are removed once the build is done, or on startup if
+// index node crashed during the build.
+type tempDirManager struct {
+	root string
+
+	mu       sync.Mutex
+	reserved int64
+	dirs     map[string]*tempDir
+}
+
+func newTempDirManager(root string) *tempDirManager {
+	return &tempDirManager{
+		root: root,
+		dirs: make(map[string]*tempDir),
+	}
+}
+
+// tempDirRoot returns the local root of the temporary files of the disk builds.
+func tempDirRoot() string {
+	if path := Params.IndexNodeCfg.TempDirPath.GetValue(); path != "" {
+		return path
+	}
+	return filepath.Join(Params.LocalStorageCfg.Path.GetValue(), typeutil.IndexNodeRole)
+}
+
+// quota returns the max size in bytes of the temporary files of the concurrent builds.
+func (m *tempDirManager) quota() int64 {
+	if quota := Params.IndexNodeCfg.TempDirQuota.GetAsInt64(); quota > 0 {
+		return quota * 1024 * 1024
+	}
+	return int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+}
+
+// watermark returns the ratio of the reserved size to the quota, 0 if there is no quota.
+func (m *tempDirManager) watermark() float64 {
+	quota := m.quota()
+	if quota <= 0 {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return float64(m.reserved) / float64(quota)
+}
+
+// cleanOrphans removes the temporary files not owned by the running builds, which are left by a crashed index node.
+func (m *tempDirManager) cleanOrphans() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	owned := make(map[string]struct{})
+	for _, dir := range m.dirs {
+		for _, path := range dir.paths {
+			owned[path] = struct{}{}
+		}
+	}
+	removed := 0
+	for _, sub := range []string{localIndexFilesDir, localRawDataDir} {
+		parent := filepath.Join(m.root, sub)
+		entries, err := os.ReadDir(parent)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Warn("failed to list the temporary files", zap.String("path", parent), zap.Error(err))
+			}
+			continue
+		}
+		for _, entry := range entries {
+			path := filepath.Join(parent, entry.Name())
+			if _, ok := owned[path]; ok {
+				continue
+			}
+			if err := os.RemoveAll(path); err != nil {
+				log.Warn("failed to remove the orphan temporary files", zap.String("path", path), zap.Error(err))
+				continue
+			}
+			removed++
+		}
+	}
+	log.Info("orphan temporary files of the disk builds cleaned", zap.String("root", m.root), zap.Int("removed", removed))
+}
+
+// acquire reserves size bytes of the local disk for the build, the build fails fast if the quota is exhausted by
+// the concurrent builds.
+func (m *tempDirManager) acquire(name string, buildID, segmentID, fieldID UniqueID, size int64) (*tempDir, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if quota := m.quota(); quota > 0 && m.reserved+size > quota {
+		log.Warn("quota of the temporary files exhausted", zap.String("task", name),
+			zap.Int64("size", size), zap.Int64("reserved", m.reserved), zap.Int64("quota", quota))
+		return nil, merr.WrapErrServiceDiskLimitExceeded(float32(m.reserved+size), float32(quota),
+			"quota of the temporary files of the disk builds exhausted")
+	}
+	dir := &tempDir{
+		name: name,
+		size: size,
+		paths: []string{
+			filepath.Join(m.root, localIndexFilesDir, strconv.FormatInt(buildID, 10)),
+			filepath.Join(m.root, localRawDataDir, strconv.FormatInt(segmentID, 10), strconv.FormatInt(fieldID, 10)),
+		},
+	}
+	m.dirs[name] = dir
+	m.reserved += size
+	metrics.IndexNodeTempDirReservedSize.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Set(float64(m.reserved))
+	return dir, nil
+}
+
+// release removes the temporary files of the build and returns its reserved space to the quota.
+func (m *tempDirManager) release(dir *tempDir) {
+	if dir == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.dirs[dir.name] != dir {
+		return
+	}
+	for _, path := range dir.paths {
+		if err := os.RemoveAll(path); err != nil {
+			log.Warn("failed to remove the temporary files", zap.String("task", dir.name), zap.String("path", path), zap.Error(err))
+		}
+	}
+	delete(m.dirs, dir.name)
+	m.reserved -= dir.size
+	metrics.IndexNodeTempDirReservedSize.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Set(float64(m.reserved))
+}
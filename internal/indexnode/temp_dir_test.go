@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestTempDirManager(t *testing.T) {
+	paramtable.Get().Save(Params.IndexNodeCfg.TempDirQuota.Key, "1")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.TempDirQuota.Key)
+
+	m := newTempDirManager(t.TempDir())
+	dir1, err := m.acquire("c/1", 1, 10, 100, 768*1024)
+	require.NoError(t, err)
+
+	_, err = m.acquire("c/2", 2, 10, 101, 512*1024)
+	assert.ErrorIs(t, err, merr.ErrServiceDiskLimitExceeded)
+	assert.False(t, isTransientError(err))
+
+	for _, path := range dir1.paths {
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "data"), []byte("data"), 0o644))
+	}
+	m.release(dir1)
+	for _, path := range dir1.paths {
+		assert.NoDirExists(t, path)
+	}
+	// released twice
+	m.release(dir1)
+	assert.Equal(t, int64(0), m.reserved)
+
+	dir2, err := m.acquire("c/2", 2, 10, 101, 512*1024)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512*1024), m.reserved)
+	m.release(dir2)
+}
+
+func TestTempDirManagerCleanOrphans(t *testing.T) {
+	m := newTempDirManager(t.TempDir())
+	// nothing to clean
+	m.cleanOrphans()
+
+	dir, err := m.acquire("c/1", 1, 10, 100, 0)
+	require.NoError(t, err)
+	orphans := []string{
+		filepath.Join(m.root, localIndexFilesDir, "2"),
+		filepath.Join(m.root, localRawDataDir, "20"),
+	}
+	for _, path := range append(orphans, dir.paths...) {
+		require.NoError(t, os.MkdirAll(path, 0o755))
+	}
+
+	m.cleanOrphans()
+	for _, path := range orphans {
+		assert.NoDirExists(t, path)
+	}
+	assert.DirExists(t, dir.paths[0])
+	m.release(dir)
+}
@@ -0,0 +1,52 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// commitUploadManifest verifies that the index files declared by the manifest exist and then writes the manifest,
+// the task must be marked finished only after the manifest is committed.
+func commitUploadManifest(ctx context.Context, cm storage.ChunkManager, manifest *storage.IndexUploadManifest) error {
+	if err := flushWriteBack(ctx, cm); err != nil {
+		return err
+	}
+	missing, err := storage.MissingIndexFiles(ctx, cm, manifest)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return merr.WrapErrIoKeyNotFound(missing[0], "index file missing after upload")
+	}
+	if err := storage.WriteIndexUploadManifest(ctx, cm, manifest); err != nil {
+		return err
+	}
+	return flushWriteBack(ctx, cm)
+}
+
+// flushWriteBack uploads the objects written back by the disk cache of cm,
+// the files must be in the storage before the task reports them to DataCoord
+func flushWriteBack(ctx context.Context, cm storage.ChunkManager) error {
+	if flusher, ok := cm.(storage.Flusher); ok {
+		return flusher.Flush(ctx)
+	}
+	return nil
+}
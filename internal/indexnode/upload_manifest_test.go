@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestCommitUploadManifest(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	manifest := &storage.IndexUploadManifest{
+		BuildID:        1000,
+		IndexVersion:   1,
+		PartitionID:    10,
+		SegmentID:      100,
+		FileKeys:       []string{"HNSW_0", "HNSW_1"},
+		SerializedSize: 12,
+	}
+	filePaths := manifest.FilePaths(rootPath)
+	require.NoError(t, cm.Write(ctx, filePaths[0], []byte("slice0")))
+
+	// the manifest isn't written while a declared file is missing
+	err := commitUploadManifest(ctx, cm, manifest)
+	assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+	read, err := storage.ReadIndexUploadManifest(ctx, cm, 1000, 1, 10, 100)
+	require.NoError(t, err)
+	assert.Nil(t, read)
+
+	require.NoError(t, cm.Write(ctx, filePaths[1], []byte("slice1")))
+	require.NoError(t, commitUploadManifest(ctx, cm, manifest))
+	read, err = storage.ReadIndexUploadManifest(ctx, cm, 1000, 1, 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, read)
+}
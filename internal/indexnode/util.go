@@ -17,11 +17,35 @@
 package indexnode
 
 import (
+	"context"
+	"strings"
 	"unsafe"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
+// cpuIndexTypes are the vector index types built by knowhere on CPU.
+var cpuIndexTypes = []string{
+	indexparamcheck.IndexFaissIDMap,
+	indexparamcheck.IndexFaissIvfFlat,
+	indexparamcheck.IndexFaissIvfPQ,
+	indexparamcheck.IndexScaNN,
+	indexparamcheck.IndexFaissIvfSQ8,
+	indexparamcheck.IndexFaissBinIDMap,
+	indexparamcheck.IndexFaissBinIvfFlat,
+	indexparamcheck.IndexHNSW,
+}
+
+// gpuIndexTypes are the vector index types built by knowhere on GPU.
+var gpuIndexTypes = []string{
+	indexparamcheck.IndexRaftIvfFlat,
+	indexparamcheck.IndexRaftIvfPQ,
+}
+
 func estimateFieldDataSize(dim int64, numRows int64, dataType schemapb.DataType) (uint64, error) {
 	if dataType == schemapb.DataType_FloatVector {
 		var value float32
@@ -36,3 +60,54 @@ func estimateFieldDataSize(dim int64, numRows int64, dataType schemapb.DataType)
 	}
 	return 0, nil
 }
+
+// checkDataPaths checks all the binlogs of the job exist before building,
+// so that the job of the missing binlogs fails immediately rather than after being scheduled
+func checkDataPaths(ctx context.Context, cm storage.ChunkManager, dataPaths []string) error {
+	exists, err := cm.MultiExist(ctx, dataPaths)
+	if err != nil {
+		return err
+	}
+	missing := make([]string, 0)
+	for i, exist := range exists {
+		if !exist {
+			missing = append(missing, dataPaths[i])
+		}
+	}
+	if len(missing) > 0 {
+		return merr.WrapErrIoKeyNotFound(strings.Join(missing, ","), "data paths not found")
+	}
+	return nil
+}
+
+// supportedIndexTypes returns the vector index types the node is able to build, including the ones delegated
+// to the registered engines.
+func supportedIndexTypes(enableDisk bool, gpuEnabled bool, engines map[string]string) []string {
+	indexTypes := make([]string, 0, len(cpuIndexTypes)+len(gpuIndexTypes)+1)
+	indexTypes = append(indexTypes, cpuIndexTypes...)
+	if enableDisk {
+		indexTypes = append(indexTypes, indexparamcheck.IndexDISKANN)
+	}
+	for _, indexType := range gpuIndexTypes {
+		if _, ok := engines[indexType]; gpuEnabled || ok {
+			indexTypes = append(indexTypes, indexType)
+		}
+	}
+	return indexTypes
+}
+
+// negotiateIndexFileVersion picks the index file version to write for the minimal version the query nodes
+// are able to load, readerVersion is 0 if the version of the query nodes is unknown.
+func negotiateIndexFileVersion(readerVersion int32) (int32, error) {
+	if readerVersion <= 0 {
+		return common.CurrentIndexFileVersion, nil
+	}
+	if readerVersion < common.MinimalIndexFileVersion {
+		return 0, merr.WrapErrParameterInvalidRange(common.MinimalIndexFileVersion, common.CurrentIndexFileVersion,
+			readerVersion, "query nodes cannot load index files written by this index node")
+	}
+	if readerVersion > common.CurrentIndexFileVersion {
+		return common.CurrentIndexFileVersion, nil
+	}
+	return readerVersion, nil
+}
@@ -0,0 +1,288 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// BackupManifestFile is the integrity manifest written along with a backup
+	BackupManifestFile = "BACKUP_MANIFEST.json"
+	backupVersion      = 1
+)
+
+// BackupManifest describes the files of a backup and their checksums
+type BackupManifest struct {
+	Version   int                `json:"version"`
+	Name      string             `json:"name"`
+	CreatedAt int64              `json:"created_at"`
+	Files     []BackupFileDigest `json:"files"`
+}
+
+// BackupFileDigest is the size and sha256 of a backup file
+type BackupFileDigest struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Backup writes a consistent checkpoint of the kv into dir, which must not exist,
+// together with an integrity manifest.
+func (kv *PebbleKV) Backup(dir string) error {
+	if kv.DB == nil {
+		return errors.New("pebble instance is nil when do Backup")
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("pebble kv backup dir %s already exists", dir)
+	}
+	if err := kv.DB.Checkpoint(dir, pebble.WithFlushedWAL()); err != nil {
+		return err
+	}
+
+	files, err := digestDir(dir)
+	if err != nil {
+		return err
+	}
+	manifest := BackupManifest{
+		Version:   backupVersion,
+		Name:      kv.name,
+		CreatedAt: time.Now().Unix(),
+		Files:     files,
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, BackupManifestFile), data, 0o600); err != nil {
+		return err
+	}
+	log.Info("pebble kv backup done", zap.String("name", kv.name), zap.String("dir", dir), zap.Int("files", len(files)))
+	return nil
+}
+
+// VerifyBackup checks the files in dir against its integrity manifest
+func VerifyBackup(dir string) (*BackupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, BackupManifestFile))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest of backup %s", dir)
+	}
+	manifest := &BackupManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse manifest of backup %s", dir)
+	}
+	if manifest.Version != backupVersion {
+		return nil, fmt.Errorf("unsupported pebble kv backup version %d", manifest.Version)
+	}
+
+	files, err := digestDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) != len(manifest.Files) {
+		return nil, fmt.Errorf("pebble kv backup %s is corrupted, expect %d files, got %d", dir, len(manifest.Files), len(files))
+	}
+	for i, file := range files {
+		if file != manifest.Files[i] {
+			return nil, fmt.Errorf("pebble kv backup %s is corrupted, file %s mismatches the manifest", dir, file.Path)
+		}
+	}
+	return manifest, nil
+}
+
+// RestoreFrom replaces the content of the kv with the backup in dir.
+// The backup is verified before the current data is touched. It must not be
+// called concurrently with other operations, and all watchers are closed.
+func (kv *PebbleKV) RestoreFrom(dir string) error {
+	if kv.DB == nil {
+		return errors.New("pebble instance is nil when do RestoreFrom")
+	}
+	if _, err := VerifyBackup(dir); err != nil {
+		return err
+	}
+
+	// stage the backup next to the data dir so that the swap is a rename
+	staging := kv.name + ".restoring"
+	if err := os.RemoveAll(staging); err != nil {
+		return err
+	}
+	if err := copyBackup(dir, staging); err != nil {
+		os.RemoveAll(staging)
+		return err
+	}
+
+	kv.stopSweeper()
+	kv.watch.closeAll()
+	if err := kv.DB.Close(); err != nil {
+		return err
+	}
+
+	// the current data is renamed aside and only removed once the backup is opened,
+	// any failure on the way rolls back to the current data
+	old := kv.name + ".old"
+	if err := os.RemoveAll(old); err != nil {
+		return kv.rollbackRestore(err, "", staging)
+	}
+	if err := os.Rename(kv.name, old); err != nil {
+		return kv.rollbackRestore(err, "", staging)
+	}
+	if err := os.Rename(staging, kv.name); err != nil {
+		return kv.rollbackRestore(err, old, staging)
+	}
+	if err := kv.reopen(); err != nil {
+		return kv.rollbackRestore(err, old, kv.name)
+	}
+	if err := os.RemoveAll(old); err != nil {
+		log.Warn("failed to remove the data replaced by the backup", zap.String("dir", old), zap.Error(err))
+	}
+
+	log.Info("pebble kv restored from backup", zap.String("name", kv.name), zap.String("dir", dir))
+	return nil
+}
+
+// rollbackRestore removes the restored files, moves the old data back to kv.name if it was moved aside,
+// and reopens it. It returns the cause of the rollback, or both errors if the kv can't be reopened,
+// which is the only case the kv is left without a db.
+func (kv *PebbleKV) rollbackRestore(cause error, old, restored string) error {
+	log.Warn("failed to restore pebble kv, roll back", zap.String("name", kv.name), zap.Error(cause))
+	err := os.RemoveAll(restored)
+	if err == nil && old != "" {
+		err = os.Rename(old, kv.name)
+	}
+	if err == nil {
+		err = kv.reopen()
+	}
+	if err != nil {
+		kv.DB = nil
+		return errors.CombineErrors(cause, errors.Wrap(err, "failed to roll back pebble kv"))
+	}
+	return cause
+}
+
+// reopen opens the db at kv.name with a new cache, as the cache is released with the closed db.
+func (kv *PebbleKV) reopen() error {
+	cacheSize := int64(LRUCacheSize)
+	if kv.Opts.Cache != nil {
+		cacheSize = kv.Opts.Cache.MaxSize()
+	}
+	c := pebble.NewCache(cacheSize)
+	defer c.Unref()
+	kv.Opts.Cache = c
+	db, err := pebble.Open(kv.name, kv.Opts)
+	if err != nil {
+		return err
+	}
+	kv.DB = db
+	if kv.stall != nil {
+		kv.stall.Bind(db)
+	}
+	if kv.cache != nil {
+		kv.cache.purge()
+	}
+	kv.startSweeper()
+	return nil
+}
+
+func digestDir(dir string) ([]BackupFileDigest, error) {
+	var files []BackupFileDigest
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == BackupManifestFile {
+			return nil
+		}
+		sum, err := sha256File(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, BackupFileDigest{Path: rel, Size: info.Size(), SHA256: sum})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func copyBackup(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if rel == BackupManifestFile {
+			return nil
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
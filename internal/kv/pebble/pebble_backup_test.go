@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_BackupAndRestore(t *testing.T) {
+	name := "/tmp/pebble_backup"
+	backupDir := "/tmp/pebble_backup_checkpoint"
+	os.RemoveAll(backupDir)
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(backupDir)
+	defer kv.Close()
+
+	assert.NoError(t, kv.MultiSave(map[string]string{"a": "1", "b": "2"}))
+	assert.NoError(t, kv.Backup(backupDir))
+	// backup dir must be new
+	assert.Error(t, kv.Backup(backupDir))
+
+	manifest, err := pebbleKV.VerifyBackup(backupDir)
+	assert.NoError(t, err)
+	assert.Equal(t, name, manifest.Name)
+	assert.NotEmpty(t, manifest.Files)
+
+	assert.NoError(t, kv.Save("c", "3"))
+	assert.NoError(t, kv.Remove("a"))
+
+	assert.NoError(t, kv.RestoreFrom(backupDir))
+	keys, vals, err := kv.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []string{"1", "2"}, vals)
+
+	// kv is still writable after restore
+	assert.NoError(t, kv.Save("d", "4"))
+	// the replaced data is removed
+	_, err = os.Stat(name + ".old")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPebbleKV_RestoreRollback(t *testing.T) {
+	name := "/tmp/pebble_backup_rollback"
+	backupDir := "/tmp/pebble_backup_rollback_checkpoint"
+	os.RemoveAll(backupDir)
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(backupDir)
+	defer kv.Close()
+	assert.NoError(t, kv.Save("a", "1"))
+
+	// a backup passing the verification, which can't be opened
+	assert.NoError(t, os.MkdirAll(backupDir, 0o755))
+	current := []byte("MANIFEST-999999\n")
+	assert.NoError(t, os.WriteFile(filepath.Join(backupDir, "CURRENT"), current, 0o600))
+	sum := sha256.Sum256(current)
+	data, err := json.Marshal(pebbleKV.BackupManifest{
+		Version: 1,
+		Name:    name,
+		Files:   []pebbleKV.BackupFileDigest{{Path: "CURRENT", Size: int64(len(current)), SHA256: hex.EncodeToString(sum[:])}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(backupDir, pebbleKV.BackupManifestFile), data, 0o600))
+	_, err = pebbleKV.VerifyBackup(backupDir)
+	assert.NoError(t, err)
+
+	assert.Error(t, kv.RestoreFrom(backupDir))
+	// rolled back to the current data, which is still writable
+	assert.NotNil(t, kv.DB)
+	val, err := kv.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", val)
+	assert.NoError(t, kv.Save("b", "2"))
+}
+
+func TestPebbleKV_RestoreCorruptedBackup(t *testing.T) {
+	name := "/tmp/pebble_backup_corrupted"
+	backupDir := "/tmp/pebble_backup_corrupted_checkpoint"
+	os.RemoveAll(backupDir)
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(backupDir)
+	defer kv.Close()
+
+	assert.NoError(t, kv.Save("a", "1"))
+	assert.NoError(t, kv.Backup(backupDir))
+
+	manifest, err := pebbleKV.VerifyBackup(backupDir)
+	assert.NoError(t, err)
+	f, err := os.OpenFile(filepath.Join(backupDir, manifest.Files[0].Path), os.O_APPEND|os.O_WRONLY, 0)
+	assert.NoError(t, err)
+	_, err = f.WriteString("corrupted")
+	assert.NoError(t, err)
+	f.Close()
+
+	assert.Error(t, kv.RestoreFrom(backupDir))
+	// current data is untouched
+	val, err := kv.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", val)
+
+	_, err = pebbleKV.VerifyBackup("/tmp/pebble_backup_not_exist")
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// readCache is a read-through LRU cache of the values of hot keys, cached keys are invalidated once written
+type readCache struct {
+	mu       sync.Mutex
+	capacity int
+	// prefixes limits the cached keys, all keys are cached if empty
+	prefixes []string
+	ll       *list.List
+	items    map[string]*list.Element
+	// gen is bumped on every invalidation, a value loaded across an invalidation is not cached
+	gen uint64
+}
+
+type readCacheEntry struct {
+	key   string
+	value string
+}
+
+func newReadCache(capacity int, prefixes []string) *readCache {
+	return &readCache{
+		capacity: capacity,
+		prefixes: prefixes,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// EnableReadCache caches the values of the keys with any of prefixes, up to capacity keys,
+// all keys are cached if no prefix is given. It must be called before the kv is used concurrently.
+func (kv *PebbleKV) EnableReadCache(capacity int, prefixes ...string) {
+	if capacity <= 0 {
+		kv.cache = nil
+		return
+	}
+	kv.cache = newReadCache(capacity, prefixes)
+}
+
+func (c *readCache) cacheable(key string) bool {
+	if len(c.prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the cached value of key and the generation to pass to put on miss
+func (c *readCache) get(key string) (string, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.ll.MoveToFront(elem)
+		metrics.PebbleKVCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return elem.Value.(*readCacheEntry).value, c.gen, true
+	}
+	metrics.PebbleKVCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+	return "", c.gen, false
+}
+
+// put caches the value loaded at generation gen, it's skipped if any write happened since then
+func (c *readCache) put(key, value string, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*readCacheEntry).value = value
+		c.ll.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&readCacheEntry{key: key, value: value})
+	if c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// invalidate drops the cached keys touched by events
+func (c *readCache) invalidate(events []WatchEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for _, event := range events {
+		if event.Type != WatchEventDeleteRange {
+			if elem, ok := c.items[event.Key]; ok {
+				c.removeElement(elem)
+			}
+			continue
+		}
+		for key, elem := range c.items {
+			if key >= event.Key && (event.EndKey == "" || key < event.EndKey) {
+				c.removeElement(elem)
+			}
+		}
+	}
+}
+
+// purge drops all cached keys
+func (c *readCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+func (c *readCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*readCacheEntry).key)
+}
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_ReadCache(t *testing.T) {
+	name := "/tmp/pebble_read_cache"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	kv.EnableReadCache(2, "hot/")
+
+	assert.NoError(t, kv.Save("hot/a", "1"))
+	assert.NoError(t, kv.Save("cold/a", "1"))
+	value, err := kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+	_, err = kv.Load("cold/a")
+	assert.NoError(t, err)
+
+	// writes bypassing the kv are invisible to the cached keys only
+	assert.NoError(t, kv.DB.Set([]byte("hot/a"), []byte("2"), kv.WriteOptions))
+	assert.NoError(t, kv.DB.Set([]byte("cold/a"), []byte("2"), kv.WriteOptions))
+	value, err = kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+	value, err = kv.Load("cold/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", value)
+
+	// writes through the kv invalidate the cache
+	assert.NoError(t, kv.Save("hot/a", "3"))
+	value, err = kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", value)
+
+	txn, err := kv.Txn()
+	assert.NoError(t, err)
+	value, err = txn.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", value)
+	assert.NoError(t, txn.Save("hot/a", "4"))
+	value, err = txn.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "4", value)
+	assert.NoError(t, txn.Commit())
+	value, err = kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "4", value)
+
+	assert.NoError(t, kv.RemoveWithPrefix("hot/"))
+	value, err = kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", value)
+
+	// evicted keys are loaded again
+	assert.NoError(t, kv.MultiSave(map[string]string{"hot/a": "5", "hot/b": "5", "hot/c": "5"}))
+	for _, key := range []string{"hot/a", "hot/b", "hot/c", "hot/a"} {
+		value, err = kv.Load(key)
+		assert.NoError(t, err)
+		assert.Equal(t, "5", value)
+	}
+	assert.NoError(t, kv.MultiSaveAndRemove(map[string]string{"hot/b": "6"}, []string{"hot/a"}))
+	value, err = kv.Load("hot/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", value)
+	value, err = kv.Load("hot/b")
+	assert.NoError(t, err)
+	assert.Equal(t, "6", value)
+
+	kv.EnableReadCache(0)
+	assert.NoError(t, kv.DB.Set([]byte("hot/b"), []byte("7"), kv.WriteOptions))
+	value, err = kv.Load("hot/b")
+	assert.NoError(t, err)
+	assert.Equal(t, "7", value)
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+)
+
+// versionPrefix is the reserved key space for key versions: versionPrefix + key -> version
+const versionPrefix = internalKeyPrefix + "version__/"
+
+// LoadWithVersion returns the value and version of specified key, the version is 0 if the key does not exist.
+// Versions are tracked by CompareVersionAndSwap and SaveIfNotExist, writing the same key with
+// Save or Remove does not update its version.
+func (kv *PebbleKV) LoadWithVersion(key string) (string, int64, error) {
+	if kv.DB == nil {
+		return "", 0, errors.New("pebble instance is nil when do LoadWithVersion")
+	}
+	if key == "" {
+		return "", 0, errors.New("pebble kv does not support load empty key")
+	}
+	values, err := kv.MultiLoad([]string{key, versionPrefix + key})
+	if err != nil {
+		return "", 0, err
+	}
+	if values[0] == "" {
+		return "", 0, nil
+	}
+	if values[1] == "" {
+		// written without version tracking
+		return values[0], 0, nil
+	}
+	version, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+	return values[0], version, nil
+}
+
+// CompareVersionAndSwap compares the existing key-value's version with version, and if
+// they are equal, the target is stored and the version is increased.
+// Version 0 means the key does not exist.
+func (kv *PebbleKV) CompareVersionAndSwap(key string, version int64, target string) (bool, error) {
+	kv.casMu.Lock()
+	defer kv.casMu.Unlock()
+
+	value, current, err := kv.LoadWithVersion(key)
+	if err != nil {
+		return false, err
+	}
+	if current != version || (version == 0 && value != "") {
+		return false, nil
+	}
+	return true, kv.saveWithVersion(key, target, version+1)
+}
+
+// CompareValueAndSwap compares the existing value with expected, and if they are equal, the target is stored.
+// An empty expected value means the key does not exist.
+func (kv *PebbleKV) CompareValueAndSwap(key, expected, target string) (bool, error) {
+	kv.casMu.Lock()
+	defer kv.casMu.Unlock()
+
+	value, current, err := kv.LoadWithVersion(key)
+	if err != nil {
+		return false, err
+	}
+	if value != expected {
+		return false, nil
+	}
+	return true, kv.saveWithVersion(key, target, current+1)
+}
+
+// SaveIfNotExist saves the key-value only if the key does not exist, returns whether it is saved
+func (kv *PebbleKV) SaveIfNotExist(key, value string) (bool, error) {
+	return kv.CompareValueAndSwap(key, "", value)
+}
+
+func (kv *PebbleKV) saveWithVersion(key, value string, version int64) error {
+	if value == "" {
+		return errors.New("pebble kv does not support empty value")
+	}
+	txn, err := kv.Txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	if err := txn.Save(key, value); err != nil {
+		return err
+	}
+	if err := txn.Save(versionPrefix+key, strconv.FormatInt(version, 10)); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_CompareAndSwap(t *testing.T) {
+	name := "/tmp/pebble_cas"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	_, version, err := kv.LoadWithVersion("lease")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, version)
+
+	ok, err := kv.CompareVersionAndSwap("lease", 0, "node-1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = kv.CompareVersionAndSwap("lease", 0, "node-2")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	value, version, err := kv.LoadWithVersion("lease")
+	assert.NoError(t, err)
+	assert.Equal(t, "node-1", value)
+	assert.EqualValues(t, 1, version)
+
+	ok, err = kv.CompareVersionAndSwap("lease", 1, "node-2")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = kv.CompareValueAndSwap("lease", "node-1", "node-3")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = kv.CompareValueAndSwap("lease", "node-2", "node-3")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	value, version, err = kv.LoadWithVersion("lease")
+	assert.NoError(t, err)
+	assert.Equal(t, "node-3", value)
+	assert.EqualValues(t, 3, version)
+
+	_, err = kv.CompareVersionAndSwap("lease", 3, "")
+	assert.Error(t, err)
+
+	// removed key starts over from version 0
+	assert.NoError(t, kv.Remove("lease"))
+	ok, err = kv.CompareVersionAndSwap("lease", 0, "node-4")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// version bookkeeping is not visible to users
+	keys, _, err := kv.LoadWithPrefix("lease")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lease"}, keys)
+}
+
+func TestPebbleKV_SaveIfNotExist(t *testing.T) {
+	name := "/tmp/pebble_save_if_not_exist"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	wg := sync.WaitGroup{}
+	saved := make(chan string, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value := string(rune('a' + i))
+			ok, err := kv.SaveIfNotExist("init", value)
+			assert.NoError(t, err)
+			if ok {
+				saved <- value
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(saved)
+	assert.Len(t, saved, 1)
+
+	value, err := kv.Load("init")
+	assert.NoError(t, err)
+	assert.Equal(t, <-saved, value)
+}
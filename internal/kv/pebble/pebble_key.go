@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeySeparator separates the segments of a key
+const KeySeparator = "/"
+
+var keySegmentEscaper = strings.NewReplacer("%", "%25", "/", "%2F")
+
+// EscapeKeySegment escapes s so that it can be embedded as a single segment of a key,
+// the result never contains KeySeparator and keeps the order of keys sharing the same prefix
+// for segments without escaped characters
+func EscapeKeySegment(s string) string {
+	return keySegmentEscaper.Replace(s)
+}
+
+// UnescapeKeySegment reverts EscapeKeySegment
+func UnescapeKeySegment(s string) (string, error) {
+	if !strings.Contains(s, "%") {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("invalid escaped key segment %s", s)
+		}
+		switch s[i+1 : i+3] {
+		case "25":
+			b.WriteByte('%')
+		case "2F":
+			b.WriteByte('/')
+		default:
+			return "", fmt.Errorf("invalid escaped key segment %s", s)
+		}
+		i += 2
+	}
+	return b.String(), nil
+}
+
+// JoinKey escapes each segment and joins them with KeySeparator
+func JoinKey(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = EscapeKeySegment(segment)
+	}
+	return strings.Join(escaped, KeySeparator)
+}
+
+// SplitKey splits a key built by JoinKey into the unescaped segments
+func SplitKey(key string) ([]string, error) {
+	segments := strings.Split(key, KeySeparator)
+	for i, segment := range segments {
+		unescaped, err := UnescapeKeySegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = unescaped
+	}
+	return segments, nil
+}
@@ -0,0 +1,54 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_EscapeKeySegment(t *testing.T) {
+	for _, segment := range []string{"", "topic", "a/b", "a%b", "a%2Fb", "/%/", "%25"} {
+		escaped := pebbleKV.EscapeKeySegment(segment)
+		assert.NotContains(t, escaped, pebbleKV.KeySeparator)
+		unescaped, err := pebbleKV.UnescapeKeySegment(escaped)
+		assert.NoError(t, err)
+		assert.Equal(t, segment, unescaped)
+	}
+	assert.Equal(t, "a%2Fb", pebbleKV.EscapeKeySegment("a/b"))
+	assert.Equal(t, "a%252Fb", pebbleKV.EscapeKeySegment("a%2Fb"))
+
+	for _, invalid := range []string{"%", "a%2", "a%zz"} {
+		_, err := pebbleKV.UnescapeKeySegment(invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestPebbleKV_JoinKey(t *testing.T) {
+	key := pebbleKV.JoinKey("page_ts", "a/b", "10")
+	assert.Equal(t, "page_ts/a%2Fb/10", key)
+
+	segments, err := pebbleKV.SplitKey(key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"page_ts", "a/b", "10"}, segments)
+
+	_, err = pebbleKV.SplitKey("page_ts/a%2/10")
+	assert.Error(t, err)
+}
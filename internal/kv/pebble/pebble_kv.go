@@ -18,11 +18,15 @@ package pebblekv
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/pebble"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -35,11 +39,32 @@ type PebbleKV struct {
 	WriteOptions *pebble.WriteOptions
 	ReadOptions  *pebble.IterOptions
 	name         string
+	// SlowOpThreshold is the latency over which an operation is logged, 0 disables the log
+	SlowOpThreshold time.Duration
+
+	// ttl sweeper, started once the db is opened
+	sweeperStop chan struct{}
+	sweeperWg   sync.WaitGroup
+	// whether any key is saved with ttl, the ttl of the removed keys is only removed if so
+	hasTTL atomic.Bool
+
+	watch watchHub
+	// serializes compare-and-swap operations
+	casMu sync.Mutex
+	// async removals are waited before closing the db
+	removals sync.WaitGroup
+	// stall tracks the write stalls of DB
+	stall *WriteStallMonitor
+	// cache is the optional read cache of hot keys, nil if disabled
+	cache *readCache
 }
 
 const (
 	// LRUCacheSize is the lru cache size of pebble, default 0
 	LRUCacheSize = 0
+
+	// internalKeyPrefix prefixes the keys reserved for bookkeeping of pebble kv
+	internalKeyPrefix = "__pebblekv_"
 )
 
 // NewPebbleKV returns a PebbleKV object, only used in test
@@ -51,28 +76,62 @@ func NewPebbleKV(name string) (*PebbleKV, error) {
 	defer c.Unref()
 
 	opts := pebble.Options{Cache: c}
+	return NewPebbleKVWithOpts(name, &opts)
+}
+
+// NewPebbleKVReadOnly opens an existing pebble kv read-only, all mutations fail with pebble.ErrReadOnly
+func NewPebbleKVReadOnly(name string) (*PebbleKV, error) {
+	if name == "" {
+		return nil, errors.New("pebble name is nil")
+	}
+	c := pebble.NewCache(LRUCacheSize)
+	defer c.Unref()
+
+	opts := pebble.Options{Cache: c, ReadOnly: true}
+	return NewPebbleKVWithOpts(name, &opts)
+}
 
+// NewPebbleKVWithOpts returns a PebbleKV object opened with the given options
+func NewPebbleKVWithOpts(name string, opts *pebble.Options) (*PebbleKV, error) {
 	wo := pebble.WriteOptions{}
 	ro := pebble.IterOptions{}
-	d, err := pebble.Open(name, &opts)
+	stall := NewWriteStallMonitor(name, opts)
+	d, err := pebble.Open(name, opts)
 	if err != nil {
 		return nil, err
 	}
-	return &PebbleKV{
-		Opts:         &opts,
-		DB:           d,
-		WriteOptions: &wo,
-		ReadOptions:  &ro,
-		name:         name,
-	}, nil
-
+	stall.Bind(d)
+	kv := &PebbleKV{
+		Opts:            opts,
+		DB:              d,
+		WriteOptions:    &wo,
+		ReadOptions:     &ro,
+		name:            name,
+		SlowOpThreshold: DefaultSlowOpThreshold,
+		stall:           stall,
+	}
+	kv.startSweeper()
+	return kv, nil
 }
 
 // Close free resource of pebble
 func (kv *PebbleKV) Close() {
+	kv.stopSweeper()
+	kv.removals.Wait()
 	if kv.DB != nil {
 		kv.DB.Close()
 	}
+	kv.watch.closeAll()
+}
+
+// WriteStall returns the monitor of the write stalls of the kv
+func (kv *PebbleKV) WriteStall() *WriteStallMonitor {
+	return kv.stall
+}
+
+// IsReadOnly returns whether the kv is opened read-only
+func (kv *PebbleKV) IsReadOnly() bool {
+	return kv.Opts != nil && kv.Opts.ReadOnly
 }
 
 // GetName returns the name of this object
@@ -81,13 +140,31 @@ func (kv *PebbleKV) GetName() string {
 }
 
 // Load returns the value of specified key
-func (kv *PebbleKV) Load(key string) (string, error) {
+func (kv *PebbleKV) Load(key string) (_ string, err error) {
+	defer kv.observe(metrics.PebbleKVGetLabel, key, time.Now(), &err)
 	if kv.DB == nil {
 		return "", fmt.Errorf("pebble instance is nil when load %s", key)
 	}
 	if key == "" {
 		return "", errors.New("pebble kv does not support load empty key")
 	}
+	cache := kv.cache
+	if cache == nil || !cache.cacheable(key) {
+		return kv.load(key)
+	}
+	value, gen, ok := cache.get(key)
+	if ok {
+		return value, nil
+	}
+	value, err = kv.load(key)
+	if err != nil {
+		return "", err
+	}
+	cache.put(key, value, gen)
+	return value, nil
+}
+
+func (kv *PebbleKV) load(key string) (string, error) {
 	value, closer, err := kv.DB.Get([]byte(key))
 	if err != nil && err != pebble.ErrNotFound {
 		return "", err
@@ -100,7 +177,8 @@ func (kv *PebbleKV) Load(key string) (string, error) {
 
 // LoadWithPrefix returns a batch values of keys with a prefix
 // if prefix is "", then load every thing from the database
-func (kv *PebbleKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
+func (kv *PebbleKV) LoadWithPrefix(prefix string) (_ []string, _ []string, err error) {
+	defer kv.observe(metrics.PebbleKVScanLabel, prefix, time.Now(), &err)
 	if kv.DB == nil {
 		return nil, nil, fmt.Errorf("pebble instance is nil when load %s", prefix)
 	}
@@ -127,7 +205,8 @@ func (kv *PebbleKV) LoadWithPrefix(prefix string) ([]string, []string, error) {
 	return keys, values, nil
 }
 
-func (kv *PebbleKV) Has(key string) (bool, error) {
+func (kv *PebbleKV) Has(key string) (_ bool, err error) {
+	defer kv.observe(metrics.PebbleKVGetLabel, key, time.Now(), &err)
 	if kv.DB == nil {
 		return false, fmt.Errorf("pebbledb instance is nil when check if has %s", key)
 	}
@@ -144,7 +223,8 @@ func (kv *PebbleKV) Has(key string) (bool, error) {
 	return len(value) != 0, nil
 }
 
-func (kv *PebbleKV) HasPrefix(prefix string) (bool, error) {
+func (kv *PebbleKV) HasPrefix(prefix string) (_ bool, err error) {
+	defer kv.observe(metrics.PebbleKVScanLabel, prefix, time.Now(), &err)
 	if kv.DB == nil {
 		return false, fmt.Errorf("rocksdb instance is nil when check if has prefix %s", prefix)
 	}
@@ -161,7 +241,8 @@ func (kv *PebbleKV) HasPrefix(prefix string) (bool, error) {
 }
 
 // MultiLoad load a batch of values by keys
-func (kv *PebbleKV) MultiLoad(keys []string) ([]string, error) {
+func (kv *PebbleKV) MultiLoad(keys []string) (_ []string, err error) {
+	defer kv.observe(metrics.PebbleKVGetLabel, firstKey(keys), time.Now(), &err)
 	if kv.DB == nil {
 		return nil, errors.New("pebble instance is nil when do MultiLoad")
 	}
@@ -181,7 +262,8 @@ func (kv *PebbleKV) MultiLoad(keys []string) ([]string, error) {
 }
 
 // Save a pair of key-value
-func (kv *PebbleKV) Save(key, value string) error {
+func (kv *PebbleKV) Save(key, value string) (err error) {
+	defer kv.observe(metrics.PebbleKVPutLabel, key, time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do save")
 	}
@@ -192,11 +274,19 @@ func (kv *PebbleKV) Save(key, value string) error {
 		return errors.New("pebble kv does not support empty value")
 	}
 
-	return kv.DB.Set([]byte(key), []byte(value), kv.WriteOptions)
+	err = kv.DB.Set([]byte(key), []byte(value), kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(WatchEvent{Type: WatchEventPut, Key: key, Value: value})
+	}
+	return nil
 }
 
 // MultiSave a batch of key-values
-func (kv *PebbleKV) MultiSave(kvs map[string]string) error {
+func (kv *PebbleKV) MultiSave(kvs map[string]string) (err error) {
+	defer kv.observe(metrics.PebbleKVPutLabel, anyKey(kvs), time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do MultiSave")
 	}
@@ -208,7 +298,14 @@ func (kv *PebbleKV) MultiSave(kvs map[string]string) error {
 		writeBatch.Set([]byte(k), []byte(v), kv.WriteOptions)
 	}
 
-	return writeBatch.Commit(kv.WriteOptions)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(putEvents(kvs)...)
+	}
+	return nil
 }
 
 // RemoveWithPrefix removes a batch of key-values with specified prefix
@@ -235,19 +332,31 @@ func (kv *PebbleKV) RemoveWithPrefix(prefix string) error {
 }
 
 // Remove is used to remove a pair of key-value
-func (kv *PebbleKV) Remove(key string) error {
+func (kv *PebbleKV) Remove(key string) (err error) {
+	defer kv.observe(metrics.PebbleKVRemoveLabel, key, time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do Remove")
 	}
 	if key == "" {
 		return errors.New("pebble kv does not support empty key")
 	}
-	err := kv.DB.Delete([]byte(key), kv.WriteOptions)
-	return err
+	writeBatch := kv.DB.NewBatch()
+	defer writeBatch.Close()
+	writeBatch.Delete([]byte(key), kv.WriteOptions)
+	kv.removeTTL(writeBatch, key)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(WatchEvent{Type: WatchEventDelete, Key: key})
+	}
+	return nil
 }
 
 // MultiRemove is used to remove a batch of key-values
-func (kv *PebbleKV) MultiRemove(keys []string) error {
+func (kv *PebbleKV) MultiRemove(keys []string) (err error) {
+	defer kv.observe(metrics.PebbleKVRemoveLabel, firstKey(keys), time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do MultiRemove")
 	}
@@ -255,11 +364,20 @@ func (kv *PebbleKV) MultiRemove(keys []string) error {
 	for _, key := range keys {
 		writeBatch.Delete([]byte(key), kv.WriteOptions)
 	}
-	return writeBatch.Commit(kv.WriteOptions)
+	kv.removeTTL(writeBatch, keys...)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(deleteEvents(keys)...)
+	}
+	return nil
 }
 
 // MultiSaveAndRemove provides a transaction to execute a batch of operations
-func (kv *PebbleKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+func (kv *PebbleKV) MultiSaveAndRemove(saves map[string]string, removals []string) (err error) {
+	defer kv.observe(metrics.PebbleKVTxnLabel, anyKey(saves), time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do MultiSaveAndRemove")
 	}
@@ -270,11 +388,20 @@ func (kv *PebbleKV) MultiSaveAndRemove(saves map[string]string, removals []strin
 	for _, key := range removals {
 		writeBatch.Delete([]byte(key), kv.WriteOptions)
 	}
-	return writeBatch.Commit(kv.WriteOptions)
+	kv.removeTTL(writeBatch, removals...)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(append(putEvents(saves), deleteEvents(removals)...)...)
+	}
+	return nil
 }
 
 // DeleteRange remove a batch of key-values from startKey to endKey
-func (kv *PebbleKV) DeleteRange(startKey, endKey string) error {
+func (kv *PebbleKV) DeleteRange(startKey, endKey string) (err error) {
+	defer kv.observe(metrics.PebbleKVRemoveLabel, startKey, time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do DeleteRange")
 	}
@@ -283,21 +410,38 @@ func (kv *PebbleKV) DeleteRange(startKey, endKey string) error {
 	}
 	writeBatch := kv.DB.NewBatch()
 	writeBatch.DeleteRange([]byte(startKey), []byte(endKey), kv.WriteOptions)
-	return writeBatch.Commit(kv.WriteOptions)
+	kv.removeTTLRange(writeBatch, startKey, endKey)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(WatchEvent{Type: WatchEventDeleteRange, Key: startKey, EndKey: endKey})
+	}
+	return nil
 }
 
 // MultiRemoveWithPrefix is used to remove a batch of key-values with the same prefix
-func (kv *PebbleKV) MultiRemoveWithPrefix(prefixes []string) error {
+func (kv *PebbleKV) MultiRemoveWithPrefix(prefixes []string) (err error) {
+	defer kv.observe(metrics.PebbleKVRemoveLabel, firstKey(prefixes), time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do RemoveWithPrefix")
 	}
 	writeBatch := kv.DB.NewBatch()
 	kv.prepareRemovePrefix(prefixes, writeBatch)
-	return writeBatch.Commit(kv.WriteOptions)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(removePrefixEvents(prefixes)...)
+	}
+	return nil
 }
 
 // MultiSaveAndRemoveWithPrefix is used to execute a batch operators with the same prefix
-func (kv *PebbleKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
+func (kv *PebbleKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) (err error) {
+	defer kv.observe(metrics.PebbleKVTxnLabel, anyKey(saves), time.Now(), &err)
 	if kv.DB == nil {
 		return errors.New("pebble instance is nil when do MultiSaveAndRemove")
 	}
@@ -306,7 +450,14 @@ func (kv *PebbleKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, remova
 		writeBatch.Set([]byte(k), []byte(v), kv.WriteOptions)
 	}
 	kv.prepareRemovePrefix(removals, writeBatch)
-	return writeBatch.Commit(kv.WriteOptions)
+	err = writeBatch.Commit(kv.WriteOptions)
+	if err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(append(putEvents(saves), removePrefixEvents(removals)...)...)
+	}
+	return nil
 }
 
 func (kv *PebbleKV) prepareRemovePrefix(prefixes []string, writeBatch *pebble.Batch) {
@@ -329,5 +480,6 @@ func (kv *PebbleKV) prepareRemovePrefix(prefixes []string, writeBatch *pebble.Ba
 	for _, prefix := range prefixes {
 		prefixEnd := typeutil.AddOne(prefix)
 		writeBatch.DeleteRange([]byte(prefix), []byte(prefixEnd), kv.WriteOptions)
+		kv.removeTTLRange(writeBatch, prefix, prefixEnd)
 	}
 }
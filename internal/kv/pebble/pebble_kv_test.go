@@ -349,3 +349,26 @@ func TestHasPrefix(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, has)
 }
+
+func TestPebbleKV_ReadOnly(t *testing.T) {
+	name := "/tmp/pebble_readonly"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	assert.False(t, kv.IsReadOnly())
+	assert.NoError(t, kv.Save("a", "1"))
+	kv.Close()
+
+	_, err = pebbleKV.NewPebbleKVReadOnly("")
+	assert.Error(t, err)
+	kv, err = pebbleKV.NewPebbleKVReadOnly(name)
+	assert.NoError(t, err)
+	defer kv.Close()
+	assert.True(t, kv.IsReadOnly())
+
+	val, err := kv.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", val)
+	assert.Error(t, kv.Save("b", "2"))
+	assert.Error(t, kv.Remove("a"))
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
+)
+
+const (
+	// DefaultSlowOpThreshold is the default latency over which a pebble kv operation is logged
+	DefaultSlowOpThreshold = 500 * time.Millisecond
+
+	maxLoggedPrefixLen = 128
+)
+
+// observe records the latency and result of an operation, and logs it if slower than SlowOpThreshold
+func (kv *PebbleKV) observe(op string, key string, start time.Time, err *error) {
+	elapsed := time.Since(start)
+	metrics.PebbleKVOpLatency.WithLabelValues(op).Observe(elapsed.Seconds())
+	status := metrics.SuccessLabel
+	if err != nil && *err != nil {
+		status = metrics.FailLabel
+	}
+	metrics.PebbleKVOpCounter.WithLabelValues(op, status).Inc()
+	slowlog.Record(context.TODO(), slowlog.KindKV, op, elapsed,
+		slowlog.F("name", kv.name),
+		slowlog.F("keyPrefix", keyPrefix(key)),
+		slowlog.F("status", status))
+
+	if kv.SlowOpThreshold > 0 && elapsed > kv.SlowOpThreshold {
+		log.Warn("pebble kv slow operation",
+			zap.String("name", kv.name),
+			zap.String("op", op),
+			zap.String("keyPrefix", keyPrefix(key)),
+			zap.Duration("elapsed", elapsed))
+	}
+}
+
+// keyPrefix strips the last path segment of the key, which is usually an id, so that
+// the logged prefix identifies the kind of data without leaking the full key
+func keyPrefix(key string) string {
+	if idx := strings.LastIndex(key, "/"); idx > 0 {
+		key = key[:idx]
+	}
+	if len(key) > maxLoggedPrefixLen {
+		key = key[:maxLoggedPrefixLen]
+	}
+	return key
+}
+
+func firstKey(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0]
+}
+
+func anyKey(kvs map[string]string) string {
+	for k := range kvs {
+		return k
+	}
+	return ""
+}
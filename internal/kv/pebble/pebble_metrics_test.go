@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+func opCount(t *testing.T, op, status string) float64 {
+	m := &dto.Metric{}
+	assert.NoError(t, metrics.PebbleKVOpCounter.WithLabelValues(op, status).Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func opLatencySum(t *testing.T, op string) float64 {
+	m := &dto.Metric{}
+	observer := metrics.PebbleKVOpLatency.WithLabelValues(op).(prometheus.Metric)
+	assert.NoError(t, observer.Write(m))
+	return m.GetHistogram().GetSampleSum()
+}
+
+func TestPebbleKV_Metrics(t *testing.T) {
+	name := "/tmp/pebble_metrics"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+	assert.Equal(t, pebbleKV.DefaultSlowOpThreshold, kv.SlowOpThreshold)
+	// log every operation
+	kv.SlowOpThreshold = time.Nanosecond
+
+	putSuccess := opCount(t, metrics.PebbleKVPutLabel, metrics.SuccessLabel)
+	putFail := opCount(t, metrics.PebbleKVPutLabel, metrics.FailLabel)
+	getSuccess := opCount(t, metrics.PebbleKVGetLabel, metrics.SuccessLabel)
+	getLatency := opLatencySum(t, metrics.PebbleKVGetLabel)
+
+	assert.NoError(t, kv.Save("topic/page/1", "1"))
+	assert.Error(t, kv.Save("", "1"))
+	_, err = kv.Load("topic/page/1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, putSuccess+1, opCount(t, metrics.PebbleKVPutLabel, metrics.SuccessLabel))
+	assert.Equal(t, putFail+1, opCount(t, metrics.PebbleKVPutLabel, metrics.FailLabel))
+	assert.Equal(t, getSuccess+1, opCount(t, metrics.PebbleKVGetLabel, metrics.SuccessLabel))
+	// sub-millisecond operations must not be truncated to zero
+	assert.Greater(t, opLatencySum(t, metrics.PebbleKVGetLabel), getLatency)
+
+	txnSuccess := opCount(t, metrics.PebbleKVTxnLabel, metrics.SuccessLabel)
+	txn, err := kv.Txn()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Save("a", "1"))
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, txnSuccess+1, opCount(t, metrics.PebbleKVTxnLabel, metrics.SuccessLabel))
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// RemoveChunkSize is the number of keys covered by each range tombstone of an async removal,
+// progress is reported once a chunk is removed
+var RemoveChunkSize = 10000
+
+// RemoveProgress is the progress of an async removal
+type RemoveProgress struct {
+	// KeysDeleted is the number of keys covered by the written range tombstones
+	KeysDeleted int64
+	// BytesReclaimed is the disk space released by the compaction, it's estimated by pebble
+	BytesReclaimed int64
+	// Compacted reports whether the compaction of the removed range finished
+	Compacted bool
+}
+
+// RemoveHandle tracks an async removal started by RemoveWithPrefixAsync
+type RemoveHandle struct {
+	prefix         string
+	keysDeleted    int64
+	bytesReclaimed int64
+	compacted      int32
+
+	deleted chan struct{}
+	done    chan struct{}
+	// deleteErr is set before deleted is closed, err before done is closed
+	deleteErr error
+	err       error
+}
+
+// Progress returns the current progress of the removal
+func (h *RemoveHandle) Progress() RemoveProgress {
+	return RemoveProgress{
+		KeysDeleted:    atomic.LoadInt64(&h.keysDeleted),
+		BytesReclaimed: atomic.LoadInt64(&h.bytesReclaimed),
+		Compacted:      atomic.LoadInt32(&h.compacted) == 1,
+	}
+}
+
+// Deleted is closed once all keys are removed, they are no longer visible to reads
+// while the disk space is reclaimed by the following compaction
+func (h *RemoveHandle) Deleted() <-chan struct{} {
+	return h.deleted
+}
+
+// Done is closed once the removal and the compaction finished or failed
+func (h *RemoveHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Wait blocks until the removal is done and returns its error
+func (h *RemoveHandle) Wait() error {
+	<-h.done
+	return h.err
+}
+
+// RemoveWithPrefixAsync removes all keys with the prefix in background with range tombstones,
+// then compacts the range to reclaim the disk space.
+func (kv *PebbleKV) RemoveWithPrefixAsync(prefix string) *RemoveHandle {
+	h := RemovePrefixAsync(kv.DB, prefix, kv.WriteOptions)
+	kv.removals.Add(1)
+	go func() {
+		defer kv.removals.Done()
+		<-h.Deleted()
+		if h.deleteErr == nil && prefix != "" && kv.hasTTL.Load() {
+			writeBatch := kv.DB.NewBatch()
+			kv.removeTTLRange(writeBatch, prefix, typeutil.AddOne(prefix))
+			if err := writeBatch.Commit(kv.WriteOptions); err != nil {
+				log.Warn("failed to remove the ttl of the removed prefix", zap.String("prefix", prefix), zap.Error(err))
+			}
+			writeBatch.Close()
+		}
+		if h.deleteErr == nil && kv.tracked() {
+			kv.committed(removePrefixEvents([]string{prefix})...)
+		}
+		<-h.Done()
+	}()
+	return h
+}
+
+// RemovePrefixAsync removes all keys with the prefix from db in background, see RemoveWithPrefixAsync
+func RemovePrefixAsync(db *pebble.DB, prefix string, writeOpts *pebble.WriteOptions) *RemoveHandle {
+	h := &RemoveHandle{
+		prefix:  prefix,
+		deleted: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	var err error
+	if db == nil {
+		err = errors.New("pebble instance is nil when do RemoveWithPrefixAsync")
+	} else if prefix == "" {
+		err = errors.New("pebble kv does not support async remove empty prefix")
+	}
+	if err != nil {
+		h.deleteErr, h.err = err, err
+		close(h.deleted)
+		close(h.done)
+		return h
+	}
+	go h.run(db, writeOpts)
+	return h
+}
+
+func (h *RemoveHandle) run(db *pebble.DB, writeOpts *pebble.WriteOptions) {
+	defer close(h.done)
+	start := []byte(h.prefix)
+	end := []byte(typeutil.AddOne(h.prefix))
+
+	sizeBefore, err := db.EstimateDiskUsage(start, end)
+	if err != nil {
+		log.Warn("failed to estimate disk usage before removal", zap.String("prefix", h.prefix), zap.Error(err))
+	}
+
+	if err := h.deleteChunks(db, writeOpts, start, end); err != nil {
+		h.deleteErr, h.err = err, err
+		close(h.deleted)
+		return
+	}
+	close(h.deleted)
+
+	if err := db.Compact(start, end, true); err != nil {
+		h.err = err
+		return
+	}
+	atomic.StoreInt32(&h.compacted, 1)
+	if sizeAfter, err := db.EstimateDiskUsage(start, end); err == nil && sizeBefore > sizeAfter {
+		atomic.StoreInt64(&h.bytesReclaimed, int64(sizeBefore-sizeAfter))
+	}
+	progress := h.Progress()
+	log.Info("pebble kv async remove done", zap.String("prefix", h.prefix),
+		zap.Int64("keysDeleted", progress.KeysDeleted),
+		zap.Int64("bytesReclaimed", progress.BytesReclaimed))
+}
+
+// deleteChunks writes a range tombstone for every RemoveChunkSize keys, the last tombstone ends
+// right after the last key seen so that keys written after the removal started are kept.
+func (h *RemoveHandle) deleteChunks(db *pebble.DB, writeOpts *pebble.WriteOptions, start, end []byte) error {
+	iter := NewPebbleIteratorWithUpperBound(db, &pebble.IterOptions{UpperBound: end})
+	defer iter.Close()
+
+	deleteRange := func(from, to []byte, n int64) error {
+		batch := db.NewBatch()
+		defer batch.Close()
+		if err := batch.DeleteRange(from, to, writeOpts); err != nil {
+			return err
+		}
+		if err := batch.Commit(writeOpts); err != nil {
+			return err
+		}
+		atomic.AddInt64(&h.keysDeleted, n)
+		return nil
+	}
+
+	chunkStart := start
+	var lastKey []byte
+	var n int64
+	for iter.Seek(start); iter.Valid(); iter.Next() {
+		if n == int64(RemoveChunkSize) {
+			// the current key starts the next chunk
+			next := append([]byte(nil), iter.Key()...)
+			if err := deleteRange(chunkStart, next, n); err != nil {
+				return err
+			}
+			chunkStart, n = next, 0
+		}
+		lastKey = append(lastKey[:0], iter.Key()...)
+		n++
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	return deleteRange(chunkStart, append(lastKey, 0), n)
+}
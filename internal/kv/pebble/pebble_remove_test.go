@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_RemoveWithPrefixAsync(t *testing.T) {
+	chunkSize := pebbleKV.RemoveChunkSize
+	pebbleKV.RemoveChunkSize = 3
+	defer func() { pebbleKV.RemoveChunkSize = chunkSize }()
+
+	name := "/tmp/pebble_remove_async"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, kv.Save(fmt.Sprintf("topic/%d", i), "value"))
+	}
+	assert.NoError(t, kv.Save("topicx", "kept"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := kv.WatchWithPrefix(ctx, "topic/")
+
+	h := kv.RemoveWithPrefixAsync("topic/")
+	<-h.Deleted()
+	has, err := kv.HasPrefix("topic/")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	assert.NoError(t, h.Wait())
+	progress := h.Progress()
+	assert.EqualValues(t, 10, progress.KeysDeleted)
+	assert.True(t, progress.Compacted)
+	assert.GreaterOrEqual(t, progress.BytesReclaimed, int64(0))
+
+	event := receiveEvent(t, ch)
+	assert.Equal(t, pebbleKV.WatchEventDeleteRange, event.Type)
+
+	val, err := kv.Load("topicx")
+	assert.NoError(t, err)
+	assert.Equal(t, "kept", val)
+
+	// nothing to remove
+	h = kv.RemoveWithPrefixAsync("topic/")
+	assert.NoError(t, h.Wait())
+	assert.EqualValues(t, 0, h.Progress().KeysDeleted)
+
+	h = kv.RemoveWithPrefixAsync("")
+	assert.Error(t, h.Wait())
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// WalkWithPrefix streams the key-values with a prefix to fn in key order without materializing them,
+// the key and value passed to fn are only valid during the call. paginationSize is kept for
+// compatibility with other kv implementations, pebble reads from a local iterator.
+func (kv *PebbleKV) WalkWithPrefix(prefix string, paginationSize int, fn func([]byte, []byte) error) (err error) {
+	defer kv.observe(metrics.PebbleKVScanLabel, prefix, time.Now(), &err)
+	if kv.DB == nil {
+		return fmt.Errorf("pebble instance is nil when walk %s", prefix)
+	}
+	option := pebble.IterOptions{}
+	if prefix != "" {
+		option.UpperBound = []byte(typeutil.AddOne(prefix))
+	}
+	iter := NewPebbleIteratorWithUpperBound(kv.DB, &option)
+	defer iter.Close()
+
+	for iter.Seek([]byte(prefix)); iter.Valid(); iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// LoadWithPrefixPaged returns at most limit key-values with a prefix, starting after the
+// continuation token returned by the previous call. An empty token starts from the beginning,
+// and an empty returned token means there is nothing left.
+func (kv *PebbleKV) LoadWithPrefixPaged(prefix string, token string, limit int) (_ []string, _ []string, _ string, err error) {
+	defer kv.observe(metrics.PebbleKVScanLabel, prefix, time.Now(), &err)
+	if kv.DB == nil {
+		return nil, nil, "", fmt.Errorf("pebble instance is nil when load %s", prefix)
+	}
+	return loadWithPrefixPaged(func(opts *pebble.IterOptions) *PebbleIterator {
+		return NewPebbleIteratorWithUpperBound(kv.DB, opts)
+	}, prefix, token, limit)
+}
+
+// LoadWithPrefixPaged returns at most limit key-values with a prefix in the snapshot,
+// the continuation token is the same as the one of PebbleKV.LoadWithPrefixPaged
+func (s *PebbleSnapshot) LoadWithPrefixPaged(prefix string, token string, limit int) ([]string, []string, string, error) {
+	return loadWithPrefixPaged(s.NewIterator, prefix, token, limit)
+}
+
+func loadWithPrefixPaged(newIter func(*pebble.IterOptions) *PebbleIterator, prefix string, token string, limit int) ([]string, []string, string, error) {
+	if limit <= 0 {
+		return nil, nil, "", errors.New("pebble kv page limit must be positive")
+	}
+	if token != "" && (len(token) < len(prefix) || token[:len(prefix)] != prefix) {
+		return nil, nil, "", fmt.Errorf("invalid continuation token %s for prefix %s", token, prefix)
+	}
+	option := pebble.IterOptions{}
+	if prefix != "" {
+		option.UpperBound = []byte(typeutil.AddOne(prefix))
+	}
+	iter := newIter(&option)
+	defer iter.Close()
+
+	start := []byte(prefix)
+	if token != "" {
+		// the token is the last key of the previous page, resume right after it
+		start = append([]byte(token), 0)
+	}
+	keys := make([]string, 0, limit)
+	values := make([]string, 0, limit)
+	for iter.Seek(start); iter.Valid(); iter.Next() {
+		if len(keys) == limit {
+			return keys, values, keys[len(keys)-1], nil
+		}
+		keys = append(keys, string(iter.Key()))
+		values = append(values, string(iter.Value()))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, nil, "", err
+	}
+	return keys, values, "", nil
+}
@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_WalkWithPrefix(t *testing.T) {
+	name := "/tmp/pebble_walk"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, kv.Save(fmt.Sprintf("page/%02d", i), fmt.Sprint(i)))
+	}
+	assert.NoError(t, kv.Save("pagf", "x"))
+
+	var keys []string
+	err = kv.WalkWithPrefix("page/", 0, func(key, value []byte) error {
+		keys = append(keys, string(key))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 10)
+	assert.Equal(t, "page/00", keys[0])
+
+	mockErr := errors.New("mock error")
+	count := 0
+	err = kv.WalkWithPrefix("page/", 0, func(key, value []byte) error {
+		count++
+		if count == 3 {
+			return mockErr
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, mockErr)
+	assert.Equal(t, 3, count)
+}
+
+func TestPebbleKV_LoadWithPrefixPaged(t *testing.T) {
+	name := "/tmp/pebble_paged"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, kv.Save(fmt.Sprintf("ack/%02d", i), fmt.Sprint(i)))
+	}
+	assert.NoError(t, kv.Save("acl", "x"))
+
+	_, _, _, err = kv.LoadWithPrefixPaged("ack/", "", 0)
+	assert.Error(t, err)
+	_, _, _, err = kv.LoadWithPrefixPaged("ack/", "other", 3)
+	assert.Error(t, err)
+
+	var keys, values []string
+	token := ""
+	pages := 0
+	for {
+		k, v, next, err := kv.LoadWithPrefixPaged("ack/", token, 3)
+		assert.NoError(t, err)
+		keys = append(keys, k...)
+		values = append(values, v...)
+		pages++
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assert.Equal(t, 4, pages)
+	assert.Len(t, keys, 10)
+	assert.Equal(t, "ack/09", keys[9])
+	assert.Equal(t, "9", values[9])
+
+	// exact multiple of the limit
+	k, _, next, err := kv.LoadWithPrefixPaged("ack/", "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, k, 10)
+	assert.Equal(t, "", next)
+
+	// the pages of a snapshot don't see the later writes
+	snapshot, err := kv.NewSnapshot()
+	assert.NoError(t, err)
+	defer snapshot.Close()
+	assert.NoError(t, kv.Save("ack/10", "10"))
+	k, _, next, err = snapshot.LoadWithPrefixPaged("ack/", "", 6)
+	assert.NoError(t, err)
+	assert.Len(t, k, 6)
+	assert.Equal(t, "ack/05", next)
+	k, _, next, err = snapshot.LoadWithPrefixPaged("ack/", next, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ack/06", "ack/07", "ack/08", "ack/09"}, k)
+	assert.Equal(t, "", next)
+}
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"runtime"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// PebbleSnapshot is a consistent point-in-time read view of the pebble kv,
+// writes after the snapshot is taken are not visible through it
+type PebbleSnapshot struct {
+	snap *pebble.Snapshot
+}
+
+// NewSnapshot takes a snapshot of the kv, the snapshot must be closed after use
+func (kv *PebbleKV) NewSnapshot() (*PebbleSnapshot, error) {
+	if kv.DB == nil {
+		return nil, errors.New("pebble instance is nil when do NewSnapshot")
+	}
+	return &PebbleSnapshot{snap: kv.DB.NewSnapshot()}, nil
+}
+
+// Load returns the value of specified key in the snapshot
+func (s *PebbleSnapshot) Load(key string) (string, error) {
+	if key == "" {
+		return "", errors.New("pebble kv does not support load empty key")
+	}
+	value, closer, err := s.snap.Get([]byte(key))
+	if err != nil && err != pebble.ErrNotFound {
+		return "", err
+	}
+	if closer != nil {
+		defer closer.Close()
+	}
+	return string(value), nil
+}
+
+// NewIterator returns an iterator over the snapshot
+func (s *PebbleSnapshot) NewIterator(opts *pebble.IterOptions) *PebbleIterator {
+	it := &PebbleIterator{s.snap.NewIter(opts), opts.GetUpperBound(), false}
+	runtime.SetFinalizer(it, func(it *PebbleIterator) {
+		if !it.close {
+			log.Error("iterator is leaking.. please check")
+		}
+	})
+	return it
+}
+
+// Close releases the snapshot
+func (s *PebbleSnapshot) Close() error {
+	return s.snap.Close()
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_Snapshot(t *testing.T) {
+	name := "/tmp/pebble_snapshot"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	assert.NoError(t, kv.MultiSave(map[string]string{"page/1": "10", "page/2": "20"}))
+	snapshot, err := kv.NewSnapshot()
+	assert.NoError(t, err)
+	defer snapshot.Close()
+
+	// writes after the snapshot are invisible
+	assert.NoError(t, kv.Save("page/3", "30"))
+	assert.NoError(t, kv.Save("page/1", "11"))
+
+	val, err := snapshot.Load("page/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", val)
+	val, err = snapshot.Load("page/3")
+	assert.NoError(t, err)
+	assert.Equal(t, "", val)
+	_, err = snapshot.Load("")
+	assert.Error(t, err)
+
+	iter := snapshot.NewIterator(&pebble.IterOptions{UpperBound: []byte("page0")})
+	defer iter.Close()
+	var keys []string
+	for iter.Seek([]byte("page/")); iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	assert.NoError(t, iter.Err())
+	assert.Equal(t, []string{"page/1", "page/2"}, keys)
+}
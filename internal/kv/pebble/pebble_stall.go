@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// ErrWriteStall is returned when the writes to pebble are stalled, the writer should back off and retry later,
+// it's a retriable merr.ErrServiceUnavailable
+var ErrWriteStall = merr.WrapErrServiceUnavailable("pebble write stall")
+
+// DefaultPressureSampleInterval is the default interval at which the L0 pressure is sampled from the db metrics,
+// collecting the metrics walks the whole LSM so it's not done on every write
+const DefaultPressureSampleInterval = time.Second
+
+// WriteStallMonitor tracks the write stalls of a pebble instance and the L0 buildup leading to them
+type WriteStallMonitor struct {
+	name string
+	// l0StopWrites is the L0 read amplification at which pebble stops the writes
+	l0StopWrites int
+	// SampleInterval is the interval at which the L0 pressure is sampled
+	SampleInterval time.Duration
+	pressure       atomic.Float64
+	lastSample     atomic.Int64
+
+	mu         sync.RWMutex
+	db         *pebble.DB
+	stalled    bool
+	reason     string
+	stallStart time.Time
+}
+
+// NewWriteStallMonitor installs the write stall listeners into opts, it must be called before opening the db
+// and Bind must be called with the opened db
+func NewWriteStallMonitor(name string, opts *pebble.Options) *WriteStallMonitor {
+	opts.EnsureDefaults()
+	m := &WriteStallMonitor{
+		name:           name,
+		l0StopWrites:   opts.L0StopWritesThreshold,
+		SampleInterval: DefaultPressureSampleInterval,
+	}
+	begin, end := opts.EventListener.WriteStallBegin, opts.EventListener.WriteStallEnd
+	opts.EventListener.WriteStallBegin = func(info pebble.WriteStallBeginInfo) {
+		m.onStallBegin(info.Reason)
+		if begin != nil {
+			begin(info)
+		}
+	}
+	opts.EventListener.WriteStallEnd = func() {
+		m.onStallEnd()
+		if end != nil {
+			end()
+		}
+	}
+	return m
+}
+
+// Bind sets the db whose L0 is inspected
+func (m *WriteStallMonitor) Bind(db *pebble.DB) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.db = db
+}
+
+func (m *WriteStallMonitor) onStallBegin(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stalled {
+		return
+	}
+	m.stalled = true
+	m.reason = reason
+	m.stallStart = time.Now()
+	metrics.PebbleWriteStallGauge.WithLabelValues(m.name).Set(1)
+	metrics.PebbleWriteStallCounter.WithLabelValues(m.name).Inc()
+	log.Warn("pebble write stall begin", zap.String("name", m.name), zap.String("reason", reason))
+}
+
+func (m *WriteStallMonitor) onStallEnd() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.stalled {
+		return
+	}
+	m.stalled = false
+	metrics.PebbleWriteStallGauge.WithLabelValues(m.name).Set(0)
+	log.Warn("pebble write stall end", zap.String("name", m.name), zap.String("reason", m.reason),
+		zap.Duration("duration", time.Since(m.stallStart)))
+}
+
+// Stalled returns whether the writes are stalled and the reason
+func (m *WriteStallMonitor) Stalled() (bool, string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stalled, m.reason
+}
+
+// Pressure returns the L0 read amplification relative to the threshold stopping the writes,
+// the writes are stalled once it reaches 1. The pressure is sampled at most once per SampleInterval,
+// the last sample is returned in between.
+func (m *WriteStallMonitor) Pressure() float64 {
+	m.mu.RLock()
+	db := m.db
+	m.mu.RUnlock()
+	if db == nil || m.l0StopWrites <= 0 {
+		return 0
+	}
+	now := time.Now().UnixNano()
+	last := m.lastSample.Load()
+	if last != 0 && now-last < int64(m.SampleInterval) {
+		return m.pressure.Load()
+	}
+	// only one caller samples, the others keep using the last sample
+	if !m.lastSample.CompareAndSwap(last, now) {
+		return m.pressure.Load()
+	}
+	pressure := float64(db.Metrics().Levels[0].Sublevels) / float64(m.l0StopWrites)
+	m.pressure.Store(pressure)
+	metrics.PebbleL0Pressure.WithLabelValues(m.name).Set(pressure)
+	return pressure
+}
+
+// Check returns an error matching ErrWriteStall if the writes are stalled
+func (m *WriteStallMonitor) Check() error {
+	if stalled, reason := m.Stalled(); stalled {
+		return errors.Wrapf(ErrWriteStall, "%s: %s", m.name, reason)
+	}
+	if pressure := m.Pressure(); pressure >= 1 {
+		return errors.Wrapf(ErrWriteStall, "%s: L0 pressure %.2f", m.name, pressure)
+	}
+	return nil
+}
+
+// ThrottleDelay returns the delay to apply before a write, it grows linearly from 0 to maxDelay
+// as the L0 pressure grows from slowdownRatio to 1
+func (m *WriteStallMonitor) ThrottleDelay(slowdownRatio float64, maxDelay time.Duration) time.Duration {
+	if maxDelay <= 0 || slowdownRatio >= 1 {
+		return 0
+	}
+	pressure := m.Pressure()
+	if pressure <= slowdownRatio {
+		return 0
+	}
+	if pressure >= 1 {
+		return maxDelay
+	}
+	return time.Duration(float64(maxDelay) * (pressure - slowdownRatio) / (1 - slowdownRatio))
+}
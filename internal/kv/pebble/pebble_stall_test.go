@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_WriteStallMonitor(t *testing.T) {
+	opts := &pebble.Options{}
+	m := pebbleKV.NewWriteStallMonitor("stall_test", opts)
+
+	stalled, _ := m.Stalled()
+	assert.False(t, stalled)
+	assert.NoError(t, m.Check())
+	// no db is bound
+	assert.Equal(t, float64(0), m.Pressure())
+	assert.Equal(t, time.Duration(0), m.ThrottleDelay(0.5, time.Second))
+
+	opts.EventListener.WriteStallBegin(pebble.WriteStallBeginInfo{Reason: "L0 file count limit exceeded"})
+	stalled, reason := m.Stalled()
+	assert.True(t, stalled)
+	assert.Equal(t, "L0 file count limit exceeded", reason)
+	err := m.Check()
+	assert.True(t, errors.Is(err, pebbleKV.ErrWriteStall))
+
+	opts.EventListener.WriteStallEnd()
+	stalled, _ = m.Stalled()
+	assert.False(t, stalled)
+	assert.NoError(t, m.Check())
+}
+
+func TestPebbleKV_WriteStall(t *testing.T) {
+	name := "/tmp/pebble_stall"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+
+	stall := kv.WriteStall()
+	assert.NotNil(t, stall)
+	assert.NoError(t, kv.Save("key", "value"))
+	assert.NoError(t, stall.Check())
+	assert.Less(t, stall.Pressure(), float64(1))
+	assert.Equal(t, time.Duration(0), stall.ThrottleDelay(1, time.Second))
+	assert.Equal(t, time.Duration(0), stall.ThrottleDelay(0.5, 0))
+
+	// the pressure is reused within the sample interval, and resampled after it
+	stall.SampleInterval = time.Hour
+	pressure := stall.Pressure()
+	// stay below the L0 compaction threshold so that the flushed files are kept in L0
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, kv.Save("key", "value"))
+		assert.NoError(t, kv.DB.Flush())
+	}
+	assert.Equal(t, pressure, stall.Pressure())
+	stall.SampleInterval = 0
+	assert.Greater(t, stall.Pressure(), pressure)
+}
@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// ttlPrefix is the reserved key space for ttl bookkeeping
+	ttlPrefix = internalKeyPrefix + "ttl__/"
+	// ttlIndexPrefix indexes keys by expire time: ttlIndexPrefix + expireTs + "/" + key
+	ttlIndexPrefix = ttlPrefix + "idx/"
+	// ttlExpirePrefix maps key to its current expire time: ttlExpirePrefix + key -> expireTs
+	ttlExpirePrefix = ttlPrefix + "exp/"
+)
+
+// TTLSweepInterval is the interval the background sweeper removes expired keys
+var TTLSweepInterval = 10 * time.Second
+
+// ttlSweepBatchSize is the most index entries checked by a batch of the sweep, the writes of ttl keys
+// are blocked by the batch being checked
+const ttlSweepBatchSize = 1000
+
+func ttlIndexKey(expireTs int64, key string) string {
+	// zero padded so that the index is ordered by expire time
+	return fmt.Sprintf("%s%020d/%s", ttlIndexPrefix, expireTs, key)
+}
+
+// SaveWithTTL saves a pair of key-value which is removed automatically once ttl elapsed.
+// Saving the key again with SaveWithTTL renews its ttl, while Save does not clear it.
+// Removing the key removes its ttl as well.
+func (kv *PebbleKV) SaveWithTTL(key, value string, ttl time.Duration) (err error) {
+	defer kv.observe(metrics.PebbleKVPutLabel, key, time.Now(), &err)
+	if kv.DB == nil {
+		return errors.New("pebble instance is nil when do SaveWithTTL")
+	}
+	if key == "" {
+		return errors.New("pebble kv does not support empty key")
+	}
+	if value == "" {
+		return errors.New("pebble kv does not support empty value")
+	}
+	if ttl <= 0 {
+		return fmt.Errorf("pebble kv ttl must be positive, ttl %s", ttl)
+	}
+
+	// serialized with the sweep, so a renewed key is never removed by its stale expire time
+	kv.casMu.Lock()
+	defer kv.casMu.Unlock()
+
+	kv.hasTTL.Store(true)
+	expireTs := time.Now().Add(ttl).UnixNano()
+	writeBatch := kv.DB.NewBatch()
+	defer writeBatch.Close()
+	writeBatch.Set([]byte(key), []byte(value), kv.WriteOptions)
+	writeBatch.Set([]byte(ttlExpirePrefix+key), []byte(strconv.FormatInt(expireTs, 10)), kv.WriteOptions)
+	writeBatch.Set([]byte(ttlIndexKey(expireTs, key)), []byte{}, kv.WriteOptions)
+	if err := writeBatch.Commit(kv.WriteOptions); err != nil {
+		return err
+	}
+	if kv.tracked() {
+		kv.committed(WatchEvent{Type: WatchEventPut, Key: key, Value: value})
+	}
+	return nil
+}
+
+// SweepExpired removes all keys whose ttl elapsed, returns the number of removed keys
+func (kv *PebbleKV) SweepExpired() (int, error) {
+	if kv.DB == nil {
+		return 0, errors.New("pebble instance is nil when do SweepExpired")
+	}
+	now := time.Now().UnixNano()
+	total := 0
+	for {
+		removed, more, err := kv.sweepExpiredBatch(now)
+		total += removed
+		if err != nil || !more {
+			return total, err
+		}
+	}
+}
+
+// sweepExpiredBatch removes the keys expired before now in a batch of the ttl index,
+// returns the number of removed keys and whether there are more index entries to check
+func (kv *PebbleKV) sweepExpiredBatch(now int64) (int, bool, error) {
+	kv.casMu.Lock()
+	defer kv.casMu.Unlock()
+
+	option := pebble.IterOptions{UpperBound: []byte(typeutil.AddOne(ttlIndexPrefix))}
+	iter := NewPebbleIteratorWithUpperBound(kv.DB, &option)
+	defer iter.Close()
+
+	writeBatch := kv.DB.NewBatch()
+	defer writeBatch.Close()
+	var removedKeys []string
+	checked := 0
+	more := false
+	for iter.Seek([]byte(ttlIndexPrefix)); iter.Valid(); iter.Next() {
+		if checked >= ttlSweepBatchSize {
+			more = true
+			break
+		}
+		checked++
+		indexKey := string(iter.Key())
+		parts := strings.SplitN(strings.TrimPrefix(indexKey, ttlIndexPrefix), "/", 2)
+		if len(parts) != 2 {
+			writeBatch.Delete([]byte(indexKey), kv.WriteOptions)
+			continue
+		}
+		expireTs, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			writeBatch.Delete([]byte(indexKey), kv.WriteOptions)
+			continue
+		}
+		if expireTs > now {
+			break
+		}
+		key := parts[1]
+		writeBatch.Delete([]byte(indexKey), kv.WriteOptions)
+		// the key is only removed if it still expires at the time of the index entry,
+		// it may have been renewed by a later SaveWithTTL, or removed with its ttl
+		current, err := kv.load(ttlExpirePrefix + key)
+		if err != nil {
+			return 0, false, err
+		}
+		if current != parts[0] {
+			continue
+		}
+		writeBatch.Delete([]byte(key), kv.WriteOptions)
+		writeBatch.Delete([]byte(ttlExpirePrefix+key), kv.WriteOptions)
+		removedKeys = append(removedKeys, key)
+	}
+	if err := iter.Err(); err != nil {
+		return 0, false, err
+	}
+	if writeBatch.Empty() {
+		return 0, false, nil
+	}
+	if err := writeBatch.Commit(kv.WriteOptions); err != nil {
+		return 0, false, err
+	}
+	if kv.tracked() {
+		kv.committed(deleteEvents(removedKeys)...)
+	}
+	return len(removedKeys), more, nil
+}
+
+// removeTTL adds the removal of the ttl of keys to writeBatch, the index entries left are dropped by the sweep
+func (kv *PebbleKV) removeTTL(writeBatch *pebble.Batch, keys ...string) {
+	if !kv.hasTTL.Load() {
+		return
+	}
+	for _, key := range keys {
+		writeBatch.Delete([]byte(ttlExpirePrefix+key), kv.WriteOptions)
+	}
+}
+
+// removeTTLRange adds the removal of the ttl of the keys from startKey to endKey to writeBatch
+func (kv *PebbleKV) removeTTLRange(writeBatch *pebble.Batch, startKey, endKey string) {
+	if !kv.hasTTL.Load() {
+		return
+	}
+	writeBatch.DeleteRange([]byte(ttlExpirePrefix+startKey), []byte(ttlExpirePrefix+endKey), kv.WriteOptions)
+}
+
+// startSweeper starts the background sweeper of the expired keys, the kv opened read-only isn't swept
+func (kv *PebbleKV) startSweeper() {
+	if kv.IsReadOnly() {
+		return
+	}
+	// the keys saved with ttl before the db was opened are swept as well
+	has, err := kv.HasPrefix(ttlExpirePrefix)
+	kv.hasTTL.Store(err != nil || has)
+	kv.sweeperStop = make(chan struct{})
+	kv.sweeperWg.Add(1)
+	go func(stop <-chan struct{}) {
+		defer kv.sweeperWg.Done()
+		ticker := time.NewTicker(TTLSweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if !kv.hasTTL.Load() {
+					continue
+				}
+				removed, err := kv.SweepExpired()
+				if err != nil {
+					log.Warn("pebble kv failed to sweep expired keys", zap.String("name", kv.name), zap.Error(err))
+					continue
+				}
+				if removed > 0 {
+					log.Debug("pebble kv swept expired keys", zap.String("name", kv.name), zap.Int("removed", removed))
+				}
+			}
+		}
+	}(kv.sweeperStop)
+}
+
+func (kv *PebbleKV) stopSweeper() {
+	if kv.sweeperStop != nil {
+		close(kv.sweeperStop)
+		kv.sweeperWg.Wait()
+		kv.sweeperStop = nil
+	}
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_SaveWithTTL(t *testing.T) {
+	name := "/tmp/pebble_ttl"
+	pebbleKV, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer pebbleKV.Close()
+	defer pebbleKV.RemoveWithPrefix("")
+
+	assert.Error(t, pebbleKV.SaveWithTTL("", "1", time.Second))
+	assert.Error(t, pebbleKV.SaveWithTTL("a", "", time.Second))
+	assert.Error(t, pebbleKV.SaveWithTTL("a", "1", 0))
+
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/1", "1", time.Millisecond))
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/2", "2", time.Hour))
+	// renewed with a longer ttl, the stale index entry must not remove it
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/3", "3", time.Millisecond))
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/3", "3", time.Hour))
+	assert.NoError(t, pebbleKV.Save("plain", "4"))
+	time.Sleep(10 * time.Millisecond)
+
+	removed, err := pebbleKV.SweepExpired()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	keys, vals, err := pebbleKV.LoadWithPrefix("lease/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lease/2", "lease/3"}, keys)
+	assert.Equal(t, []string{"2", "3"}, vals)
+	has, err := pebbleKV.Has("plain")
+	assert.NoError(t, err)
+	assert.True(t, has)
+
+	removed, err = pebbleKV.SweepExpired()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, removed)
+
+	// the ttl is removed with the key, the key saved again isn't removed by it
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/4", "4", time.Millisecond))
+	assert.NoError(t, pebbleKV.Remove("lease/4"))
+	assert.NoError(t, pebbleKV.Save("lease/4", "4"))
+	assert.NoError(t, pebbleKV.SaveWithTTL("lease/5", "5", time.Millisecond))
+	assert.NoError(t, pebbleKV.RemoveWithPrefix("lease/5"))
+	assert.NoError(t, pebbleKV.Save("lease/5", "5"))
+	time.Sleep(10 * time.Millisecond)
+	removed, err = pebbleKV.SweepExpired()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, removed)
+	keys, _, err = pebbleKV.LoadWithPrefix("lease/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lease/2", "lease/3", "lease/4", "lease/5"}, keys)
+	keys, _, err = pebbleKV.LoadWithPrefix("__pebblekv_ttl__/exp/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"__pebblekv_ttl__/exp/lease/2", "__pebblekv_ttl__/exp/lease/3"}, keys)
+}
+
+func TestPebbleKV_TTLSweeper(t *testing.T) {
+	interval := pebbleKV.TTLSweepInterval
+	pebbleKV.TTLSweepInterval = 10 * time.Millisecond
+	defer func() { pebbleKV.TTLSweepInterval = interval }()
+
+	name := "/tmp/pebble_ttl_sweeper"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer func() {
+		kv.RemoveWithPrefix("")
+		kv.Close()
+	}()
+
+	assert.NoError(t, kv.SaveWithTTL("intent", "1", 20*time.Millisecond))
+	assert.Eventually(t, func() bool {
+		has, err := kv.Has("intent")
+		return err == nil && !has
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the keys saved with ttl before the kv is reopened are swept as well
+	assert.NoError(t, kv.SaveWithTTL("intent", "1", 20*time.Millisecond))
+	kv.Close()
+	kv, err = pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		has, err := kv.Has("intent")
+		return err == nil && !has
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ErrTxnDone is returned when operating on a transaction which is already committed or discarded
+var ErrTxnDone = errors.New("pebble txn is already committed or discarded")
+
+// PebbleTxn buffers a batch of Save/Remove operations and applies them atomically on Commit.
+// Reads issued through the transaction observe its own pending writes.
+// A PebbleTxn is not safe for concurrent use.
+type PebbleTxn struct {
+	kv    *PebbleKV
+	batch *pebble.Batch
+	done  bool
+	// events are delivered to watchers once committed
+	events []WatchEvent
+}
+
+// Txn starts a new transaction on the pebble kv
+func (kv *PebbleKV) Txn() (*PebbleTxn, error) {
+	if kv.DB == nil {
+		return nil, errors.New("pebble instance is nil when do Txn")
+	}
+	return &PebbleTxn{
+		kv:    kv,
+		batch: kv.DB.NewIndexedBatch(),
+	}, nil
+}
+
+// Load returns the value of specified key, pending writes of the txn are visible
+func (txn *PebbleTxn) Load(key string) (string, error) {
+	if txn.done {
+		return "", ErrTxnDone
+	}
+	if key == "" {
+		return "", errors.New("pebble kv does not support load empty key")
+	}
+	if len(txn.events) == 0 && txn.kv.cache != nil {
+		// nothing is buffered yet, the committed value is read through the cache
+		return txn.kv.Load(key)
+	}
+	value, closer, err := txn.batch.Get([]byte(key))
+	if err != nil && err != pebble.ErrNotFound {
+		return "", err
+	}
+	if closer != nil {
+		defer closer.Close()
+	}
+	return string(value), nil
+}
+
+// Has checks whether the key exists, pending writes of the txn are visible
+func (txn *PebbleTxn) Has(key string) (bool, error) {
+	value, err := txn.Load(key)
+	if err != nil {
+		return false, err
+	}
+	return len(value) != 0, nil
+}
+
+// LoadWithPrefix returns a batch values of keys with a prefix, pending writes of the txn are visible
+func (txn *PebbleTxn) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	if txn.done {
+		return nil, nil, ErrTxnDone
+	}
+	option := pebble.IterOptions{}
+	if prefix != "" {
+		option.UpperBound = []byte(typeutil.AddOne(prefix))
+	}
+	iter := txn.batch.NewIter(&option)
+	defer iter.Close()
+
+	var keys, values []string
+	for iter.SeekGE([]byte(prefix)); iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+		values = append(values, string(iter.Value()))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// Save buffers a pair of key-value
+func (txn *PebbleTxn) Save(key, value string) error {
+	if txn.done {
+		return ErrTxnDone
+	}
+	if key == "" {
+		return errors.New("pebble kv does not support empty key")
+	}
+	if value == "" {
+		return errors.New("pebble kv does not support empty value")
+	}
+	if err := txn.batch.Set([]byte(key), []byte(value), txn.kv.WriteOptions); err != nil {
+		return err
+	}
+	txn.events = append(txn.events, WatchEvent{Type: WatchEventPut, Key: key, Value: value})
+	return nil
+}
+
+// Remove buffers the removal of a key
+func (txn *PebbleTxn) Remove(key string) error {
+	if txn.done {
+		return ErrTxnDone
+	}
+	if key == "" {
+		return errors.New("pebble kv does not support empty key")
+	}
+	if err := txn.batch.Delete([]byte(key), txn.kv.WriteOptions); err != nil {
+		return err
+	}
+	txn.kv.removeTTL(txn.batch, key)
+	txn.events = append(txn.events, WatchEvent{Type: WatchEventDelete, Key: key})
+	return nil
+}
+
+// RemoveWithPrefix buffers the removal of all keys with specified prefix
+func (txn *PebbleTxn) RemoveWithPrefix(prefix string) error {
+	if prefix == "" {
+		return errors.New("pebble txn does not support remove empty prefix")
+	}
+	return txn.DeleteRange(prefix, typeutil.AddOne(prefix))
+}
+
+// DeleteRange buffers the removal of keys from startKey to endKey
+func (txn *PebbleTxn) DeleteRange(startKey, endKey string) error {
+	if txn.done {
+		return ErrTxnDone
+	}
+	if startKey >= endKey {
+		return fmt.Errorf("pebblekv delete range startkey must < endkey, startkey %s, endkey %s", startKey, endKey)
+	}
+	if err := txn.batch.DeleteRange([]byte(startKey), []byte(endKey), txn.kv.WriteOptions); err != nil {
+		return err
+	}
+	txn.kv.removeTTLRange(txn.batch, startKey, endKey)
+	txn.events = append(txn.events, WatchEvent{Type: WatchEventDeleteRange, Key: startKey, EndKey: endKey})
+	return nil
+}
+
+// Commit applies all buffered operations atomically, the txn can not be used afterwards
+func (txn *PebbleTxn) Commit() (err error) {
+	defer txn.kv.observe(metrics.PebbleKVTxnLabel, firstEventKey(txn.events), time.Now(), &err)
+	if txn.done {
+		return ErrTxnDone
+	}
+	txn.done = true
+	defer txn.batch.Close()
+	if err := txn.batch.Commit(txn.kv.WriteOptions); err != nil {
+		return err
+	}
+	if txn.kv.tracked() {
+		txn.kv.committed(txn.events...)
+	}
+	return nil
+}
+
+// Discard drops all buffered operations, it is safe to call after Commit
+func (txn *PebbleTxn) Discard() {
+	if txn.done {
+		return
+	}
+	txn.done = true
+	txn.batch.Close()
+}
+
+func firstEventKey(events []WatchEvent) string {
+	if len(events) == 0 {
+		return ""
+	}
+	return events[0].Key
+}
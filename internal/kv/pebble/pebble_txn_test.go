@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func TestPebbleKV_TxnReadYourWrites(t *testing.T) {
+	name := "/tmp/pebble_txn"
+	pebbleKV, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer pebbleKV.Close()
+	defer pebbleKV.RemoveWithPrefix("")
+
+	err = pebbleKV.MultiSave(map[string]string{"a/1": "1", "a/2": "2", "b": "3"})
+	assert.NoError(t, err)
+
+	txn, err := pebbleKV.Txn()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Save("a/3", "3"))
+	assert.NoError(t, txn.Remove("b"))
+	assert.NoError(t, txn.RemoveWithPrefix("a/1"))
+
+	// read-your-writes
+	val, err := txn.Load("a/3")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", val)
+	has, err := txn.Has("b")
+	assert.NoError(t, err)
+	assert.False(t, has)
+	keys, vals, err := txn.LoadWithPrefix("a/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/2", "a/3"}, keys)
+	assert.Equal(t, []string{"2", "3"}, vals)
+
+	// not visible outside before commit
+	val, err = pebbleKV.Load("a/3")
+	assert.NoError(t, err)
+	assert.Equal(t, "", val)
+	val, err = pebbleKV.Load("b")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", val)
+
+	assert.NoError(t, txn.Commit())
+	keys, _, err = pebbleKV.LoadWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/2", "a/3"}, keys)
+
+	// txn is unusable once committed
+	assert.Error(t, txn.Commit())
+	assert.Error(t, txn.Save("c", "4"))
+	_, err = txn.Load("a/2")
+	assert.Error(t, err)
+	txn.Discard()
+}
+
+func TestPebbleKV_TxnDiscard(t *testing.T) {
+	name := "/tmp/pebble_txn_discard"
+	pebbleKV, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer pebbleKV.Close()
+	defer pebbleKV.RemoveWithPrefix("")
+
+	txn, err := pebbleKV.Txn()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Save("a", "1"))
+	assert.Error(t, txn.Save("", "1"))
+	assert.Error(t, txn.Save("b", ""))
+	assert.Error(t, txn.Remove(""))
+	assert.Error(t, txn.RemoveWithPrefix(""))
+	assert.Error(t, txn.DeleteRange("b", "a"))
+	txn.Discard()
+	assert.Error(t, txn.Commit())
+
+	has, err := pebbleKV.Has("a")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	db := pebbleKV.DB
+	pebbleKV.DB = nil
+	_, err = pebbleKV.Txn()
+	assert.Error(t, err)
+	pebbleKV.DB = db
+}
@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// WatchEventType is the type of a change delivered to watchers
+type WatchEventType int
+
+const (
+	// WatchEventPut means Key is saved with Value
+	WatchEventPut WatchEventType = iota
+	// WatchEventDelete means Key is removed
+	WatchEventDelete
+	// WatchEventDeleteRange means keys in [Key, EndKey) are removed, an empty EndKey means no upper bound
+	WatchEventDeleteRange
+)
+
+// WatchChanSize is the buffer size of a watch channel. A watcher which falls behind
+// more than WatchChanSize events is dropped and its channel closed, the subscriber
+// shall reload with LoadWithPrefix and watch again.
+var WatchChanSize = 1024
+
+// WatchEvent is a change of the pebble kv
+type WatchEvent struct {
+	Type   WatchEventType
+	Key    string
+	Value  string
+	EndKey string
+}
+
+type watcher struct {
+	prefix string
+	ch     chan WatchEvent
+}
+
+func (w *watcher) match(event WatchEvent) bool {
+	if event.Type != WatchEventDeleteRange {
+		return strings.HasPrefix(event.Key, w.prefix)
+	}
+	// the range [Key, EndKey) overlaps with the prefix
+	if w.prefix == "" || strings.HasPrefix(event.Key, w.prefix) {
+		return true
+	}
+	return event.Key < w.prefix && (event.EndKey == "" || w.prefix < event.EndKey)
+}
+
+func (w *watcher) deliver(events []WatchEvent) bool {
+	for _, event := range events {
+		if strings.HasPrefix(event.Key, internalKeyPrefix) || !w.match(event) {
+			continue
+		}
+		select {
+		case w.ch <- event:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type watchHub struct {
+	mu       sync.RWMutex
+	watchers map[*watcher]struct{}
+}
+
+// WatchWithPrefix delivers put/delete events of keys with the prefix until ctx is done.
+// Events of a single write are delivered in order, concurrent writes may interleave.
+func (kv *PebbleKV) WatchWithPrefix(ctx context.Context, prefix string) <-chan WatchEvent {
+	w := &watcher{
+		prefix: prefix,
+		ch:     make(chan WatchEvent, WatchChanSize),
+	}
+	kv.watch.mu.Lock()
+	if kv.watch.watchers == nil {
+		kv.watch.watchers = make(map[*watcher]struct{})
+	}
+	kv.watch.watchers[w] = struct{}{}
+	kv.watch.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		kv.watch.remove(w)
+	}()
+	return w.ch
+}
+
+func (h *watchHub) remove(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.ch)
+	}
+}
+
+func (h *watchHub) active() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.watchers) > 0
+}
+
+func (h *watchHub) notify(events ...WatchEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		if !w.deliver(events) {
+			// slow watcher, drop it rather than blocking writers
+			delete(h.watchers, w)
+			close(w.ch)
+		}
+	}
+}
+
+func (h *watchHub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		close(w.ch)
+	}
+	h.watchers = nil
+}
+
+// tracked returns whether the writes need to be reported by committed
+func (kv *PebbleKV) tracked() bool {
+	return kv.cache != nil || kv.watch.active()
+}
+
+// committed invalidates the read cache and notifies the watchers of the committed writes
+func (kv *PebbleKV) committed(events ...WatchEvent) {
+	if kv.cache != nil {
+		kv.cache.invalidate(events)
+	}
+	if kv.watch.active() {
+		kv.watch.notify(events...)
+	}
+}
+
+func putEvents(kvs map[string]string) []WatchEvent {
+	events := make([]WatchEvent, 0, len(kvs))
+	for k, v := range kvs {
+		events = append(events, WatchEvent{Type: WatchEventPut, Key: k, Value: v})
+	}
+	return events
+}
+
+func deleteEvents(keys []string) []WatchEvent {
+	events := make([]WatchEvent, 0, len(keys))
+	for _, key := range keys {
+		events = append(events, WatchEvent{Type: WatchEventDelete, Key: key})
+	}
+	return events
+}
+
+func removePrefixEvents(prefixes []string) []WatchEvent {
+	events := make([]WatchEvent, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			// the whole kv is removed
+			return []WatchEvent{{Type: WatchEventDeleteRange}}
+		}
+		events = append(events, WatchEvent{Type: WatchEventDeleteRange, Key: prefix, EndKey: typeutil.AddOne(prefix)})
+	}
+	return events
+}
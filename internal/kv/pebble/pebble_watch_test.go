@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebblekv_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pebbleKV "github.com/milvus-io/milvus/internal/kv/pebble"
+)
+
+func receiveEvent(t *testing.T, ch <-chan pebbleKV.WatchEvent) pebbleKV.WatchEvent {
+	select {
+	case event, ok := <-ch:
+		assert.True(t, ok)
+		return event
+	case <-time.After(time.Second):
+		assert.FailNow(t, "no watch event received")
+	}
+	return pebbleKV.WatchEvent{}
+}
+
+func TestPebbleKV_WatchWithPrefix(t *testing.T) {
+	name := "/tmp/pebble_watch"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := kv.WatchWithPrefix(ctx, "meta/")
+
+	assert.NoError(t, kv.Save("other", "0"))
+	assert.NoError(t, kv.Save("meta/a", "1"))
+	event := receiveEvent(t, ch)
+	assert.Equal(t, pebbleKV.WatchEvent{Type: pebbleKV.WatchEventPut, Key: "meta/a", Value: "1"}, event)
+
+	assert.NoError(t, kv.Remove("meta/a"))
+	event = receiveEvent(t, ch)
+	assert.Equal(t, pebbleKV.WatchEvent{Type: pebbleKV.WatchEventDelete, Key: "meta/a"}, event)
+
+	assert.NoError(t, kv.MultiSaveAndRemove(map[string]string{"meta/b": "2"}, []string{"meta/c"}))
+	assert.Equal(t, "meta/b", receiveEvent(t, ch).Key)
+	assert.Equal(t, "meta/c", receiveEvent(t, ch).Key)
+
+	// range removal overlapping the watched prefix
+	assert.NoError(t, kv.RemoveWithPrefix("me"))
+	event = receiveEvent(t, ch)
+	assert.Equal(t, pebbleKV.WatchEventDeleteRange, event.Type)
+	assert.Equal(t, "me", event.Key)
+
+	// txn events are delivered on commit
+	txn, err := kv.Txn()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Save("meta/d", "4"))
+	assert.Len(t, ch, 0)
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, "meta/d", receiveEvent(t, ch).Key)
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, ok := <-ch
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, kv.Save("meta/e", "5"))
+}
+
+func TestPebbleKV_WatchSlowWatcher(t *testing.T) {
+	size := pebbleKV.WatchChanSize
+	pebbleKV.WatchChanSize = 1
+	defer func() { pebbleKV.WatchChanSize = size }()
+
+	name := "/tmp/pebble_watch_slow"
+	kv, err := pebbleKV.NewPebbleKV(name)
+	assert.NoError(t, err)
+	defer os.RemoveAll(name)
+	defer kv.Close()
+	defer kv.RemoveWithPrefix("")
+
+	ch := kv.WatchWithPrefix(context.Background(), "")
+	assert.NoError(t, kv.MultiSave(map[string]string{"a": "1", "b": "2"}))
+	_, ok := <-ch
+	assert.True(t, ok)
+	_, ok = <-ch
+	assert.False(t, ok)
+}
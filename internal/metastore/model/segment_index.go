@@ -21,6 +21,7 @@ type SegmentIndex struct {
 	CreateTime    uint64
 	IndexFileKeys []string
 	IndexSize     uint64
+	ArchiveState  indexpb.IndexArchiveState
 	// deprecated
 	WriteHandoff bool
 }
@@ -45,6 +46,7 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		CreateTime:    segIndex.CreateTime,
 		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
 		IndexSize:     segIndex.SerializeSize,
+		ArchiveState:  segIndex.ArchiveState,
 		WriteHandoff:  segIndex.WriteHandoff,
 	}
 }
@@ -69,6 +71,7 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		Deleted:       segIdx.IsDeleted,
 		CreateTime:    segIdx.CreateTime,
 		SerializeSize: segIdx.IndexSize,
+		ArchiveState:  segIdx.ArchiveState,
 		WriteHandoff:  segIdx.WriteHandoff,
 	}
 }
@@ -89,6 +92,7 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		CreateTime:    segIndex.CreateTime,
 		IndexFileKeys: common.CloneStringList(segIndex.IndexFileKeys),
 		IndexSize:     segIndex.IndexSize,
+		ArchiveState:  segIndex.ArchiveState,
 		WriteHandoff:  segIndex.WriteHandoff,
 	}
 }
@@ -27,6 +27,91 @@ func (_m *ChunkManager) EXPECT() *ChunkManager_Expecter {
 	return &ChunkManager_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function with given fields: ctx, filePaths
+func (_m *ChunkManager) Archive(ctx context.Context, filePaths []string) error {
+	ret := _m.Called(ctx, filePaths)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChunkManager_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type ChunkManager_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePaths []string
+func (_e *ChunkManager_Expecter) Archive(ctx interface{}, filePaths interface{}) *ChunkManager_Archive_Call {
+	return &ChunkManager_Archive_Call{Call: _e.mock.On("Archive", ctx, filePaths)}
+}
+
+func (_c *ChunkManager_Archive_Call) Run(run func(ctx context.Context, filePaths []string)) *ChunkManager_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_Archive_Call) Return(_a0 error) *ChunkManager_Archive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ArchiveStates provides a mock function with given fields: ctx, filePaths
+func (_m *ChunkManager) ArchiveStates(ctx context.Context, filePaths []string) ([]storage.ArchiveState, error) {
+	ret := _m.Called(ctx, filePaths)
+
+	var r0 []storage.ArchiveState
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []storage.ArchiveState); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.ArchiveState)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, filePaths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChunkManager_ArchiveStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveStates'
+type ChunkManager_ArchiveStates_Call struct {
+	*mock.Call
+}
+
+// ArchiveStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePaths []string
+func (_e *ChunkManager_Expecter) ArchiveStates(ctx interface{}, filePaths interface{}) *ChunkManager_ArchiveStates_Call {
+	return &ChunkManager_ArchiveStates_Call{Call: _e.mock.On("ArchiveStates", ctx, filePaths)}
+}
+
+func (_c *ChunkManager_ArchiveStates_Call) Run(run func(ctx context.Context, filePaths []string)) *ChunkManager_ArchiveStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_ArchiveStates_Call) Return(_a0 []storage.ArchiveState, _a1 error) *ChunkManager_ArchiveStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Exist provides a mock function with given fields: ctx, filePath
 func (_m *ChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
 	ret := _m.Called(ctx, filePath)
@@ -176,6 +261,53 @@ func (_c *ChunkManager_Mmap_Call) Return(_a0 *mmap.ReaderAt, _a1 error) *ChunkMa
 	return _c
 }
 
+// MultiExist provides a mock function with given fields: ctx, filePaths
+func (_m *ChunkManager) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	ret := _m.Called(ctx, filePaths)
+
+	var r0 []bool
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []bool); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bool)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, filePaths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChunkManager_MultiExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiExist'
+type ChunkManager_MultiExist_Call struct {
+	*mock.Call
+}
+
+// MultiExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePaths []string
+func (_e *ChunkManager_Expecter) MultiExist(ctx interface{}, filePaths interface{}) *ChunkManager_MultiExist_Call {
+	return &ChunkManager_MultiExist_Call{Call: _e.mock.On("MultiExist", ctx, filePaths)}
+}
+
+func (_c *ChunkManager_MultiExist_Call) Run(run func(ctx context.Context, filePaths []string)) *ChunkManager_MultiExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_MultiExist_Call) Return(_a0 []bool, _a1 error) *ChunkManager_MultiExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // MultiRead provides a mock function with given fields: ctx, filePaths
 func (_m *ChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
 	ret := _m.Called(ctx, filePaths)
@@ -261,6 +393,53 @@ func (_c *ChunkManager_MultiRemove_Call) Return(_a0 error) *ChunkManager_MultiRe
 	return _c
 }
 
+// MultiStat provides a mock function with given fields: ctx, filePaths
+func (_m *ChunkManager) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	ret := _m.Called(ctx, filePaths)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []int64); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, filePaths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChunkManager_MultiStat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiStat'
+type ChunkManager_MultiStat_Call struct {
+	*mock.Call
+}
+
+// MultiStat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePaths []string
+func (_e *ChunkManager_Expecter) MultiStat(ctx interface{}, filePaths interface{}) *ChunkManager_MultiStat_Call {
+	return &ChunkManager_MultiStat_Call{Call: _e.mock.On("MultiStat", ctx, filePaths)}
+}
+
+func (_c *ChunkManager_MultiStat_Call) Run(run func(ctx context.Context, filePaths []string)) *ChunkManager_MultiStat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_MultiStat_Call) Return(_a0 []int64, _a1 error) *ChunkManager_MultiStat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // MultiWrite provides a mock function with given fields: ctx, contents
 func (_m *ChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
 	ret := _m.Called(ctx, contents)
@@ -344,6 +523,52 @@ func (_c *ChunkManager_Path_Call) Return(_a0 string, _a1 error) *ChunkManager_Pa
 	return _c
 }
 
+// PresignedURL provides a mock function with given fields: ctx, filePath, expiry
+func (_m *ChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	ret := _m.Called(ctx, filePath, expiry)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = rf(ctx, filePath, expiry)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, filePath, expiry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChunkManager_PresignedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresignedURL'
+type ChunkManager_PresignedURL_Call struct {
+	*mock.Call
+}
+
+// PresignedURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePath string
+//   - expiry time.Duration
+func (_e *ChunkManager_Expecter) PresignedURL(ctx interface{}, filePath interface{}, expiry interface{}) *ChunkManager_PresignedURL_Call {
+	return &ChunkManager_PresignedURL_Call{Call: _e.mock.On("PresignedURL", ctx, filePath, expiry)}
+}
+
+func (_c *ChunkManager_PresignedURL_Call) Run(run func(ctx context.Context, filePath string, expiry time.Duration)) *ChunkManager_PresignedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_PresignedURL_Call) Return(_a0 string, _a1 error) *ChunkManager_PresignedURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Read provides a mock function with given fields: ctx, filePath
 func (_m *ChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
 	ret := _m.Called(ctx, filePath)
@@ -619,6 +844,44 @@ func (_c *ChunkManager_RemoveWithPrefix_Call) Return(_a0 error) *ChunkManager_Re
 	return _c
 }
 
+// Restore provides a mock function with given fields: ctx, filePaths
+func (_m *ChunkManager) Restore(ctx context.Context, filePaths []string) error {
+	ret := _m.Called(ctx, filePaths)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, filePaths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChunkManager_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ChunkManager_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePaths []string
+func (_e *ChunkManager_Expecter) Restore(ctx interface{}, filePaths interface{}) *ChunkManager_Restore_Call {
+	return &ChunkManager_Restore_Call{Call: _e.mock.On("Restore", ctx, filePaths)}
+}
+
+func (_c *ChunkManager_Restore_Call) Run(run func(ctx context.Context, filePaths []string)) *ChunkManager_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ChunkManager_Restore_Call) Return(_a0 error) *ChunkManager_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // RootPath provides a mock function with given fields:
 func (_m *ChunkManager) RootPath() string {
 	ret := _m.Called()
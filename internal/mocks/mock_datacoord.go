@@ -36,6 +36,61 @@ func (_m *MockDataCoord) EXPECT() *MockDataCoord_Expecter {
 	return &MockDataCoord_Expecter{mock: &_m.Mock}
 }
 
+// ArchiveIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ArchiveIndexRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ArchiveIndexRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ArchiveIndexRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ArchiveIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveIndex'
+type MockDataCoord_ArchiveIndex_Call struct {
+	*mock.Call
+}
+
+// ArchiveIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.ArchiveIndexRequest
+func (_e *MockDataCoord_Expecter) ArchiveIndex(ctx interface{}, req interface{}) *MockDataCoord_ArchiveIndex_Call {
+	return &MockDataCoord_ArchiveIndex_Call{Call: _e.mock.On("ArchiveIndex", ctx, req)}
+}
+
+func (_c *MockDataCoord_ArchiveIndex_Call) Run(run func(ctx context.Context, req *indexpb.ArchiveIndexRequest)) *MockDataCoord_ArchiveIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.ArchiveIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ArchiveIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ArchiveIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ArchiveIndex_Call) RunAndReturn(run func(context.Context, *indexpb.ArchiveIndexRequest) (*commonpb.Status, error)) *MockDataCoord_ArchiveIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignSegmentID provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -915,6 +970,61 @@ func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetIndexArchiveState provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *indexpb.GetIndexArchiveStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexArchiveStateRequest) *indexpb.GetIndexArchiveStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexArchiveStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexArchiveStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexArchiveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexArchiveState'
+type MockDataCoord_GetIndexArchiveState_Call struct {
+	*mock.Call
+}
+
+// GetIndexArchiveState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.GetIndexArchiveStateRequest
+func (_e *MockDataCoord_Expecter) GetIndexArchiveState(ctx interface{}, req interface{}) *MockDataCoord_GetIndexArchiveState_Call {
+	return &MockDataCoord_GetIndexArchiveState_Call{Call: _e.mock.On("GetIndexArchiveState", ctx, req)}
+}
+
+func (_c *MockDataCoord_GetIndexArchiveState_Call) Run(run func(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest)) *MockDataCoord_GetIndexArchiveState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexArchiveStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexArchiveState_Call) Return(_a0 *indexpb.GetIndexArchiveStateResponse, _a1 error) *MockDataCoord_GetIndexArchiveState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexArchiveState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error)) *MockDataCoord_GetIndexArchiveState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIndexBuildProgress provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -2039,6 +2149,61 @@ func (_c *MockDataCoord_Register_Call) RunAndReturn(run func() error) *MockDataC
 	return _c
 }
 
+// RestoreIndex provides a mock function with given fields: ctx, req
+func (_m *MockDataCoord) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RestoreIndexRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RestoreIndexRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.RestoreIndexRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RestoreIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreIndex'
+type MockDataCoord_RestoreIndex_Call struct {
+	*mock.Call
+}
+
+// RestoreIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *indexpb.RestoreIndexRequest
+func (_e *MockDataCoord_Expecter) RestoreIndex(ctx interface{}, req interface{}) *MockDataCoord_RestoreIndex_Call {
+	return &MockDataCoord_RestoreIndex_Call{Call: _e.mock.On("RestoreIndex", ctx, req)}
+}
+
+func (_c *MockDataCoord_RestoreIndex_Call) Run(run func(ctx context.Context, req *indexpb.RestoreIndexRequest)) *MockDataCoord_RestoreIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.RestoreIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_RestoreIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RestoreIndex_Call) RunAndReturn(run func(context.Context, *indexpb.RestoreIndexRequest) (*commonpb.Status, error)) *MockDataCoord_RestoreIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: ctx, req
 func (_m *MockDataCoord) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
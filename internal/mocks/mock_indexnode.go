@@ -181,6 +181,61 @@ func (_c *MockIndexNode_GetAddress_Call) RunAndReturn(run func() string) *MockIn
 	return _c
 }
 
+// GetCapabilities provides a mock function with given fields: _a0, _a1
+func (_m *MockIndexNode) GetCapabilities(_a0 context.Context, _a1 *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetCapabilitiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetCapabilitiesRequest) *indexpb.GetCapabilitiesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetCapabilitiesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetCapabilitiesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIndexNode_GetCapabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCapabilities'
+type MockIndexNode_GetCapabilities_Call struct {
+	*mock.Call
+}
+
+// GetCapabilities is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetCapabilitiesRequest
+func (_e *MockIndexNode_Expecter) GetCapabilities(_a0 interface{}, _a1 interface{}) *MockIndexNode_GetCapabilities_Call {
+	return &MockIndexNode_GetCapabilities_Call{Call: _e.mock.On("GetCapabilities", _a0, _a1)}
+}
+
+func (_c *MockIndexNode_GetCapabilities_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetCapabilitiesRequest)) *MockIndexNode_GetCapabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetCapabilitiesRequest))
+	})
+	return _c
+}
+
+func (_c *MockIndexNode_GetCapabilities_Call) Return(_a0 *indexpb.GetCapabilitiesResponse, _a1 error) *MockIndexNode_GetCapabilities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIndexNode_GetCapabilities_Call) RunAndReturn(run func(context.Context, *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)) *MockIndexNode_GetCapabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *MockIndexNode) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -739,6 +794,61 @@ func (_c *MockIndexNode_Stop_Call) RunAndReturn(run func() error) *MockIndexNode
 	return _c
 }
 
+// UpdateLogConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockIndexNode) UpdateLogConfig(_a0 context.Context, _a1 *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.UpdateLogConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.UpdateLogConfigRequest) *indexpb.UpdateLogConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.UpdateLogConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.UpdateLogConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIndexNode_UpdateLogConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogConfig'
+type MockIndexNode_UpdateLogConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateLogConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.UpdateLogConfigRequest
+func (_e *MockIndexNode_Expecter) UpdateLogConfig(_a0 interface{}, _a1 interface{}) *MockIndexNode_UpdateLogConfig_Call {
+	return &MockIndexNode_UpdateLogConfig_Call{Call: _e.mock.On("UpdateLogConfig", _a0, _a1)}
+}
+
+func (_c *MockIndexNode_UpdateLogConfig_Call) Run(run func(_a0 context.Context, _a1 *indexpb.UpdateLogConfigRequest)) *MockIndexNode_UpdateLogConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.UpdateLogConfigRequest))
+	})
+	return _c
+}
+
+func (_c *MockIndexNode_UpdateLogConfig_Call) Return(_a0 *indexpb.UpdateLogConfigResponse, _a1 error) *MockIndexNode_UpdateLogConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIndexNode_UpdateLogConfig_Call) RunAndReturn(run func(context.Context, *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error)) *MockIndexNode_UpdateLogConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockIndexNode) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -20,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/server"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/crashreport"
 )
 
 type client struct {
@@ -136,19 +137,45 @@ func (c *client) consume(consumer *consumer) {
 			if !ok {
 				return
 			}
-			c.deliver(consumer)
+			c.deliverSafely(consumer)
 		case _, ok := <-consumer.MsgMutex():
 			if !ok {
 				// consumer MsgMutex closed, goroutine exit
 				log.Debug("Consumer MsgMutex closed")
 				return
 			}
-			c.deliver(consumer)
+			c.deliverSafely(consumer)
 		}
 	}
 }
 
-func (c *client) deliver(consumer *consumer) {
+// deliverSafely delivers the messages to the consumer, a panic is recovered into a crash report,
+// and the subscription is sought back to the first message not delivered, so the consume loop
+// keeps dispatching from it on the next notification.
+func (c *client) deliverSafely(consumer *consumer) {
+	// the id of the first message consumed but not delivered yet, 0 if the position of the subscription is right
+	var next UniqueID
+	defer crashreport.Recover("pebblemq-consumer", map[string]string{
+		"topic": consumer.topic,
+		"group": consumer.consumerName,
+	}, func(error) {
+		c.seekBack(consumer, next)
+	})
+	c.deliver(consumer, &next)
+}
+
+// seekBack seeks the subscription of the consumer back to next after a panic of the delivery.
+func (c *client) seekBack(consumer *consumer, next UniqueID) {
+	if next == 0 {
+		return
+	}
+	if err := c.server.Seek(consumer.topic, consumer.consumerName, next); err != nil {
+		log.Warn("failed to seek back after the panic of delivery", zap.String("topic", consumer.topic),
+			zap.String("group", consumer.consumerName), zap.Int64("msgID", next), zap.Error(err))
+	}
+}
+
+func (c *client) deliver(consumer *consumer, next *UniqueID) {
 	for {
 		n := cap(consumer.messageCh) - len(consumer.messageCh)
 		if n == 0 {
@@ -165,16 +192,19 @@ func (c *client) deliver(consumer *consumer) {
 			break
 		}
 		for _, msg := range msgs {
+			*next = msg.MsgID
 			select {
 			case consumer.messageCh <- Message{
 				MsgID:      msg.MsgID,
 				Payload:    msg.Payload,
 				Properties: msg.Properties,
 				Topic:      consumer.Topic()}:
+				consumer.dispatched()
 			case <-c.closeCh:
 				return
 			}
 		}
+		*next = 0
 	}
 }
 
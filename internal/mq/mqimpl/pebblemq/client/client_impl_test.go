@@ -272,3 +272,64 @@ func TestClient_consume(t *testing.T) {
 	assert.Equal(t, ok, true)
 	assert.Equal(t, id, msgConsume.MsgID)
 }
+
+func TestClient_consumeRecoverPanic(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.CrashReportPath.Key, t.TempDir())
+	defer params.Reset(params.CommonCfg.CrashReportPath.Key)
+
+	mockMQ := server.NewMockPebbleMQ(t)
+	client, err := NewClient(Options{
+		Server: mockMQ,
+	})
+	assert.NoError(t, err)
+	defer client.Close()
+	testTopic := newTopicName()
+	testGroupName := newConsumerName()
+
+	mockMQ.EXPECT().ExistConsumerGroup(testTopic, testGroupName).Return(false, nil, nil)
+	mockMQ.EXPECT().CreateConsumerGroup(testTopic, testGroupName).Return(nil)
+	mockMQ.EXPECT().RegisterConsumer(mock.Anything).Return(nil)
+	// the first delivery panics
+	mockMQ.EXPECT().Consume(testTopic, testGroupName, mock.Anything).Run(func(topicName string, groupName string, n int) {
+		panic("test panic")
+	}).Return(nil, nil).Once()
+	mockMQ.EXPECT().Consume(testTopic, testGroupName, mock.Anything).Return([]server.ConsumerMessage{{MsgID: 1, Payload: []byte("msg")}}, nil).Once()
+	mockMQ.EXPECT().Consume(testTopic, testGroupName, mock.Anything).Return(nil, nil).Maybe()
+
+	cons, err := client.Subscribe(ConsumerOptions{
+		Topic:                       testTopic,
+		SubscriptionName:            testGroupName,
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+	})
+	assert.NoError(t, err)
+
+	// the consume loop is still alive after the panic
+	cons.(*consumer).msgMutex <- struct{}{}
+	select {
+	case msg := <-cons.Chan():
+		assert.EqualValues(t, 1, msg.MsgID)
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "message not delivered after the panic")
+	}
+
+	entries, err := os.ReadDir(params.CommonCfg.CrashReportPath.GetValue())
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestClient_seekBack(t *testing.T) {
+	mockMQ := server.NewMockPebbleMQ(t)
+	c, err := NewClient(Options{
+		Server: mockMQ,
+	})
+	assert.NoError(t, err)
+	defer c.Close()
+	cons := &consumer{topic: newTopicName(), consumerName: newConsumerName()}
+
+	// the position is right if no message is pending
+	c.(*client).seekBack(cons, 0)
+
+	mockMQ.EXPECT().Seek(cons.topic, cons.consumerName, UniqueID(5)).Return(nil).Once()
+	c.(*client).seekBack(cons, 5)
+}
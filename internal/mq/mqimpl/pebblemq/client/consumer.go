@@ -12,6 +12,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
@@ -50,6 +52,18 @@ type Message struct {
 	Properties map[string]string
 }
 
+// ConsumerStats is the statistics of a consumer
+type ConsumerStats struct {
+	Topic        string
+	Subscription string
+	// Received is the number of messages dispatched into the message channel
+	Received int64
+	// Pending is the number of messages buffered in the message channel
+	Pending int
+	// LastDispatchTime is the time when a message was dispatched last time, zero if never
+	LastDispatchTime time.Time
+}
+
 // Consumer interface provide operations for a consumer
 type Consumer interface {
 	// returns the subscription for the consumer
@@ -67,6 +81,9 @@ type Consumer interface {
 	// Seek to the uniqueID position
 	Seek(UniqueID) error //nolint:govet
 
+	// SeekByTime to the first message published not before the given time
+	SeekByTime(t time.Time) error
+
 	// Close consumer
 	Close()
 
@@ -75,4 +92,7 @@ type Consumer interface {
 
 	// check created topic whether vaild or not
 	CheckTopicValid(topic string) error
+
+	// Stats returns the statistics of the consumer
+	Stats() ConsumerStats
 }
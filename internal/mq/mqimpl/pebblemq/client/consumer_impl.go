@@ -13,6 +13,8 @@ package client
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -30,6 +32,10 @@ type consumer struct {
 	msgMutex  chan struct{}
 	initCh    chan struct{}
 	messageCh chan Message
+
+	// statistics updated by the consume goroutine
+	received     int64
+	lastDispatch int64
 }
 
 func newConsumer(c *client, options ConsumerOptions) (*consumer, error) {
@@ -126,6 +132,16 @@ func (c *consumer) Seek(id UniqueID) error { //nolint:govet
 	return nil
 }
 
+// SeekByTime seeks to the first message published not before the given time
+func (c *consumer) SeekByTime(t time.Time) error {
+	err := c.client.server.SeekByTime(c.topic, c.consumerName, t)
+	if err != nil {
+		return err
+	}
+	c.client.server.Notify(c.topic, c.consumerName)
+	return nil
+}
+
 // Close destroy current consumer in pebblemq
 func (c *consumer) Close() {
 	// TODO should panic?
@@ -147,3 +163,23 @@ func (c *consumer) CheckTopicValid(topic string) error {
 	err := c.client.server.CheckTopicValid(topic)
 	return err
 }
+
+// Stats returns the statistics of the consumer
+func (c *consumer) Stats() ConsumerStats {
+	stats := ConsumerStats{
+		Topic:        c.topic,
+		Subscription: c.consumerName,
+		Received:     atomic.LoadInt64(&c.received),
+		Pending:      len(c.messageCh),
+	}
+	if lastDispatch := atomic.LoadInt64(&c.lastDispatch); lastDispatch > 0 {
+		stats.LastDispatchTime = time.Unix(0, lastDispatch)
+	}
+	return stats
+}
+
+// dispatched records that a message has been pushed into the message channel
+func (c *consumer) dispatched() {
+	atomic.AddInt64(&c.received, 1)
+	atomic.StoreInt64(&c.lastDispatch, time.Now().UnixNano())
+}
@@ -14,6 +14,8 @@ package client
 // ProducerOptions is the options of a producer
 type ProducerOptions struct {
 	Topic string
+	// Epoch is the fencing epoch of the producer, 0 disables fencing
+	Epoch int64
 }
 
 // ProducerMessage is the message of a producer
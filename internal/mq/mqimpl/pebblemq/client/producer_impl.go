@@ -26,6 +26,7 @@ type producer struct {
 	// client which the producer belong to
 	c     *client
 	topic string
+	epoch int64
 }
 
 // newProducer creates a rocksmq producer from options
@@ -41,6 +42,7 @@ func newProducer(c *client, options ProducerOptions) (*producer, error) {
 	return &producer{
 		c:     c,
 		topic: options.Topic,
+		epoch: options.Epoch,
 	}, nil
 }
 
@@ -55,6 +57,7 @@ func (p *producer) Send(message *ProducerMessage) (UniqueID, error) {
 		{
 			Payload:    message.Payload,
 			Properties: message.Properties,
+			Epoch:      p.epoch,
 		},
 	})
 	if err != nil {
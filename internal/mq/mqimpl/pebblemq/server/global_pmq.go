@@ -33,10 +33,26 @@ var Pmq *pebblemq
 // once is used to init global pebblemq
 var once sync.Once
 
+// pmqMu protects Pmq from being read while it's initialized or replaced
+var pmqMu sync.RWMutex
+
+// setPmq sets the global pebblemq instance
+func setPmq(pmq *pebblemq) {
+	pmqMu.Lock()
+	defer pmqMu.Unlock()
+	Pmq = pmq
+}
+
 // InitPmq is deprecate implementation of global pebblemq. will be removed later
 func InitPmq(name string, idAllocator allocator.Interface) error {
 	var err error
-	Pmq, err = NewPebbleMQ(name, idAllocator)
+	once.Do(func() {
+		var pmq *pebblemq
+		pmq, err = NewPebbleMQ(name, idAllocator)
+		if err == nil {
+			setPmq(pmq)
+		}
+	})
 	return err
 }
 
@@ -59,7 +75,11 @@ func InitPebbleMQ(path string) error {
 				return
 			}
 		}
-		Pmq, finalErr = NewPebbleMQ(path, nil)
+		var pmq *pebblemq
+		pmq, finalErr = NewPebbleMQ(path, nil)
+		if finalErr == nil {
+			setPmq(pmq)
+		}
 	})
 	return finalErr
 }
@@ -67,7 +87,29 @@ func InitPebbleMQ(path string) error {
 // ClosePebbleMQ is used to close global pebblemq
 func ClosePebbleMQ() {
 	log.Debug("Close PebbleMQ!")
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
 	if Pmq != nil && Pmq.store != nil {
 		Pmq.Close()
 	}
 }
+
+// GetPmq returns the global pebblemq instance, nil if it's not initialized
+func GetPmq() PebbleMQ {
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
+	if Pmq == nil {
+		return nil
+	}
+	return Pmq
+}
+
+// ScrubPebbleMQ scrubs the global pebblemq, the inconsistencies found are repaired if repair is true
+func ScrubPebbleMQ(repair bool) (*ScrubReport, error) {
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
+	if Pmq == nil {
+		return nil, ErrNotServing
+	}
+	return Pmq.Scrub(repair)
+}
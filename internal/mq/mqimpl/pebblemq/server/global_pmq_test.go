@@ -45,6 +45,7 @@ func Test_InitPmq(t *testing.T) {
 
 	defer os.RemoveAll(name + kvSuffix)
 	defer os.RemoveAll(name)
+	once = sync.Once{}
 	err = InitPmq(name, idAllocator)
 	defer Pmq.stopRetention()
 	assert.NoError(t, err)
@@ -54,6 +55,7 @@ func Test_InitPmq(t *testing.T) {
 func Test_InitPebbleMQ(t *testing.T) {
 	mqPath := "/tmp/milvus_pdb/data_global"
 	defer os.RemoveAll(mqPath)
+	once = sync.Once{}
 	err := InitPebbleMQ(mqPath)
 	defer Pmq.stopRetention()
 	assert.NoError(t, err)
@@ -89,3 +91,20 @@ func Test_InitPebbleMQError(t *testing.T) {
 	fmt.Println(err)
 	assert.Error(t, err)
 }
+
+func Test_InitPebbleMQOnce(t *testing.T) {
+	once = sync.Once{}
+	mqPath := "/tmp/milvus_pdb/data_once"
+	defer os.RemoveAll(mqPath)
+	err := InitPebbleMQ(mqPath)
+	assert.NoError(t, err)
+	defer ClosePebbleMQ()
+	pmq := GetPmq()
+	assert.NotNil(t, pmq)
+
+	// the global pebblemq is initialized only once
+	assert.NoError(t, InitPebbleMQ(mqPath+"_other"))
+	assert.NoError(t, InitPmq(mqPath+"_other", nil))
+	defer os.RemoveAll(mqPath + "_other")
+	assert.Same(t, pmq, GetPmq())
+}
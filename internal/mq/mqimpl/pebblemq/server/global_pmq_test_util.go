@@ -0,0 +1,41 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// RestartPebbleMQ closes the global pebblemq and reopens it on path, the pmq clients following
+// the global instance reconnect to the new one. Only for test, the global pebblemq is never
+// restarted in a running Milvus.
+func RestartPebbleMQ(path string) error {
+	pmqMu.Lock()
+	defer pmqMu.Unlock()
+	log.Warn("Use method RestartPebbleMQ that only for test", zap.String("path", path))
+	if Pmq != nil && Pmq.store != nil {
+		Pmq.Close()
+	}
+	pmq, err := NewPebbleMQ(path, nil)
+	if err != nil {
+		return err
+	}
+	Pmq = pmq
+	return nil
+}
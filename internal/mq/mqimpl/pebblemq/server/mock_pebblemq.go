@@ -2,7 +2,11 @@
 
 package server
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockPebbleMQ is an autogenerated mock type for the RocksMQ type
 type MockPebbleMQ struct {
@@ -529,6 +533,45 @@ func (_c *MockPebbleMQ_Seek_Call) Return(_a0 error) *MockPebbleMQ_Seek_Call {
 	return _c
 }
 
+// SeekByTime provides a mock function with given fields: topicName, groupName, t
+func (_m *MockPebbleMQ) SeekByTime(topicName string, groupName string, t time.Time) error {
+	ret := _m.Called(topicName, groupName, t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) error); ok {
+		r0 = rf(topicName, groupName, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPebbleMQ_SeekByTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeekByTime'
+type MockPebbleMQ_SeekByTime_Call struct {
+	*mock.Call
+}
+
+// SeekByTime is a helper method to define mock.On call
+//   - topicName string
+//   - groupName string
+//   - t time.Time
+func (_e *MockPebbleMQ_Expecter) SeekByTime(topicName interface{}, groupName interface{}, t interface{}) *MockPebbleMQ_SeekByTime_Call {
+	return &MockPebbleMQ_SeekByTime_Call{Call: _e.mock.On("SeekByTime", topicName, groupName, t)}
+}
+
+func (_c *MockPebbleMQ_SeekByTime_Call) Run(run func(topicName string, groupName string, t time.Time)) *MockPebbleMQ_SeekByTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPebbleMQ_SeekByTime_Call) Return(_a0 error) *MockPebbleMQ_SeekByTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // SeekToLatest provides a mock function with given fields: topicName, groupName
 func (_m *MockPebbleMQ) SeekToLatest(topicName string, groupName string) error {
 	ret := _m.Called(topicName, groupName)
@@ -11,10 +11,15 @@
 
 package server
 
+import "time"
+
 // ProducerMessage that will be written to pebbledb
 type ProducerMessage struct {
 	Payload    []byte
 	Properties map[string]string
+	// Epoch is the fencing epoch of the producer, the messages are rejected if a producer with higher epoch
+	// has produced to the topic, 0 disables fencing
+	Epoch int64
 }
 
 // Consumer is pebblemq consumer
@@ -48,6 +53,7 @@ type PebbleMQ interface {
 	Consume(topicName string, groupName string, n int) ([]ConsumerMessage, error)
 	Seek(topicName string, groupName string, msgID UniqueID) error
 	SeekToLatest(topicName, groupName string) error
+	SeekByTime(topicName, groupName string, t time.Time) error
 	ExistConsumerGroup(topicName string, groupName string) (bool, *Consumer, error)
 
 	Notify(topicName, groupName string)
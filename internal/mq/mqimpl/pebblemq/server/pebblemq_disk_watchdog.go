@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// diskState is the disk usage state of pebblemq
+type diskState = int32
+
+const (
+	// diskStateNormal stands for enough disk space
+	diskStateNormal diskState = 0
+	// diskStateRetention stands for disk space is running low, emergency retention is triggered
+	diskStateRetention diskState = 1
+	// diskStateReject stands for disk is nearly full, produce requests are rejected
+	diskStateReject diskState = 2
+)
+
+// getPathDiskUsage is used to get the total and free bytes of the disk, replaceable for test
+var getPathDiskUsage = hardware.GetPathDiskUsage
+
+// diskUsage is the disk usage collected by watchdog
+type diskUsage struct {
+	usedSize  uint64
+	freeRatio float64
+}
+
+// diskWatchdog monitors the pebblemq data size and free disk space periodically,
+// triggers emergency retention and rejects producers before the disk fills.
+type diskWatchdog struct {
+	path  string
+	dbs   []*pebble.DB
+	ri    *retentionInfo
+	state diskState
+	// usage is the last collected diskUsage
+	usage atomic.Value
+
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newDiskWatchdog(path string, ri *retentionInfo, dbs ...*pebble.DB) *diskWatchdog {
+	return &diskWatchdog{
+		path:    path,
+		dbs:     dbs,
+		ri:      ri,
+		state:   diskStateNormal,
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (w *diskWatchdog) start() {
+	w.closeWg.Add(1)
+	go w.watch()
+}
+
+func (w *diskWatchdog) watch() {
+	defer w.closeWg.Done()
+	log.Debug("Pebblemq disk watchdog start!", zap.String("path", w.path))
+	ticker := time.NewTicker(paramtable.Get().PebblemqCfg.DiskWatchdogInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.closeCh:
+			log.Info("Pebblemq disk watchdog finish!")
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// usedSize returns the disk space used by all pebble instances of pebblemq
+func (w *diskWatchdog) usedSize() uint64 {
+	var size uint64
+	for _, db := range w.dbs {
+		size += db.Metrics().DiskSpaceUsage()
+	}
+	return size
+}
+
+// check steps:
+// 1. Collect the pebblemq data size and the free disk ratio
+// 2. Evaluate the disk state by thresholds from paramtable
+// 3. Do emergency retention if disk space is running low
+func (w *diskWatchdog) check() {
+	params := paramtable.Get()
+	usedSize := w.usedSize()
+	total, free, err := getPathDiskUsage(w.path)
+	if err != nil {
+		log.Warn("Pebblemq disk watchdog failed to get disk usage", zap.String("path", w.path), zap.Error(err))
+		return
+	}
+	freeRatio := 1.0
+	if total > 0 {
+		freeRatio = float64(free) / float64(total)
+	}
+	w.usage.Store(diskUsage{usedSize: usedSize, freeRatio: freeRatio})
+	metrics.PebblemqDiskUsedSize.Set(float64(usedSize))
+	metrics.PebblemqDiskFreeRatio.Set(freeRatio)
+
+	maxSize := params.PebblemqCfg.DiskMaxSizeInMB.GetAsInt64()
+	sizeExceeded := maxSize >= 0 && usedSize > uint64(maxSize)*MB
+	oldState := atomic.LoadInt32(&w.state)
+
+	newState := diskStateNormal
+	switch {
+	case freeRatio < params.PebblemqCfg.DiskRejectFreeRatio.GetAsFloat():
+		newState = diskStateReject
+	case sizeExceeded && oldState != diskStateNormal:
+		// size still exceeds the limit after emergency retention, stop accepting new messages
+		newState = diskStateReject
+	case sizeExceeded || freeRatio < params.PebblemqCfg.DiskRetentionFreeRatio.GetAsFloat():
+		newState = diskStateRetention
+	}
+	atomic.StoreInt32(&w.state, newState)
+
+	if newState != oldState {
+		log.Warn("Pebblemq disk state changed", zap.String("path", w.path),
+			zap.Int32("oldState", oldState), zap.Int32("newState", newState),
+			zap.Uint64("usedSize", usedSize), zap.Int64("maxSizeInMB", maxSize),
+			zap.Float64("freeRatio", freeRatio))
+		switch newState {
+		case diskStateReject:
+			metrics.PebblemqDiskEventCounter.WithLabelValues(metrics.DiskRejectLabel).Inc()
+		case diskStateNormal:
+			metrics.PebblemqDiskEventCounter.WithLabelValues(metrics.DiskRecoverLabel).Inc()
+		}
+	}
+
+	if newState != diskStateNormal {
+		metrics.PebblemqDiskEventCounter.WithLabelValues(metrics.DiskRetentionLabel).Inc()
+		w.ri.emergencyRetention()
+	}
+}
+
+// checkProduce returns an error if the disk is nearly full
+func (w *diskWatchdog) checkProduce() error {
+	if atomic.LoadInt32(&w.state) != diskStateReject {
+		return nil
+	}
+	usage, _ := w.usage.Load().(diskUsage)
+	return merr.WrapErrServiceDiskLimitExceeded(float32(1-usage.freeRatio),
+		float32(1-paramtable.Get().PebblemqCfg.DiskRejectFreeRatio.GetAsFloat()),
+		fmt.Sprintf("pebblemq disk is nearly full, used size %d, reject produce", usage.usedSize))
+}
+
+// Stop close channel and stop disk watchdog
+func (w *diskWatchdog) Stop() {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+		w.closeWg.Wait()
+	})
+}
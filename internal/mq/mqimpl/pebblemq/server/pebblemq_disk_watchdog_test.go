@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var watchdogPath = "/tmp/pmq_disk_watchdog/"
+
+func mockPathDiskUsage(total, free uint64, err error) func() {
+	origin := getPathDiskUsage
+	getPathDiskUsage = func(path string) (uint64, uint64, error) {
+		return total, free, err
+	}
+	return func() {
+		getPathDiskUsage = origin
+	}
+}
+
+func TestPebblemqDiskWatchdog(t *testing.T) {
+	err := os.MkdirAll(watchdogPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(watchdogPath)
+	defer os.RemoveAll(watchdogPath + kvSuffix)
+
+	params := paramtable.Get()
+	paramtable.Init()
+	params.Save(params.PebblemqCfg.PageSize.Key, "10")
+	defer params.Reset(params.PebblemqCfg.PageSize.Key)
+	params.Save(params.PebblemqCfg.RetentionSizeInMB.Key, "-1")
+	defer params.Reset(params.PebblemqCfg.RetentionSizeInMB.Key)
+	params.Save(params.PebblemqCfg.RetentionTimeInMinutes.Key, "-1")
+	defer params.Reset(params.PebblemqCfg.RetentionTimeInMinutes.Key)
+
+	pmq, err := NewPebbleMQ(watchdogPath, nil)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	topicName := "topic_watchdog"
+	groupName := "group_watchdog"
+	err = pmq.CreateTopic(topicName)
+	assert.NoError(t, err)
+	defer pmq.DestroyTopic(topicName)
+	err = pmq.CreateConsumerGroup(topicName, groupName)
+	assert.NoError(t, err)
+	err = pmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName, MsgMutex: make(chan struct{}, 1)})
+	assert.NoError(t, err)
+
+	msgNum := 100
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := pmq.Produce(topicName, pMsgs)
+	assert.NoError(t, err)
+	assert.Equal(t, msgNum, len(ids))
+	cMsgs, err := pmq.Consume(topicName, groupName, msgNum/2)
+	assert.NoError(t, err)
+	assert.Equal(t, msgNum/2, len(cMsgs))
+
+	t.Run("disk usage error", func(t *testing.T) {
+		reset := mockPathDiskUsage(0, 0, errors.New("mock error"))
+		defer reset()
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateNormal, pmq.diskWatchdog.state)
+		assert.NoError(t, pmq.diskWatchdog.checkProduce())
+	})
+
+	t.Run("enough disk space", func(t *testing.T) {
+		reset := mockPathDiskUsage(100, 50, nil)
+		defer reset()
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateNormal, pmq.diskWatchdog.state)
+		health := pmq.Health()
+		assert.False(t, health.Throttled)
+		assert.InDelta(t, 0.5/(1-params.PebblemqCfg.DiskRejectFreeRatio.GetAsFloat()), health.DiskWatermark, 1e-9)
+		assert.Equal(t, int64(0), health.InflightProduces)
+
+		// acked pages are kept when disk space is enough
+		keys, _, err := pmq.kv.LoadWithPrefix(constructKey(AckedTsTitle, topicName))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, keys)
+	})
+
+	t.Run("emergency retention", func(t *testing.T) {
+		reset := mockPathDiskUsage(100, 8, nil)
+		defer reset()
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateRetention, pmq.diskWatchdog.state)
+		assert.NoError(t, pmq.diskWatchdog.checkProduce())
+
+		// all acked pages are cleaned, the not consumed messages are kept
+		keys, _, err := pmq.kv.LoadWithPrefix(constructKey(AckedTsTitle, topicName))
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+		err = pmq.ForceSeek(topicName, groupName, cMsgs[0].MsgID)
+		assert.NoError(t, err)
+		res, err := pmq.Consume(topicName, groupName, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Greater(t, res[0].MsgID, cMsgs[0].MsgID)
+	})
+
+	t.Run("reject produce", func(t *testing.T) {
+		reset := mockPathDiskUsage(100, 1, nil)
+		defer reset()
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateReject, pmq.diskWatchdog.state)
+		_, err := pmq.Produce(topicName, pMsgs)
+		assert.True(t, errors.Is(err, merr.ErrServiceDiskLimitExceeded))
+		assert.True(t, pmq.Health().Throttled)
+	})
+
+	t.Run("recover", func(t *testing.T) {
+		reset := mockPathDiskUsage(100, 50, nil)
+		defer reset()
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateNormal, pmq.diskWatchdog.state)
+		_, err = pmq.Produce(topicName, pMsgs[:1])
+		assert.NoError(t, err)
+	})
+
+	t.Run("size exceeded", func(t *testing.T) {
+		reset := mockPathDiskUsage(100, 50, nil)
+		defer reset()
+		params.Save(params.PebblemqCfg.DiskMaxSizeInMB.Key, "0")
+		defer params.Reset(params.PebblemqCfg.DiskMaxSizeInMB.Key)
+
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateRetention, pmq.diskWatchdog.state)
+		_, err = pmq.Produce(topicName, pMsgs[:1])
+		assert.NoError(t, err)
+
+		// still exceeded after emergency retention
+		pmq.diskWatchdog.check()
+		assert.Equal(t, diskStateReject, pmq.diskWatchdog.state)
+		_, err = pmq.Produce(topicName, pMsgs[:1])
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+)
+
+// dispatchScheduler schedules the consuming of topics by priority, the high priority topics,
+// e.g. timetick and ddl channels, never wait, while the other topics share limited dispatch slots,
+// so that ingest bursts on bulk insert topics don't stall the timeticks
+type dispatchScheduler struct {
+	priorityPrefixes []string
+	// slots limits the concurrent consuming of normal topics, nil means no limit
+	slots chan struct{}
+}
+
+func newDispatchScheduler(priorityPrefixes []string, maxConcurrency int) *dispatchScheduler {
+	s := &dispatchScheduler{}
+	for _, prefix := range priorityPrefixes {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			s.priorityPrefixes = append(s.priorityPrefixes, prefix)
+		}
+	}
+	if maxConcurrency > 0 {
+		s.slots = make(chan struct{}, maxConcurrency)
+	}
+	return s
+}
+
+// isHighPriority returns whether topicName matches any of the priority prefixes
+func (s *dispatchScheduler) isHighPriority(topicName string) bool {
+	for _, prefix := range s.priorityPrefixes {
+		if strings.HasPrefix(topicName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// acquire waits for a dispatch slot to consume topicName, the returned function releases the slot
+func (s *dispatchScheduler) acquire(topicName string) func() {
+	if s == nil || s.slots == nil || s.isHighPriority(topicName) {
+		return func() {}
+	}
+	s.slots <- struct{}{}
+	return func() {
+		<-s.slots
+	}
+}
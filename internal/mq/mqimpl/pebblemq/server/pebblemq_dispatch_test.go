@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchScheduler_IsHighPriority(t *testing.T) {
+	s := newDispatchScheduler([]string{"by-dev-rootcoord-timetick", " ", " by-dev-ddl"}, 1)
+	assert.True(t, s.isHighPriority("by-dev-rootcoord-timetick"))
+	assert.True(t, s.isHighPriority("by-dev-ddl_0"))
+	assert.False(t, s.isHighPriority("by-dev-rootcoord-dml_0"))
+	assert.False(t, s.isHighPriority(""))
+}
+
+func TestDispatchScheduler_Acquire(t *testing.T) {
+	s := newDispatchScheduler([]string{"timetick"}, 1)
+
+	release := s.acquire("dml_0")
+
+	// high priority topics never wait for a slot
+	done := make(chan struct{})
+	go func() {
+		s.acquire("timetick")()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("high priority topic blocked")
+	}
+
+	// the other topics wait until the slot is released
+	acquired := make(chan struct{})
+	go func() {
+		s.acquire("dml_1")()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("normal topic acquired without a free slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("normal topic blocked after release")
+	}
+
+	// no limit
+	s = newDispatchScheduler(nil, 0)
+	s.acquire("dml_0")
+	s.acquire("dml_0")()
+}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// HealthStat is the health of pebblemq, reported to the coordinators by the standalone MQ server.
+type HealthStat struct {
+	// DiskWatermark is the used ratio of the disk relative to the ratio rejecting the produces
+	DiskWatermark float64
+	// WritePressure is the highest L0 pressure of the pebble instances, the writes stall once it reaches 1
+	WritePressure    float64
+	InflightProduces int64
+	// Throttled is set if the produces are rejected or delayed
+	Throttled bool
+}
+
+// Health returns the current health of pebblemq.
+func (pmq *pebblemq) Health() HealthStat {
+	stat := HealthStat{
+		InflightProduces: atomic.LoadInt64(&pmq.inflightProduces),
+	}
+	if pmq.diskWatchdog != nil {
+		usage, collected := pmq.diskWatchdog.usage.Load().(diskUsage)
+		if rejectUsedRatio := 1 - paramtable.Get().PebblemqCfg.DiskRejectFreeRatio.GetAsFloat(); collected && rejectUsedRatio > 0 {
+			stat.DiskWatermark = (1 - usage.freeRatio) / rejectUsedRatio
+		}
+		stat.Throttled = atomic.LoadInt32(&pmq.diskWatchdog.state) == diskStateReject
+	}
+	slowdownRatio := paramtable.Get().PebblemqCfg.WriteStallSlowdownRatio.GetAsFloat()
+	for _, stall := range pmq.stalls {
+		if stall == nil {
+			continue
+		}
+		pressure := stall.Pressure()
+		if pressure > stat.WritePressure {
+			stat.WritePressure = pressure
+		}
+		if stalled, _ := stall.Stalled(); stalled || pressure >= slowdownRatio {
+			stat.Throttled = true
+		}
+	}
+	return stat
+}
+
+// GetPmqHealth returns the health of the global pebblemq, false if it's not initialized.
+func GetPmqHealth() (HealthStat, bool) {
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
+	if Pmq == nil {
+		return HealthStat{}, false
+	}
+	return Pmq.Health(), true
+}
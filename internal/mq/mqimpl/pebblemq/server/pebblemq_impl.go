@@ -12,9 +12,10 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -23,16 +24,23 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/pebble"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
 	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
-	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/slowlog"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -65,9 +73,33 @@ const (
 	// acked_ts/topicName/pageId, record the latest ack ts of each page, will be purged on retention or destroy of the topic
 	AckedTsTitle = "acked_ts/"
 
+	// producer_epoch/topicName record the highest fencing epoch of the producers of the topic,
+	// messages from the producers with lower epoch are rejected, it's cleaned up on destroy topic
+	ProducerEpochTitle = "producer_epoch/"
+
+	// topic_config/topicName record the configs overriding the global configs of the topic, it's cleaned up on destroy topic
+	TopicConfigTitle = "topic_config/"
+
 	mqNotServingErrMsg = "MQ is not serving"
+
+	// localIDAllocatorKey is the high-water mark of the message ids
+	localIDAllocatorKey = "pmq_local_id"
+	// legacyIDAllocatorKey is the time window of the TSO allocating the message ids before the local id allocator
+	legacyIDAllocatorKey = "pmq_id"
 )
 
+// ErrNotServing is returned by the operations on a stopped pebblemq, it's a retriable merr.ErrServiceNotReady
+// as pebblemq may be restarted
+var ErrNotServing = merr.WrapErrServiceNotReady("stopped", mqNotServingErrMsg)
+
+// ErrReadOnly is returned by the mutations on a pebblemq opened in read-only mode, it's a non-retriable
+// merr.ErrServiceInternal as the mutations never succeed until pebblemq is restarted writable by the operator,
+// a retriable merr.ErrServiceUnavailable would make the producers retry forever
+var ErrReadOnly = merr.WrapErrServiceInternal("pebblemq is opened in read-only mode")
+
+// ErrWriteStall is returned by Produce while the writes of the store or meta kv are stalled
+var ErrWriteStall = pebblekv.ErrWriteStall
+
 const (
 	// mqStateStopped state stands for just created or stopped `Pebblemq` instance
 	mqStateStopped mqState = 0
@@ -75,19 +107,40 @@ const (
 	mqStateHealthy mqState = 1
 )
 
+// legacyIDFloor returns the id above the message ids allocated by the TSO of the previous versions,
+// which composed the ids by the time window saved, 0 if the ids have been allocated by the local id allocator
+// or pebblemq never allocated ids by the TSO.
+func legacyIDFloor(metaKV kv.BaseKV) (UniqueID, error) {
+	if mark, err := metaKV.Load(localIDAllocatorKey); err != nil || mark != "" {
+		return 0, err
+	}
+	value, err := metaKV.Load(legacyIDAllocatorKey)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	last, err := typeutil.ParseTimestamp([]byte(value))
+	if err != nil {
+		return 0, err
+	}
+	if now := time.Now(); now.After(last) {
+		last = now
+	}
+	return UniqueID(tsoutil.ComposeTSByTime(last, 0)), nil
+}
+
 /**
  * Construct current id
  */
 func constructCurrentID(topicName, groupName string) string {
-	return groupName + "/" + topicName
+	return groupName + "/" + pebblekv.EscapeKeySegment(topicName)
 }
 
 /**
  * Combine metaname together with topic
  */
 func constructKey(metaName, topic string) string {
-	// Check metaName/topic
-	return metaName + topic
+	// Escape topic so that it's always a single segment of the key
+	return metaName + pebblekv.EscapeKeySegment(topic)
 }
 
 func parsePageID(key string) (int64, error) {
@@ -114,8 +167,27 @@ type pebblemq struct {
 	consumersID sync.Map
 
 	retentionInfo *retentionInfo
+	diskWatchdog  *diskWatchdog
+	dispatcher    *dispatchScheduler
 	readers       sync.Map
 	state         mqState
+	// readOnly pebblemq serves reads only, topics, messages and acked infos are never mutated
+	readOnly bool
+	// removals tracks the background removal of destroyed topics, waited before closing the store
+	removals sync.WaitGroup
+	// stalls monitor the write stalls of the store and meta kv, produce is throttled on them
+	stalls []*pebblekv.WriteStallMonitor
+	// scrubStop stops the periodic scrub, nil if it's not started
+	scrubStop chan struct{}
+	scrubWg   sync.WaitGroup
+	// inflightProduces is the number of the produce requests being served, accessed atomically
+	inflightProduces int64
+	// topicConfigs overrides the configs of the topics
+	topicConfigs *topicConfigStore
+	// topicSizes keeps the running sizes of the topics with quota
+	topicSizes *topicSizeCache
+	// produceLimiter limits the produce bandwidth per node, tenant and topic
+	produceLimiter *ratelimitutil.HierarchicalLimiter
 }
 
 // NewPebbleMQ step:
@@ -123,23 +195,46 @@ type pebblemq struct {
 // 2. Init retention info, load retention info to memory
 // 3. Start retention goroutine
 func NewPebbleMQ(name string, idAllocator allocator.Interface) (*pebblemq, error) {
+	params := paramtable.Get()
+	if err := params.PebblemqCfg.Validate(); err != nil {
+		return nil, err
+	}
+	optsKV, optsStore, cache, err := newPebbleOptions(params)
+	if err != nil {
+		return nil, err
+	}
+	// the cache is held by the dbs once opened
+	defer cache.Unref()
+	readOnly := params.PebblemqCfg.ReadOnly.GetAsBool()
+	optsKV.ReadOnly = readOnly
+	optsStore.ReadOnly = readOnly
 
 	// finish pebble KV
 	kvName := name + kvSuffix
-	kv, err := pebblekv.NewPebbleKV(kvName)
+	kv, err := pebblekv.NewPebbleKVWithOpts(kvName, optsKV)
 	if err != nil {
 		return nil, err
 	}
+	kv.SlowOpThreshold = params.PebblemqCfg.KVSlowOpThreshold.GetAsDuration(time.Millisecond)
+	// the meta read on every produce
+	kv.EnableReadCache(params.PebblemqCfg.KVReadCacheSize.GetAsInt(), TopicIDTitle, MessageSizeTitle, ProducerEpochTitle)
 
-	db, err := pebble.Open(name, kv.Opts)
+	storeStall := pebblekv.NewWriteStallMonitor(name, optsStore)
+	db, err := pebble.Open(name, optsStore)
 	if err != nil {
 		return nil, err
 	}
+	storeStall.Bind(db)
 
 	var mqIDAllocator allocator.Interface
 	// if user didn't specify id allocator, init one with kv
-	if idAllocator == nil {
-		allocator := allocator.NewGlobalIDAllocator("pmq_id", kv)
+	// no id is allocated in read-only mode
+	if idAllocator == nil && !readOnly {
+		floor, err := legacyIDFloor(kv)
+		if err != nil {
+			return nil, err
+		}
+		allocator := allocator.NewLocalIDAllocator(localIDAllocatorKey, kv, allocator.WithLocalIDFloor(floor))
 		err = allocator.Initialize()
 		if err != nil {
 			return nil, err
@@ -156,17 +251,51 @@ func NewPebbleMQ(name string, idAllocator allocator.Interface) (*pebblemq, error
 		storeMu:     &sync.Mutex{},
 		consumers:   sync.Map{},
 		readers:     sync.Map{},
+		readOnly:    readOnly,
+		stalls:      []*pebblekv.WriteStallMonitor{storeStall, kv.WriteStall()},
+
+		produceLimiter: newProduceLimiter(),
+		topicSizes:     newTopicSizeCache(),
+	}
+
+	// keys written with the raw topic names are migrated before any topic is loaded
+	if !readOnly {
+		if err := migrateKeyCodec(kv, db); err != nil {
+			return nil, err
+		}
 	}
 
+	topicConfigs, err := loadTopicConfigs(kv)
+	if err != nil {
+		return nil, err
+	}
+	pmq.topicConfigs = topicConfigs
+
 	ri, err := initRetentionInfo(kv, db)
 	if err != nil {
 		return nil, err
 	}
+	ri.topicConfigs = topicConfigs
+	ri.topicSizes = pmq.topicSizes
 	pmq.retentionInfo = ri
 
-	if checkRetention() {
+	if !checkRetention() && topicConfigs.hasRetention() {
+		if err := checkRetentionIntervals(); err != nil {
+			return nil, err
+		}
+	}
+	if (checkRetention() || topicConfigs.hasRetention()) && !readOnly {
 		pmq.retentionInfo.startRetentionInfo()
 	}
+	pmq.diskWatchdog = newDiskWatchdog(name, ri, db, kv.DB)
+	if !readOnly {
+		pmq.diskWatchdog.start()
+		pmq.startScrub()
+	} else {
+		log.Warn("pebblemq is opened in read-only mode", zap.String("path", name))
+	}
+	pmq.dispatcher = newDispatchScheduler(params.PebblemqCfg.PriorityTopics.GetAsStrings(),
+		params.PebblemqCfg.DispatchMaxConcurrency.GetAsInt())
 	atomic.StoreInt64(&pmq.state, mqStateHealthy)
 	// TODO add this to monitor metrics
 	go func() {
@@ -199,12 +328,14 @@ func (pmq *pebblemq) isClosed() bool {
 }
 
 // Close step:
-// 1. Stop retention
+// 1. Stop retention, disk watchdog and scrub
 // 2. Destroy all consumer groups and topics
 // 3. Close pebble instance
 func (pmq *pebblemq) Close() {
 	atomic.StoreInt64(&pmq.state, mqStateStopped)
 	pmq.stopRetention()
+	pmq.stopDiskWatchdog()
+	pmq.stopScrub()
 	pmq.consumers.Range(func(k, v interface{}) bool {
 		// TODO what happened if the server crashed? who handled the destroy consumer group? should we just handled it when pebblemq created?
 		// or we should not even make consumer info persistent?
@@ -218,6 +349,7 @@ func (pmq *pebblemq) Close() {
 	})
 	pmq.storeMu.Lock()
 	defer pmq.storeMu.Unlock()
+	pmq.removals.Wait()
 	pmq.kv.Close()
 	pmq.store.Close()
 	log.Info("Successfully close pebblemq")
@@ -244,15 +376,26 @@ func (pmq *pebblemq) Info() bool {
 			}
 		}
 
+		metaKV, ok := pmq.kv.(*pebblekv.PebbleKV)
+		if !ok {
+			log.Error("meta kv of pebblemq doesn't support walk", zap.String("topic", topic), zap.String("type", fmt.Sprintf("%T", pmq.kv)))
+			rtn = false
+			return false
+		}
 		pageTsSizeKey := constructKey(PageTsTitle, topic)
-		pages, _, err := pmq.kv.LoadWithPrefix(pageTsSizeKey)
+		// count pages by streaming, a topic may have a large number of pages
+		pageNum := 0
+		err := metaKV.WalkWithPrefix(pageTsSizeKey, 0, func(_, _ []byte) error {
+			pageNum++
+			return nil
+		})
 		if err != nil {
 			log.Error("Pebblemq get page num failed", zap.String("topic", topic))
 			rtn = false
 			return false
 		}
 
-		msgSizeKey := MessageSizeTitle + topic
+		msgSizeKey := constructKey(MessageSizeTitle, topic)
 		msgSizeVal, err := pmq.kv.Load(msgSizeKey)
 		if err != nil {
 			log.Error("Pebblemq get last page size failed", zap.String("topic", topic))
@@ -265,7 +408,7 @@ func (pmq *pebblemq) Info() bool {
 			zap.Int("consumer num", len(consumerList)),
 			zap.String("min position group names", minConsumerGroupName),
 			zap.Int64("min positions", minConsumerPosition),
-			zap.Int("page sum", len(pages)),
+			zap.Int("page sum", pageNum),
 			zap.String("last page size", msgSizeVal),
 		)
 		return true
@@ -279,21 +422,21 @@ func (pmq *pebblemq) stopRetention() {
 	}
 }
 
+func (pmq *pebblemq) stopDiskWatchdog() {
+	if pmq.diskWatchdog != nil {
+		pmq.diskWatchdog.Stop()
+	}
+}
+
 // CreateTopic writes initialized messages for topic in rocksdb
 func (pmq *pebblemq) CreateTopic(topicName string) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	start := time.Now()
 
-	// Check if topicName contains "/"
-	if strings.Contains(topicName, "/") {
-		log.Warn("pebblemq failed to create topic for topic name contains \"/\"", zap.String("topic", topicName))
-		return retry.Unrecoverable(fmt.Errorf("topic name = %s contains \"/\"", topicName))
-	}
-
 	// topicIDKey is the only identifier of a topic
-	topicIDKey := TopicIDTitle + topicName
+	topicIDKey := constructKey(TopicIDTitle, topicName)
 	val, err := pmq.kv.Load(topicIDKey)
 	if err != nil {
 		return err
@@ -302,6 +445,9 @@ func (pmq *pebblemq) CreateTopic(topicName string) error {
 		log.Warn("pebblemq topic already exists ", zap.String("topic", topicName))
 		return nil
 	}
+	if pmq.readOnly {
+		return retry.Unrecoverable(ErrReadOnly)
+	}
 
 	if _, ok := topicMu.Load(topicName); !ok {
 		topicMu.Store(topicName, new(sync.Mutex))
@@ -309,21 +455,27 @@ func (pmq *pebblemq) CreateTopic(topicName string) error {
 
 	// msgSizeKey -> msgSize
 	// topicIDKey -> topic creating time
-	kvs := make(map[string]string)
+	txn, err := pmq.txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
 
 	// Initialize topic message size to 0
-	msgSizeKey := MessageSizeTitle + topicName
-	kvs[msgSizeKey] = "0"
+	msgSizeKey := constructKey(MessageSizeTitle, topicName)
+	if err = txn.Save(msgSizeKey, "0"); err != nil {
+		return retry.Unrecoverable(err)
+	}
 
 	// Initialize topic id to its creating time, we don't really use it for now
 	nowTs := strconv.FormatInt(time.Now().Unix(), 10)
-	kvs[topicIDKey] = nowTs
-	if err = pmq.kv.MultiSave(kvs); err != nil {
+	if err = txn.Save(topicIDKey, nowTs); err != nil {
+		return retry.Unrecoverable(err)
+	}
+	if err = txn.Commit(); err != nil {
 		return retry.Unrecoverable(err)
 	}
 
-	pmq.retentionInfo.mutex.Lock()
-	defer pmq.retentionInfo.mutex.Unlock()
 	pmq.retentionInfo.topicRetetionTime.Insert(topicName, time.Now().Unix())
 	log.Debug("Pebblemq create topic successfully ", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
 	return nil
@@ -331,10 +483,13 @@ func (pmq *pebblemq) CreateTopic(topicName string) error {
 
 // DestroyTopic removes messages for topic in pebblemq
 func (pmq *pebblemq) DestroyTopic(topicName string) error {
+	if pmq.readOnly {
+		return ErrReadOnly
+	}
 	start := time.Now()
 	ll, ok := topicMu.Load(topicName)
 	if !ok {
-		return fmt.Errorf("topic name = %s not exist", topicName)
+		return merr.WrapErrMqTopicNotFound(topicName)
 	}
 	lock, ok := ll.(*sync.Mutex)
 	if !ok {
@@ -345,54 +500,129 @@ func (pmq *pebblemq) DestroyTopic(topicName string) error {
 
 	pmq.consumers.Delete(topicName)
 
-	// clean the topic data it self
-	fixTopicName := topicName + "/"
-	err := pmq.kv.RemoveWithPrefix(fixTopicName)
+	txn, err := pmq.txn()
 	if err != nil {
 		return err
 	}
+	defer txn.Discard()
 
+	// clean the topic data it self
+	fixTopicName := constructStorePrefix(topicName)
 	// clean page size info
 	pageMsgSizeKey := constructKey(PageMsgSizeTitle, topicName)
-	err = pmq.kv.RemoveWithPrefix(pageMsgSizeKey)
-	if err != nil {
-		return err
-	}
-
 	// clean page ts info
 	pageMsgTsKey := constructKey(PageTsTitle, topicName)
-	err = pmq.kv.RemoveWithPrefix(pageMsgTsKey)
-	if err != nil {
-		return err
-	}
-
 	// cleaned acked ts info
 	ackedTsKey := constructKey(AckedTsTitle, topicName)
-	err = pmq.kv.RemoveWithPrefix(ackedTsKey)
-	if err != nil {
-		return err
+	for _, prefix := range []string{fixTopicName, pageMsgSizeKey, pageMsgTsKey, ackedTsKey} {
+		if err = txn.RemoveWithPrefix(prefix); err != nil {
+			return err
+		}
 	}
 
 	// topic info
-	topicIDKey := TopicIDTitle + topicName
+	topicIDKey := constructKey(TopicIDTitle, topicName)
 	// message size of this topic
-	msgSizeKey := MessageSizeTitle + topicName
-	var removedKeys []string
-	removedKeys = append(removedKeys, topicIDKey, msgSizeKey)
-	// Batch remove, atomic operation
-	err = pmq.kv.MultiRemove(removedKeys)
-	if err != nil {
+	msgSizeKey := constructKey(MessageSizeTitle, topicName)
+	// producer epoch of this topic
+	epochKey := constructKey(ProducerEpochTitle, topicName)
+	// config of this topic
+	configKey := constructKey(TopicConfigTitle, topicName)
+	for _, key := range []string{topicIDKey, msgSizeKey, epochKey, configKey} {
+		if err = txn.Remove(key); err != nil {
+			return err
+		}
+	}
+	// all meta of the topic is removed atomically
+	if err = txn.Commit(); err != nil {
 		return err
 	}
 
+	// remove the messages and properties of the topic with range tombstones, the disk space
+	// is reclaimed by the compaction in background
+	writeOpts := pebble.WriteOptions{}
+	for _, prefix := range []string{fixTopicName, constructPropertiesPrefix(topicName)} {
+		removal := pebblekv.RemovePrefixAsync(pmq.store, prefix, &writeOpts)
+		<-removal.Deleted()
+		pmq.removals.Add(1)
+		go func(prefix string) {
+			defer pmq.removals.Done()
+			if err := removal.Wait(); err != nil {
+				log.Warn("pebblemq failed to remove topic data", zap.String("topic", topicName),
+					zap.String("prefix", prefix), zap.Error(err))
+			}
+		}(prefix)
+	}
+
 	// clean up retention info
 	topicMu.Delete(topicName)
 	pmq.retentionInfo.topicRetetionTime.GetAndRemove(topicName)
+	pmq.produceLimiter.Remove(pmq.topicConfigs.get(topicName).tenant(), topicName)
+	pmq.topicConfigs.remove(topicName)
+	pmq.topicSizes.invalidate(topicName)
+	metrics.CleanupPebblemqTopicMetrics(topicName)
 
 	log.Debug("Pebblemq destroy topic successfully ", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
 	return nil
 }
 
+// traceContextOf returns the context of the first sampled trace injected into the message properties by the
+// producers, which links the produce latency to the trace, context.Background() is returned if there is none.
+func traceContextOf(messages []ProducerMessage) context.Context {
+	propagator := otel.GetTextMapPropagator()
+	for _, msg := range messages {
+		if len(msg.Properties) == 0 {
+			continue
+		}
+		ctx := propagator.Extract(context.Background(), propagation.MapCarrier(msg.Properties))
+		if trace.SpanContextFromContext(ctx).IsSampled() {
+			return ctx
+		}
+	}
+	return context.Background()
+}
+
+// observeProduce records the latencies of the produce stages and the throughput of the topic. lockTime, allocTime,
+// commitTime and totalTime are the elapsed milliseconds from the start of the produce to the end of each stage, each
+// stage is observed by its own duration and the total by the whole produce. The trace of ctx is attached to the
+// latencies as the exemplar.
+func observeProduce(ctx context.Context, topicName string, msgCount int, payloadSize int64, lockTime, allocTime, commitTime, totalTime int64) {
+	metrics.ObserveWithTrace(ctx, metrics.PebblemqProduceLatency.WithLabelValues(topicName, metrics.ProduceLockStage), float64(lockTime))
+	metrics.ObserveWithTrace(ctx, metrics.PebblemqProduceLatency.WithLabelValues(topicName, metrics.ProduceAllocStage), float64(allocTime-lockTime))
+	metrics.ObserveWithTrace(ctx, metrics.PebblemqProduceLatency.WithLabelValues(topicName, metrics.ProduceCommitStage), float64(commitTime-allocTime))
+	metrics.ObserveWithTrace(ctx, metrics.PebblemqProduceLatency.WithLabelValues(topicName, metrics.ProduceTotalStage), float64(totalTime))
+	metrics.PebblemqProduceBytes.WithLabelValues(topicName).Add(float64(payloadSize))
+	metrics.PebblemqProduceMsgCounter.WithLabelValues(topicName).Add(float64(msgCount))
+}
+
+// fenceProducer rejects the messages whose epoch is lower than the highest epoch of the topic,
+// and records the epoch if it's higher. The topic lock must be held by caller.
+func (pmq *pebblemq) fenceProducer(topicName string, messages []ProducerMessage) error {
+	if len(messages) == 0 || messages[0].Epoch == 0 {
+		return nil
+	}
+	epoch := messages[0].Epoch
+	key := constructKey(ProducerEpochTitle, topicName)
+	val, err := pmq.kv.Load(key)
+	if err != nil {
+		return err
+	}
+	if val != "" {
+		latest, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		if epoch < latest {
+			return errors.Wrapf(mqwrapper.ErrProducerFenced, "topic %s, epoch %d, latest epoch %d", topicName, epoch, latest)
+		}
+		if epoch == latest {
+			return nil
+		}
+	}
+	log.Info("pebblemq producer epoch advanced", zap.String("topic", topicName), zap.Int64("epoch", epoch))
+	return pmq.kv.Save(key, strconv.FormatInt(epoch, 10))
+}
+
 // ExistConsumerGroup check if a consumer exists and return the existed consumer
 func (pmq *pebblemq) ExistConsumerGroup(topicName, groupName string) (bool, *Consumer, error) {
 	key := constructCurrentID(topicName, groupName)
@@ -412,7 +642,7 @@ func (pmq *pebblemq) ExistConsumerGroup(topicName, groupName string) (bool, *Con
 // CreateConsumerGroup creates an nonexistent consumer group for topic
 func (pmq *pebblemq) CreateConsumerGroup(topicName, groupName string) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	start := time.Now()
 	key := constructCurrentID(topicName, groupName)
@@ -430,7 +660,7 @@ func (pmq *pebblemq) CreateConsumerGroup(topicName, groupName string) error {
 // RegisterConsumer registers a consumer in pebblemq consumers
 func (pmq *pebblemq) RegisterConsumer(consumer *Consumer) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	start := time.Now()
 	if vals, ok := pmq.consumers.Load(consumer.Topic); ok {
@@ -453,7 +683,7 @@ func (pmq *pebblemq) RegisterConsumer(consumer *Consumer) error {
 
 func (pmq *pebblemq) GetLatestMsg(topicName string) (int64, error) {
 	if pmq.isClosed() {
-		return DefaultMessageID, errors.New(mqNotServingErrMsg)
+		return DefaultMessageID, ErrNotServing
 	}
 	msgID, err := pmq.getLatestMsg(topicName)
 	if err != nil {
@@ -466,7 +696,7 @@ func (pmq *pebblemq) GetLatestMsg(topicName string) (int64, error) {
 // DestroyConsumerGroup removes a consumer group from rocksdb_kv
 func (pmq *pebblemq) DestroyConsumerGroup(topicName, groupName string) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	return pmq.destroyConsumerGroupInternal(topicName, groupName)
 }
@@ -476,7 +706,7 @@ func (pmq *pebblemq) destroyConsumerGroupInternal(topicName, groupName string) e
 	start := time.Now()
 	ll, ok := topicMu.Load(topicName)
 	if !ok {
-		return fmt.Errorf("topic name = %s not exist", topicName)
+		return merr.WrapErrMqTopicNotFound(topicName)
 	}
 	lock, ok := ll.(*sync.Mutex)
 	if !ok {
@@ -503,15 +733,54 @@ func (pmq *pebblemq) destroyConsumerGroupInternal(topicName, groupName string) e
 	return nil
 }
 
+// throttleProduce rejects produce during a write stall of the store or meta kv,
+// and delays it as the L0 builds up so that the stall is less likely to happen
+func (pmq *pebblemq) throttleProduce() error {
+	params := paramtable.Get()
+	slowdownRatio := params.PebblemqCfg.WriteStallSlowdownRatio.GetAsFloat()
+	maxDelay := params.PebblemqCfg.WriteStallMaxDelay.GetAsDuration(time.Millisecond)
+	var delay time.Duration
+	for _, stall := range pmq.stalls {
+		if stall == nil {
+			continue
+		}
+		if err := stall.Check(); err != nil {
+			metrics.PebblemqThrottledProduceCounter.WithLabelValues(metrics.ProduceRejectedLabel).Inc()
+			return err
+		}
+		if d := stall.ThrottleDelay(slowdownRatio, maxDelay); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 {
+		metrics.PebblemqThrottledProduceCounter.WithLabelValues(metrics.ProduceDelayedLabel).Inc()
+		time.Sleep(delay)
+	}
+	return nil
+}
+
 // Produce produces messages for topic and updates page infos for retention
 func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]UniqueID, error) {
 	if pmq.isClosed() {
-		return nil, errors.New(mqNotServingErrMsg)
+		return nil, ErrNotServing
+	}
+	if pmq.readOnly {
+		return nil, retry.Unrecoverable(ErrReadOnly)
+	}
+	atomic.AddInt64(&pmq.inflightProduces, 1)
+	defer atomic.AddInt64(&pmq.inflightProduces, -1)
+	if err := pmq.diskWatchdog.checkProduce(); err != nil {
+		log.Warn("pebblemq reject produce", zap.String("topic", topicName), zap.Error(err))
+		return nil, err
+	}
+	if err := pmq.throttleProduce(); err != nil {
+		log.Warn("pebblemq reject produce", zap.String("topic", topicName), zap.Error(err))
+		return nil, err
 	}
 	start := time.Now()
 	ll, ok := topicMu.Load(topicName)
 	if !ok {
-		return []UniqueID{}, fmt.Errorf("topic name = %s not exist", topicName)
+		return []UniqueID{}, merr.WrapErrMqTopicNotFound(topicName)
 	}
 	lock, ok := ll.(*sync.Mutex)
 	if !ok {
@@ -522,6 +791,22 @@ func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]Un
 
 	getLockTime := time.Since(start).Milliseconds()
 
+	if err := pmq.fenceProducer(topicName, messages); err != nil {
+		return nil, err
+	}
+	var batchSize int64
+	for _, message := range messages {
+		batchSize += int64(len(message.Payload))
+	}
+	if err := pmq.checkTopicQuota(topicName, batchSize); err != nil {
+		log.Warn("pebblemq reject produce", zap.String("topic", topicName), zap.Error(err))
+		return nil, err
+	}
+	if err := pmq.checkProduceRate(topicName, batchSize); err != nil {
+		log.Warn("pebblemq reject produce", zap.String("topic", topicName), zap.Error(err))
+		return nil, err
+	}
+
 	msgLen := len(messages)
 	idStart, idEnd, err := pmq.idAllocator.Alloc(uint32(msgLen))
 
@@ -535,12 +820,16 @@ func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]Un
 
 	// Insert data to store system
 	writeOpts := pebble.WriteOptions{}
+	if config := pmq.topicConfigs.get(topicName); config.SyncWrite != nil {
+		writeOpts.Sync = *config.SyncWrite
+	}
 	batch := pmq.store.NewBatch()
 	msgSizes := make(map[UniqueID]int64)
 	msgIDs := make([]UniqueID, msgLen)
+	var payloadSize int64
 	for i := 0; i < msgLen && idStart+UniqueID(i) < idEnd; i++ {
 		msgID := idStart + UniqueID(i)
-		key := path.Join(topicName, strconv.FormatInt(msgID, 10))
+		key := constructStoreKey(topicName, msgID)
 		batch.Set([]byte(key), messages[i].Payload, &writeOpts)
 		properties, err := json.Marshal(messages[i].Properties)
 		if err != nil {
@@ -550,10 +839,11 @@ func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]Un
 				zap.Error(err))
 			return nil, err
 		}
-		pKey := path.Join(common.PropertiesKey, topicName, strconv.FormatInt(msgID, 10))
+		pKey := constructPropertiesKey(topicName, msgID)
 		batch.Set([]byte(pKey), properties, &writeOpts)
 		msgIDs[i] = msgID
 		msgSizes[msgID] = int64(len(messages[i].Payload))
+		payloadSize += int64(len(messages[i].Payload))
 	}
 
 	err = batch.Commit(&writeOpts)
@@ -575,11 +865,21 @@ func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]Un
 	// Update message page info
 	err = pmq.updatePageInfo(topicName, msgIDs, msgSizes)
 	if err != nil {
+		// the page info may be partially updated, reload the size on the next produce
+		pmq.topicSizes.invalidate(topicName)
 		return []UniqueID{}, err
 	}
+	pmq.topicSizes.add(topicName, payloadSize)
 
-	// TODO add this to monitor metrics
 	getProduceTime := time.Since(start).Milliseconds()
+	traceCtx := traceContextOf(messages)
+	observeProduce(traceCtx, topicName, msgLen, payloadSize, getLockTime, allocTime, writeTime, getProduceTime)
+	slowlog.Record(traceCtx, slowlog.KindProduce, topicName, time.Since(start),
+		slowlog.F("msgCount", strconv.Itoa(msgLen)),
+		slowlog.F("payloadSize", strconv.FormatInt(payloadSize, 10)),
+		slowlog.F("lockMs", strconv.FormatInt(getLockTime, 10)),
+		slowlog.F("allocMs", strconv.FormatInt(allocTime-getLockTime, 10)),
+		slowlog.F("writeMs", strconv.FormatInt(writeTime-allocTime, 10)))
 	if getProduceTime > 200 {
 		log.Warn("pebblemq produce too slowly", zap.String("topic", topicName),
 			zap.Int64("get lock elapse", getLockTime),
@@ -594,8 +894,14 @@ func (pmq *pebblemq) Produce(topicName string, messages []ProducerMessage) ([]Un
 
 func (pmq *pebblemq) updatePageInfo(topicName string, msgIDs []UniqueID, msgSizes map[UniqueID]int64) error {
 	params := paramtable.Get()
-	msgSizeKey := MessageSizeTitle + topicName
-	msgSizeVal, err := pmq.kv.Load(msgSizeKey)
+	txn, err := pmq.txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+
+	msgSizeKey := constructKey(MessageSizeTitle, topicName)
+	msgSizeVal, err := txn.Load(msgSizeKey)
 	if err != nil {
 		return err
 	}
@@ -606,7 +912,6 @@ func (pmq *pebblemq) updatePageInfo(topicName string, msgIDs []UniqueID, msgSize
 	fixedPageSizeKey := constructKey(PageMsgSizeTitle, topicName)
 	fixedPageTsKey := constructKey(PageTsTitle, topicName)
 	nowTs := strconv.FormatInt(time.Now().Unix(), 10)
-	mutateBuffer := make(map[string]string)
 	for _, id := range msgIDs {
 		msgSize := msgSizes[id]
 		if curMsgSize+msgSize > params.PebblemqCfg.PageSize.GetAsInt64() {
@@ -615,17 +920,31 @@ func (pmq *pebblemq) updatePageInfo(topicName string, msgIDs []UniqueID, msgSize
 			pageEndID := id
 			// Update page message size for current page. key is page end ID
 			pageMsgSizeKey := fixedPageSizeKey + "/" + strconv.FormatInt(pageEndID, 10)
-			mutateBuffer[pageMsgSizeKey] = strconv.FormatInt(newPageSize, 10)
+			if err = txn.Save(pageMsgSizeKey, strconv.FormatInt(newPageSize, 10)); err != nil {
+				return err
+			}
 			pageTsKey := fixedPageTsKey + "/" + strconv.FormatInt(pageEndID, 10)
-			mutateBuffer[pageTsKey] = nowTs
+			if err = txn.Save(pageTsKey, nowTs); err != nil {
+				return err
+			}
 			curMsgSize = 0
 		} else {
 			curMsgSize += msgSize
 		}
 	}
-	mutateBuffer[msgSizeKey] = strconv.FormatInt(curMsgSize, 10)
-	err = pmq.kv.MultiSave(mutateBuffer)
-	return err
+	if err = txn.Save(msgSizeKey, strconv.FormatInt(curMsgSize, 10)); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+// txn starts a transaction on the meta kv of pebblemq
+func (pmq *pebblemq) txn() (*pebblekv.PebbleTxn, error) {
+	metaKV, ok := pmq.kv.(*pebblekv.PebbleKV)
+	if !ok {
+		return nil, fmt.Errorf("meta kv of pebblemq doesn't support transactions: %T", pmq.kv)
+	}
+	return metaKV.Txn()
 }
 
 func (pmq *pebblemq) getCurrentID(topicName, groupName string) (int64, bool) {
@@ -650,17 +969,19 @@ func (pmq *pebblemq) getLastID(topicName string) (int64, bool) {
 // 3. Update ack informations in pebble
 func (pmq *pebblemq) Consume(topicName string, groupName string, n int) ([]ConsumerMessage, error) {
 	if pmq.isClosed() {
-		return nil, errors.New(mqNotServingErrMsg)
+		return nil, ErrNotServing
 	}
 	start := time.Now()
 	ll, ok := topicMu.Load(topicName)
 	if !ok {
-		return nil, fmt.Errorf("topic name = %s not exist", topicName)
+		return nil, merr.WrapErrMqTopicNotFound(topicName)
 	}
 	lock, ok := ll.(*sync.Mutex)
 	if !ok {
 		return nil, fmt.Errorf("get mutex failed, topic name = %s", topicName)
 	}
+	release := pmq.dispatcher.acquire(topicName)
+	defer release()
 	lock.Lock()
 	defer lock.Unlock()
 
@@ -674,7 +995,7 @@ func (pmq *pebblemq) Consume(topicName string, groupName string, n int) ([]Consu
 		return []ConsumerMessage{}, nil
 	}
 	getLockTime := time.Since(start).Milliseconds()
-	prefix := topicName + "/"
+	prefix := constructStorePrefix(topicName)
 	readOpts := pebble.IterOptions{
 		UpperBound: []byte(typeutil.AddOne(prefix)),
 	}
@@ -685,7 +1006,7 @@ func (pmq *pebblemq) Consume(topicName string, groupName string, n int) ([]Consu
 	if currentID == DefaultMessageID {
 		dataKey = prefix
 	} else {
-		dataKey = path.Join(topicName, strconv.FormatInt(currentID, 10))
+		dataKey = constructStoreKey(topicName, currentID)
 	}
 	iter.Seek([]byte(dataKey))
 	consumerMessage := make([]ConsumerMessage, 0, n)
@@ -695,11 +1016,11 @@ func (pmq *pebblemq) Consume(topicName string, groupName string, n int) ([]Consu
 		val := iter.Value()
 		strKey := string(key)
 		offset++
-		msgID, err := strconv.ParseInt(strKey[len(topicName)+1:], 10, 64)
+		msgID, err := strconv.ParseInt(strKey[len(prefix):], 10, 64)
 		if err != nil {
 			return nil, err
 		}
-		askedProperties := path.Join(common.PropertiesKey, topicName, strconv.FormatInt(msgID, 10))
+		askedProperties := constructPropertiesKey(topicName, msgID)
 		propertiesValue, closer, err := pmq.store.Get([]byte(askedProperties))
 		// pebble will return a ErrNotFound error if the key not exist, let's ignore it here
 		if err != nil && !errors.Is(err, pebble.ErrNotFound) {
@@ -773,7 +1094,7 @@ func (pmq *pebblemq) seek(topicName string, groupName string, msgID UniqueID) er
 		return fmt.Errorf("ConsumerGroup %s, channel %s not exists", groupName, topicName)
 	}
 
-	storeKey := path.Join(topicName, strconv.FormatInt(msgID, 10))
+	storeKey := constructStoreKey(topicName, msgID)
 	val, closer, err := pmq.store.Get([]byte(storeKey))
 	// pebble will return a ErrNotFound error if the key not exist, let's ignore it for consistency with rocksdb API
 	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
@@ -806,8 +1127,11 @@ func (pmq *pebblemq) moveConsumePos(topicName string, groupName string, msgID Un
 		panic("move consume position backward")
 	}
 
-	//update ack if position move forward
-	err := pmq.updateAckedInfo(topicName, groupName, oldPos, msgID-1)
+	//update ack if position move forward, acked info is kept as is in read-only mode
+	var err error
+	if !pmq.readOnly {
+		err = pmq.updateAckedInfo(topicName, groupName, oldPos, msgID-1)
+	}
 	if err != nil {
 		log.Warn("failed to update acked info ", zap.String("topic", topicName),
 			zap.String("groupName", groupName), zap.Error(err))
@@ -818,10 +1142,24 @@ func (pmq *pebblemq) moveConsumePos(topicName string, groupName string, msgID Un
 	return nil
 }
 
+// resetConsumePos sets the consume position of the group, which may move backward unlike moveConsumePos,
+// the acked info is only updated if it moves forward
+func (pmq *pebblemq) resetConsumePos(topicName string, groupName string, msgID UniqueID) error {
+	oldPos, ok := pmq.getCurrentID(topicName, groupName)
+	if !ok {
+		return errors.New("move unknown consumer")
+	}
+	if msgID >= oldPos {
+		return pmq.moveConsumePos(topicName, groupName, msgID)
+	}
+	pmq.consumersID.Store(constructCurrentID(topicName, groupName), msgID)
+	return nil
+}
+
 // Seek updates the current id to the given msgID
 func (pmq *pebblemq) Seek(topicName string, groupName string, msgID UniqueID) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	/* Step I: Check if key exists */
 	ll, ok := topicMu.Load(topicName)
@@ -847,7 +1185,7 @@ func (pmq *pebblemq) Seek(topicName string, groupName string, msgID UniqueID) er
 func (pmq *pebblemq) ForceSeek(topicName string, groupName string, msgID UniqueID) error {
 	log.Warn("Use method ForceSeek that only for test")
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	/* Step I: Check if key exists */
 	ll, ok := topicMu.Load(topicName)
@@ -879,7 +1217,7 @@ func (pmq *pebblemq) ForceSeek(topicName string, groupName string, msgID UniqueI
 // SeekToLatest updates current id to the msg id of latest message + 1
 func (pmq *pebblemq) SeekToLatest(topicName, groupName string) error {
 	if pmq.isClosed() {
-		return errors.New(mqNotServingErrMsg)
+		return ErrNotServing
 	}
 	pmq.storeMu.Lock()
 	defer pmq.storeMu.Unlock()
@@ -906,12 +1244,114 @@ func (pmq *pebblemq) SeekToLatest(topicName, groupName string) error {
 	return nil
 }
 
+// SeekByTime updates current id to the first message of the earliest page closed not before the given time,
+// the page ts is recorded in seconds when the page is closed, so the position may be earlier than the time
+func (pmq *pebblemq) SeekByTime(topicName, groupName string, t time.Time) error {
+	if pmq.isClosed() {
+		return ErrNotServing
+	}
+	ll, ok := topicMu.Load(topicName)
+	if !ok {
+		return merr.WrapErrMqTopicNotFound(topicName)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	pmq.storeMu.Lock()
+	defer pmq.storeMu.Unlock()
+
+	key := constructCurrentID(topicName, groupName)
+	_, ok = pmq.consumersID.Load(key)
+	if !ok {
+		return fmt.Errorf("ConsumerGroup %s, channel %s not exists", groupName, topicName)
+	}
+
+	msgID, err := pmq.getMsgIDByTime(topicName, t.Unix())
+	if err != nil {
+		return err
+	}
+	// seeking back to an earlier time is allowed, unlike consuming
+	err = pmq.resetConsumePos(topicName, groupName, msgID)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("successfully seek by time", zap.String("topic", topicName),
+		zap.String("group", groupName), zap.Time("time", t), zap.Int64("msgID", msgID))
+	return nil
+}
+
+// getMsgIDByTime returns the first message id of the earliest page closed not before ts,
+// the earliest retained message id is returned if all retained pages are closed not before ts
+func (pmq *pebblemq) getMsgIDByTime(topicName string, ts int64) (UniqueID, error) {
+	keys, values, err := pmq.kv.LoadWithPrefix(constructKey(PageTsTitle, topicName) + "/")
+	if err != nil {
+		return DefaultMessageID, err
+	}
+	type pageTs struct {
+		endID UniqueID
+		ts    int64
+	}
+	pages := make([]pageTs, 0, len(keys))
+	for i, key := range keys {
+		endID, err := strconv.ParseInt(key[strings.LastIndex(key, "/")+1:], 10, 64)
+		if err != nil {
+			return DefaultMessageID, err
+		}
+		pageTime, err := strconv.ParseInt(values[i], 10, 64)
+		if err != nil {
+			return DefaultMessageID, err
+		}
+		pages = append(pages, pageTs{endID: endID, ts: pageTime})
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].endID < pages[j].endID
+	})
+
+	startID := DefaultMessageID
+	for _, page := range pages {
+		if page.ts >= ts {
+			break
+		}
+		startID = page.endID + 1
+	}
+
+	// message ids are not continuous, find the first message after the closed pages
+	prefix := constructStorePrefix(topicName)
+	readOpts := pebble.IterOptions{
+		UpperBound: []byte(typeutil.AddOne(prefix)),
+	}
+	iter := pebblekv.NewPebbleIteratorWithUpperBound(pmq.store, &readOpts)
+	defer iter.Close()
+	if startID == DefaultMessageID {
+		// no page is closed before ts, start from the earliest retained message
+		iter.Seek([]byte(prefix))
+	} else {
+		iter.Seek([]byte(constructStoreKey(topicName, startID)))
+	}
+	if err := iter.Err(); err != nil {
+		return DefaultMessageID, err
+	}
+	if !iter.Valid() {
+		// all messages are published before ts, seek to latest
+		latestID, err := pmq.getLatestMsg(topicName)
+		if err != nil {
+			return DefaultMessageID, err
+		}
+		return latestID + 1, nil
+	}
+	return strconv.ParseInt(string(iter.Key())[len(prefix):], 10, 64)
+}
+
 func (pmq *pebblemq) getLatestMsg(topicName string) (int64, error) {
 	readOpts := pebble.IterOptions{}
 	iter := pebblekv.NewPebbleIterator(pmq.store, &readOpts)
 	defer iter.Close()
 
-	prefix := topicName + "/"
+	prefix := constructStorePrefix(topicName)
 	// seek to the last message of the topic
 	iter.SeekForPrev([]byte(typeutil.AddOne(prefix)))
 
@@ -933,7 +1373,7 @@ func (pmq *pebblemq) getLatestMsg(topicName string) (int64, error) {
 		return DefaultMessageID, nil
 	}
 
-	msgID, err := strconv.ParseInt(seekMsgID[len(topicName)+1:], 10, 64)
+	msgID, err := strconv.ParseInt(seekMsgID[len(prefix):], 10, 64)
 	if err != nil {
 		return DefaultMessageID, err
 	}
@@ -1017,7 +1457,7 @@ func (pmq *pebblemq) updateAckedInfo(topicName, groupName string, firstID Unique
 		for _, pID := range pageIDs {
 			if pID <= minBeginID {
 				// Update acked info for message pID
-				pageAckedTsKey := path.Join(fixedAckedTsKey, strconv.FormatInt(pID, 10))
+				pageAckedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pID, 10)
 				ackedTsKvs[pageAckedTsKey] = nowTs
 			}
 		}
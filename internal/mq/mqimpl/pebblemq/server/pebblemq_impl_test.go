@@ -12,9 +12,11 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,7 +26,14 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/pebble"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
@@ -32,9 +41,13 @@ import (
 	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 var pmqPath = "/tmp/pebblemq"
@@ -53,12 +66,12 @@ type producerMessageBefore struct {
 	Payload []byte
 }
 
-func InitIDAllocator(kvPath string) *allocator.GlobalIDAllocator {
+func InitIDAllocator(kvPath string) *allocator.LocalIDAllocator {
 	pebbleKV, err := pebblekv.NewPebbleKV(kvPath)
 	if err != nil {
 		panic(err)
 	}
-	idAllocator := allocator.NewGlobalIDAllocator("pmq_id", pebbleKV)
+	idAllocator := allocator.NewLocalIDAllocator(localIDAllocatorKey, pebbleKV)
 	_ = idAllocator.Initialize()
 	return idAllocator
 }
@@ -308,6 +321,169 @@ func TestPebblemq_Basic(t *testing.T) {
 	assert.Equal(t, cMsgs[0].Properties, expect)
 }
 
+func TestPebblemq_ReadOnly(t *testing.T) {
+	suffix := "_readonly"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+
+	channelName := "channel_readonly"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("a_message")}})
+	assert.NoError(t, err)
+	pmq.Close()
+
+	params := paramtable.Get()
+	params.Save(params.PebblemqCfg.ReadOnly.Key, "true")
+	defer params.Reset(params.PebblemqCfg.ReadOnly.Key)
+	pmq, err = NewPebbleMQ(pebblePath, nil)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	// existing topic is served
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	assert.ErrorIs(t, pmq.CreateTopic("channel_new"), ErrReadOnly)
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("b_message")}})
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.ErrorIs(t, pmq.DestroyTopic(channelName), ErrReadOnly)
+
+	groupName := "test_group"
+	assert.NoError(t, pmq.CreateConsumerGroup(channelName, groupName))
+	cMsgs, err := pmq.Consume(channelName, groupName, 1)
+	assert.NoError(t, err)
+	assert.Len(t, cMsgs, 1)
+	assert.Equal(t, "a_message", string(cMsgs[0].Payload))
+}
+
+func TestPebblemq_DestroyTopicRemovesMessages(t *testing.T) {
+	suffix := "_destroy_remove"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_destroy"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("a")}, {Payload: []byte("b")}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, pmq.DestroyTopic(channelName))
+	for _, prefix := range []string{constructStorePrefix(channelName), constructPropertiesPrefix(channelName)} {
+		iter := pebblekv.NewPebbleIteratorWithUpperBound(pmq.store, &pebble.IterOptions{UpperBound: []byte(typeutil.AddOne(prefix))})
+		iter.Seek([]byte(prefix))
+		assert.False(t, iter.Valid())
+		iter.Close()
+	}
+}
+
+func TestPebblemq_ProduceMetrics(t *testing.T) {
+	suffix := "_produce_metrics"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_produce_metrics"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("ab")}, {Payload: []byte("cde")}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, float64(5), testutil.ToFloat64(metrics.PebblemqProduceBytes.WithLabelValues(channelName)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.PebblemqProduceMsgCounter.WithLabelValues(channelName)))
+	latency := &dto.Metric{}
+	assert.NoError(t, metrics.PebblemqProduceLatency.WithLabelValues(channelName, metrics.ProduceTotalStage).(prometheus.Histogram).Write(latency))
+	assert.Equal(t, uint64(1), latency.GetHistogram().GetSampleCount())
+
+	// the stages are observed by their own durations
+	stageSum := func(stage string) float64 {
+		m := &dto.Metric{}
+		assert.NoError(t, metrics.PebblemqProduceLatency.WithLabelValues(channelName, stage).(prometheus.Histogram).Write(m))
+		return m.GetHistogram().GetSampleSum()
+	}
+	lockSum, allocSum, commitSum, totalSum := stageSum(metrics.ProduceLockStage), stageSum(metrics.ProduceAllocStage),
+		stageSum(metrics.ProduceCommitStage), stageSum(metrics.ProduceTotalStage)
+	observeProduce(context.Background(), channelName, 1, 1, 10, 30, 60, 100)
+	assert.Equal(t, lockSum+10, stageSum(metrics.ProduceLockStage))
+	assert.Equal(t, allocSum+20, stageSum(metrics.ProduceAllocStage))
+	assert.Equal(t, commitSum+30, stageSum(metrics.ProduceCommitStage))
+	assert.Equal(t, totalSum+100, stageSum(metrics.ProduceTotalStage))
+
+	// the metrics of the topic are removed with it
+	assert.NoError(t, pmq.DestroyTopic(channelName))
+	assert.False(t, metrics.PebblemqProduceLatency.DeleteLabelValues(channelName, metrics.ProduceCommitStage))
+	assert.False(t, metrics.PebblemqProduceBytes.DeleteLabelValues(channelName))
+}
+
+func TestPebblemq_ProducerFencing(t *testing.T) {
+	suffix := "_fencing"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_fencing"
+	err = pmq.CreateTopic(channelName)
+	assert.NoError(t, err)
+	defer pmq.DestroyTopic(channelName)
+
+	produce := func(epoch int64) error {
+		_, err := pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("msg"), Epoch: epoch}})
+		return err
+	}
+
+	assert.NoError(t, produce(2))
+	// the same epoch keeps producing
+	assert.NoError(t, produce(2))
+	// stale epoch is fenced
+	err = produce(1)
+	assert.ErrorIs(t, err, mqwrapper.ErrProducerFenced)
+	// epoch 0 is never checked
+	assert.NoError(t, produce(0))
+	// a newer epoch fences the old one
+	assert.NoError(t, produce(3))
+	err = produce(2)
+	assert.ErrorIs(t, err, mqwrapper.ErrProducerFenced)
+
+	// the epoch is cleared with the topic
+	err = pmq.DestroyTopic(channelName)
+	assert.NoError(t, err)
+	err = pmq.CreateTopic(channelName)
+	assert.NoError(t, err)
+	assert.NoError(t, produce(1))
+}
+
 func TestPebblemq_MultiConsumer(t *testing.T) {
 	suffix := "pmq_multi_consumer"
 	kvPath := pmqPath + kvPathSuffix + suffix
@@ -923,6 +1099,126 @@ func TestPebblemq_SeekToLatest(t *testing.T) {
 	}
 }
 
+func TestPebblemq_SeekByTime(t *testing.T) {
+	name := "/tmp/pebblemq_seekbytime"
+	defer os.RemoveAll(name)
+	kvName := name + "_meta_kv"
+	_ = os.RemoveAll(kvName)
+	defer os.RemoveAll(kvName)
+
+	params := paramtable.Get()
+	paramtable.Init()
+	params.Save(params.PebblemqCfg.PageSize.Key, "10")
+	defer params.Reset(params.PebblemqCfg.PageSize.Key)
+	pmq, err := NewPebbleMQ(name, nil)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_seekbytime"
+	groupName := "group_seekbytime"
+	err = pmq.SeekByTime(channelName, groupName, time.Now())
+	assert.True(t, errors.Is(err, merr.ErrMqTopicNotFound))
+
+	err = pmq.CreateTopic(channelName)
+	assert.NoError(t, err)
+	defer pmq.DestroyTopic(channelName)
+	err = pmq.SeekByTime(channelName, groupName, time.Now())
+	assert.Error(t, err)
+
+	loopNum := 10
+	pMsgs := make([]ProducerMessage, loopNum)
+	for i := 0; i < loopNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := pmq.Produce(channelName, pMsgs)
+	assert.NoError(t, err)
+
+	// rewrite page ts to make each page closed 10 seconds after the previous one
+	keys, _, err := pmq.kv.LoadWithPrefix(constructKey(PageTsTitle, channelName) + "/")
+	assert.NoError(t, err)
+	assert.Greater(t, len(keys), 2)
+	pageEndIDs := make([]UniqueID, 0, len(keys))
+	for _, key := range keys {
+		endID, err := strconv.ParseInt(key[strings.LastIndex(key, "/")+1:], 10, 64)
+		assert.NoError(t, err)
+		pageEndIDs = append(pageEndIDs, endID)
+	}
+	sort.Slice(pageEndIDs, func(i, j int) bool { return pageEndIDs[i] < pageEndIDs[j] })
+	for i, endID := range pageEndIDs {
+		key := constructKey(PageTsTitle, channelName) + "/" + strconv.FormatInt(endID, 10)
+		err = pmq.kv.Save(key, strconv.Itoa(1000+i*10))
+		assert.NoError(t, err)
+	}
+
+	seekAndConsume := func(t *testing.T, seekTime time.Time) []ConsumerMessage {
+		_ = pmq.DestroyConsumerGroup(channelName, groupName)
+		err := pmq.CreateConsumerGroup(channelName, groupName)
+		assert.NoError(t, err)
+		err = pmq.SeekByTime(channelName, groupName, seekTime)
+		assert.NoError(t, err)
+		msgs, err := pmq.Consume(channelName, groupName, 1)
+		assert.NoError(t, err)
+		return msgs
+	}
+
+	t.Run("before all pages", func(t *testing.T) {
+		msgs := seekAndConsume(t, time.Unix(900, 0))
+		assert.Equal(t, 1, len(msgs))
+		assert.Equal(t, ids[0], msgs[0].MsgID)
+	})
+
+	t.Run("middle page", func(t *testing.T) {
+		msgs := seekAndConsume(t, time.Unix(1015, 0))
+		assert.Equal(t, 1, len(msgs))
+		assert.Equal(t, pageEndIDs[1]+1, msgs[0].MsgID)
+	})
+
+	t.Run("page closed at the time", func(t *testing.T) {
+		msgs := seekAndConsume(t, time.Unix(1010, 0))
+		assert.Equal(t, 1, len(msgs))
+		assert.Equal(t, pageEndIDs[0]+1, msgs[0].MsgID)
+	})
+
+	t.Run("after all pages", func(t *testing.T) {
+		msgs := seekAndConsume(t, time.Now())
+		lastPageEnd := pageEndIDs[len(pageEndIDs)-1]
+		if lastPageEnd == ids[len(ids)-1] {
+			assert.Empty(t, msgs)
+		} else {
+			assert.Equal(t, 1, len(msgs))
+			assert.Equal(t, lastPageEnd+1, msgs[0].MsgID)
+		}
+	})
+
+	t.Run("backward", func(t *testing.T) {
+		_ = pmq.DestroyConsumerGroup(channelName, groupName)
+		err := pmq.CreateConsumerGroup(channelName, groupName)
+		assert.NoError(t, err)
+		msgs, err := pmq.Consume(channelName, groupName, loopNum)
+		assert.NoError(t, err)
+		assert.Equal(t, loopNum, len(msgs))
+
+		// seek back on the group which has consumed all the messages
+		err = pmq.SeekByTime(channelName, groupName, time.Unix(1015, 0))
+		assert.NoError(t, err)
+		msgs, err = pmq.Consume(channelName, groupName, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(msgs))
+		assert.Equal(t, pageEndIDs[1]+1, msgs[0].MsgID)
+
+		// the position is clamped to the earliest message before all pages
+		err = pmq.SeekByTime(channelName, groupName, time.Unix(900, 0))
+		assert.NoError(t, err)
+		currentID, ok := pmq.getCurrentID(channelName, groupName)
+		assert.True(t, ok)
+		assert.Equal(t, ids[0], currentID)
+		msgs, err = pmq.Consume(channelName, groupName, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(msgs))
+		assert.Equal(t, ids[0], msgs[0].MsgID)
+	})
+}
+
 func TestPebblemq_GetLatestMsg(t *testing.T) {
 	ep := etcdEndpoints()
 	etcdCli, err := etcd.GetRemoteEtcdClient(ep)
@@ -1267,3 +1563,317 @@ func TestPebblemq_Info(t *testing.T) {
 	pmq.kv = &pebblekv.PebbleKV{}
 	assert.False(t, pmq.Info())
 }
+
+func TestPebblemq_TopicNameWithSeparator(t *testing.T) {
+	suffix := "_topic_separator"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	// "a/b" must not see the messages of "a" and vice versa
+	topics := []string{"a", "a/b", "a%2Fb"}
+	for _, topic := range topics {
+		assert.NoError(t, pmq.CreateTopic(topic))
+		_, err = pmq.Produce(topic, []ProducerMessage{{Payload: []byte(topic)}})
+		assert.NoError(t, err)
+		assert.NoError(t, pmq.CreateConsumerGroup(topic, "group"))
+	}
+	for _, topic := range topics {
+		msgs, err := pmq.Consume(topic, "group", 10)
+		assert.NoError(t, err)
+		assert.Len(t, msgs, 1)
+		assert.Equal(t, topic, string(msgs[0].Payload))
+	}
+
+	assert.NoError(t, pmq.DestroyTopic("a/b"))
+	msgs, err := pmq.Consume("a", "group", 10)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 0)
+	has, err := pmq.kv.Has(constructKey(TopicIDTitle, "a%2Fb"))
+	assert.NoError(t, err)
+	assert.True(t, has)
+}
+
+func TestPebblemq_MigrateKeyCodec(t *testing.T) {
+	name := "/tmp/pebblemq_migrate_key_codec"
+	defer os.RemoveAll(name)
+	kvName := name + kvSuffix
+	defer os.RemoveAll(kvName)
+
+	// write a topic with the raw key codec
+	kv, err := pebblekv.NewPebbleKV(kvName)
+	assert.NoError(t, err)
+	store, err := pebble.Open(name, &pebble.Options{})
+	assert.NoError(t, err)
+
+	topic := "topic%1"
+	assert.NoError(t, kv.Save(TopicIDTitle+topic, "0"))
+	assert.NoError(t, kv.Save(MessageSizeTitle+topic, "3"))
+	assert.NoError(t, kv.Save(PageMsgSizeTitle+topic+"/10", "3"))
+	assert.NoError(t, store.Set([]byte(topic+"/10"), []byte("msg"), pebble.Sync))
+	assert.NoError(t, store.Set([]byte(common.PropertiesKey+"/"+topic+"/10"), []byte("{}"), pebble.Sync))
+
+	assert.NoError(t, migrateKeyCodec(kv, store))
+	version, err := kv.Load(KeyCodecVersionKey)
+	assert.NoError(t, err)
+	assert.Equal(t, keyCodecVersionEscaped, version)
+
+	has, err := kv.Has(TopicIDTitle + topic)
+	assert.NoError(t, err)
+	assert.False(t, has)
+	value, err := kv.Load(constructKey(MessageSizeTitle, topic))
+	assert.NoError(t, err)
+	assert.Equal(t, "3", value)
+	value, err = kv.Load(constructKey(PageMsgSizeTitle, topic) + "/10")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", value)
+
+	msg, closer, err := store.Get([]byte(constructStoreKey(topic, 10)))
+	assert.NoError(t, err)
+	assert.Equal(t, "msg", string(msg))
+	closer.Close()
+	_, _, err = store.Get([]byte(topic + "/10"))
+	assert.ErrorIs(t, err, pebble.ErrNotFound)
+	_, closer, err = store.Get([]byte(constructPropertiesKey(topic, 10)))
+	assert.NoError(t, err)
+	closer.Close()
+
+	// the migration runs only once
+	assert.NoError(t, kv.Save(TopicIDTitle+"other%1", "0"))
+	assert.NoError(t, migrateKeyCodec(kv, store))
+	has, err = kv.Has(TopicIDTitle + "other%1")
+	assert.NoError(t, err)
+	assert.True(t, has)
+
+	assert.NoError(t, store.Close())
+	kv.Close()
+}
+
+func TestPebblemq_ProduceWriteStall(t *testing.T) {
+	suffix := "_write_stall"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_stall"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+
+	opts := &pebble.Options{}
+	stall := pebblekv.NewWriteStallMonitor("stall", opts)
+	stalls := pmq.stalls
+	pmq.stalls = append(stalls, stall)
+	defer func() { pmq.stalls = stalls }()
+
+	opts.EventListener.WriteStallBegin(pebble.WriteStallBeginInfo{Reason: "memtable count limit reached"})
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("a")}})
+	assert.ErrorIs(t, err, ErrWriteStall)
+	assert.True(t, merr.IsRetriable(err))
+	assert.NotErrorIs(t, err, ErrNotServing)
+
+	opts.EventListener.WriteStallEnd()
+	_, err = pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("a")}})
+	assert.NoError(t, err)
+}
+
+func TestPebblemq_Scrub(t *testing.T) {
+	suffix := "_scrub"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.PebblemqCfg.PageSize.Key, "10")
+	defer params.Reset(params.PebblemqCfg.PageSize.Key)
+	// the messages are scrubbed across several batches
+	params.Save(params.PebblemqCfg.ScrubBatchSize.Key, "3")
+	defer params.Reset(params.PebblemqCfg.ScrubBatchSize.Key)
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_scrub"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	msgs := make([]ProducerMessage, 0, 10)
+	for i := 0; i < 10; i++ {
+		msgs = append(msgs, ProducerMessage{Payload: []byte("message"), Properties: map[string]string{"i": strconv.Itoa(i)}})
+	}
+	ids, err := pmq.Produce(channelName, msgs)
+	assert.NoError(t, err)
+
+	report, err := pmq.Scrub(false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Topics)
+	assert.Equal(t, int64(10), report.Messages)
+	assert.Equal(t, int64(70), report.Bytes)
+	assert.Empty(t, report.Issues)
+
+	// break the page sizes and properties
+	pageKeys, _, err := pmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, channelName) + "/")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pageKeys)
+	assert.NoError(t, pmq.kv.Save(pageKeys[0], "1000"))
+	assert.NoError(t, pmq.kv.Save(constructKey(MessageSizeTitle, channelName), "1000"))
+	assert.NoError(t, pmq.store.Set([]byte(constructPropertiesKey(channelName, ids[0])), []byte("{"), pebble.Sync))
+	assert.NoError(t, pmq.store.Set([]byte(constructPropertiesKey(channelName, ids[9]+1)), []byte("{}"), pebble.Sync))
+
+	kinds := func(report *ScrubReport) []string {
+		kinds := make([]string, 0, len(report.Issues))
+		for _, issue := range report.Issues {
+			kinds = append(kinds, issue.Kind)
+		}
+		sort.Strings(kinds)
+		return kinds
+	}
+	expected := []string{ScrubIssueBadProperties, ScrubIssueMessageSizeDrift, ScrubIssueOrphanProperties, ScrubIssuePageSizeDrift}
+
+	report, err = pmq.Scrub(false)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, kinds(report))
+	for _, issue := range report.Issues {
+		assert.False(t, issue.Repaired)
+	}
+
+	report, err = pmq.Scrub(true)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, kinds(report))
+	for _, issue := range report.Issues {
+		assert.True(t, issue.Repaired)
+	}
+
+	report, err = pmq.Scrub(false)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Issues)
+
+	assert.NoError(t, pmq.CreateConsumerGroup(channelName, "group"))
+	consumed, err := pmq.Consume(channelName, "group", 10)
+	assert.NoError(t, err)
+	assert.Len(t, consumed, 10)
+}
+
+func TestPebblemq_ScrubProduceBetweenBatches(t *testing.T) {
+	suffix := "_scrub_batches"
+
+	kvPath := pmqPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebblePath := pmqPath + suffix
+	defer os.RemoveAll(pebblePath + kvSuffix)
+	defer os.RemoveAll(pebblePath)
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.PebblemqCfg.PageSize.Key, "10")
+	defer params.Reset(params.PebblemqCfg.PageSize.Key)
+	pmq, err := NewPebbleMQ(pebblePath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+
+	channelName := "channel_scrub_batches"
+	assert.NoError(t, pmq.CreateTopic(channelName))
+	produce := func() {
+		for i := 0; i < 5; i++ {
+			_, err := pmq.Produce(channelName, []ProducerMessage{{Payload: []byte("message")}})
+			assert.NoError(t, err)
+		}
+	}
+	produce()
+
+	report := &ScrubReport{}
+	s := &topicScrub{
+		topic:      channelName,
+		repair:     true,
+		report:     report,
+		msgPrefix:  constructStorePrefix(channelName),
+		propPrefix: constructPropertiesPrefix(channelName),
+	}
+	assert.NoError(t, pmq.loadScrubPages(s))
+	done, err := pmq.scrubBatch(s, 2)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	// the produce between the batches seals more pages, which are left to the next scrub
+	produce()
+	for !done {
+		done, err = pmq.scrubBatch(s, 2)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, pmq.scrubPageSizes(s))
+	assert.Equal(t, int64(10), report.Messages)
+	assert.Empty(t, report.Issues)
+
+	report, err = pmq.Scrub(false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), report.Messages)
+	assert.Empty(t, report.Issues)
+}
+
+func TestPebblemq_TraceContextOf(t *testing.T) {
+	origin := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(origin)
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	messages := []ProducerMessage{
+		{Payload: []byte("no trace")},
+		{Payload: []byte("unsampled"), Properties: map[string]string{"traceparent": "00-" + strings.Repeat("1", 32) + "-0102030405060708-00"}},
+		{Payload: []byte("sampled"), Properties: map[string]string{"traceparent": "00-" + traceID + "-0102030405060708-01"}},
+	}
+	sc := trace.SpanContextFromContext(traceContextOf(messages))
+	assert.True(t, sc.IsSampled())
+	assert.Equal(t, traceID, sc.TraceID().String())
+
+	sc = trace.SpanContextFromContext(traceContextOf(messages[:2]))
+	assert.False(t, sc.IsValid())
+}
+
+func TestPebblemq_LegacyIDFloor(t *testing.T) {
+	kvPath := t.TempDir()
+	kv, err := pebblekv.NewPebbleKV(kvPath)
+	require.NoError(t, err)
+	defer kv.Close()
+
+	floor, err := legacyIDFloor(kv)
+	assert.NoError(t, err)
+	assert.Zero(t, floor)
+
+	// the ids allocated by the TSO are below the time window saved
+	window := time.Now().Add(time.Hour)
+	require.NoError(t, kv.Save(legacyIDAllocatorKey, string(typeutil.Uint64ToBytesBigEndian(uint64(window.UnixNano())))))
+	floor, err = legacyIDFloor(kv)
+	assert.NoError(t, err)
+	assert.EqualValues(t, tsoutil.ComposeTSByTime(window, 0), floor)
+
+	idAllocator := allocator.NewLocalIDAllocator(localIDAllocatorKey, kv, allocator.WithLocalIDFloor(floor))
+	require.NoError(t, idAllocator.Initialize())
+	id, err := idAllocator.AllocOne()
+	require.NoError(t, err)
+	assert.Equal(t, floor, id)
+
+	// the floor is ignored once the ids are allocated by the local id allocator
+	floor, err = legacyIDFloor(kv)
+	assert.NoError(t, err)
+	assert.Zero(t, floor)
+}
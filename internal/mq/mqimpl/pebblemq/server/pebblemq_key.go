@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// KeyCodecVersionKey records the version of the key codec used by the meta kv and the store
+	KeyCodecVersionKey = "key_codec_version"
+
+	// keyCodecVersionRaw embeds the topic names as is, topic names containing "/" are rejected
+	keyCodecVersionRaw = "0"
+	// keyCodecVersionEscaped embeds the topic names escaped by pebblekv.EscapeKeySegment
+	keyCodecVersionEscaped = "1"
+)
+
+/**
+ * Construct the prefix of the messages of topic in store, topicName/
+ */
+func constructStorePrefix(topicName string) string {
+	return pebblekv.EscapeKeySegment(topicName) + "/"
+}
+
+/**
+ * Construct the key of a message in store, topicName/msgID
+ */
+func constructStoreKey(topicName string, msgID UniqueID) string {
+	return constructStorePrefix(topicName) + strconv.FormatInt(msgID, 10)
+}
+
+/**
+ * Construct the prefix of the message properties of topic in store, properties/topicName/
+ */
+func constructPropertiesPrefix(topicName string) string {
+	return common.PropertiesKey + "/" + constructStorePrefix(topicName)
+}
+
+/**
+ * Construct the key of the properties of a message in store, properties/topicName/msgID
+ */
+func constructPropertiesKey(topicName string, msgID UniqueID) string {
+	return constructPropertiesPrefix(topicName) + strconv.FormatInt(msgID, 10)
+}
+
+// migrateKeyCodec rewrites the keys written with the raw topic names to the escaped ones,
+// only topic names containing the escape character are affected
+func migrateKeyCodec(kv *pebblekv.PebbleKV, store *pebble.DB) error {
+	version, err := kv.Load(KeyCodecVersionKey)
+	if err != nil {
+		return err
+	}
+	if version == keyCodecVersionEscaped {
+		return nil
+	}
+	if version != "" && version != keyCodecVersionRaw {
+		return fmt.Errorf("unknown pebblemq key codec version %s", version)
+	}
+
+	topicKeys, _, err := kv.LoadWithPrefix(TopicIDTitle)
+	if err != nil {
+		return err
+	}
+	rawTopics := typeutil.NewSet[string]()
+	for _, key := range topicKeys {
+		rawTopics.Insert(key[len(TopicIDTitle):])
+	}
+	for _, topic := range rawTopics.Collect() {
+		escaped := pebblekv.EscapeKeySegment(topic)
+		if escaped == topic {
+			continue
+		}
+		if rawTopics.Contain(escaped) {
+			return fmt.Errorf("failed to migrate topic %s, the escaped name collides with topic %s", topic, escaped)
+		}
+		// messages are moved first, the meta is kept until then so that the migration is retried after a crash
+		for _, prefix := range []string{"", common.PropertiesKey + "/"} {
+			if err := migrateStorePrefix(store, prefix+topic+"/", prefix+escaped+"/"); err != nil {
+				return err
+			}
+		}
+		if err := migrateTopicMeta(kv, topic, escaped); err != nil {
+			return err
+		}
+		log.Info("pebblemq migrated topic keys", zap.String("topic", topic), zap.String("escaped", escaped))
+	}
+	return kv.Save(KeyCodecVersionKey, keyCodecVersionEscaped)
+}
+
+func migrateTopicMeta(kv *pebblekv.PebbleKV, topic, escaped string) error {
+	txn, err := kv.Txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	for _, title := range []string{TopicIDTitle, MessageSizeTitle, ProducerEpochTitle, TopicConfigTitle} {
+		has, err := txn.Has(title + topic)
+		if err != nil {
+			return err
+		}
+		if !has {
+			continue
+		}
+		value, err := txn.Load(title + topic)
+		if err != nil {
+			return err
+		}
+		if err := txn.Save(title+escaped, value); err != nil {
+			return err
+		}
+		if err := txn.Remove(title + topic); err != nil {
+			return err
+		}
+	}
+	for _, title := range []string{PageMsgSizeTitle, PageTsTitle, AckedTsTitle} {
+		oldPrefix := title + topic + "/"
+		keys, values, err := txn.LoadWithPrefix(oldPrefix)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			if err := txn.Save(title+escaped+"/"+key[len(oldPrefix):], values[i]); err != nil {
+				return err
+			}
+		}
+		if err := txn.RemoveWithPrefix(oldPrefix); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+func migrateStorePrefix(store *pebble.DB, oldPrefix, newPrefix string) error {
+	readOpts := pebble.IterOptions{
+		UpperBound: []byte(typeutil.AddOne(oldPrefix)),
+	}
+	iter := pebblekv.NewPebbleIteratorWithUpperBound(store, &readOpts)
+	defer iter.Close()
+
+	batch := store.NewBatch()
+	defer batch.Close()
+	for iter.Seek([]byte(oldPrefix)); iter.Valid(); iter.Next() {
+		key := string(iter.Key())
+		if !strings.HasPrefix(key, oldPrefix) {
+			break
+		}
+		if err := batch.Set([]byte(newPrefix+key[len(oldPrefix):]), iter.Value(), nil); err != nil {
+			return err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if err := batch.DeleteRange([]byte(oldPrefix), []byte(typeutil.AddOne(oldPrefix)), nil); err != nil {
+		return err
+	}
+	return batch.Commit(pebble.Sync)
+}
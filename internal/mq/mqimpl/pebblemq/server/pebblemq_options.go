@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// PebbleLRUCacheMinCapacity is the min capacity of the pebble block cache
+var PebbleLRUCacheMinCapacity = int64(1 << 29)
+
+// PebbleLRUCacheMaxCapacity is the max capacity of the pebble block cache
+var PebbleLRUCacheMaxCapacity = int64(4 << 30)
+
+var pebbleCompressionTypes = map[int]pebble.Compression{
+	0: pebble.NoCompression,
+	1: pebble.SnappyCompression,
+	7: pebble.ZstdCompression,
+}
+
+func parsePebbleCompressionType(params *paramtable.ComponentParam) ([]pebble.Compression, error) {
+	var tError error
+	compressions := lo.Map(params.PebblemqCfg.CompressionTypes.GetAsStrings(), func(sType string, _ int) pebble.Compression {
+		iType, err := strconv.Atoi(sType)
+		if err != nil {
+			tError = fmt.Errorf("invalid pebblemq compression type: %s", err.Error())
+			return pebble.NoCompression
+		}
+		compression, ok := pebbleCompressionTypes[iType]
+		if !ok {
+			tError = fmt.Errorf("invalid pebblemq compression type, should in [0 1 7]")
+			return pebble.NoCompression
+		}
+		return compression
+	})
+	if tError == nil && len(compressions) == 0 {
+		tError = fmt.Errorf("pebblemq compression types should not be empty")
+	}
+	return compressions, tError
+}
+
+// pebbleCacheCapacity sizes the block cache with the memory of the machine
+func pebbleCacheCapacity(params *paramtable.ComponentParam) int64 {
+	memoryCount := hardware.GetMemoryCount()
+	if memoryCount == 0 {
+		return PebbleLRUCacheMinCapacity
+	}
+	capacity := int64(float64(memoryCount) * params.PebblemqCfg.LRUCacheRatio.GetAsFloat())
+	if capacity < PebbleLRUCacheMinCapacity {
+		return PebbleLRUCacheMinCapacity
+	}
+	if capacity > PebbleLRUCacheMaxCapacity {
+		return PebbleLRUCacheMaxCapacity
+	}
+	return capacity
+}
+
+// newPebbleOptions builds the options of the message store and the meta kv from PebblemqCfg,
+// the returned options share the same block cache, which must be released by the caller
+// once both dbs are opened.
+func newPebbleOptions(params *paramtable.ComponentParam) (*pebble.Options, *pebble.Options, *pebble.Cache, error) {
+	compressions, err := parsePebbleCompressionType(params)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !params.FeatureFlagCfg.PebblemqCompression.Enabled() {
+		for i := range compressions {
+			compressions[i] = pebble.NoCompression
+		}
+	}
+	bitsPerKey := params.PebblemqCfg.BloomFilterBitsPerKey.GetAsInt()
+	if bitsPerKey < 0 {
+		return nil, nil, nil, fmt.Errorf("invalid pebblemq bloom filter bits per key %d", bitsPerKey)
+	}
+	cacheCapacity := pebbleCacheCapacity(params)
+	cache := pebble.NewCache(cacheCapacity)
+
+	newOpts := func() *pebble.Options {
+		levels := make([]pebble.LevelOptions, len(compressions))
+		for i, compression := range compressions {
+			levels[i].Compression = compression
+			if bitsPerKey > 0 {
+				levels[i].FilterPolicy = bloom.FilterPolicy(bitsPerKey)
+			}
+		}
+		return &pebble.Options{
+			Cache:        cache,
+			MemTableSize: uint64(params.PebblemqCfg.MemTableSizeInMB.GetAsInt64()) << 20,
+			MaxOpenFiles: params.PebblemqCfg.MaxOpenFiles.GetAsInt(),
+			Levels:       levels,
+		}
+	}
+	log.Debug("pebblemq options",
+		zap.Int64("lru cache", cacheCapacity),
+		zap.Int64("memtable size in MB", params.PebblemqCfg.MemTableSizeInMB.GetAsInt64()),
+		zap.Int("max open files", params.PebblemqCfg.MaxOpenFiles.GetAsInt()),
+		zap.Int("levels", len(compressions)),
+		zap.Int("bloom filter bits per key", bitsPerKey))
+	return newOpts(), newOpts(), cache, nil
+}
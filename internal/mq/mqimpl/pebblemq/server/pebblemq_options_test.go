@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestParsePebbleCompressionType(t *testing.T) {
+	params := paramtable.Get()
+
+	compressions, err := parsePebbleCompressionType(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []pebble.Compression{pebble.NoCompression, pebble.NoCompression,
+		pebble.ZstdCompression, pebble.ZstdCompression, pebble.ZstdCompression}, compressions)
+
+	params.Save(params.PebblemqCfg.CompressionTypes.Key, "0,1")
+	defer params.Reset(params.PebblemqCfg.CompressionTypes.Key)
+	compressions, err = parsePebbleCompressionType(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []pebble.Compression{pebble.NoCompression, pebble.SnappyCompression}, compressions)
+
+	params.Save(params.PebblemqCfg.CompressionTypes.Key, "0,2")
+	_, err = parsePebbleCompressionType(params)
+	assert.Error(t, err)
+
+	params.Save(params.PebblemqCfg.CompressionTypes.Key, "a")
+	_, err = parsePebbleCompressionType(params)
+	assert.Error(t, err)
+}
+
+func TestNewPebbleOptions(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.PebblemqCfg.MemTableSizeInMB.Key, "8")
+	defer params.Reset(params.PebblemqCfg.MemTableSizeInMB.Key)
+	params.Save(params.PebblemqCfg.MaxOpenFiles.Key, "100")
+	defer params.Reset(params.PebblemqCfg.MaxOpenFiles.Key)
+
+	optsKV, optsStore, cache, err := newPebbleOptions(params)
+	assert.NoError(t, err)
+	defer cache.Unref()
+	for _, opts := range []*pebble.Options{optsKV, optsStore} {
+		assert.Equal(t, cache, opts.Cache)
+		assert.EqualValues(t, 8<<20, opts.MemTableSize)
+		assert.Equal(t, 100, opts.MaxOpenFiles)
+		assert.Len(t, opts.Levels, 5)
+		assert.NotNil(t, opts.Levels[0].FilterPolicy)
+	}
+	assert.GreaterOrEqual(t, cache.MaxSize(), PebbleLRUCacheMinCapacity)
+	assert.LessOrEqual(t, cache.MaxSize(), PebbleLRUCacheMaxCapacity)
+
+	params.Save(params.PebblemqCfg.BloomFilterBitsPerKey.Key, "0")
+	defer params.Reset(params.PebblemqCfg.BloomFilterBitsPerKey.Key)
+	optsKV, _, cache2, err := newPebbleOptions(params)
+	assert.NoError(t, err)
+	defer cache2.Unref()
+	assert.Nil(t, optsKV.Levels[0].FilterPolicy)
+
+	// the compression is turned off by the feature flag
+	params.Save(params.FeatureFlagCfg.PebblemqCompression.Key, "false")
+	defer params.Reset(params.FeatureFlagCfg.PebblemqCompression.Key)
+	optsKV, _, cache3, err := newPebbleOptions(params)
+	assert.NoError(t, err)
+	defer cache3.Unref()
+	assert.Len(t, optsKV.Levels, 5)
+	for _, level := range optsKV.Levels {
+		assert.Equal(t, pebble.NoCompression, level.Compression)
+	}
+
+	params.Save(params.PebblemqCfg.BloomFilterBitsPerKey.Key, "-1")
+	_, _, _, err = newPebbleOptions(params)
+	assert.Error(t, err)
+}
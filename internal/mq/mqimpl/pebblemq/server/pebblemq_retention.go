@@ -12,8 +12,9 @@
 package server
 
 import (
+	"context"
 	"fmt"
-	"path"
+	"runtime/pprof"
 	"strconv"
 	"sync"
 	"time"
@@ -22,9 +23,12 @@ import (
 	"go.uber.org/zap"
 
 	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
+	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/util/watchdog"
 )
 
 // Const value that used to convert unit
@@ -32,15 +36,73 @@ const (
 	MB = 1024 * 1024
 )
 
+// the pprof label of the retention goroutine, the goroutines of a stuck retention cycle are dumped by it
+const (
+	profileLabelPebblemq  = "pebblemq"
+	profileLabelRetention = "retention"
+)
+
+// cycleSmoothing is the weight of the latest cycle in the moving average of the retention cycle durations.
+const cycleSmoothing = 0.2
+
+// retentionScanPageSize is the number of the page infos loaded per scan by retention
+var retentionScanPageSize = 1000
+
+// pagedLoader loads the key-values with a prefix page by page, it's either the meta kv or a snapshot of it
+type pagedLoader interface {
+	LoadWithPrefixPaged(prefix string, token string, limit int) ([]string, []string, string, error)
+}
+
+// walkPages passes the sealed pages of the topic to fn in order, the page infos are loaded retentionScanPageSize
+// at a time so that a topic with millions of pages doesn't spike the memory. The walk stops once fn returns false.
+func walkPages(loader pagedLoader, topic string, fn func(pageID UniqueID, size int64) (bool, error)) error {
+	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic) + "/"
+	token := ""
+	for {
+		keys, values, next, err := loader.LoadWithPrefixPaged(pageMsgPrefix, token, retentionScanPageSize)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			pageID, err := parsePageID(key)
+			if err != nil {
+				return err
+			}
+			size, err := strconv.ParseInt(values[i], 10, 64)
+			if err != nil {
+				return err
+			}
+			if more, err := fn(pageID, size); err != nil || !more {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		token = next
+	}
+}
+
 // TODO, remove the pebble prefix after migration
 type retentionInfo struct {
 	// key is topic name, value is last retention time
 	topicRetetionTime *typeutil.ConcurrentMap[string, int64]
-	mutex             sync.RWMutex
 
 	kv *pebblekv.PebbleKV
 	db *pebble.DB
 
+	// cycleDuration is the moving average of the retention cycle durations, only accessed by the retention goroutine
+	cycleDuration time.Duration
+	watchdog      *watchdog.Watchdog
+	// topicConfigs overrides the retention of the topics, the global configs are used if it's nil
+	topicConfigs *topicConfigStore
+	// topicSizes is invalidated once the data of a topic is cleaned up, nil if the sizes aren't cached
+	topicSizes *topicSizeCache
+
+	// paramsChanged signals the retention goroutine to refresh the intervals of the tickers
+	paramsChanged chan struct{}
+
+	startOnce sync.Once
 	closeCh   chan struct{}
 	closeWg   sync.WaitGroup
 	closeOnce sync.Once
@@ -49,9 +111,10 @@ type retentionInfo struct {
 func initRetentionInfo(kv *pebblekv.PebbleKV, db *pebble.DB) (*retentionInfo, error) {
 	ri := &retentionInfo{
 		topicRetetionTime: typeutil.NewConcurrentMap[string, int64](),
-		mutex:             sync.RWMutex{},
 		kv:                kv,
 		db:                db,
+		watchdog:          watchdog.New("pebblemq"),
+		paramsChanged:     make(chan struct{}, 1),
 		closeCh:           make(chan struct{}),
 		closeWg:           sync.WaitGroup{},
 	}
@@ -61,7 +124,10 @@ func initRetentionInfo(kv *pebblekv.PebbleKV, db *pebble.DB) (*retentionInfo, er
 		return nil, err
 	}
 	for _, key := range topicKeys {
-		topic := key[len(TopicIDTitle):]
+		topic, err := pebblekv.UnescapeKeySegment(key[len(TopicIDTitle):])
+		if err != nil {
+			return nil, err
+		}
 		ri.topicRetetionTime.Insert(topic, time.Now().Unix())
 		topicMu.Store(topic, new(sync.Mutex))
 	}
@@ -70,10 +136,13 @@ func initRetentionInfo(kv *pebblekv.PebbleKV, db *pebble.DB) (*retentionInfo, er
 
 // Before do retention, load retention info from pebble to retention info structure in goroutines.
 // Because loadRetentionInfo may need some time, so do this asynchronously. Finally start retention goroutine.
+// It's started once, either on startup or once the retention is enabled for a topic by its config.
 func (ri *retentionInfo) startRetentionInfo() {
-	// var wg sync.WaitGroup
-	ri.closeWg.Add(1)
-	go ri.retention()
+	ri.startOnce.Do(func() {
+		ri.closeWg.Add(1)
+		go ri.retention()
+		ri.watchdog.Start()
+	})
 }
 
 // retention do time ticker and trigger retention check and operation for each topic
@@ -87,54 +156,147 @@ func (ri *retentionInfo) retention() error {
 	defer compactionTicker.Stop()
 	defer ri.closeWg.Done()
 
+	// the watcher is called with the config lock held, so it only signals the loop to read the params
+	watcher := config.NewHandler(fmt.Sprintf("pebblemqRetention-%p", ri), func(*config.Event) {
+		select {
+		case ri.paramsChanged <- struct{}{}:
+		default:
+		}
+	})
+	intervals := []*paramtable.ParamItem{&params.PebblemqCfg.TickerTimeInSeconds, &params.PebblemqCfg.CompactionInterval}
+	for _, interval := range intervals {
+		params.Watch(interval.Key, watcher)
+		defer params.Unwatch(interval.Key, watcher)
+	}
+
 	for {
 		select {
 		case <-ri.closeCh:
 			log.Warn("Pebblemq retention finish!")
 			return nil
+		case <-ri.paramsChanged:
+			resetTicker(ticker, &params.PebblemqCfg.TickerTimeInSeconds)
+			resetTicker(compactionTicker, &params.PebblemqCfg.CompactionInterval)
 		case <-compactionTicker.C:
 			log.Info("trigger pebble compaction, should trigger pebble data clean")
-			// compact pebble db, refer to https://pkg.go.dev/github.com/cockroachdb/pebble#DB.Compact
-			// The compact API is different from rocksdb, we must provide the end key instead of nil
-			readOpts := pebble.IterOptions{}
-			iter := pebblekv.NewPebbleIterator(ri.db, &readOpts)
-			defer iter.Close()
-			iter.SeekToLast()
-			if iter.Valid() {
-				go ri.db.Compact(nil, []byte(typeutil.AddOne(string(iter.Value()))), true)
-			}
+			ri.compact()
+		case t := <-ticker.C:
+			ri.retentionCycle(t.Unix())
+		}
+	}
+}
 
-			//compact pebble kv
-			iter = pebblekv.NewPebbleIterator(ri.kv.DB, &readOpts)
-			defer iter.Close()
-			iter.SeekToLast()
-			if iter.Valid() {
-				go ri.kv.DB.Compact(nil, []byte(typeutil.AddOne(string(iter.Value()))), true)
+// resetTicker resets the ticker to the interval in seconds of the param, the invalid interval is ignored.
+func resetTicker(ticker *time.Ticker, interval *paramtable.ParamItem) {
+	d := interval.GetAsDuration(time.Second)
+	if d <= 0 {
+		log.Warn("ignore the invalid interval of pebblemq", zap.String("key", interval.Key), zap.Duration("interval", d))
+		return
+	}
+	ticker.Reset(d)
+	log.Info("pebblemq interval refreshed", zap.String("key", interval.Key), zap.Duration("interval", d))
+}
+
+// retentionCycle cleans up the expired messages of the topics not cleaned in the last check time,
+// the cycle is watched by the watchdog against the moving average of the previous cycles.
+func (ri *retentionInfo) retentionCycle(timeNow int64) {
+	done := ri.watchdog.Watch("retention", ri.cycleDuration, profileLabelPebblemq, profileLabelRetention)
+	defer done()
+	start := time.Now()
+	pprof.Do(context.Background(), pprof.Labels(profileLabelPebblemq, profileLabelRetention), func(context.Context) {
+		// the topics are cleaned up by a snapshot, so that the topics are created and destroyed without waiting for the cycle
+		for topic, lastRetentionTs := range ri.topicRetetionTime.Snapshot() {
+			policy := ri.topicConfigs.retentionOf(topic)
+			if !policy.enabled() {
+				continue
 			}
-		case t := <-ticker.C:
-			timeNow := t.Unix()
-			checkTime := int64(params.PebblemqCfg.RetentionTimeInMinutes.GetAsFloat() * 60 / 10)
-			ri.mutex.RLock()
-			ri.topicRetetionTime.Range(func(topic string, lastRetentionTs int64) bool {
-				if lastRetentionTs+checkTime < timeNow {
-					err := ri.expiredCleanUp(topic)
-					if err != nil {
-						log.Warn("Retention expired clean failed", zap.Error(err))
-					}
+			checkTime := policy.seconds / 10
+			if lastRetentionTs+checkTime < timeNow {
+				err := ri.expiredCleanUp(topic)
+				if err != nil {
+					log.Warn("Retention expired clean failed", zap.Error(err))
+				}
+				// the topic destroyed meanwhile is not brought back
+				if ri.topicRetetionTime.Contain(topic) {
 					ri.topicRetetionTime.Insert(topic, timeNow)
 				}
-				return true
-			})
-			ri.mutex.RUnlock()
+			}
+		}
+	})
+	ri.cycleDuration = movingAverage(ri.cycleDuration, time.Since(start))
+}
+
+// movingAverage is the exponential moving average of the durations of the retention cycles.
+func movingAverage(old, latest time.Duration) time.Duration {
+	if old == 0 {
+		return latest
+	}
+	return time.Duration(float64(old)*(1-cycleSmoothing) + float64(latest)*cycleSmoothing)
+}
+
+// compact compacts pebble db and pebble kv asynchronously to reclaim the space of deleted data
+func (ri *retentionInfo) compact() {
+	// compact pebble db, refer to https://pkg.go.dev/github.com/cockroachdb/pebble#DB.Compact
+	// The compact API is different from rocksdb, we must provide the end key instead of nil
+	for _, db := range []*pebble.DB{ri.db, ri.kv.DB} {
+		readOpts := pebble.IterOptions{}
+		iter := pebblekv.NewPebbleIterator(db, &readOpts)
+		iter.SeekToLast()
+		if iter.Valid() {
+			go db.Compact(nil, []byte(typeutil.AddOne(string(iter.Key()))), true)
 		}
+		iter.Close()
 	}
 }
 
+// emergencyRetention cleans up all acked pages of every topic regardless of retention time and size,
+// it's triggered by disk watchdog when the disk space is running low.
+func (ri *retentionInfo) emergencyRetention() {
+	for topic := range ri.topicRetetionTime.Snapshot() {
+		err := ri.ackedCleanUp(topic)
+		if err != nil {
+			log.Warn("Emergency retention clean failed", zap.String("topic", topic), zap.Error(err))
+		}
+	}
+	ri.compact()
+}
+
+// ackedCleanUp deletes all the pages before the first not acked page of topic
+func (ri *retentionInfo) ackedCleanUp(topic string) error {
+	start := time.Now()
+	var pageEndID UniqueID
+	var pageCleaned int64
+
+	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
+	err := walkPages(ri.kv, topic, func(pageID UniqueID, _ int64) (bool, error) {
+		ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
+		ackedTsVal, err := ri.kv.Load(ackedTsKey)
+		if err != nil || ackedTsVal == "" {
+			return false, err
+		}
+		pageEndID = pageID
+		pageCleaned++
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if pageEndID == 0 {
+		return nil
+	}
+	log.Info("Emergency retention clean acked pages", zap.String("topic", topic),
+		zap.Int64("pageEndID", pageEndID), zap.Int64("pageCleaned", pageCleaned),
+		zap.Int64("time taken", time.Since(start).Milliseconds()))
+	return ri.cleanData(topic, pageEndID)
+}
+
 // Stop close channel and stop retention
 func (ri *retentionInfo) Stop() {
 	ri.closeOnce.Do(func() {
 		close(ri.closeCh)
 		ri.closeWg.Wait()
+		ri.watchdog.Stop()
 	})
 }
 
@@ -150,10 +312,19 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID
 	var lastAck int64
 	var err error
+	policy := ri.topicConfigs.retentionOf(topic)
+
+	// scan page and acked infos on a snapshot, so that the size accounting is not
+	// affected by the produces and acks happening during the scan
+	snapshot, err := ri.kv.NewSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
 
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	// calculate total acked size, simply add all page info
-	totalAckedSize, err := ri.calculateTopicAckedSize(topic)
+	totalAckedSize, err := ri.calculateTopicAckedSize(snapshot, topic)
 	if err != nil {
 		return err
 	}
@@ -163,77 +334,51 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			zap.Any("time taken", time.Since(start).Milliseconds()))
 		return nil
 	}
-	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic) + "/"
-	readOpts := pebble.IterOptions{
-		UpperBound: []byte(typeutil.AddOne(pageMsgPrefix)),
+	// the pages are expired by the acked time first, then by the acked size from the first unexpired page
+	expiredByTime := true
+	logTimeCheck := func() {
+		log.Info("Expired check by retention time", zap.String("topic", topic),
+			zap.Int64("pageEndID", pageEndID), zap.Int64("deletedAckedSize", deletedAckedSize), zap.Int64("lastAck", lastAck),
+			zap.Int64("pageCleaned", pageCleaned), zap.Int64("time taken", time.Since(start).Milliseconds()))
 	}
-	pageIter := pebblekv.NewPebbleIteratorWithUpperBound(ri.kv.DB, &readOpts)
-	defer pageIter.Close()
-	pageIter.Seek([]byte(pageMsgPrefix))
-	for ; pageIter.Valid(); pageIter.Next() {
-		pKey := pageIter.Key()
-		pageID, err := parsePageID(string(pKey))
-		if err != nil {
-			return err
-		}
-		ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
-		ackedTsVal, err := ri.kv.Load(ackedTsKey)
-		if err != nil {
-			return err
-		}
-		// not acked page, TODO add TTL info there
-		if ackedTsVal == "" {
-			break
-		}
-		ackedTs, err := strconv.ParseInt(ackedTsVal, 10, 64)
-		if err != nil {
-			return err
-		}
-		lastAck = ackedTs
-		if msgTimeExpiredCheck(ackedTs) {
-			pageEndID = pageID
-			pValue := pageIter.Value()
-			size, err := strconv.ParseInt(string(pValue), 10, 64)
+	err = walkPages(snapshot, topic, func(pageID UniqueID, size int64) (bool, error) {
+		if expiredByTime {
+			ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
+			ackedTsVal, err := snapshot.Load(ackedTsKey)
 			if err != nil {
-				return err
+				return false, err
 			}
-			deletedAckedSize += size
-			pageCleaned++
-		} else {
-			break
-		}
-	}
-	if err := pageIter.Err(); err != nil {
-		return err
-	}
-
-	log.Info("Expired check by retention time", zap.String("topic", topic),
-		zap.Int64("pageEndID", pageEndID), zap.Int64("deletedAckedSize", deletedAckedSize), zap.Int64("lastAck", lastAck),
-		zap.Int64("pageCleaned", pageCleaned), zap.Int64("time taken", time.Since(start).Milliseconds()))
-
-	for ; pageIter.Valid(); pageIter.Next() {
-		pValue := pageIter.Value()
-		size, err := strconv.ParseInt(string(pValue), 10, 64)
-		pKey := pageIter.Key()
-		pKeyStr := string(pKey)
-		if err != nil {
-			return err
-		}
-		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
-			pageEndID, err = parsePageID(pKeyStr)
-			if err != nil {
-				return err
+			// not acked page, TODO add TTL info there
+			if ackedTsVal != "" {
+				ackedTs, err := strconv.ParseInt(ackedTsVal, 10, 64)
+				if err != nil {
+					return false, err
+				}
+				lastAck = ackedTs
+				if msgTimeExpiredCheck(ackedTs, policy.seconds) {
+					pageEndID = pageID
+					deletedAckedSize += size
+					pageCleaned++
+					return true, nil
+				}
 			}
-			deletedAckedSize += size
-			pageCleaned++
-		} else {
-			break
+			expiredByTime = false
+			logTimeCheck()
 		}
-	}
-	if err := pageIter.Err(); err != nil {
+		if !msgSizeExpiredCheck(deletedAckedSize+size, totalAckedSize, policy.size) {
+			return false, nil
+		}
+		pageEndID = pageID
+		deletedAckedSize += size
+		pageCleaned++
+		return true, nil
+	})
+	if err != nil {
 		return err
 	}
+	if expiredByTime {
+		logTimeCheck()
+	}
 
 	if pageEndID == 0 {
 		log.Debug("All messages are not expired, skip retention", zap.Any("topic", topic), zap.Any("time taken", time.Since(start).Milliseconds()))
@@ -246,75 +391,62 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	return ri.cleanData(topic, pageEndID)
 }
 
-func (ri *retentionInfo) calculateTopicAckedSize(topic string) (int64, error) {
+func (ri *retentionInfo) calculateTopicAckedSize(snapshot *pebblekv.PebbleSnapshot, topic string) (int64, error) {
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 
-	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic) + "/"
-	pageReadOpts := pebble.IterOptions{
-		UpperBound: []byte(typeutil.AddOne(pageMsgPrefix)),
-	}
-	// ensure the iterator won't iterate to other topics
-	pageIter := pebblekv.NewPebbleIteratorWithUpperBound(ri.kv.DB, &pageReadOpts)
-	defer pageIter.Close()
-	pageIter.Seek([]byte(pageMsgPrefix))
 	var ackedSize int64
-	for ; pageIter.Valid(); pageIter.Next() {
-		key := pageIter.Key()
-		pageID, err := parsePageID(string(key))
-		if err != nil {
-			return -1, err
-		}
-
+	err := walkPages(snapshot, topic, func(pageID UniqueID, size int64) (bool, error) {
 		// check if page is acked
 		ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
-		ackedTsVal, err := ri.kv.Load(ackedTsKey)
+		ackedTsVal, err := snapshot.Load(ackedTsKey)
 		if err != nil {
-			return -1, err
+			return false, err
 		}
 		// not acked yet, break
 		// TODO, Add TTL logic here, mark it as acked if not
 		if ackedTsVal == "" {
-			break
-		}
-
-		// Get page size
-		val := pageIter.Value()
-		size, err := strconv.ParseInt(string(val), 10, 64)
-		if err != nil {
-			return -1, err
+			return false, nil
 		}
 		ackedSize += size
-	}
-	if err := pageIter.Err(); err != nil {
+		return true, nil
+	})
+	if err != nil {
 		return -1, err
 	}
 	return ackedSize, nil
 }
 
 func (ri *retentionInfo) cleanData(topic string, pageEndID UniqueID) error {
-	writeBatch := ri.kv.DB.NewBatch()
-	defer writeBatch.Close()
-
-	writeOpts := pebble.WriteOptions{}
+	txn, err := ri.kv.Txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
 
 	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic)
 	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	pageStartIDKey := pageMsgPrefix + "/"
 	pageEndIDKey := pageMsgPrefix + "/" + strconv.FormatInt(pageEndID+1, 10)
-	writeBatch.DeleteRange([]byte(pageStartIDKey), []byte(pageEndIDKey), &writeOpts)
+	if err = txn.DeleteRange(pageStartIDKey, pageEndIDKey); err != nil {
+		return err
+	}
 
 	pageTsPrefix := constructKey(PageTsTitle, topic)
 	pageTsStartIDKey := pageTsPrefix + "/"
 	pageTsEndIDKey := pageTsPrefix + "/" + strconv.FormatInt(pageEndID+1, 10)
-	writeBatch.DeleteRange([]byte(pageTsStartIDKey), []byte(pageTsEndIDKey), &writeOpts)
+	if err = txn.DeleteRange(pageTsStartIDKey, pageTsEndIDKey); err != nil {
+		return err
+	}
 
 	ackedStartIDKey := fixedAckedTsKey + "/"
 	ackedEndIDKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageEndID+1, 10)
-	writeBatch.DeleteRange([]byte(ackedStartIDKey), []byte(ackedEndIDKey), &writeOpts)
+	if err = txn.DeleteRange(ackedStartIDKey, ackedEndIDKey); err != nil {
+		return err
+	}
 
 	ll, ok := topicMu.Load(topic)
 	if !ok {
-		return fmt.Errorf("topic name = %s not exist", topic)
+		return merr.WrapErrMqTopicNotFound(topic)
 	}
 	lock, ok := ll.(*sync.Mutex)
 	if !ok {
@@ -323,23 +455,20 @@ func (ri *retentionInfo) cleanData(topic string, pageEndID UniqueID) error {
 	lock.Lock()
 	defer lock.Unlock()
 
-	err := DeleteMessages(ri.db, topic, 0, pageEndID)
+	err = DeleteMessages(ri.db, topic, 0, pageEndID)
 	if err != nil {
 		return err
 	}
 
-	err = writeBatch.Commit(&writeOpts)
-	if err != nil {
-		return err
-	}
-	return nil
+	defer ri.topicSizes.invalidate(topic)
+	return txn.Commit()
 }
 
 // DeleteMessages in pebble by range of [startID, endID)
 func DeleteMessages(db *pebble.DB, topic string, startID, endID UniqueID) error {
 	// Delete msg by range of startID and endID
-	startKey := path.Join(topic, strconv.FormatInt(startID, 10))
-	endKey := path.Join(topic, strconv.FormatInt(endID+1, 10))
+	startKey := constructStoreKey(topic, startID)
+	endKey := constructStoreKey(topic, endID+1)
 
 	writeBatch := db.NewBatch()
 	defer writeBatch.Close()
@@ -353,20 +482,18 @@ func DeleteMessages(db *pebble.DB, topic string, startID, endID UniqueID) error
 	return nil
 }
 
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	params := paramtable.Get()
-	retentionSeconds := int64(params.PebblemqCfg.RetentionTimeInMinutes.GetAsFloat() * 60)
+// msgTimeExpiredCheck checks the acked ts against the retention time in seconds, negative means no limit
+func msgTimeExpiredCheck(ackedTs int64, retentionSeconds int64) bool {
 	if retentionSeconds < 0 {
 		return false
 	}
 	return ackedTs+retentionSeconds < time.Now().Unix()
 }
 
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	params := paramtable.Get()
-	size := params.PebblemqCfg.RetentionSizeInMB.GetAsInt64()
-	if size < 0 {
+// msgSizeExpiredCheck checks the acked size left against the retention size in bytes, negative means no limit
+func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64, retentionSize int64) bool {
+	if retentionSize < 0 {
 		return false
 	}
-	return ackedSize-deletedAckedSize > size*MB
+	return ackedSize-deletedAckedSize > retentionSize
 }
@@ -471,6 +471,9 @@ func TestPebblemqRetention_PageTimeExpire(t *testing.T) {
 
 	params.Save(params.PebblemqCfg.PageSize.Key, "10")
 	params.Save(params.PebblemqCfg.TickerTimeInSeconds.Key, "1")
+	// the pages are walked across several scans
+	defer func(size int) { retentionScanPageSize = size }(retentionScanPageSize)
+	retentionScanPageSize = 3
 
 	pmq, err := NewPebbleMQ(pebbledbPath, idAllocator)
 	assert.NoError(t, err)
@@ -597,6 +600,9 @@ func TestPebblemqRetention_PageSizeExpire(t *testing.T) {
 
 	params.Save(params.PebblemqCfg.PageSize.Key, "10")
 	params.Save(params.PebblemqCfg.TickerTimeInSeconds.Key, "1")
+	// the pages are walked across several scans
+	defer func(size int) { retentionScanPageSize = size }(retentionScanPageSize)
+	retentionScanPageSize = 3
 
 	pmq, err := NewPebbleMQ(pebbledbPath, idAllocator)
 	assert.NoError(t, err)
@@ -653,3 +659,48 @@ func TestPebblemqRetention_PageSizeExpire(t *testing.T) {
 	// make sure clean up happens
 	assert.True(t, newRes[0].MsgID > ids[0])
 }
+
+func TestRetentionInfo_RetentionCycle(t *testing.T) {
+	suffix := "cycle"
+	kvPath := retentionPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	pebbledbPath := retentionPath + suffix
+	defer os.RemoveAll(pebbledbPath)
+	metaPath := retentionPath + metaPathSuffix + suffix
+	defer os.RemoveAll(metaPath)
+
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(pebbledbPath, idAllocator)
+	assert.NoError(t, err)
+	defer pmq.Close()
+	assert.NoError(t, pmq.CreateTopic("topic_cycle"))
+
+	ri := pmq.retentionInfo
+	ri.retentionCycle(time.Now().Add(time.Hour).Unix())
+	assert.Greater(t, ri.cycleDuration, time.Duration(0))
+
+	assert.Equal(t, time.Second, movingAverage(0, time.Second))
+	assert.Equal(t, 1200*time.Millisecond, movingAverage(time.Second, 2*time.Second))
+}
+
+func TestRetentionInfo_ResetTicker(t *testing.T) {
+	params := paramtable.Get()
+	paramtable.Init()
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	params.Save(params.PebblemqCfg.TickerTimeInSeconds.Key, "1")
+	defer params.Reset(params.PebblemqCfg.TickerTimeInSeconds.Key)
+	resetTicker(ticker, &params.PebblemqCfg.TickerTimeInSeconds)
+	select {
+	case <-ticker.C:
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "ticker is not reset")
+	}
+
+	// the invalid interval is ignored rather than panicking
+	params.Save(params.PebblemqCfg.TickerTimeInSeconds.Key, "0")
+	resetTicker(ticker, &params.PebblemqCfg.TickerTimeInSeconds)
+}
@@ -0,0 +1,401 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Kinds of the issues found by scrub
+const (
+	// ScrubIssueCorruption means the messages of the topic can't be read, e.g. a block checksum mismatch
+	ScrubIssueCorruption = "corruption"
+	// ScrubIssueBadProperties means the properties of a message can't be decoded
+	ScrubIssueBadProperties = "bad_properties"
+	// ScrubIssueOrphanProperties means the properties belong to no message
+	ScrubIssueOrphanProperties = "orphan_properties"
+	// ScrubIssuePageSizeDrift means the recorded size of a sealed page differs from its message bytes
+	ScrubIssuePageSizeDrift = "page_size_drift"
+	// ScrubIssueMessageSizeDrift means the recorded size of the current page differs from its message bytes
+	ScrubIssueMessageSizeDrift = "message_size_drift"
+)
+
+// ScrubIssue is an inconsistency of pebblemq data found by scrub
+type ScrubIssue struct {
+	Topic    string
+	Kind     string
+	Detail   string
+	Repaired bool
+}
+
+// ScrubReport is the result of a scrub
+type ScrubReport struct {
+	Topics   int
+	Messages int64
+	Bytes    int64
+	Issues   []ScrubIssue
+}
+
+func (r *ScrubReport) addIssue(issue ScrubIssue) {
+	r.Issues = append(r.Issues, issue)
+	metrics.PebblemqScrubIssueCounter.WithLabelValues(issue.Kind).Inc()
+	log.Warn("pebblemq scrub found issue", zap.String("topic", issue.Topic), zap.String("kind", issue.Kind),
+		zap.String("detail", issue.Detail), zap.Bool("repaired", issue.Repaired))
+}
+
+// Scrub iterates the messages of all topics, the block checksums of pebble are verified while reading,
+// checks the message properties and cross-checks the page size accounting used by size-based retention
+// against the actual message bytes. The page sizes and properties are fixed if repair is true.
+// The messages of a topic are scrubbed in batches, the topic lock is only held per batch.
+func (pmq *pebblemq) Scrub(repair bool) (*ScrubReport, error) {
+	if pmq.isClosed() {
+		return nil, ErrNotServing
+	}
+	if repair && pmq.readOnly {
+		return nil, retry.Unrecoverable(ErrReadOnly)
+	}
+	start := time.Now()
+	topicKeys, _, err := pmq.kv.LoadWithPrefix(TopicIDTitle)
+	if err != nil {
+		return nil, err
+	}
+	report := &ScrubReport{}
+	for _, key := range topicKeys {
+		topic, err := pebblekv.UnescapeKeySegment(key[len(TopicIDTitle):])
+		if err != nil {
+			return nil, err
+		}
+		scrubbed, err := pmq.scrubTopic(topic, repair, report)
+		if err != nil {
+			return nil, err
+		}
+		if scrubbed {
+			report.Topics++
+		}
+	}
+	log.Info("pebblemq scrub finished", zap.Int("topics", report.Topics), zap.Int64("messages", report.Messages),
+		zap.Int64("bytes", report.Bytes), zap.Int("issues", len(report.Issues)), zap.Bool("repair", repair),
+		zap.Duration("elapsed", time.Since(start)))
+	return report, nil
+}
+
+// scrubPage is a sealed page of a topic being scrubbed
+type scrubPage struct {
+	key      string
+	endID    UniqueID
+	recorded int64
+	actual   int64
+}
+
+// topicScrub is the progress of the scrub of a topic across the batches
+type topicScrub struct {
+	topic      string
+	repair     bool
+	report     *ScrubReport
+	msgPrefix  string
+	propPrefix string
+	// pages are the sealed pages when the scrub started, sorted by the end id
+	pages []*scrubPage
+	// currentSize is the message bytes beyond the sealed pages
+	currentSize int64
+	// resume is the key suffix of the last scrubbed message, empty before the first batch
+	resume string
+	// corrupted is set once the topic can't be fully read
+	corrupted bool
+}
+
+// scrubTopic checks a topic in batches of messages, the topic lock is held per batch so that produce and retention
+// of the topic are only blocked for a batch. The page sizes are cross-checked once all the messages are walked,
+// the pages sealed during the scrub are left to the next one. It returns false if the topic is destroyed.
+func (pmq *pebblemq) scrubTopic(topic string, repair bool, report *ScrubReport) (bool, error) {
+	s := &topicScrub{
+		topic:      topic,
+		repair:     repair,
+		report:     report,
+		msgPrefix:  constructStorePrefix(topic),
+		propPrefix: constructPropertiesPrefix(topic),
+	}
+	for first := true; ; first = false {
+		ll, ok := topicMu.Load(topic)
+		if !ok {
+			// destroyed during scrub
+			return false, nil
+		}
+		lock, ok := ll.(*sync.Mutex)
+		if !ok {
+			return false, fmt.Errorf("get mutex failed, topic name = %s", topic)
+		}
+		batchSize := paramtable.Get().PebblemqCfg.ScrubBatchSize.GetAsInt()
+		lock.Lock()
+		done, err := func() (bool, error) {
+			if first {
+				if err := pmq.loadScrubPages(s); err != nil {
+					return false, err
+				}
+			}
+			done, err := pmq.scrubBatch(s, batchSize)
+			if err != nil || !done || s.corrupted {
+				return done, err
+			}
+			return true, pmq.scrubPageSizes(s)
+		}()
+		lock.Unlock()
+		if err != nil || done {
+			return err == nil, err
+		}
+	}
+}
+
+// loadScrubPages loads the sealed pages of the topic, the topic lock must be held by caller
+func (pmq *pebblemq) loadScrubPages(s *topicScrub) error {
+	pageKeys, pageValues, err := pmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, s.topic) + "/")
+	if err != nil {
+		return err
+	}
+	s.pages = make([]*scrubPage, 0, len(pageKeys))
+	for i, key := range pageKeys {
+		endID, err := parsePageID(key)
+		if err != nil {
+			return err
+		}
+		recorded, err := strconv.ParseInt(pageValues[i], 10, 64)
+		if err != nil {
+			return err
+		}
+		s.pages = append(s.pages, &scrubPage{key: key, endID: endID, recorded: recorded})
+	}
+	sort.Slice(s.pages, func(i, j int) bool { return s.pages[i].endID < s.pages[j].endID })
+	return nil
+}
+
+// scrubBatch checks up to batchSize messages after the last scrubbed one and their properties,
+// it returns true once all the messages are walked. The topic lock must be held by caller.
+func (pmq *pebblemq) scrubBatch(s *topicScrub, batchSize int) (bool, error) {
+	storeBatch := pmq.store.NewBatch()
+	defer storeBatch.Close()
+
+	// the messages and properties are merge-walked in the same key order
+	msgIter := pebblekv.NewPebbleIteratorWithUpperBound(pmq.store, &pebble.IterOptions{UpperBound: []byte(typeutil.AddOne(s.msgPrefix))})
+	defer msgIter.Close()
+	propIter := pebblekv.NewPebbleIteratorWithUpperBound(pmq.store, &pebble.IterOptions{UpperBound: []byte(typeutil.AddOne(s.propPrefix))})
+	defer propIter.Close()
+	// the message and properties of resume are checked by the previous batch
+	seek := func(iter *pebblekv.PebbleIterator, prefix string) {
+		iter.Seek([]byte(prefix + s.resume))
+		if s.resume != "" && iter.Valid() && string(iter.Key()) == prefix+s.resume {
+			iter.Next()
+		}
+	}
+	seek(msgIter, s.msgPrefix)
+	seek(propIter, s.propPrefix)
+
+	orphanProperties := func(suffix string) error {
+		issue := ScrubIssue{Topic: s.topic, Kind: ScrubIssueOrphanProperties, Detail: "message " + suffix, Repaired: s.repair}
+		if s.repair {
+			if err := storeBatch.Delete([]byte(s.propPrefix+suffix), nil); err != nil {
+				return err
+			}
+		}
+		s.report.addIssue(issue)
+		return nil
+	}
+
+	for n := 0; msgIter.Valid() && n < batchSize; n++ {
+		suffix := string(msgIter.Key())[len(s.msgPrefix):]
+		msgID, err := strconv.ParseInt(suffix, 10, 64)
+		if err != nil {
+			return false, err
+		}
+		size := int64(len(msgIter.Value()))
+		s.report.Messages++
+		s.report.Bytes += size
+		if i := sort.Search(len(s.pages), func(i int) bool { return s.pages[i].endID >= msgID }); i < len(s.pages) {
+			s.pages[i].actual += size
+		} else {
+			s.currentSize += size
+		}
+
+		for ; propIter.Valid() && string(propIter.Key())[len(s.propPrefix):] < suffix; propIter.Next() {
+			if err := orphanProperties(string(propIter.Key())[len(s.propPrefix):]); err != nil {
+				return false, err
+			}
+		}
+		s.resume = suffix
+		msgIter.Next()
+		// the messages produced before 2.2.0 have no properties
+		if !propIter.Valid() || string(propIter.Key())[len(s.propPrefix):] != suffix {
+			continue
+		}
+		var decodeErr error
+		if value := propIter.Value(); len(value) != 0 {
+			properties := make(map[string]string)
+			decodeErr = json.Unmarshal(value, &properties)
+		}
+		propIter.Next()
+		if decodeErr != nil {
+			detail := fmt.Sprintf("message %d, %s", msgID, decodeErr.Error())
+			if s.repair {
+				if err := storeBatch.Set([]byte(constructPropertiesKey(s.topic, msgID)), []byte("{}"), nil); err != nil {
+					return false, err
+				}
+			}
+			s.report.addIssue(ScrubIssue{Topic: s.topic, Kind: ScrubIssueBadProperties, Detail: detail, Repaired: s.repair})
+		}
+	}
+	done := !msgIter.Valid()
+	if done {
+		for ; propIter.Valid(); propIter.Next() {
+			if err := orphanProperties(string(propIter.Key())[len(s.propPrefix):]); err != nil {
+				return false, err
+			}
+		}
+	}
+	for _, iter := range []*pebblekv.PebbleIterator{msgIter, propIter} {
+		if err := iter.Err(); err != nil {
+			// nothing more is repaired on a topic which can't be fully read
+			s.corrupted = true
+			s.report.addIssue(ScrubIssue{Topic: s.topic, Kind: ScrubIssueCorruption, Detail: err.Error()})
+			return true, nil
+		}
+	}
+	if s.repair && !storeBatch.Empty() {
+		if err := storeBatch.Commit(pebble.Sync); err != nil {
+			return false, err
+		}
+	}
+	return done, nil
+}
+
+// scrubPageSizes cross-checks the page sizes against the message bytes walked by the batches,
+// the topic lock must be held by caller
+func (pmq *pebblemq) scrubPageSizes(s *topicScrub) error {
+	pageKeys, pageValues, err := pmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, s.topic) + "/")
+	if err != nil {
+		return err
+	}
+	var lastSealed, lastEndID UniqueID
+	if len(s.pages) > 0 {
+		lastSealed = s.pages[len(s.pages)-1].endID
+	}
+	recorded := make(map[string]int64, len(pageKeys))
+	for i, key := range pageKeys {
+		endID, err := parsePageID(key)
+		if err != nil {
+			return err
+		}
+		if endID > lastEndID {
+			lastEndID = endID
+		}
+		size, err := strconv.ParseInt(pageValues[i], 10, 64)
+		if err != nil {
+			return err
+		}
+		recorded[key] = size
+	}
+
+	txn, err := pmq.txn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	for _, p := range s.pages {
+		size, ok := recorded[p.key]
+		// removed by retention during scrub
+		if !ok || size == p.actual {
+			continue
+		}
+		if s.repair {
+			if err := txn.Save(p.key, strconv.FormatInt(p.actual, 10)); err != nil {
+				return err
+			}
+		}
+		s.report.addIssue(ScrubIssue{Topic: s.topic, Kind: ScrubIssuePageSizeDrift, Repaired: s.repair,
+			Detail: fmt.Sprintf("page %d, recorded %d, actual %d", p.endID, size, p.actual)})
+	}
+	// the messages counted as the current page are partly sealed if a page is sealed during scrub
+	if lastEndID <= lastSealed {
+		msgSizeKey := constructKey(MessageSizeTitle, s.topic)
+		msgSizeVal, err := pmq.kv.Load(msgSizeKey)
+		if err != nil {
+			return err
+		}
+		size, err := strconv.ParseInt(msgSizeVal, 10, 64)
+		if err != nil || size != s.currentSize {
+			if s.repair {
+				if err := txn.Save(msgSizeKey, strconv.FormatInt(s.currentSize, 10)); err != nil {
+					return err
+				}
+			}
+			s.report.addIssue(ScrubIssue{Topic: s.topic, Kind: ScrubIssueMessageSizeDrift, Repaired: s.repair,
+				Detail: fmt.Sprintf("recorded %s, actual %d", msgSizeVal, s.currentSize)})
+		}
+	}
+	if !s.repair {
+		return nil
+	}
+	defer pmq.topicSizes.invalidate(s.topic)
+	return txn.Commit()
+}
+
+// startScrub scrubs the data periodically if the scrub interval is positive
+func (pmq *pebblemq) startScrub() {
+	params := paramtable.Get()
+	interval := params.PebblemqCfg.ScrubInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	pmq.scrubStop = make(chan struct{})
+	pmq.scrubWg.Add(1)
+	go func() {
+		defer pmq.scrubWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-pmq.scrubStop:
+				return
+			case <-ticker.C:
+				if _, err := pmq.Scrub(params.PebblemqCfg.ScrubRepair.GetAsBool()); err != nil {
+					log.Warn("pebblemq scrub failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (pmq *pebblemq) stopScrub() {
+	if pmq.scrubStop != nil {
+		close(pmq.scrubStop)
+		pmq.scrubWg.Wait()
+		pmq.scrubStop = nil
+	}
+}
@@ -0,0 +1,444 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	pebblekv "github.com/milvus-io/milvus/internal/kv/pebble"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+// ErrTopicQuotaExceeded is returned by Produce if the data of the topic would exceed its quota,
+// it's a non-retriable merr.ErrServiceForceDeny, the producers fail fast until the quota is raised
+// or the data of the topic is released by the retention
+var ErrTopicQuotaExceeded = errors.Wrap(merr.ErrServiceForceDeny, "pebblemq topic quota exceeded")
+
+// ErrProduceRateLimited is returned by Produce if the produce bandwidth of pebblemq, the tenant or the topic is exceeded,
+// it's a retriable merr.ErrServiceRateLimit as the bandwidth is refilled over time
+var ErrProduceRateLimited = errors.Wrap(merr.ErrServiceRateLimit, "pebblemq produce rate limited")
+
+// defaultTenant is the tenant of the topics without a tenant in their configs
+const defaultTenant = "default"
+
+// TopicConfig overrides the pebblemq configs of a topic, the unset ones follow the global configs.
+type TopicConfig struct {
+	// RetentionTimeInMinutes overrides pebblemq.retentionTimeInMinutes, -1 means no limit
+	RetentionTimeInMinutes *float64 `json:"retentionTimeInMinutes,omitempty"`
+	// RetentionSizeInMB overrides pebblemq.retentionSizeInMB, -1 means no limit
+	RetentionSizeInMB *int64 `json:"retentionSizeInMB,omitempty"`
+	// SyncWrite syncs the messages of the topic to disk before Produce returns
+	SyncWrite *bool `json:"syncWrite,omitempty"`
+	// MaxSizeInMB is the quota of the data of the topic, produce is rejected once exceeded, -1 means no limit
+	MaxSizeInMB *int64 `json:"maxSizeInMB,omitempty"`
+	// Tenant groups the topics sharing the produce bandwidth of pebblemq.produceRateLimit.tenant
+	Tenant *string `json:"tenant,omitempty"`
+}
+
+func (c *TopicConfig) validate() error {
+	if c.RetentionTimeInMinutes != nil && *c.RetentionTimeInMinutes != -1 && *c.RetentionTimeInMinutes < 0 {
+		return merr.WrapErrParameterInvalidMsg("invalid retentionTimeInMinutes %v, should be non-negative or -1 for no limit", *c.RetentionTimeInMinutes)
+	}
+	if c.RetentionSizeInMB != nil && *c.RetentionSizeInMB < -1 {
+		return merr.WrapErrParameterInvalidMsg("invalid retentionSizeInMB %d, should be non-negative or -1 for no limit", *c.RetentionSizeInMB)
+	}
+	if c.MaxSizeInMB != nil && *c.MaxSizeInMB < -1 {
+		return merr.WrapErrParameterInvalidMsg("invalid maxSizeInMB %d, should be non-negative or -1 for no limit", *c.MaxSizeInMB)
+	}
+	if c.Tenant != nil && *c.Tenant == "" {
+		return merr.WrapErrParameterInvalidMsg("tenant should not be empty")
+	}
+	return nil
+}
+
+func (c *TopicConfig) isEmpty() bool {
+	return c.RetentionTimeInMinutes == nil && c.RetentionSizeInMB == nil && c.SyncWrite == nil && c.MaxSizeInMB == nil && c.Tenant == nil
+}
+
+func (c TopicConfig) tenant() string {
+	if c.Tenant == nil {
+		return defaultTenant
+	}
+	return *c.Tenant
+}
+
+// retentionPolicy is the retention of a topic resolved from its config and the global configs
+type retentionPolicy struct {
+	// seconds is the retention time in seconds, negative means no limit
+	seconds int64
+	// size is the retention size in bytes, negative means no limit
+	size int64
+}
+
+func (p retentionPolicy) enabled() bool {
+	return p.seconds >= 0 || p.size >= 0
+}
+
+// topicConfigStore keeps the topic configs in memory, they're persisted in the meta kv by topic_config/topicName.
+type topicConfigStore struct {
+	mu      sync.RWMutex
+	kv      *pebblekv.PebbleKV
+	configs map[string]*TopicConfig
+}
+
+func loadTopicConfigs(kv *pebblekv.PebbleKV) (*topicConfigStore, error) {
+	s := &topicConfigStore{
+		kv:      kv,
+		configs: make(map[string]*TopicConfig),
+	}
+	keys, values, err := kv.LoadWithPrefix(TopicConfigTitle)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		topic, err := pebblekv.UnescapeKeySegment(key[len(TopicConfigTitle):])
+		if err != nil {
+			return nil, err
+		}
+		config := &TopicConfig{}
+		if err := json.Unmarshal([]byte(values[i]), config); err != nil {
+			return nil, fmt.Errorf("failed to parse the config of topic %s: %w", topic, err)
+		}
+		s.configs[topic] = config
+	}
+	return s, nil
+}
+
+// get returns the config of the topic, an empty config if it's not set.
+func (s *topicConfigStore) get(topic string) TopicConfig {
+	if s == nil {
+		return TopicConfig{}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if config, ok := s.configs[topic]; ok {
+		return *config
+	}
+	return TopicConfig{}
+}
+
+func (s *topicConfigStore) list() map[string]TopicConfig {
+	ret := make(map[string]TopicConfig)
+	if s == nil {
+		return ret
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for topic, config := range s.configs {
+		ret[topic] = *config
+	}
+	return ret
+}
+
+// set replaces the config of the topic, the config is removed if it's empty.
+func (s *topicConfigStore) set(topic string, config *TopicConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := constructKey(TopicConfigTitle, topic)
+	if config.isEmpty() {
+		if err := s.kv.Remove(key); err != nil {
+			return err
+		}
+		delete(s.configs, topic)
+		return nil
+	}
+	value, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err := s.kv.Save(key, string(value)); err != nil {
+		return err
+	}
+	copied := *config
+	s.configs[topic] = &copied
+	return nil
+}
+
+// remove removes the config of a destroyed topic from memory, the key is removed with the topic meta.
+func (s *topicConfigStore) remove(topic string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.configs, topic)
+}
+
+func (s *topicConfigStore) hasRetention() bool {
+	for _, config := range s.list() {
+		if resolveRetention(config).enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// retentionOf returns the retention of the topic.
+func (s *topicConfigStore) retentionOf(topic string) retentionPolicy {
+	return resolveRetention(s.get(topic))
+}
+
+func resolveRetention(config TopicConfig) retentionPolicy {
+	params := paramtable.Get()
+	minutes := params.PebblemqCfg.RetentionTimeInMinutes.GetAsFloat()
+	if config.RetentionTimeInMinutes != nil {
+		minutes = *config.RetentionTimeInMinutes
+	}
+	sizeInMB := params.PebblemqCfg.RetentionSizeInMB.GetAsInt64()
+	if config.RetentionSizeInMB != nil {
+		sizeInMB = *config.RetentionSizeInMB
+	}
+	policy := retentionPolicy{seconds: int64(minutes * 60), size: sizeInMB * MB}
+	if minutes < 0 {
+		policy.seconds = -1
+	}
+	if sizeInMB < 0 {
+		policy.size = -1
+	}
+	return policy
+}
+
+// checkRetentionIntervals checks the intervals of the retention tickers, which are not validated at startup
+// if the retention is enabled for some topics only.
+func checkRetentionIntervals() error {
+	params := paramtable.Get()
+	for _, interval := range []*paramtable.ParamItem{&params.PebblemqCfg.CompactionInterval, &params.PebblemqCfg.TickerTimeInSeconds} {
+		if interval.GetAsFloat() <= 0 {
+			return fmt.Errorf("%s should be positive if the retention is enabled", interval.Key)
+		}
+	}
+	return nil
+}
+
+// SetTopicConfig replaces the config of an existing topic, it takes effect on the following produces and
+// retention cycles without restart. The retention is started if it's enabled for the topic only.
+func (pmq *pebblemq) SetTopicConfig(topicName string, config *TopicConfig) error {
+	if pmq.isClosed() {
+		return ErrNotServing
+	}
+	if pmq.readOnly {
+		return ErrReadOnly
+	}
+	if err := config.validate(); err != nil {
+		return err
+	}
+	retentionEnabled := resolveRetention(*config).enabled()
+	if retentionEnabled {
+		if err := checkRetentionIntervals(); err != nil {
+			return err
+		}
+	}
+	ll, ok := topicMu.Load(topicName)
+	if !ok {
+		return merr.WrapErrMqTopicNotFound(topicName)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	old := pmq.topicConfigs.get(topicName)
+	if err := pmq.topicConfigs.set(topicName, config); err != nil {
+		return err
+	}
+	if old.tenant() != config.tenant() {
+		// the topic takes the bandwidth of the new tenant from now on
+		pmq.produceLimiter.Remove(old.tenant(), topicName)
+	}
+	log.Info("pebblemq topic config updated", zap.String("topic", topicName), zap.Any("old", old), zap.Any("new", config))
+	if retentionEnabled {
+		pmq.retentionInfo.startRetentionInfo()
+	}
+	return nil
+}
+
+// GetTopicConfigs returns the configs of the topics overriding the global configs.
+func (pmq *pebblemq) GetTopicConfigs() map[string]TopicConfig {
+	return pmq.topicConfigs.list()
+}
+
+// topicSizeCache keeps the running sizes of the topics with quota, so that the pages aren't loaded on every produce.
+// The size of a topic is loaded on its first produce, accumulated by the produces, and invalidated when the data
+// of the topic is cleaned up or repaired. The sizes are only changed with the topic lock held.
+type topicSizeCache struct {
+	mu    sync.Mutex
+	sizes map[string]int64
+}
+
+func newTopicSizeCache() *topicSizeCache {
+	return &topicSizeCache{sizes: make(map[string]int64)}
+}
+
+func (c *topicSizeCache) get(topic string) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	size, ok := c.sizes[topic]
+	return size, ok
+}
+
+func (c *topicSizeCache) set(topic string, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sizes[topic] = size
+}
+
+// add accumulates the size of a cached topic, it's a no-op if the topic isn't cached
+func (c *topicSizeCache) add(topic string, delta int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if size, ok := c.sizes[topic]; ok {
+		c.sizes[topic] = size + delta
+	}
+}
+
+// invalidate drops the size of topic, it's a no-op on a nil cache
+func (c *topicSizeCache) invalidate(topic string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sizes, topic)
+}
+
+// checkTopicQuota rejects the produce if the data of the topic would exceed its quota. The topic lock must be held by caller.
+func (pmq *pebblemq) checkTopicQuota(topicName string, payloadSize int64) error {
+	config := pmq.topicConfigs.get(topicName)
+	if config.MaxSizeInMB == nil || *config.MaxSizeInMB < 0 {
+		return nil
+	}
+	size, ok := pmq.topicSizes.get(topicName)
+	if !ok {
+		var err error
+		if size, err = pmq.topicSize(topicName); err != nil {
+			return err
+		}
+		pmq.topicSizes.set(topicName, size)
+	}
+	if quota := *config.MaxSizeInMB * MB; size+payloadSize > quota {
+		return retry.Unrecoverable(errors.Wrapf(ErrTopicQuotaExceeded, "topic %s, size %d, produce %d, quota %d", topicName, size, payloadSize, quota))
+	}
+	return nil
+}
+
+func newProduceLimiter() *ratelimitutil.HierarchicalLimiter {
+	return ratelimitutil.NewHierarchicalLimiter("pebblemq_produce", "node", "tenant", "topic")
+}
+
+// checkProduceRate rejects the produce if the produce bandwidth of pebblemq, the tenant or the topic is exceeded,
+// the bandwidths are refreshed from the params, so they're updated without restart.
+func (pmq *pebblemq) checkProduceRate(topicName string, payloadSize int64) error {
+	params := paramtable.Get()
+	rates := []*paramtable.ParamItem{
+		&params.PebblemqCfg.ProduceRateLimitNode,
+		&params.PebblemqCfg.ProduceRateLimitTenant,
+		&params.PebblemqCfg.ProduceRateLimitTopic,
+	}
+	for level, rate := range rates {
+		pmq.produceLimiter.SetLevelLimit(level, ratelimitutil.Limit(rate.GetAsFloat()*MB))
+	}
+	tenant := pmq.topicConfigs.get(topicName).tenant()
+	if !pmq.produceLimiter.AllowN(time.Now(), int(payloadSize), tenant, topicName) {
+		return errors.Wrapf(ErrProduceRateLimited, "topic %s, tenant %s, produce %d", topicName, tenant, payloadSize)
+	}
+	return nil
+}
+
+// topicSize returns the size of the messages of the topic, including the pages not cleaned by the retention yet.
+func (pmq *pebblemq) topicSize(topicName string) (int64, error) {
+	_, values, err := pmq.kv.LoadWithPrefix(constructKey(PageMsgSizeTitle, topicName) + "/")
+	if err != nil {
+		return 0, err
+	}
+	msgSizeVal, err := pmq.kv.Load(constructKey(MessageSizeTitle, topicName))
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, value := range append(values, msgSizeVal) {
+		if value == "" {
+			continue
+		}
+		pageSize, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		size += pageSize
+	}
+	return size, nil
+}
+
+// SetPebbleMQTopicConfig sets the config of a topic of the global pebblemq
+func SetPebbleMQTopicConfig(topicName string, config *TopicConfig) error {
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
+	if Pmq == nil {
+		return ErrNotServing
+	}
+	return Pmq.SetTopicConfig(topicName, config)
+}
+
+// TopicConfigHandler serves the topic configs of the global pebblemq, GET returns the configs of all topics,
+// PUT replaces the config of the topic in the query, e.g. PUT /pebblemq/topic_config?topic=t with body
+// {"retentionTimeInMinutes":60,"syncWrite":true}, an empty body removes the overrides of the topic.
+func TopicConfigHandler() http.Handler {
+	return http.HandlerFunc(serveTopicConfig)
+}
+
+func serveTopicConfig(w http.ResponseWriter, req *http.Request) {
+	pmqMu.RLock()
+	defer pmqMu.RUnlock()
+	if Pmq == nil {
+		http.Error(w, ErrNotServing.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		topic := req.URL.Query().Get("topic")
+		if topic == "" {
+			http.Error(w, "topic is required", http.StatusBadRequest)
+			return
+		}
+		config := &TopicConfig{}
+		if err := json.NewDecoder(req.Body).Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			http.Error(w, fmt.Sprintf("invalid topic config: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := Pmq.SetTopicConfig(topic, config); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "only GET and PUT are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(Pmq.GetTopicConfigs())
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+var topicConfigPath = "/tmp/pmq_topic_config/"
+
+func TestPebblemqTopicConfig(t *testing.T) {
+	err := os.MkdirAll(topicConfigPath, os.ModePerm)
+	require.NoError(t, err)
+	defer os.RemoveAll(topicConfigPath)
+	defer os.RemoveAll(topicConfigPath + kvSuffix)
+
+	params := paramtable.Get()
+	paramtable.Init()
+	params.Save(params.PebblemqCfg.RetentionSizeInMB.Key, "-1")
+	defer params.Reset(params.PebblemqCfg.RetentionSizeInMB.Key)
+	params.Save(params.PebblemqCfg.RetentionTimeInMinutes.Key, "-1")
+	defer params.Reset(params.PebblemqCfg.RetentionTimeInMinutes.Key)
+
+	pmq, err := NewPebbleMQ(topicConfigPath, nil)
+	require.NoError(t, err)
+
+	topicName := "topic_config"
+	err = pmq.CreateTopic(topicName)
+	require.NoError(t, err)
+
+	err = pmq.SetTopicConfig("topic_not_exist", &TopicConfig{})
+	assert.ErrorIs(t, err, merr.ErrMqTopicNotFound)
+	assert.Equal(t, merr.FaultUser, merr.FaultDomain(err))
+	invalid := int64(-2)
+	err = pmq.SetTopicConfig(topicName, &TopicConfig{RetentionSizeInMB: &invalid})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.False(t, merr.IsRetriable(err))
+
+	// the quota of the topic
+	quota := int64(0)
+	err = pmq.SetTopicConfig(topicName, &TopicConfig{MaxSizeInMB: &quota})
+	require.NoError(t, err)
+	_, err = pmq.Produce(topicName, []ProducerMessage{{Payload: []byte("message")}})
+	assert.True(t, errors.Is(err, ErrTopicQuotaExceeded))
+	assert.False(t, retry.IsRecoverable(err))
+	assert.False(t, merr.IsRetriable(err))
+	assert.Equal(t, merr.FaultUser, merr.FaultDomain(err))
+
+	quota = 1
+	syncWrite := true
+	minutes := 10.0
+	err = pmq.SetTopicConfig(topicName, &TopicConfig{MaxSizeInMB: &quota, SyncWrite: &syncWrite, RetentionTimeInMinutes: &minutes})
+	require.NoError(t, err)
+	_, err = pmq.Produce(topicName, []ProducerMessage{{Payload: []byte("message")}})
+	assert.NoError(t, err)
+	size, err := pmq.topicSize(topicName)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("message")), size)
+	// the size of the topic is accumulated by the produces
+	cached, ok := pmq.topicSizes.get(topicName)
+	assert.True(t, ok)
+	assert.Equal(t, size, cached)
+	assert.Equal(t, retentionPolicy{seconds: 600, size: -1}, pmq.topicConfigs.retentionOf(topicName))
+	assert.Equal(t, retentionPolicy{seconds: -1, size: -1}, pmq.topicConfigs.retentionOf("other"))
+
+	// the configs are persisted
+	pmq.Close()
+	pmq, err = NewPebbleMQ(topicConfigPath, nil)
+	require.NoError(t, err)
+	defer pmq.Close()
+	configs := pmq.GetTopicConfigs()
+	require.Contains(t, configs, topicName)
+	assert.Equal(t, int64(1), *configs[topicName].MaxSizeInMB)
+	assert.True(t, *configs[topicName].SyncWrite)
+
+	// the configs are served by the admin api
+	pmqMu.Lock()
+	Pmq = pmq
+	pmqMu.Unlock()
+	defer func() {
+		pmqMu.Lock()
+		Pmq = nil
+		pmqMu.Unlock()
+	}()
+	serve := func(method, url, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		TopicConfigHandler().ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w
+	}
+	w := serve(http.MethodGet, "/pebblemq/topic_config", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"maxSizeInMB":1`)
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPut, "/pebblemq/topic_config", "{}").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPut, "/pebblemq/topic_config?topic="+topicName, "{").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodDelete, "/pebblemq/topic_config", "").Code)
+	w = serve(http.MethodPut, "/pebblemq/topic_config?topic="+topicName, `{"retentionSizeInMB":100}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int64(100), *pmq.GetTopicConfigs()[topicName].RetentionSizeInMB)
+	assert.Nil(t, pmq.GetTopicConfigs()[topicName].MaxSizeInMB)
+
+	// the config is removed with the topic
+	err = pmq.DestroyTopic(topicName)
+	assert.NoError(t, err)
+	assert.Empty(t, pmq.GetTopicConfigs())
+	val, err := pmq.kv.Load(constructKey(TopicConfigTitle, topicName))
+	assert.NoError(t, err)
+	assert.Empty(t, val)
+}
+
+func TestPebblemqProduceRateLimit(t *testing.T) {
+	path := "/tmp/pmq_produce_rate_limit/"
+	err := os.MkdirAll(path, os.ModePerm)
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	defer os.RemoveAll(path + kvSuffix)
+
+	params := paramtable.Get()
+	paramtable.Init()
+	pmq, err := NewPebbleMQ(path, nil)
+	require.NoError(t, err)
+	defer pmq.Close()
+
+	tenant := "tenant"
+	topics := []string{"rate_limit_a", "rate_limit_b", "rate_limit_c"}
+	for _, topic := range topics {
+		require.NoError(t, pmq.CreateTopic(topic))
+	}
+	require.NoError(t, pmq.SetTopicConfig(topics[0], &TopicConfig{Tenant: &tenant}))
+	require.NoError(t, pmq.SetTopicConfig(topics[1], &TopicConfig{Tenant: &tenant}))
+	empty := ""
+	err = pmq.SetTopicConfig(topics[2], &TopicConfig{Tenant: &empty})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	produce := func(topic string) error {
+		_, err := pmq.Produce(topic, []ProducerMessage{{Payload: []byte("message")}})
+		return err
+	}
+
+	// about a byte per second, the first produce takes the burst and the latter ones are rejected
+	params.Save(params.PebblemqCfg.ProduceRateLimitTopic.Key, "0.000001")
+	defer params.Reset(params.PebblemqCfg.ProduceRateLimitTopic.Key)
+	assert.NoError(t, produce(topics[0]))
+	err = produce(topics[0])
+	assert.ErrorIs(t, err, ErrProduceRateLimited)
+	assert.True(t, merr.IsRetriable(err))
+	assert.NoError(t, produce(topics[1]))
+
+	// the topics of the tenant share its bandwidth
+	params.Save(params.PebblemqCfg.ProduceRateLimitTopic.Key, "-1")
+	params.Save(params.PebblemqCfg.ProduceRateLimitTenant.Key, "0.000001")
+	assert.NoError(t, produce(topics[0]))
+	assert.ErrorIs(t, produce(topics[1]), ErrProduceRateLimited)
+	assert.NoError(t, produce(topics[2]))
+
+	// the limits are updated without restart
+	params.Reset(params.PebblemqCfg.ProduceRateLimitTenant.Key)
+	assert.NoError(t, produce(topics[1]))
+
+	params.Save(params.PebblemqCfg.ProduceRateLimitNode.Key, "0.000001")
+	defer params.Reset(params.PebblemqCfg.ProduceRateLimitNode.Key)
+	assert.NoError(t, produce(topics[2]))
+	assert.ErrorIs(t, produce(topics[0]), ErrProduceRateLimited)
+
+	assert.NoError(t, pmq.DestroyTopic(topics[0]))
+}
+
+func TestRetentionExpiredCheck(t *testing.T) {
+	now := time.Now().Unix()
+	assert.False(t, msgTimeExpiredCheck(now-100, -1))
+	assert.True(t, msgTimeExpiredCheck(now-100, 10))
+	assert.False(t, msgTimeExpiredCheck(now-100, 1000))
+
+	assert.False(t, msgSizeExpiredCheck(0, 100, -1))
+	assert.True(t, msgSizeExpiredCheck(0, 100, 10))
+	assert.False(t, msgSizeExpiredCheck(95, 100, 10))
+}
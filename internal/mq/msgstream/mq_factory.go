@@ -12,6 +12,25 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
+func init() {
+	msgstream.RegisterMQBackend(msgstream.MQBackend{
+		Name:           "rocksmq",
+		StandaloneOnly: true,
+		NewFactory: func(params *paramtable.ComponentParam) (msgstream.Factory, error) {
+			return NewRocksmqFactory(params.RocksmqCfg.Path.GetValue(), &params.ServiceParam), nil
+		},
+		UnmarshalMessageID: rmqwrapper.UnmarshalRmqID,
+	})
+	msgstream.RegisterMQBackend(msgstream.MQBackend{
+		Name:           "pebblemq",
+		StandaloneOnly: true,
+		NewFactory: func(params *paramtable.ComponentParam) (msgstream.Factory, error) {
+			return NewPebblemqFactory(params.PebblemqCfg.Path.GetValue(), &params.ServiceParam), nil
+		},
+		UnmarshalMessageID: pmqwrapper.UnmarshalPmqID,
+	})
+}
+
 // NewRocksmqFactory creates a new message stream factory based on rocksmq.
 func NewRocksmqFactory(path string, cfg *paramtable.ServiceParam) msgstream.Factory {
 	if err := rmqimplserver.InitRocksMQ(path); err != nil {
@@ -542,6 +542,86 @@ func TestStream_PmqTtMsgStream_Seek(t *testing.T) {
 	outputStream.Close()
 }
 
+func TestStream_PmqTtMsgStream_SeekByTime(t *testing.T) {
+	c1 := funcutil.RandomString(8)
+	producerChannels := []string{c1}
+	consumerChannels := []string{c1}
+
+	msgPack0 := msgstream.MsgPack{}
+	msgPack0.Msgs = append(msgPack0.Msgs, getTimeTickMsg(0))
+
+	msgPack1 := msgstream.MsgPack{}
+	msgPack1.Msgs = append(msgPack1.Msgs, getTsMsg(commonpb.MsgType_Insert, 1))
+	msgPack1.Msgs = append(msgPack1.Msgs, getTsMsg(commonpb.MsgType_Insert, 19))
+
+	msgPack2 := msgstream.MsgPack{}
+	msgPack2.Msgs = append(msgPack2.Msgs, getTimeTickMsg(5))
+
+	msgPack3 := msgstream.MsgPack{}
+	msgPack3.Msgs = append(msgPack3.Msgs, getTsMsg(commonpb.MsgType_Insert, 14))
+	msgPack3.Msgs = append(msgPack3.Msgs, getTsMsg(commonpb.MsgType_Insert, 9))
+
+	msgPack4 := msgstream.MsgPack{}
+	msgPack4.Msgs = append(msgPack4.Msgs, getTimeTickMsg(11))
+
+	msgPack5 := msgstream.MsgPack{}
+	msgPack5.Msgs = append(msgPack5.Msgs, getTsMsg(commonpb.MsgType_Insert, 12))
+
+	msgPack6 := msgstream.MsgPack{}
+	msgPack6.Msgs = append(msgPack6.Msgs, getTimeTickMsg(15))
+
+	msgPack7 := msgstream.MsgPack{}
+	msgPack7.Msgs = append(msgPack7.Msgs, getTimeTickMsg(20))
+
+	ctx := context.Background()
+	inputStream, outputStream := initPmqTtStream(ctx, producerChannels, consumerChannels, funcutil.RandomString(8))
+	outputStream.Close()
+
+	_, err := inputStream.Broadcast(&msgPack0)
+	assert.NoError(t, err)
+	err = inputStream.Produce(&msgPack1)
+	assert.NoError(t, err)
+	_, err = inputStream.Broadcast(&msgPack2)
+	assert.NoError(t, err)
+	err = inputStream.Produce(&msgPack3)
+	assert.NoError(t, err)
+	_, err = inputStream.Broadcast(&msgPack4)
+	assert.NoError(t, err)
+	err = inputStream.Produce(&msgPack5)
+	assert.NoError(t, err)
+	_, err = inputStream.Broadcast(&msgPack6)
+	assert.NoError(t, err)
+	_, err = inputStream.Broadcast(&msgPack7)
+	assert.NoError(t, err)
+
+	factory := msgstream.ProtoUDFactory{}
+	pmqClient, _ := NewClientWithDefaultOptions(ctx)
+	outputStream, _ = msgstream.NewMqTtMsgStream(ctx, 100, 100, pmqClient, factory.NewUnmarshalDispatcher())
+	outputStream.AsConsumer(ctx, consumerChannels, funcutil.RandomString(8), mqwrapper.SubscriptionPositionUnknown)
+
+	err = outputStream.SeekByTime(ctx, "not_subscribed", 11)
+	assert.Error(t, err)
+
+	// the page ts of pebblemq is in seconds, all messages are replayed and filtered by ts
+	err = outputStream.SeekByTime(ctx, c1, 11)
+	assert.NoError(t, err)
+	seekMsg := consumer(ctx, outputStream)
+	assert.Equal(t, 2, len(seekMsg.Msgs))
+	result := []uint64{14, 12}
+	for i, msg := range seekMsg.Msgs {
+		assert.Equal(t, result[i], msg.BeginTs())
+	}
+	assert.Equal(t, uint64(10), seekMsg.StartPositions[0].Timestamp)
+	assert.NotEmpty(t, seekMsg.StartPositions[0].MsgID)
+
+	seekMsg2 := consumer(ctx, outputStream)
+	assert.Equal(t, 1, len(seekMsg2.Msgs))
+	assert.Equal(t, uint64(19), seekMsg2.Msgs[0].BeginTs())
+
+	inputStream.Close()
+	outputStream.Close()
+}
+
 func TestStream_qMsgStream_SeekInvalidMessage(t *testing.T) {
 	c := funcutil.RandomString(8)
 	producerChannels := []string{c}
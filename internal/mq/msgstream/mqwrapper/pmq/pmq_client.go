@@ -19,6 +19,7 @@ package pmq
 import (
 	"context"
 	"strconv"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/client"
@@ -36,22 +37,84 @@ var _ mqwrapper.Client = &pmqClient{}
 
 // pmqClient contains a pebblemq client
 type pmqClient struct {
+	mu     sync.Mutex
 	client client.Client
+	server client.PebbleMQ
+	// getServer returns the global pebblemq instance to reconnect to after it's restarted,
+	// it's nil if the client is bound to a given server
+	getServer func() client.PebbleMQ
 }
 
 func NewClientWithDefaultOptions(ctx context.Context) (mqwrapper.Client, error) {
-	option := client.Options{Server: server.Pmq}
-	return NewClient(option)
+	return NewClient(client.Options{})
 }
 
-// NewClient returns a new pmqClient object
+// NewClient returns a new pmqClient object, the client follows the global pebblemq instance
+// and reconnects to it after restart if opts.Server is not given
 func NewClient(opts client.Options) (*pmqClient, error) {
+	var getServer func() client.PebbleMQ
+	if opts.Server == nil {
+		getServer = server.GetPmq
+		opts.Server = getServer()
+	}
 	c, err := client.NewClient(opts)
 	if err != nil {
 		log.Error("Failed to set pmq client: ", zap.Error(err))
 		return nil, err
 	}
-	return &pmqClient{client: c}, nil
+	return &pmqClient{client: c, server: opts.Server, getServer: getServer}, nil
+}
+
+// current returns the pebblemq client in use
+func (rc *pmqClient) current() client.Client {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.client
+}
+
+// outdated returns whether c is no longer connected to the serving pebblemq
+func (rc *pmqClient) outdated(c client.Client) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if c != rc.client {
+		return true
+	}
+	return rc.getServer != nil && rc.getServer() != rc.server
+}
+
+// reconnect replaces the stale client with a client of the restarted pebblemq,
+// the client in use is returned if the stale one has been replaced already.
+// server.ErrNotServing is returned if pebblemq hasn't been restarted yet
+func (rc *pmqClient) reconnect(stale client.Client) (client.Client, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if stale != rc.client {
+		return rc.client, nil
+	}
+	if rc.getServer == nil {
+		return nil, server.ErrNotServing
+	}
+	srv := rc.getServer()
+	if srv == nil || srv == rc.server {
+		return nil, server.ErrNotServing
+	}
+	c, err := client.NewClient(client.Options{Server: srv})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("pmq client reconnected to the restarted pebblemq")
+	rc.client.Close()
+	rc.client, rc.server = c, srv
+	return c, nil
+}
+
+// isNotServing returns whether err is caused by a stopped pebblemq, which might be restarted later
+func isNotServing(err error) bool {
+	return errors.Is(err, server.ErrNotServing)
+}
+
+func isWriteStall(err error) bool {
+	return errors.Is(err, server.ErrWriteStall)
 }
 
 // CreateProducer creates a producer for pebblemq client
@@ -59,13 +122,19 @@ func (rc *pmqClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrappe
 	start := timerecord.NewTimeRecorder("create producer")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.TotalLabel).Inc()
 
-	pmqOpts := client.ProducerOptions{Topic: options.Topic}
-	pp, err := rc.client.CreateProducer(pmqOpts)
+	pmqOpts := client.ProducerOptions{Topic: options.Topic, Epoch: options.Epoch}
+	cli := rc.current()
+	pp, err := cli.CreateProducer(pmqOpts)
+	if isNotServing(err) {
+		if cli, err = rc.reconnect(cli); err == nil {
+			pp, err = cli.CreateProducer(pmqOpts)
+		}
+	}
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.FailLabel).Inc()
 		return nil, err
 	}
-	rp := pmqProducer{p: pp}
+	rp := pmqProducer{client: rc, options: pmqOpts, c: cli, p: pp}
 
 	elapsed := start.ElapseSpan()
 	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateProducerLabel).Observe(float64(elapsed.Milliseconds()))
@@ -84,21 +153,29 @@ func (rc *pmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Con
 		log.Warn("unexpected subscription consumer options", zap.Error(err))
 		return nil, err
 	}
-	receiveChannel := make(chan client.Message, options.BufSize)
-
-	cli, err := rc.client.Subscribe(client.ConsumerOptions{
-		Topic:                       options.Topic,
-		SubscriptionName:            options.SubscriptionName,
-		MessageChannel:              receiveChannel,
-		SubscriptionInitialPosition: options.SubscriptionInitialPosition,
-	})
+	rConsumer := &Consumer{
+		client: rc,
+		options: client.ConsumerOptions{
+			Topic:                       options.Topic,
+			SubscriptionName:            options.SubscriptionName,
+			SubscriptionInitialPosition: options.SubscriptionInitialPosition,
+		},
+		bufSize:  options.BufSize,
+		position: noPosition,
+		closeCh:  make(chan struct{}),
+	}
+	cli := rc.current()
+	err := rConsumer.subscribe(cli)
+	if isNotServing(err) {
+		if cli, err = rc.reconnect(cli); err == nil {
+			err = rConsumer.subscribe(cli)
+		}
+	}
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
 		return nil, err
 	}
 
-	rConsumer := &Consumer{c: cli, closeCh: make(chan struct{})}
-
 	elapsed := start.ElapseSpan()
 	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateConsumerLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.SuccessLabel).Inc()
@@ -122,10 +199,9 @@ func (rc *pmqClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
 
 // BytesToMsgID converts a byte array to messageID
 func (rc *pmqClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
-	rID := DeserializePmqID(id)
-	return &pmqID{messageID: rID}, nil
+	return UnmarshalPmqID(id)
 }
 
 func (rc *pmqClient) Close() {
-	rc.client.Close()
+	rc.current().Close()
 }
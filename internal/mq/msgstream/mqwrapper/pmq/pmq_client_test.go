@@ -35,16 +35,17 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
+const pmqPath = "/tmp/milvus/pdb_data"
+
 func TestMain(m *testing.M) {
 	paramtable.Init()
 	pt := paramtable.Get()
 	pt.Save(pt.ServiceParam.MQCfg.EnablePursuitMode.Key, "false")
 
 	rand.Seed(time.Now().UnixNano())
-	path := "/tmp/milvus/pdb_data"
-	defer os.RemoveAll(path)
+	defer os.RemoveAll(pmqPath)
 
-	_ = pebblemqimplserver.InitPebbleMQ(path)
+	_ = pebblemqimplserver.InitPebbleMQ(pmqPath)
 	exitCode := m.Run()
 	defer pebblemqimplserver.ClosePebbleMQ()
 	os.Exit(exitCode)
@@ -238,15 +239,113 @@ func TestPmqClient_BytesToMsgID(t *testing.T) {
 	client, _ := createPmqClient()
 	defer client.Close()
 
+	res, err := client.BytesToMsgID(SerializePmqID(5))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), res.(*pmqID).messageID)
+
+	// position written by pulsar
 	mid := pulsar.EarliestMessageID()
 	binary := pulsarwrapper.SerializePulsarMsgID(mid)
-
-	res, err := client.BytesToMsgID(binary)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+	res, err = client.BytesToMsgID(binary)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
 }
 
 func createPmqClient() (*pmqClient, error) {
 	opts := pebblemqimplclient.Options{}
 	return NewClient(opts)
 }
+
+func TestPmqClient_ConsumerStats(t *testing.T) {
+	client, err := createPmqClient()
+	assert.NoError(t, err)
+	defer client.Close()
+
+	topic := fmt.Sprintf("t2ConsumerStats-%d", rand.Int())
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err = producer.Send(context.TODO(), &mqwrapper.ProducerMessage{Payload: []byte{byte(i)}})
+		assert.NoError(t, err)
+	}
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "subName",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	sc, ok := consumer.(mqwrapper.StatsConsumer)
+	assert.True(t, ok)
+	stats := sc.Stats()
+	assert.Equal(t, "subName", stats.Subscription)
+	assert.True(t, stats.LastDispatchTime.IsZero())
+
+	for i := 0; i < 3; i++ {
+		msg := <-consumer.Chan()
+		consumer.Ack(msg)
+	}
+	// the counter is updated right after the message is dispatched
+	assert.Eventually(t, func() bool {
+		return sc.Stats().Received == 3
+	}, time.Second, 10*time.Millisecond)
+	stats = sc.Stats()
+	assert.Equal(t, int64(3), stats.Acked)
+	assert.Equal(t, 0, stats.Pending)
+	assert.False(t, stats.LastDispatchTime.IsZero())
+}
+
+func TestPmqClient_Reconnect(t *testing.T) {
+	client, err := createPmqClient()
+	assert.NoError(t, err)
+	defer client.Close()
+
+	topic := fmt.Sprintf("t2Reconnect-%d", rand.Int())
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err = producer.Send(context.TODO(), &mqwrapper.ProducerMessage{Payload: []byte{byte(i)}})
+		assert.NoError(t, err)
+	}
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "subName",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	received := make([]byte, 0, 5)
+	receive := func(n int) {
+		for i := 0; i < n; i++ {
+			select {
+			case msg := <-consumer.Chan():
+				received = append(received, msg.Payload()...)
+			case <-time.After(10 * time.Second):
+				t.FailNow()
+			}
+		}
+	}
+	receive(2)
+
+	err = pebblemqimplserver.RestartPebbleMQ(pmqPath)
+	require.NoError(t, err)
+
+	// producer resends to the restarted pebblemq
+	for i := 3; i < 5; i++ {
+		_, err = producer.Send(context.TODO(), &mqwrapper.ProducerMessage{Payload: []byte{byte(i)}})
+		assert.NoError(t, err)
+	}
+	// consumer resumes after the last received message
+	receive(3)
+	assert.Equal(t, []byte{0, 1, 2, 3, 4}, received)
+}
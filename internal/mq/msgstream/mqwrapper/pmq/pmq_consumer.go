@@ -19,24 +19,122 @@ package pmq
 import (
 	"sync"
 	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/client"
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
 
-// Consumer is a client that used to consume messages from pebblemq
+const (
+	// noPosition means the consumer hasn't consumed or sought anywhere yet
+	noPosition = int64(-1)
+
+	// reconnectCheckInterval is the interval to check whether pebblemq has been restarted
+	reconnectCheckInterval = time.Second
+)
+
+// Consumer is a client that used to consume messages from pebblemq,
+// it resubscribes to the restarted pebblemq from the last position transparently
 type Consumer struct {
-	c          client.Consumer
+	client  *pmqClient
+	options client.ConsumerOptions
+	bufSize int64
+
+	mu sync.Mutex
+	// cli is the pebblemq client c subscribed with
+	cli client.Client
+	c   client.Consumer
+	// position is the id of the last dispatched message or the sought one,
+	// it's where to resume from after resubscribe
+	position  int64
+	inclusive bool
+	seekTime  time.Time
+
 	msgChannel chan mqwrapper.Message
 	closeCh    chan struct{}
 	once       sync.Once
 	skip       int32
 	wg         sync.WaitGroup
+
+	acked        int64
+	lastDispatch int64
+}
+
+var (
+	_ mqwrapper.TimeSeekableConsumer = (*Consumer)(nil)
+	_ mqwrapper.StatsConsumer        = (*Consumer)(nil)
+)
+
+// subscribe subscribes on cli and resumes from the last position
+func (rc *Consumer) subscribe(cli client.Client) error {
+	options := rc.options
+	options.MessageChannel = make(chan client.Message, rc.bufSize)
+	c, err := cli.Subscribe(options)
+	if err != nil {
+		return err
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.position != noPosition {
+		if err := c.Seek(rc.position); err != nil {
+			c.Close()
+			return err
+		}
+		if !rc.inclusive {
+			atomic.StoreInt32(&rc.skip, 1)
+		}
+	} else if !rc.seekTime.IsZero() {
+		if err := c.SeekByTime(rc.seekTime); err != nil {
+			c.Close()
+			return err
+		}
+	}
+	rc.cli, rc.c = cli, c
+	return nil
+}
+
+// resubscribe subscribes again if pebblemq has been restarted
+func (rc *Consumer) resubscribe() {
+	rc.mu.Lock()
+	stale := rc.cli
+	rc.mu.Unlock()
+	if !rc.client.outdated(stale) {
+		return
+	}
+	cli, err := rc.client.reconnect(stale)
+	if err == nil {
+		err = rc.subscribe(cli)
+	}
+	if err != nil {
+		log.Warn("pmq consumer failed to resubscribe, retry later",
+			zap.String("topic", rc.options.Topic), zap.String("subName", rc.options.SubscriptionName), zap.Error(err))
+		return
+	}
+	log.Info("pmq consumer resubscribed",
+		zap.String("topic", rc.options.Topic), zap.String("subName", rc.options.SubscriptionName))
+}
+
+func (rc *Consumer) consumer() client.Consumer {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.c
+}
+
+// dispatched records the message as the position to resume from
+func (rc *Consumer) dispatched(msg client.Message) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.position, rc.inclusive = msg.MsgID, false
+	atomic.StoreInt64(&rc.lastDispatch, time.Now().UnixNano())
 }
 
 // Subscription returns the subscription name of this consumer
 func (rc *Consumer) Subscription() string {
-	return rc.c.Subscription()
+	return rc.options.SubscriptionName
 }
 
 // Chan returns a channel to read messages from pebblemq
@@ -49,26 +147,36 @@ func (rc *Consumer) Chan() <-chan mqwrapper.Message {
 		rc.wg.Add(1)
 		go func() {
 			defer rc.wg.Done()
-			for { //nolint:gosimple
+			ticker := time.NewTicker(reconnectCheckInterval)
+			defer ticker.Stop()
+			for {
+				c := rc.consumer()
 				select {
-				case msg, ok := <-rc.c.Chan():
+				case msg, ok := <-c.Chan():
 					if !ok {
 						close(rc.msgChannel)
 						return
 					}
+					if c != rc.consumer() {
+						// buffered before resubscribe, it will be consumed again from the resumed position
+						continue
+					}
 					skip := atomic.LoadInt32(&rc.skip)
 					if skip != 1 {
 						select {
 						case rc.msgChannel <- &pmqMessage{msg: msg}:
+							rc.dispatched(msg)
 						case <-rc.closeCh:
 							// if consumer closed, enter close branch below
 						}
 					} else {
 						atomic.StoreInt32(&rc.skip, 0)
 					}
+				case <-ticker.C:
+					rc.resubscribe()
 				case <-rc.closeCh:
 					close(rc.msgChannel)
-					rc.c.Close()
+					rc.consumer().Close()
 					return
 				}
 			}
@@ -84,11 +192,41 @@ func (rc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
 	if !inclusive {
 		atomic.StoreInt32(&rc.skip, 1)
 	}
-	return rc.c.Seek(msgID)
+	rc.mu.Lock()
+	rc.position, rc.inclusive = msgID, inclusive
+	rc.mu.Unlock()
+	return rc.consumer().Seek(msgID)
+}
+
+// SeekByTime is used to seek the position in pebblemq topic by the page ts index
+func (rc *Consumer) SeekByTime(t time.Time) error {
+	atomic.StoreInt32(&rc.skip, 0)
+	rc.mu.Lock()
+	rc.position, rc.seekTime = noPosition, t
+	rc.mu.Unlock()
+	return rc.consumer().SeekByTime(t)
 }
 
 // Ack is used to ask a pebblemq message
 func (rc *Consumer) Ack(message mqwrapper.Message) {
+	atomic.AddInt64(&rc.acked, 1)
+}
+
+// Stats returns the statistics of this consumer, the pending messages include
+// the ones buffered by the pebblemq consumer and by Chan
+func (rc *Consumer) Stats() mqwrapper.ConsumerStats {
+	inner := rc.consumer().Stats()
+	stats := mqwrapper.ConsumerStats{
+		Topic:        inner.Topic,
+		Subscription: inner.Subscription,
+		Received:     inner.Received,
+		Acked:        atomic.LoadInt64(&rc.acked),
+		Pending:      inner.Pending + len(rc.msgChannel),
+	}
+	if lastDispatch := atomic.LoadInt64(&rc.lastDispatch); lastDispatch > 0 {
+		stats.LastDispatchTime = time.Unix(0, lastDispatch)
+	}
+	return stats
 }
 
 // Close is used to free the resources of this consumer
@@ -98,10 +236,10 @@ func (rc *Consumer) Close() {
 }
 
 func (rc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
-	msgID, err := rc.c.GetLatestMsgID()
+	msgID, err := rc.consumer().GetLatestMsgID()
 	return &pmqID{messageID: msgID}, err
 }
 
 func (rc *Consumer) CheckTopicValid(topic string) error {
-	return rc.c.CheckTopicValid(topic)
+	return rc.consumer().CheckTopicValid(topic)
 }
@@ -17,6 +17,9 @@
 package pmq
 
 import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/client"
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/server"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
@@ -28,7 +31,7 @@ type pmqID struct {
 }
 
 // Check if pmqID implements MessageID interface
-var _ mqwrapper.MessageID = &pmqID{}
+var _ mqwrapper.RangeMessageID = &pmqID{}
 
 // Serialize convert pmq message id to []byte
 func (rid *pmqID) Serialize() []byte {
@@ -49,6 +52,22 @@ func (rid *pmqID) Equal(msgID []byte) (bool, error) {
 	return rid.messageID == rMsgID, nil
 }
 
+// Distance returns the id distance to the given id, since the ids are allocated from a
+// global allocator, it's the upper bound of the message number between them in one topic
+func (rid *pmqID) Distance(msgID []byte) (int64, error) {
+	if len(msgID) != 8 {
+		return 0, errors.Wrapf(mqwrapper.ErrInvalidMessageID, "pebblemq message id should be 8 bytes but got %d bytes", len(msgID))
+	}
+	rMsgID := DeserializePmqID(msgID)
+	return rMsgID - rid.messageID, nil
+}
+
+func (rid *pmqID) Add(n int64) mqwrapper.MessageID {
+	return &pmqID{
+		messageID: rid.messageID + n,
+	}
+}
+
 // SerializePmqID is used to serialize a message ID to byte array
 func SerializePmqID(messageID int64) []byte {
 	b := make([]byte, 8)
@@ -60,3 +79,12 @@ func SerializePmqID(messageID int64) []byte {
 func DeserializePmqID(messageID []byte) int64 {
 	return int64(common.Endian.Uint64(messageID))
 }
+
+// UnmarshalPmqID validates and deserializes the message id of pebblemq, which is not less than the earliest one
+func UnmarshalPmqID(messageID []byte) (mqwrapper.MessageID, error) {
+	id, err := mqwrapper.UnmarshalInt64MessageID("pebblemq", messageID, client.EarliestMessageID())
+	if err != nil {
+		return nil, err
+	}
+	return &pmqID{messageID: id}, nil
+}
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
 
 func TestPmqID_Serialize(t *testing.T) {
@@ -100,3 +102,45 @@ func Test_DeserializePmqID(t *testing.T) {
 	id := DeserializePmqID(bin)
 	assert.Equal(t, id, int64(5))
 }
+
+func Test_Distance(t *testing.T) {
+	rid1 := &pmqID{
+		messageID: 5,
+	}
+	rid2 := &pmqID{
+		messageID: 15,
+	}
+
+	d, err := rid1.Distance(rid2.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), d)
+
+	d, err = rid2.Distance(rid1.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), d)
+
+	d, err = mqwrapper.Distance(rid1, rid1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), d)
+
+	_, err = rid1.Distance([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
+}
+
+func Test_Add(t *testing.T) {
+	rid := &pmqID{
+		messageID: 5,
+	}
+
+	next := rid.Add(10)
+	assert.Equal(t, int64(15), DeserializePmqID(next.Serialize()))
+	assert.Equal(t, int64(5), rid.messageID)
+
+	prev, err := mqwrapper.Add(rid, -5)
+	assert.NoError(t, err)
+	assert.True(t, prev.AtEarliestPosition())
+
+	d, err := mqwrapper.Distance(prev, next)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15), d)
+}
@@ -13,10 +13,16 @@ package pmq
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/pebblemq/client"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
@@ -24,21 +30,68 @@ var _ mqwrapper.Producer = (*pmqProducer)(nil)
 
 // pmqProducer contains a pebblemq producer
 type pmqProducer struct {
+	client  *pmqClient
+	options client.ProducerOptions
+
+	mu sync.Mutex
+	// c is the pebblemq client p is created by
+	c client.Client
 	p client.Producer
 }
 
 // Topic returns the topic of pmq producer
 func (rp *pmqProducer) Topic() string {
-	return rp.p.Topic()
+	return rp.options.Topic
+}
+
+func (rp *pmqProducer) producer() (client.Client, client.Producer) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	return rp.c, rp.p
+}
+
+// reconnect recreates the producer on the client reconnected to the restarted pebblemq
+func (rp *pmqProducer) reconnect(stale client.Client) (client.Producer, error) {
+	c, err := rp.client.reconnect(stale)
+	if err != nil {
+		return nil, err
+	}
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.c == c {
+		return rp.p, nil
+	}
+	p, err := c.CreateProducer(rp.options)
+	if err != nil {
+		return nil, err
+	}
+	rp.c, rp.p = c, p
+	return p, nil
 }
 
 // Send send the producer messages to pebblemq
 func (rp *pmqProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
 	start := timerecord.NewTimeRecorder("send msg to stream")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
 
 	pm := &client.ProducerMessage{Payload: message.Payload, Properties: message.Properties}
-	id, err := rp.p.Send(pm)
+	c, p := rp.producer()
+	id, err := p.Send(pm)
+	if isNotServing(err) {
+		// resend once to the restarted pebblemq
+		if p, err = rp.reconnect(c); err == nil {
+			id, err = p.Send(pm)
+		}
+	}
+	if errors.Is(err, merr.ErrServiceDiskLimitExceeded) {
+		// produce is rejected until the disk watchdog finds enough free space
+		err = mqwrapper.NewBackpressureError(err, paramtable.Get().PebblemqCfg.DiskWatchdogInterval.GetAsDuration(time.Second))
+	}
+	if isWriteStall(err) {
+		// produce is rejected until pebble compacts the L0 and the writes resume
+		err = mqwrapper.NewBackpressureError(err, paramtable.Get().PebblemqCfg.WriteStallMaxDelay.GetAsDuration(time.Millisecond))
+	}
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
 		return &pmqID{messageID: id}, err
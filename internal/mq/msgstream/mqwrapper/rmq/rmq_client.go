@@ -122,8 +122,7 @@ func (rc *rmqClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
 
 // BytesToMsgID converts a byte array to messageID
 func (rc *rmqClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
-	rID := DeserializeRmqID(id)
-	return &rmqID{messageID: rID}, nil
+	return UnmarshalRmqID(id)
 }
 
 func (rc *rmqClient) Close() {
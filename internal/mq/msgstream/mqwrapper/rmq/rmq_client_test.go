@@ -237,12 +237,16 @@ func TestRmqClient_BytesToMsgID(t *testing.T) {
 	client, _ := createRmqClient()
 	defer client.Close()
 
+	res, err := client.BytesToMsgID(SerializeRmqID(5))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), res.(*rmqID).messageID)
+
+	// position written by pulsar
 	mid := pulsar.EarliestMessageID()
 	binary := pulsarwrapper.SerializePulsarMsgID(mid)
-
-	res, err := client.BytesToMsgID(binary)
-	assert.NoError(t, err)
-	assert.NotNil(t, res)
+	res, err = client.BytesToMsgID(binary)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
 }
 
 func createRmqClient() (*rmqClient, error) {
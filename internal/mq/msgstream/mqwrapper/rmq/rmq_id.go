@@ -17,6 +17,9 @@
 package rmq
 
 import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/client"
 	"github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
@@ -28,7 +31,7 @@ type rmqID struct {
 }
 
 // Check if rmqID implements MessageID interface
-var _ mqwrapper.MessageID = &rmqID{}
+var _ mqwrapper.RangeMessageID = &rmqID{}
 
 // Serialize convert rmq message id to []byte
 func (rid *rmqID) Serialize() []byte {
@@ -49,6 +52,21 @@ func (rid *rmqID) Equal(msgID []byte) (bool, error) {
 	return rid.messageID == rMsgID, nil
 }
 
+// Distance returns the id distance to the given id, since the ids are allocated from a
+// global allocator, it's the upper bound of the message number between them in one topic
+func (rid *rmqID) Distance(msgID []byte) (int64, error) {
+	if len(msgID) != 8 {
+		return 0, errors.Wrapf(mqwrapper.ErrInvalidMessageID, "rocksmq message id should be 8 bytes but got %d bytes", len(msgID))
+	}
+	return DeserializeRmqID(msgID) - rid.messageID, nil
+}
+
+func (rid *rmqID) Add(n int64) mqwrapper.MessageID {
+	return &rmqID{
+		messageID: rid.messageID + n,
+	}
+}
+
 // SerializeRmqID is used to serialize a message ID to byte array
 func SerializeRmqID(messageID int64) []byte {
 	b := make([]byte, 8)
@@ -60,3 +78,12 @@ func SerializeRmqID(messageID int64) []byte {
 func DeserializeRmqID(messageID []byte) int64 {
 	return int64(common.Endian.Uint64(messageID))
 }
+
+// UnmarshalRmqID validates and deserializes the message id of rocksmq, which is not less than the earliest one
+func UnmarshalRmqID(messageID []byte) (mqwrapper.MessageID, error) {
+	id, err := mqwrapper.UnmarshalInt64MessageID("rocksmq", messageID, client.EarliestMessageID())
+	if err != nil {
+		return nil, err
+	}
+	return &rmqID{messageID: id}, nil
+}
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
 
 func TestRmqID_Serialize(t *testing.T) {
@@ -99,3 +101,30 @@ func Test_DeserializeRmqID(t *testing.T) {
 	id := DeserializeRmqID(bin)
 	assert.Equal(t, id, int64(5))
 }
+
+func Test_Distance(t *testing.T) {
+	id1 := &rmqID{messageID: 5}
+	id2 := &rmqID{messageID: 15}
+
+	d, err := id1.Distance(id2.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), d)
+
+	d, err = id2.Distance(id1.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), d)
+
+	_, err = id1.Distance([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
+}
+
+func Test_Add(t *testing.T) {
+	id := &rmqID{messageID: 5}
+
+	next := id.Add(10)
+	assert.Equal(t, int64(15), DeserializeRmqID(next.Serialize()))
+	assert.Equal(t, int64(5), id.messageID)
+
+	prev := id.Add(-2)
+	assert.Equal(t, int64(3), DeserializeRmqID(prev.Serialize()))
+}
@@ -36,6 +36,7 @@ func (rp *rmqProducer) Topic() string {
 func (rp *rmqProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
 	start := timerecord.NewTimeRecorder("send msg to stream")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
 
 	pm := &client.ProducerMessage{Payload: message.Payload, Properties: message.Properties}
 	id, err := rp.p.Send(pm)
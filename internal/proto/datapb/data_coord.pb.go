@@ -5206,314 +5206,315 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x6f, 0x1c, 0x47,
-	0x7a, 0xea, 0x79, 0x71, 0xe6, 0x1b, 0x72, 0x38, 0x2c, 0xc9, 0xd4, 0x68, 0x64, 0x3d, 0xdc, 0x92,
-	0x6c, 0x5a, 0xb6, 0x29, 0x9b, 0xca, 0x62, 0xbd, 0xf6, 0xda, 0xbb, 0x22, 0x69, 0xc9, 0x93, 0x88,
-	0x5a, 0x6e, 0x93, 0x92, 0x03, 0x6f, 0x80, 0x41, 0x73, 0xba, 0x38, 0xec, 0x65, 0x4f, 0xf7, 0xb8,
-	0xbb, 0x87, 0x14, 0x1d, 0x20, 0xeb, 0x4d, 0x36, 0x01, 0xf2, 0x40, 0x12, 0xe4, 0x01, 0x24, 0xb7,
-	0x24, 0x87, 0x60, 0x93, 0x60, 0x4f, 0x49, 0xb0, 0x40, 0x10, 0x64, 0xaf, 0x1b, 0xe4, 0x10, 0x04,
-	0x01, 0x82, 0xe4, 0x90, 0x1f, 0x90, 0x73, 0xfe, 0x40, 0x50, 0x8f, 0xae, 0x7e, 0x55, 0xf7, 0x34,
-	0x39, 0x92, 0x05, 0x64, 0x6f, 0x53, 0xd5, 0x5f, 0x55, 0x7d, 0xf5, 0xd5, 0xf7, 0xfe, 0xaa, 0x06,
-	0xda, 0x86, 0xee, 0xeb, 0xfd, 0x81, 0xe3, 0xb8, 0xc6, 0xea, 0xd8, 0x75, 0x7c, 0x07, 0x2d, 0x8d,
-	0x4c, 0xeb, 0x68, 0xe2, 0xb1, 0xd6, 0x2a, 0xf9, 0xdc, 0x9d, 0x1f, 0x38, 0xa3, 0x91, 0x63, 0xb3,
-	0xae, 0x6e, 0xcb, 0xb4, 0x7d, 0xec, 0xda, 0xba, 0xc5, 0xdb, 0xf3, 0xd1, 0x01, 0xdd, 0x79, 0x6f,
-	0x70, 0x80, 0x47, 0x3a, 0x6f, 0x35, 0x46, 0xde, 0x90, 0xff, 0x5c, 0x32, 0x6d, 0x03, 0x3f, 0x8d,
-	0x2e, 0xa5, 0xce, 0x41, 0xf5, 0xa3, 0xd1, 0xd8, 0x3f, 0x51, 0xff, 0x4e, 0x81, 0xf9, 0xfb, 0xd6,
-	0xc4, 0x3b, 0xd0, 0xf0, 0x67, 0x13, 0xec, 0xf9, 0xe8, 0x6d, 0xa8, 0xec, 0xe9, 0x1e, 0xee, 0x28,
-	0xd7, 0x95, 0x95, 0xe6, 0xda, 0xcb, 0xab, 0x31, 0x9c, 0x38, 0x36, 0x5b, 0xde, 0x70, 0x5d, 0xf7,
-	0xb0, 0x46, 0x21, 0x11, 0x82, 0x8a, 0xb1, 0xd7, 0xdb, 0xec, 0x94, 0xae, 0x2b, 0x2b, 0x65, 0x8d,
-	0xfe, 0x46, 0x57, 0x01, 0x3c, 0x3c, 0x1c, 0x61, 0xdb, 0xef, 0x6d, 0x7a, 0x9d, 0xf2, 0xf5, 0xf2,
-	0x4a, 0x59, 0x8b, 0xf4, 0x20, 0x15, 0xe6, 0x07, 0x8e, 0x65, 0xe1, 0x81, 0x6f, 0x3a, 0x76, 0x6f,
-	0xb3, 0x53, 0xa1, 0x63, 0x63, 0x7d, 0xa8, 0x0b, 0x75, 0xd3, 0xeb, 0x8d, 0xc6, 0x8e, 0xeb, 0x77,
-	0xaa, 0xd7, 0x95, 0x95, 0xba, 0x26, 0xda, 0xea, 0xf7, 0x4b, 0xb0, 0xc0, 0xd1, 0xf6, 0xc6, 0x8e,
-	0xed, 0x61, 0x74, 0x17, 0x6a, 0x9e, 0xaf, 0xfb, 0x13, 0x8f, 0x63, 0x7e, 0x59, 0x8a, 0xf9, 0x0e,
-	0x05, 0xd1, 0x38, 0xa8, 0x14, 0xf5, 0x24, 0x6a, 0x65, 0x09, 0x6a, 0xf1, 0xed, 0x55, 0x52, 0xdb,
-	0x5b, 0x81, 0xc5, 0x7d, 0x82, 0xdd, 0x4e, 0x08, 0x54, 0xa5, 0x40, 0xc9, 0x6e, 0x32, 0x93, 0x6f,
-	0x8e, 0xf0, 0xb7, 0xf6, 0x77, 0xb0, 0x6e, 0x75, 0x6a, 0x74, 0xad, 0x48, 0x0f, 0xba, 0x04, 0x75,
-	0x3a, 0xa4, 0xef, 0x7b, 0x9d, 0xb9, 0xeb, 0xca, 0x4a, 0x45, 0x9b, 0xa3, 0xed, 0x5d, 0x4f, 0xfd,
-	0x1e, 0x5c, 0xa0, 0x24, 0xd8, 0x38, 0xd0, 0x6d, 0x1b, 0x5b, 0xde, 0xd9, 0x4f, 0x30, 0xba, 0x48,
-	0x29, 0xb6, 0x08, 0x39, 0x84, 0x01, 0x9f, 0x9f, 0x1e, 0x63, 0x43, 0x13, 0x6d, 0xf5, 0xdf, 0x14,
-	0x68, 0x8b, 0xad, 0x04, 0xab, 0x5f, 0x80, 0xea, 0xc0, 0x99, 0xd8, 0x3e, 0x5d, 0x7e, 0x41, 0x63,
-	0x0d, 0xf4, 0x0a, 0xcc, 0xf3, 0x61, 0x7d, 0x5b, 0x1f, 0x61, 0xba, 0x4a, 0x43, 0x6b, 0xf2, 0xbe,
-	0x47, 0xfa, 0x08, 0x17, 0xa2, 0xfb, 0x75, 0x68, 0x8e, 0x75, 0xd7, 0x37, 0x63, 0x5c, 0x13, 0xed,
-	0xca, 0x63, 0x1a, 0xb2, 0x82, 0x49, 0x7f, 0xed, 0xea, 0xde, 0x61, 0x6f, 0x93, 0x53, 0x3b, 0xd6,
-	0xa7, 0xfe, 0x99, 0x02, 0xcb, 0xf7, 0x3c, 0xcf, 0x1c, 0xda, 0xa9, 0x9d, 0x2d, 0x43, 0xcd, 0x76,
-	0x0c, 0xdc, 0xdb, 0xa4, 0x5b, 0x2b, 0x6b, 0xbc, 0x85, 0x2e, 0x43, 0x63, 0x8c, 0xb1, 0xdb, 0x77,
-	0x1d, 0x2b, 0xd8, 0x58, 0x9d, 0x74, 0x68, 0x8e, 0x85, 0xd1, 0xb7, 0x61, 0xc9, 0x4b, 0x4c, 0xc4,
-	0x08, 0xd9, 0x5c, 0xbb, 0xb1, 0x9a, 0x92, 0xf7, 0xd5, 0xe4, 0xa2, 0x5a, 0x7a, 0xb4, 0xfa, 0x45,
-	0x09, 0xce, 0x0b, 0x38, 0x86, 0x2b, 0xf9, 0x4d, 0x28, 0xef, 0xe1, 0xa1, 0x40, 0x8f, 0x35, 0x8a,
-	0x50, 0x5e, 0x1c, 0x59, 0x39, 0x7a, 0x64, 0x45, 0x44, 0x34, 0x71, 0x1e, 0xd5, 0xf4, 0x79, 0x5c,
-	0x83, 0x26, 0x7e, 0x3a, 0x36, 0x5d, 0xdc, 0x27, 0x4c, 0x4d, 0x49, 0x5e, 0xd1, 0x80, 0x75, 0xed,
-	0x9a, 0xa3, 0xa8, 0xdc, 0xce, 0x15, 0x96, 0x5b, 0xf5, 0x2f, 0x14, 0xb8, 0x98, 0x3a, 0x25, 0xae,
-	0x08, 0x34, 0x68, 0xd3, 0x9d, 0x87, 0x94, 0x21, 0x2a, 0x81, 0x10, 0xfc, 0xd5, 0x3c, 0x82, 0x87,
-	0xe0, 0x5a, 0x6a, 0x7c, 0x04, 0xc9, 0x52, 0x71, 0x24, 0x0f, 0xe1, 0xe2, 0x03, 0xec, 0xf3, 0x05,
-	0xc8, 0x37, 0x3c, 0x83, 0x88, 0xc6, 0x35, 0x4e, 0x29, 0xa9, 0x71, 0xd4, 0xbf, 0x2c, 0x09, 0x59,
-	0xa4, 0x4b, 0xf5, 0xec, 0x7d, 0x07, 0xbd, 0x0c, 0x0d, 0x01, 0xc2, 0xb9, 0x22, 0xec, 0x40, 0x5f,
-	0x85, 0x2a, 0xc1, 0x94, 0xb1, 0x44, 0x6b, 0xed, 0x15, 0xf9, 0x9e, 0x22, 0x73, 0x6a, 0x0c, 0x1e,
-	0x6d, 0x42, 0xcb, 0xf3, 0x75, 0xd7, 0xef, 0x8f, 0x1d, 0x8f, 0x9e, 0x33, 0x65, 0x9c, 0xe6, 0xda,
-	0x95, 0xf8, 0x0c, 0xc4, 0x00, 0x6d, 0x79, 0xc3, 0x6d, 0x0e, 0xa4, 0x2d, 0xd0, 0x41, 0x41, 0x13,
-	0x7d, 0x13, 0xe6, 0xb1, 0x6d, 0x84, 0x73, 0x54, 0x8a, 0xcc, 0xd1, 0xc4, 0xb6, 0x21, 0x66, 0x08,
-	0x4f, 0xa5, 0x5a, 0xfc, 0x54, 0x7e, 0x47, 0x81, 0x4e, 0xfa, 0x58, 0x66, 0x31, 0x22, 0xef, 0xb3,
-	0x41, 0x98, 0x1d, 0x4b, 0xae, 0x5c, 0x8b, 0xa3, 0xd1, 0xf8, 0x10, 0xf5, 0x8f, 0x15, 0x78, 0x29,
-	0x44, 0x87, 0x7e, 0x7a, 0x5e, 0x3c, 0x82, 0x6e, 0x43, 0xdb, 0xb4, 0x07, 0xd6, 0xc4, 0xc0, 0x8f,
-	0xed, 0x8f, 0xb1, 0x6e, 0xf9, 0x07, 0x27, 0xf4, 0xe4, 0xea, 0x5a, 0xaa, 0x5f, 0xfd, 0xaf, 0x12,
-	0x2c, 0x27, 0xf1, 0x9a, 0x85, 0x48, 0x3f, 0x07, 0x55, 0xd3, 0xde, 0x77, 0x02, 0x1a, 0x5d, 0xcd,
-	0x11, 0x45, 0xb2, 0x16, 0x03, 0x46, 0x0e, 0xa0, 0x40, 0x79, 0x0d, 0x0e, 0xf0, 0xe0, 0x70, 0xec,
-	0x98, 0x54, 0x4d, 0x91, 0x29, 0xbe, 0x29, 0x99, 0x42, 0x8e, 0xf1, 0x2a, 0xb7, 0x90, 0x1b, 0x62,
-	0x8a, 0x8f, 0x6c, 0xdf, 0x3d, 0xd1, 0x96, 0x06, 0xc9, 0xfe, 0xee, 0x00, 0x96, 0xe5, 0xc0, 0xa8,
-	0x0d, 0xe5, 0x43, 0x7c, 0x42, 0xb7, 0xdc, 0xd0, 0xc8, 0x4f, 0x74, 0x17, 0xaa, 0x47, 0xba, 0x35,
-	0xc1, 0x5c, 0x27, 0x4c, 0xe1, 0x5c, 0x06, 0xfb, 0x5e, 0xe9, 0x5d, 0x45, 0x1d, 0xc1, 0xe5, 0x07,
-	0xd8, 0xef, 0xd9, 0x1e, 0x76, 0xfd, 0x75, 0xd3, 0xb6, 0x9c, 0xe1, 0xb6, 0xee, 0x1f, 0xcc, 0xa0,
-	0x1c, 0x62, 0x72, 0x5e, 0x4a, 0xc8, 0xb9, 0xfa, 0x43, 0x05, 0x5e, 0x96, 0xaf, 0xc7, 0x0f, 0xb4,
-	0x0b, 0xf5, 0x7d, 0x13, 0x5b, 0x06, 0xe1, 0x1a, 0x85, 0x72, 0x8d, 0x68, 0x13, 0x25, 0x31, 0x26,
-	0xc0, 0xfc, 0xdc, 0x12, 0x4a, 0x42, 0xf8, 0xa3, 0x3b, 0xbe, 0x6b, 0xda, 0xc3, 0x87, 0xa6, 0xe7,
-	0x6b, 0x0c, 0x3e, 0xc2, 0x25, 0xe5, 0xe2, 0xc2, 0xf9, 0x5b, 0x0a, 0x5c, 0x7d, 0x80, 0xfd, 0x0d,
-	0x61, 0x63, 0xc8, 0x77, 0xd3, 0xf3, 0xcd, 0x81, 0xf7, 0x6c, 0xfd, 0xd3, 0x02, 0xce, 0x86, 0xfa,
-	0x7b, 0x0a, 0x5c, 0xcb, 0x44, 0x86, 0x93, 0x8e, 0xeb, 0xd0, 0xc0, 0xc2, 0xc8, 0x75, 0xe8, 0x2f,
-	0xe0, 0x93, 0x27, 0xe4, 0xf0, 0xb7, 0x75, 0xd3, 0x65, 0x3a, 0xf4, 0x8c, 0x16, 0xe5, 0x47, 0x0a,
-	0x5c, 0x79, 0x80, 0xfd, 0xed, 0xc0, 0xbe, 0xbe, 0x40, 0xea, 0x10, 0x98, 0x88, 0x9d, 0x0f, 0x9c,
-	0xe0, 0x58, 0x9f, 0xfa, 0xbb, 0xec, 0x38, 0xa5, 0xf8, 0xbe, 0x10, 0x02, 0x5e, 0xa5, 0x92, 0x10,
-	0x51, 0x11, 0x5c, 0xd8, 0x39, 0xf9, 0xd4, 0x1f, 0x54, 0x61, 0xfe, 0x09, 0xd7, 0x0a, 0xd4, 0x82,
-	0x26, 0x29, 0xa1, 0xc8, 0x9d, 0xa0, 0x88, 0x37, 0x25, 0x73, 0xb0, 0xd6, 0x61, 0xc1, 0xc3, 0xf8,
-	0xf0, 0x94, 0xf6, 0x72, 0x9e, 0x8c, 0x11, 0xc6, 0xee, 0x21, 0x2c, 0x4d, 0x6c, 0xea, 0x95, 0x63,
-	0x83, 0x6f, 0x80, 0x11, 0x7d, 0xba, 0x32, 0x4d, 0x0f, 0x44, 0x1f, 0xf3, 0x00, 0x25, 0x32, 0x57,
-	0xb5, 0xd0, 0x5c, 0xc9, 0x61, 0xa8, 0x07, 0x6d, 0xc3, 0x75, 0xc6, 0x63, 0x6c, 0xf4, 0xbd, 0x60,
-	0xaa, 0x5a, 0xb1, 0xa9, 0xf8, 0x38, 0x31, 0xd5, 0xdb, 0x70, 0x3e, 0x89, 0x69, 0xcf, 0x20, 0x7e,
-	0x21, 0xe1, 0x2c, 0xd9, 0x27, 0xf4, 0x26, 0x2c, 0xa5, 0xe1, 0xeb, 0x14, 0x3e, 0xfd, 0x01, 0xbd,
-	0x05, 0x28, 0x81, 0x2a, 0x01, 0x6f, 0x30, 0xf0, 0x38, 0x32, 0x1c, 0x9c, 0x06, 0xce, 0x71, 0x70,
-	0x60, 0xe0, 0xfc, 0x4b, 0x04, 0xbc, 0x47, 0xac, 0x6b, 0x0c, 0xdc, 0xeb, 0x34, 0x8b, 0x11, 0x22,
-	0x3e, 0x99, 0xa7, 0xfe, 0xa6, 0x02, 0xcb, 0x9f, 0xe8, 0xfe, 0xe0, 0x60, 0x73, 0x34, 0x7b, 0x70,
-	0xf7, 0x01, 0x34, 0x8e, 0x44, 0x08, 0xc7, 0xb4, 0xf8, 0x35, 0x09, 0x42, 0x51, 0xb6, 0xd7, 0xc2,
-	0x11, 0x24, 0x20, 0x62, 0x61, 0x66, 0x80, 0xdd, 0x97, 0xaf, 0x6a, 0xa6, 0x44, 0xdb, 0xea, 0x53,
-	0x00, 0x8e, 0xdc, 0x96, 0x37, 0x3c, 0x03, 0x5e, 0xef, 0xc2, 0x1c, 0x9f, 0x8d, 0xeb, 0x92, 0x69,
-	0x07, 0x16, 0x80, 0xab, 0xff, 0x54, 0x83, 0x66, 0xe4, 0x03, 0x6a, 0x41, 0x49, 0x28, 0x89, 0x92,
-	0x64, 0x77, 0xa5, 0xe9, 0x31, 0x54, 0x39, 0x1d, 0x43, 0xdd, 0x82, 0x96, 0x49, 0x8d, 0x77, 0x9f,
-	0x9f, 0x0a, 0xf5, 0x95, 0x1b, 0xda, 0x02, 0xeb, 0xe5, 0x2c, 0x82, 0xae, 0x42, 0xd3, 0x9e, 0x8c,
-	0xfa, 0xce, 0x7e, 0xdf, 0x75, 0x8e, 0x3d, 0x1e, 0x8c, 0x35, 0xec, 0xc9, 0xe8, 0x5b, 0xfb, 0x9a,
-	0x73, 0xec, 0x85, 0xfe, 0x7e, 0xed, 0x94, 0xfe, 0xfe, 0x55, 0x68, 0x8e, 0xf4, 0xa7, 0x64, 0xd6,
-	0xbe, 0x3d, 0x19, 0xd1, 0x38, 0xad, 0xac, 0x35, 0x46, 0xfa, 0x53, 0xcd, 0x39, 0x7e, 0x34, 0x19,
-	0xa1, 0x15, 0x68, 0x5b, 0xba, 0xe7, 0xf7, 0xa3, 0x81, 0x5e, 0x9d, 0x06, 0x7a, 0x2d, 0xd2, 0xff,
-	0x51, 0x18, 0xec, 0xa5, 0x23, 0x87, 0xc6, 0xd9, 0x22, 0x07, 0x63, 0x64, 0x85, 0x73, 0x40, 0xa1,
-	0xc8, 0xc1, 0x18, 0x59, 0x62, 0x86, 0x77, 0x61, 0x6e, 0x8f, 0x3a, 0x42, 0x79, 0x22, 0x7a, 0x9f,
-	0xf8, 0x40, 0xcc, 0x5f, 0xd2, 0x02, 0x70, 0xf4, 0x75, 0x68, 0x50, 0xfb, 0x43, 0xc7, 0xce, 0x17,
-	0x1a, 0x1b, 0x0e, 0x20, 0xa3, 0x0d, 0x6c, 0xf9, 0x3a, 0x1d, 0xbd, 0x50, 0x6c, 0xb4, 0x18, 0x40,
-	0xf4, 0xe3, 0xc0, 0xc5, 0xba, 0x8f, 0x8d, 0xf5, 0x93, 0x0d, 0x67, 0x34, 0xd6, 0x29, 0x0b, 0x75,
-	0x5a, 0xd4, 0x85, 0x97, 0x7d, 0x42, 0xaf, 0x42, 0x6b, 0x20, 0x5a, 0xf7, 0x5d, 0x67, 0xd4, 0x59,
-	0xa4, 0xd2, 0x93, 0xe8, 0x45, 0x57, 0x00, 0x02, 0xcd, 0xa8, 0xfb, 0x9d, 0x36, 0x3d, 0xbb, 0x06,
-	0xef, 0xb9, 0x47, 0xb3, 0x37, 0xa6, 0xd7, 0x67, 0x79, 0x12, 0xd3, 0x1e, 0x76, 0x96, 0xe8, 0x8a,
-	0xcd, 0x20, 0xb1, 0x62, 0xda, 0x43, 0x74, 0x11, 0xe6, 0x4c, 0xaf, 0xbf, 0xaf, 0x1f, 0xe2, 0x0e,
-	0xa2, 0x5f, 0x6b, 0xa6, 0x77, 0x5f, 0x3f, 0xa4, 0xbe, 0x29, 0x5f, 0x0c, 0x1b, 0x9d, 0xf3, 0xf4,
-	0x53, 0xd8, 0xa1, 0x7e, 0x0e, 0x17, 0x42, 0x8e, 0x8b, 0x1c, 0x71, 0x9a, 0x51, 0x94, 0x33, 0x30,
-	0x4a, 0xbe, 0x5f, 0xfc, 0x3f, 0x15, 0x58, 0xde, 0xd1, 0x8f, 0xf0, 0xf3, 0x77, 0xc1, 0x0b, 0x69,
-	0xb9, 0x87, 0xb0, 0x44, 0xbd, 0xee, 0xb5, 0x08, 0x3e, 0x39, 0x06, 0x3e, 0xca, 0x23, 0xe9, 0x81,
-	0xe8, 0x1b, 0xc4, 0x29, 0xc1, 0x83, 0xc3, 0x6d, 0x12, 0xc1, 0x04, 0xc6, 0xfd, 0x8a, 0x64, 0x9e,
-	0x0d, 0x01, 0xa5, 0x45, 0x47, 0xa0, 0x6d, 0x58, 0x8c, 0x9f, 0x40, 0x60, 0xd6, 0x5f, 0xcb, 0x0d,
-	0x6f, 0x43, 0xea, 0x6b, 0xad, 0xd8, 0x61, 0x78, 0xa8, 0x03, 0x73, 0xdc, 0x26, 0x53, 0x15, 0x52,
-	0xd7, 0x82, 0x26, 0xda, 0x86, 0xf3, 0x6c, 0x07, 0x3b, 0x5c, 0x52, 0xd8, 0xe6, 0xeb, 0x85, 0x36,
-	0x2f, 0x1b, 0x1a, 0x17, 0xb4, 0xc6, 0x69, 0x05, 0xad, 0x03, 0x73, 0x9c, 0xf9, 0xa9, 0x6e, 0xa9,
-	0x6b, 0x41, 0x93, 0x1c, 0x73, 0x28, 0x06, 0x4d, 0xc6, 0xcd, 0xa2, 0x83, 0x8c, 0x0b, 0x34, 0xf4,
-	0x3c, 0xd5, 0xd0, 0x41, 0x53, 0xfd, 0x75, 0x05, 0x20, 0xa4, 0xf4, 0x94, 0xc4, 0xcc, 0xd7, 0xa0,
-	0x2e, 0xd8, 0xbe, 0x50, 0x6c, 0x29, 0xc0, 0x93, 0x36, 0xa0, 0x9c, 0xb0, 0x01, 0xea, 0xbf, 0x28,
-	0x30, 0xbf, 0x49, 0xf6, 0xf9, 0xd0, 0x19, 0x52, 0x8b, 0x75, 0x0b, 0x5a, 0x2e, 0x1e, 0x38, 0xae,
-	0xd1, 0xc7, 0xb6, 0xef, 0x9a, 0x98, 0x05, 0xf5, 0x15, 0x6d, 0x81, 0xf5, 0x7e, 0xc4, 0x3a, 0x09,
-	0x18, 0x51, 0xeb, 0x9e, 0xaf, 0x8f, 0xc6, 0xfd, 0x7d, 0xa2, 0x48, 0x58, 0x9e, 0x78, 0x41, 0xf4,
-	0x52, 0x3d, 0xf2, 0x0a, 0xcc, 0x87, 0x60, 0xbe, 0x43, 0xd7, 0xaf, 0x68, 0x4d, 0xd1, 0xb7, 0xeb,
-	0xa0, 0x9b, 0xd0, 0xa2, 0x84, 0xee, 0x5b, 0xce, 0xb0, 0x4f, 0x42, 0x45, 0x6e, 0xcc, 0xe6, 0x0d,
-	0x8e, 0x16, 0x39, 0xc0, 0x38, 0x94, 0x67, 0x7e, 0x8e, 0xb9, 0x39, 0x13, 0x50, 0x3b, 0xe6, 0xe7,
-	0x58, 0xfd, 0x35, 0x05, 0x16, 0xb8, 0xf5, 0xdb, 0x11, 0x09, 0x7d, 0x9a, 0xe5, 0x64, 0x61, 0x3a,
-	0xfd, 0x8d, 0xde, 0x8b, 0xe7, 0xb9, 0x6e, 0x4a, 0x85, 0x80, 0x4e, 0x42, 0x7d, 0xae, 0x98, 0xe9,
-	0x2b, 0x12, 0x27, 0x7e, 0x41, 0x68, 0xaa, 0xfb, 0xfa, 0x23, 0xc7, 0x60, 0x69, 0xb7, 0x0e, 0xcc,
-	0xe9, 0x86, 0xe1, 0x62, 0xcf, 0xe3, 0x78, 0x04, 0x4d, 0xf2, 0xe5, 0x08, 0xbb, 0x5e, 0x70, 0xb0,
-	0x65, 0x2d, 0x68, 0xa2, 0xaf, 0x27, 0xf2, 0xec, 0xcd, 0xb5, 0xeb, 0xd9, 0x78, 0xf2, 0xa8, 0x26,
-	0xcc, 0xc4, 0xff, 0x7d, 0x09, 0x5a, 0x5c, 0x06, 0xd7, 0xb9, 0xa1, 0xca, 0x67, 0xb1, 0x75, 0x98,
-	0xdf, 0x0f, 0x79, 0x3f, 0x2f, 0x2b, 0x13, 0x15, 0x91, 0xd8, 0x98, 0x69, 0xbc, 0x16, 0x37, 0x95,
-	0x95, 0x99, 0x4c, 0x65, 0xf5, 0xb4, 0x12, 0x9c, 0x76, 0x99, 0x6a, 0x12, 0x97, 0x49, 0xfd, 0x25,
-	0x68, 0x46, 0x26, 0xa0, 0x1a, 0x8a, 0x25, 0x3e, 0x38, 0xc5, 0x82, 0x26, 0xba, 0x1b, 0x3a, 0x0c,
-	0x8c, 0x54, 0x97, 0x24, 0xb8, 0x24, 0x7c, 0x05, 0xf5, 0x27, 0x0a, 0xd4, 0xf8, 0xcc, 0xd7, 0xa0,
-	0xc9, 0xe5, 0x8b, 0xba, 0x50, 0x6c, 0x76, 0xe0, 0x5d, 0xc4, 0x87, 0x7a, 0x76, 0x02, 0x76, 0x09,
-	0xea, 0x09, 0xd1, 0x9a, 0xe3, 0x6a, 0x31, 0xf8, 0x14, 0x91, 0x27, 0xf2, 0x89, 0x88, 0x12, 0xba,
-	0x00, 0x55, 0xcb, 0x19, 0x8a, 0xa2, 0x08, 0x6b, 0xa8, 0x3f, 0x55, 0x68, 0x0e, 0x5b, 0xc3, 0x03,
-	0xe7, 0x08, 0xbb, 0x27, 0xb3, 0xa7, 0x01, 0xdf, 0x8f, 0xb0, 0x79, 0xc1, 0x58, 0x44, 0x0c, 0x40,
-	0xef, 0x87, 0x87, 0x50, 0x96, 0x65, 0x0b, 0xa2, 0xa6, 0x88, 0x33, 0x69, 0x78, 0x18, 0xbf, 0xaf,
-	0xd0, 0x84, 0x66, 0x7c, 0x2b, 0x67, 0xb5, 0xf6, 0xcf, 0xc4, 0xaf, 0x57, 0xff, 0x59, 0x81, 0x4b,
-	0x19, 0xd4, 0x7d, 0xb2, 0xf6, 0x02, 0xe8, 0xfb, 0x1e, 0xd4, 0x45, 0xe4, 0x5a, 0x2e, 0x14, 0xb9,
-	0x0a, 0x78, 0xf5, 0x8f, 0x58, 0x5a, 0x5d, 0x42, 0xde, 0x27, 0x6b, 0xcf, 0x89, 0xc0, 0xc9, 0x0c,
-	0x54, 0x59, 0x92, 0x81, 0xfa, 0x57, 0x05, 0xba, 0x61, 0xc6, 0xc7, 0x5b, 0x3f, 0x99, 0xb5, 0x0e,
-	0xf3, 0x6c, 0x22, 0xba, 0xaf, 0x89, 0x92, 0x01, 0xd1, 0x8b, 0x85, 0x62, 0xb1, 0xa0, 0x60, 0x60,
-	0xd3, 0xe4, 0x71, 0x7a, 0x43, 0xb3, 0x48, 0x65, 0x37, 0x72, 0xf0, 0xac, 0x6c, 0x10, 0x1e, 0xec,
-	0x4f, 0x18, 0x93, 0xde, 0x8f, 0xa7, 0x7d, 0x5e, 0x34, 0x01, 0xa3, 0xa5, 0x8c, 0x03, 0x5e, 0xca,
-	0xa8, 0x24, 0x4a, 0x19, 0xbc, 0x5f, 0x1d, 0x51, 0x16, 0x48, 0x6d, 0xe0, 0x79, 0x11, 0xec, 0x37,
-	0x14, 0xe8, 0xf0, 0x55, 0x58, 0x79, 0xde, 0x19, 0x8d, 0x2d, 0xec, 0x63, 0xe3, 0xcb, 0x4e, 0x4e,
-	0xfc, 0x49, 0x09, 0xda, 0x51, 0xc7, 0x86, 0xfa, 0x26, 0x5f, 0x81, 0x2a, 0xcd, 0xed, 0x70, 0x0c,
-	0xa6, 0x6a, 0x07, 0x06, 0x4d, 0x2c, 0x23, 0xf5, 0xe6, 0x77, 0xbd, 0xc0, 0x71, 0xe1, 0xcd, 0xd0,
-	0xbb, 0x2a, 0x9f, 0xde, 0xbb, 0x7a, 0x19, 0x1a, 0xc4, 0x72, 0x39, 0x13, 0x32, 0x2f, 0xab, 0x2f,
-	0x87, 0x1d, 0xe8, 0x03, 0xa8, 0xb1, 0x1b, 0x2d, 0xbc, 0xbc, 0x77, 0x2b, 0x3e, 0x35, 0xbf, 0xed,
-	0x12, 0x49, 0xcf, 0xd3, 0x0e, 0x8d, 0x0f, 0x22, 0x67, 0x34, 0x76, 0x9d, 0x21, 0x75, 0xc3, 0x88,
-	0x51, 0xab, 0x6a, 0xa2, 0xad, 0xfe, 0x3c, 0x2c, 0x87, 0x51, 0x32, 0x43, 0xe9, 0xac, 0x0c, 0xad,
-	0xfe, 0x87, 0x02, 0xe7, 0x77, 0x4e, 0xec, 0x41, 0x52, 0x34, 0x96, 0xa1, 0x36, 0xb6, 0xf4, 0x30,
-	0x69, 0xcc, 0x5b, 0xb4, 0x20, 0x1f, 0xc4, 0xbf, 0xc4, 0x84, 0x33, 0x7a, 0x36, 0x45, 0xdf, 0xae,
-	0x33, 0xd5, 0xb3, 0xba, 0x25, 0xc2, 0x7a, 0x6c, 0x30, 0x67, 0x81, 0x25, 0xc5, 0x16, 0x44, 0x2f,
-	0x75, 0x16, 0x3e, 0x00, 0xa0, 0xfe, 0x54, 0xff, 0x34, 0x3e, 0x14, 0x1d, 0xf1, 0x90, 0x58, 0xcc,
-	0xbf, 0x2d, 0x41, 0x27, 0x42, 0xa5, 0x2f, 0xdb, 0xbd, 0xcc, 0x08, 0x0a, 0xcb, 0xcf, 0x28, 0x28,
-	0xac, 0xcc, 0xee, 0x52, 0x56, 0x65, 0x2e, 0xe5, 0xf7, 0xcb, 0xd0, 0x0a, 0xa9, 0xb6, 0x6d, 0xe9,
-	0x76, 0x26, 0x27, 0xec, 0x40, 0xcb, 0x8b, 0x51, 0x95, 0xd3, 0xe9, 0x0d, 0x99, 0x0c, 0x65, 0x1c,
-	0x84, 0x96, 0x98, 0x02, 0x5d, 0xa1, 0x87, 0xee, 0xfa, 0x2c, 0x0d, 0xc7, 0xfc, 0xc3, 0x06, 0x13,
-	0x56, 0x73, 0x84, 0xd1, 0x9b, 0x80, 0xb8, 0x84, 0xf5, 0x4d, 0xbb, 0xef, 0xe1, 0x81, 0x63, 0x1b,
-	0x4c, 0xf6, 0xaa, 0x5a, 0x9b, 0x7f, 0xe9, 0xd9, 0x3b, 0xac, 0x1f, 0x7d, 0x05, 0x2a, 0xfe, 0xc9,
-	0x98, 0x39, 0x8b, 0x2d, 0xa9, 0xbb, 0x15, 0xe2, 0xb5, 0x7b, 0x32, 0xc6, 0x1a, 0x05, 0x0f, 0x2e,
-	0x35, 0xf9, 0xae, 0x7e, 0xc4, 0x3d, 0xef, 0x8a, 0x16, 0xe9, 0x89, 0xc6, 0xc9, 0x73, 0xb1, 0x38,
-	0x99, 0x71, 0x76, 0x20, 0xd0, 0x7d, 0xdf, 0xb7, 0x68, 0x22, 0x91, 0x72, 0x76, 0xd0, 0xbb, 0xeb,
-	0x5b, 0x64, 0x93, 0xbe, 0xe3, 0xeb, 0x16, 0x93, 0x8f, 0x06, 0xd7, 0x1c, 0xa4, 0x87, 0x46, 0xb9,
-	0xff, 0x4e, 0x34, 0x9f, 0x40, 0x4c, 0xc3, 0xde, 0xc4, 0xca, 0x96, 0xc7, 0xfc, 0xcc, 0xcd, 0x34,
-	0x51, 0xfc, 0x06, 0x34, 0x39, 0x57, 0x9c, 0x82, 0xab, 0x80, 0x0d, 0x79, 0x98, 0xc3, 0xe6, 0xd5,
-	0x67, 0xc4, 0xe6, 0xb5, 0x33, 0xe4, 0x3e, 0xe4, 0x67, 0xa3, 0xfe, 0x50, 0x81, 0x97, 0x52, 0x5a,
-	0x33, 0x97, 0xb4, 0xf9, 0x91, 0x37, 0xd7, 0xa6, 0xc9, 0x29, 0xb9, 0x6d, 0x78, 0x1f, 0x6a, 0x2e,
-	0x9d, 0x9d, 0x17, 0xcb, 0x6e, 0xe4, 0x32, 0x1f, 0x43, 0x44, 0xe3, 0x43, 0xd4, 0x3f, 0x50, 0xe0,
-	0x62, 0x1a, 0xd5, 0x19, 0x0c, 0xfe, 0x3a, 0xcc, 0xb1, 0xa9, 0x03, 0x19, 0x5d, 0xc9, 0x97, 0xd1,
-	0x90, 0x38, 0x5a, 0x30, 0x50, 0xdd, 0x81, 0xe5, 0xc0, 0x2f, 0x08, 0x49, 0xbf, 0x85, 0x7d, 0x3d,
-	0x27, 0xee, 0xbc, 0x06, 0x4d, 0x16, 0xc0, 0xb0, 0x78, 0x8e, 0xd5, 0x16, 0x61, 0x4f, 0x24, 0xfa,
-	0xd4, 0x3f, 0x2c, 0xc1, 0x05, 0x6a, 0x58, 0x93, 0x85, 0xa2, 0x22, 0x95, 0x4b, 0x55, 0xdc, 0x0d,
-	0x7b, 0xa4, 0x8f, 0xf8, 0xfd, 0x95, 0x86, 0x16, 0xeb, 0x43, 0xbd, 0x74, 0x1e, 0x50, 0x9a, 0x9f,
-	0x08, 0x4b, 0xb5, 0x9b, 0xba, 0xaf, 0xd3, 0x4a, 0x6d, 0x32, 0x01, 0x18, 0x1a, 0xf4, 0xca, 0x59,
-	0x0c, 0xfa, 0xeb, 0xd0, 0x66, 0x39, 0xee, 0xbe, 0x08, 0x77, 0xa9, 0x62, 0xaa, 0x68, 0x8b, 0xac,
-	0x7f, 0x37, 0xe8, 0x56, 0x1f, 0xc2, 0x4b, 0x09, 0xa2, 0xcc, 0x70, 0xf8, 0xea, 0x5f, 0x29, 0xe4,
-	0xe4, 0x62, 0x57, 0x86, 0xce, 0xee, 0xff, 0x5e, 0x11, 0xc5, 0xac, 0xbe, 0x69, 0x24, 0xf5, 0x8d,
-	0x81, 0x3e, 0x84, 0x86, 0x8d, 0x8f, 0xfb, 0x51, 0x97, 0xaa, 0x40, 0x70, 0x50, 0xb7, 0xf1, 0x31,
-	0xfd, 0xa5, 0x3e, 0x82, 0x8b, 0x29, 0x54, 0x67, 0xd9, 0xfb, 0x3f, 0x28, 0x70, 0x69, 0xd3, 0x75,
-	0xc6, 0x4f, 0x4c, 0xd7, 0x9f, 0xe8, 0x56, 0xbc, 0x5e, 0x7e, 0x86, 0xed, 0x17, 0xb8, 0x8e, 0xf8,
-	0x71, 0x2a, 0x0c, 0x7d, 0x53, 0x22, 0x6c, 0x69, 0xa4, 0xf8, 0xa6, 0x23, 0xae, 0xf8, 0x7f, 0x97,
-	0x65, 0xc8, 0x73, 0xb8, 0x29, 0x2e, 0x4c, 0x91, 0x38, 0x45, 0x9a, 0xb2, 0x2f, 0x9f, 0x35, 0x65,
-	0x9f, 0x61, 0x09, 0x2a, 0xcf, 0xc8, 0x12, 0x9c, 0x3a, 0x87, 0xb6, 0x01, 0xf1, 0x72, 0x0a, 0x35,
-	0xe4, 0xa7, 0x2d, 0xc1, 0x7c, 0x00, 0x10, 0x56, 0x15, 0xf8, 0x15, 0xcf, 0x29, 0x33, 0x44, 0x06,
-	0x90, 0x33, 0x12, 0xb6, 0x96, 0xbb, 0x02, 0x91, 0x6c, 0xf6, 0xb7, 0xa1, 0x2b, 0xe3, 0xcd, 0x59,
-	0xf8, 0xfd, 0x3f, 0x4b, 0x00, 0x3d, 0x71, 0x21, 0xf8, 0x6c, 0xc6, 0xe2, 0x06, 0x44, 0xdc, 0x95,
-	0x50, 0xca, 0xa3, 0xbc, 0x63, 0x10, 0x41, 0x10, 0x01, 0x2d, 0x81, 0x49, 0x05, 0xb9, 0x06, 0x9d,
-	0x27, 0x22, 0x2b, 0x8c, 0x15, 0x92, 0xfa, 0xf9, 0x32, 0x34, 0x5c, 0xe7, 0xb8, 0x4f, 0x84, 0xcb,
-	0x08, 0x6e, 0x3c, 0xbb, 0xce, 0x31, 0x11, 0x39, 0x03, 0x5d, 0x84, 0x39, 0x5f, 0xf7, 0x0e, 0xc9,
-	0xfc, 0x2c, 0xaf, 0x57, 0x23, 0xcd, 0x9e, 0x81, 0x2e, 0x40, 0x75, 0xdf, 0xb4, 0x30, 0xbb, 0x5c,
-	0xd1, 0xd0, 0x58, 0x03, 0x7d, 0x35, 0xb8, 0xa4, 0x57, 0x2f, 0x7c, 0x19, 0x87, 0xdd, 0xd3, 0xbb,
-	0x01, 0x0b, 0x84, 0x93, 0x08, 0x12, 0x4c, 0xac, 0xdb, 0x3c, 0xa7, 0xcf, 0x3b, 0x09, 0xaa, 0xea,
-	0x4f, 0x15, 0x58, 0x0c, 0x49, 0x4b, 0x75, 0x13, 0x51, 0x77, 0x54, 0xd5, 0x6d, 0x38, 0x06, 0xd3,
-	0x22, 0xad, 0x0c, 0xbb, 0xc2, 0x06, 0x32, 0x85, 0x16, 0x0e, 0xc9, 0x0b, 0xc4, 0xc9, 0xe6, 0x09,
-	0x65, 0x4c, 0x23, 0x48, 0x0d, 0xd5, 0x5c, 0xe7, 0xb8, 0x67, 0x08, 0x92, 0xb1, 0x3b, 0xcf, 0x2c,
-	0xec, 0x24, 0x24, 0xdb, 0xa0, 0xd7, 0x9e, 0x6f, 0xc0, 0x02, 0x76, 0x5d, 0xc7, 0xed, 0x8f, 0xb0,
-	0xe7, 0xe9, 0x43, 0xcc, 0xbd, 0xfc, 0x79, 0xda, 0xb9, 0xc5, 0xfa, 0xd4, 0x7f, 0xac, 0x40, 0x2b,
-	0xdc, 0x4a, 0x50, 0xfa, 0x37, 0x8d, 0xa0, 0xf4, 0x6f, 0x92, 0xf3, 0x05, 0x97, 0x69, 0x49, 0xc1,
-	0x01, 0xeb, 0xa5, 0x8e, 0xa2, 0x35, 0x78, 0x6f, 0xcf, 0x20, 0xc6, 0x9d, 0x10, 0xc8, 0x76, 0x0c,
-	0x1c, 0x72, 0x00, 0x04, 0x5d, 0x9c, 0x01, 0x62, 0x8c, 0x54, 0x29, 0xc0, 0x48, 0xd5, 0x02, 0x8c,
-	0x54, 0x93, 0x30, 0xd2, 0x32, 0xd4, 0xf6, 0x26, 0x83, 0x43, 0xec, 0x73, 0xbf, 0x8f, 0xb7, 0xe2,
-	0x0c, 0x56, 0x4f, 0x30, 0x98, 0xe0, 0xa3, 0x46, 0x94, 0x8f, 0x2e, 0x43, 0x23, 0xb0, 0xd4, 0x1e,
-	0xad, 0xa0, 0x95, 0xb5, 0x3a, 0x37, 0xd1, 0x1e, 0x7a, 0x37, 0x70, 0x0a, 0x9b, 0x54, 0xa2, 0x54,
-	0x89, 0x42, 0x4a, 0x70, 0x49, 0xe0, 0x12, 0xbe, 0x06, 0x8b, 0x11, 0x72, 0x50, 0x3e, 0x63, 0x65,
-	0xb6, 0x48, 0xcc, 0x40, 0x2d, 0xc8, 0x2d, 0x68, 0x85, 0x24, 0xa1, 0x70, 0x0b, 0x2c, 0x54, 0x13,
-	0xbd, 0x14, 0x4c, 0xb0, 0x7b, 0xeb, 0x94, 0xec, 0x7e, 0x09, 0xea, 0x3c, 0xc6, 0xf2, 0x3a, 0x8b,
-	0xf1, 0x74, 0x48, 0x21, 0x49, 0xf8, 0x2e, 0xa0, 0x70, 0x8b, 0xb3, 0x39, 0xa6, 0x09, 0x1e, 0x2a,
-	0x25, 0x79, 0x48, 0xfd, 0x6b, 0x05, 0x96, 0xa2, 0x8b, 0x9d, 0xd5, 0x70, 0x7f, 0x08, 0x4d, 0x56,
-	0xe8, 0xec, 0x13, 0x15, 0x22, 0xaf, 0x4b, 0x26, 0x0e, 0x4f, 0x83, 0xf0, 0x69, 0x05, 0x21, 0xcc,
-	0xb1, 0xe3, 0x1e, 0x9a, 0xf6, 0xb0, 0x4f, 0x30, 0x13, 0xe9, 0x5a, 0xde, 0xf9, 0x88, 0xf4, 0xa9,
-	0xbf, 0xad, 0xc0, 0xd5, 0xc7, 0x63, 0x43, 0xf7, 0x71, 0xc4, 0x83, 0x99, 0xf5, 0x86, 0xa3, 0xb8,
-	0x62, 0x58, 0xca, 0x39, 0xe6, 0xc8, 0x7a, 0x1e, 0xbf, 0x62, 0x48, 0xfc, 0x3e, 0x8e, 0x4d, 0xea,
-	0x4e, 0xf0, 0xd9, 0xb1, 0xe9, 0x42, 0xfd, 0x88, 0x4f, 0x17, 0x3c, 0x16, 0x09, 0xda, 0xb1, 0xc2,
-	0x6f, 0xf9, 0x54, 0x85, 0x5f, 0x75, 0x0b, 0x2e, 0x69, 0xd8, 0xc3, 0xb6, 0x11, 0xdb, 0xc8, 0x99,
-	0x93, 0x5a, 0x63, 0xe8, 0xca, 0xa6, 0x9b, 0x85, 0x53, 0x99, 0xe3, 0xdb, 0x77, 0xc9, 0xb4, 0x3e,
-	0x57, 0xd6, 0xc4, 0xdf, 0xa2, 0xeb, 0xf8, 0xea, 0xdf, 0x94, 0xe0, 0xe2, 0x3d, 0xc3, 0xe0, 0x7a,
-	0x9e, 0xbb, 0x72, 0xcf, 0xcb, 0xcb, 0x4e, 0x7a, 0xa1, 0xe5, 0xb4, 0x17, 0xfa, 0xac, 0x74, 0x2f,
-	0xb7, 0x42, 0xf6, 0x64, 0x14, 0x98, 0x60, 0x97, 0xdd, 0x9a, 0x7a, 0x9f, 0x97, 0x47, 0xfb, 0x96,
-	0x33, 0xa4, 0x66, 0x78, 0xba, 0x73, 0x56, 0x0f, 0x92, 0x73, 0xea, 0x18, 0x3a, 0x69, 0x62, 0xcd,
-	0xa8, 0x47, 0x02, 0x8a, 0x8c, 0x1d, 0x96, 0xe4, 0x9d, 0x27, 0x9e, 0x18, 0xed, 0xda, 0x76, 0x3c,
-	0xf5, 0x7f, 0x4b, 0xd0, 0xd9, 0xd1, 0x8f, 0xf0, 0xcf, 0xce, 0x01, 0x7d, 0x0a, 0x17, 0x3c, 0xfd,
-	0x08, 0xf7, 0x23, 0x01, 0x78, 0xdf, 0xc5, 0x9f, 0x71, 0x27, 0xf6, 0x75, 0x59, 0x1a, 0x5e, 0x7a,
-	0x9b, 0x48, 0x5b, 0xf2, 0x62, 0xfd, 0x1a, 0xfe, 0x0c, 0xbd, 0x0a, 0x8b, 0xd1, 0x2b, 0x6c, 0x04,
-	0xb5, 0x3a, 0x25, 0xf9, 0x42, 0xe4, 0x9a, 0x5a, 0xcf, 0x50, 0x3f, 0x83, 0x97, 0x1f, 0xdb, 0x1e,
-	0xf6, 0x7b, 0xe1, 0x55, 0xab, 0x19, 0xe3, 0xcf, 0x6b, 0xd0, 0x0c, 0x09, 0x9f, 0x7a, 0x25, 0x62,
-	0x78, 0xaa, 0x03, 0xdd, 0x2d, 0xdd, 0x3d, 0x0c, 0xd2, 0xd9, 0x9b, 0xec, 0xe6, 0xcb, 0x73, 0x5c,
-	0x70, 0x5f, 0xdc, 0x01, 0xd3, 0xf0, 0x3e, 0x76, 0xb1, 0x3d, 0xc0, 0x0f, 0x9d, 0xc1, 0x21, 0x71,
-	0x48, 0x7c, 0xf6, 0x50, 0x4f, 0x89, 0xf8, 0xae, 0x9b, 0x91, 0x77, 0x78, 0xa5, 0xd8, 0x3b, 0xbc,
-	0x29, 0x6f, 0x4e, 0xd5, 0x1f, 0x95, 0x60, 0xf9, 0x9e, 0xe5, 0x63, 0x37, 0xcc, 0x30, 0x9c, 0x26,
-	0x59, 0x12, 0x66, 0x2f, 0x4a, 0x67, 0xc9, 0x5e, 0x14, 0xa8, 0x56, 0xca, 0x72, 0x2d, 0x95, 0x33,
-	0xe6, 0x5a, 0xee, 0x01, 0x8c, 0x5d, 0x67, 0x8c, 0x5d, 0xdf, 0xc4, 0x41, 0xec, 0x57, 0xc0, 0xc1,
-	0x89, 0x0c, 0x52, 0x3f, 0x85, 0xf6, 0x83, 0xc1, 0x86, 0x63, 0xef, 0x9b, 0xee, 0x28, 0x20, 0x54,
-	0x4a, 0xe8, 0x94, 0x02, 0x42, 0x57, 0x4a, 0x09, 0x9d, 0x6a, 0xc2, 0x52, 0x64, 0xee, 0x19, 0x15,
-	0xd7, 0x70, 0xd0, 0xdf, 0x37, 0x6d, 0x93, 0xde, 0x2c, 0x2b, 0x51, 0x07, 0x15, 0x86, 0x83, 0xfb,
-	0xbc, 0x47, 0xfd, 0x81, 0x02, 0x97, 0x35, 0x4c, 0x84, 0x27, 0xb8, 0xa4, 0xb3, 0xeb, 0x6f, 0x79,
-	0xc3, 0x19, 0x1c, 0x8a, 0xbb, 0x50, 0x19, 0x79, 0xc3, 0x8c, 0x02, 0x3b, 0x31, 0xd1, 0xb1, 0x85,
-	0x34, 0x0a, 0xac, 0xfe, 0x58, 0x81, 0x0b, 0x41, 0x19, 0x32, 0x26, 0xc2, 0x71, 0xb6, 0x55, 0x52,
-	0xaf, 0xb6, 0x72, 0x1e, 0xe7, 0x5e, 0x84, 0x39, 0x63, 0x2f, 0xaa, 0x20, 0x6b, 0xc6, 0x1e, 0xd5,
-	0x8d, 0x12, 0x4f, 0xb9, 0x22, 0xf5, 0x94, 0x93, 0x8c, 0x5f, 0x4d, 0x33, 0xfe, 0xed, 0x0f, 0xc5,
-	0x1d, 0xe7, 0xdd, 0x93, 0x31, 0x46, 0x73, 0x50, 0x7e, 0x84, 0x8f, 0xdb, 0xe7, 0x10, 0x40, 0xed,
-	0x91, 0xe3, 0x8e, 0x74, 0xab, 0xad, 0xa0, 0x26, 0xcc, 0xf1, 0x02, 0x6b, 0xbb, 0x84, 0x16, 0xa0,
-	0xb1, 0x11, 0x14, 0xa2, 0xda, 0xe5, 0xdb, 0x7f, 0xaa, 0xc0, 0x52, 0xaa, 0x04, 0x88, 0x5a, 0x00,
-	0x8f, 0xed, 0x01, 0xaf, 0x8d, 0xb6, 0xcf, 0xa1, 0x79, 0xa8, 0x07, 0x95, 0x52, 0x36, 0xdf, 0xae,
-	0x43, 0xa1, 0xdb, 0x25, 0xd4, 0x86, 0x79, 0x36, 0x70, 0x32, 0x18, 0x60, 0xcf, 0x6b, 0x97, 0x45,
-	0xcf, 0x7d, 0xdd, 0xb4, 0x26, 0x2e, 0x6e, 0x57, 0xc8, 0x9a, 0xbb, 0x8e, 0x86, 0x2d, 0xac, 0x7b,
-	0xb8, 0x5d, 0x45, 0x08, 0x5a, 0xbc, 0x11, 0x0c, 0xaa, 0x45, 0xfa, 0x82, 0x61, 0x73, 0xb7, 0x3f,
-	0x89, 0x16, 0x6b, 0xe8, 0xf6, 0x2e, 0xc2, 0xf9, 0xc7, 0xb6, 0x81, 0xf7, 0x4d, 0x1b, 0x1b, 0xe1,
-	0xa7, 0xf6, 0x39, 0x74, 0x1e, 0x16, 0xb7, 0xb0, 0x3b, 0xc4, 0x91, 0xce, 0x12, 0x5a, 0x82, 0x85,
-	0x2d, 0xf3, 0x69, 0xa4, 0xab, 0xac, 0x56, 0xea, 0x4a, 0x5b, 0x59, 0xfb, 0xf1, 0x2d, 0x68, 0x10,
-	0x36, 0xd8, 0x70, 0x1c, 0xd7, 0x40, 0x16, 0x20, 0xfa, 0x92, 0x68, 0x34, 0x76, 0x6c, 0xf1, 0xea,
-	0x10, 0xad, 0x26, 0x38, 0x87, 0x35, 0xd2, 0x80, 0x9c, 0x53, 0xba, 0x37, 0xa5, 0xf0, 0x09, 0x60,
-	0xf5, 0x1c, 0x1a, 0xd1, 0xd5, 0x76, 0xcd, 0x11, 0xde, 0x35, 0x07, 0x87, 0x81, 0x73, 0xf9, 0x76,
-	0xc6, 0xd3, 0xad, 0x34, 0x68, 0xb0, 0xde, 0x0d, 0xe9, 0x7a, 0xec, 0xa9, 0x57, 0x20, 0xb4, 0xea,
-	0x39, 0xf4, 0x19, 0x65, 0xec, 0xd0, 0x53, 0x0f, 0x16, 0x5c, 0xcb, 0x5e, 0x30, 0x05, 0x7c, 0xca,
-	0x25, 0x1f, 0x42, 0x95, 0xb2, 0x1b, 0x92, 0xd5, 0xaf, 0xa3, 0x7f, 0x67, 0xd0, 0xbd, 0x9e, 0x0d,
-	0x20, 0x66, 0xfb, 0x2e, 0x2c, 0x26, 0x1e, 0x13, 0x23, 0x99, 0x75, 0x97, 0x3f, 0x0b, 0xef, 0xde,
-	0x2e, 0x02, 0x2a, 0xd6, 0x1a, 0x42, 0x2b, 0xfe, 0x02, 0x09, 0xad, 0x14, 0x78, 0xc7, 0xc8, 0x56,
-	0x7a, 0xbd, 0xf0, 0x8b, 0x47, 0xca, 0x04, 0xed, 0xe4, 0x33, 0x57, 0x74, 0x3b, 0x77, 0x82, 0x38,
-	0xb3, 0xbd, 0x51, 0x08, 0x56, 0x2c, 0x77, 0x42, 0x99, 0x20, 0xf5, 0xc6, 0x30, 0xc9, 0xe3, 0xc1,
-	0x34, 0x59, 0x8f, 0x1f, 0xbb, 0x77, 0x0a, 0xc3, 0x8b, 0xa5, 0x7f, 0x95, 0x5d, 0x52, 0x93, 0xbd,
-	0xd3, 0x43, 0xef, 0xc8, 0xa7, 0xcb, 0x79, 0x60, 0xd8, 0x5d, 0x3b, 0xcd, 0x10, 0x81, 0xc4, 0xf7,
-	0xe8, 0xed, 0x32, 0xc9, 0x4b, 0xb7, 0xa4, 0xdc, 0x05, 0xf3, 0x65, 0x3f, 0xe2, 0xeb, 0xbe, 0x73,
-	0x8a, 0x11, 0x02, 0x01, 0x27, 0xf9, 0x8e, 0x38, 0x10, 0xc3, 0x3b, 0x53, 0xb9, 0xe6, 0x6c, 0x32,
-	0xf8, 0x1d, 0x58, 0x4c, 0xf8, 0xbb, 0xa8, 0xb8, 0x4f, 0xdc, 0xcd, 0xb3, 0xed, 0x4c, 0x24, 0x13,
-	0xb7, 0xc9, 0x50, 0x06, 0xf7, 0x4b, 0x6e, 0x9c, 0x75, 0x6f, 0x17, 0x01, 0x15, 0x1b, 0x19, 0xc3,
-	0x52, 0xe2, 0xe3, 0x93, 0x35, 0xf4, 0x46, 0xe1, 0xd5, 0x9e, 0xac, 0x75, 0xdf, 0x2c, 0xbe, 0xde,
-	0x93, 0x35, 0xf5, 0x1c, 0xf2, 0xa8, 0x82, 0x4e, 0xdc, 0x48, 0x42, 0x19, 0xb3, 0xc8, 0x6f, 0x5e,
-	0x75, 0xdf, 0x2a, 0x08, 0x2d, 0xb6, 0x79, 0x04, 0xe7, 0x25, 0x17, 0xc7, 0xd0, 0x5b, 0xb9, 0xec,
-	0x91, 0xbc, 0x31, 0xd7, 0x5d, 0x2d, 0x0a, 0x1e, 0x31, 0x0f, 0xed, 0x00, 0xaf, 0x7b, 0x96, 0xc5,
-	0x8c, 0xff, 0x9b, 0x59, 0x96, 0x2f, 0x06, 0x96, 0xb1, 0xd5, 0x4c, 0x68, 0xb1, 0xe4, 0x2f, 0x03,
-	0xda, 0x39, 0x70, 0x8e, 0xa9, 0x7f, 0x39, 0x9c, 0xb8, 0x3a, 0x73, 0x89, 0xb3, 0x0c, 0x60, 0x1a,
-	0x34, 0x43, 0x10, 0x73, 0x47, 0x88, 0xc5, 0xfb, 0x00, 0x0f, 0xb0, 0xbf, 0x85, 0x7d, 0x97, 0x48,
-	0xff, 0xab, 0x59, 0xb8, 0x73, 0x80, 0x60, 0xa9, 0xd7, 0xa6, 0xc2, 0x45, 0x09, 0xba, 0xa5, 0xdb,
-	0x13, 0xdd, 0x8a, 0x3c, 0xf4, 0x91, 0x13, 0x34, 0x09, 0x96, 0x4f, 0xd0, 0x34, 0xb4, 0x58, 0xf2,
-	0x58, 0xf8, 0x2f, 0x91, 0xfa, 0x76, 0xbe, 0xff, 0x92, 0xbe, 0x5b, 0x95, 0xd4, 0xed, 0x39, 0xf0,
-	0x62, 0xe1, 0x2f, 0x14, 0x7a, 0xdd, 0x31, 0x01, 0xf0, 0x89, 0xe9, 0x1f, 0x6c, 0x5b, 0xba, 0xed,
-	0x15, 0x41, 0x81, 0x02, 0x9e, 0x02, 0x05, 0x0e, 0x2f, 0x50, 0x30, 0x60, 0x21, 0x56, 0x4b, 0x46,
-	0xb2, 0x07, 0x30, 0xb2, 0x12, 0x7c, 0x77, 0x65, 0x3a, 0xa0, 0x58, 0x65, 0x1f, 0x16, 0x62, 0xd1,
-	0x81, 0x74, 0x15, 0x59, 0xfc, 0x90, 0x54, 0x76, 0x09, 0xe9, 0x48, 0x12, 0xd4, 0x03, 0x94, 0x2e,
-	0x99, 0xa1, 0x62, 0x05, 0xd6, 0x3c, 0xd5, 0x93, 0x5d, 0x87, 0x63, 0xda, 0x3c, 0x51, 0x94, 0x96,
-	0x9b, 0x0a, 0x69, 0x8d, 0x5d, 0xaa, 0xcd, 0x33, 0x6a, 0xdc, 0xea, 0x39, 0xf4, 0x09, 0xd4, 0xf8,
-	0xdf, 0xfd, 0xdc, 0xcc, 0x4f, 0x4e, 0xf3, 0xd9, 0x6f, 0x4d, 0x81, 0x12, 0x13, 0x1f, 0xc2, 0xc5,
-	0x8c, 0xd4, 0xb4, 0xd4, 0xcb, 0xc8, 0x4f, 0x63, 0x4f, 0xb3, 0x7f, 0x62, 0xb1, 0x54, 0xe6, 0x39,
-	0x67, 0xb1, 0xac, 0x2c, 0xf5, 0xb4, 0xc5, 0xfa, 0xb0, 0x94, 0xca, 0xec, 0x49, 0x0d, 0x60, 0x56,
-	0xfe, 0x6f, 0xda, 0x02, 0x43, 0x78, 0x49, 0x9a, 0xc5, 0x92, 0xfa, 0x26, 0x79, 0xf9, 0xae, 0x69,
-	0x0b, 0x0d, 0xe0, 0xbc, 0x24, 0x77, 0x25, 0xb5, 0x71, 0xd9, 0x39, 0xae, 0x69, 0x8b, 0xec, 0x43,
-	0x77, 0xdd, 0x75, 0x74, 0x63, 0xa0, 0x7b, 0x3e, 0xcd, 0x27, 0x91, 0x40, 0x31, 0x70, 0x0e, 0xe5,
-	0x91, 0x83, 0x34, 0xeb, 0x34, 0x6d, 0x9d, 0x3d, 0x68, 0xd2, 0xa3, 0x64, 0x7f, 0xc9, 0x82, 0xe4,
-	0x16, 0x22, 0x02, 0x91, 0xa1, 0x76, 0x64, 0x80, 0x82, 0xa9, 0x77, 0xa1, 0xb9, 0x41, 0x0b, 0x73,
-	0x3d, 0xdb, 0xc0, 0x4f, 0x93, 0xd6, 0x8a, 0xbe, 0x4b, 0x5f, 0x8d, 0x00, 0x14, 0xa6, 0xd0, 0x02,
-	0xf5, 0xd9, 0x0d, 0xfc, 0x94, 0x9d, 0xf3, 0x8a, 0x6c, 0xde, 0x18, 0x48, 0x46, 0x8c, 0x23, 0x85,
-	0x8c, 0xd8, 0xf9, 0x0b, 0x51, 0x4f, 0x56, 0x2c, 0x77, 0x27, 0x63, 0x92, 0x14, 0x64, 0xb0, 0xea,
-	0xdb, 0xc5, 0x07, 0x44, 0xed, 0x42, 0x80, 0x57, 0x8f, 0x56, 0x05, 0x5f, 0xcb, 0x43, 0x3d, 0xea,
-	0x9e, 0xae, 0x4c, 0x07, 0x14, 0xab, 0x6c, 0x43, 0x83, 0x70, 0x27, 0x3b, 0x9e, 0x9b, 0xb2, 0x81,
-	0xe2, 0x73, 0xf1, 0xc3, 0xd9, 0xc4, 0xde, 0xc0, 0x35, 0xf7, 0xf8, 0xa1, 0x4b, 0xd1, 0x89, 0x81,
-	0xe4, 0x1e, 0x4e, 0x02, 0x52, 0x60, 0x3e, 0xa1, 0x3e, 0x83, 0x20, 0x1d, 0x57, 0x95, 0x6f, 0x4d,
-	0x3b, 0xdf, 0xb8, 0x9a, 0x5c, 0x2d, 0x0a, 0x2e, 0x96, 0xfd, 0x15, 0x1a, 0x07, 0xd1, 0xef, 0xeb,
-	0x13, 0xd3, 0x32, 0xb6, 0xf9, 0x9d, 0x6f, 0xf4, 0x76, 0xde, 0x54, 0x31, 0xd0, 0x4c, 0xf7, 0x2f,
-	0x67, 0x84, 0x58, 0xff, 0x17, 0xa1, 0x21, 0x32, 0x9b, 0x48, 0x76, 0x69, 0x31, 0x99, 0x53, 0xed,
-	0xde, 0xcc, 0x07, 0x12, 0x33, 0x63, 0xb8, 0x20, 0xcb, 0x63, 0x4a, 0x43, 0xec, 0x9c, 0x84, 0xe7,
-	0x14, 0xfe, 0x58, 0xfb, 0x73, 0x80, 0x7a, 0x30, 0xf0, 0x4b, 0x4e, 0x5c, 0xbd, 0x80, 0x4c, 0xd2,
-	0x77, 0x60, 0x31, 0xf1, 0x4f, 0x1b, 0x52, 0x0d, 0x2e, 0xff, 0x37, 0x8e, 0x69, 0xa2, 0xf6, 0x09,
-	0xff, 0x93, 0x4a, 0x11, 0xe2, 0xbd, 0x96, 0x95, 0x8d, 0x4a, 0x46, 0x77, 0x53, 0x26, 0xfe, 0xff,
-	0x1d, 0xe0, 0x3c, 0x02, 0x88, 0x84, 0x36, 0xf9, 0xb7, 0xce, 0x89, 0xb7, 0x3e, 0x8d, 0x5a, 0x23,
-	0x69, 0xf4, 0xf2, 0x7a, 0x91, 0x1b, 0xbc, 0xd9, 0x1e, 0x68, 0x76, 0xcc, 0xf2, 0x18, 0xe6, 0xa3,
-	0xef, 0x41, 0x90, 0xf4, 0x6f, 0x07, 0xd3, 0x0f, 0x46, 0xa6, 0xed, 0x62, 0xeb, 0x94, 0x8e, 0xed,
-	0x94, 0xe9, 0x3c, 0x40, 0xe9, 0x0a, 0xbf, 0x34, 0x10, 0xc8, 0xbc, 0x57, 0x20, 0x0d, 0x04, 0xb2,
-	0xaf, 0x0d, 0xb0, 0xa4, 0x64, 0xb2, 0x6c, 0x2d, 0x4d, 0x4a, 0x66, 0x5c, 0x04, 0x90, 0x26, 0x25,
-	0xb3, 0xea, 0xe0, 0x11, 0xf9, 0xcb, 0x0d, 0xdd, 0x64, 0xff, 0xa1, 0x3a, 0x85, 0x78, 0xeb, 0x77,
-	0x3f, 0x7d, 0x67, 0x68, 0xfa, 0x07, 0x93, 0x3d, 0xf2, 0xe5, 0x0e, 0x03, 0x7d, 0xcb, 0x74, 0xf8,
-	0xaf, 0x3b, 0x81, 0x1c, 0xdd, 0xa1, 0xa3, 0xef, 0x90, 0x65, 0xc6, 0x7b, 0x7b, 0x35, 0xda, 0xba,
-	0xfb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x64, 0x9a, 0xa4, 0xf9, 0x57, 0x00, 0x00,
+	// 4920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xe5, 0x3c, 0x4b, 0x8f, 0x1c, 0x49,
+	0x5a, 0x9b, 0xf5, 0xea, 0xaa, 0xaf, 0xfa, 0x51, 0x9d, 0xf6, 0xd8, 0xe5, 0xf2, 0xd8, 0xe3, 0xcd,
+	0xf1, 0x8c, 0x3d, 0x1e, 0xbb, 0xed, 0xe9, 0x01, 0x31, 0x3b, 0xc3, 0xcc, 0xae, 0xbb, 0x3d, 0xde,
+	0x69, 0x70, 0x7b, 0xbd, 0xd9, 0x6d, 0x1b, 0x0d, 0x48, 0xa5, 0xea, 0xca, 0xec, 0xee, 0xdc, 0xae,
+	0xaa, 0xac, 0xc9, 0xcc, 0xb2, 0xdd, 0xbb, 0x12, 0x3b, 0x0b, 0x0b, 0x12, 0x0f, 0xb1, 0x2b, 0x1e,
+	0x12, 0xdc, 0x80, 0x03, 0xe2, 0xa1, 0x3d, 0x01, 0x42, 0x42, 0x88, 0xbd, 0x82, 0x38, 0x20, 0x84,
+	0x84, 0xe0, 0xc0, 0x8d, 0x0b, 0x67, 0xfe, 0x00, 0x5f, 0x3c, 0x32, 0x32, 0x32, 0x33, 0x32, 0x2b,
+	0xbb, 0xca, 0x1e, 0x4b, 0x70, 0xab, 0x88, 0xfc, 0xe2, 0x8b, 0x2f, 0xbe, 0xf8, 0xde, 0x11, 0x51,
+	0xd0, 0xb2, 0x7a, 0x41, 0xaf, 0xdb, 0x77, 0x5d, 0xcf, 0x5a, 0x1b, 0x7b, 0x6e, 0xe0, 0xea, 0xab,
+	0x43, 0x67, 0xf0, 0x64, 0xe2, 0xb3, 0xd6, 0x1a, 0xf9, 0xdc, 0x59, 0xec, 0xbb, 0xc3, 0xa1, 0x3b,
+	0x62, 0x5d, 0x9d, 0x65, 0x67, 0x14, 0xd8, 0xde, 0xa8, 0x37, 0xe0, 0xed, 0x45, 0x79, 0x40, 0x67,
+	0xd1, 0xef, 0x1f, 0xda, 0xc3, 0x1e, 0x6f, 0x35, 0x86, 0xfe, 0x01, 0xff, 0xb9, 0xea, 0x8c, 0x2c,
+	0xfb, 0x99, 0x3c, 0x95, 0xb1, 0x00, 0xd5, 0x8f, 0x87, 0xe3, 0xe0, 0xd8, 0xf8, 0x2b, 0x0d, 0x16,
+	0xef, 0x0e, 0x26, 0xfe, 0xa1, 0x69, 0x7f, 0x36, 0xb1, 0xfd, 0x40, 0xbf, 0x05, 0x95, 0xbd, 0x9e,
+	0x6f, 0xb7, 0xb5, 0x4b, 0xda, 0xd5, 0xe6, 0xfa, 0xab, 0x6b, 0x31, 0x9a, 0x38, 0x35, 0xdb, 0xfe,
+	0xc1, 0x06, 0xc2, 0x98, 0x14, 0x52, 0xd7, 0xa1, 0x62, 0xed, 0x6d, 0xdd, 0x69, 0x97, 0x70, 0x44,
+	0xd9, 0xa4, 0xbf, 0xf5, 0x8b, 0x00, 0xbe, 0x7d, 0x30, 0xb4, 0x47, 0xc1, 0xd6, 0x1d, 0xbf, 0x5d,
+	0xbe, 0x54, 0xc6, 0x2f, 0x52, 0x8f, 0x6e, 0x00, 0xae, 0x6c, 0x30, 0xb0, 0xfb, 0x81, 0xe3, 0x8e,
+	0x70, 0x6c, 0x85, 0x8e, 0x8d, 0xf5, 0xe9, 0x1d, 0xa8, 0x3b, 0xfe, 0xd6, 0x70, 0xec, 0x7a, 0x41,
+	0xbb, 0x8a, 0xdf, 0xeb, 0xa6, 0x68, 0x1b, 0xdf, 0x2b, 0xc1, 0x12, 0x27, 0xdb, 0x1f, 0xbb, 0x23,
+	0xa4, 0xe2, 0x5d, 0xa8, 0xf9, 0x41, 0x2f, 0x98, 0xf8, 0x9c, 0xf2, 0xf3, 0x4a, 0xca, 0x77, 0x28,
+	0x88, 0xc9, 0x41, 0x95, 0xa4, 0x27, 0x49, 0x2b, 0x2b, 0x48, 0x8b, 0x2f, 0xaf, 0x92, 0x5a, 0xde,
+	0x55, 0x58, 0xd9, 0x27, 0xd4, 0xed, 0x44, 0x40, 0x55, 0x0a, 0x94, 0xec, 0x26, 0x98, 0x02, 0x67,
+	0x68, 0x7f, 0x63, 0x7f, 0xc7, 0xee, 0x0d, 0xda, 0x35, 0x3a, 0x97, 0xd4, 0xa3, 0x9f, 0x83, 0x3a,
+	0x1d, 0xd2, 0x0d, 0xfc, 0xf6, 0x02, 0x7e, 0xad, 0x98, 0x0b, 0xb4, 0xbd, 0xeb, 0x1b, 0xdf, 0x85,
+	0xd3, 0x94, 0x05, 0x9b, 0x87, 0xbd, 0xd1, 0xc8, 0x1e, 0xf8, 0xb3, 0xef, 0xa0, 0x3c, 0x49, 0x29,
+	0x36, 0x09, 0xd9, 0x84, 0x3e, 0xc7, 0x4f, 0xb7, 0xb1, 0x61, 0x8a, 0xb6, 0xf1, 0x2f, 0x1a, 0xb4,
+	0xc4, 0x52, 0xc2, 0xd9, 0x4f, 0x43, 0xb5, 0xef, 0x4e, 0x46, 0x01, 0x9d, 0x7e, 0xc9, 0x64, 0x0d,
+	0xfd, 0xcb, 0xc8, 0x54, 0x36, 0xac, 0x3b, 0xea, 0x0d, 0x6d, 0x3a, 0x4b, 0xc3, 0x6c, 0xf2, 0xbe,
+	0xfb, 0xd8, 0x55, 0x88, 0xef, 0x97, 0xa0, 0x39, 0xee, 0x79, 0x81, 0x13, 0x93, 0x1a, 0xb9, 0x2b,
+	0x4f, 0x68, 0xc8, 0x0c, 0x0e, 0xfd, 0xb5, 0xdb, 0xf3, 0x8f, 0x70, 0x38, 0xe3, 0x76, 0xac, 0xcf,
+	0xf8, 0x43, 0x0d, 0xce, 0xdc, 0xf6, 0x7d, 0xe7, 0x60, 0x94, 0x5a, 0xd9, 0x19, 0xa8, 0x8d, 0x5c,
+	0xcb, 0xc6, 0x81, 0x1a, 0x1d, 0xc8, 0x5b, 0xfa, 0x79, 0x68, 0x8c, 0x6d, 0xdb, 0xeb, 0x7a, 0xee,
+	0x20, 0x5c, 0x58, 0x9d, 0x74, 0x98, 0xd8, 0xd6, 0xbf, 0x09, 0xab, 0x7e, 0x02, 0x11, 0x63, 0x64,
+	0x73, 0xfd, 0xf5, 0xb5, 0x94, 0xbe, 0xaf, 0x25, 0x27, 0x35, 0xd3, 0xa3, 0x8d, 0xcf, 0x4b, 0x70,
+	0x4a, 0xc0, 0x31, 0x5a, 0xc9, 0x6f, 0xc2, 0x79, 0x04, 0x16, 0xe4, 0xb1, 0x46, 0x11, 0xce, 0x8b,
+	0x2d, 0x2b, 0xcb, 0x5b, 0x56, 0x44, 0x45, 0x13, 0xfb, 0x51, 0x4d, 0xef, 0xc7, 0x6b, 0xd0, 0xb4,
+	0x9f, 0x8d, 0x1d, 0xcf, 0xee, 0x12, 0xa1, 0xa6, 0x2c, 0xaf, 0x98, 0xc0, 0xba, 0x76, 0xb1, 0x47,
+	0xd2, 0xdb, 0x85, 0xc2, 0x7a, 0x6b, 0xfc, 0xb1, 0x06, 0x67, 0x53, 0xbb, 0xc4, 0x0d, 0x81, 0x09,
+	0x2d, 0xba, 0xf2, 0x88, 0x33, 0xc4, 0x24, 0x10, 0x86, 0xbf, 0x99, 0xc7, 0xf0, 0x08, 0xdc, 0x4c,
+	0x8d, 0x97, 0x88, 0x2c, 0x15, 0x27, 0xf2, 0x08, 0xce, 0x7e, 0xdd, 0x0e, 0xf8, 0x04, 0xe4, 0x9b,
+	0x3d, 0x87, 0x8a, 0xc6, 0x2d, 0x4e, 0x29, 0x69, 0x71, 0x8c, 0x3f, 0x29, 0x09, 0x5d, 0xa4, 0x53,
+	0x6d, 0x8d, 0xf6, 0x5d, 0xfd, 0x55, 0x68, 0x08, 0x10, 0x2e, 0x15, 0x51, 0x87, 0xfe, 0x53, 0x28,
+	0x2f, 0x04, 0x94, 0xae, 0x69, 0x79, 0xfd, 0xcb, 0xea, 0x35, 0x49, 0x38, 0x4d, 0x06, 0xaf, 0xdf,
+	0x81, 0x65, 0xfc, 0xe1, 0x05, 0xdd, 0xb1, 0xeb, 0xd3, 0x7d, 0xa6, 0x82, 0xd3, 0x5c, 0xbf, 0x10,
+	0xc7, 0x40, 0x1c, 0x10, 0x2e, 0xe2, 0x01, 0x07, 0x32, 0x97, 0xe8, 0xa0, 0xb0, 0xa9, 0x7f, 0x0d,
+	0x16, 0xed, 0x91, 0x15, 0xe1, 0xa8, 0x14, 0xc1, 0xd1, 0xc4, 0x21, 0x02, 0x43, 0xb4, 0x2b, 0xd5,
+	0xe2, 0xbb, 0xf2, 0x9b, 0x1a, 0xb4, 0xd3, 0xdb, 0x32, 0x8f, 0x13, 0xf9, 0x80, 0x0d, 0xb2, 0xd9,
+	0xb6, 0xe4, 0xea, 0xb5, 0xd8, 0x1a, 0x93, 0x0f, 0x31, 0x7e, 0x4f, 0x83, 0x57, 0x22, 0x72, 0xe8,
+	0xa7, 0x17, 0x25, 0x23, 0xfa, 0x35, 0x68, 0x39, 0xa3, 0xfe, 0x60, 0x62, 0xd9, 0x0f, 0x47, 0x9f,
+	0xa0, 0x73, 0x09, 0x0e, 0x8f, 0xe9, 0xce, 0xd5, 0xcd, 0x54, 0xbf, 0xf1, 0x1f, 0x25, 0x38, 0x93,
+	0xa4, 0x6b, 0x1e, 0x26, 0xfd, 0x04, 0x54, 0x1d, 0x44, 0x12, 0xf2, 0xe8, 0x62, 0x8e, 0x2a, 0x92,
+	0xb9, 0x18, 0xb0, 0xee, 0x82, 0x1e, 0x1a, 0x2f, 0x8c, 0x6d, 0xfa, 0x47, 0x63, 0xd7, 0xa1, 0x66,
+	0x8a, 0xa0, 0xf8, 0x9a, 0x02, 0x85, 0x9a, 0xe2, 0x35, 0xee, 0x21, 0x37, 0x05, 0x8a, 0x8f, 0x47,
+	0x81, 0x77, 0x6c, 0xae, 0xf6, 0x93, 0xfd, 0x9d, 0x3e, 0x9c, 0x51, 0x03, 0xeb, 0x2d, 0x28, 0x1f,
+	0xd9, 0xc7, 0x74, 0xc9, 0x0d, 0x93, 0xfc, 0x44, 0x3e, 0x54, 0x9f, 0xf4, 0x06, 0x13, 0x9b, 0xdb,
+	0x84, 0x29, 0x92, 0xcb, 0x60, 0xdf, 0x2f, 0xbd, 0xa7, 0x19, 0x43, 0x38, 0x8f, 0x84, 0x6e, 0x21,
+	0x65, 0x5e, 0xb0, 0xe1, 0x8c, 0x06, 0xee, 0xc1, 0x83, 0x5e, 0x70, 0x38, 0x87, 0x71, 0x88, 0xe9,
+	0x79, 0x29, 0xa1, 0xe7, 0xc6, 0x9f, 0x6a, 0xf0, 0xaa, 0x7a, 0x3e, 0xbe, 0xa1, 0xe8, 0x33, 0xf7,
+	0x1d, 0x7b, 0x60, 0x11, 0xa9, 0xd1, 0xa8, 0xd4, 0x88, 0x36, 0x31, 0x12, 0x63, 0x02, 0xcc, 0xf7,
+	0x2d, 0x61, 0x24, 0x44, 0x3c, 0xba, 0x13, 0x78, 0xce, 0xe8, 0xe0, 0x9e, 0x83, 0x1e, 0x8b, 0xc1,
+	0x4b, 0x52, 0x52, 0x2e, 0xae, 0x9c, 0xbf, 0xae, 0xc1, 0x45, 0x24, 0x75, 0x53, 0xf8, 0x18, 0xf2,
+	0x1d, 0x91, 0x3a, 0x7d, 0xff, 0xf9, 0xc6, 0xa7, 0x05, 0x82, 0x0d, 0xe3, 0x07, 0x1a, 0xbc, 0x96,
+	0x49, 0x0c, 0x67, 0x1d, 0xb7, 0xa1, 0xa1, 0x87, 0x51, 0xdb, 0xd0, 0x9f, 0xb5, 0x8f, 0x1f, 0x91,
+	0xcd, 0x7f, 0xd0, 0x73, 0x3c, 0x66, 0x43, 0x67, 0xf4, 0x28, 0x3f, 0xd2, 0xe0, 0x02, 0x52, 0xf4,
+	0x20, 0xf4, 0xaf, 0x2f, 0x91, 0x3b, 0x04, 0x46, 0xf2, 0xf3, 0x61, 0x10, 0x1c, 0xeb, 0x33, 0x7e,
+	0x8b, 0x6d, 0xa7, 0x92, 0xde, 0x97, 0xc2, 0xc0, 0x8b, 0x54, 0x13, 0x24, 0x13, 0xc1, 0x95, 0x9d,
+	0xb3, 0xcf, 0xf8, 0x7e, 0x15, 0x16, 0x1f, 0x71, 0xab, 0x40, 0x3d, 0x68, 0x92, 0x13, 0x9a, 0x3a,
+	0x08, 0x92, 0xa2, 0x29, 0x55, 0x80, 0xb5, 0x01, 0x4b, 0xbe, 0x6d, 0x1f, 0x9d, 0xd0, 0x5f, 0x2e,
+	0x92, 0x31, 0xc2, 0xd9, 0xdd, 0x83, 0xd5, 0xc9, 0x88, 0x46, 0xe5, 0xb6, 0xc5, 0x17, 0xc0, 0x98,
+	0x3e, 0xdd, 0x98, 0xa6, 0x07, 0xea, 0x9f, 0xf0, 0x04, 0x45, 0xc2, 0x55, 0x2d, 0x84, 0x2b, 0x39,
+	0x4c, 0xdf, 0x82, 0x96, 0xe5, 0xb9, 0xe3, 0xb1, 0x6d, 0x75, 0xfd, 0x10, 0x55, 0xad, 0x18, 0x2a,
+	0x3e, 0x4e, 0xa0, 0xba, 0x05, 0xa7, 0x92, 0x94, 0x6e, 0x59, 0x24, 0x2e, 0x24, 0x92, 0xa5, 0xfa,
+	0xa4, 0x5f, 0x87, 0xd5, 0x34, 0x7c, 0x9d, 0xc2, 0xa7, 0x3f, 0xe8, 0x37, 0x40, 0x4f, 0x90, 0x4a,
+	0xc0, 0x1b, 0x0c, 0x3c, 0x4e, 0x0c, 0x07, 0xa7, 0x89, 0x73, 0x1c, 0x1c, 0x18, 0x38, 0xff, 0x22,
+	0x81, 0x6f, 0x11, 0xef, 0x1a, 0x03, 0xf7, 0xdb, 0xcd, 0x62, 0x8c, 0x88, 0x23, 0xf3, 0x8d, 0x5f,
+	0xc3, 0x24, 0xe4, 0x71, 0x2f, 0xe8, 0x1f, 0xde, 0x19, 0xce, 0x9f, 0xdc, 0x7d, 0x08, 0x8d, 0x27,
+	0x22, 0x85, 0x63, 0x56, 0xfc, 0x35, 0x05, 0x41, 0xb2, 0xd8, 0x9b, 0xd1, 0x08, 0x92, 0x10, 0xb1,
+	0x34, 0x33, 0xa4, 0xee, 0x8b, 0x37, 0x35, 0x53, 0xb2, 0x6d, 0xe3, 0x19, 0x00, 0x27, 0x0e, 0xe7,
+	0x9b, 0x81, 0xae, 0xf7, 0x60, 0x81, 0x63, 0xe3, 0xb6, 0x64, 0xda, 0x86, 0x85, 0xe0, 0xc6, 0xdf,
+	0xd7, 0xa0, 0x29, 0x7d, 0xd0, 0x97, 0xa1, 0x24, 0x8c, 0x44, 0x49, 0xb1, 0xba, 0xd2, 0xf4, 0x1c,
+	0xaa, 0x9c, 0xce, 0xa1, 0xde, 0x80, 0x65, 0x87, 0x3a, 0xef, 0x2e, 0xdf, 0x15, 0x1a, 0x2b, 0x37,
+	0xcc, 0x25, 0xd6, 0xcb, 0x45, 0x04, 0xd9, 0xd4, 0x1c, 0x4d, 0x86, 0x5d, 0x77, 0x1f, 0x33, 0xd1,
+	0xa7, 0x3e, 0x4f, 0xc6, 0x1a, 0xd8, 0xf5, 0x8d, 0x7d, 0x13, 0x3b, 0xa2, 0x78, 0xbf, 0x76, 0xc2,
+	0x78, 0x1f, 0x11, 0x0f, 0x7b, 0xcf, 0x08, 0xd6, 0x2e, 0x62, 0xa3, 0x79, 0x1a, 0x22, 0xc6, 0x2e,
+	0x44, 0x7b, 0x7f, 0x32, 0xd4, 0xaf, 0x42, 0x6b, 0xd0, 0xf3, 0x83, 0xae, 0x9c, 0xe8, 0xd5, 0x69,
+	0xa2, 0xb7, 0x4c, 0xfa, 0x3f, 0x8e, 0x92, 0xbd, 0x74, 0xe6, 0xd0, 0x98, 0x2d, 0x73, 0xb0, 0x86,
+	0x83, 0x08, 0x07, 0x14, 0xca, 0x1c, 0x70, 0x88, 0xc0, 0x80, 0x3b, 0xbe, 0x47, 0x03, 0xa1, 0x3c,
+	0x15, 0xbd, 0x4b, 0x62, 0x20, 0x16, 0x2f, 0x99, 0x21, 0xb8, 0xfe, 0xd3, 0x18, 0x6a, 0x11, 0xff,
+	0x43, 0xc7, 0x2e, 0x16, 0x1a, 0x1b, 0x0d, 0x20, 0xa3, 0x2d, 0x7b, 0x10, 0xf4, 0xe8, 0xe8, 0xa5,
+	0x62, 0xa3, 0xc5, 0x00, 0x62, 0x1f, 0xfb, 0x9e, 0x8d, 0x3b, 0x62, 0x6d, 0x1c, 0x6f, 0xba, 0xc3,
+	0x71, 0x8f, 0x8a, 0x50, 0x7b, 0x99, 0x86, 0xf0, 0xaa, 0x4f, 0xfa, 0x9b, 0xb0, 0xdc, 0x17, 0xad,
+	0xbb, 0x9e, 0x3b, 0x6c, 0xaf, 0x50, 0xed, 0x49, 0xf4, 0xea, 0x17, 0x00, 0x42, 0xcb, 0xd8, 0x0b,
+	0xda, 0x2d, 0xba, 0x77, 0x0d, 0xde, 0x73, 0x9b, 0x56, 0x6f, 0x1c, 0xbf, 0xcb, 0xea, 0x24, 0x18,
+	0xe6, 0xb5, 0x57, 0xe9, 0x8c, 0xcd, 0xb0, 0xb0, 0x82, 0x5d, 0xfa, 0x59, 0x58, 0x40, 0x90, 0xfd,
+	0xde, 0x91, 0xdd, 0xd6, 0xe9, 0xd7, 0x9a, 0xe3, 0xdf, 0xc5, 0x16, 0x89, 0x4d, 0xf9, 0x64, 0xb6,
+	0xd5, 0x3e, 0x45, 0x3f, 0x45, 0x1d, 0xc6, 0xb7, 0xe1, 0x74, 0x24, 0x71, 0xd2, 0x16, 0xa7, 0x05,
+	0x45, 0x9b, 0x41, 0x50, 0xf2, 0xe3, 0xe2, 0xff, 0xae, 0xc0, 0x99, 0x9d, 0xde, 0x13, 0xfb, 0xc5,
+	0x87, 0xe0, 0x85, 0xac, 0x1c, 0x3a, 0x78, 0x1a, 0x75, 0xaf, 0x4b, 0xf4, 0xe4, 0x38, 0x78, 0x59,
+	0x46, 0xd2, 0x03, 0xf5, 0xaf, 0x92, 0xa0, 0x04, 0x33, 0x98, 0x07, 0x24, 0x83, 0x09, 0x9d, 0xfb,
+	0x05, 0x05, 0x9e, 0x4d, 0x01, 0x65, 0xca, 0x23, 0xf4, 0x07, 0xb0, 0x12, 0xdf, 0x81, 0xd0, 0xad,
+	0x5f, 0xc9, 0x4d, 0x6f, 0x23, 0xee, 0x9b, 0xcb, 0xb1, 0xcd, 0xf0, 0xf5, 0x36, 0x2c, 0x70, 0x9f,
+	0x4c, 0x4d, 0x48, 0xdd, 0x0c, 0x9b, 0x38, 0xd7, 0x29, 0xb6, 0x82, 0x1d, 0xae, 0x29, 0x6c, 0xf1,
+	0xf5, 0x42, 0x8b, 0x57, 0x0d, 0x8d, 0x2b, 0x5a, 0xe3, 0xa4, 0x8a, 0x86, 0x94, 0x72, 0xe1, 0xa7,
+	0xb6, 0x05, 0x29, 0xe5, 0x4d, 0xb2, 0xcd, 0x91, 0x1a, 0x34, 0x99, 0x34, 0x8b, 0x0e, 0x32, 0x2e,
+	0xb4, 0xd0, 0x8b, 0xd4, 0x42, 0x87, 0x4d, 0xe3, 0x57, 0x34, 0x80, 0x88, 0xd3, 0x53, 0x0a, 0x33,
+	0x5f, 0x81, 0xba, 0x10, 0xfb, 0x42, 0xb9, 0xa5, 0x00, 0x4f, 0xfa, 0x80, 0x72, 0xc2, 0x07, 0x18,
+	0xff, 0xa4, 0xc1, 0xe2, 0x1d, 0xb2, 0xce, 0x7b, 0xee, 0x01, 0xf5, 0x58, 0xe8, 0x5b, 0x3c, 0xbb,
+	0xef, 0x7a, 0x56, 0x17, 0xe7, 0xf6, 0x1c, 0x9b, 0x25, 0xf5, 0x15, 0x73, 0x89, 0xf5, 0x7e, 0xcc,
+	0x3a, 0x09, 0x18, 0x31, 0xeb, 0xb8, 0xa3, 0xc3, 0x71, 0x77, 0x9f, 0x18, 0x12, 0x56, 0x27, 0x5e,
+	0x12, 0xbd, 0xd4, 0x8e, 0xa0, 0xa1, 0x88, 0xc0, 0x02, 0x97, 0xce, 0x5f, 0x31, 0x9b, 0xa2, 0x6f,
+	0xd7, 0xd5, 0x2f, 0xc3, 0x32, 0x65, 0x74, 0x17, 0x39, 0xdd, 0x25, 0xa9, 0x22, 0x77, 0x66, 0x8b,
+	0x16, 0x27, 0x8b, 0x6c, 0x60, 0x1c, 0xca, 0x77, 0xbe, 0x6d, 0x73, 0x77, 0x26, 0xa0, 0x76, 0xb0,
+	0xcf, 0xf8, 0x65, 0x0d, 0x96, 0xb8, 0xf7, 0xdb, 0x11, 0x05, 0x7d, 0x5a, 0xe5, 0x64, 0x69, 0x3a,
+	0xfd, 0xad, 0xbf, 0x1f, 0xaf, 0x73, 0x5d, 0x56, 0x2a, 0x01, 0x45, 0x42, 0x63, 0xae, 0x98, 0xeb,
+	0x2b, 0x92, 0x27, 0x7e, 0x4e, 0x78, 0x8a, 0x58, 0xee, 0x93, 0x72, 0x30, 0xe1, 0x29, 0x8a, 0x41,
+	0xcf, 0xb2, 0x3c, 0xdb, 0xf7, 0x39, 0x1d, 0x61, 0x93, 0x7c, 0x79, 0x62, 0x7b, 0x7e, 0xb8, 0xb1,
+	0x65, 0x33, 0x6c, 0xa2, 0xc0, 0xc6, 0xeb, 0xec, 0xcd, 0xf5, 0x4b, 0xd9, 0x74, 0xf2, 0xac, 0x26,
+	0xaa, 0xc4, 0xff, 0x75, 0x09, 0x96, 0xb9, 0x0e, 0x6e, 0x70, 0x47, 0x95, 0x2f, 0x62, 0x1b, 0xb0,
+	0xb8, 0x1f, 0xc9, 0x7e, 0x5e, 0x55, 0x46, 0x56, 0x91, 0xd8, 0x98, 0x69, 0xb2, 0x16, 0x77, 0x95,
+	0x95, 0xb9, 0x5c, 0x65, 0xf5, 0xa4, 0x1a, 0x9c, 0x0e, 0x99, 0x6a, 0x8a, 0x90, 0xc9, 0xf8, 0x05,
+	0x68, 0x4a, 0x08, 0xa8, 0x85, 0x62, 0x85, 0x0f, 0xce, 0xb1, 0xb0, 0x89, 0xd9, 0xa6, 0x08, 0x18,
+	0x18, 0xab, 0xce, 0x29, 0x68, 0x49, 0xc4, 0x0a, 0xc6, 0x8f, 0x35, 0xa8, 0x71, 0xcc, 0xa4, 0x0c,
+	0xce, 0x54, 0x89, 0x86, 0x50, 0x0c, 0x3b, 0xf0, 0x2e, 0x12, 0x43, 0x3d, 0x3f, 0x05, 0x3b, 0x07,
+	0xf5, 0x84, 0x6a, 0x2d, 0x70, 0xb3, 0x18, 0x7e, 0x92, 0xf4, 0x89, 0x7c, 0x22, 0xaa, 0x44, 0xce,
+	0x00, 0xf0, 0xa7, 0x38, 0x14, 0x61, 0x0d, 0xe3, 0x1f, 0x34, 0x5a, 0xc3, 0x36, 0xd1, 0x16, 0xa0,
+	0xa0, 0x1e, 0xcf, 0x5f, 0x06, 0xfc, 0x40, 0x12, 0xf3, 0x82, 0xb9, 0x88, 0x18, 0x80, 0x83, 0xc5,
+	0x26, 0x94, 0x55, 0xd5, 0x02, 0xd9, 0x15, 0x71, 0x21, 0x8d, 0x36, 0xe3, 0x87, 0x1a, 0x2d, 0x68,
+	0xc6, 0x97, 0x32, 0xab, 0xb7, 0x7f, 0x2e, 0x71, 0xbd, 0xf1, 0x8f, 0x1a, 0x9c, 0xcb, 0xe0, 0xee,
+	0xa3, 0xf5, 0x97, 0xc0, 0xdf, 0xf7, 0xa1, 0x2e, 0x32, 0xd7, 0x72, 0xa1, 0xcc, 0x55, 0xc0, 0x1b,
+	0xbf, 0xcb, 0xca, 0xea, 0x0a, 0xf6, 0xe2, 0x52, 0x5e, 0x0c, 0x83, 0x93, 0x15, 0xa8, 0xb2, 0xa2,
+	0x02, 0xf5, 0xcf, 0x1a, 0x74, 0xa2, 0x8a, 0x8f, 0xbf, 0x71, 0x3c, 0xef, 0x39, 0xcc, 0xf3, 0xc9,
+	0xe8, 0xbe, 0x22, 0x8e, 0x0c, 0x88, 0x5d, 0x2c, 0x94, 0x8b, 0x85, 0x07, 0x06, 0x23, 0x5a, 0x3c,
+	0x4e, 0x2f, 0x68, 0x1e, 0xad, 0xec, 0x48, 0x1b, 0xcf, 0x8e, 0x0d, 0xa2, 0x8d, 0xfd, 0x31, 0x13,
+	0xd2, 0xbb, 0xf1, 0xb2, 0xcf, 0xcb, 0x66, 0xa0, 0x7c, 0x94, 0x71, 0xc8, 0x8f, 0x32, 0x2a, 0x89,
+	0xa3, 0x0c, 0xde, 0x6f, 0x0c, 0xa9, 0x08, 0xa4, 0x16, 0xf0, 0xa2, 0x18, 0xf6, 0xab, 0xa8, 0x09,
+	0x7c, 0x16, 0x76, 0x3c, 0x8f, 0xc9, 0xce, 0xc0, 0xc6, 0x64, 0xe7, 0x8b, 0x2e, 0x4e, 0xfc, 0x7e,
+	0x09, 0x5a, 0x72, 0x60, 0x43, 0x63, 0x93, 0x9f, 0x84, 0x2a, 0xad, 0xed, 0x70, 0x0a, 0xa6, 0x5a,
+	0x07, 0x06, 0x4d, 0x3c, 0x23, 0x8d, 0xe6, 0x77, 0xfd, 0x30, 0x70, 0xe1, 0xcd, 0x28, 0xba, 0x2a,
+	0x9f, 0x3c, 0xba, 0xc2, 0x18, 0x85, 0x78, 0x2e, 0x77, 0x42, 0xf0, 0xb2, 0xf3, 0xe5, 0xa8, 0x43,
+	0xff, 0x10, 0x77, 0x86, 0xde, 0x68, 0xe1, 0xc7, 0x7b, 0x6f, 0xc4, 0x51, 0xf3, 0xdb, 0x2e, 0x52,
+	0x79, 0x9e, 0x76, 0x98, 0x7c, 0x10, 0xd9, 0x23, 0x04, 0x3c, 0xa0, 0x61, 0x18, 0x71, 0x6a, 0x55,
+	0x53, 0xb4, 0x8d, 0x9f, 0x81, 0x33, 0x51, 0x96, 0xcc, 0x48, 0x9a, 0x55, 0xa0, 0x8d, 0x7f, 0xd3,
+	0xe0, 0xd4, 0xce, 0xf1, 0xa8, 0x9f, 0x54, 0x8d, 0x33, 0x50, 0x1b, 0x0f, 0x7a, 0x51, 0xd1, 0x98,
+	0xb7, 0xe8, 0x81, 0x7c, 0x98, 0xff, 0x12, 0x17, 0xce, 0xf8, 0xd9, 0x14, 0x7d, 0xe8, 0xc2, 0xa7,
+	0x45, 0x56, 0x6f, 0x88, 0xb4, 0x1e, 0x51, 0xd0, 0x60, 0x81, 0x15, 0xc5, 0x96, 0x44, 0x2f, 0x0d,
+	0x16, 0x3e, 0x04, 0xa0, 0xf1, 0x54, 0xf7, 0x24, 0x31, 0x14, 0x1d, 0x71, 0x8f, 0x78, 0xcc, 0xbf,
+	0x2c, 0x41, 0x5b, 0xe2, 0xd2, 0x17, 0x1d, 0x5e, 0x66, 0x24, 0x85, 0xe5, 0xe7, 0x94, 0x14, 0x56,
+	0xe6, 0x0f, 0x29, 0xab, 0xaa, 0x90, 0xf2, 0x7b, 0x65, 0x58, 0x8e, 0xb8, 0xf6, 0x00, 0xf7, 0x3c,
+	0x53, 0x12, 0x76, 0x60, 0xd9, 0x8f, 0x71, 0x95, 0xf3, 0xe9, 0x6d, 0x95, 0x0e, 0x65, 0x6c, 0x84,
+	0x99, 0x40, 0x41, 0x4a, 0x39, 0x2c, 0x6f, 0xa7, 0x65, 0x38, 0x16, 0x1f, 0x36, 0x98, 0xb2, 0x92,
+	0x0a, 0xdc, 0x75, 0xd0, 0xb9, 0x86, 0x75, 0x9d, 0x51, 0xd7, 0x47, 0x77, 0x3d, 0xb2, 0x98, 0xee,
+	0x55, 0xcd, 0x16, 0xff, 0xb2, 0x85, 0x73, 0xd0, 0x7e, 0xb4, 0x16, 0x95, 0xe0, 0x78, 0xcc, 0x82,
+	0xc5, 0x65, 0x65, 0xb8, 0x15, 0xd1, 0xb5, 0x8b, 0x80, 0x26, 0x05, 0x0f, 0x2f, 0x35, 0x05, 0x5e,
+	0xef, 0x09, 0x8f, 0xbc, 0x2b, 0xa6, 0xd4, 0x23, 0xe7, 0xc9, 0x0b, 0xb1, 0x3c, 0x99, 0x49, 0x76,
+	0xa8, 0xd0, 0xdd, 0x20, 0x18, 0xd0, 0x42, 0x22, 0x95, 0xec, 0xb0, 0x77, 0x37, 0x18, 0x90, 0x45,
+	0x06, 0x2e, 0xee, 0x0b, 0xd3, 0x8f, 0x06, 0xb7, 0x1c, 0xa4, 0x87, 0x66, 0xb9, 0xff, 0x4a, 0x2c,
+	0x9f, 0x20, 0x0c, 0x4d, 0xfd, 0x64, 0x90, 0xad, 0x8f, 0xf9, 0x95, 0x9b, 0x69, 0xaa, 0xf8, 0x55,
+	0x68, 0x72, 0xa9, 0x38, 0x81, 0x54, 0x01, 0x1b, 0x72, 0x2f, 0x47, 0xcc, 0xab, 0xcf, 0x49, 0xcc,
+	0x6b, 0x33, 0xd4, 0x3e, 0xd4, 0x7b, 0x43, 0xce, 0x91, 0x5f, 0x49, 0x59, 0xcd, 0x5c, 0xd6, 0xe6,
+	0x67, 0xde, 0xdc, 0x9a, 0x26, 0x51, 0x72, 0xdf, 0xf0, 0x01, 0xd4, 0x3c, 0x8a, 0x9d, 0x1f, 0x96,
+	0xbd, 0x9e, 0x2b, 0x7c, 0x8c, 0x10, 0x93, 0x0f, 0x31, 0x7e, 0x1b, 0xf3, 0x96, 0x34, 0xa9, 0x73,
+	0x38, 0xfc, 0x0d, 0x58, 0x60, 0xa8, 0x43, 0x1d, 0xbd, 0x9a, 0xaf, 0xa3, 0x11, 0x73, 0xcc, 0x70,
+	0xa0, 0xb1, 0x03, 0x67, 0xc2, 0xb8, 0x20, 0x62, 0xfd, 0xb6, 0x1d, 0xf4, 0x72, 0xf2, 0x4e, 0xcc,
+	0x1b, 0x59, 0x02, 0xc3, 0xf2, 0x39, 0x76, 0xb6, 0x08, 0x7b, 0xa2, 0xd0, 0x67, 0xfc, 0x4e, 0x09,
+	0x4e, 0x53, 0xc7, 0x9a, 0x3c, 0x28, 0x2a, 0x72, 0x72, 0x69, 0x88, 0xbb, 0x61, 0xe4, 0x98, 0x92,
+	0x2d, 0xad, 0x61, 0xc6, 0xfa, 0xf4, 0xad, 0x74, 0x1d, 0x50, 0x59, 0x9f, 0x88, 0x8e, 0x6a, 0x49,
+	0x2d, 0x84, 0x9e, 0xd4, 0x26, 0x0b, 0x80, 0x91, 0x43, 0xaf, 0xcc, 0xe2, 0xd0, 0xdf, 0x82, 0x16,
+	0xab, 0x71, 0x77, 0x45, 0xba, 0x4b, 0x0d, 0x53, 0xc5, 0x5c, 0x61, 0xfd, 0xbb, 0x61, 0xb7, 0x71,
+	0x0f, 0x5e, 0x49, 0x30, 0x65, 0x8e, 0xcd, 0x37, 0xfe, 0x4c, 0x23, 0x3b, 0x17, 0xbb, 0x32, 0x34,
+	0x7b, 0xfc, 0x7b, 0x41, 0x1c, 0x66, 0x75, 0x1d, 0x2b, 0x69, 0x6f, 0x2c, 0xfd, 0x23, 0x68, 0x8c,
+	0xec, 0xa7, 0x5d, 0x39, 0xa4, 0x2a, 0x90, 0x1c, 0xd4, 0x71, 0x0c, 0xfd, 0x65, 0xdc, 0x87, 0xb3,
+	0x29, 0x52, 0xe7, 0x59, 0xfb, 0xdf, 0x62, 0xf8, 0x7f, 0xc7, 0x73, 0xc7, 0x8f, 0x1c, 0x2f, 0x98,
+	0xf4, 0x06, 0xf1, 0xf3, 0xf2, 0x19, 0x96, 0x5f, 0xe0, 0x3a, 0xe2, 0x27, 0xa9, 0x34, 0xf4, 0xba,
+	0x42, 0xd9, 0xd2, 0x44, 0xf1, 0x45, 0x4b, 0xa1, 0xf8, 0x7f, 0x96, 0x55, 0xc4, 0x73, 0xb8, 0x29,
+	0x21, 0x4c, 0x91, 0x3c, 0x45, 0x59, 0xb2, 0x2f, 0xcf, 0x5a, 0xb2, 0xcf, 0xf0, 0x04, 0x95, 0xe7,
+	0xe4, 0x09, 0x4e, 0x5c, 0x43, 0xdb, 0x84, 0xf8, 0x71, 0x0a, 0x75, 0xe4, 0x27, 0x3d, 0x82, 0xc1,
+	0x18, 0x34, 0x3a, 0x55, 0xe0, 0x57, 0x3c, 0xa7, 0x60, 0x90, 0x06, 0x90, 0x3d, 0x12, 0xbe, 0x96,
+	0x87, 0x02, 0x52, 0x35, 0xfb, 0x9b, 0xd0, 0x51, 0xc9, 0xe6, 0x3c, 0xf2, 0xfe, 0xef, 0x25, 0x80,
+	0x2d, 0x71, 0x21, 0x78, 0x36, 0x67, 0xf1, 0x3a, 0x48, 0xe1, 0x4a, 0xa4, 0xe5, 0xb2, 0xec, 0x58,
+	0x44, 0x11, 0x44, 0x42, 0x4b, 0x60, 0x52, 0x49, 0xae, 0x45, 0xf1, 0x48, 0xba, 0xc2, 0x44, 0x21,
+	0x69, 0x9f, 0xcf, 0x43, 0x83, 0x1c, 0xcc, 0x12, 0xe5, 0xb2, 0xc2, 0x1b, 0xcf, 0xd8, 0x41, 0x54,
+	0xce, 0x22, 0xa7, 0x72, 0x01, 0x2e, 0x83, 0xe0, 0x67, 0x75, 0xbd, 0x1a, 0x69, 0x22, 0xea, 0xd3,
+	0x50, 0xdd, 0x77, 0x06, 0x36, 0xbb, 0x5c, 0xd1, 0x30, 0x59, 0x83, 0x9c, 0x10, 0xb3, 0x4b, 0x7a,
+	0xf5, 0xc2, 0x97, 0x71, 0xd8, 0x3d, 0x3d, 0xa4, 0x94, 0x48, 0x12, 0x21, 0x82, 0xa9, 0x75, 0x8b,
+	0xd7, 0xf4, 0x79, 0x27, 0x21, 0x95, 0x14, 0x13, 0x57, 0x22, 0xd6, 0x52, 0xdb, 0x44, 0xcc, 0x1d,
+	0x35, 0x75, 0x9b, 0xae, 0xc5, 0xac, 0xc8, 0x72, 0x86, 0x5f, 0x61, 0x03, 0x99, 0x41, 0x8b, 0x86,
+	0xe4, 0x25, 0xe2, 0x64, 0xf1, 0x84, 0x33, 0x8e, 0x15, 0x96, 0x86, 0x6a, 0xd8, 0x24, 0x37, 0x35,
+	0x38, 0xcb, 0xd8, 0x9d, 0x67, 0x96, 0x76, 0x12, 0x96, 0x6d, 0xd2, 0x6b, 0xcf, 0xb8, 0x14, 0xdb,
+	0xf3, 0x5c, 0xaf, 0x8b, 0xcc, 0xf5, 0x7b, 0x07, 0x36, 0x8f, 0xf2, 0x17, 0x69, 0xe7, 0x36, 0xeb,
+	0x33, 0xfe, 0xae, 0x02, 0xcb, 0xd1, 0x52, 0xc2, 0xa3, 0x7f, 0xe4, 0x32, 0x3f, 0xfa, 0x77, 0xc8,
+	0xfe, 0x82, 0xc7, 0xac, 0xa4, 0x90, 0x80, 0x8d, 0x52, 0x5b, 0x33, 0x1b, 0xbc, 0x17, 0x37, 0x01,
+	0x9d, 0x3b, 0x61, 0x10, 0xb9, 0x46, 0x1e, 0x49, 0x00, 0x84, 0x5d, 0x5c, 0x00, 0x62, 0x82, 0x54,
+	0x29, 0x20, 0x48, 0xd5, 0x02, 0x82, 0x54, 0x53, 0x08, 0x12, 0x06, 0x71, 0x7b, 0x93, 0xfe, 0x91,
+	0x1d, 0xf0, 0xb8, 0x8f, 0xb7, 0xe2, 0x02, 0x56, 0x4f, 0x08, 0x98, 0x90, 0xa3, 0x86, 0x2c, 0x47,
+	0x38, 0x24, 0xf4, 0xd4, 0x3e, 0x3d, 0x41, 0x43, 0x06, 0x73, 0x17, 0xed, 0xeb, 0xef, 0x85, 0x41,
+	0x61, 0x93, 0x6a, 0x94, 0xa1, 0x30, 0x48, 0x09, 0x29, 0x09, 0x43, 0xc2, 0x2b, 0xb0, 0x22, 0xb1,
+	0x83, 0xca, 0x19, 0x3b, 0x66, 0x93, 0x72, 0x06, 0xea, 0x41, 0x30, 0x8b, 0x88, 0x58, 0x42, 0xe1,
+	0x96, 0x58, 0xaa, 0x26, 0x7a, 0x29, 0x98, 0x10, 0xf7, 0xe5, 0x13, 0x8a, 0xfb, 0x39, 0x94, 0x3a,
+	0x96, 0x63, 0xf9, 0xed, 0x95, 0x78, 0x39, 0xa4, 0x90, 0x26, 0x7c, 0x0b, 0xf4, 0x68, 0x89, 0xf3,
+	0x05, 0xa6, 0x09, 0x19, 0x2a, 0x25, 0x65, 0xc8, 0xf8, 0x73, 0x0d, 0x56, 0xe5, 0xc9, 0x66, 0x75,
+	0xdc, 0x1f, 0x61, 0xa2, 0x43, 0xd1, 0x74, 0x89, 0x09, 0x51, 0x9f, 0x4b, 0x26, 0x36, 0x0f, 0xf3,
+	0x9c, 0xc8, 0x92, 0x22, 0x63, 0x9e, 0xba, 0xde, 0x91, 0x33, 0x3a, 0xe8, 0x12, 0xca, 0x44, 0xb9,
+	0x96, 0x77, 0x92, 0xc3, 0x33, 0xdf, 0xf8, 0x0d, 0x0d, 0x2e, 0x3e, 0x1c, 0x23, 0x1a, 0x5b, 0x8a,
+	0x60, 0xe6, 0xbd, 0xe1, 0x28, 0xae, 0x18, 0x96, 0x72, 0xb6, 0x59, 0x9a, 0xcf, 0xe7, 0x57, 0x0c,
+	0x49, 0xdc, 0xc7, 0xa9, 0x49, 0xdd, 0x09, 0x9e, 0x9d, 0x1a, 0xb4, 0x58, 0x4f, 0x38, 0xba, 0xf0,
+	0xb1, 0x48, 0xd8, 0x8e, 0x1d, 0xfc, 0x96, 0x4f, 0x74, 0xf0, 0x6b, 0x6c, 0xc3, 0x39, 0x14, 0x24,
+	0x7b, 0x64, 0xc5, 0x16, 0x32, 0x73, 0x51, 0x6b, 0x0c, 0x1d, 0x15, 0xba, 0x79, 0x24, 0x95, 0x05,
+	0xbe, 0x5d, 0x8f, 0xa0, 0x0d, 0xb8, 0xb1, 0x26, 0xf1, 0x16, 0x9d, 0x27, 0x30, 0xfe, 0xa2, 0x04,
+	0x67, 0x6f, 0x5b, 0x16, 0xb7, 0xf3, 0x3c, 0x94, 0x7b, 0x51, 0x51, 0x76, 0x32, 0x0a, 0x2d, 0xa7,
+	0xa3, 0xd0, 0xe7, 0x65, 0x7b, 0xb9, 0x17, 0x22, 0xa7, 0x7e, 0xdc, 0x05, 0x7b, 0xec, 0xd6, 0xd4,
+	0x07, 0xfc, 0x78, 0x94, 0x14, 0x0e, 0xa8, 0x1b, 0x9e, 0x1e, 0x9c, 0xd5, 0xc3, 0xe2, 0x1c, 0xee,
+	0x4f, 0x3b, 0xcd, 0xac, 0x39, 0xed, 0x48, 0xc8, 0x91, 0xb1, 0xcb, 0x8a, 0xbc, 0x8b, 0x24, 0x12,
+	0xa3, 0x5d, 0x28, 0x6f, 0xc6, 0xff, 0x94, 0xa0, 0x4d, 0x6e, 0xcb, 0xfc, 0xff, 0xd9, 0xa0, 0x4f,
+	0xe1, 0xb4, 0x8f, 0x0b, 0xee, 0x4a, 0x09, 0x38, 0x4a, 0xef, 0x67, 0x3c, 0x88, 0x7d, 0x4b, 0x55,
+	0x86, 0x57, 0xde, 0x26, 0x32, 0x57, 0xfd, 0x58, 0x3f, 0x76, 0xeb, 0x6f, 0xc2, 0x8a, 0x7c, 0x85,
+	0x8d, 0x90, 0x56, 0xa7, 0x2c, 0x5f, 0x92, 0xae, 0xa9, 0xa1, 0xf5, 0xfe, 0x0c, 0x5e, 0x7d, 0x88,
+	0x7b, 0x1a, 0x6c, 0x45, 0x57, 0xad, 0xe6, 0xcc, 0x3f, 0x71, 0xa3, 0x23, 0xc6, 0xa7, 0x5e, 0x89,
+	0x58, 0xbe, 0xe1, 0x42, 0x67, 0xbb, 0xe7, 0x1d, 0x85, 0xe5, 0xec, 0x3b, 0xec, 0xe6, 0xcb, 0x0b,
+	0x9c, 0x70, 0x5f, 0xdc, 0x01, 0x33, 0xed, 0x7d, 0xdb, 0xb3, 0x47, 0x7d, 0xfb, 0x9e, 0xdb, 0x3f,
+	0x22, 0x01, 0x49, 0xc0, 0x1e, 0xea, 0x69, 0x52, 0xec, 0x7a, 0x47, 0x7a, 0x87, 0x57, 0x8a, 0xbd,
+	0xc3, 0x9b, 0xf2, 0xe6, 0xd4, 0xf8, 0x51, 0x09, 0xce, 0xdc, 0x1e, 0x04, 0xb6, 0x17, 0x55, 0x18,
+	0x4e, 0x52, 0x2c, 0x89, 0xaa, 0x17, 0xa5, 0x59, 0xaa, 0x17, 0x05, 0x4e, 0x2b, 0x55, 0xb5, 0x96,
+	0xca, 0x8c, 0xb5, 0x96, 0xdb, 0x00, 0x08, 0x3b, 0xb6, 0x11, 0xbb, 0x1d, 0xe6, 0x7e, 0x05, 0x02,
+	0x1c, 0x69, 0x90, 0xf1, 0x29, 0xb4, 0xbe, 0xde, 0xdf, 0x74, 0x47, 0xfb, 0x8e, 0x37, 0x0c, 0x19,
+	0x95, 0x52, 0x3a, 0xad, 0x80, 0xd2, 0x95, 0x52, 0x4a, 0x67, 0x38, 0xb0, 0x2a, 0xe1, 0x9e, 0xd3,
+	0x70, 0x1d, 0xf4, 0xbb, 0xfb, 0xce, 0xc8, 0xa1, 0x37, 0xcb, 0x4a, 0x34, 0x40, 0x85, 0x83, 0xfe,
+	0x5d, 0xde, 0x63, 0x7c, 0x5f, 0x83, 0xf3, 0xa6, 0x4d, 0x94, 0x27, 0xbc, 0xa4, 0xb3, 0x4b, 0xee,
+	0x09, 0xcf, 0x11, 0x50, 0xbc, 0x0b, 0x15, 0x74, 0xc4, 0x19, 0x07, 0xec, 0xc4, 0x45, 0xc7, 0x26,
+	0x32, 0x29, 0xb0, 0xf1, 0x37, 0x1a, 0x9c, 0x0e, 0x8f, 0x21, 0x63, 0x2a, 0x1c, 0x17, 0x5b, 0x2d,
+	0xf5, 0x6a, 0x2b, 0xe7, 0x71, 0x2e, 0x9a, 0x2e, 0x6b, 0x4f, 0x36, 0x90, 0x35, 0x6b, 0x8f, 0xda,
+	0x46, 0x45, 0xa4, 0x5c, 0x51, 0x46, 0xca, 0x49, 0xc1, 0xaf, 0xa6, 0x05, 0xff, 0xda, 0x47, 0xe2,
+	0x8e, 0x33, 0x29, 0xf1, 0xeb, 0x0b, 0x50, 0xbe, 0x6f, 0x3f, 0x6d, 0x7d, 0x49, 0x07, 0xa8, 0xdd,
+	0x77, 0xbd, 0x61, 0x6f, 0xd0, 0xd2, 0xf4, 0x26, 0x2c, 0xf0, 0x03, 0xd6, 0x56, 0x49, 0x5f, 0x82,
+	0xc6, 0x66, 0x78, 0x10, 0xd5, 0x2a, 0x5f, 0xfb, 0x03, 0x8c, 0x40, 0x53, 0x47, 0x80, 0x98, 0x2f,
+	0xc1, 0xc3, 0x51, 0x9f, 0x9f, 0x8d, 0x22, 0xb6, 0x45, 0xa8, 0x87, 0x27, 0xa5, 0x0c, 0xdf, 0xae,
+	0x4b, 0xa1, 0x11, 0x5f, 0x0b, 0x16, 0xd9, 0xc0, 0x49, 0xbf, 0x8f, 0x09, 0x58, 0xab, 0x2c, 0x7a,
+	0xee, 0xf6, 0x9c, 0xc1, 0xc4, 0xb3, 0x5b, 0x15, 0x32, 0xe7, 0xae, 0x6b, 0xda, 0x03, 0x1b, 0x37,
+	0xa8, 0x55, 0xd5, 0x75, 0x58, 0xe6, 0x8d, 0x70, 0x50, 0x4d, 0xea, 0x0b, 0x87, 0x2d, 0x5c, 0x7b,
+	0x2c, 0x1f, 0xd6, 0xd0, 0xe5, 0x9d, 0x85, 0x53, 0x0f, 0x47, 0x96, 0x8d, 0x02, 0x65, 0x5b, 0xd1,
+	0x27, 0x24, 0xf0, 0x14, 0xac, 0x6c, 0xdb, 0xde, 0x81, 0x2d, 0x75, 0x96, 0xf4, 0x55, 0x58, 0xda,
+	0x76, 0x9e, 0x49, 0x5d, 0x65, 0xa3, 0x52, 0xd7, 0x5a, 0xda, 0xfa, 0x7f, 0x5d, 0x81, 0x06, 0x11,
+	0x83, 0x4d, 0xf2, 0xe8, 0x5e, 0x1f, 0x80, 0x4e, 0x5f, 0x12, 0xa1, 0x0d, 0x1f, 0x89, 0x57, 0x87,
+	0xfa, 0x5a, 0x42, 0x72, 0x58, 0x23, 0x0d, 0xc8, 0x25, 0xa5, 0x73, 0x59, 0x09, 0x9f, 0x00, 0x36,
+	0xbe, 0xa4, 0x0f, 0xe9, 0x6c, 0xa4, 0x1a, 0xba, 0xeb, 0xf4, 0x8f, 0xc2, 0xe0, 0xf2, 0x56, 0xc6,
+	0xd3, 0xad, 0x34, 0x68, 0x38, 0xdf, 0xeb, 0xca, 0xf9, 0xd8, 0x53, 0xaf, 0x50, 0x69, 0x71, 0xba,
+	0xcf, 0xa8, 0x60, 0x47, 0x91, 0x7a, 0x38, 0xe1, 0x7a, 0xf6, 0x84, 0x29, 0xe0, 0x13, 0x4e, 0x79,
+	0x0f, 0xaa, 0x54, 0xdc, 0x74, 0xd5, 0xf9, 0xb5, 0xfc, 0x77, 0x06, 0x9d, 0x4b, 0xd9, 0x00, 0x02,
+	0xdb, 0xb7, 0x60, 0x25, 0xf1, 0x98, 0x58, 0x57, 0x79, 0x77, 0xf5, 0xb3, 0xf0, 0xce, 0xb5, 0x22,
+	0xa0, 0x62, 0xae, 0x03, 0x58, 0x8e, 0xbf, 0x40, 0xd2, 0xaf, 0x16, 0x78, 0xc7, 0xc8, 0x66, 0x7a,
+	0xab, 0xf0, 0x8b, 0x47, 0x2a, 0x04, 0xad, 0xe4, 0x33, 0x57, 0xfd, 0x5a, 0x2e, 0x82, 0xb8, 0xb0,
+	0xbd, 0x5d, 0x08, 0x56, 0x4c, 0x77, 0x4c, 0x85, 0x20, 0xf5, 0xc6, 0x30, 0x29, 0xe3, 0x21, 0x9a,
+	0xac, 0xc7, 0x8f, 0x9d, 0x9b, 0x85, 0xe1, 0xc5, 0xd4, 0xbf, 0xc4, 0x2e, 0xa9, 0xa9, 0xde, 0xe9,
+	0xe9, 0xef, 0xa8, 0xd1, 0xe5, 0x3c, 0x30, 0xec, 0xac, 0x9f, 0x64, 0x88, 0x20, 0xe2, 0xbb, 0xf4,
+	0x76, 0x99, 0xe2, 0xa5, 0x5b, 0x52, 0xef, 0x42, 0x7c, 0xd9, 0x8f, 0xf8, 0x3a, 0xef, 0x9c, 0x60,
+	0x84, 0x20, 0xc0, 0x4d, 0xbe, 0x23, 0x0e, 0xd5, 0xf0, 0xe6, 0x54, 0xa9, 0x99, 0x4d, 0x07, 0x7f,
+	0x1e, 0x56, 0x12, 0xf1, 0xae, 0x5e, 0x3c, 0x26, 0xee, 0xe4, 0xf9, 0x76, 0xa6, 0x92, 0x89, 0xdb,
+	0x64, 0x7a, 0x86, 0xf4, 0x2b, 0x6e, 0x9c, 0x75, 0xae, 0x15, 0x01, 0x15, 0x0b, 0x19, 0x63, 0x2c,
+	0x12, 0xff, 0xf8, 0x68, 0x5d, 0x7f, 0xbb, 0xf0, 0x6c, 0x8f, 0xd6, 0x3b, 0xd7, 0x8b, 0xcf, 0xf7,
+	0x68, 0x1d, 0x67, 0xf4, 0xa9, 0x81, 0x4e, 0xdc, 0x48, 0xd2, 0x33, 0xb0, 0xa8, 0x6f, 0x5e, 0x75,
+	0x6e, 0x14, 0x84, 0x16, 0xcb, 0x7c, 0x02, 0xa7, 0x14, 0x17, 0xc7, 0xf4, 0x1b, 0xb9, 0xe2, 0x91,
+	0xbc, 0x31, 0xd7, 0x59, 0x2b, 0x0a, 0x2e, 0xb9, 0x87, 0x56, 0x48, 0xd7, 0xed, 0xc1, 0x80, 0x39,
+	0xff, 0xeb, 0x59, 0x9e, 0x2f, 0x06, 0x96, 0xb1, 0xd4, 0x4c, 0x68, 0x31, 0xe5, 0x77, 0x40, 0xdf,
+	0x39, 0x24, 0x05, 0x5d, 0x8c, 0x2f, 0x0f, 0x26, 0x5e, 0x8f, 0x85, 0xc4, 0x59, 0x0e, 0x30, 0x0d,
+	0x9a, 0xa1, 0x88, 0xb9, 0x23, 0xc4, 0xe4, 0x5d, 0x00, 0x24, 0x6d, 0xdb, 0x46, 0x7d, 0x41, 0xed,
+	0x7f, 0x33, 0x8b, 0x76, 0x0e, 0x10, 0x4e, 0x75, 0x65, 0x2a, 0x9c, 0xcc, 0xd0, 0xed, 0xde, 0x88,
+	0x1c, 0x78, 0x44, 0x0f, 0x7d, 0xd4, 0x0c, 0x4d, 0x82, 0xe5, 0x33, 0x34, 0x0d, 0x2d, 0xa6, 0x7c,
+	0x2a, 0xe2, 0x17, 0xe9, 0x7c, 0x3b, 0x3f, 0x7e, 0x49, 0xdf, 0xad, 0x4a, 0xda, 0xf6, 0x1c, 0x78,
+	0x31, 0xf1, 0xe7, 0x1a, 0xbd, 0xee, 0x98, 0x00, 0x78, 0xec, 0x04, 0x87, 0xe4, 0x66, 0x8d, 0x5f,
+	0x84, 0x04, 0x0a, 0x78, 0x02, 0x12, 0x38, 0xbc, 0x20, 0xc1, 0x82, 0xa5, 0xd8, 0x59, 0xb2, 0xae,
+	0x7a, 0x00, 0xa3, 0x3a, 0x82, 0xef, 0x5c, 0x9d, 0x0e, 0x28, 0x66, 0xd9, 0x87, 0xa5, 0x58, 0x76,
+	0xa0, 0x9c, 0x45, 0x95, 0x3f, 0x24, 0x8d, 0x5d, 0x42, 0x3b, 0x92, 0x0c, 0x45, 0xd3, 0x93, 0x3e,
+	0x32, 0xd3, 0x8b, 0x1d, 0xb0, 0xe6, 0x99, 0x9e, 0xec, 0x73, 0x38, 0x66, 0xcd, 0x13, 0x87, 0xd2,
+	0x6a, 0x57, 0xa1, 0x3c, 0x63, 0x57, 0x5a, 0xf3, 0x8c, 0x33, 0x6e, 0x9c, 0xeb, 0x31, 0xd4, 0xf8,
+	0xdf, 0xfd, 0x5c, 0xce, 0x2f, 0x4e, 0x73, 0xec, 0x6f, 0x4c, 0x81, 0x12, 0x88, 0x8f, 0xe0, 0x6c,
+	0x46, 0x69, 0x5a, 0x19, 0x65, 0xe4, 0x97, 0xb1, 0xa7, 0xf9, 0x3f, 0x31, 0x59, 0xaa, 0xf2, 0x9c,
+	0x33, 0x59, 0x56, 0x95, 0x7a, 0xda, 0x64, 0x5d, 0x58, 0x4d, 0x55, 0xf6, 0x94, 0x0e, 0x30, 0xab,
+	0xfe, 0x37, 0x6d, 0x82, 0x03, 0x78, 0x45, 0x59, 0xc5, 0x52, 0xc6, 0x26, 0x79, 0xf5, 0xae, 0x69,
+	0x13, 0xf5, 0xe1, 0x94, 0xa2, 0x76, 0xa5, 0xf4, 0x71, 0xd9, 0x35, 0xae, 0x69, 0x93, 0xec, 0x43,
+	0x67, 0xc3, 0x73, 0x7b, 0x56, 0xbf, 0xe7, 0x07, 0xb4, 0x9e, 0x44, 0x12, 0xc5, 0x30, 0x38, 0x54,
+	0x67, 0x0e, 0xca, 0xaa, 0xd3, 0xb4, 0x79, 0xf6, 0xa0, 0x49, 0xb7, 0x92, 0xfd, 0x25, 0x8b, 0xae,
+	0xf6, 0x10, 0x12, 0x44, 0x86, 0xd9, 0x51, 0x01, 0x0a, 0xa1, 0xde, 0xc5, 0x39, 0xe8, 0xc1, 0xdc,
+	0x16, 0x79, 0x82, 0x9e, 0xf4, 0x56, 0xf4, 0x5d, 0xfa, 0x9a, 0x04, 0x50, 0x98, 0x43, 0x4b, 0x34,
+	0x66, 0xc7, 0x11, 0x6c, 0x9f, 0xaf, 0xaa, 0xf0, 0xc6, 0x40, 0x32, 0x72, 0x1c, 0x25, 0xa4, 0xe4,
+	0xe7, 0x4f, 0xcb, 0x91, 0xac, 0x98, 0xee, 0x66, 0x06, 0x92, 0x14, 0x64, 0x38, 0xeb, 0xad, 0xe2,
+	0x03, 0x64, 0xbf, 0x10, 0xd2, 0xb5, 0x45, 0x4f, 0x05, 0xaf, 0xe4, 0x91, 0x2e, 0x87, 0xa7, 0x57,
+	0xa7, 0x03, 0x8a, 0x59, 0x1e, 0x40, 0x83, 0x48, 0x27, 0xdb, 0x9e, 0xcb, 0xaa, 0x81, 0xe2, 0x73,
+	0xf1, 0xcd, 0xb9, 0x63, 0xfb, 0x7d, 0xcf, 0xd9, 0xe3, 0x9b, 0xae, 0x24, 0x27, 0x06, 0x92, 0xbb,
+	0x39, 0x09, 0x48, 0x41, 0xf9, 0x84, 0xc6, 0x0c, 0x82, 0x75, 0xdc, 0x54, 0xde, 0x98, 0xb6, 0xbf,
+	0x71, 0x33, 0xb9, 0x56, 0x14, 0x5c, 0x4c, 0xfb, 0x8b, 0x34, 0x0f, 0xa2, 0xdf, 0x37, 0x26, 0xce,
+	0xc0, 0x7a, 0xc0, 0xef, 0x7c, 0xeb, 0xb7, 0xf2, 0x50, 0xc5, 0x40, 0x33, 0xc3, 0xbf, 0x9c, 0x11,
+	0x62, 0xfe, 0x47, 0xb0, 0x78, 0xdb, 0xeb, 0x1f, 0x3a, 0x4f, 0x38, 0x77, 0x95, 0x52, 0x21, 0x43,
+	0x14, 0xdc, 0x36, 0xc4, 0x8b, 0xb3, 0x04, 0xae, 0x97, 0x87, 0x57, 0x86, 0x28, 0x88, 0xf7, 0x3b,
+	0x3c, 0x71, 0xc7, 0x11, 0x9c, 0xaa, 0x7c, 0x1d, 0x4a, 0x41, 0x4e, 0xd3, 0x21, 0xc5, 0x00, 0xc1,
+	0xac, 0x9f, 0x83, 0x86, 0x28, 0x03, 0xeb, 0xaa, 0x1b, 0x9e, 0xc9, 0x02, 0x74, 0xe7, 0x72, 0x3e,
+	0x90, 0xc0, 0x6c, 0xc3, 0x69, 0x55, 0xd1, 0x57, 0x59, 0x8f, 0xc8, 0xa9, 0x0e, 0x4f, 0xe1, 0xde,
+	0xfa, 0x1f, 0x01, 0xd4, 0xc3, 0x81, 0x5f, 0x70, 0x95, 0xef, 0x25, 0x94, 0xdd, 0x30, 0xe5, 0x4f,
+	0xfc, 0x2d, 0x89, 0xd2, 0xdd, 0xa9, 0xff, 0xba, 0x64, 0x9a, 0x20, 0x3e, 0xe6, 0xff, 0xe8, 0x29,
+	0xf2, 0xe1, 0x2b, 0x59, 0xa5, 0xbb, 0x64, 0x2a, 0x3c, 0x55, 0xc2, 0xff, 0x2f, 0x67, 0x83, 0xf7,
+	0x01, 0xa4, 0x3c, 0x30, 0xff, 0x8a, 0x3e, 0x49, 0x6d, 0xa6, 0x71, 0x6b, 0xa8, 0x4c, 0xf5, 0xde,
+	0x2a, 0x72, 0xdd, 0x39, 0x3b, 0x5c, 0xcf, 0x4e, 0xf0, 0x1e, 0xc2, 0xa2, 0xfc, 0x78, 0x46, 0x57,
+	0xfe, 0x47, 0x63, 0xfa, 0x75, 0xcd, 0xb4, 0x55, 0x6c, 0x9f, 0x30, 0x0b, 0x98, 0x82, 0x0e, 0xb3,
+	0xa6, 0xf4, 0x75, 0x08, 0x65, 0xd6, 0x94, 0x79, 0x09, 0x43, 0x99, 0x35, 0x65, 0xdf, 0xb1, 0x60,
+	0x15, 0xdc, 0xe4, 0x19, 0xbf, 0xb2, 0x82, 0x9b, 0x71, 0x6b, 0x42, 0x59, 0xc1, 0xcd, 0xba, 0x34,
+	0x20, 0xe9, 0x5f, 0x6e, 0x9e, 0xab, 0xfa, 0xc3, 0xd9, 0x29, 0xcc, 0xdb, 0x78, 0xf7, 0xd3, 0x77,
+	0x0e, 0x30, 0x61, 0x9f, 0xec, 0x91, 0x2f, 0x37, 0x19, 0xe8, 0x0d, 0xc7, 0xe5, 0xbf, 0x6e, 0x86,
+	0x7a, 0x74, 0x93, 0x8e, 0xbe, 0x49, 0xa6, 0x19, 0xef, 0xed, 0xd5, 0x68, 0xeb, 0xdd, 0xff, 0x05,
+	0xa2, 0xbf, 0x17, 0x5c, 0x26, 0x59, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5574,6 +5575,11 @@ type DataCoordClient interface {
 	GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
+	// ArchiveIndex moves the index files of the released collection into the archival storage class
+	ArchiveIndex(ctx context.Context, in *indexpb.ArchiveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// RestoreIndex restores the archived index files of the collection asynchronously
+	RestoreIndex(ctx context.Context, in *indexpb.RestoreIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetIndexArchiveState(ctx context.Context, in *indexpb.GetIndexArchiveStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexArchiveStateResponse, error)
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
@@ -5964,6 +5970,33 @@ func (c *dataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb
 	return out, nil
 }
 
+func (c *dataCoordClient) ArchiveIndex(ctx context.Context, in *indexpb.ArchiveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ArchiveIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RestoreIndex(ctx context.Context, in *indexpb.RestoreIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/RestoreIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetIndexArchiveState(ctx context.Context, in *indexpb.GetIndexArchiveStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexArchiveStateResponse, error) {
+	out := new(indexpb.GetIndexArchiveStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetIndexArchiveState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error) {
 	out := new(GcConfirmResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GcConfirm", in, out, opts...)
@@ -6030,6 +6063,11 @@ type DataCoordServer interface {
 	GetIndexStatistics(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
+	// ArchiveIndex moves the index files of the released collection into the archival storage class
+	ArchiveIndex(context.Context, *indexpb.ArchiveIndexRequest) (*commonpb.Status, error)
+	// RestoreIndex restores the archived index files of the collection asynchronously
+	RestoreIndex(context.Context, *indexpb.RestoreIndexRequest) (*commonpb.Status, error)
+	GetIndexArchiveState(context.Context, *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error)
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
 }
@@ -6164,6 +6202,15 @@ func (*UnimplementedDataCoordServer) GetIndexStatistics(ctx context.Context, req
 func (*UnimplementedDataCoordServer) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIndexBuildProgress not implemented")
 }
+func (*UnimplementedDataCoordServer) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreIndex not implemented")
+}
+func (*UnimplementedDataCoordServer) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexArchiveState not implemented")
+}
 func (*UnimplementedDataCoordServer) GcConfirm(ctx context.Context, req *GcConfirmRequest) (*GcConfirmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GcConfirm not implemented")
 }
@@ -6931,6 +6978,60 @@ func _DataCoord_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ArchiveIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.ArchiveIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ArchiveIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ArchiveIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ArchiveIndex(ctx, req.(*indexpb.ArchiveIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_RestoreIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.RestoreIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RestoreIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/RestoreIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RestoreIndex(ctx, req.(*indexpb.RestoreIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetIndexArchiveState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(indexpb.GetIndexArchiveStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetIndexArchiveState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetIndexArchiveState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetIndexArchiveState(ctx, req.(*indexpb.GetIndexArchiveStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_GcConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GcConfirmRequest)
 	if err := dec(in); err != nil {
@@ -7139,6 +7240,18 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetIndexBuildProgress",
 			Handler:    _DataCoord_GetIndexBuildProgress_Handler,
 		},
+		{
+			MethodName: "ArchiveIndex",
+			Handler:    _DataCoord_ArchiveIndex_Handler,
+		},
+		{
+			MethodName: "RestoreIndex",
+			Handler:    _DataCoord_RestoreIndex_Handler,
+		},
+		{
+			MethodName: "GetIndexArchiveState",
+			Handler:    _DataCoord_GetIndexArchiveState_Handler,
+		},
 		{
 			MethodName: "GcConfirm",
 			Handler:    _DataCoord_GcConfirm_Handler,
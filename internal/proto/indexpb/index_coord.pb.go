@@ -27,6 +27,83 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type IndexArchiveState int32
+
+const (
+	IndexArchiveState_NotArchived IndexArchiveState = 0
+	IndexArchiveState_Archived    IndexArchiveState = 1
+	IndexArchiveState_Restoring   IndexArchiveState = 2
+)
+
+var IndexArchiveState_name = map[int32]string{
+	0: "NotArchived",
+	1: "Archived",
+	2: "Restoring",
+}
+
+var IndexArchiveState_value = map[string]int32{
+	"NotArchived": 0,
+	"Archived":    1,
+	"Restoring":   2,
+}
+
+func (x IndexArchiveState) String() string {
+	return proto.EnumName(IndexArchiveState_name, int32(x))
+}
+
+func (IndexArchiveState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{0}
+}
+
+type JobType int32
+
+const (
+	JobType_JobTypeIndexJob JobType = 0
+	JobType_JobTypeStatsJob JobType = 1
+)
+
+var JobType_name = map[int32]string{
+	0: "JobTypeIndexJob",
+	1: "JobTypeStatsJob",
+}
+
+var JobType_value = map[string]int32{
+	"JobTypeIndexJob": 0,
+	"JobTypeStatsJob": 1,
+}
+
+func (x JobType) String() string {
+	return proto.EnumName(JobType_name, int32(x))
+}
+
+func (JobType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{1}
+}
+
+// StatsType is the kind of statistics computed by a stats job. Sorting a segment by primary key is not
+// offered, the insert binlogs are always serialized in row ID order.
+type StatsType int32
+
+const (
+	StatsType_PrimaryKeyStats StatsType = 0
+)
+
+var StatsType_name = map[int32]string{
+	0: "PrimaryKeyStats",
+}
+
+var StatsType_value = map[string]int32{
+	"PrimaryKeyStats": 0,
+}
+
+func (x StatsType) String() string {
+	return proto.EnumName(StatsType_name, int32(x))
+}
+
+func (StatsType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{2}
+}
+
 type IndexInfo struct {
 	CollectionID int64                    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	FieldID      int64                    `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -236,6 +313,7 @@ type SegmentIndex struct {
 	CreateTime           uint64              `protobuf:"varint,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	SerializeSize        uint64              `protobuf:"varint,14,opt,name=serialize_size,json=serializeSize,proto3" json:"serialize_size,omitempty"`
 	WriteHandoff         bool                `protobuf:"varint,15,opt,name=write_handoff,json=writeHandoff,proto3" json:"write_handoff,omitempty"`
+	ArchiveState         IndexArchiveState   `protobuf:"varint,16,opt,name=archive_state,json=archiveState,proto3,enum=milvus.proto.index.IndexArchiveState" json:"archive_state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -371,6 +449,13 @@ func (m *SegmentIndex) GetWriteHandoff() bool {
 	return false
 }
 
+func (m *SegmentIndex) GetArchiveState() IndexArchiveState {
+	if m != nil {
+		return m.ArchiveState
+	}
+	return IndexArchiveState_NotArchived
+}
+
 type RegisterNodeRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Address              *commonpb.Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -883,19 +968,22 @@ func (m *GetIndexInfoRequest) GetIndexName() string {
 }
 
 type IndexFilePathInfo struct {
-	SegmentID            int64                    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldID              int64                    `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
-	IndexID              int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64                    `protobuf:"varint,4,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	IndexFilePaths       []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	SerializedSize       uint64                   `protobuf:"varint,8,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	SegmentID      int64                    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID        int64                    `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexID        int64                    `protobuf:"varint,3,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID        int64                    `protobuf:"varint,4,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexName      string                   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexParams    []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	IndexFilePaths []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	SerializedSize uint64                   `protobuf:"varint,8,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	IndexVersion   int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	NumRows        int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the object storage of the collection, empty if it's the global one
+	StorageEndpoint      string   `protobuf:"bytes,11,opt,name=storage_endpoint,json=storageEndpoint,proto3" json:"storage_endpoint,omitempty"`
+	StorageRequesterPays bool     `protobuf:"varint,12,opt,name=storage_requester_pays,json=storageRequesterPays,proto3" json:"storage_requester_pays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexFilePathInfo) Reset()         { *m = IndexFilePathInfo{} }
@@ -993,6 +1081,20 @@ func (m *IndexFilePathInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *IndexFilePathInfo) GetStorageEndpoint() string {
+	if m != nil {
+		return m.StorageEndpoint
+	}
+	return ""
+}
+
+func (m *IndexFilePathInfo) GetStorageRequesterPays() bool {
+	if m != nil {
+		return m.StorageRequesterPays
+	}
+	return false
+}
+
 type SegmentInfo struct {
 	CollectionID         int64                `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64                `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1379,21 +1481,32 @@ func (m *GetIndexBuildProgressResponse) GetPendingIndexRows() int64 {
 }
 
 type StorageConfig struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AccessKeyID          string   `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
-	SecretAccessKey      string   `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
-	UseSSL               bool     `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
-	BucketName           string   `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	RootPath             string   `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
-	UseIAM               bool     `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
-	IAMEndpoint          string   `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
-	StorageType          string   `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
-	UseVirtualHost       bool     `protobuf:"varint,10,opt,name=use_virtual_host,json=useVirtualHost,proto3" json:"use_virtual_host,omitempty"`
-	Region               string   `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`
-	CloudProvider        string   `protobuf:"bytes,12,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AccessKeyID     string `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,3,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	UseSSL          bool   `protobuf:"varint,4,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
+	BucketName      string `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	RootPath        string `protobuf:"bytes,6,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	UseIAM          bool   `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
+	IAMEndpoint     string `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
+	StorageType     string `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	UseVirtualHost  bool   `protobuf:"varint,10,opt,name=use_virtual_host,json=useVirtualHost,proto3" json:"use_virtual_host,omitempty"`
+	Region          string `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`
+	CloudProvider   string `protobuf:"bytes,12,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	// server-side encryption of the uploaded objects: "SSE-S3", "SSE-KMS" or "SSE-C", disabled if empty
+	SseType string `protobuf:"bytes,13,opt,name=sse_type,json=sseType,proto3" json:"sse_type,omitempty"`
+	// KMS key id of SSE-KMS, or base64 encoded 256-bit customer key of SSE-C
+	SseKey string `protobuf:"bytes,14,opt,name=sse_key,json=sseKey,proto3" json:"sse_key,omitempty"`
+	// the requester is charged for the requests and transfer of the bucket
+	RequesterPays bool `protobuf:"varint,15,opt,name=requester_pays,json=requesterPays,proto3" json:"requester_pays,omitempty"`
+	// use the dual-stack and/or FIPS endpoint of aws s3 in the region instead of address
+	UseDualStackEndpoint bool `protobuf:"varint,16,opt,name=use_dual_stack_endpoint,json=useDualStackEndpoint,proto3" json:"use_dual_stack_endpoint,omitempty"`
+	UseFipsEndpoint      bool `protobuf:"varint,17,opt,name=use_fips_endpoint,json=useFipsEndpoint,proto3" json:"use_fips_endpoint,omitempty"`
+	// headers sent with every request to the storage
+	CustomHeaders        []*commonpb.KeyValuePair `protobuf:"bytes,18,rep,name=custom_headers,json=customHeaders,proto3" json:"custom_headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *StorageConfig) Reset()         { *m = StorageConfig{} }
@@ -1505,6 +1618,48 @@ func (m *StorageConfig) GetCloudProvider() string {
 	return ""
 }
 
+func (m *StorageConfig) GetSseType() string {
+	if m != nil {
+		return m.SseType
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetSseKey() string {
+	if m != nil {
+		return m.SseKey
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetRequesterPays() bool {
+	if m != nil {
+		return m.RequesterPays
+	}
+	return false
+}
+
+func (m *StorageConfig) GetUseDualStackEndpoint() bool {
+	if m != nil {
+		return m.UseDualStackEndpoint
+	}
+	return false
+}
+
+func (m *StorageConfig) GetUseFipsEndpoint() bool {
+	if m != nil {
+		return m.UseFipsEndpoint
+	}
+	return false
+}
+
+func (m *StorageConfig) GetCustomHeaders() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.CustomHeaders
+	}
+	return nil
+}
+
 type CreateJobRequest struct {
 	ClusterID            string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	IndexFilePrefix      string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
@@ -1517,6 +1672,11 @@ type CreateJobRequest struct {
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	NumRows              int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	JobType              JobType                  `protobuf:"varint,12,opt,name=job_type,json=jobType,proto3,enum=milvus.proto.index.JobType" json:"job_type,omitempty"`
+	StatsInfo            *StatsJobInfo            `protobuf:"bytes,13,opt,name=stats_info,json=statsInfo,proto3" json:"stats_info,omitempty"`
+	Priority             int64                    `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	DependsOn            []int64                  `protobuf:"varint,15,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	ReaderIndexVersion   int32                    `protobuf:"varint,16,opt,name=reader_index_version,json=readerIndexVersion,proto3" json:"reader_index_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1624,6 +1784,41 @@ func (m *CreateJobRequest) GetNumRows() int64 {
 	return 0
 }
 
+func (m *CreateJobRequest) GetJobType() JobType {
+	if m != nil {
+		return m.JobType
+	}
+	return JobType_JobTypeIndexJob
+}
+
+func (m *CreateJobRequest) GetStatsInfo() *StatsJobInfo {
+	if m != nil {
+		return m.StatsInfo
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CreateJobRequest) GetDependsOn() []int64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *CreateJobRequest) GetReaderIndexVersion() int32 {
+	if m != nil {
+		return m.ReaderIndexVersion
+	}
+	return 0
+}
+
 type QueryJobsRequest struct {
 	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
@@ -1677,6 +1872,8 @@ type IndexTaskInfo struct {
 	IndexFileKeys        []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
 	SerializedSize       uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	FailReason           string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	JobType              JobType             `protobuf:"varint,6,opt,name=job_type,json=jobType,proto3,enum=milvus.proto.index.JobType" json:"job_type,omitempty"`
+	StatsResult          *StatsJobResult     `protobuf:"bytes,7,opt,name=stats_result,json=statsResult,proto3" json:"stats_result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1742,6 +1939,20 @@ func (m *IndexTaskInfo) GetFailReason() string {
 	return ""
 }
 
+func (m *IndexTaskInfo) GetJobType() JobType {
+	if m != nil {
+		return m.JobType
+	}
+	return JobType_JobTypeIndexJob
+}
+
+func (m *IndexTaskInfo) GetStatsResult() *StatsJobResult {
+	if m != nil {
+		return m.StatsResult
+	}
+	return nil
+}
+
 type QueryJobsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ClusterID            string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
@@ -1851,6 +2062,9 @@ type JobInfo struct {
 	EndTime              int64                    `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	PodID                int64                    `protobuf:"varint,6,opt,name=podID,proto3" json:"podID,omitempty"`
+	ResourceUsage        *ResourceUsage           `protobuf:"bytes,7,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	IndexFileVersion     int32                    `protobuf:"varint,8,opt,name=index_file_version,json=indexFileVersion,proto3" json:"index_file_version,omitempty"`
+	TimeRecords          string                   `protobuf:"bytes,9,opt,name=time_records,json=timeRecords,proto3" json:"time_records,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1923,6 +2137,27 @@ func (m *JobInfo) GetPodID() int64 {
 	return 0
 }
 
+func (m *JobInfo) GetResourceUsage() *ResourceUsage {
+	if m != nil {
+		return m.ResourceUsage
+	}
+	return nil
+}
+
+func (m *JobInfo) GetIndexFileVersion() int32 {
+	if m != nil {
+		return m.IndexFileVersion
+	}
+	return 0
+}
+
+func (m *JobInfo) GetTimeRecords() string {
+	if m != nil {
+		return m.TimeRecords
+	}
+	return ""
+}
+
 type GetJobStatsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1962,6 +2197,10 @@ type GetJobStatsResponse struct {
 	TaskSlots            int64            `protobuf:"varint,5,opt,name=task_slots,json=taskSlots,proto3" json:"task_slots,omitempty"`
 	JobInfos             []*JobInfo       `protobuf:"bytes,6,rep,name=job_infos,json=jobInfos,proto3" json:"job_infos,omitempty"`
 	EnableDisk           bool             `protobuf:"varint,7,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
+	MemoryWatermark      float64          `protobuf:"fixed64,8,opt,name=memory_watermark,json=memoryWatermark,proto3" json:"memory_watermark,omitempty"`
+	DiskWatermark        float64          `protobuf:"fixed64,9,opt,name=disk_watermark,json=diskWatermark,proto3" json:"disk_watermark,omitempty"`
+	QueueWaitP99         int64            `protobuf:"varint,10,opt,name=queue_wait_p99,json=queueWaitP99,proto3" json:"queue_wait_p99,omitempty"`
+	Throttled            bool             `protobuf:"varint,11,opt,name=throttled,proto3" json:"throttled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -2041,6 +2280,34 @@ func (m *GetJobStatsResponse) GetEnableDisk() bool {
 	return false
 }
 
+func (m *GetJobStatsResponse) GetMemoryWatermark() float64 {
+	if m != nil {
+		return m.MemoryWatermark
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetDiskWatermark() float64 {
+	if m != nil {
+		return m.DiskWatermark
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetQueueWaitP99() int64 {
+	if m != nil {
+		return m.QueueWaitP99
+	}
+	return 0
+}
+
+func (m *GetJobStatsResponse) GetThrottled() bool {
+	if m != nil {
+		return m.Throttled
+	}
+	return false
+}
+
 type GetIndexStatisticsRequest struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	IndexName            string   `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
@@ -2135,237 +2402,1201 @@ func (m *GetIndexStatisticsResponse) GetIndexInfos() []*IndexInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
-	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
-	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
-	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
-	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.index.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.index.GetIndexStateResponse")
-	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.index.GetSegmentIndexStateRequest")
-	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.index.SegmentIndexState")
-	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.index.GetSegmentIndexStateResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
-	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.index.GetIndexInfoRequest")
-	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.index.SegmentInfo")
-	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.index.GetIndexInfoResponse")
-	proto.RegisterMapType((map[int64]*SegmentInfo)(nil), "milvus.proto.index.GetIndexInfoResponse.SegmentInfoEntry")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.index.DescribeIndexRequest")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.index.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.index.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.index.GetIndexBuildProgressResponse")
-	proto.RegisterType((*StorageConfig)(nil), "milvus.proto.index.StorageConfig")
-	proto.RegisterType((*CreateJobRequest)(nil), "milvus.proto.index.CreateJobRequest")
-	proto.RegisterType((*QueryJobsRequest)(nil), "milvus.proto.index.QueryJobsRequest")
-	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
-	proto.RegisterType((*QueryJobsResponse)(nil), "milvus.proto.index.QueryJobsResponse")
-	proto.RegisterType((*DropJobsRequest)(nil), "milvus.proto.index.DropJobsRequest")
-	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
-	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
-	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
-	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.index.GetIndexStatisticsRequest")
-	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.index.GetIndexStatisticsResponse")
+type ArchiveIndexRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
-
-var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
-	0xf5, 0x4f, 0xbb, 0xc7, 0xf6, 0xf4, 0xeb, 0x19, 0xff, 0xa8, 0x78, 0xbf, 0xdf, 0xc9, 0x24, 0x21,
-	0x4e, 0x67, 0x93, 0x18, 0x44, 0x9c, 0xe0, 0x65, 0xd1, 0x82, 0x00, 0xc9, 0xb1, 0x37, 0xc9, 0x24,
-	0x9b, 0xc8, 0xb4, 0xa3, 0x48, 0xac, 0x10, 0x4d, 0xcf, 0x74, 0x8d, 0x5d, 0xeb, 0x9e, 0xae, 0x49,
-	0x57, 0x75, 0x12, 0x07, 0x09, 0xc1, 0x61, 0x0f, 0xa0, 0x95, 0x10, 0x68, 0x25, 0xfe, 0x01, 0x4e,
-	0xfb, 0x27, 0x70, 0xe1, 0xc2, 0x91, 0x13, 0x77, 0x2e, 0xfc, 0x13, 0x5c, 0x51, 0xfd, 0xe8, 0x9e,
-	0xee, 0x9e, 0x1e, 0xcf, 0xc4, 0x36, 0x42, 0x82, 0xdb, 0xd4, 0xab, 0x57, 0x3f, 0xfa, 0xbd, 0xcf,
-	0x7b, 0xef, 0xf3, 0x6a, 0x60, 0x95, 0x44, 0x01, 0x7e, 0xe3, 0xf5, 0x28, 0x8d, 0x83, 0xcd, 0x61,
-	0x4c, 0x39, 0x45, 0x68, 0x40, 0xc2, 0x57, 0x09, 0x53, 0xa3, 0x4d, 0x39, 0xdf, 0x6e, 0xf4, 0xe8,
-	0x60, 0x40, 0x23, 0x25, 0x6b, 0x2f, 0x91, 0x88, 0xe3, 0x38, 0xf2, 0x43, 0x3d, 0x6e, 0xe4, 0x57,
-	0x38, 0x7f, 0xaf, 0x81, 0xd5, 0x11, 0xab, 0x3a, 0x51, 0x9f, 0x22, 0x07, 0x1a, 0x3d, 0x1a, 0x86,
-	0xb8, 0xc7, 0x09, 0x8d, 0x3a, 0xbb, 0x2d, 0x63, 0xdd, 0xd8, 0x30, 0xdd, 0x82, 0x0c, 0xb5, 0x60,
-	0xb1, 0x4f, 0x70, 0x18, 0x74, 0x76, 0x5b, 0x73, 0x72, 0x3a, 0x1d, 0xa2, 0xab, 0x00, 0xea, 0x82,
-	0x91, 0x3f, 0xc0, 0x2d, 0x73, 0xdd, 0xd8, 0xb0, 0x5c, 0x4b, 0x4a, 0x9e, 0xf9, 0x03, 0x2c, 0x16,
-	0xca, 0x41, 0x67, 0xb7, 0x55, 0x53, 0x0b, 0xf5, 0x10, 0xdd, 0x07, 0x9b, 0x1f, 0x0f, 0xb1, 0x37,
-	0xf4, 0x63, 0x7f, 0xc0, 0x5a, 0xf3, 0xeb, 0xe6, 0x86, 0xbd, 0x75, 0x7d, 0xb3, 0xf0, 0x69, 0xfa,
-	0x9b, 0x9e, 0xe0, 0xe3, 0x17, 0x7e, 0x98, 0xe0, 0x3d, 0x9f, 0xc4, 0x2e, 0x88, 0x55, 0x7b, 0x72,
-	0x11, 0xda, 0x85, 0x86, 0x3a, 0x5c, 0x6f, 0xb2, 0x30, 0xeb, 0x26, 0xb6, 0x5c, 0xa6, 0x77, 0xb9,
-	0xae, 0x77, 0xc1, 0x81, 0x17, 0xd3, 0xd7, 0xac, 0xb5, 0x28, 0x2f, 0x6a, 0x6b, 0x99, 0x4b, 0x5f,
-	0x33, 0xf1, 0x95, 0x9c, 0x72, 0x3f, 0x54, 0x0a, 0x75, 0xa9, 0x60, 0x49, 0x89, 0x9c, 0xfe, 0x10,
-	0xe6, 0x19, 0xf7, 0x39, 0x6e, 0x59, 0xeb, 0xc6, 0xc6, 0xd2, 0xd6, 0xb5, 0xca, 0x0b, 0x48, 0x8b,
-	0xef, 0x0b, 0x35, 0x57, 0x69, 0xa3, 0x0f, 0xe1, 0xff, 0xd5, 0xf5, 0xe5, 0xd0, 0xeb, 0xfb, 0x24,
-	0xf4, 0x62, 0xec, 0x33, 0x1a, 0xb5, 0x40, 0x1a, 0x72, 0x8d, 0x64, 0x6b, 0x1e, 0xf8, 0x24, 0x74,
-	0xe5, 0x1c, 0x72, 0xa0, 0x49, 0x98, 0xe7, 0x27, 0x9c, 0x7a, 0x72, 0xbe, 0x65, 0xaf, 0x1b, 0x1b,
-	0x75, 0xd7, 0x26, 0x6c, 0x3b, 0xe1, 0x54, 0x1e, 0x83, 0x9e, 0xc2, 0x6a, 0xc2, 0x70, 0xec, 0x15,
-	0xcc, 0xd3, 0x98, 0xd5, 0x3c, 0xcb, 0x62, 0x6d, 0x27, 0x67, 0xa2, 0x6f, 0x02, 0x1a, 0xe2, 0x28,
-	0x20, 0xd1, 0x81, 0xde, 0x51, 0xda, 0xa1, 0x29, 0xed, 0xb0, 0xa2, 0x67, 0xa4, 0xbe, 0x30, 0x87,
-	0xf3, 0xb9, 0x01, 0xf0, 0x40, 0xe2, 0x43, 0xde, 0xe5, 0xfb, 0x29, 0x44, 0x48, 0xd4, 0xa7, 0x12,
-	0x5e, 0xf6, 0xd6, 0xd5, 0xcd, 0x71, 0x0c, 0x6f, 0x66, 0x98, 0xd4, 0x08, 0x92, 0xf0, 0x6c, 0xc1,
-	0x62, 0x80, 0x43, 0xcc, 0x71, 0x20, 0xa1, 0x57, 0x77, 0xd3, 0x21, 0xba, 0x06, 0x76, 0x2f, 0xc6,
-	0xc2, 0x72, 0x9c, 0x68, 0xec, 0xd5, 0x5c, 0x50, 0xa2, 0xe7, 0x64, 0x80, 0x9d, 0xcf, 0x6b, 0xd0,
-	0xd8, 0xc7, 0x07, 0x03, 0x1c, 0x71, 0x75, 0x93, 0x59, 0xa0, 0xbe, 0x0e, 0xf6, 0xd0, 0x8f, 0x39,
-	0xd1, 0x2a, 0x0a, 0xee, 0x79, 0x11, 0xba, 0x02, 0x16, 0xd3, 0xbb, 0xee, 0xca, 0x53, 0x4d, 0x77,
-	0x24, 0x40, 0x97, 0xa0, 0x1e, 0x25, 0x03, 0x65, 0x20, 0x0d, 0xf9, 0x28, 0x19, 0x48, 0x98, 0xe4,
-	0x82, 0x61, 0xbe, 0x18, 0x0c, 0x2d, 0x58, 0xec, 0x26, 0x44, 0xc6, 0xd7, 0x82, 0x9a, 0xd1, 0x43,
-	0xf4, 0x7f, 0xb0, 0x10, 0xd1, 0x00, 0x77, 0x76, 0x35, 0x2c, 0xf5, 0x08, 0xdd, 0x80, 0xa6, 0x32,
-	0xea, 0x2b, 0x1c, 0x33, 0x42, 0x23, 0x0d, 0x4a, 0x85, 0xe4, 0x17, 0x4a, 0x76, 0x5a, 0x5c, 0x5e,
-	0x03, 0x7b, 0x1c, 0x8b, 0xd0, 0x1f, 0x21, 0xf0, 0x16, 0x2c, 0xab, 0xc3, 0xfb, 0x24, 0xc4, 0xde,
-	0x11, 0x3e, 0x66, 0x2d, 0x7b, 0xdd, 0xdc, 0xb0, 0x5c, 0x75, 0xa7, 0x07, 0x24, 0xc4, 0x4f, 0xf0,
-	0x31, 0xcb, 0xfb, 0xae, 0x71, 0xa2, 0xef, 0x9a, 0x65, 0xdf, 0xa1, 0x9b, 0xb0, 0xc4, 0x70, 0x4c,
-	0xfc, 0x90, 0xbc, 0xc5, 0x1e, 0x23, 0x6f, 0x71, 0x6b, 0x49, 0xea, 0x34, 0x33, 0xe9, 0x3e, 0x79,
-	0x8b, 0x85, 0x19, 0x5e, 0xc7, 0x84, 0x63, 0xef, 0xd0, 0x8f, 0x02, 0xda, 0xef, 0xb7, 0x96, 0xe5,
-	0x39, 0x0d, 0x29, 0x7c, 0xa4, 0x64, 0xce, 0x1f, 0x0c, 0xb8, 0xe8, 0xe2, 0x03, 0xc2, 0x38, 0x8e,
-	0x9f, 0xd1, 0x00, 0xbb, 0xf8, 0x65, 0x82, 0x19, 0x47, 0xf7, 0xa0, 0xd6, 0xf5, 0x19, 0xd6, 0x90,
-	0xbc, 0x52, 0x69, 0x9d, 0xa7, 0xec, 0xe0, 0xbe, 0xcf, 0xb0, 0x2b, 0x35, 0xd1, 0x77, 0x60, 0xd1,
-	0x0f, 0x82, 0x18, 0x33, 0x26, 0x81, 0x31, 0x69, 0xd1, 0xb6, 0xd2, 0x71, 0x53, 0xe5, 0x9c, 0x17,
-	0xcd, 0xbc, 0x17, 0x9d, 0xdf, 0x1a, 0xb0, 0x56, 0xbc, 0x19, 0x1b, 0xd2, 0x88, 0x61, 0xf4, 0x01,
-	0x2c, 0x08, 0x5f, 0x24, 0x4c, 0x5f, 0xee, 0x72, 0xe5, 0x39, 0xfb, 0x52, 0xc5, 0xd5, 0xaa, 0x22,
-	0xa5, 0x92, 0x88, 0xf0, 0x34, 0xdc, 0xd5, 0x0d, 0xaf, 0x97, 0x23, 0x4d, 0x17, 0x86, 0x4e, 0x44,
-	0xb8, 0x8a, 0x6e, 0x17, 0x48, 0xf6, 0xdb, 0xf9, 0x31, 0xac, 0x3d, 0xc4, 0x3c, 0x87, 0x09, 0x6d,
-	0xab, 0x59, 0x42, 0xa7, 0x58, 0x0b, 0xe6, 0x4a, 0xb5, 0xc0, 0xf9, 0xa3, 0x01, 0xef, 0x95, 0xf6,
-	0x3e, 0xcb, 0xd7, 0x66, 0xe0, 0x9e, 0x3b, 0x0b, 0xb8, 0xcd, 0x32, 0xb8, 0x9d, 0x5f, 0x1a, 0x70,
-	0xf9, 0x21, 0xe6, 0xf9, 0xc4, 0x71, 0xce, 0x96, 0x40, 0x5f, 0x03, 0xc8, 0x12, 0x06, 0x6b, 0x99,
-	0xeb, 0xe6, 0x86, 0xe9, 0xe6, 0x24, 0xce, 0xaf, 0x0d, 0x58, 0x1d, 0x3b, 0xbf, 0x98, 0x77, 0x8c,
-	0x72, 0xde, 0xf9, 0x77, 0x99, 0xe3, 0xf7, 0x06, 0x5c, 0xa9, 0x36, 0xc7, 0x59, 0x9c, 0xf7, 0x03,
-	0xb5, 0x08, 0x0b, 0x94, 0x8a, 0xa2, 0x74, 0xb3, 0xaa, 0x1e, 0x8c, 0x9f, 0xa9, 0x17, 0x39, 0x5f,
-	0x98, 0x80, 0x76, 0x64, 0xb2, 0x50, 0x55, 0xe7, 0x1d, 0x5c, 0x73, 0x6a, 0x2a, 0x53, 0x22, 0x2c,
-	0xb5, 0xf3, 0x20, 0x2c, 0xf3, 0xa7, 0x22, 0x2c, 0x57, 0xc0, 0x12, 0x59, 0x93, 0x71, 0x7f, 0x30,
-	0x94, 0xf5, 0xa2, 0xe6, 0x8e, 0x04, 0xe3, 0xf4, 0x60, 0x71, 0x46, 0x7a, 0x50, 0x3f, 0x2d, 0x3d,
-	0x70, 0xde, 0xc0, 0xc5, 0x34, 0xb0, 0x65, 0xf9, 0x7e, 0x07, 0x77, 0x14, 0x43, 0x61, 0xae, 0x1c,
-	0x0a, 0x53, 0x9c, 0xe2, 0xfc, 0x73, 0x0e, 0x56, 0x3b, 0x69, 0xcd, 0xd9, 0xf3, 0xf9, 0xa1, 0xe4,
-	0x0c, 0x27, 0x47, 0xca, 0x64, 0x04, 0xe4, 0x0a, 0xb4, 0x39, 0xb1, 0x40, 0xd7, 0x8a, 0x05, 0xba,
-	0x78, 0xc1, 0xf9, 0x32, 0x6a, 0xce, 0x87, 0xa2, 0x6e, 0xc0, 0x4a, 0xae, 0xe0, 0x0e, 0x7d, 0x7e,
-	0x28, 0x68, 0xaa, 0xa8, 0xb8, 0x4b, 0x24, 0xff, 0xf5, 0x0c, 0xdd, 0x86, 0xe5, 0xac, 0x42, 0x06,
-	0xaa, 0x70, 0xd6, 0x25, 0x42, 0x46, 0xe5, 0x34, 0x48, 0x2b, 0x67, 0x91, 0x40, 0x58, 0x15, 0x04,
-	0x22, 0x4f, 0x66, 0xa0, 0x40, 0x66, 0x9c, 0x3f, 0x19, 0x60, 0x67, 0x01, 0x3a, 0x63, 0x1b, 0x51,
-	0xf0, 0xcb, 0x5c, 0xd9, 0x2f, 0xd7, 0xa1, 0x81, 0x23, 0xbf, 0x1b, 0x62, 0x8d, 0x5b, 0x53, 0xe1,
-	0x56, 0xc9, 0x14, 0x6e, 0x1f, 0x80, 0x3d, 0xa2, 0x92, 0x69, 0x0c, 0xde, 0x9c, 0xc8, 0x25, 0xf3,
-	0xa0, 0x70, 0x21, 0xe3, 0x94, 0xcc, 0xf9, 0xcd, 0xdc, 0xa8, 0xcc, 0x29, 0xc4, 0x9e, 0x25, 0x99,
-	0xfd, 0x04, 0x1a, 0xfa, 0x2b, 0x14, 0xc5, 0x55, 0x29, 0xed, 0xbb, 0x55, 0xd7, 0xaa, 0x3a, 0x74,
-	0x33, 0x67, 0xc6, 0x8f, 0x23, 0x1e, 0x1f, 0xbb, 0x36, 0x1b, 0x49, 0xda, 0x1e, 0xac, 0x94, 0x15,
-	0xd0, 0x0a, 0x98, 0x47, 0xf8, 0x58, 0xdb, 0x58, 0xfc, 0x14, 0xe9, 0xff, 0x95, 0xc0, 0x8e, 0xae,
-	0xfa, 0xd7, 0x4e, 0xcc, 0xa7, 0x7d, 0xea, 0x2a, 0xed, 0xef, 0xcd, 0x7d, 0x64, 0x38, 0x5f, 0x1a,
-	0xb0, 0xb2, 0x1b, 0xd3, 0xe1, 0x3b, 0xa7, 0x52, 0x07, 0x1a, 0x39, 0x5e, 0x9c, 0x46, 0x6f, 0x41,
-	0x36, 0x2d, 0xa9, 0x5e, 0x82, 0x7a, 0x10, 0xd3, 0xa1, 0xe7, 0x87, 0xa1, 0x0c, 0x2c, 0x41, 0x11,
-	0x63, 0x3a, 0xdc, 0x0e, 0x43, 0xe7, 0x35, 0xac, 0xed, 0x62, 0xd6, 0x8b, 0x49, 0xf7, 0xdd, 0x93,
-	0xfc, 0x94, 0xfa, 0x5b, 0x48, 0xa0, 0x66, 0x29, 0x81, 0x3a, 0x5f, 0x18, 0xf0, 0x5e, 0xe9, 0xe4,
-	0xb3, 0xa0, 0xe3, 0x87, 0x45, 0xcc, 0x2a, 0x70, 0x4c, 0xe9, 0x7f, 0xf2, 0x58, 0xf5, 0x65, 0xfd,
-	0x95, 0x73, 0xf7, 0x45, 0xce, 0xd9, 0x8b, 0xe9, 0x81, 0x64, 0x97, 0xe7, 0xc7, 0xcc, 0xfe, 0x62,
-	0xc0, 0xd5, 0x09, 0x67, 0x9c, 0xe5, 0xcb, 0xcb, 0x8d, 0xf5, 0xdc, 0xb4, 0xc6, 0xda, 0x2c, 0x37,
-	0xd6, 0xd5, 0x7d, 0x67, 0x6d, 0x42, 0xdf, 0xf9, 0xa5, 0x09, 0xcd, 0x7d, 0x4e, 0x63, 0xff, 0x00,
-	0xef, 0xd0, 0xa8, 0x4f, 0x0e, 0x44, 0xda, 0x4e, 0xf9, 0xba, 0x21, 0x3f, 0x3a, 0x63, 0xe4, 0xd7,
-	0xa1, 0xe1, 0xf7, 0x7a, 0x98, 0x31, 0xd1, 0xbe, 0xe8, 0x6c, 0x64, 0xb9, 0xb6, 0x92, 0x3d, 0x11,
-	0x22, 0xf4, 0x0d, 0x58, 0x65, 0xb8, 0x17, 0x63, 0xee, 0x8d, 0x34, 0x35, 0x82, 0x97, 0xd5, 0xc4,
-	0x76, 0xaa, 0x2d, 0x08, 0x7e, 0xc2, 0xf0, 0xfe, 0xfe, 0x27, 0x1a, 0xc5, 0x7a, 0x24, 0xe8, 0x55,
-	0x37, 0xe9, 0x1d, 0x61, 0x9e, 0x2f, 0x0f, 0xa0, 0x44, 0x12, 0x8a, 0x97, 0xc1, 0x8a, 0x29, 0xe5,
-	0x32, 0xa7, 0xcb, 0x5a, 0x6e, 0xb9, 0x75, 0x21, 0x10, 0x69, 0x4b, 0xef, 0xda, 0xd9, 0x7e, 0xaa,
-	0x6b, 0xb8, 0x1e, 0x89, 0x1e, 0xb5, 0xb3, 0xfd, 0xf4, 0xe3, 0x28, 0x18, 0x52, 0x12, 0x71, 0x99,
-	0xe0, 0x2d, 0x37, 0x2f, 0x12, 0x9f, 0xc7, 0x94, 0x25, 0x3c, 0x41, 0x3f, 0x64, 0x72, 0xb7, 0x5c,
-	0x5b, 0xcb, 0x9e, 0x1f, 0x0f, 0xb1, 0xa8, 0x29, 0x09, 0xc3, 0xde, 0x2b, 0x12, 0xf3, 0xc4, 0x0f,
-	0xbd, 0x43, 0xca, 0xb8, 0xcc, 0xf1, 0x75, 0x77, 0x29, 0x61, 0xf8, 0x85, 0x12, 0x3f, 0xa2, 0x8c,
-	0x8b, 0x6b, 0xc4, 0xf8, 0x40, 0xd4, 0x08, 0x5b, 0x6e, 0xa3, 0x47, 0xa2, 0x47, 0xeb, 0x85, 0x34,
-	0x09, 0xbc, 0x61, 0x4c, 0x5f, 0x91, 0x00, 0xc7, 0xb2, 0xcb, 0xb3, 0xdc, 0xa6, 0x94, 0xee, 0x69,
-	0xa1, 0xf3, 0x0f, 0x13, 0x56, 0x14, 0x59, 0x7b, 0x4c, 0xbb, 0x29, 0x6a, 0xaf, 0x80, 0xd5, 0x0b,
-	0x13, 0xd1, 0xf7, 0x68, 0xc8, 0x5a, 0xee, 0x48, 0x20, 0x4c, 0x9f, 0xaf, 0x77, 0x31, 0xee, 0x93,
-	0x37, 0xda, 0x45, 0xcb, 0xa3, 0x82, 0x27, 0xc5, 0xf9, 0xd2, 0x6c, 0x8e, 0x95, 0xe6, 0xc0, 0xe7,
-	0xbe, 0xae, 0x97, 0x35, 0x59, 0x2f, 0x2d, 0x21, 0x51, 0xa5, 0x72, 0xac, 0x02, 0xce, 0x57, 0x54,
-	0xc0, 0x1c, 0x25, 0x58, 0x28, 0x52, 0x82, 0x62, 0x4c, 0x2d, 0x96, 0x73, 0xcc, 0x23, 0x58, 0x4a,
-	0x3d, 0xd0, 0x93, 0x60, 0x94, 0x6e, 0xaa, 0xe8, 0xc7, 0x64, 0x66, 0xce, 0xa3, 0xd6, 0x6d, 0xb2,
-	0x02, 0x88, 0xcb, 0x14, 0xc2, 0x3a, 0x15, 0x85, 0x28, 0xd1, 0x57, 0x38, 0x0d, 0x7d, 0xcd, 0xd3,
-	0x01, 0xbb, 0x48, 0x07, 0x3e, 0x81, 0x95, 0x1f, 0x25, 0x38, 0x3e, 0x7e, 0x4c, 0xbb, 0x6c, 0x36,
-	0x1f, 0xb7, 0xa1, 0xae, 0x1d, 0x95, 0x56, 0x8e, 0x6c, 0xec, 0xfc, 0xcd, 0x80, 0xa6, 0x8c, 0xeb,
-	0xe7, 0x3e, 0x3b, 0x4a, 0x9f, 0x81, 0x52, 0x2f, 0x1b, 0x45, 0x2f, 0x9f, 0xb2, 0xf1, 0xa9, 0x78,
-	0xc3, 0x30, 0xab, 0xde, 0x30, 0x2a, 0x08, 0x55, 0xad, 0x92, 0x50, 0x95, 0x3a, 0xa9, 0xf9, 0xb1,
-	0x4e, 0xea, 0x2b, 0x03, 0x56, 0x73, 0x36, 0x3a, 0x4b, 0x66, 0x2d, 0x58, 0x76, 0xae, 0x6c, 0xd9,
-	0xfb, 0xc5, 0x8a, 0x63, 0x56, 0xb9, 0x3a, 0x57, 0x71, 0x52, 0x1b, 0x17, 0xaa, 0xce, 0x13, 0x58,
-	0x16, 0x9c, 0xe0, 0x7c, 0xdc, 0xf9, 0x57, 0x03, 0x16, 0x1f, 0xd3, 0xae, 0x74, 0x64, 0x1e, 0x43,
-	0x46, 0xf1, 0x7d, 0x6c, 0x05, 0xcc, 0x80, 0x0c, 0x74, 0x99, 0x10, 0x3f, 0x45, 0x8c, 0x31, 0xee,
-	0xc7, 0x7c, 0xf4, 0xc2, 0x27, 0x18, 0xa3, 0x90, 0xc8, 0x47, 0xa2, 0x4b, 0x50, 0xc7, 0x51, 0xa0,
-	0x26, 0x35, 0x2d, 0xc7, 0x51, 0x20, 0xa7, 0xce, 0xa7, 0xd3, 0x5a, 0x83, 0xf9, 0x21, 0x1d, 0xbd,
-	0xca, 0xa9, 0x81, 0xb3, 0x06, 0xe8, 0x21, 0xe6, 0x8f, 0x69, 0x57, 0x78, 0x25, 0x35, 0x8f, 0xf3,
-	0xe7, 0x39, 0xd9, 0x05, 0x8d, 0xc4, 0x67, 0x71, 0xb0, 0x03, 0x4d, 0x55, 0x17, 0x3f, 0xa3, 0x5d,
-	0x2f, 0x4a, 0x52, 0xa3, 0xd8, 0x52, 0xf8, 0x98, 0x76, 0x9f, 0x25, 0x03, 0x74, 0x07, 0x2e, 0x92,
-	0x48, 0xe4, 0x5e, 0x59, 0xaa, 0x33, 0x4d, 0x65, 0xa5, 0x15, 0x12, 0xa5, 0x45, 0x5c, 0xab, 0xdf,
-	0x82, 0x65, 0x1c, 0xbd, 0x4c, 0x70, 0x82, 0x33, 0x55, 0x65, 0xb3, 0xa6, 0x16, 0x6b, 0x3d, 0x51,
-	0x92, 0x7d, 0x76, 0xe4, 0xb1, 0x90, 0x72, 0xa6, 0x73, 0xa2, 0x25, 0x24, 0xfb, 0x42, 0x80, 0x3e,
-	0x02, 0x4b, 0x2c, 0x57, 0xd0, 0x52, 0xdd, 0xcc, 0xe5, 0x2a, 0x68, 0x69, 0x7f, 0xbb, 0xf5, 0xcf,
-	0xd4, 0x0f, 0x26, 0x02, 0x44, 0xf3, 0xfb, 0x80, 0xb0, 0x23, 0x5d, 0xd2, 0x40, 0x89, 0x76, 0x09,
-	0x3b, 0x72, 0x7e, 0x0a, 0x97, 0xf2, 0xef, 0x43, 0x84, 0x71, 0xd2, 0x3b, 0x4f, 0x9a, 0xf3, 0x3b,
-	0x03, 0xda, 0x55, 0x07, 0xfc, 0x07, 0xd9, 0xdd, 0xd6, 0xaf, 0x6c, 0x00, 0x39, 0xb3, 0x43, 0x69,
-	0x1c, 0xa0, 0x50, 0x42, 0x6b, 0x87, 0x0e, 0x86, 0x34, 0xc2, 0x11, 0x97, 0x19, 0x8b, 0xa1, 0xcd,
-	0xe2, 0x7e, 0x7a, 0x30, 0xae, 0xa8, 0x6d, 0xd5, 0x7e, 0xbf, 0x52, 0xbf, 0xa4, 0xec, 0x5c, 0x40,
-	0x2f, 0x65, 0x17, 0x34, 0x32, 0xc5, 0xce, 0xa1, 0x1f, 0x45, 0x38, 0x44, 0x5b, 0x13, 0xde, 0x0c,
-	0xab, 0x94, 0xd3, 0x33, 0x6f, 0x54, 0x9e, 0xb9, 0xcf, 0x63, 0x12, 0x1d, 0xa4, 0x26, 0x76, 0x2e,
-	0xa0, 0xe7, 0x60, 0xe7, 0x1e, 0x6e, 0xd0, 0xad, 0x2a, 0x4b, 0x8d, 0xbf, 0xec, 0xb4, 0x4f, 0xf2,
-	0x85, 0x73, 0x01, 0xf5, 0xa1, 0x59, 0x78, 0x59, 0x44, 0x1b, 0x27, 0x35, 0x5f, 0xf9, 0xe7, 0xbc,
-	0xf6, 0xd7, 0x67, 0xd0, 0xcc, 0x6e, 0xff, 0x73, 0x65, 0xb0, 0xb1, 0xa7, 0xb9, 0xbb, 0x13, 0x36,
-	0x99, 0xf4, 0x88, 0xd8, 0xbe, 0x37, 0xfb, 0x82, 0xec, 0xf0, 0x60, 0xf4, 0x91, 0x2a, 0xa0, 0x6e,
-	0x4f, 0xef, 0x30, 0xd5, 0x69, 0x1b, 0xb3, 0xb6, 0xa2, 0xce, 0x05, 0xb4, 0x07, 0x56, 0xd6, 0x0c,
-	0xa2, 0xf7, 0xab, 0x16, 0x96, 0x7b, 0xc5, 0x19, 0x9c, 0x53, 0x68, 0xa7, 0xaa, 0x9d, 0x53, 0xd5,
-	0xeb, 0x55, 0x3b, 0xa7, 0xb2, 0x37, 0x73, 0x2e, 0xa0, 0x44, 0xc6, 0x4e, 0x29, 0xba, 0xd1, 0x9d,
-	0x69, 0xfe, 0x2d, 0xa4, 0x99, 0xf6, 0xe6, 0xac, 0xea, 0xd9, 0xb1, 0xbf, 0x18, 0xbd, 0x6a, 0x17,
-	0x7a, 0x27, 0x74, 0xef, 0xa4, 0xad, 0xaa, 0x5a, 0xb9, 0xf6, 0xb7, 0xde, 0x61, 0x45, 0x0e, 0x93,
-	0x68, 0xff, 0x90, 0xbe, 0x56, 0x64, 0x31, 0x89, 0x7d, 0x91, 0x0b, 0x2b, 0x0e, 0xd7, 0x21, 0x3c,
-	0xae, 0x3a, 0xf1, 0xf0, 0x13, 0x56, 0x64, 0x87, 0x7b, 0x00, 0x0f, 0x31, 0x7f, 0x8a, 0x79, 0x2c,
-	0x6c, 0x7d, 0x6b, 0x52, 0x9e, 0xd2, 0x0a, 0xe9, 0x51, 0xb7, 0xa7, 0xea, 0x65, 0x07, 0x74, 0xc1,
-	0xde, 0x39, 0xc4, 0xbd, 0xa3, 0x47, 0xd8, 0x0f, 0xf9, 0x21, 0xaa, 0x5e, 0x99, 0xd3, 0x98, 0x00,
-	0xf9, 0x2a, 0xc5, 0xf4, 0x8c, 0xad, 0xaf, 0x16, 0xf4, 0xff, 0xe1, 0xcf, 0x68, 0x80, 0xff, 0xfb,
-	0x53, 0xf0, 0x1e, 0x58, 0x59, 0x3b, 0x56, 0x1d, 0xe1, 0xe5, 0x6e, 0x6d, 0x5a, 0x84, 0x7f, 0x0a,
-	0x56, 0x46, 0x6c, 0xab, 0x77, 0x2c, 0xf7, 0x06, 0xed, 0x9b, 0x53, 0xb4, 0xb2, 0xdb, 0x3e, 0x83,
-	0x7a, 0x4a, 0x44, 0xd1, 0x8d, 0x49, 0xe9, 0x28, 0xbf, 0xf3, 0x94, 0xbb, 0xfe, 0x0c, 0xec, 0x1c,
-	0x4b, 0xab, 0x2e, 0x40, 0xe3, 0xec, 0xae, 0x7d, 0x7b, 0xaa, 0xde, 0xff, 0x46, 0x40, 0xde, 0xff,
-	0xf6, 0xa7, 0x5b, 0x07, 0x84, 0x1f, 0x26, 0x5d, 0x61, 0xd9, 0xbb, 0x4a, 0xf3, 0x0e, 0xa1, 0xfa,
-	0xd7, 0xdd, 0xf4, 0x96, 0x77, 0xe5, 0x4e, 0x77, 0xa5, 0x9d, 0x86, 0xdd, 0xee, 0x82, 0x1c, 0x7e,
-	0xf0, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xb6, 0x87, 0xda, 0xce, 0x22, 0x00, 0x00,
+func (m *ArchiveIndexRequest) Reset()         { *m = ArchiveIndexRequest{} }
+func (m *ArchiveIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveIndexRequest) ProtoMessage()    {}
+func (*ArchiveIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{31}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ArchiveIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveIndexRequest.Unmarshal(m, b)
+}
+func (m *ArchiveIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveIndexRequest.Merge(m, src)
+}
+func (m *ArchiveIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveIndexRequest.Size(m)
+}
+func (m *ArchiveIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveIndexRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ArchiveIndexRequest proto.InternalMessageInfo
 
-// IndexCoordClient is the client API for IndexCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IndexCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
-	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error)
-	// Deprecated: use DescribeIndex instead
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+func (m *ArchiveIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
 
-type indexCoordClient struct {
-	cc *grpc.ClientConn
+type RestoreIndexRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
-	return &indexCoordClient{cc}
+func (m *RestoreIndexRequest) Reset()         { *m = RestoreIndexRequest{} }
+func (m *RestoreIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreIndexRequest) ProtoMessage()    {}
+func (*RestoreIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{32}
 }
 
-func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RestoreIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreIndexRequest.Unmarshal(m, b)
+}
+func (m *RestoreIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreIndexRequest.Merge(m, src)
+}
+func (m *RestoreIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreIndexRequest.Size(m)
+}
+func (m *RestoreIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreIndexRequest proto.InternalMessageInfo
+
+func (m *RestoreIndexRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetIndexArchiveStateRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIndexArchiveStateRequest) Reset()         { *m = GetIndexArchiveStateRequest{} }
+func (m *GetIndexArchiveStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexArchiveStateRequest) ProtoMessage()    {}
+func (*GetIndexArchiveStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{33}
+}
+
+func (m *GetIndexArchiveStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexArchiveStateRequest.Unmarshal(m, b)
+}
+func (m *GetIndexArchiveStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexArchiveStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetIndexArchiveStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexArchiveStateRequest.Merge(m, src)
+}
+func (m *GetIndexArchiveStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexArchiveStateRequest.Size(m)
+}
+func (m *GetIndexArchiveStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexArchiveStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexArchiveStateRequest proto.InternalMessageInfo
+
+func (m *GetIndexArchiveStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetIndexArchiveStateResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                IndexArchiveState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.index.IndexArchiveState" json:"state,omitempty"`
+	ArchivedSegments     int64             `protobuf:"varint,3,opt,name=archived_segments,json=archivedSegments,proto3" json:"archived_segments,omitempty"`
+	RestoringSegments    int64             `protobuf:"varint,4,opt,name=restoring_segments,json=restoringSegments,proto3" json:"restoring_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetIndexArchiveStateResponse) Reset()         { *m = GetIndexArchiveStateResponse{} }
+func (m *GetIndexArchiveStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexArchiveStateResponse) ProtoMessage()    {}
+func (*GetIndexArchiveStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{34}
+}
+
+func (m *GetIndexArchiveStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexArchiveStateResponse.Unmarshal(m, b)
+}
+func (m *GetIndexArchiveStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexArchiveStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexArchiveStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexArchiveStateResponse.Merge(m, src)
+}
+func (m *GetIndexArchiveStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexArchiveStateResponse.Size(m)
+}
+func (m *GetIndexArchiveStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexArchiveStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexArchiveStateResponse proto.InternalMessageInfo
+
+func (m *GetIndexArchiveStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetIndexArchiveStateResponse) GetState() IndexArchiveState {
+	if m != nil {
+		return m.State
+	}
+	return IndexArchiveState_NotArchived
+}
+
+func (m *GetIndexArchiveStateResponse) GetArchivedSegments() int64 {
+	if m != nil {
+		return m.ArchivedSegments
+	}
+	return 0
+}
+
+func (m *GetIndexArchiveStateResponse) GetRestoringSegments() int64 {
+	if m != nil {
+		return m.RestoringSegments
+	}
+	return 0
+}
+
+type StatsJobInfo struct {
+	StatsTypes           []StatsType `protobuf:"varint,1,rep,packed,name=stats_types,json=statsTypes,proto3,enum=milvus.proto.index.StatsType" json:"stats_types,omitempty"`
+	StatsLogPath         string      `protobuf:"bytes,2,opt,name=stats_log_path,json=statsLogPath,proto3" json:"stats_log_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StatsJobInfo) Reset()         { *m = StatsJobInfo{} }
+func (m *StatsJobInfo) String() string { return proto.CompactTextString(m) }
+func (*StatsJobInfo) ProtoMessage()    {}
+func (*StatsJobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{35}
+}
+
+func (m *StatsJobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsJobInfo.Unmarshal(m, b)
+}
+func (m *StatsJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsJobInfo.Marshal(b, m, deterministic)
+}
+func (m *StatsJobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsJobInfo.Merge(m, src)
+}
+func (m *StatsJobInfo) XXX_Size() int {
+	return xxx_messageInfo_StatsJobInfo.Size(m)
+}
+func (m *StatsJobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsJobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsJobInfo proto.InternalMessageInfo
+
+func (m *StatsJobInfo) GetStatsTypes() []StatsType {
+	if m != nil {
+		return m.StatsTypes
+	}
+	return nil
+}
+
+func (m *StatsJobInfo) GetStatsLogPath() string {
+	if m != nil {
+		return m.StatsLogPath
+	}
+	return ""
+}
+
+type StatsJobResult struct {
+	NumRows              int64    `protobuf:"varint,1,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	StatsLogPath         string   `protobuf:"bytes,2,opt,name=stats_log_path,json=statsLogPath,proto3" json:"stats_log_path,omitempty"`
+	Sorted               bool     `protobuf:"varint,3,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsJobResult) Reset()         { *m = StatsJobResult{} }
+func (m *StatsJobResult) String() string { return proto.CompactTextString(m) }
+func (*StatsJobResult) ProtoMessage()    {}
+func (*StatsJobResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{36}
+}
+
+func (m *StatsJobResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsJobResult.Unmarshal(m, b)
+}
+func (m *StatsJobResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsJobResult.Marshal(b, m, deterministic)
+}
+func (m *StatsJobResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsJobResult.Merge(m, src)
+}
+func (m *StatsJobResult) XXX_Size() int {
+	return xxx_messageInfo_StatsJobResult.Size(m)
+}
+func (m *StatsJobResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsJobResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsJobResult proto.InternalMessageInfo
+
+func (m *StatsJobResult) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *StatsJobResult) GetStatsLogPath() string {
+	if m != nil {
+		return m.StatsLogPath
+	}
+	return ""
+}
+
+func (m *StatsJobResult) GetSorted() bool {
+	if m != nil {
+		return m.Sorted
+	}
+	return false
+}
+
+type RemoteBuildRequest struct {
+	Job                  *CreateJobRequest        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	CollectionID         int64                    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID            int64                    `protobuf:"varint,4,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64                    `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	ResultPrefix         string                   `protobuf:"bytes,8,opt,name=result_prefix,json=resultPrefix,proto3" json:"result_prefix,omitempty"`
+	DataUrls             []string                 `protobuf:"bytes,9,rep,name=data_urls,json=dataUrls,proto3" json:"data_urls,omitempty"`
+	ObjectTags           []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=object_tags,json=objectTags,proto3" json:"object_tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RemoteBuildRequest) Reset()         { *m = RemoteBuildRequest{} }
+func (m *RemoteBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteBuildRequest) ProtoMessage()    {}
+func (*RemoteBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{37}
+}
+
+func (m *RemoteBuildRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteBuildRequest.Unmarshal(m, b)
+}
+func (m *RemoteBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteBuildRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoteBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteBuildRequest.Merge(m, src)
+}
+func (m *RemoteBuildRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoteBuildRequest.Size(m)
+}
+func (m *RemoteBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteBuildRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteBuildRequest proto.InternalMessageInfo
+
+func (m *RemoteBuildRequest) GetJob() *CreateJobRequest {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *RemoteBuildRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *RemoteBuildRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *RemoteBuildRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *RemoteBuildRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *RemoteBuildRequest) GetIndexParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexParams
+	}
+	return nil
+}
+
+func (m *RemoteBuildRequest) GetTypeParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.TypeParams
+	}
+	return nil
+}
+
+func (m *RemoteBuildRequest) GetResultPrefix() string {
+	if m != nil {
+		return m.ResultPrefix
+	}
+	return ""
+}
+
+func (m *RemoteBuildRequest) GetDataUrls() []string {
+	if m != nil {
+		return m.DataUrls
+	}
+	return nil
+}
+
+func (m *RemoteBuildRequest) GetObjectTags() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.ObjectTags
+	}
+	return nil
+}
+
+type RemoteIndexFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256               string   `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoteIndexFile) Reset()         { *m = RemoteIndexFile{} }
+func (m *RemoteIndexFile) String() string { return proto.CompactTextString(m) }
+func (*RemoteIndexFile) ProtoMessage()    {}
+func (*RemoteIndexFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{38}
+}
+
+func (m *RemoteIndexFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteIndexFile.Unmarshal(m, b)
+}
+func (m *RemoteIndexFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteIndexFile.Marshal(b, m, deterministic)
+}
+func (m *RemoteIndexFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteIndexFile.Merge(m, src)
+}
+func (m *RemoteIndexFile) XXX_Size() int {
+	return xxx_messageInfo_RemoteIndexFile.Size(m)
+}
+func (m *RemoteIndexFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteIndexFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteIndexFile proto.InternalMessageInfo
+
+func (m *RemoteIndexFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RemoteIndexFile) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *RemoteIndexFile) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+type RemoteBuildResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Files                []*RemoteIndexFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RemoteBuildResponse) Reset()         { *m = RemoteBuildResponse{} }
+func (m *RemoteBuildResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteBuildResponse) ProtoMessage()    {}
+func (*RemoteBuildResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{39}
+}
+
+func (m *RemoteBuildResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteBuildResponse.Unmarshal(m, b)
+}
+func (m *RemoteBuildResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteBuildResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoteBuildResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteBuildResponse.Merge(m, src)
+}
+func (m *RemoteBuildResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoteBuildResponse.Size(m)
+}
+func (m *RemoteBuildResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteBuildResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteBuildResponse proto.InternalMessageInfo
+
+func (m *RemoteBuildResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RemoteBuildResponse) GetFiles() []*RemoteIndexFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type ResourceUsage struct {
+	CpuSeconds           float64  `protobuf:"fixed64,1,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	PeakRssBytes         int64    `protobuf:"varint,2,opt,name=peak_rss_bytes,json=peakRssBytes,proto3" json:"peak_rss_bytes,omitempty"`
+	DiskReadBytes        int64    `protobuf:"varint,3,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes       int64    `protobuf:"varint,4,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	NetRecvBytes         int64    `protobuf:"varint,5,opt,name=net_recv_bytes,json=netRecvBytes,proto3" json:"net_recv_bytes,omitempty"`
+	NetSendBytes         int64    `protobuf:"varint,6,opt,name=net_send_bytes,json=netSendBytes,proto3" json:"net_send_bytes,omitempty"`
+	ConcurrentJobs       int32    `protobuf:"varint,7,opt,name=concurrent_jobs,json=concurrentJobs,proto3" json:"concurrent_jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceUsage) Reset()         { *m = ResourceUsage{} }
+func (m *ResourceUsage) String() string { return proto.CompactTextString(m) }
+func (*ResourceUsage) ProtoMessage()    {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{40}
+}
+
+func (m *ResourceUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceUsage.Unmarshal(m, b)
+}
+func (m *ResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceUsage.Marshal(b, m, deterministic)
+}
+func (m *ResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUsage.Merge(m, src)
+}
+func (m *ResourceUsage) XXX_Size() int {
+	return xxx_messageInfo_ResourceUsage.Size(m)
+}
+func (m *ResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUsage proto.InternalMessageInfo
+
+func (m *ResourceUsage) GetCpuSeconds() float64 {
+	if m != nil {
+		return m.CpuSeconds
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetPeakRssBytes() int64 {
+	if m != nil {
+		return m.PeakRssBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetDiskReadBytes() int64 {
+	if m != nil {
+		return m.DiskReadBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetDiskWriteBytes() int64 {
+	if m != nil {
+		return m.DiskWriteBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetNetRecvBytes() int64 {
+	if m != nil {
+		return m.NetRecvBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetNetSendBytes() int64 {
+	if m != nil {
+		return m.NetSendBytes
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetConcurrentJobs() int32 {
+	if m != nil {
+		return m.ConcurrentJobs
+	}
+	return 0
+}
+
+type GetCapabilitiesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCapabilitiesRequest) Reset()         { *m = GetCapabilitiesRequest{} }
+func (m *GetCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesRequest) ProtoMessage()    {}
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{41}
+}
+
+func (m *GetCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesRequest.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCapabilitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesRequest.Merge(m, src)
+}
+func (m *GetCapabilitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesRequest.Size(m)
+}
+func (m *GetCapabilitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesRequest proto.InternalMessageInfo
+
+type GetCapabilitiesResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexTypes           []string                 `protobuf:"bytes,2,rep,name=index_types,json=indexTypes,proto3" json:"index_types,omitempty"`
+	EnableDisk           bool                     `protobuf:"varint,3,opt,name=enable_disk,json=enableDisk,proto3" json:"enable_disk,omitempty"`
+	DiskCapacity         int64                    `protobuf:"varint,4,opt,name=disk_capacity,json=diskCapacity,proto3" json:"disk_capacity,omitempty"`
+	GpuEnabled           bool                     `protobuf:"varint,5,opt,name=gpu_enabled,json=gpuEnabled,proto3" json:"gpu_enabled,omitempty"`
+	SimdType             string                   `protobuf:"bytes,6,opt,name=simd_type,json=simdType,proto3" json:"simd_type,omitempty"`
+	IndexEngines         []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_engines,json=indexEngines,proto3" json:"index_engines,omitempty"`
+	BuildVersion         string                   `protobuf:"bytes,8,opt,name=build_version,json=buildVersion,proto3" json:"build_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCapabilitiesResponse) Reset()         { *m = GetCapabilitiesResponse{} }
+func (m *GetCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesResponse) ProtoMessage()    {}
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{42}
+}
+
+func (m *GetCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesResponse.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesResponse.Merge(m, src)
+}
+func (m *GetCapabilitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesResponse.Size(m)
+}
+func (m *GetCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesResponse proto.InternalMessageInfo
+
+func (m *GetCapabilitiesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCapabilitiesResponse) GetIndexTypes() []string {
+	if m != nil {
+		return m.IndexTypes
+	}
+	return nil
+}
+
+func (m *GetCapabilitiesResponse) GetEnableDisk() bool {
+	if m != nil {
+		return m.EnableDisk
+	}
+	return false
+}
+
+func (m *GetCapabilitiesResponse) GetDiskCapacity() int64 {
+	if m != nil {
+		return m.DiskCapacity
+	}
+	return 0
+}
+
+func (m *GetCapabilitiesResponse) GetGpuEnabled() bool {
+	if m != nil {
+		return m.GpuEnabled
+	}
+	return false
+}
+
+func (m *GetCapabilitiesResponse) GetSimdType() string {
+	if m != nil {
+		return m.SimdType
+	}
+	return ""
+}
+
+func (m *GetCapabilitiesResponse) GetIndexEngines() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.IndexEngines
+	}
+	return nil
+}
+
+func (m *GetCapabilitiesResponse) GetBuildVersion() string {
+	if m != nil {
+		return m.BuildVersion
+	}
+	return ""
+}
+
+// RateGroup is the rate limit shared by the rated logs of the group
+type RateGroup struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreditPerSecond      float64  `protobuf:"fixed64,2,opt,name=credit_per_second,json=creditPerSecond,proto3" json:"credit_per_second,omitempty"`
+	MaxBalance           float64  `protobuf:"fixed64,3,opt,name=max_balance,json=maxBalance,proto3" json:"max_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateGroup) Reset()         { *m = RateGroup{} }
+func (m *RateGroup) String() string { return proto.CompactTextString(m) }
+func (*RateGroup) ProtoMessage()    {}
+func (*RateGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{43}
+}
+
+func (m *RateGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateGroup.Unmarshal(m, b)
+}
+func (m *RateGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateGroup.Marshal(b, m, deterministic)
+}
+func (m *RateGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateGroup.Merge(m, src)
+}
+func (m *RateGroup) XXX_Size() int {
+	return xxx_messageInfo_RateGroup.Size(m)
+}
+func (m *RateGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateGroup proto.InternalMessageInfo
+
+func (m *RateGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RateGroup) GetCreditPerSecond() float64 {
+	if m != nil {
+		return m.CreditPerSecond
+	}
+	return 0
+}
+
+func (m *RateGroup) GetMaxBalance() float64 {
+	if m != nil {
+		return m.MaxBalance
+	}
+	return 0
+}
+
+type UpdateLogConfigRequest struct {
+	// log level to set, e.g. debug, the level is kept if it's empty
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// rate groups to update, the group named "global" is used by the rated logs without a group
+	RateGroups           []*RateGroup `protobuf:"bytes,2,rep,name=rate_groups,json=rateGroups,proto3" json:"rate_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpdateLogConfigRequest) Reset()         { *m = UpdateLogConfigRequest{} }
+func (m *UpdateLogConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogConfigRequest) ProtoMessage()    {}
+func (*UpdateLogConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{44}
+}
+
+func (m *UpdateLogConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLogConfigRequest.Unmarshal(m, b)
+}
+func (m *UpdateLogConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLogConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateLogConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogConfigRequest.Merge(m, src)
+}
+func (m *UpdateLogConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLogConfigRequest.Size(m)
+}
+func (m *UpdateLogConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLogConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateLogConfigRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *UpdateLogConfigRequest) GetRateGroups() []*RateGroup {
+	if m != nil {
+		return m.RateGroups
+	}
+	return nil
+}
+
+type UpdateLogConfigResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Level                string           `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	RateGroups           []*RateGroup     `protobuf:"bytes,3,rep,name=rate_groups,json=rateGroups,proto3" json:"rate_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateLogConfigResponse) Reset()         { *m = UpdateLogConfigResponse{} }
+func (m *UpdateLogConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLogConfigResponse) ProtoMessage()    {}
+func (*UpdateLogConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9e019eb3fda53c2, []int{45}
+}
+
+func (m *UpdateLogConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLogConfigResponse.Unmarshal(m, b)
+}
+func (m *UpdateLogConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLogConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateLogConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLogConfigResponse.Merge(m, src)
+}
+func (m *UpdateLogConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateLogConfigResponse.Size(m)
+}
+func (m *UpdateLogConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLogConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLogConfigResponse proto.InternalMessageInfo
+
+func (m *UpdateLogConfigResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *UpdateLogConfigResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *UpdateLogConfigResponse) GetRateGroups() []*RateGroup {
+	if m != nil {
+		return m.RateGroups
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.index.IndexArchiveState", IndexArchiveState_name, IndexArchiveState_value)
+	proto.RegisterEnum("milvus.proto.index.JobType", JobType_name, JobType_value)
+	proto.RegisterEnum("milvus.proto.index.StatsType", StatsType_name, StatsType_value)
+	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.index.IndexInfo")
+	proto.RegisterType((*FieldIndex)(nil), "milvus.proto.index.FieldIndex")
+	proto.RegisterType((*SegmentIndex)(nil), "milvus.proto.index.SegmentIndex")
+	proto.RegisterType((*RegisterNodeRequest)(nil), "milvus.proto.index.RegisterNodeRequest")
+	proto.RegisterType((*RegisterNodeResponse)(nil), "milvus.proto.index.RegisterNodeResponse")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.index.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.index.GetIndexStateResponse")
+	proto.RegisterType((*GetSegmentIndexStateRequest)(nil), "milvus.proto.index.GetSegmentIndexStateRequest")
+	proto.RegisterType((*SegmentIndexState)(nil), "milvus.proto.index.SegmentIndexState")
+	proto.RegisterType((*GetSegmentIndexStateResponse)(nil), "milvus.proto.index.GetSegmentIndexStateResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.index.CreateIndexRequest")
+	proto.RegisterType((*GetIndexInfoRequest)(nil), "milvus.proto.index.GetIndexInfoRequest")
+	proto.RegisterType((*IndexFilePathInfo)(nil), "milvus.proto.index.IndexFilePathInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.index.SegmentInfo")
+	proto.RegisterType((*GetIndexInfoResponse)(nil), "milvus.proto.index.GetIndexInfoResponse")
+	proto.RegisterMapType((map[int64]*SegmentInfo)(nil), "milvus.proto.index.GetIndexInfoResponse.SegmentInfoEntry")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.index.DropIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.index.DescribeIndexRequest")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.index.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.index.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.index.GetIndexBuildProgressResponse")
+	proto.RegisterType((*StorageConfig)(nil), "milvus.proto.index.StorageConfig")
+	proto.RegisterType((*CreateJobRequest)(nil), "milvus.proto.index.CreateJobRequest")
+	proto.RegisterType((*QueryJobsRequest)(nil), "milvus.proto.index.QueryJobsRequest")
+	proto.RegisterType((*IndexTaskInfo)(nil), "milvus.proto.index.IndexTaskInfo")
+	proto.RegisterType((*QueryJobsResponse)(nil), "milvus.proto.index.QueryJobsResponse")
+	proto.RegisterType((*DropJobsRequest)(nil), "milvus.proto.index.DropJobsRequest")
+	proto.RegisterType((*JobInfo)(nil), "milvus.proto.index.JobInfo")
+	proto.RegisterType((*GetJobStatsRequest)(nil), "milvus.proto.index.GetJobStatsRequest")
+	proto.RegisterType((*GetJobStatsResponse)(nil), "milvus.proto.index.GetJobStatsResponse")
+	proto.RegisterType((*GetIndexStatisticsRequest)(nil), "milvus.proto.index.GetIndexStatisticsRequest")
+	proto.RegisterType((*GetIndexStatisticsResponse)(nil), "milvus.proto.index.GetIndexStatisticsResponse")
+	proto.RegisterType((*ArchiveIndexRequest)(nil), "milvus.proto.index.ArchiveIndexRequest")
+	proto.RegisterType((*RestoreIndexRequest)(nil), "milvus.proto.index.RestoreIndexRequest")
+	proto.RegisterType((*GetIndexArchiveStateRequest)(nil), "milvus.proto.index.GetIndexArchiveStateRequest")
+	proto.RegisterType((*GetIndexArchiveStateResponse)(nil), "milvus.proto.index.GetIndexArchiveStateResponse")
+	proto.RegisterType((*StatsJobInfo)(nil), "milvus.proto.index.StatsJobInfo")
+	proto.RegisterType((*StatsJobResult)(nil), "milvus.proto.index.StatsJobResult")
+	proto.RegisterType((*RemoteBuildRequest)(nil), "milvus.proto.index.RemoteBuildRequest")
+	proto.RegisterType((*RemoteIndexFile)(nil), "milvus.proto.index.RemoteIndexFile")
+	proto.RegisterType((*RemoteBuildResponse)(nil), "milvus.proto.index.RemoteBuildResponse")
+	proto.RegisterType((*ResourceUsage)(nil), "milvus.proto.index.ResourceUsage")
+	proto.RegisterType((*GetCapabilitiesRequest)(nil), "milvus.proto.index.GetCapabilitiesRequest")
+	proto.RegisterType((*GetCapabilitiesResponse)(nil), "milvus.proto.index.GetCapabilitiesResponse")
+	proto.RegisterType((*RateGroup)(nil), "milvus.proto.index.RateGroup")
+	proto.RegisterType((*UpdateLogConfigRequest)(nil), "milvus.proto.index.UpdateLogConfigRequest")
+	proto.RegisterType((*UpdateLogConfigResponse)(nil), "milvus.proto.index.UpdateLogConfigResponse")
+}
+
+func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
+
+var fileDescriptor_f9e019eb3fda53c2 = []byte{
+	// 3562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xe4, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
+	0xb5, 0x36, 0x49, 0x51, 0x62, 0x1f, 0x92, 0x22, 0xd5, 0xd6, 0xd8, 0x34, 0xed, 0xb9, 0x96, 0xdb,
+	0x1e, 0x5b, 0xe3, 0xb9, 0x96, 0x7d, 0x35, 0x33, 0xc6, 0xf8, 0xe6, 0x05, 0x59, 0xf2, 0x43, 0xf2,
+	0x23, 0x9a, 0x96, 0x67, 0x06, 0x19, 0x04, 0xe9, 0x14, 0xd9, 0x25, 0xaa, 0xad, 0x66, 0x57, 0x4f,
+	0x55, 0xb5, 0x6c, 0x4d, 0x80, 0x20, 0x59, 0x24, 0x40, 0x82, 0x01, 0x82, 0x04, 0x01, 0xb2, 0x49,
+	0x76, 0x59, 0xe5, 0x27, 0x64, 0x9d, 0x45, 0xb6, 0xb3, 0x08, 0x10, 0x04, 0x08, 0x90, 0x5d, 0x36,
+	0xf9, 0x11, 0x41, 0x3d, 0xba, 0xd9, 0x4d, 0x36, 0x25, 0xea, 0x11, 0x04, 0x48, 0x76, 0x5d, 0xa7,
+	0x4e, 0xbd, 0xbf, 0x73, 0xce, 0x77, 0xaa, 0x1a, 0xe6, 0xbc, 0xc0, 0xc5, 0xaf, 0x9d, 0x2e, 0x21,
+	0xd4, 0x5d, 0x0a, 0x29, 0xe1, 0xc4, 0x34, 0xfb, 0x9e, 0xbf, 0x17, 0x31, 0x55, 0x5a, 0x92, 0xf5,
+	0xed, 0x5a, 0x97, 0xf4, 0xfb, 0x24, 0x50, 0xb2, 0xf6, 0xac, 0x17, 0x70, 0x4c, 0x03, 0xe4, 0xeb,
+	0x72, 0x2d, 0xdd, 0xc2, 0xfa, 0xeb, 0x14, 0x18, 0xeb, 0xa2, 0xd5, 0x7a, 0xb0, 0x4d, 0x4c, 0x0b,
+	0x6a, 0x5d, 0xe2, 0xfb, 0xb8, 0xcb, 0x3d, 0x12, 0xac, 0xaf, 0xb5, 0x0a, 0x0b, 0x85, 0xc5, 0x92,
+	0x9d, 0x91, 0x99, 0x2d, 0x98, 0xd9, 0xf6, 0xb0, 0xef, 0xae, 0xaf, 0xb5, 0x8a, 0xb2, 0x3a, 0x2e,
+	0x9a, 0x6f, 0x02, 0xa8, 0x09, 0x06, 0xa8, 0x8f, 0x5b, 0xa5, 0x85, 0xc2, 0xa2, 0x61, 0x1b, 0x52,
+	0xf2, 0x1c, 0xf5, 0xb1, 0x68, 0x28, 0x0b, 0xeb, 0x6b, 0xad, 0x29, 0xd5, 0x50, 0x17, 0xcd, 0xfb,
+	0x50, 0xe5, 0xfb, 0x21, 0x76, 0x42, 0x44, 0x51, 0x9f, 0xb5, 0xca, 0x0b, 0xa5, 0xc5, 0xea, 0xf2,
+	0x95, 0xa5, 0xcc, 0xd2, 0xf4, 0x9a, 0x9e, 0xe0, 0xfd, 0x8f, 0x91, 0x1f, 0xe1, 0x4d, 0xe4, 0x51,
+	0x1b, 0x44, 0xab, 0x4d, 0xd9, 0xc8, 0x5c, 0x83, 0x9a, 0x1a, 0x5c, 0x77, 0x32, 0x3d, 0x69, 0x27,
+	0x55, 0xd9, 0x4c, 0xf7, 0x72, 0x45, 0xf7, 0x82, 0x5d, 0x87, 0x92, 0x57, 0xac, 0x35, 0x23, 0x27,
+	0x5a, 0xd5, 0x32, 0x9b, 0xbc, 0x62, 0x62, 0x95, 0x9c, 0x70, 0xe4, 0x2b, 0x85, 0x8a, 0x54, 0x30,
+	0xa4, 0x44, 0x56, 0xbf, 0x0f, 0x65, 0xc6, 0x11, 0xc7, 0x2d, 0x63, 0xa1, 0xb0, 0x38, 0xbb, 0x7c,
+	0x39, 0x77, 0x02, 0x72, 0xc7, 0xb7, 0x84, 0x9a, 0xad, 0xb4, 0xcd, 0xf7, 0xe1, 0xbc, 0x9a, 0xbe,
+	0x2c, 0x3a, 0xdb, 0xc8, 0xf3, 0x1d, 0x8a, 0x11, 0x23, 0x41, 0x0b, 0xe4, 0x46, 0xce, 0x7b, 0x49,
+	0x9b, 0x87, 0xc8, 0xf3, 0x6d, 0x59, 0x67, 0x5a, 0x50, 0xf7, 0x98, 0x83, 0x22, 0x4e, 0x1c, 0x59,
+	0xdf, 0xaa, 0x2e, 0x14, 0x16, 0x2b, 0x76, 0xd5, 0x63, 0x2b, 0x11, 0x27, 0x72, 0x18, 0xf3, 0x19,
+	0xcc, 0x45, 0x0c, 0x53, 0x27, 0xb3, 0x3d, 0xb5, 0x49, 0xb7, 0xa7, 0x21, 0xda, 0xae, 0xa7, 0xb6,
+	0xe8, 0x7f, 0xc1, 0x0c, 0x71, 0xe0, 0x7a, 0x41, 0x4f, 0xf7, 0x28, 0xf7, 0xa1, 0x2e, 0xf7, 0xa1,
+	0xa9, 0x6b, 0xa4, 0xbe, 0xd8, 0x0e, 0xeb, 0x47, 0x05, 0x80, 0x87, 0x12, 0x1f, 0x72, 0x2e, 0x5f,
+	0x8d, 0x21, 0xe2, 0x05, 0xdb, 0x44, 0xc2, 0xab, 0xba, 0xfc, 0xe6, 0xd2, 0x28, 0x86, 0x97, 0x12,
+	0x4c, 0x6a, 0x04, 0x89, 0x4f, 0x81, 0x20, 0x17, 0xfb, 0x98, 0x63, 0x57, 0x42, 0xaf, 0x62, 0xc7,
+	0x45, 0xf3, 0x32, 0x54, 0xbb, 0x14, 0x8b, 0x9d, 0xe3, 0x9e, 0xc6, 0xde, 0x94, 0x0d, 0x4a, 0xf4,
+	0xc2, 0xeb, 0x63, 0xeb, 0xcb, 0x29, 0xa8, 0x6d, 0xe1, 0x5e, 0x1f, 0x07, 0x5c, 0xcd, 0x64, 0x12,
+	0xa8, 0x2f, 0x40, 0x35, 0x44, 0x94, 0x7b, 0x5a, 0x45, 0xc1, 0x3d, 0x2d, 0x32, 0x2f, 0x81, 0xc1,
+	0x74, 0xaf, 0x6b, 0x72, 0xd4, 0x92, 0x3d, 0x10, 0x98, 0x17, 0xa0, 0x12, 0x44, 0x7d, 0xb5, 0x41,
+	0x1a, 0xf2, 0x41, 0xd4, 0x97, 0x30, 0x49, 0x19, 0x43, 0x39, 0x6b, 0x0c, 0x2d, 0x98, 0xe9, 0x44,
+	0x9e, 0xb4, 0xaf, 0x69, 0x55, 0xa3, 0x8b, 0xe6, 0x39, 0x98, 0x0e, 0x88, 0x8b, 0xd7, 0xd7, 0x34,
+	0x2c, 0x75, 0xc9, 0xbc, 0x0a, 0x75, 0xb5, 0xa9, 0x7b, 0x98, 0x32, 0x8f, 0x04, 0x1a, 0x94, 0x0a,
+	0xc9, 0x1f, 0x2b, 0xd9, 0x71, 0x71, 0x79, 0x19, 0xaa, 0xa3, 0x58, 0x84, 0xed, 0x01, 0x02, 0xaf,
+	0x43, 0x43, 0x0d, 0xbe, 0xed, 0xf9, 0xd8, 0xd9, 0xc5, 0xfb, 0xac, 0x55, 0x5d, 0x28, 0x2d, 0x1a,
+	0xb6, 0x9a, 0xd3, 0x43, 0xcf, 0xc7, 0x4f, 0xf0, 0x3e, 0x4b, 0x9f, 0x5d, 0xed, 0xc0, 0xb3, 0xab,
+	0x0f, 0x9f, 0x9d, 0xf9, 0x16, 0xcc, 0x32, 0x4c, 0x3d, 0xe4, 0x7b, 0x9f, 0x63, 0x87, 0x79, 0x9f,
+	0xe3, 0xd6, 0xac, 0xd4, 0xa9, 0x27, 0xd2, 0x2d, 0xef, 0x73, 0x2c, 0xb6, 0xe1, 0x15, 0xf5, 0x38,
+	0x76, 0x76, 0x50, 0xe0, 0x92, 0xed, 0xed, 0x56, 0x43, 0x8e, 0x53, 0x93, 0xc2, 0xc7, 0x4a, 0x66,
+	0x6e, 0x40, 0x1d, 0xd1, 0xee, 0x8e, 0xb7, 0x87, 0x95, 0xa5, 0xb5, 0x9a, 0x72, 0x3b, 0xde, 0x1a,
+	0x8b, 0xc1, 0x15, 0xa5, 0xad, 0x36, 0xa5, 0x86, 0x52, 0x25, 0xeb, 0x57, 0x05, 0x38, 0x6b, 0xe3,
+	0x9e, 0xc7, 0x38, 0xa6, 0xcf, 0x89, 0x8b, 0x6d, 0xfc, 0x59, 0x84, 0x19, 0x37, 0xef, 0xc0, 0x54,
+	0x07, 0x31, 0xac, 0xe1, 0x7d, 0x29, 0x77, 0xa7, 0x9f, 0xb1, 0xde, 0x7d, 0xc4, 0xb0, 0x2d, 0x35,
+	0xcd, 0xbb, 0x30, 0x83, 0x5c, 0x97, 0x62, 0xc6, 0x5a, 0xc5, 0x03, 0x1a, 0xad, 0x28, 0x1d, 0x3b,
+	0x56, 0x4e, 0x21, 0xa2, 0x94, 0x46, 0x84, 0xf5, 0xb3, 0x02, 0xcc, 0x67, 0x67, 0xc6, 0x42, 0x12,
+	0x30, 0x6c, 0xbe, 0x0b, 0xd3, 0x62, 0xd9, 0x11, 0xd3, 0x93, 0xbb, 0x98, 0x3b, 0xce, 0x96, 0x54,
+	0xb1, 0xb5, 0xaa, 0x70, 0xcf, 0x5e, 0xe0, 0xf1, 0xd8, 0x75, 0xa8, 0x19, 0x5e, 0x19, 0xde, 0x31,
+	0x1d, 0x64, 0xd6, 0x03, 0x8f, 0x2b, 0x4f, 0x61, 0x83, 0x97, 0x7c, 0x5b, 0xdf, 0x82, 0xf9, 0x47,
+	0x98, 0xa7, 0xf0, 0xa5, 0xf7, 0x6a, 0x12, 0x33, 0xcc, 0xc6, 0x95, 0xe2, 0x50, 0x5c, 0xb1, 0x7e,
+	0x5b, 0x80, 0x37, 0x86, 0xfa, 0x3e, 0xc9, 0x6a, 0x13, 0x43, 0x29, 0x9e, 0xc4, 0x50, 0x4a, 0xc3,
+	0x86, 0x62, 0xfd, 0xa0, 0x00, 0x17, 0x1f, 0x61, 0x9e, 0x76, 0x42, 0xa7, 0xbc, 0x13, 0xe6, 0xff,
+	0x00, 0x24, 0xce, 0x87, 0xb5, 0x4a, 0x0b, 0xa5, 0xc5, 0x92, 0x9d, 0x92, 0x58, 0x3f, 0x29, 0xc0,
+	0xdc, 0xc8, 0xf8, 0x59, 0x1f, 0x56, 0x18, 0xf6, 0x61, 0xff, 0xaa, 0xed, 0xf8, 0x45, 0x01, 0x2e,
+	0xe5, 0x6f, 0xc7, 0x49, 0x0e, 0xef, 0x6b, 0xaa, 0x11, 0x16, 0x28, 0x15, 0x01, 0x2e, 0xd7, 0xae,
+	0x47, 0xc7, 0xd4, 0x8d, 0xac, 0x2f, 0x4a, 0x60, 0xae, 0x4a, 0xc7, 0x23, 0x2b, 0x8f, 0x72, 0x34,
+	0xc7, 0xa6, 0x45, 0x43, 0xe4, 0x67, 0xea, 0x34, 0xc8, 0x4f, 0xf9, 0x58, 0xe4, 0xe7, 0x12, 0x18,
+	0xc2, 0x03, 0x33, 0x8e, 0xfa, 0xa1, 0x8c, 0x3d, 0x53, 0xf6, 0x40, 0x30, 0x4a, 0x35, 0x66, 0x26,
+	0xa4, 0x1a, 0x95, 0xe3, 0x52, 0x0d, 0xeb, 0x35, 0x9c, 0x8d, 0x0d, 0x5b, 0x52, 0x81, 0x23, 0x1c,
+	0x47, 0xd6, 0x14, 0x8a, 0xc3, 0xa6, 0x70, 0xc8, 0xa1, 0x58, 0x7f, 0x2e, 0xc1, 0xdc, 0x7a, 0x1c,
+	0xbf, 0x36, 0x11, 0xdf, 0x91, 0xfc, 0xe3, 0x60, 0x4b, 0x19, 0x8f, 0x80, 0x54, 0xb0, 0x2f, 0x8d,
+	0x0d, 0xf6, 0x53, 0xd9, 0x60, 0x9f, 0x9d, 0x60, 0x79, 0x18, 0x35, 0xa7, 0x43, 0x77, 0x17, 0xa1,
+	0x99, 0x0a, 0xde, 0x21, 0xe2, 0x3b, 0x82, 0xf2, 0x8a, 0xe8, 0x3d, 0xeb, 0xa5, 0x57, 0xcf, 0xcc,
+	0x1b, 0xd0, 0x48, 0xa2, 0xad, 0xab, 0x82, 0x70, 0x45, 0x22, 0x64, 0x10, 0x9a, 0xdd, 0x38, 0x0a,
+	0x67, 0xc9, 0x88, 0x91, 0x43, 0x46, 0xd2, 0xc4, 0x08, 0xb2, 0xc4, 0xe8, 0x6d, 0x68, 0x32, 0x4e,
+	0x28, 0xea, 0x61, 0x07, 0x07, 0x6e, 0x48, 0xbc, 0x80, 0x4b, 0x52, 0x6b, 0xd8, 0x0d, 0x2d, 0x7f,
+	0xa0, 0xc5, 0xe6, 0x7b, 0x70, 0x2e, 0x56, 0xa5, 0x0a, 0x1a, 0x98, 0x3a, 0x21, 0xda, 0x67, 0x9a,
+	0x61, 0xcc, 0xeb, 0x5a, 0x3b, 0xae, 0xdc, 0x44, 0xfb, 0xcc, 0xfa, 0x7d, 0x01, 0xaa, 0x89, 0x07,
+	0x98, 0x30, 0xe7, 0xc9, 0x1c, 0x7c, 0x71, 0xf8, 0xe0, 0xaf, 0x40, 0x0d, 0x07, 0xa8, 0xe3, 0x63,
+	0x6d, 0x18, 0x25, 0x65, 0x18, 0x4a, 0xa6, 0x0c, 0xe3, 0x21, 0x54, 0x07, 0xbc, 0x37, 0x36, 0xf2,
+	0xf1, 0xa4, 0x23, 0x8d, 0x3a, 0x1b, 0x12, 0x02, 0xcc, 0xac, 0x9f, 0x16, 0x07, 0x71, 0x54, 0x56,
+	0x9e, 0xc8, 0x5b, 0x7e, 0x1b, 0x6a, 0x7a, 0x15, 0x8a, 0x8f, 0x2b, 0x9f, 0x79, 0x2f, 0x6f, 0x5a,
+	0x79, 0x83, 0x2e, 0xa5, 0xb6, 0xf1, 0x41, 0xc0, 0xe9, 0xbe, 0x5d, 0x65, 0x03, 0x49, 0xdb, 0x81,
+	0xe6, 0xb0, 0x82, 0xd9, 0x84, 0xd2, 0x2e, 0xde, 0xd7, 0x7b, 0x2c, 0x3e, 0x45, 0x7c, 0xd9, 0x13,
+	0xe0, 0xd4, 0xb4, 0xe2, 0xf2, 0x81, 0x0e, 0x7b, 0x9b, 0xd8, 0x4a, 0xfb, 0xff, 0x8b, 0x1f, 0x14,
+	0xac, 0x5f, 0x16, 0xa0, 0xb9, 0x46, 0x49, 0x78, 0x64, 0x5f, 0x6d, 0x41, 0x2d, 0x45, 0xe2, 0x63,
+	0xf7, 0x90, 0x91, 0x1d, 0xe6, 0xb5, 0x2f, 0x40, 0xc5, 0xa5, 0x24, 0x74, 0x90, 0xef, 0xb7, 0xa6,
+	0x34, 0x9f, 0xa5, 0x24, 0x5c, 0xf1, 0x7d, 0xeb, 0x15, 0xcc, 0xaf, 0x61, 0xd6, 0xa5, 0x5e, 0xe7,
+	0xe8, 0x51, 0xe4, 0x90, 0x00, 0x9f, 0xf1, 0xd0, 0xa5, 0x21, 0x0f, 0x6d, 0x7d, 0x51, 0x80, 0x37,
+	0x86, 0x46, 0x3e, 0x09, 0x3a, 0xbe, 0x9e, 0xc5, 0xac, 0x02, 0xc7, 0x21, 0xc9, 0x5a, 0x1a, 0xab,
+	0x48, 0x06, 0x78, 0x59, 0x77, 0x5f, 0x38, 0xb5, 0x4d, 0x4a, 0x7a, 0x92, 0xbe, 0x9e, 0x1e, 0xf5,
+	0xfb, 0x43, 0x01, 0xde, 0x1c, 0x33, 0xc6, 0x49, 0x56, 0x3e, 0x7c, 0x0b, 0x50, 0x3c, 0xec, 0x16,
+	0xa0, 0x34, 0x7c, 0x0b, 0x90, 0x9f, 0x24, 0x4f, 0x8d, 0x49, 0x92, 0x7f, 0x5d, 0x86, 0xfa, 0x96,
+	0xf2, 0x55, 0xab, 0x24, 0xd8, 0xf6, 0x7a, 0x22, 0x2e, 0xc4, 0x09, 0x41, 0x41, 0x2e, 0x3a, 0x2e,
+	0x8a, 0xb9, 0xa1, 0x6e, 0x17, 0x33, 0x26, 0x72, 0x2d, 0xed, 0x8d, 0x0c, 0xbb, 0xaa, 0x64, 0x4f,
+	0x84, 0xc8, 0xbc, 0x09, 0x73, 0x0c, 0x77, 0x29, 0xe6, 0xce, 0x40, 0x53, 0x23, 0xb8, 0xa1, 0x2a,
+	0x56, 0x62, 0x6d, 0x91, 0x41, 0x44, 0x0c, 0x6f, 0x6d, 0x3d, 0xd5, 0x28, 0xd6, 0x25, 0xc1, 0xdf,
+	0x3a, 0x51, 0x77, 0x17, 0xf3, 0x74, 0xfc, 0x01, 0x25, 0x92, 0x50, 0xbc, 0x08, 0x06, 0x25, 0x84,
+	0xcb, 0xa0, 0x21, 0xc9, 0x82, 0x61, 0x57, 0x84, 0x40, 0xb8, 0x2d, 0xdd, 0xeb, 0xfa, 0xca, 0x33,
+	0x4d, 0x12, 0x74, 0x49, 0x24, 0xd4, 0xeb, 0x2b, 0xcf, 0x62, 0x07, 0x2e, 0x23, 0x88, 0x61, 0xa7,
+	0x45, 0x62, 0x79, 0xb1, 0x4f, 0x17, 0xfc, 0x46, 0x46, 0x0f, 0xc3, 0xae, 0x6a, 0xd9, 0x8b, 0xfd,
+	0x10, 0x8b, 0xa0, 0x15, 0x31, 0xec, 0xec, 0x79, 0x94, 0x47, 0xc8, 0x77, 0x76, 0x08, 0xe3, 0x32,
+	0x88, 0x54, 0xec, 0xd9, 0x88, 0xe1, 0x8f, 0x95, 0xf8, 0x31, 0x61, 0x5c, 0x4c, 0x83, 0xe2, 0x9e,
+	0x08, 0x42, 0x2a, 0x82, 0xe8, 0x92, 0x48, 0x28, 0xbb, 0x3e, 0x89, 0x5c, 0x27, 0xa4, 0x64, 0xcf,
+	0x73, 0x31, 0x95, 0x01, 0xc3, 0xb0, 0xeb, 0x52, 0xba, 0xa9, 0x85, 0xc2, 0xc6, 0x19, 0xd3, 0xf3,
+	0xa8, 0xab, 0x53, 0x60, 0x4c, 0xcd, 0xe1, 0x3c, 0x88, 0x4f, 0xb9, 0xb1, 0xb3, 0xaa, 0x6b, 0xc6,
+	0x44, 0x9e, 0x2b, 0xba, 0x1e, 0x8a, 0x45, 0x2a, 0x0b, 0xad, 0xd3, 0x74, 0x10, 0x12, 0xd7, 0x3d,
+	0x62, 0x0d, 0xae, 0x58, 0x00, 0xe3, 0xa8, 0xbb, 0x3b, 0x08, 0x76, 0x4d, 0x15, 0xbb, 0x22, 0x86,
+	0xd7, 0x22, 0xe4, 0x6f, 0x89, 0xca, 0x64, 0x77, 0x6e, 0x4a, 0x7e, 0xe5, 0x6c, 0x7b, 0x21, 0x1b,
+	0x34, 0x98, 0x93, 0x0d, 0x04, 0x79, 0x7a, 0xe8, 0x85, 0x2c, 0xd1, 0x7d, 0x0c, 0xb3, 0xdd, 0x88,
+	0x71, 0xd2, 0x77, 0x76, 0x30, 0x72, 0x31, 0x65, 0x2d, 0x73, 0x52, 0x8e, 0x50, 0x57, 0x0d, 0x1f,
+	0xab, 0x76, 0xd6, 0x1f, 0xcb, 0xd0, 0x54, 0xac, 0x78, 0x83, 0x74, 0x62, 0xeb, 0xbd, 0x04, 0x46,
+	0xd7, 0x8f, 0xc4, 0x82, 0xb4, 0xe9, 0x1a, 0xf6, 0x40, 0x20, 0x26, 0x9a, 0x26, 0x16, 0x14, 0x6f,
+	0x7b, 0xaf, 0x35, 0x54, 0x1b, 0x03, 0x66, 0x21, 0xc5, 0x69, 0x0e, 0x54, 0x1a, 0xe1, 0x40, 0x2e,
+	0xe2, 0x48, 0x13, 0x93, 0x29, 0x49, 0x4c, 0x0c, 0x21, 0x51, 0x9c, 0x64, 0x84, 0x6a, 0x94, 0x73,
+	0xa8, 0x46, 0x8a, 0x7b, 0x4d, 0x67, 0xb9, 0x57, 0xd6, 0xb7, 0xcc, 0x0c, 0xfb, 0xda, 0xc7, 0x30,
+	0x1b, 0x23, 0xb1, 0x2b, 0x8d, 0x52, 0xc2, 0x35, 0x27, 0xf1, 0x95, 0x11, 0x2a, 0x6d, 0xbd, 0x76,
+	0x9d, 0xa5, 0x8b, 0x23, 0x5c, 0xcd, 0x38, 0x16, 0x57, 0x1b, 0xca, 0x13, 0xe0, 0x38, 0x79, 0x42,
+	0x9a, 0x77, 0x55, 0xb3, 0xbc, 0xeb, 0x2e, 0x54, 0x5e, 0x92, 0x8e, 0x02, 0x7b, 0x4d, 0xa6, 0x7a,
+	0x17, 0xf3, 0x16, 0xba, 0x41, 0x3a, 0xc2, 0x00, 0xec, 0x99, 0x97, 0xea, 0xc3, 0xfc, 0x06, 0x80,
+	0xf0, 0x9a, 0x4c, 0x31, 0x88, 0xba, 0xdc, 0xa2, 0x85, 0xfc, 0x2d, 0x42, 0x9c, 0x6d, 0x90, 0x8e,
+	0xba, 0xd4, 0x93, 0x6d, 0xc4, 0xa7, 0xd9, 0x86, 0x4a, 0x48, 0x3d, 0x42, 0x3d, 0xae, 0x6c, 0xa9,
+	0x64, 0x27, 0x65, 0x09, 0x00, 0x2c, 0xdc, 0x25, 0x73, 0x48, 0xd0, 0x6a, 0xc8, 0x30, 0x6d, 0x68,
+	0xc9, 0x37, 0x03, 0xf3, 0x0e, 0xcc, 0x53, 0x89, 0x51, 0x27, 0x8b, 0x03, 0x61, 0x42, 0x65, 0xdb,
+	0x54, 0x75, 0xeb, 0x29, 0x34, 0x58, 0x4f, 0xa1, 0xf9, 0x61, 0x84, 0xe9, 0xfe, 0x06, 0xe9, 0xb0,
+	0xc9, 0x90, 0xdc, 0x86, 0x8a, 0x86, 0x63, 0xcc, 0x13, 0x92, 0xb2, 0xf5, 0x65, 0x11, 0xea, 0xb2,
+	0xfb, 0x17, 0x88, 0xed, 0xc6, 0x37, 0x94, 0x31, 0x96, 0x0b, 0x59, 0x2c, 0x1f, 0x33, 0x8f, 0xce,
+	0xb9, 0x5e, 0x2b, 0xe5, 0x5d, 0xaf, 0xe5, 0xf0, 0xf3, 0xa9, 0x5c, 0x7e, 0x3e, 0x94, 0x98, 0x97,
+	0x47, 0x2e, 0xf4, 0xd2, 0x40, 0x98, 0x3e, 0x02, 0x10, 0x1e, 0x40, 0x4d, 0x01, 0x81, 0x62, 0x16,
+	0xf9, 0x5c, 0x1a, 0x54, 0x75, 0xd9, 0x3a, 0x08, 0x0a, 0xb6, 0xd4, 0x14, 0xde, 0x1d, 0x71, 0xa6,
+	0x0a, 0xd6, 0xef, 0x0a, 0x30, 0x97, 0x3a, 0xa2, 0x93, 0x84, 0xf1, 0xcc, 0xc1, 0x16, 0x87, 0x0f,
+	0xf6, 0x7e, 0x96, 0xde, 0x94, 0xf2, 0xec, 0x29, 0x45, 0x6f, 0xe2, 0x23, 0xce, 0x50, 0x9c, 0x27,
+	0xd0, 0x10, 0x04, 0xf4, 0x74, 0xd0, 0xf4, 0xf7, 0x22, 0xcc, 0x68, 0xfb, 0xc8, 0x18, 0x6a, 0x21,
+	0x6b, 0xa8, 0x4d, 0x28, 0xb9, 0x5e, 0x5f, 0x73, 0x12, 0xf1, 0x29, 0xac, 0x84, 0x71, 0x44, 0xf9,
+	0xe0, 0xee, 0xbb, 0x24, 0x0d, 0x8c, 0x72, 0x79, 0x7d, 0x7a, 0x01, 0x2a, 0x38, 0x70, 0x55, 0xa5,
+	0x4e, 0x32, 0x71, 0xe0, 0xca, 0xaa, 0xd3, 0xb9, 0x37, 0x98, 0x87, 0x72, 0x48, 0x06, 0xf7, 0xd5,
+	0xaa, 0x20, 0xfc, 0x27, 0xc5, 0x8c, 0x44, 0xb4, 0x8b, 0x9d, 0x88, 0xa1, 0x1e, 0xd6, 0x88, 0xc8,
+	0xdd, 0x62, 0x5b, 0x6b, 0x7e, 0x24, 0x14, 0x45, 0xb0, 0x4c, 0x15, 0x05, 0x99, 0x4a, 0xd9, 0x40,
+	0xfa, 0x92, 0xbb, 0x6c, 0x37, 0x13, 0x33, 0x88, 0x1d, 0xfe, 0x15, 0xa8, 0x89, 0xa5, 0x3a, 0x14,
+	0x77, 0x09, 0x75, 0x59, 0xcc, 0x20, 0x84, 0xcc, 0x56, 0x22, 0x6b, 0x1e, 0xcc, 0x47, 0x98, 0x6f,
+	0x90, 0xce, 0x96, 0x02, 0x9e, 0x3c, 0x39, 0xeb, 0x4f, 0x25, 0x38, 0x9b, 0x11, 0x9f, 0x04, 0x7b,
+	0x16, 0xd4, 0x15, 0x3f, 0x14, 0xb6, 0x14, 0x44, 0xf1, 0x79, 0x55, 0xa5, 0x70, 0x83, 0x74, 0x9e,
+	0x47, 0x7d, 0xf3, 0x16, 0x9c, 0xf5, 0x02, 0x27, 0xd4, 0x94, 0x35, 0xd1, 0x54, 0x07, 0xd8, 0xf4,
+	0x82, 0x98, 0xcc, 0x6a, 0xf5, 0xeb, 0xd0, 0xc0, 0xc1, 0x67, 0x11, 0x8e, 0x70, 0xa2, 0xaa, 0x8e,
+	0xb3, 0xae, 0xc5, 0x5a, 0x4f, 0x50, 0x53, 0xc4, 0x76, 0x1d, 0xe6, 0x13, 0xce, 0x74, 0x4c, 0x34,
+	0x84, 0x64, 0x4b, 0x08, 0xcc, 0x0f, 0xc0, 0x10, 0xcd, 0x15, 0xea, 0xd5, 0xb5, 0xc1, 0x38, 0x03,
+	0x97, 0x78, 0xaf, 0xbc, 0x54, 0x1f, 0x4c, 0xb8, 0x0e, 0x9d, 0xe7, 0xba, 0x1e, 0xdb, 0xd5, 0xd4,
+	0x0e, 0x94, 0x68, 0xcd, 0x63, 0xbb, 0x22, 0x77, 0xef, 0xe3, 0x3e, 0xa1, 0xfb, 0xce, 0x2b, 0xc4,
+	0x31, 0xed, 0x23, 0xba, 0x2b, 0x8f, 0xa9, 0x60, 0x37, 0x94, 0xfc, 0x93, 0x58, 0x2c, 0x78, 0x92,
+	0xe8, 0x24, 0xa5, 0x68, 0x48, 0xc5, 0xba, 0x90, 0x0e, 0xd4, 0xae, 0xc1, 0xac, 0x5a, 0xf1, 0x2b,
+	0x24, 0x2e, 0xa0, 0xef, 0xdd, 0xd3, 0xd7, 0x05, 0x35, 0x29, 0xfd, 0x04, 0x79, 0x7c, 0xf3, 0xde,
+	0x3d, 0x99, 0x16, 0xed, 0x50, 0xc2, 0xb9, 0x8f, 0x5d, 0xfd, 0x02, 0x36, 0x10, 0x58, 0xdf, 0x81,
+	0x0b, 0xe9, 0xeb, 0x61, 0x8f, 0x71, 0xaf, 0x7b, 0x9a, 0x49, 0xc8, 0xcf, 0x0b, 0xd0, 0xce, 0x1b,
+	0xe0, 0xdf, 0x99, 0x7b, 0xdd, 0x83, 0xb3, 0xfa, 0xe1, 0xe2, 0xa8, 0x29, 0xa8, 0x68, 0x6a, 0x63,
+	0xc6, 0x09, 0x3d, 0x7a, 0xd3, 0x15, 0x79, 0xc3, 0x3d, 0xfa, 0x6c, 0x72, 0x84, 0x2e, 0xfe, 0x51,
+	0x80, 0x4b, 0xf9, 0x7d, 0x9c, 0x64, 0x3b, 0xbf, 0x92, 0x0d, 0xbe, 0x13, 0xbe, 0xf6, 0xe8, 0x10,
+	0xfc, 0x0e, 0xcc, 0xe9, 0x67, 0x1f, 0xd7, 0xd1, 0xf7, 0x1b, 0x71, 0xc6, 0xd7, 0x8c, 0x2b, 0xf4,
+	0x0d, 0x05, 0x33, 0x6f, 0x81, 0x49, 0xe5, 0xee, 0x89, 0xd4, 0x2f, 0xd1, 0x56, 0x76, 0x3a, 0x97,
+	0xd4, 0xc4, 0xea, 0x16, 0x87, 0x5a, 0x9a, 0x17, 0x89, 0x73, 0x57, 0x41, 0x54, 0x84, 0x5f, 0xb1,
+	0xc4, 0xd2, 0xe2, 0x6c, 0xfe, 0xb9, 0xcb, 0x66, 0x32, 0x02, 0x03, 0x8b, 0x3f, 0x99, 0xb0, 0x17,
+	0xd5, 0xde, 0x27, 0x3d, 0x95, 0x9a, 0x29, 0xb8, 0xaa, 0xd0, 0xfc, 0x94, 0xf4, 0x04, 0x73, 0xb6,
+	0x3c, 0x98, 0xcd, 0x86, 0xe0, 0x83, 0xe2, 0xcd, 0x44, 0x5d, 0x8a, 0x54, 0x8b, 0x11, 0x2a, 0x5e,
+	0xf7, 0xd4, 0xed, 0x97, 0x2e, 0x59, 0x7f, 0x29, 0x81, 0x69, 0xe3, 0x3e, 0xe1, 0x58, 0xe6, 0xe7,
+	0x31, 0x14, 0xee, 0x42, 0xe9, 0x25, 0xe9, 0xe8, 0x23, 0xbc, 0x96, 0xb7, 0xbe, 0xe1, 0x84, 0xc3,
+	0x16, 0x0d, 0x46, 0x20, 0x54, 0x3c, 0xfc, 0xd5, 0xb6, 0x74, 0xc8, 0xab, 0xed, 0xd4, 0x01, 0xf7,
+	0xb8, 0xe5, 0xec, 0x3d, 0xee, 0xe9, 0x5c, 0xba, 0x0e, 0x11, 0xf9, 0x99, 0xe3, 0x10, 0xf9, 0xab,
+	0x50, 0x57, 0x34, 0x2b, 0xce, 0xad, 0x54, 0x2a, 0x5d, 0x53, 0x42, 0x9d, 0x58, 0x5d, 0x04, 0x99,
+	0x2c, 0x39, 0x11, 0xf5, 0x55, 0xd2, 0x61, 0xd8, 0x15, 0x21, 0xf8, 0x88, 0xfa, 0x72, 0x16, 0xa4,
+	0xf3, 0x12, 0x77, 0xb9, 0xc3, 0x51, 0xef, 0x28, 0xe9, 0x84, 0x6a, 0xf5, 0x02, 0xf5, 0x98, 0xf5,
+	0x21, 0x34, 0xd4, 0xd9, 0x26, 0x97, 0x96, 0xa6, 0x09, 0x53, 0x12, 0x23, 0x8a, 0xf9, 0xc8, 0x6f,
+	0x21, 0x93, 0x84, 0x54, 0x1d, 0x96, 0xfc, 0x96, 0x78, 0xd9, 0x41, 0xcb, 0xef, 0xdf, 0xd5, 0x17,
+	0x13, 0xba, 0x24, 0xfe, 0x17, 0x38, 0x9b, 0xc1, 0xcb, 0x49, 0xcc, 0xfe, 0x1e, 0x94, 0x05, 0x65,
+	0x88, 0xfd, 0xe7, 0xd5, 0x7c, 0xe6, 0x91, 0x59, 0x80, 0xad, 0x5a, 0x58, 0xbf, 0x29, 0x42, 0x3d,
+	0x43, 0x4a, 0xe4, 0x33, 0x75, 0x18, 0x39, 0x0c, 0x77, 0x49, 0xe0, 0xaa, 0x69, 0x14, 0x6c, 0xe8,
+	0x86, 0xd1, 0x96, 0x92, 0x08, 0x43, 0x09, 0x31, 0xda, 0x75, 0x28, 0x63, 0x4e, 0x67, 0x5f, 0xbd,
+	0x41, 0x49, 0x74, 0x0a, 0xa9, 0xcd, 0xd8, 0x7d, 0x21, 0x13, 0x51, 0x5c, 0x06, 0x3e, 0x91, 0x9c,
+	0x68, 0x35, 0x85, 0x50, 0x19, 0xf9, 0x6c, 0x8c, 0x5c, 0xa5, 0xb7, 0x08, 0x4d, 0x15, 0x20, 0xe5,
+	0x93, 0xb6, 0x52, 0x54, 0x50, 0x95, 0x81, 0xf3, 0x13, 0x21, 0x56, 0x9a, 0xd7, 0x60, 0x36, 0xc0,
+	0x5c, 0xf0, 0x9d, 0x3d, 0xad, 0xa7, 0xf3, 0xe0, 0x00, 0x73, 0x1b, 0x77, 0xf7, 0x32, 0x5a, 0x4c,
+	0x50, 0x41, 0xa5, 0x35, 0x9d, 0x68, 0x6d, 0xe1, 0x40, 0x8f, 0x7a, 0x03, 0x1a, 0x5d, 0x12, 0x74,
+	0x23, 0x4a, 0xc5, 0xa5, 0xf0, 0x4b, 0xd2, 0x51, 0xbf, 0xc0, 0x94, 0xed, 0xd9, 0x81, 0x58, 0xb0,
+	0x5d, 0xab, 0x05, 0xe7, 0x1e, 0x61, 0xbe, 0x8a, 0x42, 0xd4, 0xf1, 0x7c, 0x8f, 0x7b, 0x38, 0xa1,
+	0x51, 0x7f, 0x2b, 0xc2, 0xf9, 0x91, 0xaa, 0x93, 0x9c, 0xe2, 0xe5, 0x38, 0x16, 0x2a, 0x9f, 0x58,
+	0x94, 0x40, 0x56, 0xc1, 0x4e, 0x39, 0xbd, 0x21, 0x5e, 0x52, 0x1a, 0xe1, 0x25, 0x57, 0x41, 0x6e,
+	0xae, 0xd3, 0x45, 0x21, 0xea, 0x8a, 0x3c, 0x53, 0x6d, 0x64, 0x4d, 0x08, 0x57, 0xb5, 0x4c, 0xf4,
+	0xd2, 0x0b, 0x23, 0x47, 0x35, 0x73, 0xe5, 0x1e, 0x56, 0x6c, 0xe8, 0x85, 0xd1, 0x03, 0x25, 0x11,
+	0xe6, 0xc4, 0xbc, 0xbe, 0x3b, 0xc8, 0x8c, 0x0c, 0xbb, 0x22, 0x04, 0x32, 0xfb, 0x79, 0x18, 0xdf,
+	0x45, 0xe0, 0xa0, 0xe7, 0x05, 0xf8, 0x08, 0x66, 0xad, 0x5c, 0xca, 0x03, 0xd5, 0x4c, 0x4c, 0x55,
+	0x26, 0x04, 0x19, 0x9a, 0x6b, 0xd8, 0x35, 0x29, 0x8c, 0xb3, 0x58, 0x1f, 0x0c, 0x1b, 0x71, 0xfc,
+	0x88, 0x92, 0x28, 0x14, 0xd6, 0x25, 0x79, 0x89, 0xb6, 0x38, 0xf1, 0x2d, 0xae, 0x5f, 0xba, 0x14,
+	0xbb, 0x82, 0x32, 0x61, 0xaa, 0x21, 0x2b, 0xd1, 0x58, 0xb0, 0x1b, 0xaa, 0x62, 0x13, 0x53, 0x85,
+	0x5b, 0xb1, 0xee, 0x3e, 0x7a, 0xed, 0x74, 0x90, 0x8f, 0x82, 0xae, 0x4a, 0x1f, 0x0a, 0x36, 0xf4,
+	0xd1, 0xeb, 0xfb, 0x4a, 0x62, 0x05, 0x70, 0xee, 0xa3, 0xd0, 0x45, 0x1c, 0x3f, 0x25, 0x3d, 0x7d,
+	0xc1, 0xa1, 0xbd, 0xf8, 0x3c, 0x94, 0x7d, 0xbc, 0x87, 0x7d, 0x3d, 0xb6, 0x2a, 0x88, 0x18, 0x46,
+	0x11, 0xc7, 0x4e, 0x4f, 0x4c, 0xef, 0x40, 0xee, 0x92, 0x2c, 0xc2, 0x06, 0x1a, 0x7f, 0x32, 0xf1,
+	0x9e, 0x7f, 0x7e, 0x64, 0xc0, 0x93, 0x00, 0x28, 0x99, 0x66, 0xf1, 0x80, 0x69, 0x96, 0x8e, 0x38,
+	0xcd, 0x9b, 0x2b, 0x30, 0x37, 0x42, 0x19, 0xcc, 0x06, 0x54, 0x9f, 0x13, 0xae, 0x45, 0x6e, 0xf3,
+	0x8c, 0x59, 0x83, 0x4a, 0x52, 0x2a, 0x98, 0x75, 0x30, 0xec, 0x98, 0x03, 0x34, 0x8b, 0x37, 0xdf,
+	0x95, 0x09, 0x9f, 0xc4, 0xcf, 0x59, 0x68, 0xe8, 0x4f, 0xd9, 0xe9, 0x06, 0xe9, 0x34, 0xcf, 0xa4,
+	0x84, 0x71, 0xb8, 0x6e, 0x16, 0x6e, 0x2e, 0x80, 0x91, 0xc4, 0x7e, 0xa1, 0xb1, 0x49, 0xbd, 0x3e,
+	0xa2, 0xfb, 0x4f, 0xf0, 0xbe, 0x14, 0x37, 0xcf, 0x2c, 0xff, 0xb0, 0x0a, 0x20, 0x7b, 0x59, 0x25,
+	0x84, 0xba, 0xa6, 0x2f, 0xb3, 0x9d, 0x55, 0xd2, 0x0f, 0x49, 0x80, 0x03, 0x2e, 0x27, 0xca, 0xcc,
+	0xa5, 0xec, 0x4a, 0x75, 0x61, 0x54, 0x51, 0x9f, 0x75, 0xfb, 0x5a, 0xae, 0xfe, 0x90, 0xb2, 0x75,
+	0xc6, 0xfc, 0x4c, 0x3e, 0x50, 0x0d, 0x78, 0xf0, 0xea, 0x0e, 0x0a, 0x02, 0xec, 0x9b, 0xcb, 0x63,
+	0xfe, 0x17, 0xc9, 0x53, 0x8e, 0xc7, 0xbc, 0x9a, 0x3b, 0xe6, 0x16, 0x17, 0xdb, 0x17, 0x43, 0xc2,
+	0x3a, 0x63, 0xbe, 0x80, 0x6a, 0xea, 0xd1, 0xde, 0xbc, 0x3e, 0x9e, 0x4e, 0xa4, 0x19, 0x6d, 0xfb,
+	0x20, 0xec, 0x58, 0x67, 0xcc, 0x6d, 0xa8, 0x67, 0xfe, 0x2a, 0x31, 0x17, 0x0f, 0x7a, 0x17, 0x4b,
+	0x13, 0xdd, 0xf6, 0xdb, 0x13, 0x68, 0x26, 0xb3, 0xff, 0x9e, 0xda, 0xb0, 0x91, 0xdf, 0x32, 0x6e,
+	0x8f, 0xe9, 0x64, 0xdc, 0x0f, 0x24, 0xed, 0x3b, 0x93, 0x37, 0x48, 0x06, 0x77, 0x07, 0x8b, 0x54,
+	0x39, 0xde, 0x8d, 0xc3, 0x1f, 0xff, 0xd4, 0x68, 0x8b, 0x93, 0xbe, 0x12, 0x5a, 0x67, 0xcc, 0x4d,
+	0x30, 0x92, 0x77, 0x3a, 0x33, 0x97, 0xed, 0x0d, 0x3f, 0xe3, 0x4d, 0x70, 0x38, 0x99, 0x97, 0xae,
+	0xfc, 0xc3, 0xc9, 0x7b, 0x86, 0x6b, 0xbf, 0x3d, 0x81, 0x66, 0x32, 0xf3, 0x48, 0xda, 0xce, 0x50,
+	0x6a, 0x67, 0xde, 0x3a, 0xec, 0x7c, 0x33, 0x39, 0x66, 0x7b, 0x69, 0x52, 0xf5, 0x64, 0xd8, 0xef,
+	0x0f, 0xfe, 0x68, 0xca, 0x3c, 0x6b, 0x99, 0x77, 0x0e, 0xea, 0x2a, 0xef, 0x95, 0xad, 0xfd, 0x7f,
+	0x47, 0x68, 0x91, 0xc2, 0xa4, 0xb9, 0xb5, 0x43, 0x5e, 0x29, 0xe7, 0x1b, 0x51, 0xc4, 0x3d, 0x12,
+	0xe4, 0x0c, 0xae, 0x4d, 0x78, 0x54, 0x75, 0xec, 0xe0, 0x07, 0xb4, 0x48, 0x06, 0x77, 0x00, 0x1e,
+	0x61, 0xfe, 0x0c, 0x73, 0x2a, 0xf6, 0xfa, 0xfa, 0x38, 0x3f, 0xa5, 0x15, 0xe2, 0xa1, 0x6e, 0x1c,
+	0xaa, 0x97, 0x0c, 0xd0, 0x81, 0xea, 0xea, 0x0e, 0xee, 0xee, 0x3e, 0xc6, 0xc8, 0xe7, 0x3b, 0x66,
+	0x7e, 0xcb, 0x94, 0xc6, 0x18, 0xc8, 0xe7, 0x29, 0xc6, 0x63, 0x2c, 0xff, 0xb8, 0xa2, 0xff, 0xab,
+	0x16, 0xbf, 0xdf, 0xfd, 0xe7, 0xbb, 0xe0, 0x4d, 0x30, 0x92, 0x84, 0xcd, 0x9c, 0x28, 0x9f, 0x3b,
+	0xcc, 0xc2, 0x3f, 0x05, 0x23, 0xb9, 0x06, 0xce, 0xef, 0x71, 0xf8, 0x22, 0xbf, 0xfd, 0xd6, 0x21,
+	0x5a, 0xc9, 0x6c, 0x9f, 0x43, 0x25, 0xbe, 0xb6, 0x35, 0xaf, 0x8e, 0x73, 0x47, 0xe9, 0x9e, 0x0f,
+	0x99, 0xeb, 0x77, 0xa1, 0x9a, 0xba, 0x38, 0xcc, 0x0f, 0x40, 0xa3, 0x17, 0x8e, 0xed, 0x1b, 0x87,
+	0xea, 0x25, 0x33, 0xf6, 0xa1, 0x31, 0xc4, 0xa9, 0xcd, 0x9b, 0x63, 0x5a, 0xe7, 0x70, 0xf2, 0xf6,
+	0x3b, 0x13, 0xe9, 0xfe, 0x97, 0x98, 0xbf, 0x0f, 0x8d, 0x21, 0x7a, 0x99, 0xbf, 0x97, 0xf9, 0xa4,
+	0xb7, 0xfd, 0xce, 0x44, 0xba, 0x89, 0x23, 0x20, 0x50, 0x1b, 0xb8, 0x5a, 0x4c, 0xc5, 0xf2, 0xe4,
+	0xe7, 0x01, 0x5c, 0x65, 0xf4, 0xbe, 0xa4, 0x7d, 0xe3, 0x50, 0xbd, 0x78, 0xc0, 0xfb, 0xef, 0x7d,
+	0xba, 0xdc, 0xf3, 0xf8, 0x4e, 0xd4, 0x11, 0x30, 0xbd, 0xad, 0x9a, 0xdd, 0xf2, 0x88, 0xfe, 0xba,
+	0x1d, 0x1f, 0xc2, 0x6d, 0xd9, 0xd3, 0x6d, 0xd9, 0x53, 0xd8, 0xe9, 0x4c, 0xcb, 0xe2, 0xbb, 0xff,
+	0x1c, 0x00, 0x86, 0xbb, 0x64, 0x76, 0x63, 0x32, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexCoordClient is the client API for IndexCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetSegmentIndexState(ctx context.Context, in *GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*GetSegmentIndexStateResponse, error)
+	GetIndexInfos(ctx context.Context, in *GetIndexInfoRequest, opts ...grpc.CallOption) (*GetIndexInfoResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	GetIndexStatistics(ctx context.Context, in *GetIndexStatisticsRequest, opts ...grpc.CallOption) (*GetIndexStatisticsResponse, error)
+	// Deprecated: use DescribeIndex instead
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+}
+
+type indexCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIndexCoordClient(cc *grpc.ClientConn) IndexCoordClient {
+	return &indexCoordClient{cc}
+}
+
+func (c *indexCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *indexCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
@@ -2849,6 +4080,10 @@ type IndexNodeClient interface {
 	QueryJobs(ctx context.Context, in *QueryJobsRequest, opts ...grpc.CallOption) (*QueryJobsResponse, error)
 	DropJobs(ctx context.Context, in *DropJobsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetJobStats(ctx context.Context, in *GetJobStatsRequest, opts ...grpc.CallOption) (*GetJobStatsResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	// UpdateLogConfig changes the log level and the rate groups of the rated logs at runtime,
+	// the empty request returns the current config only
+	UpdateLogConfig(ctx context.Context, in *UpdateLogConfigRequest, opts ...grpc.CallOption) (*UpdateLogConfigResponse, error)
 	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
@@ -2916,6 +4151,24 @@ func (c *indexNodeClient) GetJobStats(ctx context.Context, in *GetJobStatsReques
 	return out, nil
 }
 
+func (c *indexNodeClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexNodeClient) UpdateLogConfig(ctx context.Context, in *UpdateLogConfigRequest, opts ...grpc.CallOption) (*UpdateLogConfigResponse, error) {
+	out := new(UpdateLogConfigResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/UpdateLogConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexNodeClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
 	out := new(internalpb.ShowConfigurationsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexNode/ShowConfigurations", in, out, opts...)
@@ -2942,6 +4195,10 @@ type IndexNodeServer interface {
 	QueryJobs(context.Context, *QueryJobsRequest) (*QueryJobsResponse, error)
 	DropJobs(context.Context, *DropJobsRequest) (*commonpb.Status, error)
 	GetJobStats(context.Context, *GetJobStatsRequest) (*GetJobStatsResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	// UpdateLogConfig changes the log level and the rate groups of the rated logs at runtime,
+	// the empty request returns the current config only
+	UpdateLogConfig(context.Context, *UpdateLogConfigRequest) (*UpdateLogConfigResponse, error)
 	ShowConfigurations(context.Context, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -2969,6 +4226,12 @@ func (*UnimplementedIndexNodeServer) DropJobs(ctx context.Context, req *DropJobs
 func (*UnimplementedIndexNodeServer) GetJobStats(ctx context.Context, req *GetJobStatsRequest) (*GetJobStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobStats not implemented")
 }
+func (*UnimplementedIndexNodeServer) GetCapabilities(ctx context.Context, req *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (*UnimplementedIndexNodeServer) UpdateLogConfig(ctx context.Context, req *UpdateLogConfigRequest) (*UpdateLogConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogConfig not implemented")
+}
 func (*UnimplementedIndexNodeServer) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowConfigurations not implemented")
 }
@@ -3088,6 +4351,42 @@ func _IndexNode_GetJobStats_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndexNode_UpdateLogConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).UpdateLogConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexNode/UpdateLogConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).UpdateLogConfig(ctx, req.(*UpdateLogConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IndexNode_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.ShowConfigurationsRequest)
 	if err := dec(in); err != nil {
@@ -3152,6 +4451,14 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobStats",
 			Handler:    _IndexNode_GetJobStats_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _IndexNode_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "UpdateLogConfig",
+			Handler:    _IndexNode_UpdateLogConfig_Handler,
+		},
 		{
 			MethodName: "ShowConfigurations",
 			Handler:    _IndexNode_ShowConfigurations_Handler,
@@ -3164,3 +4471,75 @@ var _IndexNode_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "index_coord.proto",
 }
+
+// IndexBuilderClient is the client API for IndexBuilder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexBuilderClient interface {
+	BuildIndex(ctx context.Context, in *RemoteBuildRequest, opts ...grpc.CallOption) (*RemoteBuildResponse, error)
+}
+
+type indexBuilderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIndexBuilderClient(cc *grpc.ClientConn) IndexBuilderClient {
+	return &indexBuilderClient{cc}
+}
+
+func (c *indexBuilderClient) BuildIndex(ctx context.Context, in *RemoteBuildRequest, opts ...grpc.CallOption) (*RemoteBuildResponse, error) {
+	out := new(RemoteBuildResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.index.IndexBuilder/BuildIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexBuilderServer is the server API for IndexBuilder service.
+type IndexBuilderServer interface {
+	BuildIndex(context.Context, *RemoteBuildRequest) (*RemoteBuildResponse, error)
+}
+
+// UnimplementedIndexBuilderServer can be embedded to have forward compatible implementations.
+type UnimplementedIndexBuilderServer struct {
+}
+
+func (*UnimplementedIndexBuilderServer) BuildIndex(ctx context.Context, req *RemoteBuildRequest) (*RemoteBuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildIndex not implemented")
+}
+
+func RegisterIndexBuilderServer(s *grpc.Server, srv IndexBuilderServer) {
+	s.RegisterService(&_IndexBuilder_serviceDesc, srv)
+}
+
+func _IndexBuilder_BuildIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexBuilderServer).BuildIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.index.IndexBuilder/BuildIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexBuilderServer).BuildIndex(ctx, req.(*RemoteBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IndexBuilder_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.index.IndexBuilder",
+	HandlerType: (*IndexBuilderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BuildIndex",
+			Handler:    _IndexBuilder_BuildIndex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "index_coord.proto",
+}
@@ -1762,18 +1762,21 @@ func (m *SegmentLoadInfo) GetReadableVersion() int64 {
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
-	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	IndexName            string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	IndexFilePaths       []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
-	IndexSize            int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	NumRows              int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	EnableIndex    bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	IndexName      string                   `protobuf:"bytes,3,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID        int64                    `protobuf:"varint,4,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID        int64                    `protobuf:"varint,5,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	IndexParams    []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	IndexFilePaths []string                 `protobuf:"bytes,7,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	IndexSize      int64                    `protobuf:"varint,8,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	IndexVersion   int64                    `protobuf:"varint,9,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	NumRows        int64                    `protobuf:"varint,10,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the object storage of the collection, empty if it's the global one
+	StorageEndpoint      string   `protobuf:"bytes,11,opt,name=storage_endpoint,json=storageEndpoint,proto3" json:"storage_endpoint,omitempty"`
+	StorageRequesterPays bool     `protobuf:"varint,12,opt,name=storage_requester_pays,json=storageRequesterPays,proto3" json:"storage_requester_pays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldIndexInfo) Reset()         { *m = FieldIndexInfo{} }
@@ -1871,6 +1874,20 @@ func (m *FieldIndexInfo) GetNumRows() int64 {
 	return 0
 }
 
+func (m *FieldIndexInfo) GetStorageEndpoint() string {
+	if m != nil {
+		return m.StorageEndpoint
+	}
+	return ""
+}
+
+func (m *FieldIndexInfo) GetStorageRequesterPays() bool {
+	if m != nil {
+		return m.StorageRequesterPays
+	}
+	return false
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DstNodeID            int64                      `protobuf:"varint,2,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
@@ -4574,307 +4591,309 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 4789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6f, 0x1c, 0x47,
-	0x7a, 0xea, 0x79, 0x71, 0xe6, 0x9b, 0x57, 0xb3, 0x48, 0x4a, 0xb3, 0xb3, 0x92, 0x2c, 0xb7, 0xfc,
-	0xe0, 0xca, 0x36, 0xa5, 0xa5, 0xd6, 0x5e, 0xed, 0xda, 0x86, 0x23, 0x91, 0x96, 0xcc, 0xb5, 0x4d,
-	0x33, 0x4d, 0xca, 0x1b, 0x78, 0xbd, 0x3b, 0x6e, 0x4e, 0x17, 0x87, 0x0d, 0xf5, 0x63, 0xd4, 0xdd,
-	0x43, 0x9a, 0x0e, 0x10, 0xe4, 0x90, 0x4b, 0x36, 0xd9, 0x20, 0x48, 0x0e, 0xc9, 0x21, 0xc8, 0x21,
-	0x41, 0x80, 0x4d, 0x90, 0x5c, 0x82, 0x04, 0xc8, 0x21, 0x87, 0xdc, 0x72, 0xca, 0xeb, 0x94, 0x3f,
-	0x90, 0xdc, 0x02, 0xe4, 0x92, 0x45, 0xe0, 0x5b, 0x50, 0x8f, 0x7e, 0x54, 0x77, 0x0d, 0xa7, 0xc9,
-	0x91, 0xd6, 0x76, 0xb0, 0xb7, 0xe9, 0xaf, 0x1e, 0xdf, 0x57, 0x5f, 0x7d, 0xef, 0xaa, 0x1a, 0x58,
-	0x7c, 0x3c, 0xc1, 0xfe, 0xc9, 0x60, 0xe8, 0x79, 0xbe, 0xb9, 0x36, 0xf6, 0xbd, 0xd0, 0x43, 0xc8,
-	0xb1, 0xec, 0xa3, 0x49, 0xc0, 0xbe, 0xd6, 0x68, 0x7b, 0xbf, 0x35, 0xf4, 0x1c, 0xc7, 0x73, 0x19,
-	0xac, 0xdf, 0x4a, 0xf7, 0xe8, 0x77, 0x2c, 0x37, 0xc4, 0xbe, 0x6b, 0xd8, 0x51, 0x6b, 0x30, 0x3c,
-	0xc4, 0x8e, 0xc1, 0xbf, 0x1a, 0x4e, 0x30, 0xe2, 0x3f, 0x55, 0xd3, 0x08, 0x8d, 0x34, 0xaa, 0xfe,
-	0xa2, 0xe5, 0x9a, 0xf8, 0xd3, 0x34, 0x48, 0xfb, 0x0d, 0x05, 0x2e, 0xee, 0x1e, 0x7a, 0xc7, 0x1b,
-	0x9e, 0x6d, 0xe3, 0x61, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0xc7, 0x13, 0x1c, 0x84, 0xe8, 0x16, 0x54,
-	0xf6, 0x8d, 0x00, 0xf7, 0x94, 0x6b, 0xca, 0x6a, 0x73, 0xfd, 0xf2, 0x9a, 0x40, 0x27, 0x27, 0xf0,
-	0xfd, 0x60, 0x74, 0xcf, 0x08, 0xb0, 0x4e, 0x7b, 0x22, 0x04, 0x15, 0x73, 0x7f, 0x6b, 0xb3, 0x57,
-	0xba, 0xa6, 0xac, 0x96, 0x75, 0xfa, 0x1b, 0x3d, 0x07, 0xed, 0x61, 0x3c, 0xf7, 0xd6, 0x66, 0xd0,
-	0x2b, 0x5f, 0x2b, 0xaf, 0x96, 0x75, 0x11, 0xa8, 0xfd, 0xb8, 0x04, 0x97, 0x72, 0x64, 0x04, 0x63,
-	0xcf, 0x0d, 0x30, 0xba, 0x0d, 0xb5, 0x20, 0x34, 0xc2, 0x49, 0xc0, 0x29, 0xf9, 0xba, 0x94, 0x92,
-	0x5d, 0xda, 0x45, 0xe7, 0x5d, 0xf3, 0x68, 0x4b, 0x12, 0xb4, 0xe8, 0x9b, 0xb0, 0x6c, 0xb9, 0xef,
-	0x63, 0xc7, 0xf3, 0x4f, 0x06, 0x63, 0xec, 0x0f, 0xb1, 0x1b, 0x1a, 0x23, 0x1c, 0xd1, 0xb8, 0x14,
-	0xb5, 0xed, 0x24, 0x4d, 0xe8, 0x35, 0xb8, 0xc4, 0xf6, 0x30, 0xc0, 0xfe, 0x91, 0x35, 0xc4, 0x03,
-	0xe3, 0xc8, 0xb0, 0x6c, 0x63, 0xdf, 0xc6, 0xbd, 0xca, 0xb5, 0xf2, 0x6a, 0x5d, 0x5f, 0xa1, 0xcd,
-	0xbb, 0xac, 0xf5, 0x6e, 0xd4, 0x88, 0xbe, 0x01, 0xaa, 0x8f, 0x0f, 0x7c, 0x1c, 0x1c, 0x0e, 0xc6,
-	0xbe, 0x37, 0xf2, 0x71, 0x10, 0xf4, 0xaa, 0x14, 0x4d, 0x97, 0xc3, 0x77, 0x38, 0x58, 0xfb, 0x33,
-	0x05, 0x56, 0x08, 0x33, 0x76, 0x0c, 0x3f, 0xb4, 0x9e, 0xc2, 0x96, 0x68, 0xd0, 0x4a, 0xb3, 0xa1,
-	0x57, 0xa6, 0x6d, 0x02, 0x8c, 0xf4, 0x19, 0x47, 0xe8, 0x09, 0xfb, 0x2a, 0x94, 0x54, 0x01, 0xa6,
-	0xfd, 0x0b, 0x97, 0x9d, 0x34, 0x9d, 0xf3, 0xec, 0x59, 0x16, 0x67, 0x29, 0x8f, 0xf3, 0x3c, 0x3b,
-	0x26, 0xe3, 0x7c, 0x45, 0xce, 0xf9, 0x7f, 0x2a, 0xc3, 0xca, 0x7b, 0x9e, 0x61, 0x26, 0x62, 0xf8,
-	0xf3, 0xe7, 0xfc, 0x9b, 0x50, 0x63, 0x1a, 0xdd, 0xab, 0x50, 0x5c, 0xcf, 0x8b, 0xb8, 0xb8, 0xb6,
-	0x27, 0x14, 0xee, 0x52, 0x80, 0xce, 0x07, 0xa1, 0xe7, 0xa1, 0xe3, 0xe3, 0xb1, 0x6d, 0x0d, 0x8d,
-	0x81, 0x3b, 0x71, 0xf6, 0xb1, 0xdf, 0xab, 0x5e, 0x53, 0x56, 0xab, 0x7a, 0x9b, 0x43, 0xb7, 0x29,
-	0x10, 0x7d, 0x02, 0xed, 0x03, 0x0b, 0xdb, 0xe6, 0x80, 0x9a, 0x84, 0xad, 0xcd, 0x5e, 0xed, 0x5a,
-	0x79, 0xb5, 0xb9, 0xfe, 0xfa, 0x5a, 0xde, 0x1a, 0xad, 0x49, 0x39, 0xb2, 0x76, 0x9f, 0x0c, 0xdf,
-	0x62, 0xa3, 0xdf, 0x76, 0x43, 0xff, 0x44, 0x6f, 0x1d, 0xa4, 0x40, 0xa8, 0x07, 0x0b, 0x9c, 0xbd,
-	0xbd, 0x85, 0x6b, 0xca, 0x6a, 0x5d, 0x8f, 0x3e, 0xd1, 0x8b, 0xd0, 0xf5, 0x71, 0xe0, 0x4d, 0xfc,
-	0x21, 0x1e, 0x8c, 0x7c, 0x6f, 0x32, 0x0e, 0x7a, 0xf5, 0x6b, 0xe5, 0xd5, 0x86, 0xde, 0x89, 0xc0,
-	0x0f, 0x28, 0xb4, 0xff, 0x16, 0x2c, 0xe6, 0xb0, 0x20, 0x15, 0xca, 0x8f, 0xf0, 0x09, 0xdd, 0x88,
-	0xb2, 0x4e, 0x7e, 0xa2, 0x65, 0xa8, 0x1e, 0x19, 0xf6, 0x04, 0x73, 0x56, 0xb3, 0x8f, 0xef, 0x96,
-	0xee, 0x28, 0xda, 0x1f, 0x29, 0xd0, 0xd3, 0xb1, 0x8d, 0x8d, 0x00, 0x7f, 0x91, 0x5b, 0x7a, 0x11,
-	0x6a, 0xae, 0x67, 0xe2, 0xad, 0x4d, 0xba, 0xa5, 0x65, 0x9d, 0x7f, 0x69, 0x9f, 0x2b, 0xb0, 0xfc,
-	0x00, 0x87, 0x44, 0x0d, 0xac, 0x20, 0xb4, 0x86, 0xb1, 0x9e, 0xbf, 0x09, 0x65, 0x1f, 0x3f, 0xe6,
-	0x94, 0xbd, 0x24, 0x52, 0x16, 0x9b, 0x7f, 0xd9, 0x48, 0x9d, 0x8c, 0x43, 0xcf, 0x42, 0xcb, 0x74,
-	0xec, 0xc1, 0xf0, 0xd0, 0x70, 0x5d, 0x6c, 0x33, 0x45, 0x6a, 0xe8, 0x4d, 0xd3, 0xb1, 0x37, 0x38,
-	0x08, 0x5d, 0x05, 0x08, 0xf0, 0xc8, 0xc1, 0x6e, 0x98, 0xd8, 0xe4, 0x14, 0x04, 0xdd, 0x80, 0xc5,
-	0x03, 0xdf, 0x73, 0x06, 0xc1, 0xa1, 0xe1, 0x9b, 0x03, 0x1b, 0x1b, 0x26, 0xf6, 0x29, 0xf5, 0x75,
-	0xbd, 0x4b, 0x1a, 0x76, 0x09, 0xfc, 0x3d, 0x0a, 0x46, 0xb7, 0xa1, 0x1a, 0x0c, 0xbd, 0x31, 0xa6,
-	0x92, 0xd6, 0x59, 0xbf, 0x22, 0x93, 0xa1, 0x4d, 0x23, 0x34, 0x76, 0x49, 0x27, 0x9d, 0xf5, 0xd5,
-	0xfe, 0xae, 0xc2, 0x54, 0xed, 0x4b, 0x6e, 0xe4, 0x52, 0xea, 0x58, 0x7d, 0x32, 0xea, 0x58, 0x2b,
-	0xa4, 0x8e, 0x0b, 0xa7, 0xab, 0x63, 0x8e, 0x6b, 0x67, 0x51, 0xc7, 0xfa, 0x4c, 0x75, 0x6c, 0xc8,
-	0xd4, 0x11, 0xbd, 0x0d, 0x5d, 0x16, 0x40, 0x58, 0xee, 0x81, 0x37, 0xb0, 0xad, 0x20, 0xec, 0x01,
-	0x25, 0xf3, 0x4a, 0x56, 0x42, 0x4d, 0xfc, 0xe9, 0x1a, 0x43, 0xec, 0x1e, 0x78, 0x7a, 0xdb, 0x8a,
-	0x7e, 0xbe, 0x67, 0x05, 0xe1, 0xfc, 0x5a, 0xfd, 0x0f, 0x89, 0x56, 0x7f, 0xd9, 0xa5, 0x27, 0xd1,
-	0xfc, 0xaa, 0xa0, 0xf9, 0x7f, 0xae, 0xc0, 0xd7, 0x1e, 0xe0, 0x30, 0x26, 0x9f, 0x28, 0x32, 0xfe,
-	0x92, 0xba, 0xf9, 0xbf, 0x52, 0xa0, 0x2f, 0xa3, 0x75, 0x1e, 0x57, 0xff, 0x11, 0x5c, 0x8c, 0x71,
-	0x0c, 0x4c, 0x1c, 0x0c, 0x7d, 0x6b, 0x4c, 0xb7, 0x91, 0xda, 0xaa, 0xe6, 0xfa, 0x75, 0x99, 0xe0,
-	0x67, 0x29, 0x58, 0x89, 0xa7, 0xd8, 0x4c, 0xcd, 0xa0, 0xfd, 0x44, 0x81, 0x15, 0x62, 0x1b, 0xb9,
-	0x31, 0x23, 0x12, 0x78, 0x6e, 0xbe, 0x8a, 0x66, 0xb2, 0x94, 0x33, 0x93, 0x05, 0x78, 0x4c, 0x43,
-	0xec, 0x2c, 0x3d, 0xf3, 0xf0, 0xee, 0x55, 0xa8, 0x12, 0x05, 0x8c, 0x58, 0xf5, 0x8c, 0x8c, 0x55,
-	0x69, 0x64, 0xac, 0xb7, 0xe6, 0x32, 0x2a, 0x12, 0xbb, 0x3d, 0x87, 0xb8, 0x65, 0x97, 0x5d, 0x92,
-	0x2c, 0xfb, 0xb7, 0x15, 0xb8, 0x94, 0x43, 0x38, 0xcf, 0xba, 0xdf, 0x80, 0x1a, 0xf5, 0x46, 0xd1,
-	0xc2, 0x9f, 0x93, 0x2e, 0x3c, 0x85, 0x8e, 0x58, 0x1b, 0x9d, 0x8f, 0xd1, 0x3c, 0x50, 0xb3, 0x6d,
-	0xc4, 0x4f, 0x72, 0x1f, 0x39, 0x70, 0x0d, 0x87, 0x31, 0xa0, 0xa1, 0x37, 0x39, 0x6c, 0xdb, 0x70,
-	0x30, 0xfa, 0x1a, 0xd4, 0x89, 0xca, 0x0e, 0x2c, 0x33, 0xda, 0xfe, 0x05, 0xaa, 0xc2, 0x66, 0x80,
-	0xae, 0x00, 0xd0, 0x26, 0xc3, 0x34, 0x7d, 0xe6, 0x42, 0x1b, 0x7a, 0x83, 0x40, 0xee, 0x12, 0x80,
-	0xf6, 0x87, 0x0a, 0x5c, 0xdd, 0x3d, 0x71, 0x87, 0xdb, 0xf8, 0x78, 0xc3, 0xc7, 0x46, 0x88, 0x13,
-	0xa3, 0xfd, 0x54, 0x19, 0x8f, 0xae, 0x41, 0x33, 0xa5, 0xbf, 0x5c, 0x24, 0xd3, 0x20, 0xed, 0xaf,
-	0x15, 0x68, 0x11, 0x2f, 0xf2, 0x3e, 0x0e, 0x0d, 0x22, 0x22, 0xe8, 0x3b, 0xd0, 0xb0, 0x3d, 0xc3,
-	0x1c, 0x84, 0x27, 0x63, 0x46, 0x4d, 0x27, 0x4b, 0x4d, 0xe2, 0x7a, 0xf6, 0x4e, 0xc6, 0x58, 0xaf,
-	0xdb, 0xfc, 0x57, 0x21, 0x8a, 0xb2, 0x56, 0xa6, 0x2c, 0xb1, 0x94, 0xcf, 0x40, 0xd3, 0xc1, 0xa1,
-	0x6f, 0x0d, 0x19, 0x11, 0x15, 0xba, 0x15, 0xc0, 0x40, 0x04, 0x91, 0xf6, 0x93, 0x1a, 0x5c, 0xfc,
-	0xbe, 0x11, 0x0e, 0x0f, 0x37, 0x9d, 0x28, 0x8a, 0x39, 0x3f, 0x1f, 0x13, 0xbb, 0x5c, 0x4a, 0xdb,
-	0xe5, 0x27, 0x66, 0xf7, 0x63, 0x1d, 0xad, 0xca, 0x74, 0x94, 0x24, 0xe6, 0x6b, 0x1f, 0x72, 0x31,
-	0x4b, 0xe9, 0x68, 0x2a, 0xd8, 0xa8, 0x9d, 0x27, 0xd8, 0xd8, 0x80, 0x36, 0xfe, 0x74, 0x68, 0x4f,
-	0x88, 0xbc, 0x52, 0xec, 0x2c, 0x8a, 0xb8, 0x2a, 0xc1, 0x9e, 0x36, 0x10, 0x2d, 0x3e, 0x68, 0x8b,
-	0xd3, 0xc0, 0x64, 0xc1, 0xc1, 0xa1, 0x41, 0x43, 0x85, 0xe6, 0xfa, 0xb5, 0x69, 0xb2, 0x10, 0x09,
-	0x10, 0x93, 0x07, 0xf2, 0x85, 0x2e, 0x43, 0x83, 0x87, 0x36, 0x5b, 0x9b, 0xbd, 0x06, 0x65, 0x5f,
-	0x02, 0x40, 0x06, 0xb4, 0xb9, 0xf5, 0xe4, 0x14, 0xb2, 0x00, 0xe2, 0x0d, 0x19, 0x02, 0xf9, 0x66,
-	0xa7, 0x29, 0x0f, 0x78, 0xa0, 0x13, 0xa4, 0x40, 0x24, 0xf3, 0xf7, 0x0e, 0x0e, 0x6c, 0xcb, 0xc5,
-	0xdb, 0x6c, 0x87, 0x9b, 0x94, 0x08, 0x11, 0x48, 0xc2, 0xa1, 0x23, 0xec, 0x07, 0x96, 0xe7, 0xf6,
-	0x5a, 0xb4, 0x3d, 0xfa, 0x94, 0x45, 0x39, 0xed, 0x73, 0x44, 0x39, 0x03, 0x58, 0xcc, 0x51, 0x2a,
-	0x89, 0x72, 0xbe, 0x95, 0x8e, 0x72, 0x66, 0x6f, 0x55, 0x2a, 0x0a, 0xfa, 0xa9, 0x02, 0x2b, 0x0f,
-	0xdd, 0x60, 0xb2, 0x1f, 0xb3, 0xe8, 0x8b, 0x51, 0x87, 0xac, 0x11, 0xad, 0xe4, 0x8c, 0xa8, 0xf6,
-	0x3f, 0x55, 0xe8, 0xf2, 0x55, 0x10, 0xa9, 0xa1, 0x26, 0xe7, 0x32, 0x34, 0x62, 0x3f, 0xca, 0x19,
-	0x92, 0x00, 0xb2, 0x36, 0xac, 0x94, 0xb3, 0x61, 0x85, 0x48, 0x8b, 0xa2, 0xa2, 0x4a, 0x2a, 0x2a,
-	0xba, 0x02, 0x70, 0x60, 0x4f, 0x82, 0xc3, 0x41, 0x68, 0x39, 0x98, 0x47, 0x65, 0x0d, 0x0a, 0xd9,
-	0xb3, 0x1c, 0x8c, 0xee, 0x42, 0x6b, 0xdf, 0x72, 0x6d, 0x6f, 0x34, 0x18, 0x1b, 0xe1, 0x61, 0xc0,
-	0xd3, 0x62, 0xd9, 0xb6, 0xd0, 0x18, 0xf6, 0x1e, 0xed, 0xab, 0x37, 0xd9, 0x98, 0x1d, 0x32, 0x04,
-	0x5d, 0x85, 0xa6, 0x3b, 0x71, 0x06, 0xde, 0xc1, 0xc0, 0xf7, 0x8e, 0x03, 0x9a, 0xfc, 0x96, 0xf5,
-	0x86, 0x3b, 0x71, 0x3e, 0x38, 0xd0, 0xbd, 0x63, 0xe2, 0xc7, 0x1a, 0xc4, 0xa3, 0x05, 0xb6, 0x37,
-	0x62, 0x89, 0xef, 0xec, 0xf9, 0x93, 0x01, 0x64, 0xb4, 0x89, 0xed, 0xd0, 0xa0, 0xa3, 0x1b, 0xc5,
-	0x46, 0xc7, 0x03, 0xd0, 0x0b, 0xd0, 0x19, 0x7a, 0xce, 0xd8, 0xa0, 0x1c, 0xba, 0xef, 0x7b, 0x0e,
-	0x55, 0xc0, 0xb2, 0x9e, 0x81, 0xa2, 0x0d, 0x68, 0x26, 0x4a, 0x10, 0xf4, 0x9a, 0x14, 0x8f, 0x26,
-	0xd3, 0xd2, 0x54, 0x28, 0x4f, 0x04, 0x14, 0x62, 0x2d, 0x08, 0x88, 0x64, 0x44, 0xca, 0x1e, 0x58,
-	0x9f, 0x61, 0xae, 0x68, 0x4d, 0x0e, 0xdb, 0xb5, 0x3e, 0xc3, 0x24, 0x3d, 0xb2, 0xdc, 0x00, 0xfb,
-	0x61, 0x94, 0xac, 0xf6, 0xda, 0x54, 0x7c, 0xda, 0x0c, 0xca, 0x05, 0x1b, 0x6d, 0x42, 0x27, 0x08,
-	0x0d, 0x3f, 0x1c, 0x8c, 0xbd, 0x80, 0x0a, 0x40, 0xaf, 0x43, 0x65, 0x3b, 0xa3, 0x92, 0x4e, 0x30,
-	0x22, 0x82, 0xbd, 0xc3, 0x3b, 0xe9, 0x6d, 0x3a, 0x28, 0xfa, 0x24, 0xb3, 0x50, 0x4e, 0x24, 0xb3,
-	0x74, 0x0b, 0xcd, 0x42, 0x07, 0xc5, 0xb3, 0xac, 0x92, 0x74, 0xc9, 0x30, 0x8d, 0x7d, 0x1b, 0x7f,
-	0xc8, 0x2d, 0x88, 0x4a, 0x17, 0x96, 0x05, 0x6b, 0xff, 0x5d, 0x82, 0x8e, 0xc8, 0x1e, 0x62, 0x76,
-	0x58, 0x56, 0x16, 0xc9, 0x7c, 0xf4, 0x49, 0x98, 0x85, 0x5d, 0x32, 0x9a, 0xa5, 0x80, 0x54, 0xe4,
-	0xeb, 0x7a, 0x93, 0xc1, 0xe8, 0x04, 0x44, 0x74, 0xd9, 0xa6, 0x50, 0x3d, 0x2b, 0x53, 0x46, 0x35,
-	0x28, 0x84, 0x86, 0x2a, 0x3d, 0x58, 0x88, 0xb2, 0x47, 0x26, 0xf0, 0xd1, 0x27, 0x69, 0xd9, 0x9f,
-	0x58, 0x14, 0x2b, 0x13, 0xf8, 0xe8, 0x13, 0x6d, 0x42, 0x8b, 0x4d, 0x39, 0x36, 0x7c, 0xc3, 0x89,
-	0xc4, 0xfd, 0x59, 0xa9, 0xc9, 0x78, 0x17, 0x9f, 0x7c, 0x48, 0xac, 0xcf, 0x8e, 0x61, 0xf9, 0x3a,
-	0x13, 0x8f, 0x1d, 0x3a, 0x0a, 0xad, 0x82, 0xca, 0x66, 0x39, 0xb0, 0x6c, 0xcc, 0x15, 0x67, 0x81,
-	0xa5, 0x90, 0x14, 0x7e, 0xdf, 0xb2, 0x31, 0xd3, 0x8d, 0x78, 0x09, 0x54, 0x20, 0xea, 0x4c, 0x35,
-	0x28, 0x84, 0x8a, 0xc3, 0x75, 0x60, 0x56, 0x74, 0x10, 0xd9, 0x66, 0xe6, 0x40, 0x18, 0x8d, 0x9c,
-	0xad, 0x34, 0x24, 0x9b, 0x38, 0x4c, 0xb9, 0x80, 0x2d, 0xc7, 0x9d, 0x38, 0x44, 0xb5, 0xb4, 0xdf,
-	0xab, 0xc2, 0x12, 0xb1, 0x30, 0xdc, 0xd8, 0xcc, 0x11, 0x20, 0x5c, 0x01, 0x30, 0x83, 0x70, 0x20,
-	0x58, 0xc5, 0x86, 0x19, 0x84, 0xdc, 0x7d, 0x7c, 0x27, 0xf2, 0xef, 0xe5, 0xe9, 0xe9, 0x4a, 0xc6,
-	0xe2, 0xe5, 0x7d, 0xfc, 0xb9, 0xea, 0x7b, 0xd7, 0xa1, 0xcd, 0x73, 0x75, 0x21, 0xb1, 0x6c, 0x31,
-	0xe0, 0xb6, 0xdc, 0x6e, 0xd7, 0xa4, 0x75, 0xc6, 0x94, 0x9f, 0x5f, 0x98, 0xcf, 0xcf, 0xd7, 0xb3,
-	0x7e, 0xfe, 0x3e, 0x74, 0x45, 0x55, 0x8b, 0x6c, 0xd5, 0x0c, 0x5d, 0xeb, 0x08, 0xba, 0x16, 0xa4,
-	0xdd, 0x34, 0x88, 0x6e, 0xfa, 0x3a, 0xb4, 0x5d, 0x8c, 0xcd, 0x41, 0xe8, 0x1b, 0x6e, 0x70, 0x80,
-	0x7d, 0xea, 0xe6, 0xeb, 0x7a, 0x8b, 0x00, 0xf7, 0x38, 0x0c, 0xbd, 0x01, 0x40, 0xd7, 0xc8, 0xca,
-	0x53, 0xad, 0xe9, 0xe5, 0x29, 0x2a, 0x34, 0xb4, 0x3c, 0x45, 0x99, 0x42, 0x7f, 0x3e, 0xa1, 0x48,
-	0x40, 0xfb, 0xe7, 0x12, 0x5c, 0xe4, 0xe5, 0x8a, 0xf9, 0xe5, 0x72, 0x9a, 0xa7, 0x8e, 0x5c, 0x5d,
-	0xf9, 0x94, 0x02, 0x40, 0xa5, 0x40, 0x30, 0x5b, 0x95, 0x04, 0xb3, 0x62, 0x12, 0x5c, 0xcb, 0x25,
-	0xc1, 0x71, 0xfd, 0x6f, 0xa1, 0x78, 0xfd, 0x0f, 0x2d, 0x43, 0x95, 0x66, 0x66, 0x54, 0x76, 0x1a,
-	0x3a, 0xfb, 0x28, 0xb4, 0xab, 0xda, 0x1f, 0x94, 0xa0, 0xbd, 0x8b, 0x0d, 0x7f, 0x78, 0x18, 0xf1,
-	0xf1, 0xb5, 0x74, 0xbd, 0xf4, 0xb9, 0x29, 0xf5, 0x52, 0x61, 0xc8, 0x57, 0xa6, 0x50, 0x4a, 0x10,
-	0x84, 0x5e, 0x68, 0xc4, 0x54, 0x0e, 0xdc, 0x89, 0xc3, 0x8b, 0x88, 0x5d, 0xda, 0xc0, 0x49, 0xdd,
-	0x9e, 0x38, 0xda, 0x7f, 0x29, 0xd0, 0xfa, 0x65, 0x32, 0x4d, 0xc4, 0x98, 0x3b, 0x69, 0xc6, 0xbc,
-	0x30, 0x85, 0x31, 0x3a, 0x49, 0xb2, 0xf0, 0x11, 0xfe, 0xca, 0xd5, 0x90, 0xff, 0x51, 0x81, 0x3e,
-	0x49, 0xb1, 0x75, 0x66, 0x77, 0xe6, 0xd7, 0xae, 0xeb, 0xd0, 0x3e, 0x12, 0x82, 0xd9, 0x12, 0x15,
-	0xce, 0xd6, 0x51, 0xba, 0x24, 0xa0, 0x83, 0x1a, 0x95, 0x74, 0xf9, 0x62, 0x23, 0x37, 0xf0, 0xa2,
-	0x8c, 0xea, 0x0c, 0x71, 0xd4, 0x42, 0x74, 0x7d, 0x11, 0xa8, 0xfd, 0x8e, 0x02, 0x4b, 0x92, 0x8e,
-	0xe8, 0x12, 0x2c, 0xf0, 0xf2, 0x03, 0x8f, 0x17, 0x98, 0xbe, 0x9b, 0x64, 0x7b, 0x92, 0x02, 0x9a,
-	0x65, 0xe6, 0x23, 0x64, 0x93, 0x64, 0xd4, 0x71, 0xae, 0x65, 0xe6, 0xf6, 0xc7, 0x0c, 0x50, 0x1f,
-	0xea, 0xdc, 0x9a, 0x46, 0x49, 0x6c, 0xfc, 0xad, 0x3d, 0x02, 0xf4, 0x00, 0x27, 0xbe, 0x6b, 0x1e,
-	0x8e, 0x26, 0xf6, 0x26, 0x21, 0x34, 0x6d, 0x84, 0x4c, 0xed, 0x3f, 0x14, 0x58, 0x12, 0xb0, 0xcd,
-	0x53, 0x26, 0x4a, 0xfc, 0x6b, 0xe9, 0x3c, 0xfe, 0x55, 0x28, 0x85, 0x94, 0xcf, 0x54, 0x0a, 0xb9,
-	0x0a, 0x10, 0xf3, 0x3f, 0xe2, 0x68, 0x0a, 0xa2, 0xfd, 0xbd, 0x02, 0x17, 0xdf, 0x31, 0x5c, 0xd3,
-	0x3b, 0x38, 0x98, 0x5f, 0x54, 0x37, 0x40, 0x48, 0x7b, 0x8b, 0x16, 0x03, 0xc5, 0x5c, 0xf9, 0x25,
-	0x58, 0xf4, 0x99, 0x67, 0x32, 0x45, 0x59, 0x2e, 0xeb, 0x6a, 0xd4, 0x10, 0xcb, 0xe8, 0x5f, 0x96,
-	0x00, 0x91, 0x55, 0xdf, 0x33, 0x6c, 0xc3, 0x1d, 0xe2, 0xf3, 0x93, 0xfe, 0x3c, 0x74, 0x84, 0x10,
-	0x26, 0x3e, 0x9c, 0x4f, 0xc7, 0x30, 0x01, 0x7a, 0x17, 0x3a, 0xfb, 0x0c, 0xd5, 0xc0, 0xc7, 0x46,
-	0xe0, 0xb9, 0x7c, 0x3b, 0xa4, 0x75, 0xbf, 0x3d, 0xdf, 0x1a, 0x8d, 0xb0, 0xbf, 0xe1, 0xb9, 0x26,
-	0x8f, 0xda, 0xf7, 0x23, 0x32, 0xc9, 0x50, 0xa2, 0x0c, 0x49, 0x3c, 0x17, 0x6f, 0x4e, 0x1c, 0xd0,
-	0x51, 0x56, 0x04, 0xd8, 0xb0, 0x13, 0x46, 0x24, 0xde, 0x50, 0x65, 0x0d, 0xbb, 0xd3, 0xcb, 0xbe,
-	0x92, 0xf8, 0x4a, 0xfb, 0x1b, 0x05, 0x50, 0x9c, 0x9a, 0xd3, 0x5a, 0x06, 0xd5, 0xe8, 0xec, 0x50,
-	0x45, 0xe2, 0x94, 0x2f, 0x43, 0xc3, 0x8c, 0x46, 0x72, 0x13, 0x94, 0x00, 0xa8, 0x8f, 0xa4, 0x44,
-	0x0f, 0x88, 0xe4, 0x61, 0x33, 0x4a, 0x7d, 0x19, 0xf0, 0x3d, 0x0a, 0x13, 0xc3, 0xb3, 0x4a, 0x36,
-	0x3c, 0x4b, 0x57, 0x35, 0xab, 0x42, 0x55, 0x53, 0xfb, 0x69, 0x09, 0x54, 0xea, 0x42, 0x36, 0x92,
-	0xf2, 0x54, 0x21, 0xa2, 0xaf, 0x43, 0x9b, 0x5f, 0x6e, 0x11, 0x08, 0x6f, 0x3d, 0x4e, 0x4d, 0x86,
-	0x6e, 0xc1, 0x32, 0xeb, 0xe4, 0xe3, 0x60, 0x62, 0x27, 0x59, 0x1f, 0x4b, 0x66, 0xd0, 0x63, 0xe6,
-	0xbb, 0x48, 0x53, 0x34, 0xe2, 0x21, 0x5c, 0x1c, 0xd9, 0xde, 0xbe, 0x61, 0x0f, 0xc4, 0xed, 0x61,
-	0x7b, 0x58, 0x40, 0xe2, 0x97, 0xd9, 0xf0, 0xdd, 0xf4, 0x1e, 0x06, 0xe8, 0x1e, 0xb4, 0x03, 0x8c,
-	0x1f, 0x25, 0xa9, 0x60, 0xb5, 0x48, 0x2a, 0xd8, 0x22, 0x63, 0xa2, 0x2f, 0xed, 0x8f, 0x15, 0xe8,
-	0x66, 0xce, 0x24, 0xb2, 0x85, 0x0b, 0x25, 0x5f, 0xb8, 0xb8, 0x03, 0x55, 0x62, 0xa9, 0x98, 0x6f,
-	0xe9, 0xc8, 0x93, 0x6a, 0x71, 0x56, 0x9d, 0x0d, 0x40, 0x37, 0x61, 0x49, 0x72, 0xf7, 0x81, 0x6f,
-	0x3f, 0xca, 0x5f, 0x7d, 0xd0, 0x7e, 0x56, 0x81, 0x66, 0x8a, 0x15, 0x33, 0x6a, 0x2e, 0x4f, 0xa4,
-	0xb6, 0x3c, 0xed, 0xac, 0x9b, 0x88, 0x9c, 0x83, 0x1d, 0x96, 0xf7, 0xf1, 0x24, 0xd4, 0xc1, 0x0e,
-	0xcd, 0xfa, 0xd2, 0x09, 0x5d, 0x4d, 0x48, 0xe8, 0x32, 0x29, 0xef, 0xc2, 0x29, 0x29, 0x6f, 0x5d,
-	0x4c, 0x79, 0x05, 0x15, 0x6a, 0x64, 0x55, 0xa8, 0x68, 0x19, 0xe4, 0x16, 0x2c, 0x0d, 0x59, 0xed,
-	0xfe, 0xde, 0xc9, 0x46, 0xdc, 0xc4, 0x83, 0x52, 0x59, 0x13, 0xba, 0x9f, 0x14, 0x38, 0xd9, 0x2e,
-	0xb3, 0xa4, 0x43, 0x9e, 0x51, 0xf3, 0xbd, 0x61, 0x9b, 0x1c, 0x59, 0x66, 0xfa, 0x95, 0x2d, 0xc0,
-	0xb4, 0xcf, 0x55, 0x80, 0x79, 0x06, 0x9a, 0x51, 0xa4, 0x42, 0x34, 0xbd, 0xc3, 0x8c, 0x5e, 0x64,
-	0x06, 0xcc, 0x40, 0xb0, 0x03, 0x5d, 0xf1, 0x74, 0x23, 0x5b, 0x8f, 0x50, 0xf3, 0xf5, 0x88, 0x4b,
-	0xb0, 0x60, 0x05, 0x83, 0x03, 0xe3, 0x11, 0xee, 0x2d, 0xd2, 0xd6, 0x9a, 0x15, 0xdc, 0x37, 0x1e,
-	0x61, 0xed, 0x5f, 0xcb, 0xd0, 0x49, 0x1c, 0x6c, 0x61, 0x0b, 0x52, 0xe4, 0xfe, 0xcf, 0x36, 0xa8,
-	0x49, 0xdc, 0x43, 0x39, 0x7c, 0x6a, 0x0e, 0x9e, 0x3d, 0x32, 0xec, 0x8e, 0x33, 0xfa, 0x2a, 0xb8,
-	0xfb, 0xca, 0x99, 0xdc, 0xfd, 0x9c, 0x37, 0x03, 0x6e, 0xc3, 0x4a, 0xec, 0x7b, 0x85, 0x65, 0xb3,
-	0x04, 0x6b, 0x39, 0x6a, 0xdc, 0x49, 0x2f, 0x7f, 0x8a, 0x09, 0x58, 0x98, 0x66, 0x02, 0xb2, 0x22,
-	0x50, 0xcf, 0x89, 0x40, 0xfe, 0x82, 0x42, 0x43, 0x72, 0x41, 0x41, 0x7b, 0x08, 0x4b, 0xb4, 0xd8,
-	0x1c, 0x0c, 0x7d, 0x6b, 0x1f, 0xc7, 0x29, 0x40, 0x91, 0x6d, 0xed, 0x43, 0x3d, 0x93, 0x45, 0xc4,
-	0xdf, 0xda, 0x8f, 0x15, 0xb8, 0x98, 0x9f, 0x97, 0x4a, 0x4c, 0x62, 0x48, 0x14, 0xc1, 0x90, 0xfc,
-	0x0a, 0x2c, 0xa5, 0x22, 0x4a, 0x61, 0xe6, 0x29, 0x11, 0xb8, 0x84, 0x70, 0x1d, 0x25, 0x73, 0x44,
-	0x30, 0xed, 0x67, 0x4a, 0x5c, 0xb3, 0x27, 0xb0, 0x11, 0x3d, 0x10, 0x21, 0x7e, 0xcd, 0x73, 0x6d,
-	0xcb, 0x8d, 0x0b, 0x2e, 0x7c, 0x8d, 0x0c, 0xc8, 0x0b, 0x2e, 0xef, 0x40, 0x97, 0x77, 0x8a, 0xdd,
-	0x53, 0xc1, 0x80, 0xac, 0xc3, 0xc6, 0xc5, 0x8e, 0xe9, 0x79, 0xe8, 0xf0, 0x93, 0x8a, 0x08, 0x5f,
-	0x59, 0x76, 0x7e, 0xf1, 0x3d, 0x50, 0xa3, 0x6e, 0x67, 0x75, 0x88, 0x5d, 0x3e, 0x30, 0x0e, 0xec,
-	0x7e, 0x53, 0x81, 0x9e, 0xe8, 0x1e, 0x53, 0xcb, 0x3f, 0x7b, 0x78, 0xf7, 0xba, 0x78, 0x3e, 0xfd,
-	0xfc, 0x29, 0xf4, 0x24, 0x78, 0xa2, 0x53, 0xea, 0xdf, 0x2d, 0xd1, 0xcb, 0x06, 0x24, 0xd5, 0xdb,
-	0xb4, 0x82, 0xd0, 0xb7, 0xf6, 0x27, 0xf3, 0x9d, 0x98, 0x1a, 0xd0, 0x1c, 0x1e, 0xe2, 0xe1, 0xa3,
-	0xb1, 0x67, 0x25, 0xbb, 0xf2, 0x96, 0x8c, 0xa6, 0xe9, 0x68, 0xd7, 0x36, 0x92, 0x19, 0xd8, 0x91,
-	0x53, 0x7a, 0xce, 0xfe, 0x0f, 0x41, 0xcd, 0x76, 0x48, 0x9f, 0xf4, 0x34, 0xd8, 0x49, 0xcf, 0x6d,
-	0xf1, 0xa4, 0x67, 0x46, 0xa4, 0x91, 0x3a, 0xe8, 0xf9, 0xdb, 0x12, 0x7c, 0x5d, 0x4a, 0xdb, 0x3c,
-	0x59, 0xd2, 0xb4, 0x3a, 0xd2, 0x3d, 0xa8, 0x67, 0x92, 0xda, 0x17, 0x4e, 0xd9, 0x3f, 0x5e, 0x92,
-	0x65, 0xa5, 0xc1, 0x20, 0x89, 0xad, 0x12, 0x85, 0xaf, 0x4c, 0x9f, 0x83, 0xeb, 0x9d, 0x30, 0x47,
-	0x34, 0x0e, 0xdd, 0x85, 0x16, 0x2b, 0x18, 0x0c, 0x8e, 0x2c, 0x7c, 0x1c, 0x9d, 0xa3, 0x5e, 0x95,
-	0x9a, 0x66, 0xda, 0xef, 0x43, 0x0b, 0x1f, 0xeb, 0x4d, 0x3b, 0xfe, 0x1d, 0x68, 0xbf, 0x5f, 0x01,
-	0x48, 0xda, 0x48, 0x76, 0x96, 0xe8, 0x3c, 0x57, 0xe2, 0x14, 0x84, 0xc4, 0x12, 0x62, 0xe4, 0x1a,
-	0x7d, 0x22, 0x3d, 0x39, 0xc7, 0x30, 0xad, 0x20, 0xe4, 0x7c, 0xb9, 0x79, 0x3a, 0x2d, 0x11, 0x8b,
-	0xc8, 0x96, 0x71, 0x99, 0x09, 0x12, 0x08, 0x7a, 0x05, 0xd0, 0xc8, 0xf7, 0x8e, 0x2d, 0x77, 0x94,
-	0xce, 0x37, 0x58, 0x5a, 0xb2, 0xc8, 0x5b, 0x52, 0x09, 0xc7, 0x8f, 0x40, 0xcd, 0x74, 0x8f, 0x58,
-	0x72, 0x7b, 0x06, 0x19, 0x0f, 0x84, 0xb9, 0xb8, 0xf8, 0x76, 0x45, 0x0c, 0xf4, 0xd0, 0x74, 0xcf,
-	0xf0, 0x47, 0x38, 0xda, 0x51, 0x1e, 0x87, 0x89, 0xc0, 0xfe, 0x00, 0xd4, 0xec, 0xaa, 0x24, 0x47,
-	0x9a, 0xaf, 0x8a, 0x82, 0x7e, 0x9a, 0x3d, 0x22, 0xd3, 0xa4, 0x44, 0xbd, 0x6f, 0xc0, 0xb2, 0x8c,
-	0x5e, 0x09, 0x92, 0x73, 0x6b, 0xd3, 0x5b, 0x71, 0x48, 0x4c, 0xf7, 0x61, 0x9a, 0x97, 0x49, 0x15,
-	0x9e, 0x4b, 0x42, 0xe1, 0x59, 0xfb, 0xf5, 0x32, 0xa0, 0xbc, 0xf8, 0xa3, 0x0e, 0x94, 0xe2, 0x49,
-	0x4a, 0x5b, 0x9b, 0x19, 0x71, 0x2b, 0xe5, 0xc4, 0xed, 0x32, 0x34, 0x62, 0xaf, 0xcf, 0x4d, 0x7c,
-	0x02, 0x48, 0x0b, 0x63, 0x45, 0x14, 0xc6, 0x14, 0x61, 0x55, 0xb1, 0x22, 0x7e, 0x0b, 0x96, 0x6d,
-	0x23, 0x08, 0x07, 0xac, 0xf0, 0x1e, 0x5a, 0x0e, 0x0e, 0x42, 0xc3, 0x19, 0xd3, 0xad, 0xac, 0xe8,
-	0x88, 0xb4, 0x6d, 0x92, 0xa6, 0xbd, 0xa8, 0x05, 0xed, 0x45, 0xd1, 0x35, 0xb1, 0xbd, 0xfc, 0xb2,
-	0xc0, 0xab, 0xc5, 0xd4, 0x3d, 0x29, 0x77, 0x33, 0x89, 0x6a, 0xc4, 0x61, 0x67, 0xff, 0x13, 0xe8,
-	0x88, 0x8d, 0x92, 0xed, 0xbb, 0x23, 0x6e, 0x5f, 0x91, 0xc0, 0x36, 0xb5, 0x87, 0x87, 0x80, 0xf2,
-	0xc6, 0x23, 0xcd, 0x33, 0x45, 0xe4, 0xd9, 0xac, 0xbd, 0x48, 0xf1, 0xb4, 0x2c, 0x6e, 0xf6, 0x9f,
-	0x96, 0x01, 0x25, 0x11, 0x5c, 0x7c, 0x78, 0x5d, 0x24, 0xec, 0xb9, 0x09, 0x4b, 0xf9, 0xf8, 0x2e,
-	0x0a, 0x6a, 0x51, 0x2e, 0xba, 0x93, 0x45, 0x62, 0x65, 0xd9, 0x55, 0xd1, 0xd7, 0x62, 0x73, 0xcf,
-	0xc2, 0xd5, 0xab, 0x53, 0xcf, 0x33, 0x44, 0x8b, 0xff, 0xc3, 0xec, 0x15, 0x53, 0x66, 0x3f, 0xee,
-	0x48, 0x4d, 0x73, 0x6e, 0xc9, 0x33, 0xef, 0x97, 0x0a, 0x81, 0x74, 0xed, 0x2c, 0x81, 0xf4, 0xfc,
-	0x17, 0x42, 0xff, 0xbd, 0x04, 0x8b, 0x31, 0x23, 0xcf, 0xb4, 0x49, 0xb3, 0xef, 0x19, 0x3c, 0xe5,
-	0x5d, 0xf9, 0x58, 0xbe, 0x2b, 0xdf, 0x3e, 0x35, 0x99, 0x29, 0xba, 0x29, 0xf3, 0x73, 0xf6, 0x33,
-	0x58, 0xe0, 0x65, 0xe9, 0x9c, 0x81, 0x2b, 0x52, 0x2e, 0x58, 0x86, 0x2a, 0xb1, 0xa7, 0x51, 0x4d,
-	0x91, 0x7d, 0x30, 0x96, 0xa6, 0x2f, 0x1c, 0x73, 0x1b, 0xd7, 0x16, 0xee, 0x1b, 0x6b, 0xbf, 0x55,
-	0x06, 0xd8, 0x3d, 0x71, 0x87, 0x77, 0x99, 0x92, 0xde, 0x82, 0xca, 0xac, 0xeb, 0x69, 0xa4, 0x37,
-	0x95, 0x2d, 0xda, 0xb3, 0xc0, 0xe6, 0x0a, 0x05, 0x91, 0x72, 0xb6, 0x20, 0x32, 0xad, 0x94, 0x31,
-	0xdd, 0x04, 0x7f, 0x1b, 0x2a, 0xd4, 0x94, 0xb2, 0xdb, 0x5b, 0x85, 0x4e, 0x85, 0xe9, 0x00, 0xb4,
-	0x0a, 0x91, 0x4b, 0xde, 0x72, 0x99, 0xcf, 0xa5, 0xe6, 0xb8, 0xac, 0x67, 0xc1, 0xe8, 0x05, 0xe8,
-	0xb0, 0x42, 0x58, 0xdc, 0x91, 0xe5, 0x74, 0x19, 0x68, 0xde, 0xa3, 0x37, 0x24, 0x1e, 0x9d, 0xe0,
-	0x35, 0x7d, 0x6f, 0x3c, 0x4e, 0x4d, 0xc7, 0x2a, 0x21, 0x59, 0xb0, 0xf6, 0x79, 0x09, 0x2e, 0x11,
-	0xfe, 0x3e, 0x99, 0xa8, 0xbc, 0x88, 0xf0, 0xa4, 0xec, 0x79, 0x59, 0xb4, 0xe7, 0x77, 0x60, 0x81,
-	0x95, 0x5b, 0xa2, 0xf8, 0xf2, 0xea, 0x34, 0x69, 0x60, 0xb2, 0xa3, 0x47, 0xdd, 0xe7, 0xcd, 0xd9,
-	0x85, 0x33, 0xf3, 0xda, 0x7c, 0x67, 0xe6, 0x0b, 0xd9, 0xa2, 0x6c, 0x4a, 0xac, 0xea, 0xa2, 0x17,
-	0x7a, 0x08, 0x6d, 0x3d, 0xad, 0x1a, 0x08, 0x41, 0x25, 0x75, 0x61, 0x95, 0xfe, 0xa6, 0x69, 0xb6,
-	0x31, 0x36, 0x86, 0x56, 0x78, 0x42, 0xd9, 0x59, 0xd5, 0xe3, 0x6f, 0xb9, 0x1e, 0x6a, 0xff, 0xab,
-	0xc0, 0xc5, 0xe8, 0x50, 0x95, 0x6b, 0xf9, 0xf9, 0x77, 0x74, 0x1d, 0x56, 0xb8, 0x4a, 0x67, 0x74,
-	0x9b, 0x05, 0xd3, 0x4b, 0x0c, 0x26, 0x2e, 0x63, 0x1d, 0x56, 0x42, 0x2a, 0x5d, 0xd9, 0x31, 0x6c,
-	0xbf, 0x97, 0x58, 0xa3, 0x38, 0xa6, 0xc8, 0xa1, 0xf6, 0x33, 0xec, 0x06, 0x16, 0x67, 0x2d, 0x57,
-	0x52, 0x70, 0x27, 0x0e, 0x5f, 0xa5, 0x76, 0x0c, 0x97, 0xd9, 0x95, 0xf1, 0x7d, 0x91, 0xa2, 0xb9,
-	0xce, 0x34, 0xa4, 0xeb, 0xce, 0xd8, 0xb4, 0x3f, 0x51, 0xe0, 0xca, 0x14, 0xcc, 0xf3, 0x64, 0x73,
-	0xef, 0x49, 0xb1, 0x4f, 0xc9, 0xbd, 0x05, 0xbc, 0xec, 0xc2, 0x82, 0x48, 0xe4, 0xe7, 0x15, 0x58,
-	0xcc, 0x75, 0x3a, 0xb3, 0xcc, 0xbd, 0x0c, 0x88, 0x6c, 0x42, 0xfc, 0x3c, 0x92, 0x96, 0x33, 0xb8,
-	0xf3, 0x54, 0xdd, 0x89, 0x13, 0x3f, 0x8d, 0xdc, 0xf6, 0x4c, 0x8c, 0x2c, 0xd6, 0x9b, 0x9d, 0x68,
-	0xc4, 0x3b, 0x57, 0x99, 0xfe, 0x0a, 0x26, 0x47, 0xe0, 0xda, 0xf6, 0xc4, 0x61, 0x87, 0x1f, 0x7c,
-	0x97, 0x99, 0x43, 0x24, 0xa8, 0x04, 0x30, 0x3a, 0x80, 0x45, 0x7a, 0x3f, 0x6f, 0x12, 0x8e, 0x3c,
-	0x92, 0x50, 0x51, 0xba, 0x98, 0xdb, 0xfd, 0x6e, 0x61, 0x4c, 0x1f, 0xf0, 0xd1, 0x84, 0x78, 0x9e,
-	0x53, 0xb9, 0x22, 0x34, 0xc2, 0x63, 0xb9, 0x43, 0xcf, 0x89, 0xf1, 0xd4, 0xce, 0x88, 0x67, 0x8b,
-	0x8f, 0x16, 0xf1, 0xa4, 0xa1, 0xfd, 0x0d, 0x58, 0x91, 0x2e, 0x7d, 0x96, 0xa3, 0xaf, 0xa6, 0x33,
-	0xaf, 0x7b, 0xb0, 0x2c, 0x5b, 0xd5, 0x39, 0xe6, 0xc8, 0x51, 0x7c, 0x96, 0x39, 0xb4, 0xbf, 0x28,
-	0x41, 0x7b, 0x13, 0xdb, 0x38, 0xc4, 0x4f, 0xf7, 0xcc, 0x39, 0x77, 0x80, 0x5e, 0xce, 0x1f, 0xa0,
-	0xe7, 0x6e, 0x03, 0x54, 0x24, 0xb7, 0x01, 0xae, 0xc4, 0x97, 0x20, 0xc8, 0x2c, 0x55, 0x31, 0x86,
-	0x30, 0xd1, 0xeb, 0xd0, 0x1a, 0xfb, 0x96, 0x63, 0xf8, 0x27, 0x83, 0x47, 0xf8, 0x24, 0xe0, 0x4e,
-	0xa3, 0x27, 0x75, 0x3b, 0x5b, 0x9b, 0x81, 0xde, 0xe4, 0xbd, 0xdf, 0xc5, 0x27, 0xf4, 0x82, 0x45,
-	0x9c, 0xc6, 0xb1, 0x1b, 0x75, 0x15, 0x3d, 0x05, 0xb9, 0xf1, 0x12, 0x34, 0xe2, 0x8b, 0x4b, 0xa8,
-	0x0e, 0x95, 0xfb, 0x13, 0xdb, 0x56, 0x2f, 0xa0, 0x06, 0x54, 0x69, 0xa2, 0xa7, 0x2a, 0xe4, 0x27,
-	0x8d, 0xfd, 0xd4, 0xd2, 0x8d, 0x5f, 0x82, 0x46, 0x7c, 0x81, 0x02, 0x35, 0x61, 0xe1, 0xa1, 0xfb,
-	0xae, 0xeb, 0x1d, 0xbb, 0xea, 0x05, 0xb4, 0x00, 0xe5, 0xbb, 0xb6, 0xad, 0x2a, 0xa8, 0x0d, 0x8d,
-	0xdd, 0xd0, 0xc7, 0x06, 0xd9, 0x3e, 0xb5, 0x84, 0x3a, 0x00, 0xef, 0x58, 0x41, 0xe8, 0xf9, 0xd6,
-	0xd0, 0xb0, 0xd5, 0xf2, 0x8d, 0xcf, 0xa0, 0x23, 0xd6, 0xd3, 0x51, 0x0b, 0xea, 0xdb, 0x5e, 0xf8,
-	0xf6, 0xa7, 0x56, 0x10, 0xaa, 0x17, 0x48, 0xff, 0x6d, 0x2f, 0xdc, 0xf1, 0x71, 0x80, 0xdd, 0x50,
-	0x55, 0x10, 0x40, 0xed, 0x03, 0x77, 0xd3, 0x0a, 0x1e, 0xa9, 0x25, 0xb4, 0xc4, 0x8f, 0xca, 0x0c,
-	0x7b, 0x8b, 0x17, 0xa9, 0xd5, 0x32, 0x19, 0x1e, 0x7f, 0x55, 0x90, 0x0a, 0xad, 0xb8, 0xcb, 0x83,
-	0x9d, 0x87, 0x6a, 0x95, 0x51, 0x4f, 0x7e, 0xd6, 0x6e, 0x98, 0xa0, 0x66, 0x8f, 0x78, 0xc9, 0x9c,
-	0x6c, 0x11, 0x31, 0x48, 0xbd, 0x40, 0x56, 0xc6, 0xcf, 0xd8, 0x55, 0x05, 0x75, 0xa1, 0x99, 0x3a,
-	0xb1, 0x56, 0x4b, 0x04, 0xf0, 0xc0, 0x1f, 0x0f, 0xb9, 0x6c, 0x31, 0x12, 0x88, 0xa0, 0x6e, 0x12,
-	0x4e, 0x54, 0x6e, 0xdc, 0x83, 0x7a, 0x94, 0x9f, 0x90, 0xae, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x80,
-	0x16, 0xa1, 0x2d, 0x3c, 0xbd, 0x53, 0x15, 0x84, 0xa0, 0x23, 0x3e, 0x8e, 0x55, 0x4b, 0x37, 0xd6,
-	0x01, 0x92, 0x38, 0x9f, 0x90, 0xb3, 0xe5, 0x1e, 0x19, 0xb6, 0x65, 0x32, 0xda, 0x48, 0x13, 0xe1,
-	0x2e, 0xe5, 0x0e, 0xd3, 0x59, 0xb5, 0x74, 0xe3, 0x4d, 0xa8, 0x47, 0xb1, 0x2b, 0x81, 0xeb, 0xd8,
-	0xf1, 0x8e, 0x30, 0xdb, 0x99, 0x5d, 0x1c, 0xb2, 0x7d, 0xbc, 0xeb, 0x60, 0xd7, 0x54, 0x4b, 0x84,
-	0x8c, 0x87, 0x63, 0xd3, 0x08, 0xa3, 0x6b, 0xa6, 0x6a, 0x79, 0xfd, 0x3f, 0x97, 0x00, 0xd8, 0x99,
-	0xad, 0xe7, 0xf9, 0x26, 0xb2, 0xe9, 0xdd, 0x8d, 0x0d, 0xcf, 0x19, 0x7b, 0x6e, 0x74, 0xa0, 0x14,
-	0xa0, 0xb5, 0x4c, 0x89, 0x84, 0x7d, 0xe4, 0x3b, 0x72, 0xde, 0xf4, 0x9f, 0x93, 0xf6, 0xcf, 0x74,
-	0xd6, 0x2e, 0x20, 0x87, 0x62, 0xdb, 0xb3, 0x1c, 0xbc, 0x67, 0x0d, 0x1f, 0xc5, 0x07, 0xbd, 0xd3,
-	0x1f, 0xad, 0x66, 0xba, 0x46, 0xf8, 0xae, 0x4b, 0xf1, 0xed, 0x86, 0xbe, 0xe5, 0x8e, 0x22, 0xef,
-	0xa8, 0x5d, 0x40, 0x8f, 0x33, 0x4f, 0x66, 0x23, 0x84, 0xeb, 0x45, 0x5e, 0xc9, 0x9e, 0x0f, 0xa5,
-	0x0d, 0xdd, 0xcc, 0x7f, 0x13, 0xa0, 0x1b, 0xf2, 0xb7, 0x47, 0xb2, 0xff, 0x51, 0xe8, 0xbf, 0x54,
-	0xa8, 0x6f, 0x8c, 0xcd, 0x82, 0x8e, 0xf8, 0xa8, 0x1e, 0x7d, 0x63, 0xda, 0x04, 0xb9, 0xd7, 0x8f,
-	0xfd, 0x1b, 0x45, 0xba, 0xc6, 0xa8, 0x3e, 0x62, 0xe2, 0x3b, 0x0b, 0x95, 0xf4, 0xc1, 0x69, 0xff,
-	0xb4, 0xc0, 0x44, 0xbb, 0x80, 0x3e, 0x21, 0x31, 0x44, 0xe6, 0x8d, 0x26, 0x7a, 0x59, 0xee, 0xf7,
-	0xe4, 0x4f, 0x39, 0x67, 0x61, 0xf8, 0x28, 0xab, 0x7c, 0xd3, 0xa9, 0xcf, 0x3d, 0xfe, 0x2e, 0x4e,
-	0x7d, 0x6a, 0xfa, 0xd3, 0xa8, 0x3f, 0x33, 0x06, 0x9b, 0xa5, 0x53, 0x92, 0xd7, 0x61, 0x59, 0x51,
-	0x4e, 0xb2, 0x99, 0xe9, 0x4f, 0xc9, 0x66, 0x61, 0x9b, 0x50, 0x25, 0xcd, 0x5e, 0x56, 0x78, 0x65,
-	0xca, 0x31, 0x88, 0xfc, 0x59, 0x6a, 0x7f, 0xad, 0x68, 0xf7, 0xb4, 0x2c, 0x8b, 0x2f, 0x1f, 0xe5,
-	0x5b, 0x24, 0x7d, 0xad, 0x29, 0x97, 0x65, 0xf9, 0x43, 0x4a, 0xed, 0x02, 0xda, 0x13, 0x4c, 0x3d,
-	0x7a, 0x61, 0x9a, 0x28, 0x88, 0xb7, 0x97, 0x66, 0xf1, 0xed, 0x57, 0x01, 0x31, 0x4d, 0x75, 0x0f,
-	0xac, 0xd1, 0xc4, 0x37, 0x98, 0x18, 0x4f, 0x33, 0x6e, 0xf9, 0xae, 0x11, 0x9a, 0x6f, 0x9e, 0x61,
-	0x44, 0xbc, 0xa4, 0x01, 0xc0, 0x03, 0x1c, 0xbe, 0x4f, 0x9f, 0xc0, 0x05, 0xd9, 0x15, 0x25, 0xf6,
-	0x9b, 0x77, 0x88, 0x50, 0xbd, 0x38, 0xb3, 0x5f, 0x8c, 0x60, 0x1f, 0x9a, 0x0f, 0x48, 0x7e, 0x45,
-	0x63, 0xc6, 0x00, 0x4d, 0x1d, 0x19, 0xf5, 0x88, 0x50, 0xac, 0xce, 0xee, 0x98, 0x36, 0x9e, 0x99,
-	0x57, 0xa0, 0x68, 0xea, 0xc6, 0xe6, 0xdf, 0xa6, 0xca, 0x8d, 0xe7, 0x94, 0x67, 0xa5, 0x6c, 0x45,
-	0xf4, 0x28, 0xee, 0x1d, 0x6c, 0xd8, 0xe1, 0xe1, 0x94, 0x15, 0xa5, 0x7a, 0x9c, 0xbe, 0x22, 0xa1,
-	0x63, 0x8c, 0x03, 0xc3, 0x12, 0xd3, 0x42, 0x31, 0x31, 0xbd, 0x29, 0x9f, 0x22, 0xdf, 0xb3, 0xa0,
-	0xe8, 0x19, 0xb0, 0xb8, 0xe9, 0x7b, 0x63, 0x11, 0xc9, 0x2b, 0x52, 0x24, 0xb9, 0x7e, 0x05, 0x51,
-	0x7c, 0x1f, 0x5a, 0x51, 0xfe, 0x4f, 0x33, 0x16, 0x39, 0x17, 0xd2, 0x5d, 0x0a, 0x4e, 0xfc, 0x31,
-	0x74, 0x33, 0x85, 0x05, 0xf9, 0xa6, 0xcb, 0xab, 0x0f, 0xb3, 0x66, 0x3f, 0x06, 0x44, 0x9f, 0xf6,
-	0x8a, 0xff, 0x4e, 0x20, 0x8f, 0x6f, 0xf2, 0x1d, 0x23, 0x24, 0x37, 0x0b, 0xf7, 0x8f, 0x77, 0xfe,
-	0xd7, 0x60, 0x45, 0x9a, 0xbc, 0x67, 0x0d, 0x02, 0xbf, 0xae, 0x7c, 0x4a, 0x85, 0x21, 0x6b, 0x10,
-	0x4e, 0x1d, 0x11, 0xe1, 0x5f, 0xff, 0x37, 0x04, 0x0d, 0x1a, 0xe7, 0xd1, 0xdd, 0xfa, 0x45, 0x98,
-	0xf7, 0x64, 0xc3, 0xbc, 0x8f, 0xa1, 0x9b, 0x79, 0x72, 0x2a, 0x17, 0x5a, 0xf9, 0xbb, 0xd4, 0x02,
-	0xd1, 0x8a, 0xf8, 0x5a, 0x53, 0xee, 0x0a, 0xa5, 0x2f, 0x3a, 0x67, 0xcd, 0xfd, 0x21, 0x7b, 0xce,
-	0x1d, 0x9f, 0xe6, 0xbe, 0x38, 0xf5, 0xf0, 0x41, 0xbc, 0x76, 0xfc, 0xc5, 0x47, 0x41, 0x5f, 0xed,
-	0x08, 0xf4, 0x63, 0xe8, 0x66, 0x1e, 0xf6, 0xc8, 0x25, 0x46, 0xfe, 0xfa, 0x67, 0xd6, 0xec, 0x3f,
-	0xc7, 0xe0, 0xc9, 0x84, 0x25, 0xc9, 0x3b, 0x0a, 0xb4, 0x36, 0x2d, 0x10, 0x95, 0x3f, 0xb8, 0x98,
-	0xbd, 0xa0, 0xb6, 0xa0, 0xa6, 0x59, 0x7f, 0x93, 0x10, 0x99, 0xfd, 0x5b, 0xa3, 0xfe, 0xcb, 0xc5,
-	0xfe, 0x03, 0x29, 0x5e, 0xd0, 0x2e, 0xd4, 0xd8, 0x73, 0x1f, 0xf4, 0xac, 0xfc, 0x10, 0x26, 0xf5,
-	0x14, 0xa8, 0x3f, 0xeb, 0xc1, 0x50, 0x30, 0xb1, 0x43, 0x42, 0xff, 0x0f, 0xa0, 0xc3, 0x40, 0x31,
-	0x83, 0x9e, 0xe0, 0xe4, 0xbb, 0x50, 0xa5, 0xa6, 0x1d, 0x49, 0x0f, 0x14, 0xd2, 0x8f, 0x7a, 0xfa,
-	0xb3, 0xdf, 0xf1, 0x24, 0x14, 0x37, 0xe9, 0x48, 0x56, 0xd5, 0x79, 0x92, 0x53, 0xdf, 0x52, 0xd0,
-	0x0f, 0xa0, 0xcd, 0x26, 0x8f, 0xb8, 0xf1, 0x24, 0x29, 0x1f, 0xc2, 0x52, 0x8a, 0xf2, 0xa7, 0x81,
-	0xe2, 0x96, 0xf2, 0xff, 0x3c, 0xba, 0xff, 0x94, 0x3e, 0xaa, 0xc9, 0x5e, 0x1b, 0x43, 0x6b, 0x67,
-	0xbb, 0xfb, 0xd6, 0xbf, 0x59, 0xb8, 0x7f, 0x8c, 0xf9, 0x47, 0xa0, 0x66, 0x8f, 0x0a, 0xd1, 0x4b,
-	0xd3, 0x6c, 0x89, 0x0c, 0xe7, 0x0c, 0x43, 0xf2, 0x3d, 0xa8, 0xb1, 0x1a, 0xb1, 0x5c, 0x01, 0x85,
-	0xfa, 0xf1, 0x8c, 0xb9, 0xee, 0x7d, 0xeb, 0xa3, 0xf5, 0x91, 0x15, 0x1e, 0x4e, 0xf6, 0x49, 0xcb,
-	0x4d, 0xd6, 0xf5, 0x15, 0xcb, 0xe3, 0xbf, 0x6e, 0x46, 0x7b, 0x79, 0x93, 0x8e, 0xbe, 0x49, 0x11,
-	0x8c, 0xf7, 0xf7, 0x6b, 0xf4, 0xf3, 0xf6, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xc4, 0x63,
-	0x89, 0xd0, 0x53, 0x00, 0x00,
+	// 4817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xec, 0x3c, 0x4b, 0x73, 0x24, 0x47,
+	0x5a, 0x53, 0xfd, 0x52, 0xf7, 0xd7, 0xaf, 0x52, 0xea, 0x31, 0xbd, 0xbd, 0x33, 0x63, 0xb9, 0xc6,
+	0x0f, 0x59, 0x63, 0x4b, 0x63, 0x8d, 0xed, 0x9d, 0x5d, 0xdb, 0x61, 0x66, 0x24, 0xcf, 0x58, 0x6b,
+	0x5b, 0x16, 0xa5, 0x19, 0x2f, 0xe1, 0xf5, 0x6e, 0xbb, 0xd4, 0x95, 0x6a, 0x55, 0x4c, 0x75, 0x55,
+	0x4f, 0x55, 0xb5, 0x64, 0x99, 0x08, 0x82, 0x03, 0x17, 0x16, 0x96, 0x20, 0xe0, 0x00, 0x07, 0x82,
+	0x03, 0x04, 0x11, 0x0b, 0x01, 0x17, 0x02, 0x22, 0x38, 0x70, 0xe0, 0xc6, 0x89, 0xd7, 0x89, 0x3f,
+	0x00, 0x37, 0x8e, 0x6c, 0x10, 0xbe, 0x11, 0xf9, 0xa8, 0x47, 0x56, 0x65, 0xab, 0x4b, 0xea, 0x99,
+	0xb5, 0x4d, 0x70, 0xeb, 0xfa, 0xf2, 0xf1, 0x7d, 0xf9, 0xbd, 0xf2, 0xfb, 0xbe, 0xcc, 0x6c, 0x98,
+	0x7f, 0x3c, 0xc6, 0xde, 0x69, 0xaf, 0xef, 0xba, 0x9e, 0xb9, 0x3e, 0xf2, 0xdc, 0xc0, 0x45, 0x68,
+	0x68, 0xd9, 0xc7, 0x63, 0x9f, 0x7d, 0xad, 0xd3, 0xf6, 0x6e, 0xa3, 0xef, 0x0e, 0x87, 0xae, 0xc3,
+	0x60, 0xdd, 0x46, 0xb2, 0x47, 0xb7, 0x65, 0x39, 0x01, 0xf6, 0x1c, 0xc3, 0x0e, 0x5b, 0xfd, 0xfe,
+	0x11, 0x1e, 0x1a, 0xfc, 0xab, 0x36, 0xf4, 0x07, 0xfc, 0xa7, 0x6a, 0x1a, 0x81, 0x91, 0x44, 0xd5,
+	0x9d, 0xb7, 0x1c, 0x13, 0x7f, 0x9e, 0x04, 0x69, 0xbf, 0xa1, 0xc0, 0xf2, 0xfe, 0x91, 0x7b, 0xb2,
+	0xe5, 0xda, 0x36, 0xee, 0x07, 0x96, 0xeb, 0xf8, 0x3a, 0x7e, 0x3c, 0xc6, 0x7e, 0x80, 0x6e, 0x42,
+	0xe9, 0xc0, 0xf0, 0x71, 0x47, 0x59, 0x51, 0x56, 0xeb, 0x9b, 0x57, 0xd6, 0x05, 0x3a, 0x39, 0x81,
+	0x1f, 0xfa, 0x83, 0xbb, 0x86, 0x8f, 0x75, 0xda, 0x13, 0x21, 0x28, 0x99, 0x07, 0x3b, 0xdb, 0x9d,
+	0xc2, 0x8a, 0xb2, 0x5a, 0xd4, 0xe9, 0x6f, 0xf4, 0x1c, 0x34, 0xfb, 0xd1, 0xdc, 0x3b, 0xdb, 0x7e,
+	0xa7, 0xb8, 0x52, 0x5c, 0x2d, 0xea, 0x22, 0x50, 0xfb, 0x49, 0x01, 0x2e, 0x67, 0xc8, 0xf0, 0x47,
+	0xae, 0xe3, 0x63, 0x74, 0x0b, 0x2a, 0x7e, 0x60, 0x04, 0x63, 0x9f, 0x53, 0xf2, 0x6d, 0x29, 0x25,
+	0xfb, 0xb4, 0x8b, 0xce, 0xbb, 0x66, 0xd1, 0x16, 0x24, 0x68, 0xd1, 0xab, 0xb0, 0x68, 0x39, 0x1f,
+	0xe2, 0xa1, 0xeb, 0x9d, 0xf6, 0x46, 0xd8, 0xeb, 0x63, 0x27, 0x30, 0x06, 0x38, 0xa4, 0x71, 0x21,
+	0x6c, 0xdb, 0x8b, 0x9b, 0xd0, 0x1b, 0x70, 0x99, 0xc9, 0xd0, 0xc7, 0xde, 0xb1, 0xd5, 0xc7, 0x3d,
+	0xe3, 0xd8, 0xb0, 0x6c, 0xe3, 0xc0, 0xc6, 0x9d, 0xd2, 0x4a, 0x71, 0xb5, 0xaa, 0x2f, 0xd1, 0xe6,
+	0x7d, 0xd6, 0x7a, 0x27, 0x6c, 0x44, 0x2f, 0x81, 0xea, 0xe1, 0x43, 0x0f, 0xfb, 0x47, 0xbd, 0x91,
+	0xe7, 0x0e, 0x3c, 0xec, 0xfb, 0x9d, 0x32, 0x45, 0xd3, 0xe6, 0xf0, 0x3d, 0x0e, 0xd6, 0xfe, 0x4c,
+	0x81, 0x25, 0xc2, 0x8c, 0x3d, 0xc3, 0x0b, 0xac, 0xa7, 0x20, 0x12, 0x0d, 0x1a, 0x49, 0x36, 0x74,
+	0x8a, 0xb4, 0x4d, 0x80, 0x91, 0x3e, 0xa3, 0x10, 0x3d, 0x61, 0x5f, 0x89, 0x92, 0x2a, 0xc0, 0xb4,
+	0x7f, 0xe1, 0xba, 0x93, 0xa4, 0x73, 0x16, 0x99, 0xa5, 0x71, 0x16, 0xb2, 0x38, 0x2f, 0x22, 0x31,
+	0x19, 0xe7, 0x4b, 0x72, 0xce, 0xff, 0x53, 0x11, 0x96, 0x3e, 0x70, 0x0d, 0x33, 0x56, 0xc3, 0x5f,
+	0x3c, 0xe7, 0xdf, 0x86, 0x0a, 0xb3, 0xe8, 0x4e, 0x89, 0xe2, 0x7a, 0x5e, 0xc4, 0xc5, 0xda, 0xd6,
+	0x63, 0x0a, 0xf7, 0x29, 0x40, 0xe7, 0x83, 0xd0, 0xf3, 0xd0, 0xf2, 0xf0, 0xc8, 0xb6, 0xfa, 0x46,
+	0xcf, 0x19, 0x0f, 0x0f, 0xb0, 0xd7, 0x29, 0xaf, 0x28, 0xab, 0x65, 0xbd, 0xc9, 0xa1, 0xbb, 0x14,
+	0x88, 0x3e, 0x83, 0xe6, 0xa1, 0x85, 0x6d, 0xb3, 0x47, 0x5d, 0xc2, 0xce, 0x76, 0xa7, 0xb2, 0x52,
+	0x5c, 0xad, 0x6f, 0xbe, 0xb9, 0x9e, 0xf5, 0x46, 0xeb, 0x52, 0x8e, 0xac, 0xdf, 0x23, 0xc3, 0x77,
+	0xd8, 0xe8, 0x77, 0x9d, 0xc0, 0x3b, 0xd5, 0x1b, 0x87, 0x09, 0x10, 0xea, 0xc0, 0x1c, 0x67, 0x6f,
+	0x67, 0x6e, 0x45, 0x59, 0xad, 0xea, 0xe1, 0x27, 0x7a, 0x11, 0xda, 0x1e, 0xf6, 0xdd, 0xb1, 0xd7,
+	0xc7, 0xbd, 0x81, 0xe7, 0x8e, 0x47, 0x7e, 0xa7, 0xba, 0x52, 0x5c, 0xad, 0xe9, 0xad, 0x10, 0x7c,
+	0x9f, 0x42, 0xbb, 0xef, 0xc0, 0x7c, 0x06, 0x0b, 0x52, 0xa1, 0xf8, 0x08, 0x9f, 0x52, 0x41, 0x14,
+	0x75, 0xf2, 0x13, 0x2d, 0x42, 0xf9, 0xd8, 0xb0, 0xc7, 0x98, 0xb3, 0x9a, 0x7d, 0x7c, 0xaf, 0x70,
+	0x5b, 0xd1, 0xfe, 0x48, 0x81, 0x8e, 0x8e, 0x6d, 0x6c, 0xf8, 0xf8, 0xab, 0x14, 0xe9, 0x32, 0x54,
+	0x1c, 0xd7, 0xc4, 0x3b, 0xdb, 0x54, 0xa4, 0x45, 0x9d, 0x7f, 0x69, 0x5f, 0x2a, 0xb0, 0x78, 0x1f,
+	0x07, 0xc4, 0x0c, 0x2c, 0x3f, 0xb0, 0xfa, 0x91, 0x9d, 0xbf, 0x0d, 0x45, 0x0f, 0x3f, 0xe6, 0x94,
+	0xdd, 0x10, 0x29, 0x8b, 0xdc, 0xbf, 0x6c, 0xa4, 0x4e, 0xc6, 0xa1, 0x67, 0xa1, 0x61, 0x0e, 0xed,
+	0x5e, 0xff, 0xc8, 0x70, 0x1c, 0x6c, 0x33, 0x43, 0xaa, 0xe9, 0x75, 0x73, 0x68, 0x6f, 0x71, 0x10,
+	0xba, 0x06, 0xe0, 0xe3, 0xc1, 0x10, 0x3b, 0x41, 0xec, 0x93, 0x13, 0x10, 0xb4, 0x06, 0xf3, 0x87,
+	0x9e, 0x3b, 0xec, 0xf9, 0x47, 0x86, 0x67, 0xf6, 0x6c, 0x6c, 0x98, 0xd8, 0xa3, 0xd4, 0x57, 0xf5,
+	0x36, 0x69, 0xd8, 0x27, 0xf0, 0x0f, 0x28, 0x18, 0xdd, 0x82, 0xb2, 0xdf, 0x77, 0x47, 0x98, 0x6a,
+	0x5a, 0x6b, 0xf3, 0xaa, 0x4c, 0x87, 0xb6, 0x8d, 0xc0, 0xd8, 0x27, 0x9d, 0x74, 0xd6, 0x57, 0xfb,
+	0xbb, 0x12, 0x33, 0xb5, 0xaf, 0xb9, 0x93, 0x4b, 0x98, 0x63, 0xf9, 0xc9, 0x98, 0x63, 0x25, 0x97,
+	0x39, 0xce, 0x9d, 0x6d, 0x8e, 0x19, 0xae, 0x9d, 0xc7, 0x1c, 0xab, 0x53, 0xcd, 0xb1, 0x26, 0x33,
+	0x47, 0xf4, 0x2e, 0xb4, 0x59, 0x00, 0x61, 0x39, 0x87, 0x6e, 0xcf, 0xb6, 0xfc, 0xa0, 0x03, 0x94,
+	0xcc, 0xab, 0x69, 0x0d, 0x35, 0xf1, 0xe7, 0xeb, 0x0c, 0xb1, 0x73, 0xe8, 0xea, 0x4d, 0x2b, 0xfc,
+	0xf9, 0x81, 0xe5, 0x07, 0xb3, 0x5b, 0xf5, 0x3f, 0xc4, 0x56, 0xfd, 0x75, 0xd7, 0x9e, 0xd8, 0xf2,
+	0xcb, 0x82, 0xe5, 0xff, 0xb9, 0x02, 0xdf, 0xba, 0x8f, 0x83, 0x88, 0x7c, 0x62, 0xc8, 0xf8, 0x6b,
+	0xba, 0xcd, 0xff, 0x95, 0x02, 0x5d, 0x19, 0xad, 0xb3, 0x6c, 0xf5, 0x9f, 0xc0, 0x72, 0x84, 0xa3,
+	0x67, 0x62, 0xbf, 0xef, 0x59, 0x23, 0xf2, 0x9b, 0xf9, 0xaa, 0xfa, 0xe6, 0x75, 0x99, 0xe2, 0xa7,
+	0x29, 0x58, 0x8a, 0xa6, 0xd8, 0x4e, 0xcc, 0xa0, 0xfd, 0x54, 0x81, 0x25, 0xe2, 0x1b, 0xb9, 0x33,
+	0x23, 0x1a, 0x78, 0x61, 0xbe, 0x8a, 0x6e, 0xb2, 0x90, 0x71, 0x93, 0x39, 0x78, 0x4c, 0x43, 0xec,
+	0x34, 0x3d, 0xb3, 0xf0, 0xee, 0x75, 0x28, 0x13, 0x03, 0x0c, 0x59, 0xf5, 0x8c, 0x8c, 0x55, 0x49,
+	0x64, 0xac, 0xb7, 0xe6, 0x30, 0x2a, 0x62, 0xbf, 0x3d, 0x83, 0xba, 0xa5, 0x97, 0x5d, 0x90, 0x2c,
+	0xfb, 0xb7, 0x15, 0xb8, 0x9c, 0x41, 0x38, 0xcb, 0xba, 0xdf, 0x82, 0x0a, 0xdd, 0x8d, 0xc2, 0x85,
+	0x3f, 0x27, 0x5d, 0x78, 0x02, 0x1d, 0xf1, 0x36, 0x3a, 0x1f, 0xa3, 0xb9, 0xa0, 0xa6, 0xdb, 0xc8,
+	0x3e, 0xc9, 0xf7, 0xc8, 0x9e, 0x63, 0x0c, 0x19, 0x03, 0x6a, 0x7a, 0x9d, 0xc3, 0x76, 0x8d, 0x21,
+	0x46, 0xdf, 0x82, 0x2a, 0x31, 0xd9, 0x9e, 0x65, 0x86, 0xe2, 0x9f, 0xa3, 0x26, 0x6c, 0xfa, 0xe8,
+	0x2a, 0x00, 0x6d, 0x32, 0x4c, 0xd3, 0x63, 0x5b, 0x68, 0x4d, 0xaf, 0x11, 0xc8, 0x1d, 0x02, 0xd0,
+	0xfe, 0x50, 0x81, 0x6b, 0xfb, 0xa7, 0x4e, 0x7f, 0x17, 0x9f, 0x6c, 0x79, 0xd8, 0x08, 0x70, 0xec,
+	0xb4, 0x9f, 0x2a, 0xe3, 0xd1, 0x0a, 0xd4, 0x13, 0xf6, 0xcb, 0x55, 0x32, 0x09, 0xd2, 0xfe, 0x5a,
+	0x81, 0x06, 0xd9, 0x45, 0x3e, 0xc4, 0x81, 0x41, 0x54, 0x04, 0x7d, 0x17, 0x6a, 0xb6, 0x6b, 0x98,
+	0xbd, 0xe0, 0x74, 0xc4, 0xa8, 0x69, 0x6d, 0x5e, 0x91, 0x71, 0x97, 0x0c, 0x7a, 0x70, 0x3a, 0xc2,
+	0x7a, 0xd5, 0xe6, 0xbf, 0x72, 0x51, 0x94, 0xf6, 0x32, 0x45, 0x89, 0xa7, 0x7c, 0x06, 0xea, 0x43,
+	0x1c, 0x78, 0x56, 0x9f, 0x11, 0x51, 0xa2, 0xa2, 0x00, 0x06, 0x22, 0x88, 0xb4, 0x9f, 0x56, 0x60,
+	0xf9, 0x07, 0x46, 0xd0, 0x3f, 0xda, 0x1e, 0x86, 0x51, 0xcc, 0xc5, 0xf9, 0x18, 0xfb, 0xe5, 0x42,
+	0xd2, 0x2f, 0x3f, 0x31, 0xbf, 0x1f, 0xd9, 0x68, 0x59, 0x66, 0xa3, 0x24, 0x31, 0x5f, 0xff, 0x98,
+	0xab, 0x59, 0xc2, 0x46, 0x13, 0xc1, 0x46, 0xe5, 0x22, 0xc1, 0xc6, 0x16, 0x34, 0xf1, 0xe7, 0x7d,
+	0x7b, 0x4c, 0xf4, 0x95, 0x62, 0x67, 0x51, 0xc4, 0x35, 0x09, 0xf6, 0xa4, 0x83, 0x68, 0xf0, 0x41,
+	0x3b, 0x9c, 0x06, 0xa6, 0x0b, 0x43, 0x1c, 0x18, 0x34, 0x54, 0xa8, 0x6f, 0xae, 0x4c, 0xd2, 0x85,
+	0x50, 0x81, 0x98, 0x3e, 0x90, 0x2f, 0x74, 0x05, 0x6a, 0x3c, 0xb4, 0xd9, 0xd9, 0xee, 0xd4, 0x28,
+	0xfb, 0x62, 0x00, 0x32, 0xa0, 0xc9, 0xbd, 0x27, 0xa7, 0x90, 0x05, 0x10, 0x6f, 0xc9, 0x10, 0xc8,
+	0x85, 0x9d, 0xa4, 0xdc, 0xe7, 0x81, 0x8e, 0x9f, 0x00, 0x91, 0xcc, 0xdf, 0x3d, 0x3c, 0xb4, 0x2d,
+	0x07, 0xef, 0x32, 0x09, 0xd7, 0x29, 0x11, 0x22, 0x90, 0x84, 0x43, 0xc7, 0xd8, 0xf3, 0x2d, 0xd7,
+	0xe9, 0x34, 0x68, 0x7b, 0xf8, 0x29, 0x8b, 0x72, 0x9a, 0x17, 0x88, 0x72, 0x7a, 0x30, 0x9f, 0xa1,
+	0x54, 0x12, 0xe5, 0xbc, 0x96, 0x8c, 0x72, 0xa6, 0x8b, 0x2a, 0x11, 0x05, 0xfd, 0x4c, 0x81, 0xa5,
+	0x87, 0x8e, 0x3f, 0x3e, 0x88, 0x58, 0xf4, 0xd5, 0x98, 0x43, 0xda, 0x89, 0x96, 0x32, 0x4e, 0x54,
+	0xfb, 0xef, 0x32, 0xb4, 0xf9, 0x2a, 0x88, 0xd6, 0x50, 0x97, 0x73, 0x05, 0x6a, 0xd1, 0x3e, 0xca,
+	0x19, 0x12, 0x03, 0xd2, 0x3e, 0xac, 0x90, 0xf1, 0x61, 0xb9, 0x48, 0x0b, 0xa3, 0xa2, 0x52, 0x22,
+	0x2a, 0xba, 0x0a, 0x70, 0x68, 0x8f, 0xfd, 0xa3, 0x5e, 0x60, 0x0d, 0x31, 0x8f, 0xca, 0x6a, 0x14,
+	0xf2, 0xc0, 0x1a, 0x62, 0x74, 0x07, 0x1a, 0x07, 0x96, 0x63, 0xbb, 0x83, 0xde, 0xc8, 0x08, 0x8e,
+	0x7c, 0x9e, 0x16, 0xcb, 0xc4, 0x42, 0x63, 0xd8, 0xbb, 0xb4, 0xaf, 0x5e, 0x67, 0x63, 0xf6, 0xc8,
+	0x10, 0x74, 0x0d, 0xea, 0xce, 0x78, 0xd8, 0x73, 0x0f, 0x7b, 0x9e, 0x7b, 0xe2, 0xd3, 0xe4, 0xb7,
+	0xa8, 0xd7, 0x9c, 0xf1, 0xf0, 0xa3, 0x43, 0xdd, 0x3d, 0x21, 0xfb, 0x58, 0x8d, 0xec, 0x68, 0xbe,
+	0xed, 0x0e, 0x58, 0xe2, 0x3b, 0x7d, 0xfe, 0x78, 0x00, 0x19, 0x6d, 0x62, 0x3b, 0x30, 0xe8, 0xe8,
+	0x5a, 0xbe, 0xd1, 0xd1, 0x00, 0xf4, 0x02, 0xb4, 0xfa, 0xee, 0x70, 0x64, 0x50, 0x0e, 0xdd, 0xf3,
+	0xdc, 0x21, 0x35, 0xc0, 0xa2, 0x9e, 0x82, 0xa2, 0x2d, 0xa8, 0xc7, 0x46, 0xe0, 0x77, 0xea, 0x14,
+	0x8f, 0x26, 0xb3, 0xd2, 0x44, 0x28, 0x4f, 0x14, 0x14, 0x22, 0x2b, 0xf0, 0x89, 0x66, 0x84, 0xc6,
+	0xee, 0x5b, 0x5f, 0x60, 0x6e, 0x68, 0x75, 0x0e, 0xdb, 0xb7, 0xbe, 0xc0, 0x24, 0x3d, 0xb2, 0x1c,
+	0x1f, 0x7b, 0x41, 0x98, 0xac, 0x76, 0x9a, 0x54, 0x7d, 0x9a, 0x0c, 0xca, 0x15, 0x1b, 0x6d, 0x43,
+	0xcb, 0x0f, 0x0c, 0x2f, 0xe8, 0x8d, 0x5c, 0x9f, 0x2a, 0x40, 0xa7, 0xb5, 0xa2, 0x64, 0x4d, 0x92,
+	0xd4, 0x3e, 0x3f, 0xf4, 0x07, 0x7b, 0xbc, 0x93, 0xde, 0xa4, 0x83, 0xc2, 0x4f, 0x32, 0x0b, 0xe5,
+	0x44, 0x3c, 0x4b, 0x3b, 0xd7, 0x2c, 0x74, 0x50, 0x34, 0xcb, 0x2a, 0x49, 0x97, 0x0c, 0x93, 0x14,
+	0xf5, 0x3e, 0xe6, 0x1e, 0x44, 0xa5, 0x0b, 0x4b, 0x83, 0xb5, 0x7f, 0x2d, 0x42, 0x4b, 0x64, 0x0f,
+	0x71, 0x3b, 0x2c, 0x2b, 0x0b, 0x75, 0x3e, 0xfc, 0x24, 0xcc, 0xc2, 0x0e, 0x19, 0xcd, 0x52, 0x40,
+	0xaa, 0xf2, 0x55, 0xbd, 0xce, 0x60, 0x74, 0x02, 0xa2, 0xba, 0x4c, 0x28, 0xd4, 0xce, 0x8a, 0x94,
+	0x51, 0x35, 0x0a, 0xa1, 0xa1, 0x4a, 0x07, 0xe6, 0xc2, 0xec, 0x91, 0x29, 0x7c, 0xf8, 0x49, 0x5a,
+	0x0e, 0xc6, 0x16, 0xc5, 0xca, 0x14, 0x3e, 0xfc, 0x44, 0xdb, 0xd0, 0x60, 0x53, 0x8e, 0x0c, 0xcf,
+	0x18, 0x86, 0xea, 0xfe, 0xac, 0xd4, 0x65, 0xbc, 0x8f, 0x4f, 0x3f, 0x26, 0xde, 0x67, 0xcf, 0xb0,
+	0x3c, 0x9d, 0xa9, 0xc7, 0x1e, 0x1d, 0x85, 0x56, 0x41, 0x65, 0xb3, 0x1c, 0x5a, 0x36, 0xe6, 0x86,
+	0x33, 0xc7, 0x52, 0x48, 0x0a, 0xbf, 0x67, 0xd9, 0x98, 0xd9, 0x46, 0xb4, 0x04, 0xaa, 0x10, 0x55,
+	0x66, 0x1a, 0x14, 0x42, 0xd5, 0xe1, 0x3a, 0x30, 0x2f, 0xda, 0x0b, 0x7d, 0x33, 0xdb, 0x40, 0x18,
+	0x8d, 0x9c, 0xad, 0x34, 0x24, 0x1b, 0x0f, 0x99, 0x71, 0x01, 0x5b, 0x8e, 0x33, 0x1e, 0x52, 0xd3,
+	0x7a, 0x09, 0x54, 0x3f, 0x70, 0x3d, 0x63, 0x80, 0x7b, 0xd8, 0x31, 0x47, 0xae, 0xe5, 0x04, 0xd4,
+	0xfd, 0xd7, 0xf4, 0x36, 0x87, 0xbf, 0xcb, 0xc1, 0xe8, 0x35, 0x58, 0x0e, 0xbb, 0x7a, 0xcc, 0x6f,
+	0x62, 0xaf, 0x37, 0x32, 0x4e, 0x7d, 0xaa, 0xa6, 0x55, 0x7d, 0x91, 0xb7, 0xea, 0x61, 0xe3, 0x9e,
+	0x71, 0xea, 0x6b, 0xbf, 0x57, 0x86, 0x05, 0xe2, 0xc2, 0xb8, 0x37, 0x9b, 0x21, 0x02, 0xb9, 0x0a,
+	0x60, 0xfa, 0x41, 0x4f, 0x70, 0xbb, 0x35, 0xd3, 0x0f, 0xf8, 0xfe, 0xf4, 0xdd, 0x30, 0x80, 0x28,
+	0x4e, 0xce, 0x87, 0x52, 0x2e, 0x35, 0x1b, 0x44, 0x5c, 0xa8, 0x80, 0x78, 0x1d, 0x9a, 0xbc, 0x18,
+	0x20, 0x64, 0xae, 0x0d, 0x06, 0xdc, 0x95, 0x6f, 0x0c, 0x15, 0x69, 0x21, 0x33, 0x11, 0x48, 0xcc,
+	0xcd, 0x16, 0x48, 0x54, 0xd3, 0x81, 0xc4, 0x3d, 0x68, 0x8b, 0xb6, 0x1c, 0x3a, 0xc3, 0x29, 0xc6,
+	0xdc, 0x12, 0x8c, 0xd9, 0x4f, 0xc6, 0x01, 0x20, 0xc6, 0x01, 0xd7, 0xa1, 0xe9, 0x60, 0x6c, 0xf6,
+	0x02, 0xcf, 0x70, 0xfc, 0x43, 0xec, 0x51, 0x45, 0xaa, 0xea, 0x0d, 0x02, 0x7c, 0xc0, 0x61, 0xe8,
+	0x2d, 0x00, 0xba, 0x46, 0x56, 0xff, 0x6a, 0x4c, 0xae, 0x7f, 0x51, 0xa5, 0x21, 0x9d, 0xf4, 0x9a,
+	0x1d, 0xfe, 0x7c, 0x42, 0xa1, 0x86, 0xf6, 0xcf, 0x05, 0x58, 0xe6, 0xf5, 0x90, 0xd9, 0xf5, 0x72,
+	0x52, 0x28, 0x10, 0xee, 0xa5, 0xc5, 0x33, 0x2a, 0x0c, 0xa5, 0x1c, 0xd1, 0x72, 0x59, 0x12, 0x2d,
+	0x8b, 0x59, 0x76, 0x25, 0x93, 0x65, 0x47, 0x05, 0xc6, 0xb9, 0xfc, 0x05, 0x46, 0x52, 0x3f, 0xa2,
+	0xa9, 0x1f, 0xd5, 0x9d, 0x9a, 0xce, 0x3e, 0x72, 0x49, 0x55, 0xfb, 0x83, 0x02, 0x34, 0xf7, 0xb1,
+	0xe1, 0xf5, 0x8f, 0x42, 0x3e, 0xbe, 0x91, 0x2c, 0xc8, 0x3e, 0x37, 0xa1, 0x20, 0x2b, 0x0c, 0xf9,
+	0xc6, 0x54, 0x62, 0x09, 0x82, 0xc0, 0x0d, 0x8c, 0x88, 0x4a, 0x52, 0xa8, 0xe4, 0x55, 0xca, 0x36,
+	0x6d, 0xe0, 0xa4, 0xee, 0x8e, 0x87, 0xda, 0x7f, 0x29, 0xd0, 0xf8, 0x65, 0x32, 0x4d, 0xc8, 0x98,
+	0xdb, 0x49, 0xc6, 0xbc, 0x30, 0x81, 0x31, 0x3a, 0xc9, 0xe2, 0xf0, 0x31, 0xfe, 0xc6, 0x15, 0xa9,
+	0xff, 0x51, 0x81, 0x2e, 0xc9, 0xe1, 0x75, 0xe6, 0x77, 0x66, 0xb7, 0xae, 0xeb, 0xd0, 0x3c, 0x16,
+	0xa2, 0xe5, 0x02, 0x55, 0xce, 0xc6, 0x71, 0xb2, 0xe6, 0xa0, 0x93, 0x03, 0x2b, 0x56, 0x33, 0xe6,
+	0x8b, 0x0d, 0xb7, 0x81, 0x17, 0x65, 0x54, 0xa7, 0x88, 0xa3, 0x1e, 0xa2, 0xed, 0x89, 0x40, 0xed,
+	0x77, 0x14, 0x58, 0x90, 0x74, 0x44, 0x97, 0x61, 0x8e, 0xd7, 0x37, 0x3a, 0x4a, 0xc2, 0xde, 0x4d,
+	0x22, 0x9e, 0xb8, 0x42, 0x67, 0x99, 0xd9, 0x10, 0xdc, 0x24, 0x29, 0x7b, 0x94, 0xcc, 0x99, 0x19,
+	0xf9, 0x98, 0x3e, 0xea, 0x42, 0x95, 0x7b, 0xd3, 0x30, 0x4b, 0x8e, 0xbe, 0xb5, 0x47, 0x80, 0xee,
+	0xe3, 0x78, 0xef, 0x9a, 0x85, 0xa3, 0xb1, 0xbf, 0x89, 0x09, 0x4d, 0x3a, 0x21, 0x53, 0xfb, 0x0f,
+	0x05, 0x16, 0x04, 0x6c, 0xb3, 0xd4, 0xa1, 0xe2, 0xfd, 0xb5, 0x70, 0x91, 0xfd, 0x55, 0xa8, 0xb5,
+	0x14, 0xcf, 0x55, 0x6b, 0xb9, 0x06, 0x10, 0xf1, 0x3f, 0xe4, 0x68, 0x02, 0xa2, 0xfd, 0xbd, 0x02,
+	0xcb, 0xef, 0x19, 0x8e, 0xe9, 0x1e, 0x1e, 0xce, 0xae, 0xaa, 0x5b, 0x20, 0xe4, 0xd5, 0x79, 0xab,
+	0x8d, 0xc2, 0x20, 0x74, 0x03, 0xe6, 0x3d, 0xb6, 0x33, 0x99, 0xa2, 0x2e, 0x17, 0x75, 0x35, 0x6c,
+	0x88, 0x74, 0xf4, 0x2f, 0x0b, 0x80, 0xc8, 0xaa, 0xef, 0x1a, 0xb6, 0xe1, 0xf4, 0xf1, 0xc5, 0x49,
+	0x7f, 0x1e, 0x5a, 0x42, 0x08, 0x13, 0x9d, 0xfe, 0x27, 0x63, 0x18, 0x1f, 0xbd, 0x0f, 0xad, 0x03,
+	0x86, 0xaa, 0xe7, 0x61, 0xc3, 0x77, 0x1d, 0x2e, 0x0e, 0x69, 0x61, 0xf1, 0x81, 0x67, 0x0d, 0x06,
+	0xd8, 0xdb, 0x72, 0x1d, 0x93, 0xa7, 0x05, 0x07, 0x21, 0x99, 0x64, 0x28, 0x31, 0x86, 0x38, 0x9e,
+	0x8b, 0x84, 0x13, 0x05, 0x74, 0x94, 0x15, 0x3e, 0x36, 0xec, 0x98, 0x11, 0xf1, 0x6e, 0xa8, 0xb2,
+	0x86, 0xfd, 0xc9, 0x75, 0x65, 0x49, 0x7c, 0xa5, 0xfd, 0x8d, 0x02, 0x28, 0xca, 0xfd, 0x69, 0xb1,
+	0x84, 0x5a, 0x74, 0x7a, 0xa8, 0x92, 0x1d, 0x4a, 0x62, 0x2b, 0x33, 0x1c, 0xc9, 0x5d, 0x50, 0x0c,
+	0xa0, 0x7b, 0x24, 0x25, 0xba, 0x47, 0x34, 0x0f, 0x9b, 0x61, 0x6e, 0xcd, 0x80, 0x1f, 0x50, 0x98,
+	0x18, 0x9e, 0x95, 0xd2, 0xe1, 0x59, 0xb2, 0x6c, 0x5a, 0x16, 0xca, 0xa6, 0xda, 0xcf, 0x0a, 0xa0,
+	0xd2, 0x2d, 0x64, 0x2b, 0xae, 0x7f, 0xe5, 0x22, 0xfa, 0x3a, 0x34, 0xf9, 0xed, 0x19, 0x81, 0xf0,
+	0xc6, 0xe3, 0xc4, 0x64, 0xe8, 0x26, 0x2c, 0xb2, 0x4e, 0x1e, 0xf6, 0xc7, 0x76, 0x9c, 0x56, 0xb2,
+	0x6c, 0x09, 0x3d, 0x66, 0x7b, 0x17, 0x69, 0x0a, 0x47, 0x3c, 0x84, 0xe5, 0x81, 0xed, 0x1e, 0x18,
+	0x76, 0x4f, 0x14, 0x0f, 0x93, 0x61, 0x0e, 0x8d, 0x5f, 0x64, 0xc3, 0xf7, 0x93, 0x32, 0xf4, 0xd1,
+	0x5d, 0x52, 0xe9, 0xc2, 0x8f, 0xe2, 0x5c, 0xb3, 0x9c, 0x27, 0xd7, 0x6c, 0x90, 0x31, 0xe1, 0x97,
+	0xf6, 0xc7, 0x0a, 0xb4, 0x53, 0x87, 0x1e, 0xe9, 0xca, 0x88, 0x92, 0xad, 0x8c, 0xdc, 0x86, 0x32,
+	0xf1, 0x54, 0x6c, 0x6f, 0x69, 0xc9, 0xb3, 0x76, 0x71, 0x56, 0x9d, 0x0d, 0x40, 0x1b, 0xb0, 0x20,
+	0xb9, 0x5c, 0xc1, 0xc5, 0x8f, 0xb2, 0x77, 0x2b, 0xb4, 0x9f, 0x97, 0xa0, 0x9e, 0x60, 0xc5, 0x94,
+	0xa2, 0xce, 0x13, 0x29, 0x5e, 0x4f, 0x3a, 0x4c, 0x27, 0x2a, 0x37, 0xc4, 0x43, 0x96, 0x58, 0xf2,
+	0x2c, 0x77, 0x88, 0x87, 0x34, 0xad, 0x4c, 0x66, 0x8c, 0x15, 0x31, 0x63, 0x14, 0x73, 0xea, 0xb9,
+	0x33, 0x72, 0xea, 0xaa, 0x98, 0x53, 0x0b, 0x26, 0x54, 0x4b, 0x9b, 0x50, 0xde, 0x3a, 0xcb, 0x4d,
+	0x58, 0xe8, 0xb3, 0xc3, 0x81, 0xbb, 0xa7, 0x5b, 0x51, 0x13, 0x0f, 0x4a, 0x65, 0x4d, 0xe8, 0x5e,
+	0x5c, 0x41, 0x65, 0x52, 0x66, 0x49, 0x87, 0x3c, 0x65, 0xe7, 0xb2, 0x61, 0x42, 0x6e, 0xf8, 0x89,
+	0xaf, 0x74, 0x85, 0xa7, 0x79, 0xa1, 0x0a, 0xcf, 0x33, 0x50, 0x0f, 0x23, 0x15, 0x62, 0xe9, 0x2d,
+	0xe6, 0xf4, 0x38, 0x88, 0x44, 0x00, 0x49, 0x3f, 0xd0, 0x16, 0x8f, 0x4f, 0xd2, 0x05, 0x0f, 0x35,
+	0x5b, 0xf0, 0xb8, 0x0c, 0x73, 0x96, 0xdf, 0x3b, 0x34, 0x1e, 0xe1, 0xce, 0x3c, 0x6d, 0xad, 0x58,
+	0xfe, 0x3d, 0xe3, 0x11, 0xa6, 0x95, 0x95, 0x78, 0x83, 0xcd, 0xed, 0x41, 0xf2, 0x5c, 0x30, 0xda,
+	0x05, 0x35, 0xfa, 0x66, 0x1c, 0x3e, 0x33, 0x07, 0x4f, 0x9f, 0x49, 0xb6, 0x47, 0x22, 0x40, 0xdc,
+	0xee, 0x4b, 0xe7, 0xda, 0xee, 0x67, 0xbc, 0x7a, 0x70, 0x0b, 0x96, 0xa2, 0xbd, 0x57, 0x58, 0x36,
+	0x4b, 0xb0, 0x16, 0xc3, 0xc6, 0xbd, 0xe4, 0xf2, 0x27, 0xb8, 0x80, 0xb9, 0x49, 0x2e, 0x20, 0xad,
+	0x02, 0xd5, 0x8c, 0x0a, 0x64, 0x6f, 0x40, 0xd4, 0x24, 0x37, 0x20, 0xb4, 0x87, 0xb0, 0x40, 0xab,
+	0xd9, 0xe4, 0x20, 0xf7, 0x00, 0x47, 0x29, 0x40, 0x1e, 0xb1, 0x76, 0xa1, 0x9a, 0xca, 0x22, 0xa2,
+	0x6f, 0xed, 0x27, 0x0a, 0x2c, 0x67, 0xe7, 0xa5, 0x1a, 0x13, 0x3b, 0x12, 0x45, 0x70, 0x24, 0xbf,
+	0x02, 0x0b, 0x89, 0x88, 0x52, 0x98, 0x79, 0x42, 0x04, 0x2e, 0x21, 0x5c, 0x47, 0xf1, 0x1c, 0x21,
+	0x4c, 0xfb, 0xb9, 0x12, 0x1d, 0x0a, 0x10, 0xd8, 0x80, 0x9e, 0xb8, 0x90, 0x7d, 0xcd, 0x75, 0x6c,
+	0xcb, 0xc1, 0x3d, 0x81, 0x9c, 0x06, 0x03, 0xf2, 0x82, 0xcb, 0x7b, 0xd0, 0xe6, 0x9d, 0xa2, 0xed,
+	0x29, 0x67, 0x40, 0xd6, 0x62, 0xe3, 0xa2, 0x8d, 0xe9, 0x79, 0x68, 0xf1, 0xa3, 0x90, 0x10, 0x5f,
+	0x51, 0x76, 0x40, 0xf2, 0x7d, 0x50, 0xc3, 0x6e, 0xe7, 0xdd, 0x10, 0xdb, 0x7c, 0x60, 0x14, 0xd8,
+	0xfd, 0xa6, 0x02, 0x1d, 0x71, 0x7b, 0x4c, 0x2c, 0xff, 0xfc, 0xe1, 0xdd, 0x9b, 0xe2, 0x01, 0xf8,
+	0xf3, 0x67, 0xd0, 0x13, 0xe3, 0x09, 0x8f, 0xc1, 0x7f, 0xb7, 0x40, 0x6f, 0x33, 0x90, 0x54, 0x6f,
+	0xdb, 0xf2, 0x03, 0xcf, 0x3a, 0x18, 0xcf, 0x76, 0x24, 0x6b, 0x40, 0xbd, 0x7f, 0x84, 0xfb, 0x8f,
+	0x68, 0x59, 0x31, 0xa4, 0xe9, 0x1d, 0x19, 0x4d, 0x93, 0xd1, 0xae, 0x6f, 0xc5, 0x33, 0xb0, 0x33,
+	0xad, 0xe4, 0x9c, 0xdd, 0x1f, 0x81, 0x9a, 0xee, 0x90, 0x3c, 0x4a, 0xaa, 0xb1, 0xa3, 0xa4, 0x5b,
+	0xe2, 0x51, 0xd2, 0x94, 0x48, 0x23, 0x71, 0x92, 0xf4, 0xb7, 0x05, 0xf8, 0xb6, 0x94, 0xb6, 0x59,
+	0xb2, 0xa4, 0x49, 0x75, 0xa4, 0xbb, 0x50, 0x4d, 0x25, 0xb5, 0x2f, 0x9c, 0x21, 0x3f, 0x5e, 0xf3,
+	0x65, 0xa5, 0x41, 0x3f, 0x8e, 0xad, 0x62, 0x83, 0x2f, 0x4d, 0x9e, 0x83, 0xdb, 0x9d, 0x30, 0x47,
+	0x38, 0x8e, 0x1c, 0xf4, 0xb0, 0x82, 0x41, 0xef, 0xd8, 0xc2, 0x27, 0xe1, 0x41, 0xed, 0x35, 0xa9,
+	0x6b, 0xa6, 0xfd, 0x3e, 0xb6, 0xf0, 0x89, 0x5e, 0xb7, 0xa3, 0xdf, 0xbe, 0xf6, 0xfb, 0x25, 0x80,
+	0xb8, 0x8d, 0x64, 0x67, 0xb1, 0xcd, 0x73, 0x23, 0x4e, 0x40, 0x48, 0x2c, 0x21, 0x46, 0xae, 0xe1,
+	0x27, 0xd2, 0xe3, 0x83, 0x12, 0x93, 0x14, 0x01, 0x19, 0x5f, 0x36, 0xce, 0xa6, 0x25, 0x64, 0x11,
+	0x11, 0x19, 0xd7, 0x19, 0x3f, 0x86, 0xa0, 0x57, 0x00, 0x0d, 0x3c, 0xf7, 0xc4, 0x72, 0x06, 0xc9,
+	0x7c, 0x83, 0xa5, 0x25, 0xf3, 0xbc, 0x25, 0x91, 0x70, 0xfc, 0x18, 0xd4, 0x54, 0xf7, 0x90, 0x25,
+	0xb7, 0xa6, 0x90, 0x71, 0x5f, 0x98, 0x8b, 0xab, 0x6f, 0x5b, 0xc4, 0x40, 0x4f, 0x65, 0x1f, 0x18,
+	0xde, 0x00, 0x87, 0x12, 0xe5, 0x71, 0x98, 0x08, 0xec, 0xf6, 0x40, 0x4d, 0xaf, 0x4a, 0x72, 0x66,
+	0xfa, 0xba, 0xa8, 0xe8, 0x67, 0xf9, 0x23, 0x32, 0x4d, 0x42, 0xd5, 0xbb, 0x06, 0x2c, 0xca, 0xe8,
+	0x95, 0x20, 0xb9, 0xb0, 0x35, 0xbd, 0x03, 0xf5, 0x04, 0xf2, 0x89, 0xbb, 0x4c, 0xa2, 0xf0, 0x5c,
+	0x10, 0x0a, 0xcf, 0xda, 0xaf, 0x17, 0x01, 0x65, 0xd5, 0x1f, 0xb5, 0xa0, 0x10, 0x4d, 0x52, 0xd8,
+	0xd9, 0x4e, 0xa9, 0x5b, 0x21, 0xa3, 0x6e, 0x57, 0xa0, 0x16, 0xed, 0xfa, 0xdc, 0xc5, 0xc7, 0x80,
+	0xa4, 0x32, 0x96, 0x44, 0x65, 0x4c, 0x10, 0x56, 0x16, 0x08, 0x23, 0xb9, 0x95, 0x6d, 0xf8, 0x41,
+	0x8f, 0x15, 0xde, 0x03, 0x6b, 0x88, 0xfd, 0xc0, 0x18, 0x8e, 0xa8, 0x28, 0x4b, 0x3a, 0x22, 0x6d,
+	0xdb, 0xa4, 0xe9, 0x41, 0xd8, 0x82, 0x1e, 0x84, 0xd1, 0x35, 0xf1, 0xbd, 0xfc, 0x36, 0xc2, 0xeb,
+	0xf9, 0xcc, 0x3d, 0x2e, 0x77, 0x33, 0x8d, 0xaa, 0x45, 0x61, 0x67, 0xf7, 0x33, 0x68, 0x89, 0x8d,
+	0x12, 0xf1, 0xdd, 0x16, 0xc5, 0x97, 0x27, 0xb0, 0x4d, 0xc8, 0xf0, 0x08, 0x50, 0xd6, 0x79, 0x24,
+	0x79, 0xa6, 0x88, 0x3c, 0x9b, 0x26, 0x8b, 0x04, 0x4f, 0x8b, 0xa2, 0xb0, 0xff, 0xb4, 0x08, 0x28,
+	0x8e, 0xe0, 0xa2, 0xd3, 0xf1, 0x3c, 0x61, 0xcf, 0x06, 0x2c, 0x64, 0xe3, 0xbb, 0x30, 0xa8, 0x45,
+	0x99, 0xe8, 0x4e, 0x16, 0x89, 0x15, 0x65, 0x77, 0x51, 0xdf, 0x88, 0xdc, 0x3d, 0x0b, 0x57, 0xaf,
+	0x4d, 0x3c, 0xcf, 0x10, 0x3d, 0xfe, 0x8f, 0xd2, 0x77, 0x58, 0x99, 0xff, 0xb8, 0x2d, 0x75, 0xcd,
+	0x99, 0x25, 0x4f, 0xbd, 0xc0, 0x2a, 0x04, 0xd2, 0x95, 0xf3, 0x04, 0xd2, 0xb3, 0xdf, 0x38, 0xfd,
+	0xf7, 0x02, 0xcc, 0x47, 0x8c, 0x3c, 0x97, 0x90, 0xa6, 0x5f, 0x64, 0x78, 0xca, 0x52, 0xf9, 0x54,
+	0x2e, 0x95, 0xef, 0x9c, 0x99, 0xcc, 0xe4, 0x15, 0xca, 0xec, 0x9c, 0xfd, 0x02, 0xe6, 0x78, 0x59,
+	0x3a, 0xe3, 0xe0, 0xf2, 0x94, 0x0b, 0x16, 0xa1, 0x4c, 0xfc, 0x69, 0x58, 0x53, 0x64, 0x1f, 0x8c,
+	0xa5, 0xc9, 0x1b, 0xcd, 0xdc, 0xc7, 0x35, 0x85, 0x0b, 0xcd, 0xda, 0x6f, 0x15, 0x01, 0x48, 0x75,
+	0xff, 0x0e, 0x33, 0xd2, 0x9b, 0x50, 0x9a, 0x76, 0xff, 0x8d, 0xf4, 0xa6, 0xba, 0x45, 0x7b, 0xe6,
+	0x10, 0xae, 0x50, 0x10, 0x29, 0xa6, 0x0b, 0x22, 0x93, 0x4a, 0x19, 0x93, 0x5d, 0xf0, 0x77, 0xa0,
+	0x44, 0x5d, 0x29, 0xbb, 0x1e, 0x96, 0xeb, 0x54, 0x98, 0x0e, 0x20, 0xb7, 0x16, 0xf8, 0x96, 0xbc,
+	0xe3, 0xb0, 0x3d, 0x97, 0xba, 0xe3, 0xa2, 0x9e, 0x06, 0x93, 0xd2, 0x05, 0x2b, 0x84, 0x45, 0x1d,
+	0x59, 0x4e, 0x97, 0x82, 0x66, 0x77, 0xf4, 0x9a, 0x64, 0x47, 0x27, 0x78, 0x4d, 0xcf, 0x1d, 0x8d,
+	0x12, 0xd3, 0xb1, 0x4a, 0x48, 0x1a, 0xac, 0x7d, 0x49, 0x9e, 0x80, 0x9d, 0x3a, 0xfd, 0x27, 0x13,
+	0x95, 0xe7, 0x51, 0x9e, 0x84, 0x3f, 0x2f, 0x8a, 0xfe, 0xfc, 0x36, 0xcc, 0xb1, 0x72, 0x4b, 0x18,
+	0x5f, 0x5e, 0x9b, 0xa4, 0x0d, 0x4c, 0x77, 0xf4, 0xb0, 0xfb, 0xac, 0x39, 0xbb, 0x70, 0x66, 0x5e,
+	0x99, 0xed, 0xcc, 0x7c, 0x2e, 0x5d, 0x94, 0x4d, 0xa8, 0x55, 0x55, 0xdc, 0x85, 0x1e, 0x42, 0x53,
+	0x4f, 0x9a, 0x06, 0x39, 0xed, 0x4d, 0xdc, 0x88, 0xa5, 0xbf, 0x69, 0x9a, 0x6d, 0x8c, 0x8c, 0xbe,
+	0x15, 0x9c, 0x52, 0x76, 0x96, 0xf5, 0xe8, 0x5b, 0x6e, 0x87, 0xda, 0xff, 0x28, 0xb0, 0x1c, 0x1e,
+	0xaa, 0x72, 0x2b, 0xbf, 0xb8, 0x44, 0x37, 0x61, 0x89, 0x9b, 0x74, 0xca, 0xb6, 0x59, 0x30, 0xbd,
+	0xc0, 0x60, 0xe2, 0x32, 0x36, 0x61, 0x29, 0xa0, 0xda, 0x95, 0x1e, 0xc3, 0xe4, 0xbd, 0xc0, 0x1a,
+	0xc5, 0x31, 0x79, 0x0e, 0xb5, 0x9f, 0x61, 0x57, 0xbc, 0x38, 0x6b, 0xb9, 0x91, 0x02, 0xa9, 0x29,
+	0x32, 0x88, 0x76, 0x02, 0x57, 0xd8, 0x9d, 0xf4, 0x03, 0x91, 0xa2, 0x99, 0xce, 0x34, 0xa4, 0xeb,
+	0x4e, 0xf9, 0xb4, 0x3f, 0x51, 0xe0, 0xea, 0x04, 0xcc, 0xb3, 0x64, 0x73, 0x1f, 0x48, 0xb1, 0x4f,
+	0xc8, 0xbd, 0x05, 0xbc, 0xec, 0xc2, 0x82, 0x48, 0xe4, 0x97, 0x25, 0x98, 0xcf, 0x74, 0x3a, 0xb7,
+	0xce, 0xbd, 0x0c, 0x88, 0x08, 0x21, 0x7a, 0x7f, 0x49, 0xcb, 0x19, 0x7c, 0xf3, 0x54, 0x9d, 0xf1,
+	0x30, 0x7a, 0x7b, 0x49, 0x2a, 0x1a, 0xc8, 0x62, 0xbd, 0xd9, 0x89, 0x46, 0x24, 0xb9, 0xd2, 0xe4,
+	0x67, 0x36, 0x19, 0x02, 0xd7, 0x77, 0xc7, 0x43, 0x76, 0xf8, 0xc1, 0xa5, 0xcc, 0x36, 0x44, 0xd5,
+	0x49, 0x81, 0xd1, 0x21, 0xcc, 0x13, 0x54, 0xee, 0x38, 0x18, 0xb8, 0x24, 0xa1, 0xa2, 0x74, 0xb1,
+	0x6d, 0xf7, 0x7b, 0xb9, 0x31, 0x7d, 0xc4, 0x47, 0x13, 0xe2, 0x79, 0x4e, 0xe5, 0x88, 0xd0, 0x10,
+	0x8f, 0xe5, 0xf4, 0xdd, 0x61, 0x84, 0xa7, 0x72, 0x4e, 0x3c, 0x3b, 0x7c, 0xb4, 0x88, 0x27, 0x09,
+	0xed, 0x6e, 0xc1, 0x92, 0x74, 0xe9, 0xd3, 0x36, 0xfa, 0x72, 0x32, 0xf3, 0xba, 0x0b, 0x8b, 0xb2,
+	0x55, 0x5d, 0x60, 0x8e, 0x0c, 0xc5, 0xe7, 0x99, 0x43, 0xfb, 0x8b, 0x02, 0x34, 0xb7, 0xb1, 0x8d,
+	0x03, 0xfc, 0x74, 0xcf, 0x9c, 0x33, 0x07, 0xe8, 0xc5, 0xec, 0x01, 0x7a, 0xe6, 0x36, 0x40, 0x49,
+	0x72, 0x1b, 0xe0, 0x6a, 0x74, 0x09, 0x82, 0xcc, 0x52, 0x16, 0x63, 0x08, 0x13, 0xbd, 0x09, 0x8d,
+	0x91, 0x67, 0x0d, 0x0d, 0xef, 0xb4, 0xf7, 0x08, 0x9f, 0xfa, 0x7c, 0xd3, 0xe8, 0x48, 0xb7, 0x9d,
+	0x9d, 0x6d, 0x5f, 0xaf, 0xf3, 0xde, 0xef, 0xe3, 0x53, 0x7a, 0xc1, 0x22, 0x4a, 0xe3, 0xd8, 0x95,
+	0xbd, 0x92, 0x9e, 0x80, 0xac, 0xdd, 0x80, 0x5a, 0x74, 0x71, 0x09, 0x55, 0xa1, 0x74, 0x6f, 0x6c,
+	0xdb, 0xea, 0x25, 0x54, 0x83, 0x32, 0x4d, 0xf4, 0x54, 0x85, 0xfc, 0xa4, 0xb1, 0x9f, 0x5a, 0x58,
+	0xfb, 0x25, 0xa8, 0x45, 0x17, 0x28, 0x50, 0x1d, 0xe6, 0x1e, 0x3a, 0xef, 0x3b, 0xee, 0x89, 0xa3,
+	0x5e, 0x42, 0x73, 0x50, 0xbc, 0x63, 0xdb, 0xaa, 0x82, 0x9a, 0x50, 0xdb, 0x0f, 0x3c, 0x6c, 0x10,
+	0xf1, 0xa9, 0x05, 0xd4, 0x02, 0x78, 0xcf, 0xf2, 0x03, 0xd7, 0xb3, 0xfa, 0x86, 0xad, 0x16, 0xd7,
+	0xbe, 0x80, 0x96, 0x58, 0x4f, 0x47, 0x0d, 0xa8, 0xee, 0xba, 0xc1, 0xbb, 0x9f, 0x5b, 0x7e, 0xa0,
+	0x5e, 0x22, 0xfd, 0x77, 0xdd, 0x60, 0xcf, 0xc3, 0x3e, 0x76, 0x02, 0x55, 0x41, 0x00, 0x95, 0x8f,
+	0x9c, 0x6d, 0xcb, 0x7f, 0xa4, 0x16, 0xd0, 0x02, 0x3f, 0x2a, 0x33, 0xec, 0x1d, 0x5e, 0xa4, 0x56,
+	0x8b, 0x64, 0x78, 0xf4, 0x55, 0x42, 0x2a, 0x34, 0xa2, 0x2e, 0xf7, 0xf7, 0x1e, 0xaa, 0x65, 0x46,
+	0x3d, 0xf9, 0x59, 0x59, 0x33, 0x41, 0x4d, 0x1f, 0xf1, 0x92, 0x39, 0xd9, 0x22, 0x22, 0x90, 0x7a,
+	0x89, 0xac, 0x8c, 0x9f, 0xb1, 0xab, 0x0a, 0x6a, 0x43, 0x3d, 0x71, 0x62, 0xad, 0x16, 0x08, 0xe0,
+	0xbe, 0x37, 0xea, 0x73, 0xdd, 0x62, 0x24, 0x10, 0x45, 0xdd, 0x26, 0x9c, 0x28, 0xad, 0xdd, 0x85,
+	0x6a, 0x98, 0x9f, 0x90, 0xae, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x84, 0xe6, 0xa1, 0x29, 0xbc, 0xed,
+	0x53, 0x15, 0x84, 0xa0, 0x25, 0xbe, 0xbe, 0x55, 0x0b, 0x6b, 0x9b, 0x00, 0x71, 0x9c, 0x4f, 0xc8,
+	0xd9, 0x71, 0x8e, 0x0d, 0xdb, 0x32, 0x19, 0x6d, 0xa4, 0x89, 0x70, 0x97, 0x72, 0x87, 0xd9, 0xac,
+	0x5a, 0x58, 0x7b, 0x1b, 0xaa, 0x61, 0xec, 0x4a, 0xe0, 0x3a, 0x1e, 0xba, 0xc7, 0x98, 0x49, 0x66,
+	0x1f, 0x07, 0x4c, 0x8e, 0x77, 0x86, 0xd8, 0x31, 0xd5, 0x02, 0x21, 0xe3, 0xe1, 0xc8, 0x34, 0x82,
+	0xf0, 0x1e, 0xab, 0x5a, 0xdc, 0xfc, 0xcf, 0x05, 0x00, 0x76, 0x66, 0xeb, 0xba, 0x9e, 0x89, 0x6c,
+	0x7a, 0x77, 0x83, 0x1c, 0x4a, 0xb9, 0x4e, 0x78, 0xa0, 0xe4, 0xa3, 0xf5, 0x54, 0x89, 0x84, 0x7d,
+	0x64, 0x3b, 0x72, 0xde, 0x74, 0x9f, 0x93, 0xf6, 0x4f, 0x75, 0xd6, 0x2e, 0xa1, 0x21, 0xc5, 0x46,
+	0x8a, 0x0a, 0x0f, 0xac, 0xfe, 0xa3, 0xe8, 0xa0, 0x77, 0xf2, 0xab, 0xd8, 0x54, 0xd7, 0x10, 0xdf,
+	0x75, 0x29, 0xbe, 0xfd, 0xc0, 0xb3, 0x9c, 0x41, 0xb8, 0x3b, 0x6a, 0x97, 0xd0, 0xe3, 0xd4, 0x9b,
+	0xdc, 0x10, 0xe1, 0x66, 0x9e, 0x67, 0xb8, 0x17, 0x43, 0x69, 0x43, 0x3b, 0xf5, 0xe7, 0x07, 0x68,
+	0x4d, 0xfe, 0xb8, 0x49, 0xf6, 0x47, 0x0d, 0xdd, 0x1b, 0xb9, 0xfa, 0x46, 0xd8, 0x2c, 0x68, 0x89,
+	0xaf, 0xf6, 0xd1, 0x4b, 0x93, 0x26, 0xc8, 0x3c, 0xaf, 0xec, 0xae, 0xe5, 0xe9, 0x1a, 0xa1, 0xfa,
+	0x84, 0xa9, 0xef, 0x34, 0x54, 0xd2, 0x17, 0xad, 0xdd, 0xb3, 0x02, 0x13, 0xed, 0x12, 0xfa, 0x8c,
+	0xc4, 0x10, 0xa9, 0x47, 0xa0, 0xe8, 0x65, 0xf9, 0xbe, 0x27, 0x7f, 0x2b, 0x3a, 0x0d, 0xc3, 0x27,
+	0x69, 0xe3, 0x9b, 0x4c, 0x7d, 0xe6, 0x75, 0x79, 0x7e, 0xea, 0x13, 0xd3, 0x9f, 0x45, 0xfd, 0xb9,
+	0x31, 0xd8, 0x70, 0x79, 0xc2, 0xf3, 0x33, 0xb4, 0x29, 0xc3, 0x73, 0xf6, 0x5b, 0xb5, 0x69, 0xd8,
+	0xc6, 0xd4, 0x48, 0xd3, 0x97, 0x15, 0x5e, 0x99, 0x70, 0x0c, 0x22, 0x7f, 0xf7, 0xda, 0x5d, 0xcf,
+	0xdb, 0x3d, 0xa9, 0xcb, 0xe2, 0xd3, 0x4a, 0xb9, 0x88, 0xa4, 0xcf, 0x41, 0xbb, 0x6b, 0x79, 0xba,
+	0x46, 0xa8, 0x1e, 0x08, 0xae, 0x1e, 0xbd, 0x30, 0x49, 0x15, 0xc4, 0xdb, 0x4b, 0xd3, 0xf8, 0xf6,
+	0xab, 0x80, 0x98, 0xa5, 0x3a, 0x87, 0xd6, 0x60, 0xec, 0x19, 0x4c, 0x8d, 0x27, 0x39, 0xb7, 0x6c,
+	0xd7, 0x10, 0xcd, 0xab, 0xe7, 0x18, 0x11, 0x2d, 0xa9, 0x07, 0x70, 0x1f, 0x07, 0x1f, 0xd2, 0x37,
+	0x76, 0x7e, 0x7a, 0x45, 0xb1, 0xff, 0xe6, 0x1d, 0x42, 0x54, 0x2f, 0x4e, 0xed, 0x17, 0x21, 0x38,
+	0x80, 0xfa, 0x7d, 0x1c, 0xf0, 0x98, 0xd1, 0x47, 0x13, 0x47, 0x86, 0x3d, 0x42, 0x14, 0xab, 0xd3,
+	0x3b, 0x26, 0x9d, 0x67, 0xea, 0x99, 0x29, 0x9a, 0x28, 0xd8, 0xec, 0xe3, 0xd7, 0xee, 0x8d, 0x5c,
+	0x7d, 0x93, 0x2b, 0xa2, 0x47, 0x71, 0xef, 0x61, 0xc3, 0x0e, 0x8e, 0x26, 0xac, 0x28, 0xd1, 0xe3,
+	0xec, 0x15, 0x09, 0x1d, 0x23, 0x1c, 0x18, 0x16, 0x98, 0x15, 0x8a, 0x89, 0xe9, 0x86, 0x7c, 0x8a,
+	0x6c, 0xcf, 0x9c, 0xaa, 0x67, 0xc0, 0xfc, 0xb6, 0xe7, 0x8e, 0x44, 0x24, 0xaf, 0x48, 0x91, 0x64,
+	0xfa, 0xe5, 0x44, 0xf1, 0x03, 0x68, 0x84, 0xf9, 0x3f, 0xcd, 0x58, 0xe4, 0x5c, 0x48, 0x76, 0xc9,
+	0x39, 0xf1, 0xa7, 0xd0, 0x4e, 0x15, 0x16, 0xe4, 0x42, 0x97, 0x57, 0x1f, 0xa6, 0xcd, 0x7e, 0x02,
+	0x88, 0xbe, 0x1d, 0x16, 0xff, 0xfe, 0x40, 0x1e, 0xdf, 0x64, 0x3b, 0x86, 0x48, 0x36, 0x72, 0xf7,
+	0x8f, 0x24, 0xff, 0x6b, 0xb0, 0x24, 0x4d, 0xde, 0xd1, 0x4d, 0xd9, 0xe2, 0xce, 0xaa, 0x30, 0x74,
+	0x5f, 0x3d, 0xc7, 0x88, 0x10, 0xff, 0xe6, 0xbf, 0x21, 0xa8, 0xd1, 0x38, 0x8f, 0x4a, 0xeb, 0xff,
+	0xc3, 0xbc, 0x27, 0x1b, 0xe6, 0x7d, 0x0a, 0xed, 0xd4, 0x9b, 0x56, 0xb9, 0xd2, 0xca, 0x1f, 0xbe,
+	0xe6, 0x88, 0x56, 0xc4, 0xe7, 0xa0, 0xf2, 0xad, 0x50, 0xfa, 0x64, 0x74, 0xda, 0xdc, 0x1f, 0xb3,
+	0xf7, 0xe2, 0xd1, 0x69, 0xee, 0x8b, 0x13, 0x0f, 0x1f, 0xc4, 0x6b, 0xc7, 0x5f, 0x7d, 0x14, 0xf4,
+	0xcd, 0x8e, 0x40, 0x3f, 0x85, 0x76, 0xea, 0x61, 0x8f, 0x5c, 0x63, 0xe4, 0xaf, 0x7f, 0xa6, 0xcd,
+	0xfe, 0x0b, 0x0c, 0x9e, 0x4c, 0x58, 0x90, 0xbc, 0xa3, 0x40, 0xeb, 0x93, 0x02, 0x51, 0xf9, 0x83,
+	0x8b, 0xe9, 0x0b, 0x6a, 0x0a, 0x66, 0x8a, 0x56, 0x65, 0xf3, 0xcb, 0xfe, 0x37, 0xa9, 0xfb, 0x72,
+	0xbe, 0x3f, 0x59, 0x8a, 0x16, 0xb4, 0x0f, 0x15, 0xf6, 0xdc, 0x07, 0x3d, 0x2b, 0x5d, 0x43, 0xf2,
+	0x29, 0x50, 0x77, 0xda, 0x83, 0x21, 0x7f, 0x6c, 0x07, 0x84, 0xfe, 0x1f, 0x42, 0x8b, 0x81, 0x22,
+	0x06, 0x3d, 0xc1, 0xc9, 0xf7, 0xa1, 0x4c, 0x5d, 0x3b, 0x92, 0x1e, 0x28, 0x24, 0x1f, 0xf5, 0x74,
+	0xa7, 0xbf, 0xe3, 0x89, 0x29, 0xae, 0xd3, 0x91, 0xac, 0xaa, 0xf3, 0x24, 0xa7, 0xbe, 0xa9, 0xa0,
+	0x1f, 0x42, 0x93, 0x4d, 0x1e, 0x72, 0xe3, 0x49, 0x52, 0xde, 0x87, 0x85, 0x04, 0xe5, 0x4f, 0x03,
+	0xc5, 0x4d, 0xe5, 0xff, 0x78, 0x74, 0xff, 0x39, 0x7d, 0x54, 0x93, 0xbe, 0x36, 0x86, 0xd6, 0xcf,
+	0x77, 0xf7, 0xad, 0xbb, 0x91, 0xbb, 0x7f, 0x84, 0xf9, 0xc7, 0xa0, 0xa6, 0x8f, 0x0a, 0xd1, 0x8d,
+	0x49, 0xbe, 0x44, 0x86, 0x73, 0x8a, 0x23, 0xf9, 0x3e, 0x54, 0x58, 0x8d, 0x58, 0x6e, 0x80, 0x42,
+	0xfd, 0x78, 0xca, 0x5c, 0x77, 0x5f, 0xfb, 0x64, 0x73, 0x60, 0x05, 0x47, 0xe3, 0x03, 0xd2, 0xb2,
+	0xc1, 0xba, 0xbe, 0x62, 0xb9, 0xfc, 0xd7, 0x46, 0x28, 0xcb, 0x0d, 0x3a, 0x7a, 0x83, 0x22, 0x18,
+	0x1d, 0x1c, 0x54, 0xe8, 0xe7, 0xad, 0xff, 0x1d, 0x00, 0x78, 0x03, 0xdd, 0x0e, 0x31, 0x54, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,6 +28,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
@@ -72,11 +73,19 @@ func NewMinioHandler(ctx context.Context, cfg *paramtable.MinioConfig, rootPath
 		rootPath = rootPath + "/"
 	}
 
+	accessKeyID, err := storage.ResolveSecret(ctx, cfg.AccessKeyID.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := storage.ResolveSecret(ctx, cfg.SecretAccessKey.GetValue())
+	if err != nil {
+		return nil, err
+	}
 	handlerCfg := config{
 		address:           cfg.Address.GetValue(),
 		bucketName:        cfg.BucketName.GetValue(),
-		accessKeyID:       cfg.AccessKeyID.GetValue(),
-		secretAccessKeyID: cfg.SecretAccessKey.GetValue(),
+		accessKeyID:       accessKeyID,
+		secretAccessKeyID: secretAccessKey,
 		useSSL:            cfg.UseSSL.GetAsBool(),
 		createBucket:      true,
 		useIAM:            cfg.UseIAM.GetAsBool(),
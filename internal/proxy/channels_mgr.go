@@ -183,6 +183,12 @@ func createStream(factory msgstream.Factory, pchans []pChan, repack repackFuncTy
 		return nil, err
 	}
 
+	if paramtable.Get().MQCfg.ProducerFencing.GetAsBool() {
+		// a standby proxy taking over gets a larger node id, which fences the stale one
+		if !msgstream.SetProducerEpoch(stream, paramtable.GetNodeID()) {
+			log.Warn("producer fencing is not supported by the msgstream", zap.Strings("pchans", pchans))
+		}
+	}
 	stream.AsProducer(pchans)
 	if repack != nil {
 		stream.SetRepackFunc(repack)
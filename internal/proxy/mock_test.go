@@ -296,6 +296,10 @@ func (ms *simpleMockMsgStream) Seek(ctx context.Context, offset []*msgstream.Msg
 	return nil
 }
 
+func (ms *simpleMockMsgStream) SeekByTime(ctx context.Context, channel string, ts Timestamp) error {
+	return nil
+}
+
 func (ms *simpleMockMsgStream) GetLatestMsgID(channel string) (msgstream.MessageID, error) {
 	return nil, nil
 }
@@ -405,7 +405,7 @@ func (dt *deleteTask) produce(ctx context.Context, stream msgstream.MsgStream, p
 		zap.Int64("taskID", dt.ID()),
 		zap.Duration("prepare duration", dt.tr.RecordSpan()))
 
-	err := stream.Produce(msgPack)
+	err := wrapProduceError(stream.Produce(msgPack))
 	if err != nil {
 		return err
 	}
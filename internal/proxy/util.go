@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
@@ -1499,3 +1500,11 @@ func checkDynamicFieldData(schema *schemapb.CollectionSchema, insertMsg *msgstre
 	insertMsg.FieldsData = append(insertMsg.FieldsData, dynamicData)
 	return nil
 }
+
+// wrapProduceError converts the backpressure of mq into rate limit error, so that the clients back off and retry
+func wrapProduceError(err error) error {
+	if retryAfter, ok := mqwrapper.RetryAfter(err); ok {
+		return errors.Wrapf(merr.ErrServiceRateLimit, "mq backpressure, retry after %s: %s", retryAfter, err.Error())
+	}
+	return err
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -2051,3 +2052,14 @@ func Test_validateMaxCapacityPerRow(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestWrapProduceError(t *testing.T) {
+	assert.NoError(t, wrapProduceError(nil))
+
+	err := errors.New("mock error")
+	assert.Equal(t, err, wrapProduceError(err))
+
+	err = wrapProduceError(mqwrapper.NewBackpressureError(err, time.Second))
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, merr.Status(err).GetErrorCode())
+}
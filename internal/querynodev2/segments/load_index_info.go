@@ -71,6 +71,13 @@ func (li *LoadIndexInfo) appendLoadIndexInfo(indexInfo *querypb.FieldIndexInfo,
 		return err
 	}
 
+	if indexInfo.GetStorageEndpoint() != "" {
+		err = li.appendStorageInfo(indexInfo.GetStorageEndpoint(), indexInfo.GetStorageRequesterPays())
+		if err != nil {
+			return err
+		}
+	}
+
 	// some build params also exist in indexParams, which are useless during loading process
 	indexParams := funcutil.KeyValuePair2Map(indexInfo.IndexParams)
 	if indexParams["index_type"] == indexparamcheck.IndexDISKANN {
@@ -110,6 +117,15 @@ func (li *LoadIndexInfo) appendIndexInfo(indexID int64, buildID int64, indexVers
 	return HandleCStatus(&status, "AppendIndexInfo failed")
 }
 
+// appendStorageInfo sets the object storage of the collection the index files are loaded from
+func (li *LoadIndexInfo) appendStorageInfo(address string, requesterPays bool) error {
+	cAddress := C.CString(address)
+	defer C.free(unsafe.Pointer(cAddress))
+
+	status := C.AppendStorageInfo(li.cLoadIndexInfo, cAddress, C.bool(requesterPays))
+	return HandleCStatus(&status, "AppendStorageInfo failed")
+}
+
 func (li *LoadIndexInfo) cleanLocalData() error {
 	status := C.CleanLoadedIndex(li.cLoadIndexInfo)
 	return HandleCStatus(&status, "failed to clean cached data on disk")
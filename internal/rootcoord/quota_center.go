@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -82,6 +83,7 @@ type collectionStates = map[milvuspb.QuotaState]commonpb.ErrorCode
 //  5. DQL queue latency protection ->  dqlRate = curDQLRate * CoolOffSpeed
 //  6. Search result protection ->	 	searchRate = curSearchRate * CoolOffSpeed
 //  7. GrowingSegsSize protection ->    dmlRate = maxDMLRate * (high - cur) / (high - low)
+//  8. Embedded MQ protection ->        force deny writing if the embedded pebblemq is throttled
 //
 // If necessary, user can also manually force to deny RW requests.
 type QuotaCenter struct {
@@ -108,6 +110,9 @@ type QuotaCenter struct {
 
 	rateAllocateStrategy RateAllocateStrategy
 
+	// mqHealths is the health pushed by the embedded pebblemq by server id
+	mqHealths *typeutil.ConcurrentMap[int64, *sessionutil.NodeHealth]
+
 	stopOnce sync.Once
 	stopChan chan struct{}
 }
@@ -126,10 +131,41 @@ func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoord, da
 		writableCollections: make([]int64, 0),
 
 		rateAllocateStrategy: DefaultRateAllocateStrategy,
+		mqHealths:            typeutil.NewConcurrentMap[int64, *sessionutil.NodeHealth](),
 		stopChan:             make(chan struct{}),
 	}
 }
 
+// watchMQHealth keeps the health pushed by the embedded pebblemq up to date, the writes are denied while it's
+// throttled. The healths are dropped if the watch stops, so the writes are never denied by a stale health.
+func (q *QuotaCenter) watchMQHealth(session *sessionutil.Session) error {
+	healths, revision, err := session.GetNodeHealths(sessionutil.MQHealthServerName)
+	if err != nil {
+		return err
+	}
+	for serverID, health := range healths {
+		q.mqHealths.Insert(serverID, health)
+	}
+	eventCh := session.WatchNodeHealth(sessionutil.MQHealthServerName, revision+1)
+	go func() {
+		defer func() {
+			q.mqHealths.Range(func(serverID int64, _ *sessionutil.NodeHealth) bool {
+				q.mqHealths.Remove(serverID)
+				return true
+			})
+		}()
+		for event := range eventCh {
+			if event.Health == nil {
+				q.mqHealths.Remove(event.ServerID)
+				continue
+			}
+			q.mqHealths.Insert(event.ServerID, event.Health)
+		}
+		log.Warn("QuotaCenter watch MQ health stopped")
+	}()
+	return nil
+}
+
 // run starts the service of QuotaCenter.
 func (q *QuotaCenter) run() {
 	log.Info("Start QuotaCenter", zap.Float64("collectInterval/s", Params.QuotaConfig.QuotaCenterCollectInterval.GetAsFloat()))
@@ -429,6 +465,7 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	}
 
 	q.checkDiskQuota()
+	q.checkMQHealth()
 
 	ts, err := q.tsoAllocator.GenerateTSO(1)
 	if err != nil {
@@ -789,6 +826,26 @@ func (q *QuotaCenter) checkDiskQuota() {
 	q.totalBinlogSize = total
 }
 
+// checkMQHealth denies the writes while the embedded pebblemq is throttled, i.e. it's rejecting or delaying the
+// produces since it's running out of the disk or the writes are stalled.
+func (q *QuotaCenter) checkMQHealth() {
+	q.mqHealths.Range(func(serverID int64, health *sessionutil.NodeHealth) bool {
+		if !health.Throttled {
+			return true
+		}
+		log.RatedWarn(10, "embedded MQ is throttled",
+			zap.Int64("serverID", serverID),
+			zap.Float64("disk watermark", health.DiskWatermark),
+			zap.Int64("inflight produces", health.QueueDepth))
+		if health.DiskWatermark >= 1 {
+			q.forceDenyWriting(commonpb.ErrorCode_DiskQuotaExhausted)
+		} else {
+			q.forceDenyWriting(commonpb.ErrorCode_RateLimit)
+		}
+		return false
+	})
+}
+
 // setRates notifies Proxies to set rates for different rate types.
 func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -537,6 +538,31 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, colQuotaBackup)
 	})
 
+	t.Run("test checkMQHealth", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoord(t)
+		meta := mockrootcoord.NewIMetaTable(t)
+		quotaCenter := NewQuotaCenter(pcm, qc, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.writableCollections = []int64{1, 2}
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.mqHealths.Insert(1, &sessionutil.NodeHealth{ServerID: 1})
+		quotaCenter.checkMQHealth()
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[1][internalpb.RateType_DMLInsert])
+
+		quotaCenter.mqHealths.Insert(1, &sessionutil.NodeHealth{ServerID: 1, Throttled: true, DiskWatermark: 0.5})
+		quotaCenter.checkMQHealth()
+		for _, collection := range quotaCenter.writableCollections {
+			assert.Equal(t, Limit(0), quotaCenter.currentRates[collection][internalpb.RateType_DMLInsert])
+			assert.Equal(t, commonpb.ErrorCode_RateLimit, quotaCenter.quotaStates[collection][milvuspb.QuotaState_DenyToWrite])
+		}
+
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.mqHealths.Insert(1, &sessionutil.NodeHealth{ServerID: 1, Throttled: true, DiskWatermark: 1})
+		quotaCenter.checkMQHealth()
+		for _, collection := range quotaCenter.writableCollections {
+			assert.Equal(t, commonpb.ErrorCode_DiskQuotaExhausted, quotaCenter.quotaStates[collection][milvuspb.QuotaState_DenyToWrite])
+		}
+	})
+
 	t.Run("test setRates", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoord(t)
 		p1 := mocks.NewMockProxy(t)
@@ -660,6 +660,9 @@ func (c *Core) startInternal() error {
 	}
 
 	if Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
+		if err := c.quotaCenter.watchMQHealth(c.session); err != nil {
+			log.Warn("QuotaCenter failed to watch MQ health", zap.Error(err))
+		}
 		go c.quotaCenter.run()
 	}
 
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// ArchiveState is the state of an object regarding the archival storage class
+type ArchiveState int32
+
+const (
+	// ArchiveStateStandard means the object is readable in a non-archival storage class
+	ArchiveStateStandard ArchiveState = iota
+	// ArchiveStateArchived means the object is in the archival storage class, it must be restored before reading
+	ArchiveStateArchived
+	// ArchiveStateRestoring means the restore of the archived object is in progress
+	ArchiveStateRestoring
+	// ArchiveStateRestored means a temporary copy of the archived object is readable
+	ArchiveStateRestored
+)
+
+func (s ArchiveState) String() string {
+	switch s {
+	case ArchiveStateStandard:
+		return "Standard"
+	case ArchiveStateArchived:
+		return "Archived"
+	case ArchiveStateRestoring:
+		return "Restoring"
+	case ArchiveStateRestored:
+		return "Restored"
+	default:
+		return fmt.Sprintf("ArchiveState(%d)", int32(s))
+	}
+}
+
+// Archival storage classes of s3, the objects of them must be restored before reading
+const (
+	StorageClassGlacier     = "GLACIER"
+	StorageClassDeepArchive = "DEEP_ARCHIVE"
+
+	storageClassStandard  = "STANDARD"
+	storageClassMetaKey   = "X-Amz-Storage-Class"
+	restoreInProgressCode = "RestoreAlreadyInProgress"
+)
+
+// restoredCopyDays is the days the temporary copy of a restored object is kept,
+// it only needs to last until the copy is moved into the standard storage class
+const restoredCopyDays = 3
+
+// archiveStorage is the ObjectStorage able to move objects into the archival storage class and back
+type archiveStorage interface {
+	// ArchiveObject moves the object into the archival storage class
+	ArchiveObject(ctx context.Context, bucketName, objectName string) error
+	// UnarchiveObject moves the archived object one step towards the standard storage class,
+	// it may take several calls as the restore is asynchronous
+	UnarchiveObject(ctx context.Context, bucketName, objectName string) error
+	// ArchiveStateOfObject returns the archive state of the object
+	ArchiveStateOfObject(ctx context.Context, bucketName, objectName string) (ArchiveState, error)
+}
+
+// ValidateArchiveStorageClass checks whether storageClass is an archival storage class of s3
+func ValidateArchiveStorageClass(storageClass string) error {
+	switch storageClass {
+	case "", StorageClassGlacier, StorageClassDeepArchive:
+		return nil
+	default:
+		return fmt.Errorf("invalid archive storage class %s, should be one of %s, %s", storageClass, StorageClassGlacier, StorageClassDeepArchive)
+	}
+}
+
+func archiveStorageClassOrDefault(storageClass string) string {
+	if storageClass == "" {
+		return StorageClassGlacier
+	}
+	return storageClass
+}
+
+func isArchiveStorageClass(storageClass string) bool {
+	return strings.EqualFold(storageClass, StorageClassGlacier) || strings.EqualFold(storageClass, StorageClassDeepArchive)
+}
+
+// archiveStateOfObjectInfo returns the archive state by the storage class and the restore status of the object
+func archiveStateOfObjectInfo(info minio.ObjectInfo) ArchiveState {
+	if !isArchiveStorageClass(info.StorageClass) {
+		return ArchiveStateStandard
+	}
+	if info.Restore == nil {
+		return ArchiveStateArchived
+	}
+	if info.Restore.OngoingRestore {
+		return ArchiveStateRestoring
+	}
+	return ArchiveStateRestored
+}
+
+// copyMinioObjectToStorageClass copies the object onto itself in storageClass, the user metadata and tags are kept
+func copyMinioObjectToStorageClass(ctx context.Context, client *minio.Client, bucketName, objectName string,
+	info minio.ObjectInfo, sse encrypt.ServerSide, storageClass string,
+) error {
+	metadata := make(map[string]string, len(info.UserMetadata)+1)
+	for k, v := range info.UserMetadata {
+		metadata[k] = v
+	}
+	metadata[storageClassMetaKey] = storageClass
+	src := minio.CopySrcOptions{Bucket: bucketName, Object: objectName}
+	if sse != nil && sse.Type() == encrypt.SSEC {
+		src.Encryption = encrypt.SSECopy(sse)
+	}
+	_, err := client.CopyObject(ctx, minio.CopyDestOptions{
+		Bucket:          bucketName,
+		Object:          objectName,
+		Encryption:      sse,
+		UserMetadata:    metadata,
+		ReplaceMetadata: true,
+	}, src)
+	return err
+}
+
+// unarchiveMinioObject requests a temporary copy of the archived object, and copies the restored object into
+// the standard storage class, nothing is done if the object is in the standard storage class or being restored
+func unarchiveMinioObject(ctx context.Context, client *minio.Client, bucketName, objectName string,
+	info minio.ObjectInfo, sse encrypt.ServerSide,
+) error {
+	switch archiveStateOfObjectInfo(info) {
+	case ArchiveStateArchived:
+		req := minio.RestoreRequest{}
+		req.SetDays(restoredCopyDays)
+		req.SetGlacierJobParameters(minio.GlacierJobParameters{Tier: minio.TierStandard})
+		err := client.RestoreObject(ctx, bucketName, objectName, "", req)
+		if err != nil && minio.ToErrorResponse(err).Code == restoreInProgressCode {
+			return nil
+		}
+		return err
+	case ArchiveStateRestored:
+		return copyMinioObjectToStorageClass(ctx, client, bucketName, objectName, info, sse, storageClassStandard)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	minio "github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// archivingObjectStorage restores the archived objects after two unarchive calls, like the async restore of s3
+type archivingObjectStorage struct {
+	*countingObjectStorage
+	mu     sync.Mutex
+	states map[string]ArchiveState
+}
+
+func newArchivingObjectStorage() *archivingObjectStorage {
+	return &archivingObjectStorage{countingObjectStorage: newCountingObjectStorage(), states: map[string]ArchiveState{}}
+}
+
+func (s *archivingObjectStorage) ArchiveObject(ctx context.Context, bucketName, objectName string) error {
+	if _, err := s.StatObject(ctx, bucketName, objectName); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.states[objectName] == ArchiveStateStandard {
+		s.states[objectName] = ArchiveStateArchived
+	}
+	return nil
+}
+
+func (s *archivingObjectStorage) UnarchiveObject(ctx context.Context, bucketName, objectName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch s.states[objectName] {
+	case ArchiveStateArchived:
+		s.states[objectName] = ArchiveStateRestoring
+	case ArchiveStateRestoring:
+		s.states[objectName] = ArchiveStateRestored
+	case ArchiveStateRestored:
+		s.states[objectName] = ArchiveStateStandard
+	}
+	return nil
+}
+
+func (s *archivingObjectStorage) ArchiveStateOfObject(ctx context.Context, bucketName, objectName string) (ArchiveState, error) {
+	if _, err := s.StatObject(ctx, bucketName, objectName); err != nil {
+		return ArchiveStateStandard, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.states[objectName], nil
+}
+
+func TestRemoteChunkManagerArchive(t *testing.T) {
+	ctx := context.Background()
+	backend := newArchivingObjectStorage()
+	testCM, err := newRemoteChunkManagerWithBackend(backend, &config{bucketName: "bucket"})
+	require.NoError(t, err)
+
+	keys := []string{"key1", "key2"}
+	for _, key := range keys {
+		require.NoError(t, testCM.Write(ctx, key, []byte("value")))
+	}
+
+	states, err := testCM.ArchiveStates(ctx, keys)
+	assert.NoError(t, err)
+	assert.Equal(t, []ArchiveState{ArchiveStateStandard, ArchiveStateStandard}, states)
+
+	assert.NoError(t, testCM.Archive(ctx, keys))
+	states, err = testCM.ArchiveStates(ctx, keys)
+	assert.NoError(t, err)
+	assert.Equal(t, []ArchiveState{ArchiveStateArchived, ArchiveStateArchived}, states)
+
+	for _, expected := range []ArchiveState{ArchiveStateRestoring, ArchiveStateRestored, ArchiveStateStandard} {
+		assert.NoError(t, testCM.Restore(ctx, keys))
+		states, err = testCM.ArchiveStates(ctx, keys)
+		assert.NoError(t, err)
+		assert.Equal(t, []ArchiveState{expected, expected}, states)
+	}
+
+	assert.Error(t, testCM.Archive(ctx, []string{"not_exist"}))
+	_, err = testCM.ArchiveStates(ctx, []string{"not_exist"})
+	assert.Error(t, err)
+}
+
+func TestRemoteChunkManagerArchiveNotSupported(t *testing.T) {
+	ctx := context.Background()
+	testCM, err := newRemoteChunkManagerWithBackend(newCountingObjectStorage(), &config{bucketName: "bucket"})
+	require.NoError(t, err)
+
+	assert.Error(t, testCM.Archive(ctx, []string{"key"}))
+	assert.Error(t, testCM.Restore(ctx, []string{"key"}))
+	_, err = testCM.ArchiveStates(ctx, []string{"key"})
+	assert.Error(t, err)
+}
+
+func TestArchiveStateOfObjectInfo(t *testing.T) {
+	assert.Equal(t, ArchiveStateStandard, archiveStateOfObjectInfo(minio.ObjectInfo{}))
+	assert.Equal(t, ArchiveStateStandard, archiveStateOfObjectInfo(minio.ObjectInfo{StorageClass: "STANDARD_IA"}))
+	assert.Equal(t, ArchiveStateArchived, archiveStateOfObjectInfo(minio.ObjectInfo{StorageClass: StorageClassGlacier}))
+	assert.Equal(t, ArchiveStateArchived, archiveStateOfObjectInfo(minio.ObjectInfo{StorageClass: StorageClassDeepArchive}))
+	assert.Equal(t, ArchiveStateRestoring, archiveStateOfObjectInfo(minio.ObjectInfo{
+		StorageClass: StorageClassGlacier,
+		Restore:      &minio.RestoreInfo{OngoingRestore: true},
+	}))
+	assert.Equal(t, ArchiveStateRestored, archiveStateOfObjectInfo(minio.ObjectInfo{
+		StorageClass: StorageClassGlacier,
+		Restore:      &minio.RestoreInfo{OngoingRestore: false},
+	}))
+}
+
+func TestValidateArchiveStorageClass(t *testing.T) {
+	assert.NoError(t, ValidateArchiveStorageClass(""))
+	assert.NoError(t, ValidateArchiveStorageClass(StorageClassGlacier))
+	assert.NoError(t, ValidateArchiveStorageClass(StorageClassDeepArchive))
+	assert.Error(t, ValidateArchiveStorageClass("STANDARD"))
+	assert.Equal(t, StorageClassGlacier, archiveStorageClassOrDefault(""))
+	assert.Equal(t, StorageClassDeepArchive, archiveStorageClassOrDefault(StorageClassDeepArchive))
+}
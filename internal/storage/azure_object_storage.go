@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -29,7 +30,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -39,26 +42,7 @@ type AzureObjectStorage struct {
 }
 
 func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObjectStorage, error) {
-	var client *service.Client
-	var err error
-	if c.useIAM {
-		cred, credErr := azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
-			ClientID:      os.Getenv("AZURE_CLIENT_ID"),
-			TenantID:      os.Getenv("AZURE_TENANT_ID"),
-			TokenFilePath: os.Getenv("AZURE_FEDERATED_TOKEN_FILE"),
-		})
-		if credErr != nil {
-			return nil, credErr
-		}
-		client, err = service.NewClient("https://"+c.accessKeyID+".blob."+c.address+"/", cred, &service.ClientOptions{})
-	} else {
-		connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING")
-		if connectionString == "" {
-			connectionString = "DefaultEndpointsProtocol=https;AccountName=" + c.accessKeyID +
-				";AccountKey=" + c.secretAccessKeyID + ";EndpointSuffix=" + c.address
-		}
-		client, err = service.NewClientFromConnectionString(connectionString, &service.ClientOptions{})
-	}
+	client, err := newAzureServiceClient(c)
 	if err != nil {
 		return nil, err
 	}
@@ -89,18 +73,79 @@ func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObje
 	return &AzureObjectStorage{Client: client}, nil
 }
 
+// newAzureServiceClient creates the blob service client, the credential is chosen by:
+// 1. useIAM, workload identity if the federated token file is provided, otherwise managed identity
+// 2. SAS token, from config or AZURE_STORAGE_SAS_TOKEN
+// 3. connection string, from AZURE_STORAGE_CONNECTION_STRING or built with the account key
+func newAzureServiceClient(c *config) (*service.Client, error) {
+	serviceURL := "https://" + c.accessKeyID + ".blob." + c.address + "/"
+	if c.useIAM {
+		var cred azcore.TokenCredential
+		var err error
+		if tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE"); tokenFile != "" {
+			cred, err = azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+				ClientID:      os.Getenv("AZURE_CLIENT_ID"),
+				TenantID:      os.Getenv("AZURE_TENANT_ID"),
+				TokenFilePath: tokenFile,
+			})
+		} else {
+			opts := &azidentity.ManagedIdentityCredentialOptions{}
+			// system-assigned identity is used if no client id is given
+			if clientID := os.Getenv("AZURE_CLIENT_ID"); clientID != "" {
+				opts.ID = azidentity.ClientID(clientID)
+			}
+			cred, err = azidentity.NewManagedIdentityCredential(opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return service.NewClient(serviceURL, cred, &service.ClientOptions{})
+	}
+
+	sasToken := c.sasToken
+	if sasToken == "" {
+		sasToken = os.Getenv("AZURE_STORAGE_SAS_TOKEN")
+	}
+	if sasToken != "" {
+		return service.NewClientWithNoCredential(serviceURL+"?"+strings.TrimPrefix(sasToken, "?"), &service.ClientOptions{})
+	}
+
+	connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING")
+	if connectionString == "" {
+		connectionString = "DefaultEndpointsProtocol=https;AccountName=" + c.accessKeyID +
+			";AccountKey=" + c.secretAccessKeyID + ";EndpointSuffix=" + c.address
+	}
+	return service.NewClientFromConnectionString(connectionString, &service.ClientOptions{})
+}
+
 func (AzureObjectStorage *AzureObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
 	opts := azblob.DownloadStreamOptions{}
-	if offset > 0 {
+	if offset > 0 || size > 0 {
 		opts.Range = azblob.HTTPRange{
 			Offset: offset,
 			Count:  size,
 		}
 	}
+	pin := objectPinFrom(ctx)
+	if pin != nil && pin.get() != "" {
+		etag := azcore.ETag(pin.get())
+		opts.AccessConditions = &blob.AccessConditions{
+			ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfMatch: &etag},
+		}
+	}
 	object, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).DownloadStream(ctx, &opts)
 	if err != nil {
+		if bloberror.HasCode(err, bloberror.ConditionNotMet) {
+			return nil, errors.Wrap(errObjectChanged, err.Error())
+		}
 		return nil, err
 	}
+	if pin != nil && object.ETag != nil {
+		if err := pin.check(string(*object.ETag)); err != nil {
+			object.Body.Close()
+			return nil, err
+		}
+	}
 	return object.Body, nil
 }
 
@@ -117,6 +162,45 @@ func (AzureObjectStorage *AzureObjectStorage) StatObject(ctx context.Context, bu
 	return 0, err
 }
 
+// PresignGetObject returns the url with a read-only SAS of the blob, which requires the shared key credential
+func (AzureObjectStorage *AzureObjectStorage) PresignGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	blobClient := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlobClient(objectName)
+	return blobClient.GetSASURL(sas.BlobPermissions{Read: true}, time.Now().Add(expiry), nil)
+}
+
+// ArchiveObject moves the blob to the archive tier
+func (AzureObjectStorage *AzureObjectStorage) ArchiveObject(ctx context.Context, bucketName, objectName string) error {
+	blobClient := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlobClient(objectName)
+	_, err := blobClient.SetTier(ctx, blob.AccessTierArchive, &blob.SetTierOptions{})
+	return err
+}
+
+// UnarchiveObject rehydrates the archived blob to the hot tier, which completes asynchronously
+func (AzureObjectStorage *AzureObjectStorage) UnarchiveObject(ctx context.Context, bucketName, objectName string) error {
+	state, err := AzureObjectStorage.ArchiveStateOfObject(ctx, bucketName, objectName)
+	if err != nil || state != ArchiveStateArchived {
+		return err
+	}
+	blobClient := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlobClient(objectName)
+	priority := blob.RehydratePriorityStandard
+	_, err = blobClient.SetTier(ctx, blob.AccessTierHot, &blob.SetTierOptions{RehydratePriority: &priority})
+	return err
+}
+
+func (AzureObjectStorage *AzureObjectStorage) ArchiveStateOfObject(ctx context.Context, bucketName, objectName string) (ArchiveState, error) {
+	info, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlobClient(objectName).GetProperties(ctx, &blob.GetPropertiesOptions{})
+	if err != nil {
+		return ArchiveStateStandard, err
+	}
+	if info.ArchiveStatus != nil && strings.HasPrefix(*info.ArchiveStatus, "rehydrate-pending") {
+		return ArchiveStateRestoring, nil
+	}
+	if info.AccessTier != nil && *info.AccessTier == string(blob.AccessTierArchive) {
+		return ArchiveStateArchived, nil
+	}
+	return ArchiveStateStandard, nil
+}
+
 func (AzureObjectStorage *AzureObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
 	pager := AzureObjectStorage.Client.NewContainerClient(bucketName).NewListBlobsFlatPager(&azblob.ListBlobsFlatOptions{
 		Prefix: &prefix,
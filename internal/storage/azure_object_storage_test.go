@@ -162,4 +162,36 @@ func TestAzureObjectStorage(t *testing.T) {
 		assert.Error(t, err)
 		os.Setenv("AZURE_STORAGE_CONNECTION_STRING", connectionString)
 	})
+	t.Run("test sas token", func(t *testing.T) {
+		// config shadows the type in this test, so the subtests take a copy of it
+		cfg := config
+		cfg.accessKeyID = "devstoreaccount1"
+		cfg.address = "core.windows.net"
+		cfg.useIAM = false
+		cfg.sasToken = "?sv=2022-11-02&ss=b&srt=co&sp=rwdl&sig=signature"
+		client, err := newAzureServiceClient(&cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net/?sv=2022-11-02&ss=b&srt=co&sp=rwdl&sig=signature", client.URL())
+
+		cfg.sasToken = ""
+		os.Setenv("AZURE_STORAGE_SAS_TOKEN", "sv=2022-11-02&sig=env")
+		defer os.Unsetenv("AZURE_STORAGE_SAS_TOKEN")
+		client, err = newAzureServiceClient(&cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net/?sv=2022-11-02&sig=env", client.URL())
+	})
+
+	t.Run("test managed identity", func(t *testing.T) {
+		tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+		os.Unsetenv("AZURE_FEDERATED_TOKEN_FILE")
+		defer os.Setenv("AZURE_FEDERATED_TOKEN_FILE", tokenFile)
+		cfg := config
+		cfg.accessKeyID = "devstoreaccount1"
+		cfg.address = "core.windows.net"
+		cfg.useIAM = true
+		cfg.sasToken = ""
+		client, err := newAzureServiceClient(&cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net/", client.URL())
+	})
 }
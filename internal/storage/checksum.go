@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+const ChecksumMismatch = "ChecksumMismatch"
+
+// ErrChecksumMismatch means the object is corrupted, it's distinct from ErrNoSuchKey
+var ErrChecksumMismatch = errors.New(ChecksumMismatch)
+
+func WrapErrChecksumMismatch(key, expected, actual string) error {
+	return fmt.Errorf("%w(key=%s, expected=%s, actual=%s)", ErrChecksumMismatch, key, expected, actual)
+}
+
+func IsErrChecksumMismatch(err error) bool {
+	return errors.Is(err, ErrChecksumMismatch)
+}
+
+// checksumMetaKey is the user metadata keeping the CRC32C checksum of the content written by milvus.
+// The checksum is verified by Read, Reader and ReadAt of the chunk managers, the objects read by
+// the C++ segcore through its own chunk manager, e.g. the index files loaded by QueryNode, are not verified.
+const checksumMetaKey = "Milvus-Crc32c"
+
+const (
+	// minChecksumBlockSize is the minimum size of the blocks checksummed for the ranged reads of ReadAt
+	minChecksumBlockSize = 64 << 10
+	// maxChecksumBlocks bounds the block checksums kept in the metadata, which is limited to 2KB by S3
+	maxChecksumBlocks = 160
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksum returns the hex encoded CRC32C of data
+func checksum(data []byte) string {
+	return fmt.Sprintf("%08x", crc32.Checksum(data, castagnoliTable))
+}
+
+// checksumOfMetadata returns the checksum kept in the user metadata, the keys are case-insensitive as http headers
+func checksumOfMetadata(metadata map[string]string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, checksumMetaKey) {
+			return v
+		}
+	}
+	return ""
+}
+
+// checksumBlockSize returns the size of the checksummed blocks of an object,
+// the blocks grow with the object to keep their checksums within maxChecksumBlocks
+func checksumBlockSize(size int64) int64 {
+	blockSize := (size + maxChecksumBlocks - 1) / maxChecksumBlocks
+	if blockSize < minChecksumBlockSize {
+		return minChecksumBlockSize
+	}
+	return (blockSize + minChecksumBlockSize - 1) / minChecksumBlockSize * minChecksumBlockSize
+}
+
+// objectChecksum returns the checksum kept for data, the CRC32C of the whole content is followed by
+// the block size and the CRC32C of each block, e.g. "e3069283:65536:e3069283"
+func objectChecksum(data []byte) string {
+	blockSize := checksumBlockSize(int64(len(data)))
+	var sb strings.Builder
+	sb.WriteString(checksum(data))
+	sb.WriteString(":")
+	sb.WriteString(strconv.FormatInt(blockSize, 10))
+	sb.WriteString(":")
+	for start := int64(0); start < int64(len(data)); start += blockSize {
+		end := start + blockSize
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		sb.WriteString(checksum(data[start:end]))
+	}
+	return sb.String()
+}
+
+// parseChecksum splits the kept checksum into the checksum of the whole content and the block checksums,
+// the checksums written by the older versions have no blocks
+func parseChecksum(expected string) (string, int64, []string) {
+	parts := strings.Split(expected, ":")
+	if len(parts) != 3 {
+		return parts[0], 0, nil
+	}
+	blockSize, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || blockSize <= 0 || len(parts[2])%8 != 0 {
+		return parts[0], 0, nil
+	}
+	blocks := make([]string, 0, len(parts[2])/8)
+	for i := 0; i < len(parts[2]); i += 8 {
+		blocks = append(blocks, parts[2][i:i+8])
+	}
+	return parts[0], blockSize, blocks
+}
+
+func checksumMismatch(key, expected, actual string) error {
+	metrics.PersistentDataCorruptionCounter.Inc()
+	log.Warn("object checksum mismatch", zap.String("path", key), zap.String("expected", expected), zap.String("actual", actual))
+	return WrapErrChecksumMismatch(key, expected, actual)
+}
+
+// verifyChecksum checks data against the expected checksum, the objects without checksum,
+// e.g. written by the older versions or the other components, are not verified
+func verifyChecksum(key string, data []byte, expected string) error {
+	expected, _, _ = parseChecksum(expected)
+	if expected == "" {
+		return nil
+	}
+	if actual := checksum(data); actual != expected {
+		return checksumMismatch(key, expected, actual)
+	}
+	return nil
+}
+
+// checksumReader verifies the content streamed from the object once it's read to the end
+type checksumReader struct {
+	FileReader
+	key      string
+	expected string
+	hash     hash.Hash32
+}
+
+// newChecksumReader wraps reader to verify the content against the expected checksum
+func newChecksumReader(key string, reader FileReader, expected string) FileReader {
+	expected, _, _ = parseChecksum(expected)
+	if expected == "" {
+		return reader
+	}
+	return &checksumReader{
+		FileReader: reader,
+		key:        key,
+		expected:   expected,
+		hash:       crc32.New(castagnoliTable),
+	}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.FileReader.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := fmt.Sprintf("%08x", r.hash.Sum32()); actual != r.expected {
+			return n, checksumMismatch(r.key, r.expected, actual)
+		}
+	}
+	return n, err
+}
+
+// readAtWithChecksum reads [off, off+length) of the object by read, the range is widened to the checksummed blocks
+// to verify them. The objects without block checksums, e.g. written by the older versions, are not verified.
+func readAtWithChecksum(ctx context.Context, key string, size int64, expected string, off int64, length int64,
+	read func(ctx context.Context, off int64, length int64) ([]byte, error),
+) ([]byte, error) {
+	_, blockSize, blocks := parseChecksum(expected)
+	if blockSize <= 0 || length == 0 || off+length > size || int64(len(blocks)) != (size+blockSize-1)/blockSize {
+		return read(ctx, off, length)
+	}
+	start := off / blockSize * blockSize
+	end := (off + length + blockSize - 1) / blockSize * blockSize
+	if end > size {
+		end = size
+	}
+	data, err := read(ctx, start, end-start)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != end-start {
+		return nil, io.ErrUnexpectedEOF
+	}
+	for blockStart := start; blockStart < end; blockStart += blockSize {
+		blockEnd := blockStart + blockSize
+		if blockEnd > end {
+			blockEnd = end
+		}
+		expected := blocks[blockStart/blockSize]
+		if actual := checksum(data[blockStart-start : blockEnd-start]); actual != expected {
+			return nil, checksumMismatch(key, expected, actual)
+		}
+	}
+	return data[off-start : off-start+length], nil
+}
+
+// checksumStorage is the ObjectStorage keeping the checksum of objects in their metadata
+type checksumStorage interface {
+	PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error
+	// StatObjectWithChecksum returns the size and the checksum of the object, the checksum is empty if it's not kept
+	StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error)
+}
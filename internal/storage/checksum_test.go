@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memChecksumStorage is the in-memory ObjectStorage keeping the checksum of objects
+type memChecksumStorage struct {
+	objects   map[string][]byte
+	checksums map[string]string
+}
+
+func (s *memChecksumStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	data, ok := s.objects[objectName]
+	if !ok {
+		return nil, WrapErrNoSuchKey(objectName)
+	}
+	if size == 0 {
+		size = int64(len(data)) - offset
+	}
+	return io.NopCloser(bytes.NewReader(data[offset : offset+size])), nil
+}
+
+func (s *memChecksumStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	return s.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, "")
+}
+
+func (s *memChecksumStorage) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s.objects[objectName] = data
+	s.checksums[objectName] = checksum
+	return nil
+}
+
+func (s *memChecksumStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	size, _, err := s.StatObjectWithChecksum(ctx, bucketName, objectName)
+	return size, err
+}
+
+func (s *memChecksumStorage) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	data, ok := s.objects[objectName]
+	if !ok {
+		return 0, "", WrapErrNoSuchKey(objectName)
+	}
+	return int64(len(data)), s.checksums[objectName], nil
+}
+
+func (s *memChecksumStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	return nil, nil
+}
+
+func (s *memChecksumStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	delete(s.objects, objectName)
+	return nil
+}
+
+func TestChecksum(t *testing.T) {
+	// the check value of CRC32C
+	assert.Equal(t, "e3069283", checksum([]byte("123456789")))
+	assert.Equal(t, "00000000", checksum(nil))
+
+	assert.Equal(t, "e3069283", checksumOfMetadata(map[string]string{"milvus-crc32c": "e3069283"}))
+	assert.Equal(t, "", checksumOfMetadata(map[string]string{"Other": "e3069283"}))
+
+	assert.NoError(t, verifyChecksum("key", []byte("123456789"), "e3069283"))
+	assert.NoError(t, verifyChecksum("key", []byte("123456789"), ""))
+	err := verifyChecksum("key", []byte("12345678"), "e3069283")
+	assert.True(t, IsErrChecksumMismatch(err))
+	assert.False(t, IsErrNoSuchKey(err))
+
+	assert.Equal(t, "e3069283:65536:e3069283", objectChecksum([]byte("123456789")))
+	assert.NoError(t, verifyChecksum("key", []byte("123456789"), "e3069283:65536:e3069283"))
+	assert.True(t, IsErrChecksumMismatch(verifyChecksum("key", []byte("12345678"), "e3069283:65536:e3069283")))
+
+	crc, blockSize, blocks := parseChecksum("e3069283")
+	assert.Equal(t, "e3069283", crc)
+	assert.Zero(t, blockSize)
+	assert.Empty(t, blocks)
+	value := make([]byte, 3*minChecksumBlockSize+1)
+	crc, blockSize, blocks = parseChecksum(objectChecksum(value))
+	assert.Equal(t, checksum(value), crc)
+	assert.EqualValues(t, minChecksumBlockSize, blockSize)
+	assert.Equal(t, 4, len(blocks))
+
+	// the blocks grow with the object to bound the size of the metadata
+	assert.EqualValues(t, minChecksumBlockSize, checksumBlockSize(0))
+	assert.EqualValues(t, 2*minChecksumBlockSize, checksumBlockSize(maxChecksumBlocks*minChecksumBlockSize+1))
+}
+
+func TestRemoteChunkManagerChecksum(t *testing.T) {
+	ctx := context.Background()
+	client := &memChecksumStorage{objects: map[string][]byte{}, checksums: map[string]string{}}
+	mcm := &RemoteChunkManager{client: client}
+
+	value := []byte("binlog")
+	require.NoError(t, mcm.Write(ctx, "a", value))
+	assert.Equal(t, objectChecksum(value), client.checksums["a"])
+
+	data, err := mcm.Read(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, value, data)
+
+	// corrupted at rest
+	client.objects["a"] = []byte("binlOg")
+	_, err = mcm.Read(ctx, "a")
+	assert.True(t, IsErrChecksumMismatch(err))
+
+	mcm.rangedRead = rangedReadConfig{partSize: 2, concurrency: 2}
+	_, err = mcm.Read(ctx, "a")
+	assert.True(t, IsErrChecksumMismatch(err))
+
+	// the objects without checksum are not verified
+	client.checksums["a"] = ""
+	data, err = mcm.Read(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("binlOg"), data)
+}
+
+func TestRemoteChunkManagerChecksumReader(t *testing.T) {
+	ctx := context.Background()
+	client := &memChecksumStorage{objects: map[string][]byte{}, checksums: map[string]string{}}
+	mcm := &RemoteChunkManager{client: client}
+
+	value := []byte("binlog")
+	require.NoError(t, mcm.Write(ctx, "a", value))
+	reader, err := mcm.Reader(ctx, "a")
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, value, data)
+
+	client.objects["a"] = []byte("binlOg")
+	reader, err = mcm.Reader(ctx, "a")
+	require.NoError(t, err)
+	_, err = io.ReadAll(reader)
+	assert.True(t, IsErrChecksumMismatch(err))
+}
+
+func TestRemoteChunkManagerChecksumReadAt(t *testing.T) {
+	ctx := context.Background()
+	client := &memChecksumStorage{objects: map[string][]byte{}, checksums: map[string]string{}}
+	mcm := &RemoteChunkManager{client: client}
+
+	value := make([]byte, 3*minChecksumBlockSize)
+	for i := range value {
+		value[i] = byte(i)
+	}
+	require.NoError(t, mcm.Write(ctx, "a", value))
+
+	data, err := mcm.ReadAt(ctx, "a", minChecksumBlockSize-1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, value[minChecksumBlockSize-1:minChecksumBlockSize+1], data)
+
+	// the corrupted block is detected by the reads overlapping it only
+	client.objects["a"][2*minChecksumBlockSize] ^= 0xff
+	data, err = mcm.ReadAt(ctx, "a", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, value[:10], data)
+	_, err = mcm.ReadAt(ctx, "a", 2*minChecksumBlockSize+10, 10)
+	assert.True(t, IsErrChecksumMismatch(err))
+
+	// the objects without block checksums are not verified
+	client.checksums["a"] = checksum(value)
+	_, err = mcm.ReadAt(ctx, "a", 2*minChecksumBlockSize+10, 10)
+	assert.NoError(t, err)
+}
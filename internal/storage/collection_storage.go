@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// CollectionStorage is the object storage of a collection, whose endpoint and requester-pays are set by
+// the collection properties instead of the global config. The index files of the collection are built, loaded
+// and recycled through it.
+type CollectionStorage struct {
+	Endpoint      string
+	RequesterPays bool
+}
+
+// GetCollectionStorage returns the object storage set by the properties of a collection, nil if the collection
+// uses the global object storage. The property not set is taken from the global config.
+func GetCollectionStorage(params *paramtable.ComponentParam, properties map[string]string) (*CollectionStorage, error) {
+	endpoint, hasEndpoint := properties[common.CollectionStorageEndpointKey]
+	requesterPays, hasRequesterPays := properties[common.CollectionStorageRequesterPaysKey]
+	if (!hasEndpoint || endpoint == "") && !hasRequesterPays {
+		return nil, nil
+	}
+	cs := &CollectionStorage{
+		Endpoint:      params.MinioCfg.Address.GetValue(),
+		RequesterPays: params.MinioCfg.RequesterPays.GetAsBool(),
+	}
+	if hasEndpoint && endpoint != "" {
+		cs.Endpoint = endpoint
+	}
+	if hasRequesterPays {
+		v, err := strconv.ParseBool(requesterPays)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid collection property %s", common.CollectionStorageRequesterPaysKey)
+		}
+		cs.RequesterPays = v
+	}
+	return cs, nil
+}
+
+// NewCollectionChunkManager returns the chunk manager of the object storage of a collection,
+// it's created once for each object storage and shared by the callers.
+func (f *ChunkManagerFactory) NewCollectionChunkManager(ctx context.Context, cs CollectionStorage) (ChunkManager, error) {
+	f.collectionMu.Lock()
+	defer f.collectionMu.Unlock()
+
+	if cm, ok := f.collectionMgrs[cs]; ok {
+		return cm, nil
+	}
+	c := *f.config
+	c.address = cs.Endpoint
+	c.requesterPays = cs.RequesterPays
+	cm, err := (&ChunkManagerFactory{persistentStorage: f.persistentStorage, config: &c}).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if f.collectionMgrs == nil {
+		f.collectionMgrs = make(map[CollectionStorage]ChunkManager)
+	}
+	f.collectionMgrs[cs] = cm
+	return cm, nil
+}
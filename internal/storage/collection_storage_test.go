@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetCollectionStorage(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	cs, err := GetCollectionStorage(params, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cs)
+	cs, err = GetCollectionStorage(params, map[string]string{common.CollectionStorageEndpointKey: ""})
+	assert.NoError(t, err)
+	assert.Nil(t, cs)
+
+	cs, err = GetCollectionStorage(params, map[string]string{common.CollectionStorageEndpointKey: "s3.us-west-2.amazonaws.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, &CollectionStorage{Endpoint: "s3.us-west-2.amazonaws.com", RequesterPays: params.MinioCfg.RequesterPays.GetAsBool()}, cs)
+
+	cs, err = GetCollectionStorage(params, map[string]string{common.CollectionStorageRequesterPaysKey: "true"})
+	assert.NoError(t, err)
+	assert.Equal(t, &CollectionStorage{Endpoint: params.MinioCfg.Address.GetValue(), RequesterPays: true}, cs)
+
+	_, err = GetCollectionStorage(params, map[string]string{common.CollectionStorageRequesterPaysKey: "bad_value"})
+	assert.Error(t, err)
+}
+
+func TestNewCollectionChunkManager(t *testing.T) {
+	factory := NewChunkManagerFactory("local", RootPath("/tmp/milvus_test/collection_storage"))
+	cm1, err := factory.NewCollectionChunkManager(context.Background(), CollectionStorage{Endpoint: "a"})
+	assert.NoError(t, err)
+	cm2, err := factory.NewCollectionChunkManager(context.Background(), CollectionStorage{Endpoint: "a"})
+	assert.NoError(t, err)
+	assert.Same(t, cm1, cm2)
+
+	cm3, err := factory.NewCollectionChunkManager(context.Background(), CollectionStorage{Endpoint: "b"})
+	assert.NoError(t, err)
+	assert.NotSame(t, cm1, cm3)
+}
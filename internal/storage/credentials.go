@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	defaultSTSEndpoint = "https://sts.amazonaws.com"
+	// assumeRoleSessionName is the session name of the role assumed by milvus
+	assumeRoleSessionName = "milvus"
+)
+
+// newAWSCredentials returns the credentials of aws and the other services compatible with it, e.g. minio.
+// The temporary credentials are refreshed before expired, so the long-running chunk managers keep working:
+// the IAM role, including IRSA by AWS_WEB_IDENTITY_TOKEN_FILE, and the role assumed by STS are renewed automatically,
+// the credentials file is reloaded once it's rotated, e.g. by a vault agent.
+func newAWSCredentials(c *config) (*credentials.Credentials, error) {
+	switch {
+	case c.useIAM:
+		return credentials.NewIAM(""), nil
+	case c.credentialsFile != "":
+		return credentials.New(&fileCredentialsProvider{path: c.credentialsFile}), nil
+	case c.roleARN != "":
+		endpoint := c.stsEndpoint
+		if endpoint == "" {
+			endpoint = defaultSTSEndpoint
+		}
+		creds, err := credentials.NewSTSAssumeRole(endpoint, credentials.STSAssumeRoleOptions{
+			AccessKey:       c.accessKeyID,
+			SecretKey:       c.secretAccessKeyID,
+			RoleARN:         c.roleARN,
+			RoleSessionName: assumeRoleSessionName,
+			Location:        c.region,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create assume role credentials")
+		}
+		return creds, nil
+	default:
+		return credentials.NewStaticV4(c.accessKeyID, c.secretAccessKeyID, c.sessionToken), nil
+	}
+}
+
+// fileCredentialsProvider implements "github.com/minio/minio-go/v7/pkg/credentials".Provider,
+// it retrieves the credentials from the aws shared credentials file, and reloads them once the file is modified
+type fileCredentialsProvider struct {
+	path    string
+	modTime time.Time
+}
+
+// Retrieve returns nil if it successfully retrieved the value.
+// according to the caller credentials.Credentials.Get(),
+// it already has a lock, so we don't need to worry about concurrency
+func (p *fileCredentialsProvider) Retrieve() (credentials.Value, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return credentials.Value{}, errors.Wrap(err, "failed to stat credentials file")
+	}
+	file := &credentials.FileAWSCredentials{Filename: p.path}
+	value, err := file.Retrieve()
+	if err != nil {
+		return credentials.Value{}, errors.Wrap(err, "failed to retrieve credentials from file")
+	}
+	p.modTime = info.ModTime()
+	return value, nil
+}
+
+// IsExpired returns whether the credentials file is modified since the last retrieval,
+// the current credentials are kept if the file is inaccessible temporarily, e.g. while rotating
+func (p *fileCredentialsProvider) IsExpired() bool {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return p.modTime.IsZero()
+	}
+	return !info.ModTime().Equal(p.modTime)
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredentialsFile(t *testing.T, path, accessKey string, modTime time.Time) {
+	content := "[default]\naws_access_key_id = " + accessKey + "\naws_secret_access_key = secret\naws_session_token = token\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestNewAWSCredentials(t *testing.T) {
+	t.Run("static with session token", func(t *testing.T) {
+		creds, err := newAWSCredentials(&config{accessKeyID: "ak", secretAccessKeyID: "sk", sessionToken: "token"})
+		require.NoError(t, err)
+		value, err := creds.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "ak", value.AccessKeyID)
+		assert.Equal(t, "token", value.SessionToken)
+	})
+
+	t.Run("assume role", func(t *testing.T) {
+		creds, err := newAWSCredentials(&config{accessKeyID: "ak", secretAccessKeyID: "sk", roleARN: "arn:aws:iam::123456789012:role/milvus"})
+		assert.NoError(t, err)
+		assert.NotNil(t, creds)
+	})
+
+	t.Run("credentials file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "credentials")
+		now := time.Now()
+		writeCredentialsFile(t, path, "ak1", now)
+
+		creds, err := newAWSCredentials(&config{accessKeyID: "ak", credentialsFile: path})
+		require.NoError(t, err)
+		value, err := creds.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "ak1", value.AccessKeyID)
+		assert.Equal(t, "token", value.SessionToken)
+		assert.False(t, creds.IsExpired())
+
+		// rotated
+		writeCredentialsFile(t, path, "ak2", now.Add(time.Hour))
+		assert.True(t, creds.IsExpired())
+		value, err = creds.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "ak2", value.AccessKeyID)
+
+		// the current credentials are kept while the file is absent
+		require.NoError(t, os.Remove(path))
+		assert.False(t, creds.IsExpired())
+		value, err = creds.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, "ak2", value.AccessKeyID)
+	})
+
+	t.Run("absent credentials file", func(t *testing.T) {
+		creds, err := newAWSCredentials(&config{credentialsFile: filepath.Join(t.TempDir(), "absent")})
+		require.NoError(t, err)
+		_, err = creds.Get()
+		assert.Error(t, err)
+	})
+}
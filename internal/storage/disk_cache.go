@@ -0,0 +1,654 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	// diskCacheTmpDir keeps the objects being written to the cache
+	diskCacheTmpDir = ".tmp"
+	// diskCachePendingDir keeps the written back objects until they're flushed, which survive the restarts
+	diskCachePendingDir = ".pending"
+
+	diskCacheFlushAttempts = 10
+
+	// diskCacheValidateInterval is how long a cached object is served without checking it against the storage,
+	// so the objects replaced or removed by the other nodes are refetched after it at the latest
+	diskCacheValidateInterval = 10 * time.Second
+)
+
+// diskCache caches the objects of the remote storage on the local disk, e.g. the NVMe of the node.
+// The objects are read through, and written through or written back, the least recently used ones
+// are evicted once the size of the cache exceeds the capacity, the pending ones are never evicted.
+// The cached objects are revalidated by their size and checksum once diskCacheValidateInterval passes,
+// the pending ones are newer than the storage and served as is.
+type diskCache struct {
+	root      string
+	capacity  int64
+	writeBack bool
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru is ordered from the most recently used to the least
+	lru  *list.List
+	size int64
+	// keyLock serializes the flushes and the removes of the same key
+	keyLock *lock.KeyLock
+
+	flushOnce sync.Once
+}
+
+type diskCacheEntry struct {
+	key        string
+	bucketName string
+	objectName string
+	size       int64
+	checksum   string
+	pending    bool
+	// validated is when the entry is last known to match the storage
+	validated time.Time
+	// next is the layer the pending entry is flushed to
+	next ObjectStorageLayer
+}
+
+// matches reports whether the object of size and checksum in the storage is the one cached,
+// only the size is compared if the storage doesn't keep the checksum
+func (e *diskCacheEntry) matches(size int64, checksum string) bool {
+	expected, _, _ := parseChecksum(checksum)
+	cached, _, _ := parseChecksum(e.checksum)
+	return e.size == size && (expected == "" || expected == cached)
+}
+
+var (
+	diskCachesMu sync.Mutex
+	diskCaches   = map[string]*diskCache{}
+)
+
+// getDiskCache returns the cache at root, which is shared by all the chunk managers of the node
+func getDiskCache(root string, capacity int64, writeBack bool) (*diskCache, error) {
+	diskCachesMu.Lock()
+	defer diskCachesMu.Unlock()
+
+	root = filepath.Clean(root)
+	if cache, ok := diskCaches[root]; ok {
+		return cache, nil
+	}
+	cache, err := newDiskCache(root, capacity, writeBack)
+	if err != nil {
+		return nil, err
+	}
+	diskCaches[root] = cache
+	return cache, nil
+}
+
+// newDiskCache drops the cached objects left by the last run as their checksums are kept in memory,
+// the pending ones are kept and flushed once the cache is composed into a chain
+func newDiskCache(root string, capacity int64, writeBack bool) (*diskCache, error) {
+	c := &diskCache{
+		root:      root,
+		capacity:  capacity,
+		writeBack: writeBack,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+		keyLock:   lock.NewKeyLock(),
+	}
+	if err := os.MkdirAll(root, os.ModePerm); err != nil {
+		return nil, err
+	}
+	dirs, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range dirs {
+		if dir.Name() != diskCachePendingDir {
+			if err := os.RemoveAll(filepath.Join(root, dir.Name())); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, diskCacheTmpDir), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	pendingRoot := filepath.Join(root, diskCachePendingDir)
+	err = filepath.WalkDir(pendingRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(pendingRoot, path)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		bucketName, objectName, ok := strings.Cut(key, "/")
+		if !ok {
+			return nil
+		}
+		size, sum, err := checksumOfFile(path)
+		if err != nil {
+			return err
+		}
+		c.add(&diskCacheEntry{key: key, bucketName: bucketName, objectName: objectName, size: size, checksum: sum, pending: true})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("disk cache initialized", zap.String("root", root), zap.Int64("capacity", capacity),
+		zap.Bool("writeBack", writeBack), zap.Int("pending", len(c.entries)))
+	return c, nil
+}
+
+func checksumOfFile(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := crc32.New(castagnoliTable)
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, fmt.Sprintf("%08x", h.Sum32()), nil
+}
+
+// key returns the key of the object in the cache, the objects escaping the root are not cached
+func (c *diskCache) key(bucketName, objectName string) (string, bool) {
+	key := bucketName + "/" + objectName
+	clean := filepath.ToSlash(filepath.Clean(key))
+	if clean != key || strings.HasPrefix(key, ".") || strings.HasPrefix(key, "/") {
+		return "", false
+	}
+	return key, true
+}
+
+func (c *diskCache) path(e *diskCacheEntry) string {
+	if e.pending {
+		return filepath.Join(c.root, diskCachePendingDir, filepath.FromSlash(e.key))
+	}
+	return filepath.Join(c.root, filepath.FromSlash(e.key))
+}
+
+// add puts the entry at the front, the caller must hold mu or own c exclusively
+func (c *diskCache) add(e *diskCacheEntry) {
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.size += e.size
+	metrics.PersistentDataCacheSize.Add(float64(e.size))
+	if e.pending {
+		metrics.PersistentDataCachePendingSize.Add(float64(e.size))
+	}
+}
+
+// drop removes the entry and its file, the caller must hold mu
+func (c *diskCache) drop(elem *list.Element) {
+	e := elem.Value.(*diskCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, e.key)
+	c.size -= e.size
+	metrics.PersistentDataCacheSize.Sub(float64(e.size))
+	if e.pending {
+		metrics.PersistentDataCachePendingSize.Sub(float64(e.size))
+	}
+	if err := os.Remove(c.path(e)); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove cached object", zap.String("key", e.key), zap.Error(err))
+	}
+}
+
+// evict drops the least recently used entries until the size fits the capacity, the caller must hold mu
+func (c *diskCache) evict() {
+	for elem := c.lru.Back(); elem != nil && c.size > c.capacity; {
+		prev := elem.Prev()
+		if !elem.Value.(*diskCacheEntry).pending {
+			c.drop(elem)
+			metrics.PersistentDataCacheEvictionCounter.Inc()
+		}
+		elem = prev
+	}
+}
+
+// get returns the entry and marks it as the most recently used
+func (c *diskCache) get(key string) (*diskCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*diskCacheEntry), true
+}
+
+// validate checks the cached entry of key against the storage if it's not validated recently,
+// the entry is dropped if the object is replaced or removed
+func (c *diskCache) validate(ctx context.Context, key string, next ObjectStorageLayer) {
+	c.mu.Lock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	e := elem.Value.(*diskCacheEntry)
+	if e.pending || time.Since(e.validated) < diskCacheValidateInterval {
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+
+	size, checksum, err := next.StatObjectWithChecksum(ctx, e.bucketName, e.objectName)
+	if err != nil && !IsErrNoSuchKey(err) {
+		// keep the entry, the storage is checked again by the next access
+		log.Warn("failed to validate cached object", zap.String("key", key), zap.Error(err))
+		return
+	}
+	c.refresh(e, err == nil, size, checksum)
+}
+
+// refresh marks the entry as validated if it matches the object in the storage, or drops it otherwise
+func (c *diskCache) refresh(e *diskCacheEntry, exist bool, size int64, checksum string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[e.key]
+	if !ok || elem.Value != e || e.pending {
+		return
+	}
+	if exist && e.matches(size, checksum) {
+		e.validated = time.Now()
+		return
+	}
+	log.Info("cached object is stale, drop it", zap.String("key", e.key))
+	c.drop(elem)
+	metrics.PersistentDataCacheEvictionCounter.Inc()
+}
+
+// open returns the reader of the cached object from offset, the rest of the object is read if size is 0
+func (c *diskCache) open(key string, offset int64, size int64) (FileReader, bool) {
+	c.mu.Lock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	e := elem.Value.(*diskCacheEntry)
+	path := c.path(e)
+	c.mu.Unlock()
+
+	// the file may be evicted after it's opened, it's still readable until closed
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false
+	}
+	if size == 0 {
+		size = e.size - offset
+	}
+	return &cachedFileReader{SectionReader: io.NewSectionReader(f, offset, size), file: f}, true
+}
+
+// writeTemp writes the content of reader to a temporary file, and returns its size and checksum
+func (c *diskCache) writeTemp(reader io.Reader) (string, int64, string, error) {
+	f, err := os.CreateTemp(filepath.Join(c.root, diskCacheTmpDir), "object-")
+	if err != nil {
+		return "", 0, "", err
+	}
+	h := crc32.New(castagnoliTable)
+	n, err := io.Copy(io.MultiWriter(f, h), reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", 0, "", err
+	}
+	return f.Name(), n, fmt.Sprintf("%08x", h.Sum32()), nil
+}
+
+// commit moves the temporary file into the cache as e, it replaces the entry of the same key.
+// The temporary file is left to the caller if it fails.
+func (c *diskCache) commit(tmp string, e *diskCacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[e.key]; ok {
+		c.drop(elem)
+	}
+	if !e.pending && e.size > c.capacity {
+		os.Remove(tmp)
+		return nil
+	}
+	path := c.path(e)
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		return err
+	}
+	c.add(e)
+	c.evict()
+	return nil
+}
+
+// invalidate drops the entry of key if it's cached
+func (c *diskCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.drop(elem)
+	}
+}
+
+// flushed moves the flushed entry from the pending ones to the cached ones, unless it's replaced or removed
+func (c *diskCache) flushed(e *diskCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[e.key]; !ok || elem.Value != e {
+		return
+	}
+	pendingPath := c.path(e)
+	flushed := *e
+	flushed.pending = false
+	path := c.path(&flushed)
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err == nil {
+		err = os.Rename(pendingPath, path)
+	}
+	if err != nil {
+		// it's flushed already, so the pending file is dropped rather than flushed again
+		log.Warn("failed to move flushed object into cache", zap.String("key", e.key), zap.Error(err))
+		c.drop(c.entries[e.key])
+		return
+	}
+	metrics.PersistentDataCachePendingSize.Sub(float64(e.size))
+	e.pending = false
+	e.validated = time.Now()
+	c.evict()
+}
+
+// flush uploads the pending entry to its next layer, it's kept pending and flushed on the next start if all the attempts fail.
+// The entry flushed already, replaced or removed is skipped.
+func (c *diskCache) flush(ctx context.Context, e *diskCacheEntry) error {
+	c.keyLock.Lock(e.key)
+	defer c.keyLock.Unlock(e.key)
+
+	skipped := false
+	err := retry.Do(ctx, func() error {
+		c.mu.Lock()
+		elem, ok := c.entries[e.key]
+		skipped = !ok || elem.Value != e || !e.pending
+		path := c.path(e)
+		c.mu.Unlock()
+		if skipped {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return e.next.PutObjectWithChecksum(ctx, e.bucketName, e.objectName, f, e.size, e.checksum)
+	}, retry.Attempts(diskCacheFlushAttempts), retry.MaxSleepTime(time.Minute))
+	if err != nil {
+		log.Error("failed to flush cached object", zap.String("key", e.key), zap.Error(err))
+		return err
+	}
+	if !skipped {
+		c.flushed(e)
+	}
+	return nil
+}
+
+// flushAll uploads all the pending entries, it returns once they're in the storage
+func (c *diskCache) flushAll(ctx context.Context) error {
+	var errs error
+	for _, e := range c.pendingEntries("") {
+		if err := c.flush(ctx, e); err != nil {
+			errs = merr.Combine(errs, err)
+		}
+	}
+	return errs
+}
+
+// pendingEntries returns the pending entries under prefix
+func (c *diskCache) pendingEntries(prefix string) []*diskCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ret []*diskCacheEntry
+	for _, elem := range c.entries {
+		e := elem.Value.(*diskCacheEntry)
+		if e.pending && strings.HasPrefix(e.key, prefix) {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// middleware returns the cache layer in front of next, the pending objects left by the last run are flushed to
+// the first next
+func (c *diskCache) middleware(next ObjectStorageLayer) ObjectStorageLayer {
+	c.flushOnce.Do(func() {
+		for _, e := range c.pendingEntries("") {
+			c.mu.Lock()
+			e.next = next
+			c.mu.Unlock()
+			go c.flush(context.Background(), e)
+		}
+	})
+	return &diskCacheLayer{ObjectStorageLayer: next, cache: c}
+}
+
+type cachedFileReader struct {
+	*io.SectionReader
+	file *os.File
+}
+
+func (r *cachedFileReader) Close() error {
+	return r.file.Close()
+}
+
+// diskCacheLayer serves the requests by the cache, the misses are passed to the next layer
+type diskCacheLayer struct {
+	ObjectStorageLayer
+	cache *diskCache
+}
+
+// GetObject reads the whole object through the cache, the ranged gets missing the cache are passed to the next layer
+func (l *diskCacheLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	key, ok := l.cache.key(bucketName, objectName)
+	if !ok {
+		return l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, offset, size)
+	}
+	l.cache.validate(ctx, key, l.ObjectStorageLayer)
+	if reader, ok := l.cache.open(key, offset, size); ok {
+		metrics.PersistentDataCacheCounter.WithLabelValues(metrics.DataGetLabel, metrics.CacheHitLabel).Inc()
+		return reader, nil
+	}
+	metrics.PersistentDataCacheCounter.WithLabelValues(metrics.DataGetLabel, metrics.CacheMissLabel).Inc()
+	if offset != 0 || size != 0 {
+		return l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, offset, size)
+	}
+
+	reader, err := l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	tmp, n, sum, err := l.cache.writeTemp(reader)
+	reader.Close()
+	if err != nil {
+		log.Warn("failed to cache object, read it from the storage", zap.String("key", key), zap.Error(err))
+		return l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, 0, 0)
+	}
+	// open the file before committing, so it's readable even if it's evicted immediately
+	f, err := os.Open(tmp)
+	if err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	e := &diskCacheEntry{key: key, bucketName: bucketName, objectName: objectName, size: n, checksum: sum, validated: time.Now()}
+	if err := l.cache.commit(tmp, e); err != nil {
+		log.Warn("failed to cache object", zap.String("key", key), zap.Error(err))
+		os.Remove(tmp)
+	}
+	return &cachedFileReader{SectionReader: io.NewSectionReader(f, 0, n), file: f}, nil
+}
+
+// StatObjectWithChecksum serves the pending objects by the cache, the others are stated by the storage,
+// which revalidates the cached entry as well
+func (l *diskCacheLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	key, ok := l.cache.key(bucketName, objectName)
+	if !ok {
+		return l.ObjectStorageLayer.StatObjectWithChecksum(ctx, bucketName, objectName)
+	}
+	e, cached := l.cache.get(key)
+	if cached && e.pending {
+		metrics.PersistentDataCacheCounter.WithLabelValues(metrics.DataStatLabel, metrics.CacheHitLabel).Inc()
+		return e.size, e.checksum, nil
+	}
+	metrics.PersistentDataCacheCounter.WithLabelValues(metrics.DataStatLabel, metrics.CacheMissLabel).Inc()
+	size, checksum, err := l.ObjectStorageLayer.StatObjectWithChecksum(ctx, bucketName, objectName)
+	if cached && (err == nil || IsErrNoSuchKey(err)) {
+		l.cache.refresh(e, err == nil, size, checksum)
+	}
+	return size, checksum, err
+}
+
+// PutObjectWithChecksum writes the object to the cache, then uploads it synchronously,
+// or asynchronously if the cache writes back, the objects larger than the capacity bypass the cache
+func (l *diskCacheLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	key, ok := l.cache.key(bucketName, objectName)
+	if !ok || objectSize < 0 || objectSize > l.cache.capacity {
+		if ok {
+			l.cache.invalidate(key)
+		}
+		return l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	}
+
+	var offset int64
+	seeker, seekable := reader.(io.Seeker)
+	if seekable {
+		offset, _ = seeker.Seek(0, io.SeekCurrent)
+	}
+	tmp, n, sum, err := l.cache.writeTemp(reader)
+	if err != nil {
+		l.cache.invalidate(key)
+		if !seekable {
+			return err
+		}
+		log.Warn("failed to cache object, write it to the storage", zap.String("key", key), zap.Error(err))
+		if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		return l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	}
+	if checksum == "" {
+		checksum = sum
+	}
+	e := &diskCacheEntry{key: key, bucketName: bucketName, objectName: objectName, size: n, checksum: checksum}
+
+	if l.cache.writeBack {
+		e.pending = true
+		e.next = l.ObjectStorageLayer
+		err := l.cache.commit(tmp, e)
+		if err == nil {
+			go l.cache.flush(context.Background(), e)
+			return nil
+		}
+		log.Warn("failed to write back object, write it to the storage", zap.String("key", key), zap.Error(err))
+		e.pending = false
+	}
+
+	f, err := os.Open(tmp)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	err = l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, f, n, checksum)
+	f.Close()
+	if err != nil {
+		os.Remove(tmp)
+		l.cache.invalidate(key)
+		return err
+	}
+	e.validated = time.Now()
+	if err := l.cache.commit(tmp, e); err != nil {
+		log.Warn("failed to cache object", zap.String("key", key), zap.Error(err))
+		os.Remove(tmp)
+	}
+	return nil
+}
+
+// ListObjects lists the pending objects as well, which are not flushed to the storage yet
+func (l *diskCacheLayer) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	objects, err := l.ObjectStorageLayer.ListObjects(ctx, bucketName, prefix, recursive)
+	if err != nil {
+		return nil, err
+	}
+	if !l.cache.writeBack {
+		return objects, nil
+	}
+	if objects == nil {
+		objects = make(map[string]time.Time)
+	}
+	for _, e := range l.cache.pendingEntries(bucketName + "/" + prefix) {
+		name := e.objectName
+		if !recursive {
+			if idx := strings.Index(name[len(prefix):], "/"); idx >= 0 {
+				name = name[:len(prefix)+idx+1]
+			}
+		}
+		if _, ok := objects[name]; !ok {
+			objects[name] = time.Now()
+		}
+	}
+	return objects, nil
+}
+
+// RemoveObject drops the object from the cache, it waits for the ongoing flush so the removed object isn't resurrected
+func (l *diskCacheLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	if key, ok := l.cache.key(bucketName, objectName); ok {
+		l.cache.keyLock.Lock(key)
+		defer l.cache.keyLock.Unlock(key)
+		l.cache.invalidate(key)
+	}
+	return l.ObjectStorageLayer.RemoveObject(ctx, bucketName, objectName)
+}
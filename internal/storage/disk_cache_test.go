@@ -0,0 +1,278 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingObjectStorage is the thread-safe in-memory ObjectStorage counting the requests
+type countingObjectStorage struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	requests int
+}
+
+func newCountingObjectStorage() *countingObjectStorage {
+	return &countingObjectStorage{objects: map[string][]byte{}}
+}
+
+func (s *countingObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	data, ok := s.objects[objectName]
+	if !ok {
+		return nil, WrapErrNoSuchKey(objectName)
+	}
+	if size == 0 {
+		size = int64(len(data)) - offset
+	}
+	return io.NopCloser(bytes.NewReader(data[offset : offset+size])), nil
+}
+
+func (s *countingObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	s.objects[objectName] = data
+	return nil
+}
+
+func (s *countingObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	data, ok := s.objects[objectName]
+	if !ok {
+		return 0, WrapErrNoSuchKey(objectName)
+	}
+	return int64(len(data)), nil
+}
+
+func (s *countingObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	return map[string]time.Time{}, nil
+}
+
+func (s *countingObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	delete(s.objects, objectName)
+	return nil
+}
+
+func (s *countingObjectStorage) get(objectName string) ([]byte, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objects[objectName], s.requests
+}
+
+// objectReader returns the func reading all the content of the object got
+func objectReader(t *testing.T) func(FileReader, error) []byte {
+	return func(reader FileReader, err error) []byte {
+		require.NoError(t, err)
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return data
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("read through", func(t *testing.T) {
+		read := objectReader(t)
+		backend := newCountingObjectStorage()
+		backend.objects["a/b"] = []byte("0123456789")
+		cache, err := newDiskCache(t.TempDir(), 15, false)
+		require.NoError(t, err)
+		chain := newObjectStorageChain(backend, cache.middleware)
+
+		// the ranged get missing the cache isn't cached
+		assert.Equal(t, []byte("234"), read(chain.GetObject(ctx, "bucket", "a/b", 2, 3)))
+		assert.Equal(t, []byte("0123456789"), read(chain.GetObject(ctx, "bucket", "a/b", 0, 0)))
+		_, requests := backend.get("a/b")
+		assert.Equal(t, 2, requests)
+
+		assert.Equal(t, []byte("0123456789"), read(chain.GetObject(ctx, "bucket", "a/b", 0, 0)))
+		assert.Equal(t, []byte("234"), read(chain.GetObject(ctx, "bucket", "a/b", 2, 3)))
+		_, requests = backend.get("a/b")
+		assert.Equal(t, 2, requests)
+		// the stat isn't served by the cache, the object may be replaced by the other nodes
+		size, _, err := chain.StatObjectWithChecksum(ctx, "bucket", "a/b")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, size)
+		_, requests = backend.get("a/b")
+		assert.Equal(t, 3, requests)
+
+		_, err = chain.GetObject(ctx, "bucket", "a/c", 0, 0)
+		assert.True(t, IsErrNoSuchKey(err))
+
+		// the least recently used object is evicted
+		backend.objects["a/c"] = []byte("abcdefgh")
+		assert.Equal(t, []byte("abcdefgh"), read(chain.GetObject(ctx, "bucket", "a/c", 0, 0)))
+		_, ok := cache.get("bucket/a/b")
+		assert.False(t, ok)
+		_, err = os.Stat(filepath.Join(cache.root, "bucket", "a", "b"))
+		assert.True(t, os.IsNotExist(err))
+		assert.EqualValues(t, 8, cache.size)
+	})
+
+	t.Run("write through", func(t *testing.T) {
+		read := objectReader(t)
+		backend := newCountingObjectStorage()
+		cache, err := newDiskCache(t.TempDir(), 15, false)
+		require.NoError(t, err)
+		chain := newObjectStorageChain(backend, cache.middleware)
+
+		value := []byte("binlog")
+		require.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", bytes.NewReader(value), int64(len(value)), checksum(value)))
+		data, requests := backend.get("a")
+		assert.Equal(t, value, data)
+		assert.Equal(t, []byte("binlog"), read(chain.GetObject(ctx, "bucket", "a", 0, 0)))
+		_, after := backend.get("a")
+		assert.Equal(t, requests, after)
+
+		// the objects larger than the capacity bypass the cache
+		value = make([]byte, 20)
+		require.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "large", bytes.NewReader(value), int64(len(value)), ""))
+		_, ok := cache.get("bucket/large")
+		assert.False(t, ok)
+
+		require.NoError(t, chain.RemoveObject(ctx, "bucket", "a"))
+		_, ok = cache.get("bucket/a")
+		assert.False(t, ok)
+		_, err = chain.GetObject(ctx, "bucket", "a", 0, 0)
+		assert.True(t, IsErrNoSuchKey(err))
+	})
+
+	t.Run("write back", func(t *testing.T) {
+		read := objectReader(t)
+		backend := newCountingObjectStorage()
+		root := t.TempDir()
+		cache, err := newDiskCache(root, 15, true)
+		require.NoError(t, err)
+		chain := newObjectStorageChain(backend, cache.middleware)
+
+		value := []byte("binlog")
+		require.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "dir/a", bytes.NewReader(value), int64(len(value)), ""))
+		assert.Equal(t, value, read(chain.GetObject(ctx, "bucket", "dir/a", 0, 0)))
+		assert.Eventually(t, func() bool {
+			data, _ := backend.get("dir/a")
+			return bytes.Equal(value, data)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Eventually(t, func() bool {
+			e, ok := cache.get("bucket/dir/a")
+			return ok && !e.pending
+		}, 5*time.Second, 10*time.Millisecond)
+		_, err = os.Stat(filepath.Join(root, "bucket", "dir", "a"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("recover pending", func(t *testing.T) {
+		root := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(root, diskCachePendingDir, "bucket", "dir"), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(root, diskCachePendingDir, "bucket", "dir", "a"), []byte("pending"), os.ModePerm))
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "bucket"), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "bucket", "cached"), []byte("cached"), os.ModePerm))
+
+		cache, err := newDiskCache(root, 15, true)
+		require.NoError(t, err)
+		// the cached objects are dropped
+		_, err = os.Stat(filepath.Join(root, "bucket", "cached"))
+		assert.True(t, os.IsNotExist(err))
+
+		// the pending objects are listed before flushed
+		backend := newCountingObjectStorage()
+		chain := newObjectStorageChain(backend, func(next ObjectStorageLayer) ObjectStorageLayer {
+			return &diskCacheLayer{ObjectStorageLayer: next, cache: cache}
+		})
+		objects, err := chain.ListObjects(ctx, "bucket", "", false)
+		assert.NoError(t, err)
+		assert.Contains(t, objects, "dir/")
+		objects, err = chain.ListObjects(ctx, "bucket", "dir/", true)
+		assert.NoError(t, err)
+		assert.Contains(t, objects, "dir/a")
+
+		newObjectStorageChain(backend, cache.middleware)
+		assert.Eventually(t, func() bool {
+			data, _ := backend.get("dir/a")
+			return bytes.Equal([]byte("pending"), data)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("revalidate", func(t *testing.T) {
+		read := objectReader(t)
+		backend := newCountingObjectStorage()
+		backend.objects["a"] = []byte("old")
+		cache, err := newDiskCache(t.TempDir(), 15, false)
+		require.NoError(t, err)
+		chain := newObjectStorageChain(backend, cache.middleware)
+		assert.Equal(t, []byte("old"), read(chain.GetObject(ctx, "bucket", "a", 0, 0)))
+
+		// the object replaced by the other nodes is refetched once the entry is due to revalidate
+		backend.objects["a"] = []byte("newer")
+		assert.Equal(t, []byte("old"), read(chain.GetObject(ctx, "bucket", "a", 0, 0)))
+		e, ok := cache.get("bucket/a")
+		require.True(t, ok)
+		e.validated = time.Now().Add(-diskCacheValidateInterval)
+		assert.Equal(t, []byte("newer"), read(chain.GetObject(ctx, "bucket", "a", 0, 0)))
+
+		// the object removed by the other nodes is dropped by the stat
+		delete(backend.objects, "a")
+		_, _, err = chain.StatObjectWithChecksum(ctx, "bucket", "a")
+		assert.True(t, IsErrNoSuchKey(err))
+		_, ok = cache.get("bucket/a")
+		assert.False(t, ok)
+	})
+
+	t.Run("flush all", func(t *testing.T) {
+		backend := newCountingObjectStorage()
+		cache, err := newDiskCache(t.TempDir(), 15, true)
+		require.NoError(t, err)
+		chain := newObjectStorageChain(backend, cache.middleware)
+
+		value := []byte("binlog")
+		require.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", bytes.NewReader(value), int64(len(value)), ""))
+		require.NoError(t, cache.flushAll(ctx))
+		data, _ := backend.get("a")
+		assert.Equal(t, value, data)
+		e, ok := cache.get("bucket/a")
+		require.True(t, ok)
+		assert.False(t, e.pending)
+		assert.Empty(t, cache.pendingEntries(""))
+	})
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 
@@ -11,11 +12,31 @@ import (
 type ChunkManagerFactory struct {
 	persistentStorage string
 	config            *config
+
+	collectionMu   sync.Mutex
+	collectionMgrs map[CollectionStorage]ChunkManager
 }
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
-	if params.CommonCfg.StorageType.GetValue() == "local" {
+	switch params.CommonCfg.StorageType.GetValue() {
+	case "local":
 		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+	case "hdfs":
+		return NewChunkManagerFactory("hdfs",
+			RootPath(params.HdfsCfg.RootPath.GetValue()),
+			Address(params.HdfsCfg.Address.GetValue()),
+			UseSSL(params.HdfsCfg.UseSSL.GetAsBool()),
+			HdfsUser(params.HdfsCfg.User.GetValue()),
+			HdfsDelegationToken(params.HdfsCfg.DelegationToken.GetValue()),
+			HdfsDelegationTokenFile(params.HdfsCfg.DelegationTokenFile.GetValue()),
+			HdfsReplication(params.HdfsCfg.Replication.GetAsInt()),
+			ReadPartSize(params.MinioCfg.ReadPartSize.GetAsInt64()<<20),
+			ReadConcurrency(params.MinioCfg.ReadConcurrency.GetAsInt()),
+			RequestRateLimit(params.MinioCfg.RequestRateLimit.GetAsFloat()),
+			BandwidthLimit(params.MinioCfg.BandwidthLimit.GetAsInt64()<<20),
+			RequestRetryAttempts(params.MinioCfg.RequestRetryAttempts.GetAsInt()),
+			DiskCache(params.MinioCfg.CachePath.GetValue(), params.MinioCfg.CacheCapacity.GetAsInt64()<<30, params.MinioCfg.CacheWriteBack.GetAsBool()),
+			CreateBucket(true))
 	}
 	return NewChunkManagerFactory(params.CommonCfg.StorageType.GetValue(),
 		RootPath(params.MinioCfg.RootPath.GetValue()),
@@ -29,6 +50,24 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
 		UseVirtualHost(params.MinioCfg.UseVirtualHost.GetAsBool()),
 		Region(params.MinioCfg.Region.GetValue()),
+		SASToken(params.MinioCfg.SASToken.GetValue()),
+		GcpCredentialJSON(params.MinioCfg.GcpCredentialJSON.GetValue()),
+		GcpKMSKeyName(params.MinioCfg.GcpKMSKeyName.GetValue()),
+		ReadPartSize(params.MinioCfg.ReadPartSize.GetAsInt64()<<20),
+		ReadConcurrency(params.MinioCfg.ReadConcurrency.GetAsInt()),
+		RequestRateLimit(params.MinioCfg.RequestRateLimit.GetAsFloat()),
+		BandwidthLimit(params.MinioCfg.BandwidthLimit.GetAsInt64()<<20),
+		RequestRetryAttempts(params.MinioCfg.RequestRetryAttempts.GetAsInt()),
+		DiskCache(params.MinioCfg.CachePath.GetValue(), params.MinioCfg.CacheCapacity.GetAsInt64()<<30, params.MinioCfg.CacheWriteBack.GetAsBool()),
+		SessionToken(params.MinioCfg.SessionToken.GetValue()),
+		RoleARN(params.MinioCfg.RoleARN.GetValue()),
+		STSEndpoint(params.MinioCfg.STSEndpoint.GetValue()),
+		CredentialsFile(params.MinioCfg.CredentialsFile.GetValue()),
+		ServerSideEncryption(params.MinioCfg.SSEType.GetValue(), params.MinioCfg.SSEKey.GetValue()),
+		RequesterPays(params.MinioCfg.RequesterPays.GetAsBool()),
+		S3Endpoint(params.MinioCfg.UseDualStackEndpoint.GetAsBool(), params.MinioCfg.UseFIPSEndpoint.GetAsBool()),
+		CustomHeaders(params.MinioCfg.CustomHeaders.GetAsJSONMap()),
+		ArchiveStorageClass(params.MinioCfg.ArchiveStorageClass.GetValue()),
 		CreateBucket(true))
 }
 
@@ -44,13 +83,31 @@ func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkMana
 }
 
 func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string) (ChunkManager, error) {
-	switch engine {
-	case "local":
+	if err := ValidateServerSideEncryption(engine, f.config.cloudProvider, f.config.sseType, f.config.sseKey); err != nil {
+		return nil, err
+	}
+	if err := ValidateS3RequestOptions(engine, f.config.cloudProvider, f.config.address, f.config.region,
+		f.config.useSSL, f.config.requesterPays, f.config.dualStack, f.config.fips, f.config.customHeaders); err != nil {
+		return nil, err
+	}
+	if err := ValidateArchiveStorageClass(f.config.archiveClass); err != nil {
+		return nil, err
+	}
+	if engine == "local" {
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+	}
+	// the credentials may reference the secrets, they're resolved once the chunk manager is created
+	c, err := f.config.resolveSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch engine {
 	case "minio":
-		return newMinioChunkManagerWithConfig(ctx, f.config)
+		return newMinioChunkManagerWithConfig(ctx, c)
 	case "remote":
-		return NewRemoteChunkManager(ctx, f.config)
+		return NewRemoteChunkManager(ctx, c)
+	case "hdfs":
+		return NewHdfsChunkManager(ctx, c)
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
@@ -83,6 +83,10 @@ func NewMinioClient(address string, opts *minio.Options) (*minio.Client, error)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create default transport")
 	}
+	// the transport provided by the caller is kept as the backend
+	if opts.Transport != nil {
+		transport.backend = opts.Transport
+	}
 	opts.Transport = transport
 	opts.Creds = credentials.NewStaticV2("", "", "")
 	return minio.New(address, opts)
@@ -0,0 +1,406 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/milvus-io/milvus/internal/storage/gcp"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	gcsDefaultEndpoint = "https://" + gcp.GcsDefaultAddress
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+	// gcsUploadChunkSize is the chunk size of resumable uploads, it must be a multiple of 256 KiB,
+	// smaller objects are uploaded in a single request
+	gcsUploadChunkSize = 16 << 20
+	// gcsUploadRetryAttempts is the attempts to resume an upload from the bytes committed by GCS
+	gcsUploadRetryAttempts = 3
+)
+
+// GcpNativeObjectStorage accesses Google Cloud Storage by the JSON API rather than the S3 compatible XML API
+type GcpNativeObjectStorage struct {
+	client   *http.Client
+	endpoint string
+	// kmsKeyName is the customer-managed encryption key of the written objects, the bucket default if empty
+	kmsKeyName string
+	chunkSize  int
+}
+
+// gcsError is the error returned by GCS JSON API
+type gcsError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *gcsError) Error() string {
+	return fmt.Sprintf("gcs error, status code: %d, message: %s", e.StatusCode, e.Message)
+}
+
+func newGcpNativeObjectStorageWithConfig(ctx context.Context, c *config) (*GcpNativeObjectStorage, error) {
+	if c.bucketName == "" {
+		return nil, fmt.Errorf("invalid bucket name")
+	}
+	endpoint := gcsDefaultEndpoint
+	if c.address != "" && !strings.Contains(c.address, gcp.GcsDefaultAddress) {
+		scheme := "http://"
+		if c.useSSL {
+			scheme = "https://"
+		}
+		endpoint = scheme + c.address
+	}
+	client, err := newGcsHTTPClient(ctx, c, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	s := &GcpNativeObjectStorage{
+		client:     client,
+		endpoint:   endpoint,
+		kmsKeyName: c.gcpKMSKeyName,
+		chunkSize:  gcsUploadChunkSize,
+	}
+	// GCS buckets belong to projects, they're never created by milvus
+	checkBucketFn := func() error {
+		resp, err := s.do(ctx, http.MethodGet, s.bucketURL(c.bucketName), nil, nil)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	err = retry.Do(ctx, checkBucketFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// newGcsHTTPClient returns the client authorized by the service account credentials if provided,
+// otherwise by the application default credentials, e.g. workload identity.
+// A custom endpoint without credentials, e.g. an emulator, is accessed without authorization.
+func newGcsHTTPClient(ctx context.Context, c *config, endpoint string) (*http.Client, error) {
+	if c.gcpCredentialJSON != "" {
+		creds, err := google.CredentialsFromJSON(ctx, []byte(c.gcpCredentialJSON), gcsScope)
+		if err != nil {
+			return nil, err
+		}
+		return oauth2.NewClient(ctx, creds.TokenSource), nil
+	}
+	if c.useIAM || endpoint == gcsDefaultEndpoint {
+		creds, err := google.FindDefaultCredentials(ctx, gcsScope)
+		if err != nil {
+			return nil, err
+		}
+		return oauth2.NewClient(ctx, creds.TokenSource), nil
+	}
+	return &http.Client{}, nil
+}
+
+func (s *GcpNativeObjectStorage) bucketURL(bucketName string) string {
+	return s.endpoint + "/storage/v1/b/" + url.PathEscape(bucketName)
+}
+
+func (s *GcpNativeObjectStorage) objectURL(bucketName, objectName string) string {
+	return s.bucketURL(bucketName) + "/o/" + url.PathEscape(objectName)
+}
+
+func (s *GcpNativeObjectStorage) uploadURL(bucketName, objectName, uploadType string) string {
+	query := url.Values{}
+	query.Set("uploadType", uploadType)
+	query.Set("name", objectName)
+	if s.kmsKeyName != "" {
+		query.Set("kmsKeyName", s.kmsKeyName)
+	}
+	return s.endpoint + "/upload/storage/v1/b/" + url.PathEscape(bucketName) + "/o?" + query.Encode()
+}
+
+// do sends the request, the responses with unexpected status are returned as *gcsError
+func (s *GcpNativeObjectStorage) do(ctx context.Context, method, reqURL string, header http.Header, body io.Reader, expected ...int) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(expected) == 0 {
+		expected = []int{http.StatusOK}
+	}
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+	gcsErr := &gcsError{StatusCode: resp.StatusCode}
+	var errResp struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if data, err := io.ReadAll(resp.Body); err == nil {
+		if json.Unmarshal(data, &errResp) == nil && errResp.Error.Message != "" {
+			gcsErr.Message = errResp.Error.Message
+		} else {
+			gcsErr.Message = string(data)
+		}
+	}
+	return nil, gcsErr
+}
+
+func (s *GcpNativeObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	header := http.Header{}
+	if offset > 0 || size > 0 {
+		rng := fmt.Sprintf("bytes=%d-", offset)
+		if size > 0 {
+			rng += strconv.FormatInt(offset+size-1, 10)
+		}
+		header.Set("Range", rng)
+	}
+	query := url.Values{"alt": []string{"media"}}
+	pin := objectPinFrom(ctx)
+	if pin != nil && pin.get() != "" {
+		query.Set("ifGenerationMatch", pin.get())
+	}
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(bucketName, objectName)+"?"+query.Encode(), header, nil,
+		http.StatusOK, http.StatusPartialContent)
+	if err != nil {
+		var gcsErr *gcsError
+		if errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusPreconditionFailed {
+			return nil, errors.Wrap(errObjectChanged, err.Error())
+		}
+		return nil, err
+	}
+	if pin != nil {
+		if err := pin.check(resp.Header.Get("X-Goog-Generation")); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	return resp.Body, nil
+}
+
+// PutObject uploads the small objects in a single request, the others by a resumable upload session
+func (s *GcpNativeObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	if objectSize < 0 {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		reader, objectSize = bytes.NewReader(data), int64(len(data))
+	}
+	if objectSize <= int64(s.chunkSize) {
+		data := make([]byte, objectSize)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return err
+		}
+		resp, err := s.do(ctx, http.MethodPost, s.uploadURL(bucketName, objectName, "media"), nil, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, s.uploadURL(bucketName, objectName, "resumable"),
+		http.Header{"Content-Type": []string{"application/json"}}, bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	session := resp.Header.Get("Location")
+	if session == "" {
+		return fmt.Errorf("gcs resumable upload session of %s is not created", objectName)
+	}
+
+	chunk := make([]byte, s.chunkSize)
+	for offset := int64(0); offset < objectSize; {
+		n := s.chunkSize
+		if remain := objectSize - offset; remain < int64(n) {
+			n = int(remain)
+		}
+		if _, err := io.ReadFull(reader, chunk[:n]); err != nil {
+			return err
+		}
+		committed, err := s.uploadChunk(ctx, session, chunk[:n], offset, objectSize)
+		if err != nil {
+			return err
+		}
+		offset = committed
+	}
+	return nil
+}
+
+// uploadChunk sends the chunk at offset, the upload is resumed from the bytes committed by GCS on failures,
+// returns the committed size after the chunk
+func (s *GcpNativeObjectStorage) uploadChunk(ctx context.Context, session string, chunk []byte, offset, total int64) (int64, error) {
+	committed := offset
+	err := retry.Do(ctx, func() error {
+		var err error
+		if committed != offset {
+			// resume from the bytes committed by the failed attempt
+			if committed, err = s.queryUpload(ctx, session, total); err != nil {
+				return err
+			}
+			if committed < offset || committed > offset+int64(len(chunk)) {
+				return retry.Unrecoverable(fmt.Errorf("gcs resumable upload committed %d bytes out of the chunk [%d, %d)",
+					committed, offset, offset+int64(len(chunk))))
+			}
+		}
+		data := chunk[committed-offset:]
+		if len(data) == 0 {
+			return nil
+		}
+		end := committed + int64(len(data)) - 1
+		header := http.Header{"Content-Range": []string{fmt.Sprintf("bytes %d-%d/%d", committed, end, total)}}
+		resp, err := s.do(ctx, http.MethodPut, session, header, bytes.NewReader(data),
+			http.StatusOK, http.StatusCreated, http.StatusPermanentRedirect)
+		if err != nil {
+			// the failed attempt is resumed by querying the upload status
+			committed = -1
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusPermanentRedirect {
+			committed = total
+			return nil
+		}
+		committed = committedSize(resp)
+		if committed < end+1 {
+			return fmt.Errorf("gcs resumable upload committed %d bytes, expected %d", committed, end+1)
+		}
+		return nil
+	}, retry.Attempts(gcsUploadRetryAttempts))
+	return committed, err
+}
+
+// queryUpload returns the size committed by the resumable upload session
+func (s *GcpNativeObjectStorage) queryUpload(ctx context.Context, session string, total int64) (int64, error) {
+	header := http.Header{"Content-Range": []string{fmt.Sprintf("bytes */%d", total)}}
+	resp, err := s.do(ctx, http.MethodPut, session, header, bytes.NewReader(nil),
+		http.StatusOK, http.StatusCreated, http.StatusPermanentRedirect)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPermanentRedirect {
+		return total, nil
+	}
+	return committedSize(resp), nil
+}
+
+// committedSize parses the Range header, e.g. bytes=0-1023, of an incomplete resumable upload
+func committedSize(resp *http.Response) int64 {
+	rng := resp.Header.Get("Range")
+	idx := strings.LastIndex(rng, "-")
+	if idx < 0 {
+		return 0
+	}
+	last, err := strconv.ParseInt(rng[idx+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return last + 1
+}
+
+func (s *GcpNativeObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(bucketName, objectName), nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	var object struct {
+		Size string `json:"size"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&object); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(object.Size, 10, 64)
+}
+
+func (s *GcpNativeObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	objects := map[string]time.Time{}
+	pageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("prefix", prefix)
+		if !recursive {
+			query.Set("delimiter", "/")
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		resp, err := s.do(ctx, http.MethodGet, s.bucketURL(bucketName)+"/o?"+query.Encode(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Items []struct {
+				Name    string    `json:"name"`
+				Updated time.Time `json:"updated"`
+			} `json:"items"`
+			Prefixes      []string `json:"prefixes"`
+			NextPageToken string   `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			objects[item.Name] = item.Updated
+		}
+		// the common prefixes are listed as directories like minio does
+		for _, p := range page.Prefixes {
+			objects[p] = time.Time{}
+		}
+		if page.NextPageToken == "" {
+			return objects, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// RemoveObject succeeds if the object doesn't exist like minio does
+func (s *GcpNativeObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.objectURL(bucketName, objectName), nil, nil,
+		http.StatusOK, http.StatusNoContent)
+	if err != nil {
+		var gcsErr *gcsError
+		if errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,302 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGcsServer serves the subset of GCS JSON API used by GcpNativeObjectStorage
+type fakeGcsServer struct {
+	*httptest.Server
+	bucket string
+
+	mu       sync.Mutex
+	objects  map[string][]byte
+	sessions map[string][]byte
+	kmsKeys  map[string]string
+	// failPuts is the number of resumable upload requests to fail after committing half of the chunk
+	failPuts int
+}
+
+func newFakeGcsServer(bucket string) *fakeGcsServer {
+	s := &fakeGcsServer{
+		bucket:   bucket,
+		objects:  map[string][]byte{},
+		sessions: map[string][]byte{},
+		kmsKeys:  map[string]string{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *fakeGcsServer) address() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+func (s *fakeGcsServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := r.URL.EscapedPath()
+	bucketPrefix := "/storage/v1/b/" + s.bucket
+	switch {
+	case strings.HasPrefix(path, "/session/"):
+		s.serveUpload(w, r, strings.TrimPrefix(path, "/session/"))
+	case path == "/upload/storage/v1/b/"+s.bucket+"/o":
+		name := r.URL.Query().Get("name")
+		s.kmsKeys[name] = r.URL.Query().Get("kmsKeyName")
+		if r.URL.Query().Get("uploadType") == "resumable" {
+			s.sessions[name] = nil
+			w.Header().Set("Location", s.URL+"/session/"+url.PathEscape(name))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		s.objects[name] = data
+	case path == bucketPrefix:
+		w.Write([]byte(`{}`))
+	case path == bucketPrefix+"/o":
+		s.serveList(w, r)
+	case strings.HasPrefix(path, bucketPrefix+"/o/"):
+		name, _ := url.PathUnescape(strings.TrimPrefix(path, bucketPrefix+"/o/"))
+		data, ok := s.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404, "message": "No such object"}}`))
+			return
+		}
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(s.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Query().Get("alt") == "media":
+			var start, end int
+			if rng := r.Header.Get("Range"); rng != "" {
+				end = len(data) - 1
+				fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write(data[start : end+1])
+				return
+			}
+			w.Write(data)
+		default:
+			json.NewEncoder(w).Encode(map[string]string{"name": name, "size": fmt.Sprint(len(data))})
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *fakeGcsServer) serveList(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	delimiter := r.URL.Query().Get("delimiter")
+	type item struct {
+		Name    string    `json:"name"`
+		Updated time.Time `json:"updated"`
+	}
+	var names []string
+	for name := range s.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var items []item
+	prefixes := map[string]struct{}{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if idx := strings.Index(name[len(prefix):], delimiter); idx >= 0 {
+				prefixes[name[:len(prefix)+idx+1]] = struct{}{}
+				continue
+			}
+		}
+		items = append(items, item{Name: name, Updated: time.Now()})
+	}
+	// one item per page to exercise the paging
+	page := struct {
+		Items         []item   `json:"items"`
+		Prefixes      []string `json:"prefixes"`
+		NextPageToken string   `json:"nextPageToken,omitempty"`
+	}{}
+	for p := range prefixes {
+		page.Prefixes = append(page.Prefixes, p)
+	}
+	var offset int
+	fmt.Sscan(r.URL.Query().Get("pageToken"), &offset)
+	if offset < len(items) {
+		page.Items = items[offset : offset+1]
+		if offset+1 < len(items) {
+			page.NextPageToken = fmt.Sprint(offset + 1)
+		}
+	}
+	json.NewEncoder(w).Encode(page)
+}
+
+func (s *fakeGcsServer) serveUpload(w http.ResponseWriter, r *http.Request, session string) {
+	name, _ := url.PathUnescape(session)
+	data, ok := s.sessions[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	var start, end, total int
+	if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		// status query
+		fmt.Sscanf(r.Header.Get("Content-Range"), "bytes */%d", &total)
+	} else {
+		if start != len(data) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if s.failPuts > 0 {
+			s.failPuts--
+			s.sessions[name] = append(data, body[:len(body)/2]...)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		data = append(data, body...)
+		s.sessions[name] = data
+	}
+	if len(data) == total {
+		s.objects[name] = data
+		delete(s.sessions, name)
+		return
+	}
+	if len(data) > 0 {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(data)-1))
+	}
+	w.WriteHeader(http.StatusPermanentRedirect)
+}
+
+func TestGcpNativeObjectStorage(t *testing.T) {
+	ctx := context.Background()
+	bucketName := "gcs-bucket"
+	server := newFakeGcsServer(bucketName)
+	defer server.Close()
+
+	config := config{
+		address:       server.address(),
+		bucketName:    bucketName,
+		cloudProvider: CloudProviderGCPNative,
+		gcpKMSKeyName: "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+	}
+
+	t.Run("test initialize", func(t *testing.T) {
+		config := config
+		config.bucketName = ""
+		_, err := newGcpNativeObjectStorageWithConfig(ctx, &config)
+		assert.Error(t, err)
+	})
+
+	testCM, err := newGcpNativeObjectStorageWithConfig(ctx, &config)
+	require.NoError(t, err)
+
+	t.Run("test put and get", func(t *testing.T) {
+		err := testCM.PutObject(ctx, bucketName, "a/b/c", bytes.NewReader([]byte("0123456789")), 10)
+		assert.NoError(t, err)
+		assert.Equal(t, config.gcpKMSKeyName, server.kmsKeys["a/b/c"])
+
+		size, err := testCM.StatObject(ctx, bucketName, "a/b/c")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, size)
+
+		reader, err := testCM.GetObject(ctx, bucketName, "a/b/c", 0, 0)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "0123456789", string(data))
+
+		reader, err = testCM.GetObject(ctx, bucketName, "a/b/c", 2, 3)
+		assert.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "234", string(data))
+
+		_, err = testCM.GetObject(ctx, bucketName, "a/b/d", 0, 0)
+		gcsErr, ok := err.(*gcsError)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, gcsErr.StatusCode)
+		assert.Equal(t, "No such object", gcsErr.Message)
+
+		_, err = testCM.StatObject(ctx, bucketName, "a/b/d")
+		assert.Error(t, err)
+	})
+
+	t.Run("test resumable upload", func(t *testing.T) {
+		testCM.chunkSize = 4
+		defer func() { testCM.chunkSize = gcsUploadChunkSize }()
+
+		value := []byte("resumable upload across chunks")
+		err := testCM.PutObject(ctx, bucketName, "resumable", bytes.NewReader(value), int64(len(value)))
+		assert.NoError(t, err)
+		assert.Equal(t, value, server.objects["resumable"])
+		assert.Equal(t, config.gcpKMSKeyName, server.kmsKeys["resumable"])
+
+		// the failed chunks are resumed from the committed bytes
+		server.failPuts = 2
+		err = testCM.PutObject(ctx, bucketName, "resumed", bytes.NewReader(value), -1)
+		assert.NoError(t, err)
+		assert.Equal(t, value, server.objects["resumed"])
+	})
+
+	t.Run("test list and remove", func(t *testing.T) {
+		for _, key := range []string{"list/a", "list/b", "list/c/d"} {
+			err := testCM.PutObject(ctx, bucketName, key, bytes.NewReader([]byte(key)), int64(len(key)))
+			require.NoError(t, err)
+		}
+
+		objects, err := testCM.ListObjects(ctx, bucketName, "list/", false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"list/a", "list/b", "list/c/"}, listedKeys(objects))
+
+		objects, err = testCM.ListObjects(ctx, bucketName, "list/", true)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"list/a", "list/b", "list/c/d"}, listedKeys(objects))
+
+		assert.NoError(t, testCM.RemoveObject(ctx, bucketName, "list/a"))
+		// removing an absent object succeeds
+		assert.NoError(t, testCM.RemoveObject(ctx, bucketName, "list/a"))
+		objects, err = testCM.ListObjects(ctx, bucketName, "list/", true)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"list/b", "list/c/d"}, listedKeys(objects))
+	})
+}
+
+func listedKeys(objects map[string]time.Time) []string {
+	ret := make([]string, 0, len(objects))
+	for key := range objects {
+		ret = append(ret, key)
+	}
+	return ret
+}
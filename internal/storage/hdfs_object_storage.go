@@ -0,0 +1,373 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	// hdfsMaxReplication is the default dfs.replication.max of HDFS
+	hdfsMaxReplication = 512
+	hdfsDirectoryType  = "DIRECTORY"
+)
+
+// HdfsObjectStorage accesses HDFS by the WebHDFS REST API of the namenode,
+// the buckets are the top level directories, the root directory is used if the bucket name is empty.
+// Kerberos secured clusters are accessed with a delegation token instead of SPNEGO, the token is rotated
+// by replacing the token file.
+type HdfsObjectStorage struct {
+	client      *http.Client
+	endpoint    string
+	user        string
+	token       *hdfsToken
+	replication int
+}
+
+// hdfsError is the RemoteException returned by WebHDFS
+type hdfsError struct {
+	StatusCode int
+	Exception  string
+	Message    string
+}
+
+func (e *hdfsError) Error() string {
+	return fmt.Sprintf("hdfs error, status code: %d, exception: %s, message: %s", e.StatusCode, e.Exception, e.Message)
+}
+
+type hdfsFileStatus struct {
+	PathSuffix       string `json:"pathSuffix"`
+	Type             string `json:"type"`
+	Length           int64  `json:"length"`
+	ModificationTime int64  `json:"modificationTime"`
+}
+
+func validateHdfsConfig(c *config) error {
+	if c.address == "" {
+		return fmt.Errorf("invalid hdfs namenode address")
+	}
+	if c.hdfsReplication < 0 || c.hdfsReplication > hdfsMaxReplication {
+		return fmt.Errorf("invalid hdfs replication %d, should be in [0, %d]", c.hdfsReplication, hdfsMaxReplication)
+	}
+	return nil
+}
+
+func newHdfsObjectStorageWithConfig(ctx context.Context, c *config) (*HdfsObjectStorage, error) {
+	if err := validateHdfsConfig(c); err != nil {
+		return nil, err
+	}
+	token, err := newHdfsToken(c.hdfsToken, c.hdfsTokenFile)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "http://"
+	if c.useSSL {
+		scheme = "https://"
+	}
+	s := &HdfsObjectStorage{
+		client: &http.Client{
+			// the redirects to datanodes are followed explicitly, as the data must not be sent to the namenode
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		endpoint:    scheme + c.address + "/webhdfs/v1",
+		user:        c.hdfsUser,
+		token:       token,
+		replication: c.hdfsReplication,
+	}
+	checkBucketFn := func() error {
+		_, err := s.fileStatus(ctx, c.bucketName, "")
+		if err == nil || !isHdfsNotFound(err) || !c.createBucket {
+			return err
+		}
+		return s.mkdirs(ctx, c.bucketName)
+	}
+	err = retry.Do(ctx, checkBucketFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewHdfsChunkManager returns the chunk manager of HDFS, the files are stored under rootPath of the root directory
+func NewHdfsChunkManager(ctx context.Context, c *config) (*RemoteChunkManager, error) {
+	client, err := newHdfsObjectStorageWithConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	mcm, err := newRemoteChunkManagerWithBackend(client, c)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("hdfs chunk manager init success.", zap.String("address", c.address), zap.String("root", mcm.RootPath()))
+	return mcm, nil
+}
+
+func isHdfsNotFound(err error) bool {
+	var hdfsErr *hdfsError
+	return errors.As(err, &hdfsErr) && hdfsErr.StatusCode == http.StatusNotFound
+}
+
+// isHdfsInvalidToken returns whether the delegation token is rejected, e.g. it's expired or cancelled
+func isHdfsInvalidToken(err error) bool {
+	var hdfsErr *hdfsError
+	return errors.As(err, &hdfsErr) && (hdfsErr.StatusCode == http.StatusUnauthorized ||
+		hdfsErr.StatusCode == http.StatusForbidden && strings.Contains(hdfsErr.Exception, "InvalidToken"))
+}
+
+// opURL returns the url of the operation on the object, the bucket itself if objectName is empty
+func (s *HdfsObjectStorage) opURL(bucketName, objectName, op string, query url.Values, token string) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("op", op)
+	if token != "" {
+		query.Set("delegation", token)
+	} else if s.user != "" {
+		query.Set("user.name", s.user)
+	}
+	p := &url.URL{Path: "/" + strings.TrimLeft(path.Join(bucketName, objectName), "/")}
+	return s.endpoint + p.EscapedPath() + "?" + query.Encode()
+}
+
+// doOp sends the operation to the namenode, it's sent again with the token re-read from the token file
+// once the token is rejected, as the token may be rotated since the last check
+func (s *HdfsObjectStorage) doOp(ctx context.Context, method, bucketName, objectName, op string, query url.Values, expected ...int) (*http.Response, error) {
+	token, err := s.token.get(false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, method, s.opURL(bucketName, objectName, op, query, token), nil, expected...)
+	if !isHdfsInvalidToken(err) || s.token.file == "" {
+		return resp, err
+	}
+	rotated, tokenErr := s.token.get(true)
+	if tokenErr != nil || rotated == token {
+		return nil, err
+	}
+	log.Info("hdfs delegation token rejected, retry with the rotated token", zap.String("op", op), zap.Error(err))
+	return s.do(ctx, method, s.opURL(bucketName, objectName, op, query, rotated), nil, expected...)
+}
+
+// do sends the request, the responses with unexpected status are returned as *hdfsError
+func (s *HdfsObjectStorage) do(ctx context.Context, method, reqURL string, body io.Reader, expected ...int) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+		// send the known size instead of chunked encoding
+		if r, ok := body.(*io.LimitedReader); ok {
+			req.ContentLength = r.N
+		}
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(expected) == 0 {
+		expected = []int{http.StatusOK}
+	}
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+	hdfsErr := &hdfsError{StatusCode: resp.StatusCode}
+	var errResp struct {
+		RemoteException struct {
+			Exception string `json:"exception"`
+			Message   string `json:"message"`
+		} `json:"RemoteException"`
+	}
+	if data, err := io.ReadAll(resp.Body); err == nil {
+		if json.Unmarshal(data, &errResp) == nil && errResp.RemoteException.Exception != "" {
+			hdfsErr.Exception = errResp.RemoteException.Exception
+			hdfsErr.Message = errResp.RemoteException.Message
+		} else {
+			hdfsErr.Message = string(data)
+		}
+	}
+	return nil, hdfsErr
+}
+
+// redirect returns the datanode location the namenode redirects the operation to
+func (s *HdfsObjectStorage) redirect(ctx context.Context, method, bucketName, objectName, op string, query url.Values) (string, error) {
+	resp, err := s.doOp(ctx, method, bucketName, objectName, op, query, http.StatusTemporaryRedirect)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("hdfs namenode redirects %s of %s without location", op, path.Join(bucketName, objectName))
+	}
+	return location, nil
+}
+
+func (s *HdfsObjectStorage) mkdirs(ctx context.Context, bucketName string) error {
+	resp, err := s.doOp(ctx, http.MethodPut, bucketName, "", "MKDIRS", nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *HdfsObjectStorage) fileStatus(ctx context.Context, bucketName, objectName string) (*hdfsFileStatus, error) {
+	resp, err := s.doOp(ctx, http.MethodGet, bucketName, objectName, "GETFILESTATUS", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var status struct {
+		FileStatus hdfsFileStatus `json:"FileStatus"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status.FileStatus, nil
+}
+
+func (s *HdfsObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	query := url.Values{}
+	if offset > 0 {
+		query.Set("offset", strconv.FormatInt(offset, 10))
+	}
+	if size > 0 {
+		query.Set("length", strconv.FormatInt(size, 10))
+	}
+	location, err := s.redirect(ctx, http.MethodGet, bucketName, objectName, "OPEN", query)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// PutObject overwrites the file, the parent directories are created by HDFS
+func (s *HdfsObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	query := url.Values{}
+	query.Set("overwrite", "true")
+	if s.replication > 0 {
+		query.Set("replication", strconv.Itoa(s.replication))
+	}
+	location, err := s.redirect(ctx, http.MethodPut, bucketName, objectName, "CREATE", query)
+	if err != nil {
+		return err
+	}
+	if objectSize >= 0 {
+		reader = io.LimitReader(reader, objectSize)
+	}
+	resp, err := s.do(ctx, http.MethodPut, location, reader, http.StatusCreated)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *HdfsObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	status, err := s.fileStatus(ctx, bucketName, objectName)
+	if err != nil {
+		return 0, err
+	}
+	if status.Type == hdfsDirectoryType {
+		return 0, &hdfsError{StatusCode: http.StatusNotFound, Exception: "FileNotFoundException", Message: objectName + " is a directory"}
+	}
+	return status.Length, nil
+}
+
+// ListObjects lists the directory of prefix, the sub directories are listed with zero time like minio does if not recursive
+func (s *HdfsObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	objects := map[string]time.Time{}
+	dir := prefix[:strings.LastIndex(prefix, "/")+1]
+	if err := s.listDir(ctx, bucketName, dir, prefix, recursive, objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (s *HdfsObjectStorage) listDir(ctx context.Context, bucketName, dir, prefix string, recursive bool, objects map[string]time.Time) error {
+	resp, err := s.doOp(ctx, http.MethodGet, bucketName, dir, "LISTSTATUS", nil)
+	if err != nil {
+		if isHdfsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	var list struct {
+		FileStatuses struct {
+			FileStatus []hdfsFileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	for _, status := range list.FileStatuses.FileStatus {
+		name := dir + status.PathSuffix
+		if status.Type == hdfsDirectoryType {
+			name += "/"
+		}
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		switch {
+		case status.Type != hdfsDirectoryType:
+			objects[name] = time.UnixMilli(status.ModificationTime)
+		case recursive:
+			if err := s.listDir(ctx, bucketName, name, name, recursive, objects); err != nil {
+				return err
+			}
+		default:
+			objects[name] = time.Time{}
+		}
+	}
+	return nil
+}
+
+// RemoveObject succeeds if the file doesn't exist like minio does
+func (s *HdfsObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	query := url.Values{}
+	query.Set("recursive", "false")
+	resp, err := s.doOp(ctx, http.MethodDelete, bucketName, objectName, "DELETE", query)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
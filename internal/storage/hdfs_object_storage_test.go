@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWebHdfsServer serves the subset of WebHDFS used by HdfsObjectStorage,
+// the data operations are redirected to /datanode like the namenode does
+type fakeWebHdfsServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	files map[string][]byte
+	dirs  map[string]struct{}
+	// queries records the query of the operations sent to the namenode
+	queries map[string]map[string]string
+	// rejectedToken is the delegation token rejected as InvalidToken
+	rejectedToken string
+}
+
+func newFakeWebHdfsServer() *fakeWebHdfsServer {
+	s := &fakeWebHdfsServer{
+		files:   map[string][]byte{},
+		dirs:    map[string]struct{}{"/": {}},
+		queries: map[string]map[string]string{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *fakeWebHdfsServer) notFound(w http.ResponseWriter, p string) {
+	w.WriteHeader(http.StatusNotFound)
+	w.Write([]byte(`{"RemoteException": {"exception": "FileNotFoundException", "message": "File does not exist: ` + p + `"}}`))
+}
+
+func (s *fakeWebHdfsServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	if strings.HasPrefix(r.URL.Path, "/datanode") {
+		p := strings.TrimPrefix(r.URL.Path, "/datanode")
+		if r.Method == http.MethodPut {
+			data, _ := io.ReadAll(r.Body)
+			s.files[p] = data
+			for dir := p[:strings.LastIndex(p, "/")]; dir != ""; dir = dir[:strings.LastIndex(dir, "/")] {
+				s.dirs[dir] = struct{}{}
+			}
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		data := s.files[p]
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		end := len(data)
+		if length, err := strconv.Atoi(query.Get("length")); err == nil {
+			end = offset + length
+		}
+		w.Write(data[offset:end])
+		return
+	}
+
+	p := strings.TrimPrefix(r.URL.Path, "/webhdfs/v1")
+	if p != "/" {
+		p = strings.TrimSuffix(p, "/")
+	}
+	op := query.Get("op")
+	if token := query.Get("delegation"); token != "" && token == s.rejectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"RemoteException": {"exception": "InvalidToken", "message": "token is expired"}}`))
+		return
+	}
+	s.queries[op] = map[string]string{}
+	for k := range query {
+		s.queries[op][k] = query.Get(k)
+	}
+	_, isDir := s.dirs[p]
+	data, isFile := s.files[p]
+	switch op {
+	case "MKDIRS":
+		s.dirs[p] = struct{}{}
+		w.Write([]byte(`{"boolean": true}`))
+	case "CREATE", "OPEN":
+		if op == "OPEN" && !isFile {
+			s.notFound(w, p)
+			return
+		}
+		w.Header().Set("Location", s.URL+"/datanode"+p+"?"+r.URL.RawQuery)
+		w.WriteHeader(http.StatusTemporaryRedirect)
+	case "GETFILESTATUS":
+		switch {
+		case isFile:
+			json.NewEncoder(w).Encode(map[string]interface{}{"FileStatus": hdfsFileStatus{Type: "FILE", Length: int64(len(data))}})
+		case isDir:
+			json.NewEncoder(w).Encode(map[string]interface{}{"FileStatus": hdfsFileStatus{Type: hdfsDirectoryType}})
+		default:
+			s.notFound(w, p)
+		}
+	case "LISTSTATUS":
+		if !isDir {
+			s.notFound(w, p)
+			return
+		}
+		statuses := []hdfsFileStatus{}
+		parent := strings.TrimSuffix(p, "/") + "/"
+		for name, data := range s.files {
+			if suffix := strings.TrimPrefix(name, parent); suffix != name && !strings.Contains(suffix, "/") {
+				statuses = append(statuses, hdfsFileStatus{PathSuffix: suffix, Type: "FILE", Length: int64(len(data)), ModificationTime: 1000})
+			}
+		}
+		for name := range s.dirs {
+			if suffix := strings.TrimPrefix(name, parent); suffix != name && suffix != "" && !strings.Contains(suffix, "/") {
+				statuses = append(statuses, hdfsFileStatus{PathSuffix: suffix, Type: hdfsDirectoryType})
+			}
+		}
+		resp := map[string]interface{}{"FileStatuses": map[string]interface{}{"FileStatus": statuses}}
+		json.NewEncoder(w).Encode(resp)
+	case "DELETE":
+		delete(s.files, p)
+		w.Write([]byte(`{"boolean": ` + strconv.FormatBool(isFile) + `}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestHdfsObjectStorage(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeWebHdfsServer()
+	defer server.Close()
+
+	config := config{
+		address:         strings.TrimPrefix(server.URL, "http://"),
+		bucketName:      "milvus-bucket",
+		createBucket:    true,
+		hdfsUser:        "milvus",
+		hdfsReplication: 2,
+	}
+
+	t.Run("test validate", func(t *testing.T) {
+		invalid := config
+		invalid.address = ""
+		_, err := newHdfsObjectStorageWithConfig(ctx, &invalid)
+		assert.Error(t, err)
+
+		invalid = config
+		invalid.hdfsReplication = hdfsMaxReplication + 1
+		_, err = newHdfsObjectStorageWithConfig(ctx, &invalid)
+		assert.Error(t, err)
+	})
+
+	testCM, err := newHdfsObjectStorageWithConfig(ctx, &config)
+	require.NoError(t, err)
+	assert.Contains(t, server.dirs, "/milvus-bucket")
+
+	t.Run("test put and get", func(t *testing.T) {
+		value := []byte("0123456789")
+		err := testCM.PutObject(ctx, config.bucketName, "a/b/c", bytes.NewReader(value), int64(len(value)))
+		assert.NoError(t, err)
+		assert.Equal(t, "2", server.queries["CREATE"]["replication"])
+		assert.Equal(t, "milvus", server.queries["CREATE"]["user.name"])
+
+		size, err := testCM.StatObject(ctx, config.bucketName, "a/b/c")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, size)
+
+		reader, err := testCM.GetObject(ctx, config.bucketName, "a/b/c", 2, 3)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "234", string(data))
+
+		_, err = testCM.GetObject(ctx, config.bucketName, "a/b/d", 0, 0)
+		assert.True(t, isHdfsNotFound(err))
+		assert.Equal(t, "FileNotFoundException", err.(*hdfsError).Exception)
+
+		_, err = testCM.StatObject(ctx, config.bucketName, "a/b")
+		assert.True(t, isHdfsNotFound(err))
+	})
+
+	t.Run("test list and remove", func(t *testing.T) {
+		for _, key := range []string{"list/a", "list/b", "list/c/d"} {
+			err := testCM.PutObject(ctx, config.bucketName, key, bytes.NewReader([]byte(key)), int64(len(key)))
+			require.NoError(t, err)
+		}
+
+		objects, err := testCM.ListObjects(ctx, config.bucketName, "list/", false)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 3)
+		assert.Contains(t, objects, "list/c/")
+		assert.True(t, objects["list/c/"].IsZero())
+		assert.Equal(t, int64(1000), objects["list/a"].UnixMilli())
+
+		objects, err = testCM.ListObjects(ctx, config.bucketName, "list/", true)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 3)
+		assert.Contains(t, objects, "list/c/d")
+
+		objects, err = testCM.ListObjects(ctx, config.bucketName, "list/a", true)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 1)
+
+		objects, err = testCM.ListObjects(ctx, config.bucketName, "absent/", true)
+		assert.NoError(t, err)
+		assert.Empty(t, objects)
+
+		assert.NoError(t, testCM.RemoveObject(ctx, config.bucketName, "list/a"))
+		// removing an absent file succeeds
+		assert.NoError(t, testCM.RemoveObject(ctx, config.bucketName, "list/a"))
+		objects, err = testCM.ListObjects(ctx, config.bucketName, "list/", true)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 2)
+	})
+
+	t.Run("test delegation token", func(t *testing.T) {
+		config := config
+		config.hdfsToken = "token"
+		testCM, err := newHdfsObjectStorageWithConfig(ctx, &config)
+		require.NoError(t, err)
+		_, err = testCM.StatObject(ctx, config.bucketName, "a/b/c")
+		assert.NoError(t, err)
+		assert.Equal(t, "token", server.queries["GETFILESTATUS"]["delegation"])
+		assert.NotContains(t, server.queries["GETFILESTATUS"], "user.name")
+	})
+}
+
+func TestHdfsError(t *testing.T) {
+	notFound := errors.Wrap(&hdfsError{StatusCode: http.StatusNotFound, Exception: "FileNotFoundException"}, "get object")
+	assert.True(t, isHdfsNotFound(notFound))
+	assert.False(t, isHdfsInvalidToken(notFound))
+
+	invalidToken := errors.Wrap(&hdfsError{StatusCode: http.StatusForbidden, Exception: "InvalidToken"}, "get object")
+	assert.True(t, isHdfsInvalidToken(invalidToken))
+	assert.False(t, isHdfsNotFound(invalidToken))
+	assert.False(t, isHdfsInvalidToken(&hdfsError{StatusCode: http.StatusForbidden, Exception: "AccessControlException"}))
+
+	assert.False(t, isHdfsNotFound(errors.New("connection refused")))
+}
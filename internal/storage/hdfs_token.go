@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+var (
+	// hdfsTokenCheckInterval is the interval at which the token file is checked for a rotated token
+	hdfsTokenCheckInterval = 10 * time.Second
+	// hdfsTokenExpiryWarning is how long before the max date of the token a warning is logged
+	hdfsTokenExpiryWarning = time.Hour
+)
+
+// hdfsToken is the delegation token to access HDFS. If a token file is configured the token is re-read once
+// the file changes, so that it's rotated by the job renewing the tokens outside of Milvus, which requires kerberos.
+// The max date of the token is decoded from its identifier, an expired token is not sent to the namenode.
+type hdfsToken struct {
+	file string
+
+	mu      sync.Mutex
+	token   string
+	expiry  time.Time
+	modTime time.Time
+	checked time.Time
+}
+
+func newHdfsToken(token, file string) (*hdfsToken, error) {
+	t := &hdfsToken{file: file}
+	if file == "" {
+		t.set(token)
+		return t, nil
+	}
+	if err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// get returns the token, the token file is re-read if it's not checked within hdfsTokenCheckInterval or force is true.
+// It returns an InvalidToken *hdfsError once the token expires.
+func (t *hdfsToken) get(force bool) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.file != "" && (force || time.Since(t.checked) >= hdfsTokenCheckInterval) {
+		if err := t.reload(); err != nil {
+			// keep using the current token, the file may be in the middle of a rotation
+			log.Warn("failed to reload hdfs delegation token", zap.String("file", t.file), zap.Error(err))
+		}
+	}
+	if t.token == "" || t.expiry.IsZero() {
+		return t.token, nil
+	}
+	if remaining := time.Until(t.expiry); remaining <= 0 {
+		return "", &hdfsError{
+			StatusCode: http.StatusUnauthorized,
+			Exception:  "InvalidToken",
+			Message:    fmt.Sprintf("hdfs delegation token expired at %s", t.expiry),
+		}
+	} else if remaining < hdfsTokenExpiryWarning {
+		log.RatedWarn(60, "hdfs delegation token is about to expire", zap.Time("expiry", t.expiry))
+	}
+	return t.token, nil
+}
+
+// reload re-reads the token file if it's modified, the lock must be held by caller if t is shared
+func (t *hdfsToken) reload() error {
+	t.checked = time.Now()
+	info, err := os.Stat(t.file)
+	if err != nil {
+		return err
+	}
+	if !t.modTime.IsZero() && info.ModTime().Equal(t.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(t.file)
+	if err != nil {
+		return err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return fmt.Errorf("empty hdfs delegation token file %s", t.file)
+	}
+	t.modTime = info.ModTime()
+	if token != t.token {
+		t.set(token)
+		log.Info("hdfs delegation token loaded", zap.String("file", t.file), zap.Time("expiry", t.expiry))
+	}
+	return nil
+}
+
+func (t *hdfsToken) set(token string) {
+	t.token = token
+	t.expiry = time.Time{}
+	if token == "" {
+		return
+	}
+	expiry, err := parseHdfsTokenExpiry(token)
+	if err != nil {
+		log.Warn("failed to decode the max date of hdfs delegation token, it's used until rejected", zap.Error(err))
+		return
+	}
+	t.expiry = expiry
+}
+
+// parseHdfsTokenExpiry decodes the max date of a delegation token in the url string form of hadoop,
+// which is the url safe base64 of the Writable token whose identifier is an AbstractDelegationTokenIdentifier
+func parseHdfsTokenExpiry(token string) (time.Time, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(token, "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	identifier, err := readWritableBytes(bytes.NewReader(data))
+	if err != nil {
+		return time.Time{}, err
+	}
+	r := bytes.NewReader(identifier)
+	if version, err := r.ReadByte(); err != nil {
+		return time.Time{}, err
+	} else if version != 0 {
+		return time.Time{}, fmt.Errorf("unknown delegation token identifier version %d", version)
+	}
+	// owner, renewer and real user
+	for i := 0; i < 3; i++ {
+		if _, err := readWritableBytes(r); err != nil {
+			return time.Time{}, err
+		}
+	}
+	// issue date
+	if _, err := readVLong(r); err != nil {
+		return time.Time{}, err
+	}
+	maxDate, err := readVLong(r)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(maxDate), nil
+}
+
+// readWritableBytes reads the bytes prefixed by their vint length, e.g. a Text of hadoop
+func readWritableBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := readVLong(r)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > int64(r.Len()) {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readVLong reads a zero-compressed long of hadoop WritableUtils
+func readVLong(r *bytes.Reader) (int64, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	first := int8(b)
+	if first >= -112 {
+		return int64(first), nil
+	}
+	negative := first < -120
+	size := -111 - int(first)
+	if negative {
+		size = -119 - int(first)
+	}
+	var v int64
+	for i := 1; i < size; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<8 | int64(b)
+	}
+	if negative {
+		v = ^v
+	}
+	return v, nil
+}
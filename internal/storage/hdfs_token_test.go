@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeVLong writes a zero-compressed long like hadoop WritableUtils does
+func writeVLong(buf *bytes.Buffer, v int64) {
+	if v >= -112 && v <= 127 {
+		buf.WriteByte(byte(v))
+		return
+	}
+	size := -112
+	if v < 0 {
+		v = ^v
+		size = -120
+	}
+	for tmp := v; tmp != 0; tmp >>= 8 {
+		size--
+	}
+	buf.WriteByte(byte(int8(size)))
+	if size < -120 {
+		size = -(size + 120)
+	} else {
+		size = -(size + 112)
+	}
+	for i := size; i != 0; i-- {
+		buf.WriteByte(byte(v >> ((i - 1) * 8)))
+	}
+}
+
+func writeWritableBytes(buf *bytes.Buffer, data []byte) {
+	writeVLong(buf, int64(len(data)))
+	buf.Write(data)
+}
+
+// encodeHdfsToken encodes a delegation token in the url string form of hadoop
+func encodeHdfsToken(owner string, maxDate time.Time) string {
+	identifier := &bytes.Buffer{}
+	identifier.WriteByte(0)
+	writeWritableBytes(identifier, []byte(owner))
+	writeWritableBytes(identifier, []byte("yarn"))
+	writeWritableBytes(identifier, nil)
+	writeVLong(identifier, maxDate.Add(-7*24*time.Hour).UnixMilli())
+	writeVLong(identifier, maxDate.UnixMilli())
+	writeVLong(identifier, 42)
+	writeVLong(identifier, 7)
+
+	token := &bytes.Buffer{}
+	writeWritableBytes(token, identifier.Bytes())
+	writeWritableBytes(token, []byte("password"))
+	writeWritableBytes(token, []byte("HDFS_DELEGATION_TOKEN"))
+	writeWritableBytes(token, []byte("ha-hdfs:cluster"))
+	return base64.RawURLEncoding.EncodeToString(token.Bytes())
+}
+
+func TestParseHdfsTokenExpiry(t *testing.T) {
+	maxDate := time.UnixMilli(time.Now().Add(time.Hour).UnixMilli())
+	expiry, err := parseHdfsTokenExpiry(encodeHdfsToken("milvus", maxDate))
+	assert.NoError(t, err)
+	assert.True(t, maxDate.Equal(expiry))
+
+	for _, v := range []int64{0, 127, -112, 128, -113, 1 << 40, -(1 << 40)} {
+		buf := &bytes.Buffer{}
+		writeVLong(buf, v)
+		decoded, err := readVLong(bytes.NewReader(buf.Bytes()))
+		assert.NoError(t, err)
+		assert.Equal(t, v, decoded)
+	}
+
+	_, err = parseHdfsTokenExpiry("not a token")
+	assert.Error(t, err)
+	_, err = parseHdfsTokenExpiry(base64.RawURLEncoding.EncodeToString([]byte{100, 1}))
+	assert.Error(t, err)
+}
+
+func TestHdfsToken(t *testing.T) {
+	t.Run("static token", func(t *testing.T) {
+		token, err := newHdfsToken("opaque", "")
+		require.NoError(t, err)
+		// the token of unknown form is used until rejected
+		value, err := token.get(false)
+		assert.NoError(t, err)
+		assert.Equal(t, "opaque", value)
+
+		expired := encodeHdfsToken("milvus", time.Now().Add(-time.Minute))
+		token, err = newHdfsToken(expired, "")
+		require.NoError(t, err)
+		_, err = token.get(false)
+		assert.True(t, isHdfsInvalidToken(err))
+		assert.False(t, isRetryableError(err))
+	})
+
+	t.Run("token file", func(t *testing.T) {
+		file := path.Join(t.TempDir(), "token")
+		_, err := newHdfsToken("", file)
+		assert.Error(t, err)
+
+		first := encodeHdfsToken("first", time.Now().Add(time.Hour))
+		require.NoError(t, os.WriteFile(file, []byte(first+"\n"), 0o600))
+		token, err := newHdfsToken("ignored", file)
+		require.NoError(t, err)
+		value, err := token.get(false)
+		assert.NoError(t, err)
+		assert.Equal(t, first, value)
+
+		// the rotated token is read once the file is checked again
+		second := encodeHdfsToken("second", time.Now().Add(2*time.Hour))
+		require.NoError(t, os.WriteFile(file, []byte(second), 0o600))
+		require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+		value, err = token.get(false)
+		assert.NoError(t, err)
+		assert.Equal(t, first, value)
+		value, err = token.get(true)
+		assert.NoError(t, err)
+		assert.Equal(t, second, value)
+
+		// the current token is kept if the file can't be read
+		require.NoError(t, os.Remove(file))
+		value, err = token.get(true)
+		assert.NoError(t, err)
+		assert.Equal(t, second, value)
+	})
+}
+
+func TestHdfsObjectStorage_RotateToken(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeWebHdfsServer()
+	defer server.Close()
+
+	file := path.Join(t.TempDir(), "token")
+	first := encodeHdfsToken("first", time.Now().Add(time.Hour))
+	require.NoError(t, os.WriteFile(file, []byte(first), 0o600))
+	config := config{
+		address:       strings.TrimPrefix(server.URL, "http://"),
+		bucketName:    "milvus-bucket",
+		createBucket:  true,
+		hdfsTokenFile: file,
+	}
+	testCM, err := newHdfsObjectStorageWithConfig(ctx, &config)
+	require.NoError(t, err)
+	assert.Equal(t, first, server.queries["GETFILESTATUS"]["delegation"])
+
+	// the namenode rejects the old token once it's rotated
+	second := encodeHdfsToken("second", time.Now().Add(2*time.Hour))
+	require.NoError(t, os.WriteFile(file, []byte(second), 0o600))
+	require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+	server.mu.Lock()
+	server.rejectedToken = first
+	server.mu.Unlock()
+
+	err = testCM.PutObject(ctx, config.bucketName, "a", bytes.NewReader([]byte("a")), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, second, server.queries["CREATE"]["delegation"])
+
+	// the rejection is returned if no rotated token is found
+	server.mu.Lock()
+	server.rejectedToken = second
+	server.mu.Unlock()
+	_, err = testCM.StatObject(ctx, config.bucketName, "a")
+	assert.True(t, isHdfsInvalidToken(err))
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// IndexUploadManifest declares the index files uploaded by a build. IndexNode writes it once all the files are
+// uploaded and before the build is reported finished, so the files declared by it are expected to exist.
+type IndexUploadManifest struct {
+	BuildID        int64    `json:"buildID"`
+	IndexVersion   int64    `json:"indexVersion"`
+	PartitionID    int64    `json:"partitionID"`
+	SegmentID      int64    `json:"segmentID"`
+	FileKeys       []string `json:"fileKeys"`
+	SerializedSize uint64   `json:"serializedSize"`
+}
+
+// ManifestPath returns the path of the manifest under rootPath.
+func (m *IndexUploadManifest) ManifestPath(rootPath string) string {
+	return metautil.BuildSegmentIndexUploadManifestPath(rootPath, m.BuildID, m.IndexVersion, m.PartitionID, m.SegmentID)
+}
+
+// FilePaths returns the paths of the declared index files under rootPath.
+func (m *IndexUploadManifest) FilePaths(rootPath string) []string {
+	return metautil.BuildSegmentIndexFilePaths(rootPath, m.BuildID, m.IndexVersion, m.PartitionID, m.SegmentID, m.FileKeys)
+}
+
+// WriteIndexUploadManifest writes the manifest of the build.
+func WriteIndexUploadManifest(ctx context.Context, cm ChunkManager, manifest *IndexUploadManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return cm.Write(ctx, manifest.ManifestPath(cm.RootPath()), data)
+}
+
+// ReadIndexUploadManifest reads the manifest of the build, nil is returned if the manifest doesn't exist, e.g.
+// the build was done by an IndexNode of an older version.
+func ReadIndexUploadManifest(ctx context.Context, cm ChunkManager, buildID, indexVersion, partitionID, segmentID int64) (*IndexUploadManifest, error) {
+	manifestPath := metautil.BuildSegmentIndexUploadManifestPath(cm.RootPath(), buildID, indexVersion, partitionID, segmentID)
+	exist, err := cm.Exist(ctx, manifestPath)
+	if err != nil || !exist {
+		return nil, err
+	}
+	return readIndexUploadManifest(ctx, cm, manifestPath)
+}
+
+// CheckIndexUploadManifest reads the manifest of the build and returns the declared index files not existing,
+// nil is returned if the manifest doesn't exist. The directory of the build is listed once instead of checking
+// the manifest and the index files one by one.
+func CheckIndexUploadManifest(ctx context.Context, cm ChunkManager, buildID, indexVersion, partitionID, segmentID int64) (*IndexUploadManifest, []string, error) {
+	manifestPath := metautil.BuildSegmentIndexUploadManifestPath(cm.RootPath(), buildID, indexVersion, partitionID, segmentID)
+	dir := path.Dir(manifestPath)
+	listed, err := listIndexDir(ctx, cm, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !listed.Contain(manifestPath) {
+		return nil, nil, nil
+	}
+	manifest, err := readIndexUploadManifest(ctx, cm, manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	missing, err := missingIndexFiles(ctx, cm, manifest, dir, listed)
+	if err != nil {
+		return nil, nil, err
+	}
+	return manifest, missing, nil
+}
+
+func readIndexUploadManifest(ctx context.Context, cm ChunkManager, manifestPath string) (*IndexUploadManifest, error) {
+	data, err := cm.Read(ctx, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &IndexUploadManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// listIndexDir returns the paths of the files under the directory of a build.
+func listIndexDir(ctx context.Context, cm ChunkManager, dir string) (typeutil.Set[string], error) {
+	filePaths, _, err := cm.ListWithPrefix(ctx, dir+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	return typeutil.NewSet(filePaths...), nil
+}
+
+// MissingIndexFiles returns the paths of the index files declared by the manifest but not existing.
+func MissingIndexFiles(ctx context.Context, cm ChunkManager, manifest *IndexUploadManifest) ([]string, error) {
+	dir := path.Dir(manifest.ManifestPath(cm.RootPath()))
+	listed, err := listIndexDir(ctx, cm, dir)
+	if err != nil {
+		return nil, err
+	}
+	return missingIndexFiles(ctx, cm, manifest, dir, listed)
+}
+
+// missingIndexFiles checks the declared index files against the listed files of the build directory dir, only the
+// files stored out of the directory, i.e. the content-addressed ones, are checked by their own.
+func missingIndexFiles(ctx context.Context, cm ChunkManager, manifest *IndexUploadManifest, dir string, listed typeutil.Set[string]) ([]string, error) {
+	missing := make([]string, 0)
+	unlisted := make([]string, 0)
+	for _, filePath := range manifest.FilePaths(cm.RootPath()) {
+		if listed.Contain(filePath) {
+			continue
+		}
+		if strings.HasPrefix(filePath, dir+"/") {
+			missing = append(missing, filePath)
+		} else {
+			unlisted = append(unlisted, filePath)
+		}
+	}
+	if len(unlisted) == 0 {
+		return missing, nil
+	}
+	exists, err := cm.MultiExist(ctx, unlisted)
+	if err != nil {
+		return nil, err
+	}
+	for i, exist := range exists {
+		if !exist {
+			missing = append(missing, unlisted[i])
+		}
+	}
+	return missing, nil
+}
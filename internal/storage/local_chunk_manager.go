@@ -124,6 +124,32 @@ func (lcm *LocalChunkManager) Exist(ctx context.Context, filePath string) (bool,
 	return true, nil
 }
 
+// MultiExist checks whether the chunks are saved to local storage.
+func (lcm *LocalChunkManager) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	exists := make([]bool, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		exist, err := lcm.Exist(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		exists = append(exists, exist)
+	}
+	return exists, nil
+}
+
+// MultiStat returns the sizes of the chunks saved to local storage.
+func (lcm *LocalChunkManager) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	sizes := make([]int64, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		size, err := lcm.Size(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes, nil
+}
+
 // Read reads the local storage data if exists.
 func (lcm *LocalChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
 	exist, err := lcm.Exist(ctx, filePath)
@@ -218,6 +244,24 @@ func (lcm *LocalChunkManager) ReadAt(ctx context.Context, filePath string, off i
 	return res, nil
 }
 
+// PresignedURL is not supported since the local files aren't accessible by other nodes
+func (lcm *LocalChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	return "", errors.New("presigned url is not supported by local storage")
+}
+
+// Archive is not supported since the local storage has no storage class
+func (lcm *LocalChunkManager) Archive(ctx context.Context, filePaths []string) error {
+	return errors.New("archiving is not supported by local storage")
+}
+
+func (lcm *LocalChunkManager) Restore(ctx context.Context, filePaths []string) error {
+	return errors.New("archiving is not supported by local storage")
+}
+
+func (lcm *LocalChunkManager) ArchiveStates(ctx context.Context, filePaths []string) ([]ArchiveState, error) {
+	return nil, errors.New("archiving is not supported by local storage")
+}
+
 func (lcm *LocalChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
 	return mmap.Open(path.Clean(filePath))
 }
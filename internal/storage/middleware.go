@@ -0,0 +1,360 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/cockroachdb/errors"
+	minio "github.com/minio/minio-go/v7"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// ObjectStorageLayer is a layer of the object storage chain of the remote chunk manager.
+// Different from ObjectStorage, it carries the checksum of the objects, which is empty if the backend doesn't keep it.
+type ObjectStorageLayer interface {
+	GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error)
+	PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error
+	StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error)
+	ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error)
+	RemoveObject(ctx context.Context, bucketName, objectName string) error
+}
+
+// ObjectStorageMiddleware wraps the next layer with a cross-cutting behavior, e.g. retry, metrics or caching,
+// so that it's implemented once for all the backends
+type ObjectStorageMiddleware func(next ObjectStorageLayer) ObjectStorageLayer
+
+// newObjectStorageChain composes the middlewares around the raw backend, the first middleware is the outermost layer
+func newObjectStorageChain(backend ObjectStorage, middlewares ...ObjectStorageMiddleware) ObjectStorageLayer {
+	var layer ObjectStorageLayer = backendLayer{backend}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		layer = middlewares[i](layer)
+	}
+	return layer
+}
+
+// defaultMiddlewares returns the middleware stack of the config from the outermost to the innermost.
+// The disk cache and the custom middlewares are outside of the built-in ones, so the cache hits save the requests
+// to the backend, the retry is outside of the limiter, so each attempt is charged.
+func defaultMiddlewares(c *config) ([]ObjectStorageMiddleware, error) {
+	middlewares := []ObjectStorageMiddleware{withTracing}
+	if c.cachePath != "" {
+		cache, err := getDiskCache(c.cachePath, c.cacheCapacity, c.cacheWriteBack)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, cache.middleware)
+	}
+	middlewares = append(middlewares, c.middlewares...)
+	return append(middlewares,
+		withMetrics,
+		withRetry(c.retryAttempts),
+		withErrorTranslation,
+		getStorageLimiter(c).middleware,
+	), nil
+}
+
+// backendLayer adapts the raw backend to the innermost layer, the checksum is kept if the backend supports it
+type backendLayer struct {
+	ObjectStorage
+}
+
+func (l backendLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	if client, ok := l.ObjectStorage.(checksumStorage); ok {
+		return client.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	}
+	return l.PutObject(ctx, bucketName, objectName, reader, objectSize)
+}
+
+func (l backendLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	if client, ok := l.ObjectStorage.(checksumStorage); ok {
+		return client.StatObjectWithChecksum(ctx, bucketName, objectName)
+	}
+	size, err := l.StatObject(ctx, bucketName, objectName)
+	return size, "", err
+}
+
+// tracingLayer starts a span for each request
+type tracingLayer struct {
+	next ObjectStorageLayer
+}
+
+func withTracing(next ObjectStorageLayer) ObjectStorageLayer {
+	return &tracingLayer{next: next}
+}
+
+func (l *tracingLayer) start(ctx context.Context, op, bucketName, name string) (context.Context, trace.Span) {
+	return otel.Tracer("ObjectStorage").Start(ctx, "ObjectStorage-"+op, trace.WithAttributes(
+		attribute.String("bucket", bucketName),
+		attribute.String("name", name),
+	))
+}
+
+func endSpan(sp trace.Span, err error) {
+	if err != nil {
+		sp.RecordError(err)
+	}
+	sp.End()
+}
+
+func (l *tracingLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	ctx, sp := l.start(ctx, metrics.DataGetLabel, bucketName, objectName)
+	reader, err := l.next.GetObject(ctx, bucketName, objectName, offset, size)
+	endSpan(sp, err)
+	return reader, err
+}
+
+func (l *tracingLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	ctx, sp := l.start(ctx, metrics.DataPutLabel, bucketName, objectName)
+	err := l.next.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	endSpan(sp, err)
+	return err
+}
+
+func (l *tracingLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	ctx, sp := l.start(ctx, metrics.DataStatLabel, bucketName, objectName)
+	size, checksum, err := l.next.StatObjectWithChecksum(ctx, bucketName, objectName)
+	endSpan(sp, err)
+	return size, checksum, err
+}
+
+func (l *tracingLayer) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	ctx, sp := l.start(ctx, metrics.DataListLabel, bucketName, prefix)
+	objects, err := l.next.ListObjects(ctx, bucketName, prefix, recursive)
+	endSpan(sp, err)
+	return objects, err
+}
+
+func (l *tracingLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	ctx, sp := l.start(ctx, metrics.DataRemoveLabel, bucketName, objectName)
+	err := l.next.RemoveObject(ctx, bucketName, objectName)
+	endSpan(sp, err)
+	return err
+}
+
+// metricsLayer counts the requests and observes the latency of the successful ones
+type metricsLayer struct {
+	next ObjectStorageLayer
+}
+
+func withMetrics(next ObjectStorageLayer) ObjectStorageLayer {
+	return &metricsLayer{next: next}
+}
+
+func observeRequest(op string, start *timerecord.TimeRecorder, err error) {
+	metrics.PersistentDataOpCounter.WithLabelValues(op, metrics.TotalLabel).Inc()
+	if err == nil {
+		metrics.PersistentDataRequestLatency.WithLabelValues(op).Observe(float64(start.ElapseSpan().Milliseconds()))
+		metrics.PersistentDataOpCounter.WithLabelValues(op, metrics.SuccessLabel).Inc()
+	} else {
+		metrics.PersistentDataOpCounter.WithLabelValues(op, metrics.FailLabel).Inc()
+	}
+}
+
+func (l *metricsLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	start := timerecord.NewTimeRecorder("getObject")
+	reader, err := l.next.GetObject(ctx, bucketName, objectName, offset, size)
+	observeRequest(metrics.DataGetLabel, start, err)
+	return reader, err
+}
+
+func (l *metricsLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	start := timerecord.NewTimeRecorder("putObject")
+	err := l.next.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	observeRequest(metrics.DataPutLabel, start, err)
+	return err
+}
+
+func (l *metricsLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	start := timerecord.NewTimeRecorder("statObject")
+	size, checksum, err := l.next.StatObjectWithChecksum(ctx, bucketName, objectName)
+	observeRequest(metrics.DataStatLabel, start, err)
+	return size, checksum, err
+}
+
+func (l *metricsLayer) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	start := timerecord.NewTimeRecorder("listObjects")
+	objects, err := l.next.ListObjects(ctx, bucketName, prefix, recursive)
+	observeRequest(metrics.DataListLabel, start, err)
+	return objects, err
+}
+
+func (l *metricsLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	start := timerecord.NewTimeRecorder("removeObject")
+	err := l.next.RemoveObject(ctx, bucketName, objectName)
+	observeRequest(metrics.DataRemoveLabel, start, err)
+	return err
+}
+
+// retryLayer retries the requests failed by the transient errors, e.g. throttling or network errors
+type retryLayer struct {
+	next     ObjectStorageLayer
+	attempts uint
+}
+
+// withRetry returns the middleware retrying each request up to attempts times, it's a no-op if attempts <= 1
+func withRetry(attempts int) ObjectStorageMiddleware {
+	return func(next ObjectStorageLayer) ObjectStorageLayer {
+		if attempts <= 1 {
+			return next
+		}
+		return &retryLayer{next: next, attempts: uint(attempts)}
+	}
+}
+
+// isRetryableError reports whether the request may succeed by retrying
+func isRetryableError(err error) bool {
+	if IsErrNoSuchKey(err) || errors.Is(err, errObjectChanged) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusCode int
+	switch err := err.(type) {
+	case *azcore.ResponseError:
+		statusCode = err.StatusCode
+	case minio.ErrorResponse:
+		statusCode = err.StatusCode
+	case *gcsError:
+		statusCode = err.StatusCode
+	case *hdfsError:
+		statusCode = err.StatusCode
+	}
+	// the other client errors fail again, e.g. access denied
+	return statusCode < http.StatusBadRequest || statusCode >= http.StatusInternalServerError ||
+		statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests
+}
+
+// do runs fn with retry, the last error is returned as is so that the callers are able to check it
+func (l *retryLayer) do(ctx context.Context, fn func() error) error {
+	var lastErr error
+	err := retry.Do(ctx, func() error {
+		lastErr = fn()
+		if lastErr != nil && !isRetryableError(lastErr) {
+			return retry.Unrecoverable(lastErr)
+		}
+		return lastErr
+	}, retry.Attempts(l.attempts))
+	if err != nil && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+func (l *retryLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	var reader FileReader
+	err := l.do(ctx, func() error {
+		var err error
+		reader, err = l.next.GetObject(ctx, bucketName, objectName, offset, size)
+		return err
+	})
+	return reader, err
+}
+
+// PutObjectWithChecksum rewinds the reader before each attempt, the request isn't retried if the reader isn't seekable
+func (l *retryLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	seeker, ok := reader.(io.Seeker)
+	if !ok {
+		return l.next.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	}
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return l.next.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	}
+	return l.do(ctx, func() error {
+		if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		return l.next.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+	})
+}
+
+func (l *retryLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	var size int64
+	var checksum string
+	err := l.do(ctx, func() error {
+		var err error
+		size, checksum, err = l.next.StatObjectWithChecksum(ctx, bucketName, objectName)
+		return err
+	})
+	return size, checksum, err
+}
+
+func (l *retryLayer) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	var objects map[string]time.Time
+	err := l.do(ctx, func() error {
+		var err error
+		objects, err = l.next.ListObjects(ctx, bucketName, prefix, recursive)
+		return err
+	})
+	return objects, err
+}
+
+func (l *retryLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	return l.do(ctx, func() error {
+		return l.next.RemoveObject(ctx, bucketName, objectName)
+	})
+}
+
+// errorTranslationLayer translates the not found errors of the backends to ErrNoSuchKey
+type errorTranslationLayer struct {
+	ObjectStorageLayer
+}
+
+func withErrorTranslation(next ObjectStorageLayer) ObjectStorageLayer {
+	return &errorTranslationLayer{ObjectStorageLayer: next}
+}
+
+func isNotFound(err error) bool {
+	switch err := err.(type) {
+	case *azcore.ResponseError:
+		return err.ErrorCode == string(bloberror.BlobNotFound)
+	case minio.ErrorResponse:
+		return err.Code == "NoSuchKey"
+	case *gcsError:
+		return err.StatusCode == http.StatusNotFound
+	case *hdfsError:
+		return err.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func (l *errorTranslationLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	reader, err := l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, offset, size)
+	if isNotFound(err) {
+		return nil, WrapErrNoSuchKey(objectName)
+	}
+	return reader, err
+}
+
+func (l *errorTranslationLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	size, checksum, err := l.ObjectStorageLayer.StatObjectWithChecksum(ctx, bucketName, objectName)
+	if isNotFound(err) {
+		return size, checksum, WrapErrNoSuchKey(objectName)
+	}
+	return size, checksum, err
+}
@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	minio "github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyObjectStorage is the in-memory ObjectStorage failing the first failures requests with err
+type flakyObjectStorage struct {
+	objects  map[string][]byte
+	failures int
+	err      error
+	calls    int
+}
+
+func (s *flakyObjectStorage) fail() error {
+	s.calls++
+	if s.failures > 0 {
+		s.failures--
+		return s.err
+	}
+	return nil
+}
+
+func (s *flakyObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	data, ok := s.objects[objectName]
+	if !ok {
+		return nil, &gcsError{StatusCode: http.StatusNotFound}
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *flakyObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	// the failed upload consumes the reader
+	data, _ := io.ReadAll(reader)
+	if err := s.fail(); err != nil {
+		return err
+	}
+	s.objects[objectName] = data
+	return nil
+}
+
+func (s *flakyObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	if err := s.fail(); err != nil {
+		return 0, err
+	}
+	data, ok := s.objects[objectName]
+	if !ok {
+		return 0, minio.ErrorResponse{Code: "NoSuchKey", StatusCode: http.StatusNotFound}
+	}
+	return int64(len(data)), nil
+}
+
+func (s *flakyObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return map[string]time.Time{}, nil
+}
+
+func (s *flakyObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	if err := s.fail(); err != nil {
+		return err
+	}
+	delete(s.objects, objectName)
+	return nil
+}
+
+// recordingLayer records the requests passed through it
+type recordingLayer struct {
+	ObjectStorageLayer
+	name   string
+	record *[]string
+}
+
+func recording(name string, record *[]string) ObjectStorageMiddleware {
+	return func(next ObjectStorageLayer) ObjectStorageLayer {
+		return &recordingLayer{ObjectStorageLayer: next, name: name, record: record}
+	}
+}
+
+func (l *recordingLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	*l.record = append(*l.record, l.name)
+	return l.ObjectStorageLayer.RemoveObject(ctx, bucketName, objectName)
+}
+
+func TestObjectStorageChain(t *testing.T) {
+	ctx := context.Background()
+	backend := &flakyObjectStorage{objects: map[string][]byte{}}
+
+	var record []string
+	chain := newObjectStorageChain(backend, recording("outer", &record), recording("inner", &record))
+	assert.NoError(t, chain.RemoveObject(ctx, "bucket", "a"))
+	assert.Equal(t, []string{"outer", "inner"}, record)
+
+	// the checksum is dropped by the backend not keeping it
+	assert.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", bytes.NewReader([]byte("abc")), 3, "checksum"))
+	size, checksum, err := chain.StatObjectWithChecksum(ctx, "bucket", "a")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+	assert.Empty(t, checksum)
+}
+
+func TestMinioChunkManagerChain(t *testing.T) {
+	ctx := context.Background()
+	backend := &flakyObjectStorage{objects: map[string][]byte{}}
+
+	var record []string
+	remote, err := newRemoteChunkManagerWithBackend(backend, &config{middlewares: []ObjectStorageMiddleware{recording("custom", &record)}})
+	require.NoError(t, err)
+	mcm := &MinioChunkManager{remote: remote}
+	mcm.SetVar("bucket", "root")
+
+	// the requests of the minio chunk manager pass through the middlewares as well
+	assert.NoError(t, mcm.Remove(ctx, "root/a"))
+	assert.Equal(t, []string{"custom"}, record)
+	assert.Equal(t, "root", mcm.RootPath())
+}
+
+func TestRetryLayer(t *testing.T) {
+	ctx := context.Background()
+	backend := &flakyObjectStorage{objects: map[string][]byte{}}
+	chain := newObjectStorageChain(backend, withRetry(3), withErrorTranslation)
+
+	t.Run("transient errors", func(t *testing.T) {
+		backend.failures, backend.calls = 2, 0
+		backend.err = minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}
+		// the reader is rewound for each attempt
+		assert.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", bytes.NewReader([]byte("abc")), 3, ""))
+		assert.Equal(t, 3, backend.calls)
+		assert.Equal(t, []byte("abc"), backend.objects["a"])
+
+		backend.failures, backend.calls = 3, 0
+		_, _, err := chain.StatObjectWithChecksum(ctx, "bucket", "a")
+		assert.Equal(t, backend.err, err)
+		assert.Equal(t, 3, backend.calls)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		backend.failures, backend.calls = 1, 0
+		backend.err = minio.ErrorResponse{Code: "AccessDenied", StatusCode: http.StatusForbidden}
+		assert.Equal(t, backend.err, chain.RemoveObject(ctx, "bucket", "a"))
+		assert.Equal(t, 1, backend.calls)
+
+		backend.failures, backend.calls = 0, 0
+		_, _, err := chain.StatObjectWithChecksum(ctx, "bucket", "b")
+		assert.True(t, IsErrNoSuchKey(err))
+		_, err = chain.GetObject(ctx, "bucket", "b", 0, 0)
+		assert.True(t, IsErrNoSuchKey(err))
+		assert.Equal(t, 2, backend.calls)
+
+		// the reader isn't seekable
+		backend.failures, backend.calls = 1, 0
+		backend.err = minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}
+		err = chain.PutObjectWithChecksum(ctx, "bucket", "a", io.LimitReader(bytes.NewReader([]byte("abc")), 3), 3, "")
+		assert.Equal(t, backend.err, err)
+		assert.Equal(t, 1, backend.calls)
+	})
+
+	t.Run("no retry", func(t *testing.T) {
+		backend.failures, backend.calls = 1, 0
+		chain := newObjectStorageChain(backend, withRetry(0))
+		assert.Error(t, chain.RemoveObject(ctx, "bucket", "a"))
+		assert.Equal(t, 1, backend.calls)
+	})
+}
+
+func TestRemoteChunkManagerMiddlewares(t *testing.T) {
+	ctx := context.Background()
+	backend := &flakyObjectStorage{
+		objects:  map[string][]byte{},
+		failures: 1,
+		err:      &gcsError{StatusCode: http.StatusTooManyRequests},
+	}
+	var record []string
+	mcm, err := newRemoteChunkManagerWithBackend(backend, &config{
+		bucketName:    "bucket",
+		retryAttempts: 2,
+		middlewares:   []ObjectStorageMiddleware{recording("custom", &record)},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, mcm.Write(ctx, "a", []byte("binlog")))
+	data, err := mcm.Read(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("binlog"), data)
+
+	exist, err := mcm.Exist(ctx, "b")
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	assert.NoError(t, mcm.Remove(ctx, "a"))
+	assert.Equal(t, []string{"custom"}, record)
+}
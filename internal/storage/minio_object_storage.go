@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	minio "github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/storage/aliyun"
@@ -34,9 +37,18 @@ import (
 
 type MinioObjectStorage struct {
 	*minio.Client
+	sse encrypt.ServerSide
+	// the reads are charged to the requester, the other requests are marked by the header transport
+	requesterPays bool
+	// the storage class the objects are archived to
+	archiveClass string
 }
 
 func newMinioObjectStorageWithConfig(ctx context.Context, c *config) (*MinioObjectStorage, error) {
+	sse, err := newServerSideEncryption(c.sseType, c.sseKey)
+	if err != nil {
+		return nil, err
+	}
 	var creds *credentials.Credentials
 	newMinioFn := minio.New
 	bucketLookupType := minio.BucketLookupAuto
@@ -56,18 +68,26 @@ func newMinioObjectStorageWithConfig(ctx context.Context, c *config) (*MinioObje
 			creds = credentials.NewStaticV2(c.accessKeyID, c.secretAccessKeyID, "")
 		}
 	default: // aws, minio
-		if c.useIAM {
-			creds = credentials.NewIAM("")
-		} else {
-			creds = credentials.NewStaticV4(c.accessKeyID, c.secretAccessKeyID, "")
+		creds, err = newAWSCredentials(c)
+		if err != nil {
+			return nil, err
 		}
 	}
+	address, err := ResolveS3Endpoint(c.address, c.region, c.dualStack, c.fips)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := newHeaderTransport(c.useSSL, c.customHeaders, c.requesterPays, creds)
+	if err != nil {
+		return nil, err
+	}
 	minioOpts := &minio.Options{
 		BucketLookup: bucketLookupType,
 		Creds:        creds,
 		Secure:       c.useSSL,
+		Transport:    transport,
 	}
-	minIOClient, err := newMinioFn(c.address, minioOpts)
+	minIOClient, err := newMinioFn(address, minioOpts)
 	// options nil or invalid formatted endpoint, don't need to retry
 	if err != nil {
 		return nil, err
@@ -99,40 +119,128 @@ func newMinioObjectStorageWithConfig(ctx context.Context, c *config) (*MinioObje
 		return nil, err
 	}
 
-	return &MinioObjectStorage{minIOClient}, nil
+	return &MinioObjectStorage{
+		Client:        minIOClient,
+		sse:           sse,
+		requesterPays: c.requesterPays,
+		archiveClass:  archiveStorageClassOrDefault(c.archiveClass),
+	}, nil
 }
 
 func (minioObjectStorage *MinioObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
-	opts := minio.GetObjectOptions{}
-	if offset > 0 {
+	opts := minio.GetObjectOptions{ServerSideEncryption: readEncryption(minioObjectStorage.sse)}
+	minioObjectStorage.setRequestPayer(opts.Set)
+	if offset > 0 || size > 0 {
 		err := opts.SetRange(offset, offset+size-1)
 		if err != nil {
 			log.Warn("failed to set range", zap.String("bucket", bucketName), zap.String("path", objectName), zap.Error(err))
 			return nil, err
 		}
 	}
+	pin := objectPinFrom(ctx)
+	if pin != nil && pin.get() != "" {
+		if err := opts.SetMatchETag(pin.get()); err != nil {
+			return nil, err
+		}
+	}
 	object, err := minioObjectStorage.Client.GetObject(ctx, bucketName, objectName, opts)
 	if err != nil {
 		return nil, err
 	}
+	if pin != nil {
+		// the get request is sent lazily, stat it to receive the etag of the object
+		info, err := object.Stat()
+		if err == nil {
+			err = pin.check(info.ETag)
+		}
+		if err != nil {
+			object.Close()
+			if minio.ToErrorResponse(err).StatusCode == http.StatusPreconditionFailed {
+				return nil, errors.Wrap(errObjectChanged, err.Error())
+			}
+			return nil, err
+		}
+	}
 	return object, nil
 }
 
 func (minioObjectStorage *MinioObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
-	_, err := minioObjectStorage.Client.PutObject(ctx, bucketName, objectName, reader, objectSize, minio.PutObjectOptions{})
+	_, err := minioObjectStorage.Client.PutObject(ctx, bucketName, objectName, reader, objectSize, minio.PutObjectOptions{
+		ServerSideEncryption: minioObjectStorage.sse,
+	})
+	return err
+}
+
+// PutObjectWithChecksum keeps the checksum in the user metadata, the content is verified by MD5 on upload as well
+func (minioObjectStorage *MinioObjectStorage) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	_, err := minioObjectStorage.Client.PutObject(ctx, bucketName, objectName, reader, objectSize, minio.PutObjectOptions{
+		ServerSideEncryption: minioObjectStorage.sse,
+		UserMetadata:         map[string]string{checksumMetaKey: checksum},
+		SendContentMd5:       true,
+	})
 	return err
 }
 
+func (minioObjectStorage *MinioObjectStorage) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	info, err := minioObjectStorage.statObjectInfo(ctx, bucketName, objectName)
+	return info.Size, checksumOfMetadata(info.UserMetadata), err
+}
+
 func (minioObjectStorage *MinioObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
-	info, err := minioObjectStorage.Client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	info, err := minioObjectStorage.statObjectInfo(ctx, bucketName, objectName)
 	return info.Size, err
 }
 
+func (minioObjectStorage *MinioObjectStorage) statObjectInfo(ctx context.Context, bucketName, objectName string) (minio.ObjectInfo, error) {
+	opts := minio.StatObjectOptions{ServerSideEncryption: readEncryption(minioObjectStorage.sse)}
+	minioObjectStorage.setRequestPayer(opts.Set)
+	return minioObjectStorage.Client.StatObject(ctx, bucketName, objectName, opts)
+}
+
+// ArchiveObject copies the object onto itself in the archival storage class, nothing is done if it's archived already
+func (minioObjectStorage *MinioObjectStorage) ArchiveObject(ctx context.Context, bucketName, objectName string) error {
+	info, err := minioObjectStorage.statObjectInfo(ctx, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if archiveStateOfObjectInfo(info) != ArchiveStateStandard {
+		return nil
+	}
+	return copyMinioObjectToStorageClass(ctx, minioObjectStorage.Client, bucketName, objectName, info,
+		minioObjectStorage.sse, minioObjectStorage.archiveClass)
+}
+
+func (minioObjectStorage *MinioObjectStorage) UnarchiveObject(ctx context.Context, bucketName, objectName string) error {
+	info, err := minioObjectStorage.statObjectInfo(ctx, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	return unarchiveMinioObject(ctx, minioObjectStorage.Client, bucketName, objectName, info, minioObjectStorage.sse)
+}
+
+func (minioObjectStorage *MinioObjectStorage) ArchiveStateOfObject(ctx context.Context, bucketName, objectName string) (ArchiveState, error) {
+	info, err := minioObjectStorage.statObjectInfo(ctx, bucketName, objectName)
+	if err != nil {
+		return ArchiveStateStandard, err
+	}
+	return archiveStateOfObjectInfo(info), nil
+}
+
+func (minioObjectStorage *MinioObjectStorage) PresignGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	u, err := minioObjectStorage.PresignedGetObject(ctx, bucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
 func (minioObjectStorage *MinioObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
-	res := minioObjectStorage.Client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+	opts := minio.ListObjectsOptions{
 		Prefix:    prefix,
 		Recursive: recursive,
-	})
+	}
+	minioObjectStorage.setRequestPayer(opts.Set)
+	res := minioObjectStorage.Client.ListObjects(ctx, bucketName, opts)
 
 	objects := map[string]time.Time{}
 	for object := range res {
@@ -144,6 +252,13 @@ func (minioObjectStorage *MinioObjectStorage) ListObjects(ctx context.Context, b
 	return objects, nil
 }
 
+// setRequestPayer marks the read requests to the requester-pays bucket by set
+func (minioObjectStorage *MinioObjectStorage) setRequestPayer(set func(key, value string)) {
+	if minioObjectStorage.requesterPays {
+		set(requestPayerHeader, requestPayerRequester)
+	}
+}
+
 func (minioObjectStorage *MinioObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
 	return minioObjectStorage.Client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
 }
@@ -14,6 +14,33 @@ type config struct {
 	iamEndpoint       string
 	useVirtualHost    bool
 	region            string
+	sasToken          string
+	gcpCredentialJSON string
+	gcpKMSKeyName     string
+	hdfsUser          string
+	hdfsToken         string
+	hdfsTokenFile     string
+	hdfsReplication   int
+	readPartSize      int64
+	readConcurrency   int
+	sessionToken      string
+	roleARN           string
+	stsEndpoint       string
+	credentialsFile   string
+	sseType           string
+	sseKey            string
+	requesterPays     bool
+	dualStack         bool
+	fips              bool
+	customHeaders     map[string]string
+	archiveClass      string
+	requestRateLimit  float64
+	bandwidthLimit    int64
+	retryAttempts     int
+	middlewares       []ObjectStorageMiddleware
+	cachePath         string
+	cacheCapacity     int64
+	cacheWriteBack    bool
 }
 
 func newDefaultConfig() *config {
@@ -94,3 +121,175 @@ func Region(region string) Option {
 		c.region = region
 	}
 }
+
+// SASToken is the shared access signature used to access Azure Blob storage instead of the account key
+func SASToken(sasToken string) Option {
+	return func(c *config) {
+		c.sasToken = sasToken
+	}
+}
+
+// GcpCredentialJSON is the service account credentials to access GCS natively,
+// the application default credentials are used if empty
+func GcpCredentialJSON(credentialJSON string) Option {
+	return func(c *config) {
+		c.gcpCredentialJSON = credentialJSON
+	}
+}
+
+// GcpKMSKeyName is the customer-managed encryption key of the objects written to GCS natively
+func GcpKMSKeyName(kmsKeyName string) Option {
+	return func(c *config) {
+		c.gcpKMSKeyName = kmsKeyName
+	}
+}
+
+// HdfsUser is the user to access HDFS with simple authentication
+func HdfsUser(user string) Option {
+	return func(c *config) {
+		c.hdfsUser = user
+	}
+}
+
+// HdfsDelegationToken is the delegation token to access the kerberos secured HDFS
+func HdfsDelegationToken(token string) Option {
+	return func(c *config) {
+		c.hdfsToken = token
+	}
+}
+
+// HdfsDelegationTokenFile is the file holding the delegation token, it's re-read once changed so that the token
+// can be rotated, it takes precedence over HdfsDelegationToken
+func HdfsDelegationTokenFile(file string) Option {
+	return func(c *config) {
+		c.hdfsTokenFile = file
+	}
+}
+
+// HdfsReplication is the replication of the files written to HDFS, the cluster default if 0
+func HdfsReplication(replication int) Option {
+	return func(c *config) {
+		c.hdfsReplication = replication
+	}
+}
+
+// ReadPartSize is the size of each ranged get when reading a large object,
+// the object is read by a single get if it's not positive
+func ReadPartSize(partSize int64) Option {
+	return func(c *config) {
+		c.readPartSize = partSize
+	}
+}
+
+// ReadConcurrency is the number of the concurrent ranged gets when reading a large object
+func ReadConcurrency(concurrency int) Option {
+	return func(c *config) {
+		c.readConcurrency = concurrency
+	}
+}
+
+// SessionToken is the session token of the temporary access key
+func SessionToken(token string) Option {
+	return func(c *config) {
+		c.sessionToken = token
+	}
+}
+
+// RoleARN is the role assumed by STS with the access key, the temporary credentials are refreshed automatically
+func RoleARN(roleARN string) Option {
+	return func(c *config) {
+		c.roleARN = roleARN
+	}
+}
+
+// STSEndpoint is the endpoint of STS to assume the role
+func STSEndpoint(endpoint string) Option {
+	return func(c *config) {
+		c.stsEndpoint = endpoint
+	}
+}
+
+// CredentialsFile is the aws shared credentials file, which is reloaded once it's rotated
+func CredentialsFile(path string) Option {
+	return func(c *config) {
+		c.credentialsFile = path
+	}
+}
+
+// ServerSideEncryption requests the server-side encryption of sseType on uploads,
+// sseKey is the KMS key id of SSE-KMS, or the base64 encoded customer key of SSE-C
+func ServerSideEncryption(sseType, sseKey string) Option {
+	return func(c *config) {
+		c.sseType = sseType
+		c.sseKey = sseKey
+	}
+}
+
+// RequesterPays charges the requester for the requests and transfer of the bucket
+func RequesterPays(requesterPays bool) Option {
+	return func(c *config) {
+		c.requesterPays = requesterPays
+	}
+}
+
+// S3Endpoint uses the dual-stack and/or FIPS endpoint of aws s3 in the region instead of the address
+func S3Endpoint(dualStack, fips bool) Option {
+	return func(c *config) {
+		c.dualStack = dualStack
+		c.fips = fips
+	}
+}
+
+// CustomHeaders are sent with every request to the object storage
+func CustomHeaders(headers map[string]string) Option {
+	return func(c *config) {
+		c.customHeaders = headers
+	}
+}
+
+// ArchiveStorageClass is the s3 storage class the objects are archived to, GLACIER if it's empty
+func ArchiveStorageClass(storageClass string) Option {
+	return func(c *config) {
+		c.archiveClass = storageClass
+	}
+}
+
+// RequestRateLimit is the most requests per second sent to the object storage by the node, unlimited if it's not positive
+func RequestRateLimit(requestRate float64) Option {
+	return func(c *config) {
+		c.requestRateLimit = requestRate
+	}
+}
+
+// BandwidthLimit is the most bytes per second read from and written to the object storage by the node,
+// unlimited if it's not positive
+func BandwidthLimit(bandwidth int64) Option {
+	return func(c *config) {
+		c.bandwidthLimit = bandwidth
+	}
+}
+
+// RequestRetryAttempts is the attempts of each request to the object storage on the transient errors,
+// the requests aren't retried if it's not greater than 1
+func RequestRetryAttempts(attempts int) Option {
+	return func(c *config) {
+		c.retryAttempts = attempts
+	}
+}
+
+// Middlewares are composed around the built-in middlewares of the remote chunk manager, the first one is the outermost
+func Middlewares(middlewares ...ObjectStorageMiddleware) Option {
+	return func(c *config) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// DiskCache caches the objects of the remote chunk manager under path on the local disk up to capacity bytes,
+// the objects are uploaded asynchronously if writeBack is set. The cache is disabled if path is empty.
+func DiskCache(path string, capacity int64, writeBack bool) Option {
+	return func(c *config) {
+		c.cachePath = path
+		c.cacheCapacity = capacity
+		c.cacheWriteBack = writeBack
+	}
+}
@@ -0,0 +1,38 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MaxPresignExpiry is the longest expiry of the presigned urls, which is the limit of S3 signature V4
+const MaxPresignExpiry = 7 * 24 * time.Hour
+
+// presignStorage is the ObjectStorage able to presign urls to access objects without credentials
+type presignStorage interface {
+	PresignGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error)
+}
+
+func checkPresignExpiry(expiry time.Duration) error {
+	if expiry < time.Second || expiry > MaxPresignExpiry {
+		return fmt.Errorf("invalid presign expiry %s, should be in [1s, %s]", expiry, MaxPresignExpiry)
+	}
+	return nil
+}
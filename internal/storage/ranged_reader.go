@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+// rangedReadRetryAttempts is the attempts to resume a part from the bytes already read
+const rangedReadRetryAttempts = 5
+
+// rangedReadConfig is the config of the ranged read of the chunk managers
+type rangedReadConfig struct {
+	// partSize is the size of each ranged get, the ranged read is disabled if it's not positive
+	partSize    int64
+	concurrency int
+}
+
+func newRangedReadConfig(c *config) rangedReadConfig {
+	concurrency := c.readConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return rangedReadConfig{partSize: c.readPartSize, concurrency: concurrency}
+}
+
+// enabled returns whether the object of size is read by ranged gets, only the objects of multiple parts are
+func (c rangedReadConfig) enabled(size int64) bool {
+	return c.partSize > 0 && size > c.partSize
+}
+
+// errObjectChanged is returned if the object is overwritten while its parts are read
+var errObjectChanged = errors.New("object changed during the ranged read")
+
+type objectPinKey struct{}
+
+// objectPin pins the ranged gets of an object to the same version of it. The first response records the version
+// tag of the object, i.e. the ETag, or the generation of GCS, the later gets are conditional on it, and each response
+// is checked against it, so the parts of different versions are never mixed.
+type objectPin struct {
+	mu  sync.Mutex
+	tag string
+}
+
+func withObjectPin(ctx context.Context, pin *objectPin) context.Context {
+	return context.WithValue(ctx, objectPinKey{}, pin)
+}
+
+// objectPinFrom returns the pin of the ranged read, nil if the get isn't a part of a ranged read.
+func objectPinFrom(ctx context.Context) *objectPin {
+	pin, _ := ctx.Value(objectPinKey{}).(*objectPin)
+	return pin
+}
+
+// get returns the pinned version tag, empty if no response is received yet.
+func (p *objectPin) get() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.tag
+}
+
+// check pins the version tag of the response if nothing is pinned, or checks it against the pinned one.
+func (p *objectPin) check(tag string) error {
+	if tag == "" {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tag == "" {
+		p.tag = tag
+		return nil
+	}
+	if p.tag != tag {
+		return errors.Wrapf(errObjectChanged, "version %s, expected %s", tag, p.tag)
+	}
+	return nil
+}
+
+// rangeGetter opens the reader of [offset, offset+size) of an object
+type rangeGetter func(ctx context.Context, offset int64, size int64) (FileReader, error)
+
+// readRanged downloads the object of size by the concurrent ranged gets of partSize,
+// the part failed midway is resumed from the bytes already read rather than downloading the whole object again.
+// The gets are pinned to the same version of the object, errObjectChanged is returned if it's overwritten meanwhile,
+// except for the backends without version tags, e.g. HDFS.
+func readRanged(ctx context.Context, size int64, partSize int64, concurrency int, get rangeGetter) ([]byte, error) {
+	data := make([]byte, size)
+	group, groupCtx := errgroup.WithContext(withObjectPin(ctx, &objectPin{}))
+	group.SetLimit(concurrency)
+	for offset := int64(0); offset < size; offset += partSize {
+		offset := offset
+		end := offset + partSize
+		if end > size {
+			end = size
+		}
+		group.Go(func() error {
+			return readPart(groupCtx, offset, data[offset:end], get)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func readPart(ctx context.Context, offset int64, part []byte, get rangeGetter) error {
+	var read int
+	// the object is removed or overwritten, no need to retry
+	var errUnrecoverable error
+	err := retry.Do(ctx, func() error {
+		reader, err := get(ctx, offset+int64(read), int64(len(part)-read))
+		if err != nil {
+			if IsErrNoSuchKey(err) || errors.Is(err, errObjectChanged) {
+				errUnrecoverable = err
+				return retry.Unrecoverable(err)
+			}
+			return err
+		}
+		defer reader.Close()
+		n, err := io.ReadFull(reader, part[read:])
+		read += n
+		if err != nil {
+			log.Warn("failed to read part, resume it later", zap.Int64("offset", offset), zap.Int("read", read),
+				zap.Int("size", len(part)), zap.Error(err))
+			return err
+		}
+		return nil
+	}, retry.Attempts(rangedReadRetryAttempts))
+	if errUnrecoverable != nil {
+		return errUnrecoverable
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyReader fails after reading limit bytes
+type flakyReader struct {
+	data  []byte
+	limit int
+}
+
+func (r *flakyReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	if r.limit == 0 {
+		return 0, errors.New("connection reset by peer")
+	}
+	n := copy(p[:minInt(len(p), r.limit)], r.data)
+	r.data, r.limit = r.data[n:], r.limit-n
+	return n, nil
+}
+
+func (r *flakyReader) Close() error {
+	return nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func TestRangedReadConfig(t *testing.T) {
+	c := newRangedReadConfig(&config{readPartSize: 10})
+	assert.Equal(t, 1, c.concurrency)
+	assert.False(t, c.enabled(10))
+	assert.True(t, c.enabled(11))
+
+	c = newRangedReadConfig(&config{readConcurrency: 4})
+	assert.False(t, c.enabled(1<<30))
+}
+
+func TestReadRanged(t *testing.T) {
+	ctx := context.Background()
+	object := []byte("the object is read by concurrent ranged gets")
+
+	t.Run("read parts", func(t *testing.T) {
+		var mu sync.Mutex
+		var ranges [][2]int64
+		data, err := readRanged(ctx, int64(len(object)), 8, 3, func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			mu.Lock()
+			ranges = append(ranges, [2]int64{offset, size})
+			mu.Unlock()
+			return &flakyReader{data: object[offset : offset+size], limit: len(object)}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, object, data)
+		assert.Len(t, ranges, 6)
+		assert.Contains(t, ranges, [2]int64{40, 4})
+	})
+
+	t.Run("resume parts", func(t *testing.T) {
+		var mu sync.Mutex
+		gets := 0
+		data, err := readRanged(ctx, int64(len(object)), 16, 2, func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			gets++
+			// every get is reset after 6 bytes, the parts are resumed from the bytes read
+			return &flakyReader{data: object[offset : offset+size], limit: 6}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, object, data)
+		// 3 gets for each of the 2 parts of 16 bytes, 2 gets for the last part of 12 bytes
+		assert.Equal(t, 8, gets)
+	})
+
+	t.Run("no such key", func(t *testing.T) {
+		gets := 0
+		_, err := readRanged(ctx, int64(len(object)), 64, 1, func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			gets++
+			return nil, WrapErrNoSuchKey("object")
+		})
+		assert.True(t, IsErrNoSuchKey(err))
+		assert.Equal(t, 1, gets)
+	})
+
+	t.Run("object changed", func(t *testing.T) {
+		var mu sync.Mutex
+		gets := 0
+		_, err := readRanged(ctx, int64(len(object)), 8, 1, func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			gets++
+			pin := objectPinFrom(ctx)
+			if gets > 1 {
+				// the later gets are conditional on the version of the first response
+				assert.Equal(t, "v1", pin.get())
+			}
+			version := "v1"
+			if offset >= 16 {
+				// overwritten while reading
+				version = "v2"
+			}
+			if err := pin.check(version); err != nil {
+				return nil, err
+			}
+			return &flakyReader{data: object[offset : offset+size], limit: len(object)}, nil
+		})
+		assert.ErrorIs(t, err, errObjectChanged)
+		// not retried
+		assert.Equal(t, 3, gets)
+	})
+
+	t.Run("retry exhausted", func(t *testing.T) {
+		_, err := readRanged(ctx, int64(len(object)), 64, 1, func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			return &flakyReader{data: object[offset : offset+size], limit: 0}, nil
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limitChunkSize is the most bytes charged to the bandwidth limit at once,
+// the concurrent transfers take turns by chunks of this size
+const limitChunkSize = 64 << 10
+
+// storageLimiter limits the request rate and the bandwidth to the object storage.
+// It's shared by all the chunk managers of the node, and the waiters are served in the order they arrive,
+// so the concurrent tasks get a fair share instead of a large transfer starving the others.
+type storageLimiter struct {
+	requests  *rate.Limiter
+	bandwidth *rate.Limiter
+}
+
+var nodeStorageLimiter = newStorageLimiter()
+
+func newStorageLimiter() *storageLimiter {
+	return &storageLimiter{
+		requests:  rate.NewLimiter(rate.Inf, 0),
+		bandwidth: rate.NewLimiter(rate.Inf, 0),
+	}
+}
+
+// getStorageLimiter applies the limits of the config to the limiter shared by the node
+func getStorageLimiter(c *config) *storageLimiter {
+	nodeStorageLimiter.setLimits(c.requestRateLimit, c.bandwidthLimit)
+	return nodeStorageLimiter
+}
+
+// setLimits updates the requests per second and the bytes per second, not positive means unlimited
+func (l *storageLimiter) setLimits(requestRate float64, bandwidth int64) {
+	if requestRate > 0 {
+		burst := int(requestRate)
+		if burst < 1 {
+			burst = 1
+		}
+		setLimit(l.requests, rate.Limit(requestRate), burst)
+	} else {
+		l.requests.SetLimit(rate.Inf)
+	}
+
+	if bandwidth > 0 {
+		setLimit(l.bandwidth, rate.Limit(bandwidth), limitChunkSize)
+	} else {
+		l.bandwidth.SetLimit(rate.Inf)
+	}
+}
+
+// setLimit updates the limit and the burst of lim, the bucket starts full once lim turns from unlimited to limited
+func setLimit(lim *rate.Limiter, limit rate.Limit, burst int) {
+	at := time.Now()
+	if lim.Limit() == rate.Inf {
+		// the tokens are refilled since the zero time on the next wait
+		at = time.Time{}
+	}
+	lim.SetBurstAt(at, burst)
+	lim.SetLimitAt(at, limit)
+}
+
+// waitRequest blocks until a request is allowed or the ctx is done, a nil limiter is unlimited
+func (l *storageLimiter) waitRequest(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	return l.requests.Wait(ctx)
+}
+
+// waitBytes blocks until n bytes are allowed to transfer or the ctx is done, a nil limiter is unlimited
+func (l *storageLimiter) waitBytes(ctx context.Context, n int64) error {
+	if l == nil || l.bandwidth.Limit() == rate.Inf {
+		return nil
+	}
+	return waitChunks(ctx, l.bandwidth, n)
+}
+
+// waitChunks charges n bytes to the bandwidth by chunks, so the concurrent transfers take turns
+func waitChunks(ctx context.Context, bandwidth *rate.Limiter, n int64) error {
+	for n > 0 {
+		chunk := n
+		if chunk > limitChunkSize {
+			chunk = limitChunkSize
+		}
+		if err := bandwidth.WaitN(ctx, int(chunk)); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// reader limits the bandwidth of reading r, r is returned as is if the bandwidth is unlimited
+func (l *storageLimiter) reader(ctx context.Context, r io.Reader) io.Reader {
+	if l == nil || l.bandwidth.Limit() == rate.Inf {
+		return r
+	}
+	return &limitedReader{ctx: ctx, reader: r, bandwidth: l.bandwidth}
+}
+
+// fileReader is the reader which keeps the Close of r, and the ReadAt and Seek of r if it's an object of minio,
+// so the ranged reads of the callers still work
+func (l *storageLimiter) fileReader(ctx context.Context, r FileReader) FileReader {
+	if l == nil || l.bandwidth.Limit() == rate.Inf {
+		return r
+	}
+	reader := &limitedFileReader{
+		limitedReader: limitedReader{ctx: ctx, reader: r, bandwidth: l.bandwidth},
+		closer:        r,
+	}
+	if object, ok := r.(seekableFileReader); ok {
+		return &limitedSeekableReader{limitedFileReader: reader, object: object}
+	}
+	return reader
+}
+
+type limitedReader struct {
+	ctx       context.Context
+	reader    io.Reader
+	bandwidth *rate.Limiter
+}
+
+// Read charges the bytes read to the bandwidth limit, and waits for the tokens before returning
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > limitChunkSize {
+		p = p[:limitChunkSize]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if werr := r.bandwidth.WaitN(r.ctx, n); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return n, err
+}
+
+type limitedFileReader struct {
+	limitedReader
+	closer io.Closer
+}
+
+func (r *limitedFileReader) Close() error {
+	return r.closer.Close()
+}
+
+// seekableFileReader is the FileReader supporting the random access, e.g. *minio.Object
+type seekableFileReader interface {
+	FileReader
+	io.ReaderAt
+	io.Seeker
+}
+
+type limitedSeekableReader struct {
+	*limitedFileReader
+	object seekableFileReader
+}
+
+// ReadAt charges the bytes read to the bandwidth limit like Read
+func (r *limitedSeekableReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.object.ReadAt(p, off)
+	if werr := waitChunks(r.ctx, r.bandwidth, int64(n)); werr != nil && err == nil {
+		err = werr
+	}
+	return n, err
+}
+
+func (r *limitedSeekableReader) Seek(offset int64, whence int) (int64, error) {
+	return r.object.Seek(offset, whence)
+}
+
+// middleware charges the requests and the bytes transferred through the layer to the limiter
+func (l *storageLimiter) middleware(next ObjectStorageLayer) ObjectStorageLayer {
+	return &limitLayer{ObjectStorageLayer: next, limiter: l}
+}
+
+type limitLayer struct {
+	ObjectStorageLayer
+	limiter *storageLimiter
+}
+
+func (l *limitLayer) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	if err := l.limiter.waitRequest(ctx); err != nil {
+		return nil, err
+	}
+	reader, err := l.ObjectStorageLayer.GetObject(ctx, bucketName, objectName, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	return l.limiter.fileReader(ctx, reader), nil
+}
+
+// PutObjectWithChecksum charges the bytes of the object before the request if the size is known, and passes the reader
+// as is, so the client still retries and uploads the parts in parallel by seeking and reading at the reader
+func (l *limitLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	if err := l.limiter.waitRequest(ctx); err != nil {
+		return err
+	}
+	if objectSize < 0 {
+		return l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, l.limiter.reader(ctx, reader), objectSize, checksum)
+	}
+	if err := l.limiter.waitBytes(ctx, objectSize); err != nil {
+		return err
+	}
+	return l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+}
+
+func (l *limitLayer) StatObjectWithChecksum(ctx context.Context, bucketName, objectName string) (int64, string, error) {
+	if err := l.limiter.waitRequest(ctx); err != nil {
+		return 0, "", err
+	}
+	return l.ObjectStorageLayer.StatObjectWithChecksum(ctx, bucketName, objectName)
+}
+
+func (l *limitLayer) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	if err := l.limiter.waitRequest(ctx); err != nil {
+		return nil, err
+	}
+	return l.ObjectStorageLayer.ListObjects(ctx, bucketName, prefix, recursive)
+}
+
+func (l *limitLayer) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	if err := l.limiter.waitRequest(ctx); err != nil {
+		return err
+	}
+	return l.ObjectStorageLayer.RemoveObject(ctx, bucketName, objectName)
+}
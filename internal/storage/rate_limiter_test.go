@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestStorageLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		var nilLimiter *storageLimiter
+		assert.NoError(t, nilLimiter.waitRequest(ctx))
+		reader := bytes.NewReader([]byte("data"))
+		assert.Equal(t, reader, nilLimiter.reader(ctx, reader))
+
+		limiter := newStorageLimiter()
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, limiter.waitRequest(ctx))
+		}
+		assert.Equal(t, reader, limiter.reader(ctx, reader))
+		fileReader := io.NopCloser(reader)
+		assert.Equal(t, fileReader, limiter.fileReader(ctx, fileReader))
+	})
+
+	t.Run("request rate", func(t *testing.T) {
+		limiter := newStorageLimiter()
+		limiter.setLimits(1, 0)
+		assert.NoError(t, limiter.waitRequest(ctx))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, limiter.waitRequest(ctx))
+
+		// back to unlimited
+		limiter.setLimits(0, 0)
+		assert.NoError(t, limiter.waitRequest(context.Background()))
+	})
+
+	t.Run("bandwidth", func(t *testing.T) {
+		limiter := newStorageLimiter()
+		limiter.setLimits(0, 10*limitChunkSize)
+
+		value := make([]byte, 3*limitChunkSize)
+		start := time.Now()
+		data, err := io.ReadAll(limiter.fileReader(ctx, io.NopCloser(bytes.NewReader(value))))
+		assert.NoError(t, err)
+		assert.Equal(t, value, data)
+		// the first chunk is taken from the full bucket, the others wait 100ms each
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = io.ReadAll(limiter.reader(ctx, bytes.NewReader(value)))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("seekable readers", func(t *testing.T) {
+		limiter := newStorageLimiter()
+		limiter.setLimits(0, 10*limitChunkSize)
+
+		// the object is read at by the ranged reads
+		value := make([]byte, 3*limitChunkSize)
+		value[2*limitChunkSize] = 1
+		reader := limiter.fileReader(ctx, nopSeekableReader{bytes.NewReader(value)})
+		readerAt, ok := reader.(io.ReaderAt)
+		require.True(t, ok)
+		p := make([]byte, limitChunkSize+1)
+		n, err := readerAt.ReadAt(p, limitChunkSize)
+		assert.NoError(t, err)
+		assert.Equal(t, len(p), n)
+		assert.EqualValues(t, 1, p[limitChunkSize])
+		_, ok = reader.(io.Seeker)
+		assert.True(t, ok)
+
+		// the uploaded reader is passed as is, so the client may seek it to retry
+		capture := &readerCaptureLayer{}
+		chain := newObjectStorageChain(&memChecksumStorage{objects: map[string][]byte{}, checksums: map[string]string{}},
+			limiter.middleware, func(next ObjectStorageLayer) ObjectStorageLayer {
+				capture.ObjectStorageLayer = next
+				return capture
+			})
+		body := bytes.NewReader(value)
+		require.NoError(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", body, int64(len(value)), ""))
+		assert.Same(t, body, capture.reader)
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, chain.PutObjectWithChecksum(ctx, "bucket", "a", body, int64(len(value)), ""), context.Canceled)
+	})
+
+	t.Run("shared by the node", func(t *testing.T) {
+		defer nodeStorageLimiter.setLimits(0, 0)
+		limiter := getStorageLimiter(&config{requestRateLimit: 100, bandwidthLimit: 1 << 20})
+		assert.Same(t, nodeStorageLimiter, limiter)
+		assert.EqualValues(t, 100, limiter.requests.Limit())
+		assert.EqualValues(t, 1<<20, limiter.bandwidth.Limit())
+
+		// the latest config applies to all the chunk managers of the node
+		assert.Same(t, limiter, getStorageLimiter(&config{}))
+		assert.Equal(t, rate.Inf, limiter.requests.Limit())
+	})
+}
+
+type nopSeekableReader struct {
+	*bytes.Reader
+}
+
+func (nopSeekableReader) Close() error {
+	return nil
+}
+
+// readerCaptureLayer records the reader of the last upload
+type readerCaptureLayer struct {
+	ObjectStorageLayer
+	reader io.Reader
+}
+
+func (l *readerCaptureLayer) PutObjectWithChecksum(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, checksum string) error {
+	l.reader = reader
+	return l.ObjectStorageLayer.PutObjectWithChecksum(ctx, bucketName, objectName, reader, objectSize, checksum)
+}
+
+func TestRemoteChunkManagerLimit(t *testing.T) {
+	client := &memChecksumStorage{objects: map[string][]byte{}, checksums: map[string]string{}}
+	limiter := newStorageLimiter()
+	mcm := &RemoteChunkManager{client: newObjectStorageChain(client, limiter.middleware)}
+
+	ctx := context.Background()
+	require.NoError(t, mcm.Write(ctx, "a", []byte("binlog")))
+
+	limiter.setLimits(1, limitChunkSize)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	// the only request of the second is taken, the others wait until the ctx is done
+	assert.NoError(t, limiter.waitRequest(ctx))
+	_, err := mcm.Read(ctx, "a")
+	assert.Error(t, err)
+	assert.Error(t, mcm.Write(ctx, "b", []byte("binlog")))
+	_, err = mcm.Exist(ctx, "a")
+	assert.Error(t, err)
+	assert.Error(t, mcm.Remove(ctx, "a"))
+
+	limiter.setLimits(0, 0)
+	data, err := mcm.Read(context.Background(), "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("binlog"), data)
+}
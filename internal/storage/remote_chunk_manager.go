@@ -24,8 +24,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 	"github.com/cockroachdb/errors"
 	minio "github.com/minio/minio-go/v7"
 	"go.uber.org/zap"
@@ -35,7 +33,6 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
-	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
 const (
@@ -44,6 +41,8 @@ const (
 	CloudProviderAliyun = "aliyun"
 
 	CloudProviderAzure = "azure"
+	// CloudProviderGCPNative accesses GCS by the JSON API instead of the S3 compatible API
+	CloudProviderGCPNative = "gcpnative"
 )
 
 type ObjectStorage interface {
@@ -56,30 +55,70 @@ type ObjectStorage interface {
 
 // RemoteChunkManager is responsible for read and write data stored in minio.
 type RemoteChunkManager struct {
-	client ObjectStorage
+	// client is the chain of the middlewares around the backend
+	client ObjectStorageLayer
+	// presigner is the backend if it supports presigning, nil otherwise
+	presigner presignStorage
+	// archiver is the backend if it supports archiving, nil otherwise
+	archiver archiveStorage
+	// cache is the disk cache of the chain, nil if it's disabled
+	cache *diskCache
 
 	//	ctx        context.Context
 	bucketName string
 	rootPath   string
+	rangedRead rangedReadConfig
 }
 
-var _ ChunkManager = (*RemoteChunkManager)(nil)
+var (
+	_ ChunkManager = (*RemoteChunkManager)(nil)
+	_ Flusher      = (*RemoteChunkManager)(nil)
+)
+
+// newRemoteChunkManagerWithBackend composes the middlewares of the config around the backend
+func newRemoteChunkManagerWithBackend(backend ObjectStorage, c *config) (*RemoteChunkManager, error) {
+	middlewares, err := defaultMiddlewares(c)
+	if err != nil {
+		return nil, err
+	}
+	presigner, _ := backend.(presignStorage)
+	archiver, _ := backend.(archiveStorage)
+	var cache *diskCache
+	if c.cachePath != "" {
+		// it's the cache shared by the node, which is composed into the chain by defaultMiddlewares
+		cache, err = getDiskCache(c.cachePath, c.cacheCapacity, c.cacheWriteBack)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &RemoteChunkManager{
+		client:     newObjectStorageChain(backend, middlewares...),
+		presigner:  presigner,
+		archiver:   archiver,
+		cache:      cache,
+		bucketName: c.bucketName,
+		rootPath:   strings.TrimLeft(c.rootPath, "/"),
+		rangedRead: newRangedReadConfig(c),
+	}, nil
+}
 
 func NewRemoteChunkManager(ctx context.Context, c *config) (*RemoteChunkManager, error) {
-	var client ObjectStorage
+	var backend ObjectStorage
 	var err error
-	if c.cloudProvider == CloudProviderAzure {
-		client, err = newAzureObjectStorageWithConfig(ctx, c)
-	} else {
-		client, err = newMinioObjectStorageWithConfig(ctx, c)
+	switch c.cloudProvider {
+	case CloudProviderAzure:
+		backend, err = newAzureObjectStorageWithConfig(ctx, c)
+	case CloudProviderGCPNative:
+		backend, err = newGcpNativeObjectStorageWithConfig(ctx, c)
+	default:
+		backend, err = newMinioObjectStorageWithConfig(ctx, c)
 	}
 	if err != nil {
 		return nil, err
 	}
-	mcm := &RemoteChunkManager{
-		client:     client,
-		bucketName: c.bucketName,
-		rootPath:   strings.TrimLeft(c.rootPath, "/"),
+	mcm, err := newRemoteChunkManagerWithBackend(backend, c)
+	if err != nil {
+		return nil, err
 	}
 	log.Info("remote chunk manager init success.", zap.String("remote", c.cloudProvider), zap.String("bucketname", c.bucketName), zap.String("root", mcm.RootPath()))
 	return mcm, nil
@@ -102,14 +141,19 @@ func (mcm *RemoteChunkManager) Path(ctx context.Context, filePath string) (strin
 	return filePath, nil
 }
 
-// Reader returns the path of minio data if exists.
+// Reader returns the path of minio data if exists, the content is verified against its checksum once it's read to the end.
 func (mcm *RemoteChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
-	reader, err := mcm.getObject(ctx, mcm.bucketName, filePath, int64(0), int64(0))
+	_, expected, err := mcm.client.StatObjectWithChecksum(ctx, mcm.bucketName, filePath)
+	if err != nil {
+		log.Warn("failed to stat object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
+	reader, err := mcm.client.GetObject(ctx, mcm.bucketName, filePath, int64(0), int64(0))
 	if err != nil {
 		log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return nil, err
 	}
-	return reader, nil
+	return newChecksumReader(filePath, reader, expected), nil
 }
 
 func (mcm *RemoteChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
@@ -124,7 +168,7 @@ func (mcm *RemoteChunkManager) Size(ctx context.Context, filePath string) (int64
 
 // Write writes the data to minio storage.
 func (mcm *RemoteChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
-	err := mcm.putObject(ctx, mcm.bucketName, filePath, bytes.NewReader(content), int64(len(content)))
+	err := mcm.client.PutObjectWithChecksum(ctx, mcm.bucketName, filePath, bytes.NewReader(content), int64(len(content)), objectChecksum(content))
 	if err != nil {
 		log.Warn("failed to put object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return err
@@ -134,6 +178,14 @@ func (mcm *RemoteChunkManager) Write(ctx context.Context, filePath string, conte
 	return nil
 }
 
+// Flush returns once the objects written back by the disk cache are uploaded to the storage.
+func (mcm *RemoteChunkManager) Flush(ctx context.Context) error {
+	if mcm.cache == nil || !mcm.cache.writeBack {
+		return nil
+	}
+	return mcm.cache.flushAll(ctx)
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *RemoteChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
@@ -160,9 +212,46 @@ func (mcm *RemoteChunkManager) Exist(ctx context.Context, filePath string) (bool
 	return true, nil
 }
 
+// MultiExist checks the existence of the objects concurrently.
+func (mcm *RemoteChunkManager) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	exists := make([]bool, len(filePaths))
+	err := forEachPath(ctx, filePaths, func(ctx context.Context, i int, filePath string) error {
+		var err error
+		exists[i], err = mcm.Exist(ctx, filePath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return exists, nil
+}
+
+// MultiStat stats the objects concurrently.
+func (mcm *RemoteChunkManager) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	sizes := make([]int64, len(filePaths))
+	err := forEachPath(ctx, filePaths, func(ctx context.Context, i int, filePath string) error {
+		var err error
+		sizes[i], err = mcm.Size(ctx, filePath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
 // Read reads the minio storage data if exists.
 func (mcm *RemoteChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
-	object, err := mcm.getObject(ctx, mcm.bucketName, filePath, int64(0), int64(0))
+	size, expected, err := mcm.client.StatObjectWithChecksum(ctx, mcm.bucketName, filePath)
+	if err != nil {
+		log.Warn("failed to stat object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
+	if mcm.rangedRead.enabled(size) {
+		return mcm.readRanged(ctx, filePath, size, expected)
+	}
+
+	object, err := mcm.client.GetObject(ctx, mcm.bucketName, filePath, int64(0), int64(0))
 	if err != nil {
 		log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return nil, err
@@ -180,11 +269,6 @@ func (mcm *RemoteChunkManager) Read(ctx context.Context, filePath string) ([]byt
 		log.Warn("failed to read object", zap.String("path", filePath), zap.Error(err))
 		return nil, err
 	}
-	size, err := mcm.getObjectSize(ctx, mcm.bucketName, filePath)
-	if err != nil {
-		log.Warn("failed to stat object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
-		return nil, err
-	}
 	data, err := Read(object, size)
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
@@ -194,6 +278,26 @@ func (mcm *RemoteChunkManager) Read(ctx context.Context, filePath string) ([]byt
 		log.Warn("failed to read object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return nil, err
 	}
+	if err := verifyChecksum(filePath, data, expected); err != nil {
+		return nil, err
+	}
+	metrics.PersistentDataKvSize.WithLabelValues(metrics.DataGetLabel).Observe(float64(size))
+	return data, nil
+}
+
+// readRanged reads the large object by the concurrent ranged gets, and verifies it against the expected checksum
+func (mcm *RemoteChunkManager) readRanged(ctx context.Context, filePath string, size int64, expected string) ([]byte, error) {
+	data, err := readRanged(ctx, size, mcm.rangedRead.partSize, mcm.rangedRead.concurrency,
+		func(ctx context.Context, offset int64, size int64) (FileReader, error) {
+			return mcm.client.GetObject(ctx, mcm.bucketName, filePath, offset, size)
+		})
+	if err != nil {
+		log.Warn("failed to read object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
+	if err := verifyChecksum(filePath, data, expected); err != nil {
+		return nil, err
+	}
 	metrics.PersistentDataKvSize.WithLabelValues(metrics.DataGetLabel).Observe(float64(size))
 	return data, nil
 }
@@ -225,17 +329,94 @@ func (mcm *RemoteChunkManager) ReadWithPrefix(ctx context.Context, prefix string
 	return objectsKeys, objectsValues, nil
 }
 
+// PresignedURL returns the url to download the object without credentials, which expires after @expiry.
+// The object must exist in the remote storage.
+func (mcm *RemoteChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	if err := checkPresignExpiry(expiry); err != nil {
+		return "", err
+	}
+	if mcm.presigner == nil {
+		return "", errors.New("presigned url is not supported by the remote storage")
+	}
+	if _, err := mcm.Size(ctx, filePath); err != nil {
+		return "", err
+	}
+	url, err := mcm.presigner.PresignGetObject(ctx, mcm.bucketName, filePath, expiry)
+	if err != nil {
+		log.Warn("failed to presign object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return "", err
+	}
+	return url, nil
+}
+
+// Archive moves the objects into the archival storage class, they must be restored before reading.
+func (mcm *RemoteChunkManager) Archive(ctx context.Context, filePaths []string) error {
+	if mcm.archiver == nil {
+		return errors.New("archiving is not supported by the remote storage")
+	}
+	return forEachPath(ctx, filePaths, func(ctx context.Context, i int, filePath string) error {
+		err := mcm.archiver.ArchiveObject(ctx, mcm.bucketName, filePath)
+		if err != nil {
+			log.Warn("failed to archive object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		}
+		return err
+	})
+}
+
+// Restore moves the archived objects one step towards the standard storage class, the restore is asynchronous,
+// call it until ArchiveStates reports all of them are standard.
+func (mcm *RemoteChunkManager) Restore(ctx context.Context, filePaths []string) error {
+	if mcm.archiver == nil {
+		return errors.New("archiving is not supported by the remote storage")
+	}
+	return forEachPath(ctx, filePaths, func(ctx context.Context, i int, filePath string) error {
+		err := mcm.archiver.UnarchiveObject(ctx, mcm.bucketName, filePath)
+		if err != nil {
+			log.Warn("failed to restore object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		}
+		return err
+	})
+}
+
+// ArchiveStates returns the archive state of each of the objects.
+func (mcm *RemoteChunkManager) ArchiveStates(ctx context.Context, filePaths []string) ([]ArchiveState, error) {
+	if mcm.archiver == nil {
+		return nil, errors.New("archiving is not supported by the remote storage")
+	}
+	states := make([]ArchiveState, len(filePaths))
+	err := forEachPath(ctx, filePaths, func(ctx context.Context, i int, filePath string) error {
+		var err error
+		states[i], err = mcm.archiver.ArchiveStateOfObject(ctx, mcm.bucketName, filePath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
 func (mcm *RemoteChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
 	return nil, errors.New("this method has not been implemented")
 }
 
-// ReadAt reads specific position data of minio storage if exists.
+// ReadAt reads specific position data of minio storage if exists, the blocks covering the range are verified against their checksums.
 func (mcm *RemoteChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
 	if off < 0 || length < 0 {
 		return nil, io.EOF
 	}
 
-	object, err := mcm.getObject(ctx, mcm.bucketName, filePath, off, length)
+	size, expected, err := mcm.client.StatObjectWithChecksum(ctx, mcm.bucketName, filePath)
+	if err != nil {
+		log.Warn("failed to stat object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
+	return readAtWithChecksum(ctx, filePath, size, expected, off, length, func(ctx context.Context, off int64, length int64) ([]byte, error) {
+		return mcm.readAt(ctx, filePath, off, length)
+	})
+}
+
+func (mcm *RemoteChunkManager) readAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	object, err := mcm.client.GetObject(ctx, mcm.bucketName, filePath, off, length)
 	if err != nil {
 		log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return nil, err
@@ -257,7 +438,7 @@ func (mcm *RemoteChunkManager) ReadAt(ctx context.Context, filePath string, off
 
 // Remove deletes an object with @key.
 func (mcm *RemoteChunkManager) Remove(ctx context.Context, filePath string) error {
-	err := mcm.removeObject(ctx, mcm.bucketName, filePath)
+	err := mcm.client.RemoveObject(ctx, mcm.bucketName, filePath)
 	if err != nil {
 		log.Warn("failed to remove object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return err
@@ -279,8 +460,9 @@ func (mcm *RemoteChunkManager) MultiRemove(ctx context.Context, keys []string) e
 
 // RemoveWithPrefix removes all objects with the same prefix @prefix from minio.
 func (mcm *RemoteChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
-	objects, err := mcm.listObjects(ctx, mcm.bucketName, prefix, true)
+	objects, err := mcm.client.ListObjects(ctx, mcm.bucketName, prefix, true)
 	if err != nil {
+		log.Warn("failed to list with prefix", zap.String("bucket", mcm.bucketName), zap.String("prefix", prefix), zap.Error(err))
 		return err
 	}
 	removeKeys := make([]string, 0)
@@ -294,7 +476,7 @@ func (mcm *RemoteChunkManager) RemoveWithPrefix(ctx context.Context, prefix stri
 		for j := 0; j < maxGoroutine && i < len(removeKeys); j++ {
 			key := removeKeys[i]
 			runningGroup.Go(func() error {
-				err := mcm.removeObject(groupCtx, mcm.bucketName, key)
+				err := mcm.client.RemoveObject(groupCtx, mcm.bucketName, key)
 				if err != nil {
 					log.Warn("failed to remove object", zap.String("path", key), zap.Error(err))
 					return err
@@ -333,8 +515,9 @@ func (mcm *RemoteChunkManager) ListWithPrefix(ctx context.Context, prefix string
 
 		// TODO add concurrent call if performance matters
 		// only return current level per call
-		objects, err := mcm.listObjects(ctx, mcm.bucketName, pre, false)
+		objects, err := mcm.client.ListObjects(ctx, mcm.bucketName, pre, false)
 		if err != nil {
+			log.Warn("failed to list with prefix", zap.String("bucket", mcm.bucketName), zap.String("prefix", prefix), zap.Error(err))
 			return nil, nil, err
 		}
 
@@ -355,101 +538,23 @@ func (mcm *RemoteChunkManager) ListWithPrefix(ctx context.Context, prefix string
 	return objectsKeys, modTimes, nil
 }
 
-func (mcm *RemoteChunkManager) getObject(ctx context.Context, bucketName, objectName string,
-	offset int64, size int64,
-) (FileReader, error) {
-	start := timerecord.NewTimeRecorder("getObject")
-
-	reader, err := mcm.client.GetObject(ctx, bucketName, objectName, offset, size)
-	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataGetLabel, metrics.TotalLabel).Inc()
-	if err == nil && reader != nil {
-		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataGetLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataGetLabel, metrics.SuccessLabel).Inc()
-	} else {
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataGetLabel, metrics.FailLabel).Inc()
-	}
-
-	switch err := err.(type) {
-	case *azcore.ResponseError:
-		if err.ErrorCode == string(bloberror.BlobNotFound) {
-			return nil, WrapErrNoSuchKey(objectName)
-		}
-	case minio.ErrorResponse:
-		if err.Code == "NoSuchKey" {
-			return nil, WrapErrNoSuchKey(objectName)
-		}
-	}
-
-	return reader, err
-}
-
-func (mcm *RemoteChunkManager) putObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
-	start := timerecord.NewTimeRecorder("putObject")
-
-	err := mcm.client.PutObject(ctx, bucketName, objectName, reader, objectSize)
-	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataPutLabel, metrics.TotalLabel).Inc()
-	if err == nil {
-		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataPutLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.SuccessLabel).Inc()
-	} else {
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.FailLabel).Inc()
-	}
-
-	return err
-}
-
 func (mcm *RemoteChunkManager) getObjectSize(ctx context.Context, bucketName, objectName string) (int64, error) {
-	start := timerecord.NewTimeRecorder("getObjectSize")
-
-	info, err := mcm.client.StatObject(ctx, bucketName, objectName)
-	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataStatLabel, metrics.TotalLabel).Inc()
-	if err == nil {
-		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataStatLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataStatLabel, metrics.SuccessLabel).Inc()
-	} else {
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataStatLabel, metrics.FailLabel).Inc()
-	}
-
-	switch err := err.(type) {
-	case *azcore.ResponseError:
-		if err.ErrorCode == string(bloberror.BlobNotFound) {
-			return info, WrapErrNoSuchKey(objectName)
-		}
-	case minio.ErrorResponse:
-		if err.Code == "NoSuchKey" {
-			return info, WrapErrNoSuchKey(objectName)
-		}
-	}
-
-	return info, err
+	size, _, err := mcm.client.StatObjectWithChecksum(ctx, bucketName, objectName)
+	return size, err
 }
 
-func (mcm *RemoteChunkManager) listObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
-	start := timerecord.NewTimeRecorder("listObjects")
+// multiStatConcurrency is the most stat requests in flight of MultiExist and MultiStat
+const multiStatConcurrency = 32
 
-	res, err := mcm.client.ListObjects(ctx, bucketName, prefix, recursive)
-	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataListLabel, metrics.TotalLabel).Inc()
-	if err == nil {
-		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataListLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataListLabel, metrics.SuccessLabel).Inc()
-	} else {
-		log.Warn("failed to list with prefix", zap.String("bucket", mcm.bucketName), zap.String("prefix", prefix), zap.Error(err))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataListLabel, metrics.FailLabel).Inc()
+// forEachPath calls fn on each of paths concurrently with their indexes, it stops at the first error
+func forEachPath(ctx context.Context, paths []string, fn func(ctx context.Context, i int, path string) error) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(multiStatConcurrency)
+	for i, path := range paths {
+		i, path := i, path
+		group.Go(func() error {
+			return fn(groupCtx, i, path)
+		})
 	}
-	return res, err
-}
-
-func (mcm *RemoteChunkManager) removeObject(ctx context.Context, bucketName, objectName string) error {
-	start := timerecord.NewTimeRecorder("removeObject")
-
-	err := mcm.client.RemoveObject(ctx, bucketName, objectName)
-	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataRemoveLabel, metrics.TotalLabel).Inc()
-	if err == nil {
-		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataRemoveLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataRemoveLabel, metrics.SuccessLabel).Inc()
-	} else {
-		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataRemoveLabel, metrics.FailLabel).Inc()
-	}
-
-	return err
+	return group.Wait()
 }
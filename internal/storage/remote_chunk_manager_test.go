@@ -18,8 +18,12 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -429,6 +433,39 @@ func TestMinioChunkManager(t *testing.T) {
 		assert.Nil(t, r)
 	})
 
+	t.Run("test PresignedURL", func(t *testing.T) {
+		testPresignRoot := path.Join(testMinIOKVRoot, "presign")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newMinioChunkManager(ctx, testBucket, testPresignRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testPresignRoot)
+
+		key := path.Join(testPresignRoot, "TestMinIOKV_Presign_key")
+		value := []byte("TestMinIOKV_Presign_value")
+
+		err = testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		url, err := testCM.PresignedURL(ctx, key, time.Minute)
+		require.NoError(t, err)
+		resp, err := http.Get(url)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, value, data)
+
+		_, err = testCM.PresignedURL(ctx, key, 0)
+		assert.Error(t, err)
+		_, err = testCM.PresignedURL(ctx, key, MaxPresignExpiry+time.Second)
+		assert.Error(t, err)
+
+		_, err = testCM.PresignedURL(ctx, path.Join(testPresignRoot, "not_exist"), time.Minute)
+		assert.Error(t, err)
+	})
+
 	t.Run("test Prefix", func(t *testing.T) {
 		testPrefix := path.Join(testMinIOKVRoot, "prefix")
 		ctx, cancel := context.WithCancel(context.Background())
@@ -506,9 +543,9 @@ func TestMinioChunkManager(t *testing.T) {
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, ErrNoSuchKey))
 
-		file, err := testCM.Reader(ctx, key)
-		assert.NoError(t, err) // todo
-		file.Close()
+		_, err = testCM.Reader(ctx, key)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
 
 		_, err = testCM.ReadAt(ctx, key, 100, 1)
 		assert.Error(t, err)
@@ -969,3 +1006,50 @@ func TestAzureChunkManager(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrNoSuchKey))
 	})
 }
+
+func TestRemoteChunkManagerPresignNotSupported(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingObjectStorage()
+	testCM, err := newRemoteChunkManagerWithBackend(backend, &config{bucketName: "bucket"})
+	require.NoError(t, err)
+
+	require.NoError(t, testCM.Write(ctx, "key", []byte("value")))
+	_, err = testCM.PresignedURL(ctx, "key", time.Minute)
+	assert.Error(t, err)
+}
+
+func TestRemoteChunkManagerMultiStat(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingObjectStorage()
+	testCM, err := newRemoteChunkManagerWithBackend(backend, &config{bucketName: "bucket"})
+	require.NoError(t, err)
+
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key_%d", i)
+		keys = append(keys, key)
+		if i%2 == 0 {
+			require.NoError(t, testCM.Write(ctx, key, make([]byte, i)))
+		}
+	}
+
+	exists, err := testCM.MultiExist(ctx, keys)
+	assert.NoError(t, err)
+	assert.Len(t, exists, len(keys))
+	for i, exist := range exists {
+		assert.Equal(t, i%2 == 0, exist)
+	}
+
+	existKeys := make([]string, 0, 50)
+	for i := 0; i < 100; i += 2 {
+		existKeys = append(existKeys, keys[i])
+	}
+	sizes, err := testCM.MultiStat(ctx, existKeys)
+	assert.NoError(t, err)
+	for i, size := range sizes {
+		assert.EqualValues(t, 2*i, size)
+	}
+
+	_, err = testCM.MultiStat(ctx, keys)
+	assert.True(t, IsErrNoSuchKey(err))
+}
@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// Header of the requests to the requester-pays buckets
+const (
+	requestPayerHeader    = "X-Amz-Request-Payer"
+	requestPayerRequester = "requester"
+
+	signV4Algorithm = "AWS4-HMAC-SHA256"
+)
+
+// the headers managed by the clients, which can't be customized
+var reservedHeaders = map[string]struct{}{
+	"authorization":  {},
+	"host":           {},
+	"content-length": {},
+	"content-md5":    {},
+	"content-type":   {},
+	"date":           {},
+}
+
+// ValidateS3RequestOptions checks whether the requester-pays bucket, dual-stack/FIPS endpoint and custom headers are
+// supported by the chunk manager of storageType and cloudProvider, and whether the endpoint and headers are valid
+func ValidateS3RequestOptions(storageType, cloudProvider, address, region string, useSSL, requesterPays, dualStack, fips bool, headers map[string]string) error {
+	if !requesterPays && !dualStack && !fips && len(headers) == 0 {
+		return nil
+	}
+	if storageType != "minio" && storageType != "remote" {
+		return fmt.Errorf("requester-pays bucket, dual-stack/FIPS endpoint and custom headers are not supported by storage type %s", storageType)
+	}
+	if cloudProvider == CloudProviderAzure || cloudProvider == CloudProviderGCPNative {
+		return fmt.Errorf("requester-pays bucket, dual-stack/FIPS endpoint and custom headers are not supported by cloud provider %s, "+
+			"use its s3 compatible endpoint instead", cloudProvider)
+	}
+	if requesterPays && cloudProvider != CloudProviderAWS {
+		return fmt.Errorf("requester-pays bucket is only supported by cloud provider %s, got %s", CloudProviderAWS, cloudProvider)
+	}
+	if requesterPays && !useSSL {
+		// the uploads without ssl are streaming signed, the request payer can't be added to them
+		return errors.New("requester-pays bucket requires ssl")
+	}
+	if _, err := ResolveS3Endpoint(address, region, dualStack, fips); err != nil {
+		return err
+	}
+	for key, value := range headers {
+		if key == "" || strings.ContainsAny(key, " \t\r\n:") || strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid custom header %q: %q", key, value)
+		}
+		lowerKey := strings.ToLower(key)
+		if _, ok := reservedHeaders[lowerKey]; ok {
+			return fmt.Errorf("custom header %s is managed by the storage client and can't be set", key)
+		}
+		if strings.HasPrefix(lowerKey, "x-amz-") {
+			return fmt.Errorf("custom header %s is not allowed as the x-amz-* headers must be signed, "+
+				"use the dedicated options such as requester-pays instead", key)
+		}
+	}
+	return nil
+}
+
+// ResolveS3Endpoint returns the dual-stack and/or FIPS endpoint of aws s3 in region if either is requested,
+// otherwise address is returned as is
+func ResolveS3Endpoint(address, region string, dualStack, fips bool) (string, error) {
+	if !dualStack && !fips {
+		return address, nil
+	}
+	host := address
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		host = h
+	}
+	if !strings.HasSuffix(host, ".amazonaws.com") {
+		return "", fmt.Errorf("dual-stack and FIPS endpoints are only available on aws s3 but the address is %s, "+
+			"set the address to the endpoint of the storage and disable them instead", address)
+	}
+	if region == "" {
+		return "", errors.New("region is required to resolve the dual-stack or FIPS endpoint of aws s3")
+	}
+	name := "s3"
+	if fips {
+		name = "s3-fips"
+	}
+	if dualStack {
+		name += ".dualstack"
+	}
+	return fmt.Sprintf("%s.%s.amazonaws.com", name, region), nil
+}
+
+// headerTransport sets the custom headers on every request, they're sent unsigned.
+// For the requester-pays bucket, it also sets the request payer on the requests minio-go has no option for,
+// e.g. the writes, removes and multipart uploads, and signs them again as the x-amz-* headers must be signed.
+type headerTransport struct {
+	backend http.RoundTripper
+	headers map[string]string
+	// the credentials to sign the request payer with, nil if the bucket isn't requester-pays
+	payerCreds *credentials.Credentials
+}
+
+// newHeaderTransport returns the transport sending headers and the request payer, nil if there is neither
+func newHeaderTransport(secure bool, headers map[string]string, requesterPays bool, creds *credentials.Credentials) (http.RoundTripper, error) {
+	if len(headers) == 0 && !requesterPays {
+		return nil, nil
+	}
+	backend, err := minio.DefaultTransport(secure)
+	if err != nil {
+		return nil, err
+	}
+	t := &headerTransport{backend: backend, headers: headers}
+	if requesterPays {
+		t.payerCreds = creds
+	}
+	return t, nil
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip shouldn't modify the request
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+	if t.payerCreds != nil && req.Header.Get(requestPayerHeader) == "" {
+		var err error
+		if req, err = t.signRequestPayer(req); err != nil {
+			return nil, err
+		}
+	}
+	return t.backend.RoundTrip(req)
+}
+
+// signRequestPayer sets the request payer on req and signs it again in the region of its signature
+func (t *headerTransport) signRequestPayer(req *http.Request) (*http.Request, error) {
+	req.Header.Set(requestPayerHeader, requestPayerRequester)
+	auth := req.Header.Get("Authorization")
+	if auth == "" || t.payerCreds == nil {
+		// the anonymous requests aren't signed
+		return req, nil
+	}
+	if strings.HasPrefix(req.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return nil, errors.New("the streaming signed request can't be sent to the requester-pays bucket, enable ssl instead")
+	}
+	region, err := signatureRegion(auth)
+	if err != nil {
+		return nil, err
+	}
+	value, err := t.payerCreds.Get()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Authorization")
+	return signer.SignV4(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken, region), nil
+}
+
+// signatureRegion returns the region in the credential scope of the signature v4 authorization header auth,
+// e.g. "AWS4-HMAC-SHA256 Credential=<access key>/<date>/<region>/s3/aws4_request, SignedHeaders=..., Signature=..."
+func signatureRegion(auth string) (string, error) {
+	if !strings.HasPrefix(auth, signV4Algorithm+" ") {
+		return "", errors.New("the requester-pays bucket requires the signature v4")
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(auth, signV4Algorithm+" "), ",") {
+		part = strings.TrimSpace(part)
+		if !strings.HasPrefix(part, "Credential=") {
+			continue
+		}
+		fields := strings.Split(strings.TrimPrefix(part, "Credential="), "/")
+		if len(fields) != 5 {
+			break
+		}
+		return fields[2], nil
+	}
+	return "", fmt.Errorf("invalid signature v4 authorization %q", auth)
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveS3Endpoint(t *testing.T) {
+	address, err := ResolveS3Endpoint("localhost:9000", "", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:9000", address)
+
+	cases := []struct {
+		dualStack, fips bool
+		expected        string
+	}{
+		{true, false, "s3.dualstack.us-west-2.amazonaws.com"},
+		{false, true, "s3-fips.us-west-2.amazonaws.com"},
+		{true, true, "s3-fips.dualstack.us-west-2.amazonaws.com"},
+	}
+	for _, c := range cases {
+		address, err := ResolveS3Endpoint("s3.us-west-2.amazonaws.com:443", "us-west-2", c.dualStack, c.fips)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, address)
+	}
+
+	_, err = ResolveS3Endpoint("localhost:9000", "us-west-2", true, false)
+	assert.Error(t, err)
+	_, err = ResolveS3Endpoint("s3.amazonaws.com", "", false, true)
+	assert.Error(t, err)
+}
+
+func TestValidateS3RequestOptions(t *testing.T) {
+	headers := map[string]string{"X-Request-Source": "milvus"}
+	assert.NoError(t, ValidateS3RequestOptions("local", "", "", "", false, false, false, false, nil))
+	assert.NoError(t, ValidateS3RequestOptions("minio", CloudProviderAWS, "s3.amazonaws.com", "us-east-1", true, true, true, true, headers))
+	assert.NoError(t, ValidateS3RequestOptions("remote", CloudProviderGCP, "storage.googleapis.com", "", false, false, false, false, headers))
+
+	assert.Error(t, ValidateS3RequestOptions("local", "", "", "", false, true, false, false, nil))
+	assert.Error(t, ValidateS3RequestOptions("remote", CloudProviderAzure, "", "", false, false, false, false, headers))
+	assert.Error(t, ValidateS3RequestOptions("minio", CloudProviderAliyun, "", "", false, true, false, false, nil))
+	assert.Error(t, ValidateS3RequestOptions("minio", CloudProviderAWS, "", "", false, true, false, false, nil))
+	assert.Error(t, ValidateS3RequestOptions("minio", CloudProviderAWS, "localhost:9000", "us-east-1", false, false, true, false, nil))
+	for _, key := range []string{"", "X Bad", "Authorization", "content-md5", "x-amz-request-payer"} {
+		assert.Error(t, ValidateS3RequestOptions("minio", CloudProviderAWS, "", "", false, false, false, false, map[string]string{key: "v"}))
+	}
+	assert.Error(t, ValidateS3RequestOptions("minio", CloudProviderAWS, "", "", false, false, false, false, map[string]string{"X-Key": "a\r\nb"}))
+
+	_, err := NewChunkManagerFactory("local", RequesterPays(true)).NewPersistentStorageChunkManager(context.Background())
+	assert.Error(t, err)
+}
+
+func TestHeaderTransport(t *testing.T) {
+	transport, err := newHeaderTransport(false, nil, false, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, transport)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Echo", r.Header.Get("X-Request-Source"))
+	}))
+	defer server.Close()
+
+	transport, err = newHeaderTransport(false, map[string]string{"X-Request-Source": "milvus"}, false, nil)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "milvus", resp.Header.Get("X-Echo"))
+	// the original request is untouched
+	assert.Empty(t, req.Header.Get("X-Request-Source"))
+}
+
+func TestHeaderTransportRequesterPays(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Echo-Payer", r.Header.Get(requestPayerHeader))
+		w.Header().Set("X-Echo-Auth", r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	creds := credentials.NewStaticV4("ak", "sk", "")
+	transport, err := newHeaderTransport(false, nil, true, creds)
+	require.NoError(t, err)
+
+	newRequest := func(contentSha256 string) *http.Request {
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/bucket/object", nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Amz-Content-Sha256", contentSha256)
+		return signer.SignV4(*req, "ak", "sk", "", "us-west-2")
+	}
+
+	// the write is signed again with the request payer in the same region
+	resp, err := transport.RoundTrip(newRequest("UNSIGNED-PAYLOAD"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, requestPayerRequester, resp.Header.Get("X-Echo-Payer"))
+	assert.Contains(t, resp.Header.Get("X-Echo-Auth"), "/us-west-2/s3/aws4_request")
+	assert.Contains(t, resp.Header.Get("X-Echo-Auth"), "x-amz-request-payer")
+
+	// the streaming signed request can't be signed again
+	_, err = transport.RoundTrip(newRequest("STREAMING-AWS4-HMAC-SHA256-PAYLOAD"))
+	assert.Error(t, err)
+
+	_, err = signatureRegion("AWS4-HMAC-SHA256 Credential=ak/20230101, Signature=x")
+	assert.Error(t, err)
+	_, err = signatureRegion("AWS ak:signature")
+	assert.Error(t, err)
+}
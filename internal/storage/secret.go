@@ -0,0 +1,260 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// The schemes of the secret references, e.g. "env://MINIO_SECRET_KEY", "file:///etc/milvus/secret_key"
+// or "vault://secret/data/milvus#secretAccessKey".
+const (
+	SecretSchemeEnv   = "env"
+	SecretSchemeFile  = "file"
+	SecretSchemeVault = "vault"
+
+	secretSchemeSeparator = "://"
+
+	// the address and token of vault are taken from the env, as the vault cli does
+	vaultAddrEnv      = "VAULT_ADDR"
+	vaultTokenEnv     = "VAULT_TOKEN"
+	vaultTokenFileEnv = "VAULT_TOKEN_FILE"
+	vaultTimeout      = 10 * time.Second
+
+	vaultCacheTTL           = 5 * time.Minute
+	vaultRenewRetryInterval = time.Minute
+)
+
+// SecretResolver returns the secret of the path in a reference of its scheme.
+type SecretResolver func(ctx context.Context, path string) (string, error)
+
+var (
+	secretResolversMu sync.RWMutex
+	secretResolvers   = map[string]SecretResolver{
+		SecretSchemeEnv:   resolveEnvSecret,
+		SecretSchemeFile:  resolveFileSecret,
+		SecretSchemeVault: resolveVaultSecret,
+	}
+)
+
+// RegisterSecretResolver registers the resolver of the references of scheme, e.g. to resolve the secrets in a KMS.
+func RegisterSecretResolver(scheme string, resolver SecretResolver) {
+	secretResolversMu.Lock()
+	defer secretResolversMu.Unlock()
+	secretResolvers[scheme] = resolver
+}
+
+func getSecretResolver(value string) (string, SecretResolver, bool) {
+	scheme, path, ok := strings.Cut(value, secretSchemeSeparator)
+	if !ok {
+		return "", nil, false
+	}
+	secretResolversMu.RLock()
+	defer secretResolversMu.RUnlock()
+	resolver, ok := secretResolvers[scheme]
+	return path, resolver, ok
+}
+
+// IsSecretRef returns whether value is a reference to a secret rather than the secret itself.
+func IsSecretRef(value string) bool {
+	_, _, ok := getSecretResolver(value)
+	return ok
+}
+
+// ResolveSecret returns the secret referenced by value, or value itself if it's not a reference,
+// so that the secrets are only resolved where they're used, and never flow through the requests, logs or etcd.
+func ResolveSecret(ctx context.Context, value string) (string, error) {
+	path, resolver, ok := getSecretResolver(value)
+	if !ok {
+		return value, nil
+	}
+	secret, err := resolver(ctx, path)
+	if err != nil {
+		// the error carries the reference only, never the secret
+		return "", errors.Wrapf(err, "failed to resolve secret %s", value)
+	}
+	return secret, nil
+}
+
+func resolveEnvSecret(_ context.Context, name string) (string, error) {
+	secret, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("env %s not set", name)
+	}
+	return secret, nil
+}
+
+// resolveFileSecret reads the secret from a file, e.g. mounted from a kubernetes secret,
+// the trailing newline is trimmed.
+func resolveFileSecret(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+type vaultSecret struct {
+	secret   string
+	expireAt time.Time
+}
+
+var (
+	vaultSecretsMu sync.Mutex
+	vaultSecrets   = make(map[string]vaultSecret)
+	vaultRenewOnce sync.Once
+)
+
+// resolveVaultSecret reads the field of the secret from vault by the reference "<path>#<field>",
+// both the kv v1 and v2 engines are supported.
+// The secrets are cached for their lease duration, or vaultCacheTTL if they have none as the kv secrets,
+// and the token is renewed in the background once vault is used.
+func resolveVaultSecret(ctx context.Context, ref string) (string, error) {
+	path, field, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || field == "" {
+		return "", fmt.Errorf("vault secret should be referenced by <path>#<field>")
+	}
+	addr := os.Getenv(vaultAddrEnv)
+	if addr == "" {
+		return "", fmt.Errorf("env %s not set", vaultAddrEnv)
+	}
+	cacheKey := addr + "/" + ref
+	vaultSecretsMu.Lock()
+	cached, ok := vaultSecrets[cacheKey]
+	vaultSecretsMu.Unlock()
+	if ok && time.Now().Before(cached.expireAt) {
+		return cached.secret, nil
+	}
+
+	var body struct {
+		LeaseDuration int64                      `json:"lease_duration"`
+		Data          map[string]json.RawMessage `json:"data"`
+	}
+	if err := vaultRequest(ctx, addr, http.MethodGet, path, &body); err != nil {
+		return "", err
+	}
+	data := body.Data
+	// the kv v2 engine nests the secret in data.data
+	if nested, ok := data["data"]; ok {
+		var v2 map[string]json.RawMessage
+		if err := json.Unmarshal(nested, &v2); err == nil {
+			data = v2
+		}
+	}
+	raw, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("field %s not found", field)
+	}
+	var secret string
+	if err := json.Unmarshal(raw, &secret); err != nil {
+		return "", fmt.Errorf("field %s is not a string", field)
+	}
+
+	ttl := vaultCacheTTL
+	if body.LeaseDuration > 0 {
+		ttl = time.Duration(body.LeaseDuration) * time.Second
+	}
+	vaultSecretsMu.Lock()
+	vaultSecrets[cacheKey] = vaultSecret{secret: secret, expireAt: time.Now().Add(ttl)}
+	vaultSecretsMu.Unlock()
+	vaultRenewOnce.Do(func() {
+		go renewVaultToken(addr)
+	})
+	return secret, nil
+}
+
+// vaultToken returns the token of vault, the token file is read every time as it may be rotated by the vault agent.
+func vaultToken() (string, error) {
+	token := os.Getenv(vaultTokenEnv)
+	if tokenFile := os.Getenv(vaultTokenFileEnv); token == "" && tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", err
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	return token, nil
+}
+
+func vaultRequest(ctx context.Context, addr, method, path string, body interface{}) error {
+	token, err := vaultToken()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, vaultTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(addr, "/")+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault responded %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(body)
+}
+
+// renewVaultToken renews the token at half of its lease duration, it stops if the token never expires, e.g. a root token.
+func renewVaultToken(addr string) {
+	for {
+		var body struct {
+			Auth struct {
+				LeaseDuration int64 `json:"lease_duration"`
+				Renewable     bool  `json:"renewable"`
+			} `json:"auth"`
+		}
+		interval := vaultRenewRetryInterval
+		if err := vaultRequest(context.Background(), addr, http.MethodPost, "auth/token/renew-self", &body); err != nil {
+			log.Warn("failed to renew vault token", zap.Error(err))
+		} else if body.Auth.LeaseDuration <= 0 || !body.Auth.Renewable {
+			return
+		} else {
+			interval = time.Duration(body.Auth.LeaseDuration) * time.Second / 2
+		}
+		time.Sleep(interval)
+	}
+}
+
+// resolveSecrets returns a copy of c with the credentials resolved.
+func (c *config) resolveSecrets(ctx context.Context) (*config, error) {
+	resolved := *c
+	var err error
+	if resolved.accessKeyID, err = ResolveSecret(ctx, c.accessKeyID); err != nil {
+		return nil, err
+	}
+	if resolved.secretAccessKeyID, err = ResolveSecret(ctx, c.secretAccessKeyID); err != nil {
+		return nil, err
+	}
+	return &resolved, nil
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestResolveSecret(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("raw", func(t *testing.T) {
+		assert.False(t, IsSecretRef("minioadmin"))
+		assert.False(t, IsSecretRef("unknown://secret"))
+		secret, err := ResolveSecret(ctx, "minioadmin")
+		assert.NoError(t, err)
+		assert.Equal(t, "minioadmin", secret)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("TEST_STORAGE_SECRET", "env_secret")
+		assert.True(t, IsSecretRef("env://TEST_STORAGE_SECRET"))
+		secret, err := ResolveSecret(ctx, "env://TEST_STORAGE_SECRET")
+		assert.NoError(t, err)
+		assert.Equal(t, "env_secret", secret)
+
+		_, err = ResolveSecret(ctx, "env://TEST_STORAGE_SECRET_NOT_SET")
+		assert.Error(t, err)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		file := path.Join(t.TempDir(), "secret")
+		require.NoError(t, os.WriteFile(file, []byte("file_secret\n"), 0o600))
+		secret, err := ResolveSecret(ctx, "file://"+file)
+		assert.NoError(t, err)
+		assert.Equal(t, "file_secret", secret)
+
+		_, err = ResolveSecret(ctx, "file://"+file+"_not_exist")
+		assert.Error(t, err)
+	})
+
+	t.Run("vault", func(t *testing.T) {
+		reads := atomic.NewInt32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.Header.Get("X-Vault-Token") != "token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			switch req.URL.Path {
+			case "/v1/auth/token/renew-self":
+				w.Write([]byte(`{"auth":{"lease_duration":0,"renewable":false}}`))
+			case "/v1/secret/data/milvus":
+				reads.Inc()
+				w.Write([]byte(`{"data":{"data":{"secretAccessKey":"vault_secret"},"metadata":{"version":1}}}`))
+			case "/v1/kv/milvus":
+				w.Write([]byte(`{"data":{"secretAccessKey":"vault_v1_secret"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		_, err := ResolveSecret(ctx, "vault://secret/data/milvus#secretAccessKey")
+		assert.Error(t, err)
+
+		t.Setenv(vaultAddrEnv, server.URL)
+		t.Setenv(vaultTokenEnv, "token")
+		secret, err := ResolveSecret(ctx, "vault://secret/data/milvus#secretAccessKey")
+		assert.NoError(t, err)
+		assert.Equal(t, "vault_secret", secret)
+		// cached
+		secret, err = ResolveSecret(ctx, "vault://secret/data/milvus#secretAccessKey")
+		assert.NoError(t, err)
+		assert.Equal(t, "vault_secret", secret)
+		assert.Equal(t, int32(1), reads.Load())
+		secret, err = ResolveSecret(ctx, "vault://kv/milvus#secretAccessKey")
+		assert.NoError(t, err)
+		assert.Equal(t, "vault_v1_secret", secret)
+
+		_, err = ResolveSecret(ctx, "vault://secret/data/milvus#notExist")
+		assert.Error(t, err)
+		_, err = ResolveSecret(ctx, "vault://secret/data/notExist#secretAccessKey")
+		assert.Error(t, err)
+		_, err = ResolveSecret(ctx, "vault://secret/data/milvus")
+		assert.Error(t, err)
+
+		vaultSecretsMu.Lock()
+		vaultSecrets = make(map[string]vaultSecret)
+		vaultSecretsMu.Unlock()
+		t.Setenv(vaultTokenEnv, "")
+		tokenFile := path.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("token\n"), 0o600))
+		t.Setenv(vaultTokenFileEnv, tokenFile)
+		secret, err = ResolveSecret(ctx, "vault://secret/data/milvus#secretAccessKey")
+		assert.NoError(t, err)
+		assert.Equal(t, "vault_secret", secret)
+		assert.Equal(t, int32(3), reads.Load())
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		RegisterSecretResolver("test", func(_ context.Context, path string) (string, error) {
+			return "kms_" + path, nil
+		})
+		secret, err := ResolveSecret(ctx, "test://key")
+		assert.NoError(t, err)
+		assert.Equal(t, "kms_key", secret)
+	})
+
+	t.Run("chunk manager", func(t *testing.T) {
+		t.Setenv("TEST_STORAGE_ACCESS_KEY", "access_key")
+		c := &config{accessKeyID: "env://TEST_STORAGE_ACCESS_KEY", secretAccessKeyID: "secret"}
+		resolved, err := c.resolveSecrets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "access_key", resolved.accessKeyID)
+		assert.Equal(t, "secret", resolved.secretAccessKeyID)
+		// the config keeps the references
+		assert.Equal(t, "env://TEST_STORAGE_ACCESS_KEY", c.accessKeyID)
+
+		_, err = NewChunkManagerFactory("minio", SecretAccessKeyID("env://TEST_STORAGE_SECRET_NOT_SET")).NewPersistentStorageChunkManager(ctx)
+		assert.ErrorContains(t, err, "env://TEST_STORAGE_SECRET_NOT_SET")
+	})
+}
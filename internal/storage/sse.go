@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// Server-side encryption types of the uploaded objects
+const (
+	SSETypeS3  = "SSE-S3"
+	SSETypeKMS = "SSE-KMS"
+	SSETypeC   = "SSE-C"
+)
+
+// ValidateServerSideEncryption checks whether the server-side encryption is supported by the chunk manager of
+// storageType and cloudProvider, and whether the key is valid for sseType
+func ValidateServerSideEncryption(storageType, cloudProvider, sseType, sseKey string) error {
+	if sseType == "" {
+		return nil
+	}
+	if storageType != "minio" && storageType != "remote" {
+		return fmt.Errorf("server-side encryption is not supported by storage type %s", storageType)
+	}
+	if cloudProvider == CloudProviderAzure || cloudProvider == CloudProviderGCPNative {
+		return fmt.Errorf("server-side encryption is not supported by cloud provider %s", cloudProvider)
+	}
+	_, err := newServerSideEncryption(sseType, sseKey)
+	return err
+}
+
+// newServerSideEncryption returns the server-side encryption to upload objects, nil if sseType is empty
+func newServerSideEncryption(sseType, sseKey string) (encrypt.ServerSide, error) {
+	switch sseType {
+	case "":
+		return nil, nil
+	case SSETypeS3:
+		if sseKey != "" {
+			return nil, fmt.Errorf("%s is encrypted by the keys managed by the storage, no key should be provided", SSETypeS3)
+		}
+		return encrypt.NewSSE(), nil
+	case SSETypeKMS:
+		// the aws managed key is used if the key id is empty
+		return encrypt.NewSSEKMS(sseKey, nil)
+	case SSETypeC:
+		key, err := base64.StdEncoding.DecodeString(sseKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "the customer key of %s should be base64 encoded", SSETypeC)
+		}
+		return encrypt.NewSSEC(key)
+	default:
+		return nil, fmt.Errorf("unknown server-side encryption type %s, should be one of %s, %s, %s", sseType, SSETypeS3, SSETypeKMS, SSETypeC)
+	}
+}
+
+// readEncryption returns the server-side encryption required to read the objects, only SSE-C requires the key
+func readEncryption(sse encrypt.ServerSide) encrypt.ServerSide {
+	if sse != nil && sse.Type() == encrypt.SSEC {
+		return sse
+	}
+	return nil
+}
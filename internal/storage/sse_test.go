@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerSideEncryption(t *testing.T) {
+	customerKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+	sse, err := newServerSideEncryption("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, sse)
+	assert.Nil(t, readEncryption(sse))
+
+	sse, err = newServerSideEncryption(SSETypeS3, "")
+	assert.NoError(t, err)
+	assert.Equal(t, encrypt.S3, sse.Type())
+	assert.Nil(t, readEncryption(sse))
+	_, err = newServerSideEncryption(SSETypeS3, "key")
+	assert.Error(t, err)
+
+	sse, err = newServerSideEncryption(SSETypeKMS, "arn:aws:kms:us-east-1:123456789012:key/milvus")
+	assert.NoError(t, err)
+	assert.Equal(t, encrypt.KMS, sse.Type())
+	assert.Nil(t, readEncryption(sse))
+
+	sse, err = newServerSideEncryption(SSETypeC, customerKey)
+	assert.NoError(t, err)
+	assert.Equal(t, encrypt.SSEC, sse.Type())
+	assert.Equal(t, sse, readEncryption(sse))
+	_, err = newServerSideEncryption(SSETypeC, "not base64")
+	assert.Error(t, err)
+	_, err = newServerSideEncryption(SSETypeC, base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+
+	_, err = newServerSideEncryption("SSE-unknown", "")
+	assert.Error(t, err)
+}
+
+func TestValidateServerSideEncryption(t *testing.T) {
+	assert.NoError(t, ValidateServerSideEncryption("local", "", "", ""))
+	assert.NoError(t, ValidateServerSideEncryption("minio", CloudProviderAWS, SSETypeKMS, "key"))
+	assert.NoError(t, ValidateServerSideEncryption("remote", CloudProviderAWS, SSETypeS3, ""))
+	assert.Error(t, ValidateServerSideEncryption("local", "", SSETypeS3, ""))
+	assert.Error(t, ValidateServerSideEncryption("hdfs", "", SSETypeS3, ""))
+	assert.Error(t, ValidateServerSideEncryption("remote", CloudProviderAzure, SSETypeS3, ""))
+	assert.Error(t, ValidateServerSideEncryption("remote", CloudProviderGCPNative, SSETypeS3, ""))
+	assert.Error(t, ValidateServerSideEncryption("minio", CloudProviderAWS, SSETypeC, "invalid"))
+
+	_, err := NewChunkManagerFactory("local", ServerSideEncryption(SSETypeS3, "")).NewPersistentStorageChunkManager(context.Background())
+	assert.Error(t, err)
+}
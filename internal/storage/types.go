@@ -54,6 +54,10 @@ type ChunkManager interface {
 	MultiWrite(ctx context.Context, contents map[string][]byte) error
 	// Exist returns true if @filePath exists.
 	Exist(ctx context.Context, filePath string) (bool, error)
+	// MultiExist returns whether each of @filePaths exists.
+	MultiExist(ctx context.Context, filePaths []string) ([]bool, error)
+	// MultiStat returns the size of each of @filePaths, it fails if any of them doesn't exist.
+	MultiStat(ctx context.Context, filePaths []string) ([]int64, error)
 	// Read reads @filePath and returns content.
 	Read(ctx context.Context, filePath string) ([]byte, error)
 	// Reader return a reader for @filePath
@@ -74,4 +78,18 @@ type ChunkManager interface {
 	MultiRemove(ctx context.Context, filePaths []string) error
 	// RemoveWithPrefix remove files with same @prefix.
 	RemoveWithPrefix(ctx context.Context, prefix string) error
+	// PresignedURL returns an url to download @filePath without credentials, which expires after @expiry.
+	PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error)
+	// Archive moves @filePaths into the archival storage class, they must be restored before reading.
+	Archive(ctx context.Context, filePaths []string) error
+	// Restore moves the archived @filePaths back to the standard storage class asynchronously.
+	Restore(ctx context.Context, filePaths []string) error
+	// ArchiveStates returns the archive state of each of @filePaths.
+	ArchiveStates(ctx context.Context, filePaths []string) ([]ArchiveState, error)
+}
+
+// Flusher is the ChunkManager writing the objects back asynchronously, e.g. by the disk cache.
+type Flusher interface {
+	// Flush returns once the objects written so far are uploaded to the storage.
+	Flush(ctx context.Context) error
 }
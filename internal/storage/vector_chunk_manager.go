@@ -163,6 +163,14 @@ func (vcm *VectorChunkManager) Exist(ctx context.Context, filePath string) (bool
 	return vcm.vectorStorage.Exist(ctx, filePath)
 }
 
+func (vcm *VectorChunkManager) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	return vcm.vectorStorage.MultiExist(ctx, filePaths)
+}
+
+func (vcm *VectorChunkManager) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	return vcm.vectorStorage.MultiStat(ctx, filePaths)
+}
+
 func (vcm *VectorChunkManager) readFile(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
 	contents, err := vcm.vectorStorage.Read(ctx, filePath)
 	if err != nil {
@@ -249,6 +257,22 @@ func (vcm *VectorChunkManager) Mmap(ctx context.Context, filePath string) (*mmap
 	return nil, errors.New("the file mmap has not been cached")
 }
 
+func (vcm *VectorChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	return vcm.vectorStorage.PresignedURL(ctx, filePath, expiry)
+}
+
+func (vcm *VectorChunkManager) Archive(ctx context.Context, filePaths []string) error {
+	return vcm.vectorStorage.Archive(ctx, filePaths)
+}
+
+func (vcm *VectorChunkManager) Restore(ctx context.Context, filePaths []string) error {
+	return vcm.vectorStorage.Restore(ctx, filePaths)
+}
+
+func (vcm *VectorChunkManager) ArchiveStates(ctx context.Context, filePaths []string) ([]ArchiveState, error) {
+	return vcm.vectorStorage.ArchiveStates(ctx, filePaths)
+}
+
 func (vcm *VectorChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
 	return nil, errors.New("this method has not been implemented")
 }
@@ -382,6 +382,15 @@ type DataCoord interface {
 	// Deprecated: use DescribeIndex instead
 	GetIndexBuildProgress(ctx context.Context, req *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
 
+	// ArchiveIndex moves the index files of a released collection to the archive storage class.
+	ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest) (*commonpb.Status, error)
+
+	// RestoreIndex starts restoring the archived index files of a collection.
+	RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest) (*commonpb.Status, error)
+
+	// GetIndexArchiveState gets the archive state of the index files of a collection.
+	GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest) (*indexpb.GetIndexArchiveStateResponse, error)
+
 	// DropIndex deletes indexes based on IndexID. One IndexID corresponds to the index of an entire column. A column is
 	// divided into many segments, and each segment corresponds to an IndexBuildID. IndexCoord uses IndexBuildID to record
 	// index tasks. Therefore, when DropIndex is called, delete all tasks corresponding to IndexBuildID corresponding to IndexID.
@@ -434,6 +443,11 @@ type IndexNode interface {
 	DropJobs(context.Context, *indexpb.DropJobsRequest) (*commonpb.Status, error)
 	// GetJobStats returns metrics of indexnode, including available job queue info, available task slots and finished job infos.
 	GetJobStats(context.Context, *indexpb.GetJobStatsRequest) (*indexpb.GetJobStatsResponse, error)
+	// GetCapabilities returns the capabilities of indexnode, including the index types it's able to build,
+	// disk capacity, GPU presence and SIMD level.
+	GetCapabilities(context.Context, *indexpb.GetCapabilitiesRequest) (*indexpb.GetCapabilitiesResponse, error)
+	// UpdateLogConfig changes the log level and the rate groups of the rated logs at runtime.
+	UpdateLogConfig(context.Context, *indexpb.UpdateLogConfigRequest) (*indexpb.UpdateLogConfigResponse, error)
 
 	ShowConfigurations(ctx context.Context, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)
 	// GetMetrics gets the metrics about IndexNode.
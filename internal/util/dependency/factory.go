@@ -2,6 +2,7 @@ package dependency
 
 import (
 	"context"
+	"path"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -20,6 +21,9 @@ const (
 	mqTypePebblemq = "pebblemq"
 	mqTypeKafka    = "kafka"
 	mqTypePulsar   = "pulsar"
+	mqTypeKinesis  = "kinesis"
+	mqTypePubsub   = "pubsub"
+	mqTypeRabbitmq = "rabbitmq"
 )
 
 type mqEnable struct {
@@ -86,24 +90,42 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 	mqType := mustSelectMQType(standalone, params.MQCfg.Type.GetValue(), mqEnable{params.RocksmqEnable(), params.PebblemqEnable(), params.NatsmqEnable(), params.PulsarEnable(), params.KafkaEnable()})
 	log.Info("try to init mq", zap.Bool("standalone", standalone), zap.String("mqType", mqType))
 
-	switch mqType {
-	case mqTypeNatsmq:
-		f.msgStreamFactory = msgstream.NewNatsmqFactory()
-	case mqTypeRocksmq:
-		f.msgStreamFactory = smsgstream.NewRocksmqFactory(params.RocksmqCfg.Path.GetValue(), &params.ServiceParam)
-	case mqTypePebblemq:
-		f.msgStreamFactory = smsgstream.NewPebblemqFactory(params.PebblemqCfg.Path.GetValue(), &params.ServiceParam)
-	case mqTypePulsar:
-		f.msgStreamFactory = msgstream.NewPmsFactory(&params.ServiceParam)
-	case mqTypeKafka:
-		f.msgStreamFactory = msgstream.NewKmsFactory(&params.ServiceParam)
-	}
-	if f.msgStreamFactory == nil {
-		return errors.New("failed to create MQ: check the milvus log for initialization failures")
+	factory, err := newMQFactory(mqType, params)
+	if err != nil {
+		return err
 	}
+
+	mirrorType := params.MQCfg.MirrorType.GetValue()
+	if mirrorType != "" && mirrorType != mqType {
+		if err := validateMQType(standalone, mirrorType); err != nil {
+			return err
+		}
+		log.Info("mirror mq messages", zap.String("mqType", mqType), zap.String("mirrorType", mirrorType))
+		mirror, err := newMQFactory(mirrorType, params)
+		if err != nil {
+			return err
+		}
+		factory = msgstream.NewMirrorFactory(factory, mirror)
+	}
+	f.msgStreamFactory = factory
 	return nil
 }
 
+func newMQFactory(mqType string, params *paramtable.ComponentParam) (msgstream.Factory, error) {
+	backend, ok := msgstream.GetMQBackend(mqType)
+	if !ok {
+		return nil, errors.Newf("mq type %s is not registered", mqType)
+	}
+	factory, err := backend.NewFactory(params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create MQ %s", mqType)
+	}
+	if factory == nil {
+		return nil, errors.New("failed to create MQ: check the milvus log for initialization failures")
+	}
+	return factory, nil
+}
+
 // Select valid mq if mq type is default.
 func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 	if mqType != mqTypeDefault {
@@ -131,23 +153,49 @@ func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 	panic(errors.Errorf("no available mq config found, %s, enable: %+v", mqType, enable))
 }
 
-// Validate mq type.
+// Validate mq type by the registered mq backends.
 func validateMQType(standalone bool, mqType string) error {
-	if mqType != mqTypeNatsmq && mqType != mqTypeRocksmq && mqType != mqTypePebblemq && mqType != mqTypeKafka && mqType != mqTypePulsar {
-		return errors.Newf("mq type %s is invalid", mqType)
+	backend, ok := msgstream.GetMQBackend(mqType)
+	if !ok {
+		return errors.Newf("mq type %s is invalid, registered: %v", mqType, msgstream.RegisteredMQBackends())
 	}
-	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypePebblemq || mqType == mqTypeNatsmq) {
-		return errors.Newf("mq %s is only valid in standalone mode")
+	if !standalone && backend.StandaloneOnly {
+		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
 }
 
 func (f *DefaultFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
-	return f.msgStreamFactory.NewMsgStream(ctx)
+	ms, err := f.msgStreamFactory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return f.withObjectDeadLetterSink(ctx, ms)
 }
 
 func (f *DefaultFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
-	return f.msgStreamFactory.NewTtMsgStream(ctx)
+	ms, err := f.msgStreamFactory.NewTtMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return f.withObjectDeadLetterSink(ctx, ms)
+}
+
+// withObjectDeadLetterSink sets a dead letter sink writing into the persistent storage for ms,
+// if dead letters are configured to go to object storage.
+func (f *DefaultFactory) withObjectDeadLetterSink(ctx context.Context, ms msgstream.MsgStream) (msgstream.MsgStream, error) {
+	params := paramtable.Get()
+	if params.MQCfg.DeadLetterSink.GetValue() != msgstream.DeadLetterSinkObjectStorage || f.chunkManagerFactory == nil {
+		return ms, nil
+	}
+	cm, err := f.chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		ms.Close()
+		return nil, err
+	}
+	rootPath := path.Join(cm.RootPath(), params.MQCfg.DeadLetterRootPath.GetValue())
+	msgstream.SetDeadLetterSink(ms, msgstream.NewObjectDeadLetterSink(cm, rootPath))
+	return ms, nil
 }
 
 func (f *DefaultFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
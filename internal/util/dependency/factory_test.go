@@ -12,6 +12,13 @@ func TestValidateMQType(t *testing.T) {
 	assert.Error(t, validateMQType(false, mqTypeNatsmq))
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
 	assert.Error(t, validateMQType(false, mqTypePebblemq))
+	assert.NoError(t, validateMQType(true, mqTypeKinesis))
+	assert.NoError(t, validateMQType(false, mqTypeKinesis))
+	assert.NoError(t, validateMQType(false, mqTypePubsub))
+	assert.NoError(t, validateMQType(false, mqTypeRabbitmq))
+	assert.NoError(t, validateMQType(true, mqTypeRocksmq))
+	assert.NoError(t, validateMQType(true, mqTypePebblemq))
+	assert.Error(t, validateMQType(true, "unknown"))
 }
 
 func TestSelectMQType(t *testing.T) {
@@ -37,4 +44,7 @@ func TestSelectMQType(t *testing.T) {
 	assert.Panics(t, func() { mustSelectMQType(false, mqTypeNatsmq, mqEnable{true, true, true, true, true}) })
 	assert.Equal(t, mustSelectMQType(false, mqTypePulsar, mqEnable{true, true, true, true, true}), mqTypePulsar)
 	assert.Equal(t, mustSelectMQType(false, mqTypeKafka, mqEnable{true, true, true, true, true}), mqTypeKafka)
+	assert.Equal(t, mustSelectMQType(false, mqTypeKinesis, mqEnable{true, true, true, true, true}), mqTypeKinesis)
+	assert.Equal(t, mustSelectMQType(false, mqTypePubsub, mqEnable{true, true, true, true, true}), mqTypePubsub)
+	assert.Equal(t, mustSelectMQType(false, mqTypeRabbitmq, mqEnable{true, true, true, true, true}), mqTypeRabbitmq)
 }
@@ -413,6 +413,13 @@ func (c *ClientBase[T]) call(ctx context.Context, caller func(client T) (any, er
 		var err error
 		ret, err = caller(client)
 		if err != nil {
+			// the errors returned by the server interceptors carry the merr codes
+			if merrErr, ok := merr.FromGRPCError(err); ok {
+				if !merr.IsRetryableErr(merrErr) {
+					return retry.Unrecoverable(merrErr)
+				}
+				return merrErr
+			}
 			needRetry, needReset := c.checkErr(ctx, err)
 			if !needRetry {
 				// stop retry
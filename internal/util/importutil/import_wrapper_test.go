@@ -82,6 +82,14 @@ func (mc *MockChunkManager) Exist(ctx context.Context, filePath string) (bool, e
 	return true, nil
 }
 
+func (mc *MockChunkManager) MultiExist(ctx context.Context, filePaths []string) ([]bool, error) {
+	return nil, nil
+}
+
+func (mc *MockChunkManager) MultiStat(ctx context.Context, filePaths []string) ([]int64, error) {
+	return nil, nil
+}
+
 func (mc *MockChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
 	if mc.readErr != nil {
 		return nil, mc.readErr
@@ -144,6 +152,22 @@ func (mc *MockChunkManager) RemoveWithPrefix(ctx context.Context, prefix string)
 	return nil
 }
 
+func (mc *MockChunkManager) PresignedURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	return "", nil
+}
+
+func (mc *MockChunkManager) Archive(ctx context.Context, filePaths []string) error {
+	return nil
+}
+
+func (mc *MockChunkManager) Restore(ctx context.Context, filePaths []string) error {
+	return nil
+}
+
+func (mc *MockChunkManager) ArchiveStates(ctx context.Context, filePaths []string) ([]storage.ArchiveState, error) {
+	return make([]storage.ArchiveState, len(filePaths)), nil
+}
+
 type rowCounterTest struct {
 	rowCount int
 	callTime int
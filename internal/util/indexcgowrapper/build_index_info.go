@@ -24,6 +24,7 @@ package indexcgowrapper
 import "C"
 
 import (
+	"encoding/json"
 	"fmt"
 	"unsafe"
 
@@ -33,6 +34,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/indexcgopb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 )
 
 type BuildIndexInfo struct {
@@ -42,7 +45,16 @@ type BuildIndexInfo struct {
 func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 	var cBuildIndexInfo C.CBuildIndexInfo
 
-	cAddress := C.CString(config.Address)
+	address, err := storage.ResolveS3Endpoint(config.GetAddress(), config.GetRegion(), config.GetUseDualStackEndpoint(), config.GetUseFipsEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	customHeaders, err := json.Marshal(funcutil.KeyValuePair2Map(config.GetCustomHeaders()))
+	if err != nil {
+		return nil, err
+	}
+
+	cAddress := C.CString(address)
 	cBucketName := C.CString(config.BucketName)
 	cAccessKey := C.CString(config.AccessKeyID)
 	cAccessValue := C.CString(config.SecretAccessKey)
@@ -51,6 +63,7 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 	cCloudProvider := C.CString(config.CloudProvider)
 	cIamEndPoint := C.CString(config.IAMEndpoint)
 	cRegion := C.CString(config.Region)
+	cCustomHeaders := C.CString(string(customHeaders))
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -60,6 +73,7 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 	defer C.free(unsafe.Pointer(cCloudProvider))
 	defer C.free(unsafe.Pointer(cIamEndPoint))
 	defer C.free(unsafe.Pointer(cRegion))
+	defer C.free(unsafe.Pointer(cCustomHeaders))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -73,6 +87,8 @@ func NewBuildIndexInfo(config *indexpb.StorageConfig) (*BuildIndexInfo, error) {
 		useIAM:           C.bool(config.UseIAM),
 		region:           cRegion,
 		useVirtualHost:   C.bool(config.UseVirtualHost),
+		requester_pays:   C.bool(config.GetRequesterPays()),
+		custom_headers:   cCustomHeaders,
 	}
 
 	status := C.NewBuildIndexInfo(&cBuildIndexInfo, storageConfig)
@@ -150,3 +166,23 @@ func (bi *BuildIndexInfo) AppendInsertFile(filePath string) error {
 	status := C.AppendInsertFilePath(bi.cBuildIndexInfo, cInsertFilePath)
 	return HandleCStatus(&status, "appendInsertFile failed")
 }
+
+// AppendObjectTags attaches the tags to the index files uploaded by the build
+func (bi *BuildIndexInfo) AppendObjectTags(tags map[string]string) error {
+	for key, value := range tags {
+		cKey := C.CString(key)
+		cValue := C.CString(value)
+		status := C.AppendObjectTag(bi.cBuildIndexInfo, cKey, cValue)
+		C.free(unsafe.Pointer(cKey))
+		C.free(unsafe.Pointer(cValue))
+		if err := HandleCStatus(&status, "appendObjectTag failed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetContentAddressed uploads the memory index files of the build to the paths named by their sha256
+func (bi *BuildIndexInfo) SetContentAddressed(contentAddressed bool) {
+	C.SetContentAddressed(bi.cBuildIndexInfo, C.bool(contentAddressed))
+}
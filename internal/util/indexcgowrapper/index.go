@@ -13,9 +13,11 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -92,7 +94,29 @@ func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]str
 	return index, nil
 }
 
+// CreateIndex builds the index described by buildIndexInfo, the build is aborted at the next cancellation
+// check of the engine once ctx is done. The checks are between the batches of the raw data loaded and after
+// the knowhere build, the knowhere/DiskANN build itself takes no cancel flag and runs to the end once started,
+// so a build cancelled in it only skips the upload and frees the index once it returns.
 func CreateIndex(ctx context.Context, buildIndexInfo *BuildIndexInfo) (CodecIndex, error) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		select {
+		case <-ctx.Done():
+			log.Ctx(ctx).Info("cancel the index build", zap.Error(ctx.Err()))
+			C.CancelBuildIndex(buildIndexInfo.cBuildIndexInfo)
+		case <-done:
+		}
+	}()
+	// the build info is freed by the caller once returned, wait the canceller to exit
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
 	var indexPtr C.CIndex
 	status := C.CreateIndexV2(&indexPtr, buildIndexInfo.cBuildIndexInfo)
 	if err := HandleCStatus(&status, "failed to create index"); err != nil {
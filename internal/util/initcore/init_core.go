@@ -28,12 +28,14 @@ package initcore
 import "C"
 
 import (
+	"context"
 	"fmt"
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -55,17 +57,40 @@ func InitTraceConfig(params *paramtable.ComponentParam) {
 	C.InitTrace(&config)
 }
 
+// InitStorageRateLimits applies the request rate and the bandwidth limits of the object storage to the chunk managers
+// of segcore, the limits are per node like the ones of the chunk managers of go, but they're not shared with them
+func InitStorageRateLimits(params *paramtable.ComponentParam) {
+	C.SetStorageRateLimits(C.double(params.MinioCfg.RequestRateLimit.GetAsFloat()), C.int64_t(params.MinioCfg.BandwidthLimit.GetAsInt64()<<20))
+}
+
 func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
-	cAddress := C.CString(params.MinioCfg.Address.GetValue())
+	address, err := storage.ResolveS3Endpoint(params.MinioCfg.Address.GetValue(), params.MinioCfg.Region.GetValue(),
+		params.MinioCfg.UseDualStackEndpoint.GetAsBool(), params.MinioCfg.UseFIPSEndpoint.GetAsBool())
+	if err != nil {
+		return err
+	}
+
+	// segcore takes the credentials as they are, the secret references are resolved here
+	accessKeyID, err := storage.ResolveSecret(context.Background(), params.MinioCfg.AccessKeyID.GetValue())
+	if err != nil {
+		return err
+	}
+	secretAccessKey, err := storage.ResolveSecret(context.Background(), params.MinioCfg.SecretAccessKey.GetValue())
+	if err != nil {
+		return err
+	}
+
+	cAddress := C.CString(address)
 	cBucketName := C.CString(params.MinioCfg.BucketName.GetValue())
-	cAccessKey := C.CString(params.MinioCfg.AccessKeyID.GetValue())
-	cAccessValue := C.CString(params.MinioCfg.SecretAccessKey.GetValue())
+	cAccessKey := C.CString(accessKeyID)
+	cAccessValue := C.CString(secretAccessKey)
 	cRootPath := C.CString(params.MinioCfg.RootPath.GetValue())
 	cStorageType := C.CString(params.CommonCfg.StorageType.GetValue())
 	cCloudProvider := C.CString(params.MinioCfg.CloudProvider.GetValue())
 	cIamEndPoint := C.CString(params.MinioCfg.IAMEndpoint.GetValue())
 	cLogLevel := C.CString(params.MinioCfg.LogLevel.GetValue())
 	cRegion := C.CString(params.MinioCfg.Region.GetValue())
+	cCustomHeaders := C.CString(params.MinioCfg.CustomHeaders.GetValue())
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -76,6 +101,7 @@ func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
 	defer C.free(unsafe.Pointer(cIamEndPoint))
 	defer C.free(unsafe.Pointer(cLogLevel))
 	defer C.free(unsafe.Pointer(cRegion))
+	defer C.free(unsafe.Pointer(cCustomHeaders))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -90,6 +116,8 @@ func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
 		log_level:        cLogLevel,
 		region:           cRegion,
 		useVirtualHost:   C.bool(params.MinioCfg.UseVirtualHost.GetAsBool()),
+		requester_pays:   C.bool(params.MinioCfg.RequesterPays.GetAsBool()),
+		custom_headers:   cCustomHeaders,
 	}
 
 	status := C.InitRemoteChunkManagerSingleton(storageConfig)
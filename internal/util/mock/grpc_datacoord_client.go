@@ -216,6 +216,18 @@ func (m *GrpcDataCoordClient) GetIndexBuildProgress(ctx context.Context, req *in
 	return &indexpb.GetIndexBuildProgressResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) ArchiveIndex(ctx context.Context, req *indexpb.ArchiveIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) RestoreIndex(ctx context.Context, req *indexpb.RestoreIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetIndexArchiveState(ctx context.Context, req *indexpb.GetIndexArchiveStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexArchiveStateResponse, error) {
+	return &indexpb.GetIndexArchiveStateResponse{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -61,6 +61,14 @@ func (m *GrpcIndexNodeClient) GetJobStats(ctx context.Context, in *indexpb.GetJo
 	return &indexpb.GetJobStatsResponse{}, m.Err
 }
 
+func (m *GrpcIndexNodeClient) GetCapabilities(ctx context.Context, in *indexpb.GetCapabilitiesRequest, opts ...grpc.CallOption) (*indexpb.GetCapabilitiesResponse, error) {
+	return &indexpb.GetCapabilitiesResponse{}, m.Err
+}
+
+func (m *GrpcIndexNodeClient) UpdateLogConfig(ctx context.Context, in *indexpb.UpdateLogConfigRequest, opts ...grpc.CallOption) (*indexpb.UpdateLogConfigResponse, error) {
+	return &indexpb.UpdateLogConfigResponse{}, m.Err
+}
+
 func (m *GrpcIndexNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
@@ -0,0 +1,312 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// DefaultHealthRoot is the root path of the health reported by the nodes
+const DefaultHealthRoot = "health/"
+
+// MQHealthServerName is the server name of the health of the embedded pebblemq, pushed in standalone mode
+const MQHealthServerName = "pebblemq"
+
+// NodeHealth is the health a node pushes to the coordinators on change, the key is attached to a lease
+// of a short ttl, so it's deleted soon after the node fails.
+type NodeHealth struct {
+	ServerID   int64  `json:"ServerID"`
+	ServerName string `json:"ServerName"`
+	// Score is in [0, 1], 1 means the node is idle and 0 means the node is unable to serve
+	Score           float64 `json:"Score"`
+	QueueDepth      int64   `json:"QueueDepth"`
+	MemoryWatermark float64 `json:"MemoryWatermark"`
+	DiskWatermark   float64 `json:"DiskWatermark"`
+	Throttled       bool    `json:"Throttled"`
+	// Timestamp is the unix milliseconds when the health is reported
+	Timestamp int64 `json:"Timestamp"`
+}
+
+// changed reports whether the health differs from the last reported one enough to push it.
+func (h *NodeHealth) changed(last *NodeHealth, delta float64) bool {
+	if last == nil {
+		return true
+	}
+	return h.Throttled != last.Throttled ||
+		h.QueueDepth != last.QueueDepth ||
+		math.Abs(h.Score-last.Score) >= delta ||
+		math.Abs(h.MemoryWatermark-last.MemoryWatermark) >= delta ||
+		math.Abs(h.DiskWatermark-last.DiskWatermark) >= delta
+}
+
+// HealthScore computes the score of a node from its watermarks, which are the ratios to their limits.
+func HealthScore(throttled bool, watermarks ...float64) float64 {
+	if throttled {
+		return 0
+	}
+	highest := 0.0
+	for _, watermark := range watermarks {
+		highest = math.Max(highest, watermark)
+	}
+	return math.Max(0, 1-highest)
+}
+
+func healthPrefix(metaRoot, prefix string) string {
+	return path.Join(metaRoot, DefaultHealthRoot, prefix)
+}
+
+func healthKey(metaRoot, serverName string, serverID int64) string {
+	return path.Join(metaRoot, DefaultHealthRoot, fmt.Sprintf("%s-%d", serverName, serverID))
+}
+
+// serverIDOfHealthKey parses the server id from the health key, which ends with "-{serverID}".
+func serverIDOfHealthKey(key string) (int64, bool) {
+	idx := strings.LastIndex(key, "-")
+	if idx < 0 {
+		return 0, false
+	}
+	serverID, err := strconv.ParseInt(key[idx+1:], 10, 64)
+	return serverID, err == nil
+}
+
+// HealthReporter checks the health of a node periodically and pushes it if it changes,
+// or it isn't pushed for a while.
+type HealthReporter struct {
+	cli        *clientv3.Client
+	key        string
+	serverName string
+	serverID   int64
+	collect    func() *NodeHealth
+
+	mu       sync.Mutex
+	leaseID  clientv3.LeaseID
+	last     *NodeHealth
+	lastTime time.Time
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewHealthReporter creates a HealthReporter of the node, collect returns the current health of the node.
+func NewHealthReporter(cli *clientv3.Client, metaRoot, serverName string, serverID int64, collect func() *NodeHealth) *HealthReporter {
+	return &HealthReporter{
+		cli:        cli,
+		key:        healthKey(metaRoot, serverName, serverID),
+		serverName: serverName,
+		serverID:   serverID,
+		collect:    collect,
+		closeCh:    make(chan struct{}),
+	}
+}
+
+// Start starts reporting the health, it's a no-op if the health report is disabled.
+func (r *HealthReporter) Start() {
+	if !paramtable.Get().CommonCfg.HealthReportEnabled.GetAsBool() {
+		return
+	}
+	r.wg.Add(1)
+	go r.loop()
+}
+
+func (r *HealthReporter) loop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(paramtable.Get().CommonCfg.HealthReportInterval.GetAsDuration(time.Millisecond))
+	defer ticker.Stop()
+	for {
+		if err := r.report(context.Background()); err != nil {
+			log.RatedWarn(60, "failed to report node health", zap.String("key", r.key), zap.Error(err))
+		}
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// report pushes the health if it changes enough or the max interval elapses since the last push.
+func (r *HealthReporter) report(ctx context.Context) error {
+	params := &paramtable.Get().CommonCfg
+	health := r.collect()
+	health.ServerID = r.serverID
+	health.ServerName = r.serverName
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !health.changed(r.last, params.HealthReportScoreDelta.GetAsFloat()) &&
+		time.Since(r.lastTime) < params.HealthReportMaxInterval.GetAsDuration(time.Second) {
+		return nil
+	}
+
+	if r.leaseID == clientv3.NoLease {
+		if err := r.grantLease(ctx, params.HealthReportTTL.GetAsInt64()); err != nil {
+			return err
+		}
+	}
+	health.Timestamp = time.Now().UnixMilli()
+	value, err := json.Marshal(health)
+	if err != nil {
+		return err
+	}
+	if _, err := r.cli.Put(ctx, r.key, string(value), clientv3.WithLease(r.leaseID)); err != nil {
+		// the lease may be expired, grant a new one in the next report
+		r.leaseID = clientv3.NoLease
+		return err
+	}
+	r.last = health
+	r.lastTime = time.Now()
+	return nil
+}
+
+// grantLease grants the lease of the health key and keeps it alive, the mutex must be held by caller.
+func (r *HealthReporter) grantLease(ctx context.Context, ttl int64) error {
+	resp, err := r.cli.Grant(ctx, ttl)
+	if err != nil {
+		return err
+	}
+	keepAliveCtx, cancel := context.WithCancel(context.Background())
+	ch, err := r.cli.KeepAlive(keepAliveCtx, resp.ID)
+	if err != nil {
+		cancel()
+		return err
+	}
+	r.leaseID = resp.ID
+	r.wg.Add(1)
+	go func(leaseID clientv3.LeaseID) {
+		defer r.wg.Done()
+		defer cancel()
+		for {
+			select {
+			case <-r.closeCh:
+				return
+			case _, ok := <-ch:
+				if ok {
+					continue
+				}
+				log.Warn("health report lease is lost", zap.String("key", r.key), zap.Int64("leaseID", int64(leaseID)))
+				r.mu.Lock()
+				if r.leaseID == leaseID {
+					r.leaseID = clientv3.NoLease
+					r.last = nil
+				}
+				r.mu.Unlock()
+				return
+			}
+		}
+	}(resp.ID)
+	return nil
+}
+
+// Stop stops reporting and deletes the reported health.
+func (r *HealthReporter) Stop() {
+	r.closeOnce.Do(func() {
+		close(r.closeCh)
+		r.wg.Wait()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.leaseID != clientv3.NoLease {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if _, err := r.cli.Revoke(ctx, r.leaseID); err != nil {
+				log.Warn("failed to revoke the health report lease", zap.String("key", r.key), zap.Error(err))
+			}
+			r.leaseID = clientv3.NoLease
+		}
+	})
+}
+
+// NodeHealthEvent is a change of the health reported by a node, Health is nil if it expires,
+// e.g. the node fails or stops.
+type NodeHealthEvent struct {
+	ServerID int64
+	Health   *NodeHealth
+}
+
+// GetNodeHealths returns the health reported by the nodes of the prefix, and the revision to watch from.
+func (s *Session) GetNodeHealths(prefix string) (map[int64]*NodeHealth, int64, error) {
+	resp, err := s.etcdCli.Get(s.ctx, healthPrefix(s.metaRoot, prefix), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+	healths := make(map[int64]*NodeHealth, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		health := &NodeHealth{}
+		if err := json.Unmarshal(kv.Value, health); err != nil {
+			log.Warn("failed to unmarshal node health", zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		healths[health.ServerID] = health
+	}
+	return healths, resp.Header.Revision, nil
+}
+
+// WatchNodeHealth watches the health reported by the nodes of the prefix from the revision,
+// the channel is closed when the session stops or the watch fails.
+func (s *Session) WatchNodeHealth(prefix string, revision int64) <-chan *NodeHealthEvent {
+	eventCh := make(chan *NodeHealthEvent, 100)
+	rch := s.etcdCli.Watch(s.ctx, healthPrefix(s.metaRoot, prefix), clientv3.WithPrefix(), clientv3.WithRev(revision))
+	go func() {
+		defer close(eventCh)
+		for wresp := range rch {
+			if err := wresp.Err(); err != nil {
+				log.Warn("failed to watch node health", zap.String("prefix", prefix), zap.Error(err))
+				return
+			}
+			for _, ev := range wresp.Events {
+				event := &NodeHealthEvent{}
+				switch ev.Type {
+				case mvccpb.PUT:
+					health := &NodeHealth{}
+					if err := json.Unmarshal(ev.Kv.Value, health); err != nil {
+						log.Warn("failed to unmarshal node health", zap.String("key", string(ev.Kv.Key)), zap.Error(err))
+						continue
+					}
+					event.ServerID = health.ServerID
+					event.Health = health
+				case mvccpb.DELETE:
+					serverID, ok := serverIDOfHealthKey(string(ev.Kv.Key))
+					if !ok {
+						continue
+					}
+					event.ServerID = serverID
+				}
+				select {
+				case eventCh <- event:
+				case <-s.ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return eventCh
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestNodeHealth_changed(t *testing.T) {
+	last := &NodeHealth{Score: 0.5, QueueDepth: 2, MemoryWatermark: 0.5}
+	assert.True(t, (&NodeHealth{}).changed(nil, 0.05))
+	assert.False(t, (&NodeHealth{Score: 0.52, QueueDepth: 2, MemoryWatermark: 0.51}).changed(last, 0.05))
+	assert.True(t, (&NodeHealth{Score: 0.4, QueueDepth: 2, MemoryWatermark: 0.5}).changed(last, 0.05))
+	assert.True(t, (&NodeHealth{Score: 0.5, QueueDepth: 3, MemoryWatermark: 0.5}).changed(last, 0.05))
+	assert.True(t, (&NodeHealth{Score: 0.5, QueueDepth: 2, MemoryWatermark: 0.5, Throttled: true}).changed(last, 0.05))
+}
+
+func TestHealthScore(t *testing.T) {
+	assert.Equal(t, 1.0, HealthScore(false))
+	assert.InDelta(t, 0.2, HealthScore(false, 0.5, 0.8), 1e-9)
+	assert.Equal(t, 0.0, HealthScore(false, 1.2))
+	assert.Equal(t, 0.0, HealthScore(true, 0.1))
+}
+
+func TestServerIDOfHealthKey(t *testing.T) {
+	serverID, ok := serverIDOfHealthKey(healthKey("by-dev/meta", "indexnode", 10))
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), serverID)
+
+	_, ok = serverIDOfHealthKey("by-dev/meta/health/indexnode")
+	assert.False(t, ok)
+}
+
+func TestHealthReporter(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.HealthReportInterval.Key, "10")
+	defer params.Reset(params.CommonCfg.HealthReportInterval.Key)
+
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+	etcdCli, err := etcd.GetRemoteEtcdClient(strings.Split(params.EtcdCfg.Endpoints.GetValue(), ","))
+	require.NoError(t, err)
+	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s := NewSession(ctx, metaRoot, etcdCli)
+	healths, revision, err := s.GetNodeHealths("healthtest")
+	assert.NoError(t, err)
+	assert.Empty(t, healths)
+	eventCh := s.WatchNodeHealth("healthtest", revision+1)
+
+	var queueDepth int64
+	reporter := NewHealthReporter(etcdCli, metaRoot, "healthtest", 100, func() *NodeHealth {
+		depth := atomic.LoadInt64(&queueDepth)
+		return &NodeHealth{Score: HealthScore(false, float64(depth)/10), QueueDepth: depth}
+	})
+	reporter.Start()
+
+	nextEvent := func() *NodeHealthEvent {
+		select {
+		case event := <-eventCh:
+			return event
+		case <-time.After(10 * time.Second):
+			t.Fatal("wait for the node health event timeout")
+			return nil
+		}
+	}
+	event := nextEvent()
+	assert.Equal(t, int64(100), event.ServerID)
+	assert.Equal(t, "healthtest", event.Health.ServerName)
+	assert.Equal(t, 1.0, event.Health.Score)
+
+	atomic.StoreInt64(&queueDepth, 5)
+	event = nextEvent()
+	assert.Equal(t, int64(5), event.Health.QueueDepth)
+	assert.InDelta(t, 0.5, event.Health.Score, 1e-9)
+
+	healths, _, err = s.GetNodeHealths("healthtest")
+	assert.NoError(t, err)
+	assert.Contains(t, healths, int64(100))
+
+	// the health is deleted once the reporter stops
+	reporter.Stop()
+	event = nextEvent()
+	assert.Equal(t, int64(100), event.ServerID)
+	assert.Nil(t, event.Health)
+}
@@ -96,6 +96,8 @@ type Session struct {
 	Stopping    bool   `json:"Stopping,omitempty"`
 	TriggerKill bool
 	Version     semver.Version `json:"Version,omitempty"`
+	// IndexEngineVersion is the range of the index file versions the node loads, only set by query nodes
+	IndexEngineVersion IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 
 	liveChOnce sync.Once
 	liveCh     chan struct{}
@@ -120,6 +122,12 @@ type Session struct {
 	reuseNodeID       bool
 }
 
+// IndexEngineVersion is the range of the index file versions a node is able to load.
+type IndexEngineVersion struct {
+	MinimalIndexVersion int32 `json:"MinimalIndexVersion,omitempty"`
+	CurrentIndexVersion int32 `json:"CurrentIndexVersion,omitempty"`
+}
+
 type SessionOption func(session *Session)
 
 func WithTTL(ttl int64) SessionOption {
@@ -134,6 +142,12 @@ func WithResueNodeID(b bool) SessionOption {
 	return func(session *Session) { session.reuseNodeID = b }
 }
 
+func WithIndexEngineVersion(minimal, current int32) SessionOption {
+	return func(session *Session) {
+		session.IndexEngineVersion = IndexEngineVersion{MinimalIndexVersion: minimal, CurrentIndexVersion: current}
+	}
+}
+
 func (s *Session) apply(opts ...SessionOption) {
 	for _, opt := range opts {
 		opt(s)
@@ -149,8 +163,9 @@ func (s *Session) UnmarshalJSON(data []byte) error {
 		Exclusive   bool   `json:"Exclusive,omitempty"`
 		Stopping    bool   `json:"Stopping,omitempty"`
 		TriggerKill bool
-		Version     string            `json:"Version"`
-		LeaseID     *clientv3.LeaseID `json:"LeaseID,omitempty"`
+		Version     string             `json:"Version"`
+		LeaseID     *clientv3.LeaseID  `json:"LeaseID,omitempty"`
+		IndexEngine IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 	}
 	err := json.Unmarshal(data, &raw)
 	if err != nil {
@@ -171,6 +186,7 @@ func (s *Session) UnmarshalJSON(data []byte) error {
 	s.Stopping = raw.Stopping
 	s.TriggerKill = raw.TriggerKill
 	s.leaseID = raw.LeaseID
+	s.IndexEngineVersion = raw.IndexEngine
 	return nil
 }
 
@@ -184,8 +200,9 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 		Exclusive   bool   `json:"Exclusive,omitempty"`
 		Stopping    bool   `json:"Stopping,omitempty"`
 		TriggerKill bool
-		Version     string            `json:"Version"`
-		LeaseID     *clientv3.LeaseID `json:"LeaseID,omitempty"`
+		Version     string             `json:"Version"`
+		LeaseID     *clientv3.LeaseID  `json:"LeaseID,omitempty"`
+		IndexEngine IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 	}{
 		ServerID:    s.ServerID,
 		ServerName:  s.ServerName,
@@ -195,6 +212,7 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 		TriggerKill: s.TriggerKill,
 		Version:     verStr,
 		LeaseID:     s.leaseID,
+		IndexEngine: s.IndexEngineVersion,
 	})
 }
 
@@ -387,6 +387,10 @@ func TestSesssionMarshal(t *testing.T) {
 		ServerName: "test",
 		Address:    "localhost",
 		Version:    common.Version,
+		IndexEngineVersion: IndexEngineVersion{
+			MinimalIndexVersion: 1,
+			CurrentIndexVersion: 2,
+		},
 	}
 
 	bs, err := json.Marshal(s)
@@ -399,6 +403,7 @@ func TestSesssionMarshal(t *testing.T) {
 	assert.Equal(t, s.ServerName, s2.ServerName)
 	assert.Equal(t, s.Address, s2.Address)
 	assert.Equal(t, s.Version.String(), s2.Version.String())
+	assert.Equal(t, s.IndexEngineVersion, s2.IndexEngineVersion)
 }
 
 func TestSessionUnmarshal(t *testing.T) {
@@ -79,6 +79,29 @@ const (
 
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
+
+	// SegmentIndexCASPath storage path const for content-addressed segment index files.
+	SegmentIndexCASPath = `index_cas`
+
+	// SegmentIndexManifestKey file key of the manifest of a build with content-addressed index files.
+	SegmentIndexManifestKey = `manifest.json`
+
+	// SegmentIndexUploadManifestKey file key of the manifest declaring the uploaded index files of a build, it's
+	// written before the build is reported finished.
+	SegmentIndexUploadManifestKey = `upload_manifest.json`
+)
+
+// Index file versions, a query node loads the index files of the versions up to its current one.
+const (
+	// IndexFileVersionPlain the index files are stored under the path of the build.
+	IndexFileVersionPlain = int32(1)
+	// IndexFileVersionContentAddressed the index files may be stored content-addressed with a manifest.
+	IndexFileVersionContentAddressed = int32(2)
+
+	// MinimalIndexFileVersion is the oldest index file version index node is able to write.
+	MinimalIndexFileVersion = IndexFileVersionPlain
+	// CurrentIndexFileVersion is the newest index file version index node writes and query node loads.
+	CurrentIndexFileVersion = IndexFileVersionContentAddressed
 )
 
 // Search, Index parameter keys
@@ -117,6 +140,13 @@ const (
 	CollectionSearchRateMaxKey   = "collection.searchRate.max.vps"
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
+
+	// object storage of the index builds
+	CollectionStorageEndpointKey      = "collection.storage.endpoint"
+	CollectionStorageRequesterPaysKey = "collection.storage.requesterPays"
+
+	// priority of the index builds under the priority schedule policy of IndexNode
+	CollectionIndexBuildPriorityKey = "collection.index.buildPriority"
 )
 
 const (
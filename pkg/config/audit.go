@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// RuntimeSource is the source of the configs changed at runtime, e.g. by the tests or the admin apis
+	RuntimeSource = "Runtime"
+
+	// defaultAuditCapacity is the number of the latest config changes kept by the audit
+	defaultAuditCapacity = 1000
+
+	// the sources put a config by both the original key and the formatted key, the duplicated changes
+	// within the window are merged into one record of the original key
+	duplicateWindow = time.Second
+)
+
+// ChangeRecord is a change of a config made by a source.
+type ChangeRecord struct {
+	Time     time.Time `json:"time"`
+	Key      string    `json:"key"`
+	Source   string    `json:"source"`
+	Type     string    `json:"type"`
+	OldValue string    `json:"old_value"`
+	NewValue string    `json:"new_value"`
+	// Caller identifies who made the change, the function out of the config packages for the changes at runtime,
+	// or the source for the changes watched from the sources
+	Caller string `json:"caller"`
+}
+
+// configPackages are the packages whose functions are skipped when looking for the caller changing a config
+var configPackages = []string{
+	"github.com/milvus-io/milvus/pkg/config.",
+	"github.com/milvus-io/milvus/pkg/util/paramtable.",
+}
+
+// callerIdentity returns the first function out of the config packages on the stack with its file and line.
+func callerIdentity() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		// the tests of the config packages change the configs as callers
+		inConfig := false
+		for _, pkg := range configPackages {
+			if strings.HasPrefix(frame.Function, pkg) && !strings.HasSuffix(frame.File, "_test.go") {
+				inConfig = true
+				break
+			}
+		}
+		if !inConfig && frame.Function != "" {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// changeAudit keeps the latest config changes in a ring.
+type changeAudit struct {
+	mu       sync.Mutex
+	capacity int
+	records  []ChangeRecord
+	// next is the position of the next record once the ring is full
+	next int
+	// latest is the position of the latest record of the formatted key
+	latest map[string]int
+}
+
+func newChangeAudit(capacity int) *changeAudit {
+	return &changeAudit{
+		capacity: capacity,
+		records:  make([]ChangeRecord, 0, capacity),
+		latest:   make(map[string]int),
+	}
+}
+
+func (a *changeAudit) record(r ChangeRecord) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key := formatKey(r.Key)
+	if pos, ok := a.latest[key]; ok {
+		last := &a.records[pos]
+		if formatKey(last.Key) == key && last.Source == r.Source && last.Caller == r.Caller && last.Type == r.Type &&
+			last.OldValue == r.OldValue && last.NewValue == r.NewValue && r.Time.Sub(last.Time) < duplicateWindow {
+			if r.Key != key {
+				last.Key = r.Key
+			}
+			return
+		}
+	}
+
+	log.Info("config changed",
+		zap.String("key", r.Key),
+		zap.String("source", r.Source),
+		zap.String("type", r.Type),
+		zap.String("oldValue", r.OldValue),
+		zap.String("newValue", r.NewValue),
+		zap.String("caller", r.Caller))
+	pos := len(a.records)
+	if pos < a.capacity {
+		a.records = append(a.records, r)
+	} else {
+		pos = a.next
+		a.records[pos] = r
+		a.next = (a.next + 1) % a.capacity
+	}
+	a.latest[key] = pos
+}
+
+// list returns the changes of the keys with the prefix from the oldest to the newest, all if prefix is empty.
+func (a *changeAudit) list(prefix string) []ChangeRecord {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	prefix = formatKey(prefix)
+	ret := make([]ChangeRecord, 0)
+	for i := range a.records {
+		r := a.records[(a.next+i)%len(a.records)]
+		if strings.HasPrefix(formatKey(r.Key), prefix) {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
@@ -153,7 +153,7 @@ func (es *EtcdSource) refreshConfigurations() error {
 	}
 	es.Lock()
 	defer es.Unlock()
-	err = es.configRefresher.fireEvents(es.GetSourceName(), es.currentConfig, newConfig)
+	_, err = es.configRefresher.fireEvents(es.GetSourceName(), es.currentConfig, newConfig)
 	if err != nil {
 		return err
 	}
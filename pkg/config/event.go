@@ -27,15 +27,18 @@ type Event struct {
 	EventType   string
 	Key         string
 	Value       string
-	HasUpdated  bool
+	// OldValue is the value of the key in the source before the event, empty for the create event
+	OldValue   string
+	HasUpdated bool
 }
 
-func newEvent(eventSource, eventType string, key string, value string) *Event {
+func newEvent(eventSource, eventType string, key string, value string, oldValue string) *Event {
 	return &Event{
 		EventSource: eventSource,
 		EventType:   eventType,
 		Key:         key,
 		Value:       value,
+		OldValue:    oldValue,
 		HasUpdated:  false,
 	}
 }
@@ -47,9 +50,9 @@ func PopulateEvents(source string, currentConfig, updatedConfig map[string]strin
 	for key, value := range updatedConfig {
 		currentValue, ok := currentConfig[key]
 		if !ok { // if new configuration introduced
-			events = append(events, newEvent(source, CreateType, key, value))
+			events = append(events, newEvent(source, CreateType, key, value, ""))
 		} else if currentValue != value {
-			events = append(events, newEvent(source, UpdateType, key, value))
+			events = append(events, newEvent(source, UpdateType, key, value, currentValue))
 		}
 	}
 
@@ -57,7 +60,7 @@ func PopulateEvents(source string, currentConfig, updatedConfig map[string]strin
 	for key, value := range currentConfig {
 		_, ok := updatedConfig[key]
 		if !ok { // when old config not present in new config
-			events = append(events, newEvent(source, DeleteType, key, value))
+			events = append(events, newEvent(source, DeleteType, key, value, value))
 		}
 	}
 	return events, nil
@@ -106,7 +106,17 @@ func (fs *FileSource) UpdateOptions(opts Options) {
 	fs.files = opts.FileInfo.Files
 }
 
+// Reload re-reads the files, and fires the events of the changed configs, which are returned.
+func (fs *FileSource) Reload() ([]*Event, error) {
+	return fs.reload()
+}
+
 func (fs *FileSource) loadFromFile() error {
+	_, err := fs.reload()
+	return err
+}
+
+func (fs *FileSource) reload() ([]*Event, error) {
 	yamlReader := viper.New()
 	newConfig := make(map[string]string)
 	var configFiles []string
@@ -122,7 +132,7 @@ func (fs *FileSource) loadFromFile() error {
 
 		yamlReader.SetConfigFile(configFile)
 		if err := yamlReader.ReadInConfig(); err != nil {
-			return errors.Wrap(err, "Read config failed: "+configFile)
+			return nil, errors.Wrap(err, "Read config failed: "+configFile)
 		}
 
 		for _, key := range yamlReader.AllKeys() {
@@ -156,11 +166,11 @@ func (fs *FileSource) loadFromFile() error {
 
 	fs.Lock()
 	defer fs.Unlock()
-	err := fs.configRefresher.fireEvents(fs.GetSourceName(), fs.configs, newConfig)
+	events, err := fs.configRefresher.fireEvents(fs.GetSourceName(), fs.configs, newConfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fs.configs = newConfig
 
-	return nil
+	return events, nil
 }
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -86,6 +87,16 @@ type Manager struct {
 	keySourceMap  map[string]string // store the key to config source, example: key is A.B.C and source is file which means the A.B.C's value is from file
 	overlays      map[string]string // store the highest priority configs which modified at runtime
 	forbiddenKeys typeutil.Set[string]
+	audit         *changeAudit
+	validators    map[string]func(value string) error // validate the dynamic updates of the keys
+	rejected      map[string]rejectedUpdate           // the invalid updates of the keys which are still in their sources
+}
+
+// rejectedUpdate is an invalid value put by a source, the source still holds it, so the old value is served instead.
+type rejectedUpdate struct {
+	source   string
+	value    string
+	oldValue string
 }
 
 func NewManager() *Manager {
@@ -95,12 +106,21 @@ func NewManager() *Manager {
 		keySourceMap:  make(map[string]string),
 		overlays:      make(map[string]string),
 		forbiddenKeys: typeutil.NewSet[string](),
+		audit:         newChangeAudit(defaultAuditCapacity),
+		validators:    make(map[string]func(value string) error),
+		rejected:      make(map[string]rejectedUpdate),
 	}
 }
 
 func (m *Manager) GetConfig(key string) (string, error) {
 	m.RLock()
 	defer m.RUnlock()
+	return m.getConfig(key)
+}
+
+// getConfig returns the value of the key, the rejected invalid value is replaced by the value kept.
+// The lock must be held by caller.
+func (m *Manager) getConfig(key string) (string, error) {
 	realKey := formatKey(key)
 	v, ok := m.overlays[realKey]
 	if ok {
@@ -113,17 +133,29 @@ func (m *Manager) GetConfig(key string) (string, error) {
 	if !ok {
 		return "", fmt.Errorf("key not found: %s", key)
 	}
-	return m.getConfigValueBySource(realKey, sourceName)
+	v, err := m.getConfigValueBySource(realKey, sourceName)
+	if err != nil {
+		return "", err
+	}
+	if r, ok := m.rejected[realKey]; ok && r.source == sourceName && r.value == v {
+		return r.oldValue, nil
+	}
+	return v, nil
 }
 
-// GetConfigs returns all the key values
+// GetConfigs returns all the key values, the rejected invalid values are replaced by the values kept
 func (m *Manager) GetConfigs() map[string]string {
 	m.RLock()
 	defer m.RUnlock()
+	return m.getConfigs()
+}
+
+// getConfigs returns all the key values, the lock must be held by caller.
+func (m *Manager) getConfigs() map[string]string {
 	config := make(map[string]string)
 
 	for key := range m.keySourceMap {
-		sValue, err := m.GetConfig(key)
+		sValue, err := m.getConfig(key)
 		if err != nil {
 			continue
 		}
@@ -141,7 +173,7 @@ func (m *Manager) GetBy(filters ...Filter) map[string]string {
 	defer m.RUnlock()
 	matchedConfig := make(map[string]string)
 
-	for key, value := range m.GetConfigs() {
+	for key, value := range m.getConfigs() {
 		newkey, ok := filterate(key, filters...)
 		if ok {
 			matchedConfig[newkey] = value
@@ -164,6 +196,49 @@ func (m *Manager) FileConfigs() map[string]string {
 	return config
 }
 
+// ReloadFiles re-reads the file sources, the changes are applied and dispatched to the watchers,
+// and the events of them are returned, once per key by the original key in the files.
+func (m *Manager) ReloadFiles() ([]*Event, error) {
+	m.RLock()
+	sources := make([]*FileSource, 0, 1)
+	for _, source := range m.sources {
+		if s, ok := source.(*FileSource); ok {
+			sources = append(sources, s)
+		}
+	}
+	m.RUnlock()
+
+	// the events are handled by OnEvent, which requires the lock
+	var events []*Event
+	for _, s := range sources {
+		sourceEvents, err := s.Reload()
+		if err != nil {
+			return events, err
+		}
+		events = append(events, dedupEvents(sourceEvents)...)
+	}
+	return events, nil
+}
+
+// dedupEvents keeps one event per key, the file sources fire the events of both the original and formatted keys.
+func dedupEvents(events []*Event) []*Event {
+	positions := make(map[string]int, len(events))
+	deduped := make([]*Event, 0, len(events))
+	for _, e := range events {
+		key := formatKey(e.Key)
+		pos, ok := positions[key]
+		if !ok {
+			positions[key] = len(deduped)
+			deduped = append(deduped, e)
+			continue
+		}
+		if e.Key != key {
+			deduped[pos] = e
+		}
+	}
+	return deduped
+}
+
 func (m *Manager) Close() {
 	m.Lock()
 	defer m.Unlock()
@@ -200,6 +275,7 @@ func (m *Manager) AddSource(source Source) error {
 func (m *Manager) SetConfig(key, value string) {
 	m.Lock()
 	defer m.Unlock()
+	m.recordOverlayChange(key, value)
 	m.overlays[formatKey(key)] = value
 }
 
@@ -207,6 +283,7 @@ func (m *Manager) SetConfig(key, value string) {
 func (m *Manager) DeleteConfig(key string) {
 	m.Lock()
 	defer m.Unlock()
+	m.recordOverlayChange(key, TombValue)
 	m.overlays[formatKey(key)] = TombValue
 }
 
@@ -214,9 +291,52 @@ func (m *Manager) DeleteConfig(key string) {
 func (m *Manager) ResetConfig(key string) {
 	m.Lock()
 	defer m.Unlock()
+	if _, ok := m.overlays[formatKey(key)]; ok {
+		m.recordOverlayChange(key, "")
+	}
 	delete(m.overlays, formatKey(key))
 }
 
+// recordOverlayChange records the change of the config at runtime, value is empty if the runtime config is removed,
+// or TombValue if it's deleted.
+func (m *Manager) recordOverlayChange(key, value string) {
+	oldValue, ok := m.overlays[formatKey(key)]
+	record := ChangeRecord{
+		Time:     time.Now(),
+		Key:      key,
+		Source:   RuntimeSource,
+		Type:     UpdateType,
+		OldValue: oldValue,
+		NewValue: value,
+		Caller:   callerIdentity(),
+	}
+	if !ok {
+		record.Type = CreateType
+	}
+	if value == "" || value == TombValue {
+		record.Type = DeleteType
+		record.NewValue = ""
+	}
+	if record.OldValue == TombValue {
+		record.OldValue = ""
+	}
+	m.audit.record(record)
+}
+
+// ConfigChanges returns the latest config changes of the keys with the prefix from the oldest to the newest,
+// all the kept changes are returned if the prefix is empty.
+func (m *Manager) ConfigChanges(prefix string) []ChangeRecord {
+	return m.audit.list(prefix)
+}
+
+// SetValidator sets the validator of the dynamic updates of the key, an invalid update from the sources is
+// rejected and the key keeps its old value. The changes at runtime by SetConfig are not validated.
+func (m *Manager) SetValidator(key string, validator func(value string) error) {
+	m.Lock()
+	defer m.Unlock()
+	m.validators[formatKey(key)] = validator
+}
+
 // Ignore any of update events, which means the config cannot auto refresh anymore
 func (m *Manager) ForbidUpdate(key string) {
 	m.Lock()
@@ -339,15 +459,62 @@ func (m *Manager) OnEvent(event *Event) {
 		log.Info("ignore event for forbidden key", zap.String("key", event.Key))
 		return
 	}
+	if m.rejectInvalid(event) {
+		return
+	}
 	err := m.updateEvent(event)
 	if err != nil {
 		log.Warn("failed in updating event with error", zap.Error(err), zap.Any("event", event))
 		return
 	}
+	// the source holds its lock while firing the events, so the values are taken from the event
+	record := ChangeRecord{
+		Time:     time.Now(),
+		Key:      event.Key,
+		Source:   event.EventSource,
+		Type:     event.EventType,
+		OldValue: event.OldValue,
+		NewValue: event.Value,
+		Caller:   event.EventSource,
+	}
+	if event.EventType == DeleteType {
+		record.NewValue = ""
+	}
+	m.audit.record(record)
 
 	m.Dispatcher.Dispatch(event)
 }
 
+// rejectInvalid returns true if the event puts an invalid value, the old value of the key is kept.
+func (m *Manager) rejectInvalid(event *Event) bool {
+	realKey := formatKey(event.Key)
+	validator, ok := m.validators[realKey]
+	if !ok {
+		return false
+	}
+	// the source replaces the rejected value, which has never taken effect, so the change is from the value kept.
+	// the rejection is kept for the duplicated event of the other key format
+	if r, ok := m.rejected[realKey]; ok && r.source == event.EventSource && r.value == event.OldValue {
+		event.OldValue = r.oldValue
+	}
+	if event.EventType == DeleteType {
+		return false
+	}
+	err := validator(event.Value)
+	if err == nil {
+		return false
+	}
+	// the values aren't logged, which may be secrets
+	log.Warn("reject invalid config update", zap.String("key", event.Key), zap.String("source", event.EventSource),
+		zap.Error(err))
+	// the source has kept the value if it maintains the key, the event of a source of lower priority or
+	// a new key doesn't take effect since it isn't applied
+	if m.keySourceMap[realKey] == event.EventSource {
+		m.rejected[realKey] = rejectedUpdate{source: event.EventSource, value: event.Value, oldValue: event.OldValue}
+	}
+	return true
+}
+
 func (m *Manager) GetIdentifier() string {
 	return "Manager"
 }
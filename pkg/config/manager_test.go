@@ -19,6 +19,7 @@ package config
 import (
 	"os"
 	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -53,6 +54,110 @@ func TestConfigChangeEvent(t *testing.T) {
 	res, err = mgr.GetConfig("a.b")
 	assert.NoError(t, err)
 	assert.Equal(t, res, "6")
+
+	// the change is recorded once by the original key
+	changes := mgr.ConfigChanges("a.")
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "a.b", changes[0].Key)
+	assert.Equal(t, fs.GetSourceName(), changes[0].Source)
+	assert.Equal(t, fs.GetSourceName(), changes[0].Caller)
+	assert.Equal(t, UpdateType, changes[0].Type)
+	assert.Equal(t, "3", changes[0].OldValue)
+	assert.Equal(t, "6", changes[0].NewValue)
+}
+
+func TestRejectInvalidUpdate(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "milvus")
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 1\nc.d: 2"), 0o600)
+
+	fs := NewFileSource(&FileInfo{[]string{path.Join(dir, "milvus.yaml")}, 1})
+	mgr, _ := Init()
+	mgr.SetValidator("a.b", func(value string) error {
+		if v, err := strconv.Atoi(value); err != nil || v <= 0 {
+			return errors.New("should be a positive integer")
+		}
+		return nil
+	})
+	err := mgr.AddSource(fs)
+	assert.NoError(t, err)
+
+	// the invalid update is rejected, the old value is kept
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: -1\nc.d: -2"), 0o600)
+	time.Sleep(3 * time.Second)
+	res, err := mgr.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res)
+	res, err = mgr.GetConfig("c.d")
+	assert.NoError(t, err)
+	assert.Equal(t, "-2", res)
+	assert.Empty(t, mgr.ConfigChanges("a.b"))
+	// the listings serve the value kept too
+	assert.Equal(t, "1", mgr.GetConfigs()["ab"])
+	assert.Equal(t, "1", mgr.GetBy(WithPrefix("ab"))["ab"])
+
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 3"), 0o600)
+	time.Sleep(3 * time.Second)
+	res, err = mgr.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", res)
+	// the change is recorded from the value kept
+	changes := mgr.ConfigChanges("a.b")
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "1", changes[0].OldValue)
+
+	// not validated at runtime
+	mgr.SetConfig("a.b", "0")
+	res, err = mgr.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "0", res)
+}
+
+func TestConfigChanges(t *testing.T) {
+	mgr, _ := Init()
+	mgr.SetConfig("a.b", "1")
+	mgr.SetConfig("a.b", "2")
+	mgr.DeleteConfig("a.b")
+	mgr.ResetConfig("a.b")
+	// not set at runtime
+	mgr.ResetConfig("c.d")
+	mgr.SetConfig("c.d", "3")
+
+	changes := mgr.ConfigChanges("a.b")
+	assert.Len(t, changes, 4)
+	for i, expected := range []struct {
+		tp       string
+		oldValue string
+		newValue string
+	}{
+		{CreateType, "", "1"},
+		{UpdateType, "1", "2"},
+		{DeleteType, "2", ""},
+		{DeleteType, "", ""},
+	} {
+		assert.Equal(t, RuntimeSource, changes[i].Source)
+		assert.Contains(t, changes[i].Caller, "TestConfigChanges")
+		assert.Equal(t, expected.tp, changes[i].Type)
+		assert.Equal(t, expected.oldValue, changes[i].OldValue)
+		assert.Equal(t, expected.newValue, changes[i].NewValue)
+	}
+	assert.Len(t, mgr.ConfigChanges(""), 5)
+}
+
+func TestChangeAudit(t *testing.T) {
+	audit := newChangeAudit(2)
+	now := time.Now()
+	audit.record(ChangeRecord{Time: now, Key: "ab", Source: "s", NewValue: "1"})
+	// the duplicated change by the original key
+	audit.record(ChangeRecord{Time: now, Key: "a.b", Source: "s", NewValue: "1"})
+	assert.Equal(t, []ChangeRecord{{Time: now, Key: "a.b", Source: "s", NewValue: "1"}}, audit.list(""))
+
+	audit.record(ChangeRecord{Time: now, Key: "c.d", Source: "s", NewValue: "2"})
+	audit.record(ChangeRecord{Time: now, Key: "e.f", Source: "s", NewValue: "3"})
+	changes := audit.list("")
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "c.d", changes[0].Key)
+	assert.Equal(t, "e.f", changes[1].Key)
+	assert.Len(t, audit.list("c.d"), 1)
 }
 
 func TestAllDupliateSource(t *testing.T) {
@@ -98,3 +203,48 @@ func (e ErrSource) SetEventHandler(eh EventHandler) {
 
 func (e ErrSource) UpdateOptions(opt Options) {
 }
+
+func TestReloadFiles(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "milvus")
+	defer os.RemoveAll(dir)
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 1\nc.d: 2"), 0o600)
+
+	// not refreshed in the test, only reloaded
+	fs := NewFileSource(&FileInfo{[]string{path.Join(dir, "milvus.yaml")}, time.Hour})
+	mgr, _ := Init()
+	err := mgr.AddSource(fs)
+	assert.NoError(t, err)
+
+	var watched []string
+	mgr.Dispatcher.Register("a.b", NewHandler("test", func(e *Event) {
+		watched = append(watched, e.Value)
+	}))
+
+	events, err := mgr.ReloadFiles()
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 3\nc.d: 2\ne.f: 4"), 0o600)
+	events, err = mgr.ReloadFiles()
+	assert.NoError(t, err)
+	keys := make(map[string]string)
+	for _, e := range events {
+		keys[e.Key] = e.EventType
+	}
+	assert.Equal(t, map[string]string{"a.b": UpdateType, "e.f": CreateType}, keys)
+	res, err := mgr.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", res)
+	res, err = mgr.GetConfig("e.f")
+	assert.NoError(t, err)
+	assert.Equal(t, "4", res)
+	assert.Contains(t, watched, "3")
+
+	// the old configs are kept if the file is broken
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: [3"), 0o600)
+	_, err = mgr.ReloadFiles()
+	assert.Error(t, err)
+	res, err = mgr.GetConfig("a.b")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", res)
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+)
+
+// PresetSource serves the configs derived from a preset profile, they never change after created.
+type PresetSource struct {
+	name    string
+	configs map[string]string
+}
+
+// NewPresetSource creates a source of the configs of the preset.
+func NewPresetSource(name string, configs map[string]string) *PresetSource {
+	ps := &PresetSource{
+		name:    name,
+		configs: make(map[string]string),
+	}
+	for key, value := range configs {
+		ps.configs[key] = value
+		ps.configs[formatKey(key)] = value
+	}
+	return ps
+}
+
+// GetConfigurationByKey implements ConfigSource
+func (ps *PresetSource) GetConfigurationByKey(key string) (string, error) {
+	value, ok := ps.configs[key]
+	if !ok {
+		return "", fmt.Errorf("key not found: %s", key)
+	}
+	return value, nil
+}
+
+// GetConfigurations implements ConfigSource
+func (ps *PresetSource) GetConfigurations() (map[string]string, error) {
+	configMap := make(map[string]string, len(ps.configs))
+	for k, v := range ps.configs {
+		configMap[k] = v
+	}
+	return configMap, nil
+}
+
+// GetPriority implements ConfigSource
+func (ps *PresetSource) GetPriority() int {
+	return PresetPriority
+}
+
+// GetSourceName implements ConfigSource
+func (ps *PresetSource) GetSourceName() string {
+	return "PresetSource"
+}
+
+func (ps *PresetSource) SetEventHandler(eh EventHandler) {
+}
+
+func (ps *PresetSource) UpdateOptions(opts Options) {
+}
+
+func (ps *PresetSource) Close() {
+}
@@ -79,11 +79,12 @@ func (r *refresher) refreshPeriodically(name string) {
 	}
 }
 
-func (r *refresher) fireEvents(name string, source, target map[string]string) error {
+// fireEvents fires the events of the changes from source to target, the fired events are returned.
+func (r *refresher) fireEvents(name string, source, target map[string]string) ([]*Event, error) {
 	events, err := PopulateEvents(name, source, target)
 	if err != nil {
 		log.Warn("generating event error", zap.Error(err))
-		return err
+		return nil, err
 	}
 	// Generate OnEvent Callback based on the events created
 	if r.eh != nil {
@@ -91,5 +92,5 @@ func (r *refresher) fireEvents(name string, source, target map[string]string) er
 			r.eh.OnEvent(e)
 		}
 	}
-	return nil
+	return events, nil
 }
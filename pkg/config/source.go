@@ -21,6 +21,8 @@ const (
 	HighPriority   = 1
 	NormalPriority = HighPriority + 10
 	LowPriority    = NormalPriority + 10
+	// PresetPriority only serves the defaults, the yaml files, env and config center override it
+	PresetPriority = LowPriority + 1
 )
 
 type Source interface {
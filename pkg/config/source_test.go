@@ -50,3 +50,27 @@ func TestLoadFromFileSource(t *testing.T) {
 		assert.Equal(t, "2", v2)
 	})
 }
+
+func TestPresetSource(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "milvus")
+	defer os.RemoveAll(dir)
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 1\nc.d: 2\ne.f: 3"), 0o600)
+
+	t.Setenv("C_D", "20")
+	mgr, _ := Init(WithEnvSource(formatKey),
+		WithFilesSource(&FileInfo{[]string{path.Join(dir, "milvus.yaml")}, -1}))
+	err := mgr.AddSource(NewPresetSource("test", map[string]string{"a.b": "10", "c.d": "10", "g.h": "10"}))
+	assert.NoError(t, err)
+
+	// the files and env override the preset
+	v, _ := mgr.GetConfig("a.b")
+	assert.Equal(t, "1", v)
+	v, _ = mgr.GetConfig("c.d")
+	assert.Equal(t, "20", v)
+	v, _ = mgr.GetConfig("e.f")
+	assert.Equal(t, "3", v)
+	v, _ = mgr.GetConfig("g.h")
+	assert.Equal(t, "10", v)
+	v, _ = mgr.GetConfig("GH")
+	assert.Equal(t, "10", v)
+}
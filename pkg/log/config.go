@@ -71,6 +71,8 @@ type Config struct {
 	//
 	// Values configured here are per-second. See zapcore.NewSampler for details.
 	Sampling *zap.SamplingConfig `toml:"sampling" json:"sampling"`
+	// RedactKeys are the keys of the fields redacted besides DefaultRedactKeys.
+	RedactKeys []string `toml:"redact-keys" json:"redact-keys"`
 }
 
 // ZapProperties records some information about zap.
@@ -83,6 +83,8 @@ func InitLogger(cfg *Config, opts ...zap.Option) (*zap.Logger, *ZapProperties, e
 		}
 		outputs = append(outputs, stdOut)
 	}
+	// the latest lines are kept in memory for the crash reports
+	outputs = append(outputs, globalTail)
 	debugCfg := *cfg
 	debugCfg.Level = "debug"
 	outputsWriter := zap.CombineWriteSyncers(outputs...)
@@ -118,7 +120,7 @@ func InitLoggerWithWriteSyncer(cfg *Config, output zapcore.WriteSyncer, opts ...
 	if err != nil {
 		return nil, nil, fmt.Errorf("initLoggerWithWriteSyncer UnmarshalText cfg.Level err:%w", err)
 	}
-	core := NewTextCore(newZapTextEncoder(cfg), output, level)
+	core := newRedactCore(NewTextCore(newZapTextEncoder(cfg), output, level), cfg.RedactKeys)
 	opts = append(cfg.buildOptions(output), opts...)
 	lg := zap.New(core, opts...)
 	r := &ZapProperties{
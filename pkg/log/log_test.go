@@ -150,6 +150,28 @@ func TestSampling(t *testing.T) {
 	}
 }
 
+func TestRedact(t *testing.T) {
+	ts := newTestLogSpy(t)
+	conf := &Config{Level: "debug", DisableTimestamp: true, RedactKeys: []string{"apiKey"}}
+	logger, _, _ := InitTestLogger(ts, conf)
+
+	logger.Info("create chunk manager failed", zap.String("bucket", "a-bucket"),
+		zap.String("accessKey", "AKIAEXAMPLE"), zap.String("SecretAccessKey", "secret-example"))
+	ts.assertLastMessageContains("a-bucket")
+	ts.assertLastMessageContains(RedactedValue)
+	ts.assertLastMessageNotContains("AKIAEXAMPLE")
+	ts.assertLastMessageNotContains("secret-example")
+
+	// the fields added by With and the extra keys are redacted as well
+	logger.With(zap.String("password", "password-example")).Info("with", zap.String("apiKey", "api-key-example"))
+	ts.assertLastMessageNotContains("password-example")
+	ts.assertLastMessageNotContains("api-key-example")
+
+	fields := []zapcore.Field{zap.String("bucket", "a-bucket")}
+	core := newRedactCore(zapcore.NewNopCore(), nil).(*redactCore)
+	assert.Equal(t, &fields[0], &core.redact(fields)[0])
+}
+
 func TestRatedLog(t *testing.T) {
 	ts := newTestLogSpy(t)
 	conf := &Config{Level: "debug", DisableTimestamp: true}
@@ -276,6 +298,28 @@ func TestStdAndFileLogger(t *testing.T) {
 	assert.True(t, fileInfo.Size() > 0)
 }
 
+func TestTail(t *testing.T) {
+	tail := newTailWriter(2)
+	assert.Empty(t, tail.latest(0))
+	for _, line := range []string{"a\n", "b\n", "c\n"} {
+		n, err := tail.Write([]byte(line))
+		assert.NoError(t, err)
+		assert.Equal(t, len(line), n)
+	}
+	assert.Equal(t, []string{"b", "c"}, tail.latest(0))
+	assert.Equal(t, []string{"c"}, tail.latest(1))
+
+	conf := &Config{Level: "info"}
+	logger, _, err := InitLogger(conf)
+	assert.NoError(t, err)
+	logger.Info("TestTail")
+	logger.Debug("TestTail debug")
+	lines := Tail(1)
+	assert.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "TestTail")
+	assert.NotContains(t, lines[0], "debug")
+}
+
 func TestStdLogger(t *testing.T) {
 	conf := &Config{Level: "debug", Stdout: true}
 
@@ -44,6 +44,8 @@ func (l *MLogger) WithRateGroup(groupName string, creditPerSecond, maxBalance fl
 	if loaded {
 		rl.Update(creditPerSecond, maxBalance)
 		rl = actual.(*utils.ReconfigurableRateLimiter)
+	} else {
+		_rateGroups.LoadOrStore(groupName, RateGroup{Name: groupName, CreditPerSecond: creditPerSecond, MaxBalance: maxBalance})
 	}
 	l.rl.Store(rl)
 	return l
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/uber/jaeger-client-go/utils"
+)
+
+// GlobalRateGroup is the name of the rate group used by the rated logs without a named rate group.
+const GlobalRateGroup = "global"
+
+// RateGroup is the rate limit shared by the rated logs of the group.
+type RateGroup struct {
+	Name            string  `json:"name"`
+	CreditPerSecond float64 `json:"creditPerSecond"`
+	MaxBalance      float64 `json:"maxBalance"`
+}
+
+// _rateGroups holds the current config of the rate groups, the rate limiters don't expose it
+var _rateGroups sync.Map // group name -> RateGroup
+
+func init() {
+	_rateGroups.Store(GlobalRateGroup, RateGroup{Name: GlobalRateGroup, CreditPerSecond: 1.0, MaxBalance: 60.0})
+}
+
+// RateGroups returns the config of all the rate groups sorted by name.
+func RateGroups() []RateGroup {
+	groups := make([]RateGroup, 0)
+	_rateGroups.Range(func(_, value any) bool {
+		groups = append(groups, value.(RateGroup))
+		return true
+	})
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
+// UpdateRateGroup changes the rate limit of the group at runtime, the group is created if it's not used yet,
+// then the loggers joining it later share the updated limit.
+func UpdateRateGroup(group RateGroup) error {
+	if group.Name == "" {
+		return fmt.Errorf("rate group name is empty")
+	}
+	if group.CreditPerSecond <= 0 || group.MaxBalance <= 0 {
+		return fmt.Errorf("invalid rate of group %s, creditPerSecond and maxBalance must be positive, got %v and %v",
+			group.Name, group.CreditPerSecond, group.MaxBalance)
+	}
+	if group.Name == GlobalRateGroup {
+		R().Update(group.CreditPerSecond, group.MaxBalance)
+	} else {
+		rl := utils.NewRateLimiter(group.CreditPerSecond, group.MaxBalance)
+		if actual, loaded := _namedRateLimiters.LoadOrStore(group.Name, rl); loaded {
+			actual.(*utils.ReconfigurableRateLimiter).Update(group.CreditPerSecond, group.MaxBalance)
+		}
+	}
+	_rateGroups.Store(group.Name, group)
+	return nil
+}
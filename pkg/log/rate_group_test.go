@@ -0,0 +1,54 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateRateGroup(t *testing.T) {
+	ts := newTestLogSpy(t)
+	conf := &Config{Level: "debug", DisableTimestamp: true}
+	logger, p, _ := InitTestLogger(ts, conf)
+	ReplaceGlobals(logger, p)
+
+	assert.Contains(t, RateGroups(), RateGroup{Name: GlobalRateGroup, CreditPerSecond: 1, MaxBalance: 60})
+
+	ctx := context.TODO()
+	assert.True(t, Ctx(ctx).WithRateGroup("test.rateGroup", 0.001, 1).RatedInfo(1.0, "info test"))
+	assert.False(t, Ctx(ctx).WithRateGroup("test.rateGroup", 0.001, 1).RatedInfo(1.0, "info test"))
+	assert.Contains(t, RateGroups(), RateGroup{Name: "test.rateGroup", CreditPerSecond: 0.001, MaxBalance: 1})
+
+	// the updated rate is shared by the loggers of the group, and isn't overridden by them
+	assert.NoError(t, UpdateRateGroup(RateGroup{Name: "test.rateGroup", CreditPerSecond: 1000, MaxBalance: 1000}))
+	time.Sleep(10 * time.Millisecond)
+	assert.True(t, Ctx(ctx).WithRateGroup("test.rateGroup", 0.001, 1).RatedInfo(1.0, "info test"))
+	assert.Contains(t, RateGroups(), RateGroup{Name: "test.rateGroup", CreditPerSecond: 1000, MaxBalance: 1000})
+
+	// the group is created before it's used
+	assert.NoError(t, UpdateRateGroup(RateGroup{Name: "test.newRateGroup", CreditPerSecond: 0.001, MaxBalance: 1}))
+	assert.True(t, Ctx(ctx).WithRateGroup("test.newRateGroup", 1000, 1000).RatedInfo(1.0, "info test"))
+	assert.False(t, Ctx(ctx).WithRateGroup("test.newRateGroup", 1000, 1000).RatedInfo(1.0, "info test"))
+
+	assert.Error(t, UpdateRateGroup(RateGroup{CreditPerSecond: 1, MaxBalance: 1}))
+	assert.Error(t, UpdateRateGroup(RateGroup{Name: "test.rateGroup", CreditPerSecond: 0, MaxBalance: 1}))
+	assert.Error(t, UpdateRateGroup(RateGroup{Name: "test.rateGroup", CreditPerSecond: 1, MaxBalance: -1}))
+}
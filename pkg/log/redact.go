@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RedactedValue replaces the values of the redacted fields.
+const RedactedValue = "******"
+
+// DefaultRedactKeys are the keys of the fields always redacted, e.g. the credentials of the object storage.
+var DefaultRedactKeys = []string{
+	"accessKey",
+	"accessKeyID",
+	"secretAccessKey",
+	"secretKey",
+	"sessionToken",
+	"sasToken",
+	"sseKey",
+	"password",
+	"token",
+}
+
+// redactCore replaces the values of the fields whose keys are in the deny-list, the keys are matched
+// case-insensitively. Only the fields themselves are redacted, not the objects or the messages containing the secrets.
+type redactCore struct {
+	zapcore.Core
+	keys map[string]struct{}
+}
+
+// newRedactCore wraps core to redact the fields of DefaultRedactKeys and the extra keys.
+func newRedactCore(core zapcore.Core, extraKeys []string) zapcore.Core {
+	keys := make(map[string]struct{}, len(DefaultRedactKeys)+len(extraKeys))
+	for _, list := range [][]string{DefaultRedactKeys, extraKeys} {
+		for _, key := range list {
+			if key = strings.TrimSpace(key); key != "" {
+				keys[strings.ToLower(key)] = struct{}{}
+			}
+		}
+	}
+	return &redactCore{Core: core, keys: keys}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), keys: c.keys}
+}
+
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(ent, c.redact(fields))
+}
+
+// redact returns the fields with the sensitive ones replaced, the fields are copied only if any is replaced.
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var redacted []zapcore.Field
+	for i, field := range fields {
+		if _, ok := c.keys[strings.ToLower(field.Key)]; !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = make([]zapcore.Field, len(fields))
+			copy(redacted, fields)
+		}
+		redacted[i] = zap.String(field.Key, RedactedValue)
+	}
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"sync"
+)
+
+// TailSize is the number of the latest log lines kept in memory, e.g. for the crash reports.
+const TailSize = 256
+
+// tailWriter keeps the latest lines written by the loggers in a ring, a write is a log entry.
+type tailWriter struct {
+	mu    sync.Mutex
+	lines []string
+	next  int
+	size  int
+}
+
+var globalTail = newTailWriter(TailSize)
+
+func newTailWriter(size int) *tailWriter {
+	return &tailWriter{
+		lines: make([]string, 0, size),
+		size:  size,
+	}
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	line := strings.TrimRight(string(p), "\n")
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.lines) < w.size {
+		w.lines = append(w.lines, line)
+	} else {
+		w.lines[w.next] = line
+		w.next = (w.next + 1) % w.size
+	}
+	return len(p), nil
+}
+
+func (w *tailWriter) Sync() error {
+	return nil
+}
+
+func (w *tailWriter) latest(n int) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lines := make([]string, 0, len(w.lines))
+	lines = append(lines, w.lines[w.next:]...)
+	lines = append(lines, w.lines[:w.next]...)
+	if n > 0 && n < len(lines) {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// Tail returns the latest n lines written by the loggers initialized by InitLogger from the oldest to the newest,
+// at most TailSize lines are kept, all of them are returned if n <= 0.
+func Tail(n int) []string {
+	return globalTail.latest(n)
+}
@@ -0,0 +1,44 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIDExemplarLabel is the exemplar label of the trace id, which is recognized by Grafana to link the trace
+const TraceIDExemplarLabel = "trace_id"
+
+// ObserveWithTrace observes the value, and attaches the trace id of ctx as the exemplar if the trace is sampled,
+// so that a latency spike can be linked to a representative trace. Only the sampled traces are attached,
+// the others are not exported and the exemplars would link to nothing.
+func ObserveWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	ObserveWithSpanContext(trace.SpanContextFromContext(ctx), observer, value)
+}
+
+// ObserveWithSpanContext is the same as ObserveWithTrace, for the callers holding the span context only,
+// e.g. the one extracted from the message properties.
+func ObserveWithSpanContext(sc trace.SpanContext, observer prometheus.Observer, value float64) {
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && sc.IsValid() && sc.IsSampled() {
+		exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{TraceIDExemplarLabel: sc.TraceID().String()})
+		return
+	}
+	observer.Observe(value)
+}
@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveWithTrace(t *testing.T) {
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_exemplar", Buckets: []float64{1, 10}})
+	traceID := trace.TraceID{1, 2, 3}
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}, TraceFlags: trace.FlagsSampled})
+	unsampled := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{4}, SpanID: trace.SpanID{1}})
+
+	ObserveWithTrace(context.Background(), histogram, 0.5)
+	ObserveWithTrace(trace.ContextWithSpanContext(context.Background(), unsampled), histogram, 0.5)
+	ObserveWithTrace(trace.ContextWithRemoteSpanContext(context.Background(), sampled), histogram, 5)
+
+	m := &dto.Metric{}
+	require.NoError(t, histogram.Write(m))
+	buckets := m.GetHistogram().GetBucket()
+	assert.Equal(t, uint64(3), m.GetHistogram().GetSampleCount())
+	assert.Nil(t, buckets[0].GetExemplar())
+	exemplar := buckets[1].GetExemplar()
+	require.NotNil(t, exemplar)
+	assert.Equal(t, 5.0, exemplar.GetValue())
+	assert.Equal(t, TraceIDExemplarLabel, exemplar.GetLabel()[0].GetName())
+	assert.Equal(t, traceID.String(), exemplar.GetLabel()[0].GetValue())
+
+	point := histogramDataPoint(m, 0, 0)
+	require.Len(t, point.GetExemplars(), 1)
+	assert.Equal(t, traceID[:], point.GetExemplars()[0].GetTraceId())
+	assert.Equal(t, 5.0, point.GetExemplars()[0].GetAsDouble())
+	assert.Empty(t, point.GetExemplars()[0].GetFilteredAttributes())
+}
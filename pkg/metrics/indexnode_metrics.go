@@ -32,7 +32,7 @@ var (
 			Subsystem: typeutil.IndexNodeRole,
 			Name:      "index_task_count",
 			Help:      "number of tasks that index node received",
-		}, []string{nodeIDLabelName, statusLabelName})
+		}, []string{nodeIDLabelName, clusterIDLabelName, statusLabelName})
 
 	IndexNodeLoadFieldLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -86,8 +86,49 @@ var (
 			Name:      "index_task_latency_in_queue",
 			Help:      "latency of index task in queue",
 			Buckets:   buckets,
+		}, []string{nodeIDLabelName, clusterIDLabelName})
+
+	IndexNodeTaskQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_queue_depth",
+			Help:      "number of tasks waiting in the queue",
+		}, []string{nodeIDLabelName})
+
+	IndexNodeTaskWaitSLOViolationCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_wait_slo_violation_count",
+			Help:      "number of tasks which waited in the queue longer than the SLO",
+		}, []string{nodeIDLabelName, clusterIDLabelName})
+
+	IndexNodeTaskLocalRetryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "task_local_retry_count",
+			Help:      "number of tasks failed with transient errors and retried locally",
+		}, []string{nodeIDLabelName, clusterIDLabelName})
+
+	IndexNodeTempDirReservedSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "temp_dir_reserved_size",
+			Help:      "local disk size in bytes reserved by the temporary files of the running disk builds",
 		}, []string{nodeIDLabelName})
 
+	IndexNodeBuildPeakRSS = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "build_peak_rss",
+			Help:      "peak rss in MB of index node during the index builds",
+			Buckets:   prometheus.ExponentialBuckets(64, 2, 12), // 64MB to 128GB
+		}, []string{nodeIDLabelName, indexTypeLabelName})
+
 	IndexNodeBuildIndexLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -95,7 +136,7 @@ var (
 			Name:      "build_index_latency",
 			Help:      "latency of build index for segment",
 			Buckets:   indexBucket,
-		}, []string{nodeIDLabelName})
+		}, []string{nodeIDLabelName, clusterIDLabelName})
 )
 
 // RegisterIndexNode registers IndexNode metrics
@@ -108,4 +149,19 @@ func RegisterIndexNode(registry *prometheus.Registry) {
 	registry.MustRegister(IndexNodeSaveIndexFileLatency)
 	registry.MustRegister(IndexNodeIndexTaskLatencyInQueue)
 	registry.MustRegister(IndexNodeBuildIndexLatency)
+	registry.MustRegister(IndexNodeTaskQueueDepth)
+	registry.MustRegister(IndexNodeTaskWaitSLOViolationCounter)
+	registry.MustRegister(IndexNodeTaskLocalRetryCounter)
+	registry.MustRegister(IndexNodeTempDirReservedSize)
+	registry.MustRegister(IndexNodeBuildPeakRSS)
+}
+
+// CleanupIndexNodeClusterIDMetrics removes the task metrics labeled by the clusterID
+func CleanupIndexNodeClusterIDMetrics(clusterID string) {
+	labels := prometheus.Labels{clusterIDLabelName: clusterID}
+	IndexNodeBuildIndexTaskCounter.DeletePartialMatch(labels)
+	IndexNodeIndexTaskLatencyInQueue.DeletePartialMatch(labels)
+	IndexNodeTaskWaitSLOViolationCounter.DeletePartialMatch(labels)
+	IndexNodeTaskLocalRetryCounter.DeletePartialMatch(labels)
+	IndexNodeBuildIndexLatency.DeletePartialMatch(labels)
 }
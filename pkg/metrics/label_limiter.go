@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+)
+
+// OtherLabelValue is the label value of the values beyond the limit of a LabelLimiter.
+const OtherLabelValue = "other"
+
+// trackedFactor is the number of the candidate values tracked per limited value.
+const trackedFactor = 4
+
+// LabelLimiter bounds the cardinality of a label by keeping the top K values by the number of observations,
+// the others share the OtherLabelValue, so that a label of tenants doesn't explode the series.
+// A candidate value replaces the least observed kept value once it's observed more, the series of the
+// replaced value are removed by onEvict. The candidates are tracked up to trackedFactor times the limit.
+type LabelLimiter struct {
+	mu    sync.Mutex
+	limit func() int
+	// onEvict removes the series of a value no longer kept
+	onEvict func(value string)
+	// number of observations by the kept values
+	kept map[string]int64
+	// number of observations by the candidate values
+	candidates map[string]int64
+}
+
+// NewLabelLimiter creates a LabelLimiter with the limit, which is read per observation so that it can be refreshed.
+// onEvict is called with the values no longer kept, outside of the lock of the limiter, it may be nil.
+func NewLabelLimiter(limit func() int, onEvict func(value string)) *LabelLimiter {
+	return &LabelLimiter{
+		limit:      limit,
+		onEvict:    onEvict,
+		kept:       make(map[string]int64),
+		candidates: make(map[string]int64),
+	}
+}
+
+// Value observes the label value, and returns the value to label the metrics with.
+func (l *LabelLimiter) Value(value string) string {
+	limit := l.limit()
+	if limit <= 0 {
+		l.evict(l.clear())
+		return OtherLabelValue
+	}
+	labelValue, evicted := l.observe(value, limit)
+	l.evict(evicted)
+	return labelValue
+}
+
+func (l *LabelLimiter) observe(value string, limit int) (string, []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var evicted []string
+	// the limit is lowered
+	for len(l.kept) > limit {
+		least, _ := l.leastKept()
+		delete(l.kept, least)
+		evicted = append(evicted, least)
+	}
+	if _, ok := l.kept[value]; ok {
+		l.kept[value]++
+		return value, evicted
+	}
+	if len(l.kept) < limit {
+		l.kept[value] = l.candidates[value] + 1
+		delete(l.candidates, value)
+		return value, evicted
+	}
+
+	count, ok := l.candidates[value]
+	if !ok && len(l.candidates) >= limit*trackedFactor {
+		return OtherLabelValue, evicted
+	}
+	count++
+	least, leastCount := l.leastKept()
+	if count <= leastCount {
+		l.candidates[value] = count
+		return OtherLabelValue, evicted
+	}
+	delete(l.kept, least)
+	evicted = append(evicted, least)
+	delete(l.candidates, value)
+	if len(l.candidates) < limit*trackedFactor {
+		l.candidates[least] = leastCount
+	}
+	l.kept[value] = count
+	return value, evicted
+}
+
+// clear drops all the kept values once the label is limited to 0, and returns them
+func (l *LabelLimiter) clear() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	evicted := make([]string, 0, len(l.kept))
+	for v := range l.kept {
+		evicted = append(evicted, v)
+	}
+	l.kept = make(map[string]int64)
+	return evicted
+}
+
+func (l *LabelLimiter) evict(values []string) {
+	if l.onEvict == nil {
+		return
+	}
+	for _, v := range values {
+		l.onEvict(v)
+	}
+}
+
+func (l *LabelLimiter) leastKept() (string, int64) {
+	least, leastCount := "", int64(-1)
+	for v, c := range l.kept {
+		if leastCount < 0 || c < leastCount {
+			least, leastCount = v, c
+		}
+	}
+	return least, leastCount
+}
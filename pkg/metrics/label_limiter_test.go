@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelLimiter(t *testing.T) {
+	limit := 2
+	var evicted []string
+	l := NewLabelLimiter(func() int { return limit }, func(value string) { evicted = append(evicted, value) })
+
+	assert.Equal(t, "a", l.Value("a"))
+	assert.Equal(t, "b", l.Value("b"))
+	assert.Equal(t, "a", l.Value("a"))
+	// beyond the limit
+	assert.Equal(t, OtherLabelValue, l.Value("c"))
+
+	// c replaces b once it's observed more
+	assert.Equal(t, "c", l.Value("c"))
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, OtherLabelValue, l.Value("b"))
+	assert.Equal(t, "a", l.Value("a"))
+
+	// the candidates are tracked up to trackedFactor times the limit
+	for i := 0; i < limit*trackedFactor; i++ {
+		l.Value(fmt.Sprint(i))
+	}
+	assert.LessOrEqual(t, len(l.candidates), limit*trackedFactor)
+
+	// the least observed values are dropped once the limit is lowered
+	limit = 1
+	assert.Equal(t, "a", l.Value("a"))
+	assert.Len(t, l.kept, 1)
+	assert.Equal(t, []string{"b", "c"}, evicted)
+
+	limit = 0
+	assert.Equal(t, OtherLabelValue, l.Value("a"))
+	assert.Equal(t, []string{"b", "c", "a"}, evicted)
+	assert.Len(t, l.kept, 0)
+}
+
+func TestCleanupIndexNodeClusterIDMetrics(t *testing.T) {
+	IndexNodeBuildIndexTaskCounter.WithLabelValues("1", "cluster-evicted", SuccessLabel).Inc()
+	IndexNodeIndexTaskLatencyInQueue.WithLabelValues("1", "cluster-evicted").Observe(1)
+	IndexNodeIndexTaskLatencyInQueue.WithLabelValues("1", "cluster-kept").Observe(1)
+
+	CleanupIndexNodeClusterIDMetrics("cluster-evicted")
+	assert.False(t, IndexNodeBuildIndexTaskCounter.DeleteLabelValues("1", "cluster-evicted", SuccessLabel))
+	assert.False(t, IndexNodeIndexTaskLatencyInQueue.DeleteLabelValues("1", "cluster-evicted"))
+	assert.True(t, IndexNodeIndexTaskLatencyInQueue.DeleteLabelValues("1", "cluster-kept"))
+}
@@ -61,10 +61,17 @@ const (
 	HookAfter  = "after"
 	HookMock   = "mock"
 
+	DeadlineTooShortLabel = "deadline_too_short"
+	TimeoutLabel          = "timeout"
+
+	RateLimitAllowedLabel  = "allowed"
+	RateLimitRejectedLabel = "rejected"
+
 	ReduceSegments = "segments"
 	ReduceShards   = "shards"
 
 	nodeIDLabelName          = "node_id"
+	clusterIDLabelName       = "cluster_id"
 	statusLabelName          = "status"
 	indexTaskStatusLabelName = "index_task_status"
 	msgTypeLabelName         = "msg_type"
@@ -81,6 +88,7 @@ const (
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
 	indexCountLabelName      = "indexed_field_count"
+	indexTypeLabelName       = "index_type"
 	requestScope             = "scope"
 	fullMethodLabelName      = "full_method"
 	reduceLevelName          = "reduce_level"
@@ -88,6 +96,12 @@ const (
 	lockSource               = "lock_source"
 	lockType                 = "lock_type"
 	lockOp                   = "lock_op"
+	featureFlagLabelName     = "feature_flag"
+	enabledLabelName         = "enabled"
+	componentStateLabelName  = "component_state"
+	deadlineReasonLabelName  = "reason"
+	rateLimiterLabelName     = "rate_limiter"
+	rateLimitLevelLabelName  = "level"
 )
 
 var (
@@ -114,6 +128,58 @@ var (
 			lockOp,
 		})
 
+	// FeatureFlagEvaluations counts the evaluations of the feature flags by the result
+	FeatureFlagEvaluations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Name:      "feature_flag_evaluation_count",
+			Help:      "count of the evaluations of the feature flags",
+		}, []string{featureFlagLabelName, enabledLabelName})
+
+	// ComponentStateDuration records the seconds the components spent in the lifetime states, observed on leaving the states
+	ComponentStateDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Name:      "component_state_duration_seconds",
+			Help:      "seconds the components spent in the lifetime states",
+			// [1 4 16 64 256 1024 4096 16384 65536 2.62144e+05 1.048576e+06 4.194304e+06]
+			Buckets: prometheus.ExponentialBuckets(1, 4, 12),
+		}, []string{roleNameLabelName, componentStateLabelName})
+
+	// ComponentStateSince is the unix time the components entered their current lifetime states
+	ComponentStateSince = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Name:      "component_state_since",
+			Help:      "unix time the components entered their current lifetime states",
+		}, []string{roleNameLabelName, componentStateLabelName})
+
+	// GRPCDeadlineEnforcements counts the rpcs rejected by their deadlines or timed out by the server
+	GRPCDeadlineEnforcements = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Name:      "grpc_deadline_enforcement_count",
+			Help:      "count of the rpcs rejected by their deadlines or timed out by the server",
+		}, []string{fullMethodLabelName, deadlineReasonLabelName})
+
+	// RateLimiterEvents counts the events of the hierarchical rate limiters, an allowed event is counted by the deepest
+	// level of its buckets, and a rejected one by the level of the bucket rejecting it
+	RateLimiterEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Name:      "rate_limiter_event_count",
+			Help:      "count of the events allowed or rejected by the hierarchical rate limiters",
+		}, []string{rateLimiterLabelName, rateLimitLevelLabelName, statusLabelName})
+
+	// RateLimiterLimit is the refill rate per second of the buckets of the levels of the hierarchical rate limiters,
+	// -1 means unlimited
+	RateLimiterLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Name:      "rate_limiter_limit",
+			Help:      "refill rate per second of the buckets of the levels of the hierarchical rate limiters",
+		}, []string{rateLimiterLabelName, rateLimitLevelLabelName})
+
 	metricRegisterer prometheus.Registerer
 )
 
@@ -131,5 +197,11 @@ func GetRegisterer() prometheus.Registerer {
 func Register(r prometheus.Registerer) {
 	r.MustRegister(NumNodes)
 	r.MustRegister(LockCosts)
+	r.MustRegister(FeatureFlagEvaluations)
+	r.MustRegister(ComponentStateDuration)
+	r.MustRegister(ComponentStateSince)
+	r.MustRegister(GRPCDeadlineEnforcements)
+	r.MustRegister(RateLimiterEvents)
+	r.MustRegister(RateLimiterLimit)
 	metricRegisterer = r
 }
@@ -24,6 +24,8 @@ const (
 
 	CreateProducerLabel = "create_producer"
 	CreateConsumerLabel = "create_consumer"
+	DeadLetterLabel     = "dead_letter"
+	MirrorProduceLabel  = "mirror_produce"
 
 	msgStreamOpType = "message_op_type"
 )
@@ -56,6 +58,22 @@ var (
 			Name:      "op_count",
 			Help:      "count of stream message operation",
 		}, []string{msgStreamOpType, statusLabelName})
+
+	MsgStreamConsumerLagMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "consumer_lag_messages",
+			Help:      "number of messages between the consumer position and the latest message",
+		}, []string{channelNameLabelName})
+
+	MsgStreamConsumerLagSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "consumer_lag_seconds",
+			Help:      "seconds since the consumer caught up with the latest message last time",
+		}, []string{channelNameLabelName})
 )
 
 // RegisterMsgStreamMetrics registers msg stream metrics
@@ -63,4 +81,6 @@ func RegisterMsgStreamMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(NumConsumers)
 	registry.MustRegister(MsgStreamRequestLatency)
 	registry.MustRegister(MsgStreamOpCounter)
+	registry.MustRegister(MsgStreamConsumerLagMessages)
+	registry.MustRegister(MsgStreamConsumerLagSeconds)
 }
@@ -0,0 +1,314 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/trace"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	otlpcommonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	otlpmetricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	otlpresourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const otlpScopeName = "github.com/milvus-io/milvus/pkg/metrics"
+
+// OTLPExporterConfig is the config of OTLPExporter.
+type OTLPExporterConfig struct {
+	// Endpoint is the address of the OpenTelemetry collector, e.g. 127.0.0.1:4317
+	Endpoint string
+	Insecure bool
+	Interval time.Duration
+	Timeout  time.Duration
+	// ServiceName and NodeID are reported as the resource attributes
+	ServiceName string
+	NodeID      int64
+}
+
+// OTLPExporter periodically pushes the metrics gathered from the prometheus registry to an OpenTelemetry
+// collector by OTLP/gRPC, so the metrics can be received without scraping the prometheus endpoint.
+type OTLPExporter struct {
+	cfg       OTLPExporterConfig
+	gatherer  prometheus.Gatherer
+	conn      *grpc.ClientConn
+	client    colmetricspb.MetricsServiceClient
+	resource  *otlpresourcepb.Resource
+	startTime time.Time
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewOTLPExporter creates an OTLPExporter pushing the metrics of the gatherer, Start must be called to push them.
+func NewOTLPExporter(gatherer prometheus.Gatherer, cfg OTLPExporterConfig) (*OTLPExporter, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("empty otlp metrics endpoint")
+	}
+	if cfg.Interval <= 0 {
+		return nil, errors.Newf("invalid otlp metrics export interval %s", cfg.Interval)
+	}
+	creds := insecure.NewCredentials()
+	if !cfg.Insecure {
+		creds = credentials.NewClientTLSFromCert(nil, "")
+	}
+	conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &OTLPExporter{
+		cfg:      cfg,
+		gatherer: gatherer,
+		conn:     conn,
+		client:   colmetricspb.NewMetricsServiceClient(conn),
+		resource: &otlpresourcepb.Resource{
+			Attributes: []*otlpcommonpb.KeyValue{
+				stringAttribute("service.name", cfg.ServiceName),
+				stringAttribute("NodeID", strconv.FormatInt(cfg.NodeID, 10)),
+			},
+		},
+		startTime: time.Now(),
+		closeCh:   make(chan struct{}),
+	}, nil
+}
+
+// Start starts pushing the metrics every interval.
+func (e *OTLPExporter) Start() {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-e.closeCh:
+				return
+			case <-ticker.C:
+				if err := e.Export(context.Background()); err != nil {
+					log.RatedWarn(60, "failed to export metrics by otlp",
+						zap.String("endpoint", e.cfg.Endpoint), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops pushing and closes the connection after pushing the metrics for the last time.
+func (e *OTLPExporter) Stop() {
+	e.closeOnce.Do(func() {
+		close(e.closeCh)
+		e.wg.Wait()
+		if err := e.Export(context.Background()); err != nil {
+			log.Warn("failed to export metrics by otlp before stopping", zap.Error(err))
+		}
+		e.conn.Close()
+	})
+}
+
+// Export gathers the metrics and pushes them once.
+func (e *OTLPExporter) Export(ctx context.Context) error {
+	families, err := e.gatherer.Gather()
+	if err != nil {
+		// the gatherer returns the metrics gathered successfully along with the error
+		log.RatedWarn(60, "failed to gather some metrics for otlp", zap.Error(err))
+	}
+	if len(families) == 0 {
+		return nil
+	}
+	if e.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.cfg.Timeout)
+		defer cancel()
+	}
+	_, err = e.client.Export(ctx, &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*otlpmetricspb.ResourceMetrics{{
+			Resource: e.resource,
+			ScopeMetrics: []*otlpmetricspb.ScopeMetrics{{
+				Scope:   &otlpcommonpb.InstrumentationScope{Name: otlpScopeName},
+				Metrics: convertMetricFamilies(families, e.startTime, time.Now()),
+			}},
+		}},
+	})
+	return err
+}
+
+// convertMetricFamilies converts the prometheus metrics to the otlp ones, all of them are cumulative since start.
+func convertMetricFamilies(families []*dto.MetricFamily, start, now time.Time) []*otlpmetricspb.Metric {
+	startNano, nowNano := uint64(start.UnixNano()), uint64(now.UnixNano())
+	ret := make([]*otlpmetricspb.Metric, 0, len(families))
+	for _, family := range families {
+		metric := &otlpmetricspb.Metric{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+		}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			points := make([]*otlpmetricspb.NumberDataPoint, 0, len(family.GetMetric()))
+			for _, m := range family.GetMetric() {
+				points = append(points, numberDataPoint(m, m.GetCounter().GetValue(), startNano, nowNano))
+			}
+			metric.Data = &otlpmetricspb.Metric_Sum{Sum: &otlpmetricspb.Sum{
+				DataPoints:             points,
+				AggregationTemporality: otlpmetricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			points := make([]*otlpmetricspb.NumberDataPoint, 0, len(family.GetMetric()))
+			for _, m := range family.GetMetric() {
+				value := m.GetGauge().GetValue()
+				if family.GetType() == dto.MetricType_UNTYPED {
+					value = m.GetUntyped().GetValue()
+				}
+				points = append(points, numberDataPoint(m, value, startNano, nowNano))
+			}
+			metric.Data = &otlpmetricspb.Metric_Gauge{Gauge: &otlpmetricspb.Gauge{DataPoints: points}}
+		case dto.MetricType_HISTOGRAM:
+			points := make([]*otlpmetricspb.HistogramDataPoint, 0, len(family.GetMetric()))
+			for _, m := range family.GetMetric() {
+				points = append(points, histogramDataPoint(m, startNano, nowNano))
+			}
+			metric.Data = &otlpmetricspb.Metric_Histogram{Histogram: &otlpmetricspb.Histogram{
+				DataPoints:             points,
+				AggregationTemporality: otlpmetricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			}}
+		case dto.MetricType_SUMMARY:
+			points := make([]*otlpmetricspb.SummaryDataPoint, 0, len(family.GetMetric()))
+			for _, m := range family.GetMetric() {
+				points = append(points, summaryDataPoint(m, startNano, nowNano))
+			}
+			metric.Data = &otlpmetricspb.Metric_Summary{Summary: &otlpmetricspb.Summary{DataPoints: points}}
+		default:
+			continue
+		}
+		ret = append(ret, metric)
+	}
+	return ret
+}
+
+func numberDataPoint(m *dto.Metric, value float64, startNano, nowNano uint64) *otlpmetricspb.NumberDataPoint {
+	return &otlpmetricspb.NumberDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Value:             &otlpmetricspb.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// histogramDataPoint converts the cumulative prometheus buckets to the otlp bucket counts,
+// which have one more bucket than the explicit bounds for the values above the last bound.
+func histogramDataPoint(m *dto.Metric, startNano, nowNano uint64) *otlpmetricspb.HistogramDataPoint {
+	h := m.GetHistogram()
+	bounds := make([]float64, 0, len(h.GetBucket()))
+	counts := make([]uint64, 0, len(h.GetBucket())+1)
+	var prev uint64
+	var exemplars []*otlpmetricspb.Exemplar
+	for _, bucket := range h.GetBucket() {
+		if exemplar := convertExemplar(bucket.GetExemplar()); exemplar != nil {
+			exemplars = append(exemplars, exemplar)
+		}
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			continue
+		}
+		bounds = append(bounds, bucket.GetUpperBound())
+		counts = append(counts, bucket.GetCumulativeCount()-prev)
+		prev = bucket.GetCumulativeCount()
+	}
+	counts = append(counts, h.GetSampleCount()-prev)
+	sum := h.GetSampleSum()
+	return &otlpmetricspb.HistogramDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+		Exemplars:         exemplars,
+	}
+}
+
+// convertExemplar converts the prometheus exemplar, the trace id label becomes the trace id of the otlp exemplar
+// and the other labels are kept as the filtered attributes.
+func convertExemplar(e *dto.Exemplar) *otlpmetricspb.Exemplar {
+	if e == nil {
+		return nil
+	}
+	exemplar := &otlpmetricspb.Exemplar{
+		Value: &otlpmetricspb.Exemplar_AsDouble{AsDouble: e.GetValue()},
+	}
+	if e.GetTimestamp() != nil {
+		exemplar.TimeUnixNano = uint64(e.GetTimestamp().AsTime().UnixNano())
+	}
+	for _, label := range e.GetLabel() {
+		if label.GetName() == TraceIDExemplarLabel {
+			if traceID, err := trace.TraceIDFromHex(label.GetValue()); err == nil {
+				exemplar.TraceId = traceID[:]
+				continue
+			}
+		}
+		exemplar.FilteredAttributes = append(exemplar.FilteredAttributes, stringAttribute(label.GetName(), label.GetValue()))
+	}
+	return exemplar
+}
+
+func summaryDataPoint(m *dto.Metric, startNano, nowNano uint64) *otlpmetricspb.SummaryDataPoint {
+	s := m.GetSummary()
+	quantiles := make([]*otlpmetricspb.SummaryDataPoint_ValueAtQuantile, 0, len(s.GetQuantile()))
+	for _, q := range s.GetQuantile() {
+		quantiles = append(quantiles, &otlpmetricspb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		})
+	}
+	return &otlpmetricspb.SummaryDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Count:             s.GetSampleCount(),
+		Sum:               s.GetSampleSum(),
+		QuantileValues:    quantiles,
+	}
+}
+
+func labelAttributes(labels []*dto.LabelPair) []*otlpcommonpb.KeyValue {
+	attrs := make([]*otlpcommonpb.KeyValue, 0, len(labels))
+	for _, label := range labels {
+		attrs = append(attrs, stringAttribute(label.GetName(), label.GetValue()))
+	}
+	return attrs
+}
+
+func stringAttribute(key, value string) *otlpcommonpb.KeyValue {
+	return &otlpcommonpb.KeyValue{
+		Key:   key,
+		Value: &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
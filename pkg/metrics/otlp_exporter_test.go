@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	otlpmetricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+)
+
+type mockMetricsService struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	mu       sync.Mutex
+	requests []*colmetricspb.ExportMetricsServiceRequest
+}
+
+func (s *mockMetricsService) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func (s *mockMetricsService) received() []*colmetricspb.ExportMetricsServiceRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestOTLPExporter(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	service := &mockMetricsService{}
+	server := grpc.NewServer()
+	colmetricspb.RegisterMetricsServiceServer(server, service)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	r := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_counter", Help: "counter"}, []string{"topic"})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_histogram", Buckets: []float64{1, 10}})
+	r.MustRegister(counter, histogram)
+	counter.WithLabelValues("t1").Add(3)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+	histogram.Observe(100)
+
+	_, err = NewOTLPExporter(r, OTLPExporterConfig{Interval: time.Second})
+	assert.Error(t, err)
+	_, err = NewOTLPExporter(r, OTLPExporterConfig{Endpoint: lis.Addr().String()})
+	assert.Error(t, err)
+
+	exporter, err := NewOTLPExporter(r, OTLPExporterConfig{
+		Endpoint:    lis.Addr().String(),
+		Insecure:    true,
+		Interval:    10 * time.Millisecond,
+		Timeout:     time.Second,
+		ServiceName: "indexnode",
+		NodeID:      1,
+	})
+	require.NoError(t, err)
+	exporter.Start()
+	assert.Eventually(t, func() bool {
+		return len(service.received()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	exporter.Stop()
+
+	req := service.received()[0]
+	require.Len(t, req.GetResourceMetrics(), 1)
+	rm := req.GetResourceMetrics()[0]
+	assert.Equal(t, "service.name", rm.GetResource().GetAttributes()[0].GetKey())
+	assert.Equal(t, "indexnode", rm.GetResource().GetAttributes()[0].GetValue().GetStringValue())
+
+	metrics := make(map[string]*otlpmetricspb.Metric)
+	for _, m := range rm.GetScopeMetrics()[0].GetMetrics() {
+		metrics[m.GetName()] = m
+	}
+	require.Contains(t, metrics, "test_counter")
+	sum := metrics["test_counter"].GetSum()
+	assert.True(t, sum.GetIsMonotonic())
+	require.Len(t, sum.GetDataPoints(), 1)
+	assert.Equal(t, float64(3), sum.GetDataPoints()[0].GetAsDouble())
+	assert.Equal(t, "topic", sum.GetDataPoints()[0].GetAttributes()[0].GetKey())
+	assert.Equal(t, "t1", sum.GetDataPoints()[0].GetAttributes()[0].GetValue().GetStringValue())
+
+	require.Contains(t, metrics, "test_histogram")
+	point := metrics["test_histogram"].GetHistogram().GetDataPoints()[0]
+	assert.Equal(t, uint64(3), point.GetCount())
+	assert.Equal(t, 105.5, point.GetSum())
+	assert.Equal(t, []float64{1, 10}, point.GetExplicitBounds())
+	assert.Equal(t, []uint64{1, 1, 1}, point.GetBucketCounts())
+}
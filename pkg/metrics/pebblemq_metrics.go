@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	DiskRetentionLabel = "emergency_retention"
+	DiskRejectLabel    = "reject_produce"
+	DiskRecoverLabel   = "recover"
+
+	diskEventLabelName = "disk_event"
+
+	PebbleKVGetLabel    = "get"
+	PebbleKVScanLabel   = "scan"
+	PebbleKVPutLabel    = "put"
+	PebbleKVRemoveLabel = "remove"
+	PebbleKVTxnLabel    = "txn"
+
+	ProduceDelayedLabel  = "delayed"
+	ProduceRejectedLabel = "rejected"
+
+	pebbleKVOpType = "pebblekv_op_type"
+
+	pebbleDBLabelName = "pebble_db"
+
+	scrubIssueLabelName = "scrub_issue"
+
+	ProduceLockStage   = "lock"
+	ProduceAllocStage  = "alloc"
+	ProduceCommitStage = "commit"
+	ProduceTotalStage  = "total"
+
+	topicLabelName        = "topic"
+	produceStageLabelName = "produce_stage"
+)
+
+var (
+	// pebbleKVOpBuckets involves durations in seconds, most kv operations finish well within a millisecond,
+	// [1e-05 2e-05 4e-05 ... 2.62144 5.24288]
+	pebbleKVOpBuckets = prometheus.ExponentialBuckets(0.00001, 2, 20)
+
+	PebblemqDiskUsedSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "disk_used_size",
+			Help:      "disk space used by pebblemq data in bytes",
+		})
+
+	PebblemqDiskFreeRatio = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "disk_free_ratio",
+			Help:      "free ratio of the disk where pebblemq path located",
+		})
+
+	PebblemqDiskEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "disk_event_count",
+			Help:      "count of events triggered by pebblemq disk watchdog",
+		}, []string{diskEventLabelName})
+
+	PebbleKVOpLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "kv_op_latency",
+			Help:      "latency of pebble kv operations in seconds",
+			Buckets:   pebbleKVOpBuckets,
+		}, []string{pebbleKVOpType})
+
+	PebbleKVOpCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "kv_op_count",
+			Help:      "count of pebble kv operations",
+		}, []string{pebbleKVOpType, statusLabelName})
+
+	PebbleKVCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "kv_cache_count",
+			Help:      "count of pebble kv read cache hits and misses",
+		}, []string{cacheStateLabelName})
+
+	PebbleWriteStallGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "write_stalled",
+			Help:      "whether the writes to pebble are stalled, 1 means stalled",
+		}, []string{pebbleDBLabelName})
+
+	PebbleWriteStallCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "write_stall_count",
+			Help:      "count of pebble write stalls",
+		}, []string{pebbleDBLabelName})
+
+	PebbleL0Pressure = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "l0_pressure",
+			Help:      "L0 read amplification of pebble relative to the threshold stopping the writes",
+		}, []string{pebbleDBLabelName})
+
+	PebblemqThrottledProduceCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "throttled_produce_count",
+			Help:      "count of pebblemq produce requests delayed or rejected by write stall",
+		}, []string{statusLabelName})
+
+	PebblemqProduceLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "produce_latency",
+			Help:      "latency of pebblemq produce per topic in milliseconds, the duration of each stage and of the whole produce",
+			Buckets:   buckets,
+		}, []string{topicLabelName, produceStageLabelName})
+
+	PebblemqProduceBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "produce_bytes",
+			Help:      "payload bytes of the messages committed to pebblemq per topic",
+		}, []string{topicLabelName})
+
+	PebblemqProduceMsgCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "produce_msg_count",
+			Help:      "count of the messages committed to pebblemq per topic",
+		}, []string{topicLabelName})
+
+	PebblemqScrubIssueCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "pebblemq",
+			Name:      "scrub_issue_count",
+			Help:      "count of the data inconsistencies found by pebblemq scrub",
+		}, []string{scrubIssueLabelName})
+)
+
+// RegisterPebblemqMetrics registers pebblemq metrics
+func RegisterPebblemqMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(PebblemqDiskUsedSize)
+	registry.MustRegister(PebblemqDiskFreeRatio)
+	registry.MustRegister(PebblemqDiskEventCounter)
+	registry.MustRegister(PebbleKVOpLatency)
+	registry.MustRegister(PebbleKVOpCounter)
+	registry.MustRegister(PebbleKVCacheCounter)
+	registry.MustRegister(PebbleWriteStallGauge)
+	registry.MustRegister(PebbleWriteStallCounter)
+	registry.MustRegister(PebbleL0Pressure)
+	registry.MustRegister(PebblemqThrottledProduceCounter)
+	registry.MustRegister(PebblemqScrubIssueCounter)
+	registry.MustRegister(PebblemqProduceLatency)
+	registry.MustRegister(PebblemqProduceBytes)
+	registry.MustRegister(PebblemqProduceMsgCounter)
+}
+
+// CleanupPebblemqTopicMetrics removes the produce metrics of the destroyed topic
+func CleanupPebblemqTopicMetrics(topicName string) {
+	PebblemqProduceLatency.DeletePartialMatch(prometheus.Labels{topicLabelName: topicName})
+	PebblemqProduceBytes.Delete(prometheus.Labels{topicLabelName: topicName})
+	PebblemqProduceMsgCounter.Delete(prometheus.Labels{topicLabelName: topicName})
+}
@@ -54,6 +54,46 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	PersistentDataCorruptionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "corruption_count",
+			Help:      "count of the objects read with mismatched checksum",
+		})
+
+	PersistentDataCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_count",
+			Help:      "count of the requests hit or missed the local disk cache",
+		}, []string{persistentDataOpType, cacheStateLabelName})
+
+	PersistentDataCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_size",
+			Help:      "size of the objects in the local disk cache",
+		})
+
+	PersistentDataCachePendingSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_pending_size",
+			Help:      "size of the objects written back to the local disk cache but not flushed yet",
+		})
+
+	PersistentDataCacheEvictionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_eviction_count",
+			Help:      "count of the objects evicted from the local disk cache",
+		})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +101,9 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataCorruptionCounter)
+	registry.MustRegister(PersistentDataCacheCounter)
+	registry.MustRegister(PersistentDataCacheSize)
+	registry.MustRegister(PersistentDataCachePendingSize)
+	registry.MustRegister(PersistentDataCacheEvictionCounter)
 }
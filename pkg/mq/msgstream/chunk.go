@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// chunkIDKey, chunkIndexKey and chunkNumKey are the properties attached to each chunk of a large message
+	chunkIDKey    = "_chunk_id"
+	chunkIndexKey = "_chunk_index"
+	chunkNumKey   = "_chunk_num"
+
+	// maxPendingChunkedMessages is the max number of partially received messages kept by a chunkAssembler
+	maxPendingChunkedMessages = 16
+)
+
+var (
+	chunkIDPrefix = fmt.Sprintf("%d-", time.Now().UnixNano())
+	chunkIDSeq    int64
+)
+
+func nextChunkID() string {
+	return chunkIDPrefix + strconv.FormatInt(paramtable.GetNodeID(), 10) + "-" + strconv.FormatInt(atomic.AddInt64(&chunkIDSeq, 1), 10)
+}
+
+// splitMessage splits the message into chunks if its payload is larger than chunkSize,
+// each chunk carries the properties of the original message and its position in the message.
+// The message is returned as is if chunking is disabled or not needed.
+func splitMessage(msg *mqwrapper.ProducerMessage, chunkSize int) []*mqwrapper.ProducerMessage {
+	if chunkSize <= 0 || len(msg.Payload) <= chunkSize {
+		return []*mqwrapper.ProducerMessage{msg}
+	}
+	chunkNum := (len(msg.Payload) + chunkSize - 1) / chunkSize
+	chunkID := nextChunkID()
+	chunks := make([]*mqwrapper.ProducerMessage, 0, chunkNum)
+	for i := 0; i < chunkNum; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(msg.Payload) {
+			end = len(msg.Payload)
+		}
+		properties := make(map[string]string, len(msg.Properties)+3)
+		for k, v := range msg.Properties {
+			properties[k] = v
+		}
+		properties[chunkIDKey] = chunkID
+		properties[chunkIndexKey] = strconv.Itoa(i)
+		properties[chunkNumKey] = strconv.Itoa(chunkNum)
+		chunks = append(chunks, &mqwrapper.ProducerMessage{
+			Payload:    msg.Payload[i*chunkSize : end],
+			Properties: properties,
+		})
+	}
+	return chunks
+}
+
+// chunkedMessage is a message reassembled from its chunks
+type chunkedMessage struct {
+	topic      string
+	properties map[string]string
+	payload    []byte
+	id         mqwrapper.MessageID
+}
+
+var _ mqwrapper.Message = (*chunkedMessage)(nil)
+
+func (m *chunkedMessage) Topic() string {
+	return m.topic
+}
+
+func (m *chunkedMessage) Properties() map[string]string {
+	return m.properties
+}
+
+func (m *chunkedMessage) Payload() []byte {
+	return m.payload
+}
+
+// ID returns the id of the first chunk, so seeking to it replays the whole message
+func (m *chunkedMessage) ID() mqwrapper.MessageID {
+	return m.id
+}
+
+// pendingMessage is a chunked message not received completely
+type pendingMessage struct {
+	first    mqwrapper.Message
+	chunkNum int
+	next     int
+	payload  []byte
+}
+
+// chunkAssembler reassembles the chunks received from one consumer,
+// it's not thread safe and should be used by the goroutine consuming the messages.
+type chunkAssembler struct {
+	pending map[string]*pendingMessage
+	// order is the chunk ids of pending messages in receiving order, used to evict the oldest one
+	order []string
+}
+
+func newChunkAssembler() *chunkAssembler {
+	return &chunkAssembler{
+		pending: make(map[string]*pendingMessage),
+	}
+}
+
+// assemble returns the complete message if msg is not a chunk or is the last chunk of a message,
+// otherwise the chunk is cached and false is returned.
+// Chunks of a message are expected in order, a message with missing chunks is dropped.
+func (a *chunkAssembler) assemble(msg mqwrapper.Message) (mqwrapper.Message, bool) {
+	properties := msg.Properties()
+	chunkID, ok := properties[chunkIDKey]
+	if !ok {
+		return msg, true
+	}
+	index, err1 := strconv.Atoi(properties[chunkIndexKey])
+	chunkNum, err2 := strconv.Atoi(properties[chunkNumKey])
+	if err1 != nil || err2 != nil || index < 0 || index >= chunkNum {
+		return nil, false
+	}
+
+	if index == 0 {
+		// restart the message if the first chunk is redelivered
+		a.remove(chunkID)
+		a.add(chunkID, &pendingMessage{first: msg, chunkNum: chunkNum})
+	}
+	pm, ok := a.pending[chunkID]
+	if !ok {
+		// chunks before this one are lost, e.g. seek to the middle of a chunked message
+		return nil, false
+	}
+	if pm.next != index || pm.chunkNum != chunkNum {
+		a.remove(chunkID)
+		return nil, false
+	}
+	pm.next++
+	pm.payload = append(pm.payload, msg.Payload()...)
+
+	if index < chunkNum-1 {
+		return nil, false
+	}
+	a.remove(chunkID)
+
+	originProperties := make(map[string]string, len(properties))
+	for k, v := range pm.first.Properties() {
+		if k != chunkIDKey && k != chunkIndexKey && k != chunkNumKey {
+			originProperties[k] = v
+		}
+	}
+	return &chunkedMessage{
+		topic:      pm.first.Topic(),
+		properties: originProperties,
+		payload:    pm.payload,
+		id:         pm.first.ID(),
+	}, true
+}
+
+func (a *chunkAssembler) add(chunkID string, pm *pendingMessage) {
+	if len(a.order) >= maxPendingChunkedMessages {
+		delete(a.pending, a.order[0])
+		a.order = a.order[1:]
+	}
+	a.pending[chunkID] = pm
+	a.order = append(a.order, chunkID)
+}
+
+func (a *chunkAssembler) remove(chunkID string) {
+	delete(a.pending, chunkID)
+	for i, id := range a.order {
+		if id == chunkID {
+			a.order = append(a.order[:i], a.order[i+1:]...)
+			return
+		}
+	}
+}
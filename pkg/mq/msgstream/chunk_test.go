@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+type chunkTestMessage struct {
+	*mqwrapper.ProducerMessage
+	id mqwrapper.MessageID
+}
+
+func (m *chunkTestMessage) Topic() string                 { return "topic" }
+func (m *chunkTestMessage) Properties() map[string]string { return m.ProducerMessage.Properties }
+func (m *chunkTestMessage) Payload() []byte               { return m.ProducerMessage.Payload }
+func (m *chunkTestMessage) ID() mqwrapper.MessageID       { return m.id }
+
+type chunkTestID int
+
+func (id chunkTestID) Serialize() []byte                          { return []byte{byte(id)} }
+func (id chunkTestID) AtEarliestPosition() bool                   { return id == 0 }
+func (id chunkTestID) LessOrEqualThan(msgID []byte) (bool, error) { return byte(id) <= msgID[0], nil }
+func (id chunkTestID) Equal(msgID []byte) (bool, error)           { return byte(id) == msgID[0], nil }
+
+func toChunkTestMessages(chunks []*mqwrapper.ProducerMessage, firstID int) []mqwrapper.Message {
+	msgs := make([]mqwrapper.Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		msgs = append(msgs, &chunkTestMessage{ProducerMessage: chunk, id: chunkTestID(firstID + i)})
+	}
+	return msgs
+}
+
+func TestSplitMessage(t *testing.T) {
+	payload := bytes.Repeat([]byte("a"), 10)
+	msg := &mqwrapper.ProducerMessage{Payload: payload, Properties: map[string]string{"key": "value"}}
+
+	assert.Equal(t, []*mqwrapper.ProducerMessage{msg}, splitMessage(msg, 0))
+	assert.Equal(t, []*mqwrapper.ProducerMessage{msg}, splitMessage(msg, 10))
+
+	chunks := splitMessage(msg, 3)
+	assert.Equal(t, 4, len(chunks))
+	chunkID := chunks[0].Properties[chunkIDKey]
+	assert.NotEmpty(t, chunkID)
+	for i, chunk := range chunks {
+		assert.Equal(t, "value", chunk.Properties["key"])
+		assert.Equal(t, chunkID, chunk.Properties[chunkIDKey])
+		assert.Equal(t, "4", chunk.Properties[chunkNumKey])
+		assert.Equal(t, strconv.Itoa(i), chunk.Properties[chunkIndexKey])
+	}
+	assert.Equal(t, 1, len(chunks[3].Payload))
+	assert.NotContains(t, msg.Properties, chunkIDKey)
+
+	assert.NotEqual(t, chunkID, splitMessage(msg, 3)[0].Properties[chunkIDKey])
+}
+
+func TestChunkAssembler(t *testing.T) {
+	payload := []byte("0123456789")
+	msg := &mqwrapper.ProducerMessage{Payload: payload, Properties: map[string]string{"key": "value"}}
+
+	t.Run("not chunked", func(t *testing.T) {
+		a := newChunkAssembler()
+		origin := &chunkTestMessage{ProducerMessage: msg, id: chunkTestID(1)}
+		res, ok := a.assemble(origin)
+		assert.True(t, ok)
+		assert.Equal(t, origin, res)
+	})
+
+	t.Run("assemble", func(t *testing.T) {
+		a := newChunkAssembler()
+		msgs := toChunkTestMessages(splitMessage(msg, 4), 1)
+		for _, m := range msgs[:len(msgs)-1] {
+			_, ok := a.assemble(m)
+			assert.False(t, ok)
+		}
+		res, ok := a.assemble(msgs[len(msgs)-1])
+		assert.True(t, ok)
+		assert.Equal(t, payload, res.Payload())
+		assert.Equal(t, map[string]string{"key": "value"}, res.Properties())
+		assert.Equal(t, chunkTestID(1), res.ID())
+		assert.Equal(t, "topic", res.Topic())
+		assert.Empty(t, a.pending)
+		assert.Empty(t, a.order)
+	})
+
+	t.Run("interleaved", func(t *testing.T) {
+		a := newChunkAssembler()
+		msgs1 := toChunkTestMessages(splitMessage(msg, 5), 1)
+		msgs2 := toChunkTestMessages(splitMessage(msg, 5), 3)
+		_, ok := a.assemble(msgs1[0])
+		assert.False(t, ok)
+		_, ok = a.assemble(msgs2[0])
+		assert.False(t, ok)
+		res, ok := a.assemble(msgs2[1])
+		assert.True(t, ok)
+		assert.Equal(t, chunkTestID(3), res.ID())
+		res, ok = a.assemble(msgs1[1])
+		assert.True(t, ok)
+		assert.Equal(t, chunkTestID(1), res.ID())
+	})
+
+	t.Run("missing chunks", func(t *testing.T) {
+		a := newChunkAssembler()
+		msgs := toChunkTestMessages(splitMessage(msg, 4), 1)
+		// seek to the middle of the message
+		_, ok := a.assemble(msgs[1])
+		assert.False(t, ok)
+		_, ok = a.assemble(msgs[2])
+		assert.False(t, ok)
+		assert.Empty(t, a.pending)
+
+		// chunk lost
+		_, ok = a.assemble(msgs[0])
+		assert.False(t, ok)
+		_, ok = a.assemble(msgs[2])
+		assert.False(t, ok)
+		assert.Empty(t, a.pending)
+	})
+
+	t.Run("redelivered", func(t *testing.T) {
+		a := newChunkAssembler()
+		msgs := toChunkTestMessages(splitMessage(msg, 4), 1)
+		_, ok := a.assemble(msgs[0])
+		assert.False(t, ok)
+		_, ok = a.assemble(msgs[1])
+		assert.False(t, ok)
+		for _, m := range msgs[:len(msgs)-1] {
+			_, ok = a.assemble(m)
+			assert.False(t, ok)
+		}
+		res, ok := a.assemble(msgs[len(msgs)-1])
+		assert.True(t, ok)
+		assert.Equal(t, payload, res.Payload())
+	})
+
+	t.Run("invalid chunk", func(t *testing.T) {
+		a := newChunkAssembler()
+		_, ok := a.assemble(&chunkTestMessage{ProducerMessage: &mqwrapper.ProducerMessage{
+			Payload:    payload,
+			Properties: map[string]string{chunkIDKey: "id", chunkIndexKey: "x", chunkNumKey: "2"},
+		}})
+		assert.False(t, ok)
+	})
+
+	t.Run("evict", func(t *testing.T) {
+		a := newChunkAssembler()
+		for i := 0; i < maxPendingChunkedMessages+1; i++ {
+			_, ok := a.assemble(toChunkTestMessages(splitMessage(msg, 5), 1)[0])
+			assert.False(t, ok)
+		}
+		assert.Equal(t, maxPendingChunkedMessages, len(a.pending))
+		assert.Equal(t, maxPendingChunkedMessages, len(a.order))
+	})
+}
@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+const (
+	// msgCodecKey is the property recording the codec of a message payload, proto if absent
+	msgCodecKey = "_msg_codec"
+
+	// MsgCodecProto marshals messages by their proto
+	MsgCodecProto = "proto"
+	// MsgCodecColumnar marshals the float vectors of insert messages as raw columns
+	MsgCodecColumnar = "columnar"
+
+	// columnarVectorField is the proto field number carrying a raw float vector column,
+	// it's unknown to msgpb.InsertRequest so the header of the payload is still parsable
+	columnarVectorField protowire.Number = 100000
+)
+
+// MsgCodec serializes TsMsg into the payload of mq messages and back
+type MsgCodec interface {
+	// Name is recorded in the message properties to choose the codec on consuming
+	Name() string
+	// Marshal serializes msg into payload
+	Marshal(msg TsMsg) ([]byte, error)
+	// Unmarshal deserializes the payload of msgType, dispatcher unmarshals the proto part
+	Unmarshal(dispatcher UnmarshalDispatcher, payload []byte, msgType commonpb.MsgType) (TsMsg, error)
+}
+
+var (
+	msgCodecsMu sync.RWMutex
+	msgCodecs   = map[string]MsgCodec{
+		MsgCodecProto:    protoCodec{},
+		MsgCodecColumnar: columnarCodec{},
+	}
+)
+
+// RegisterMsgCodec registers codec by its name, the registered one with the same name is replaced
+func RegisterMsgCodec(codec MsgCodec) {
+	msgCodecsMu.Lock()
+	defer msgCodecsMu.Unlock()
+	msgCodecs[codec.Name()] = codec
+}
+
+// GetMsgCodec returns the codec registered by name
+func GetMsgCodec(name string) (MsgCodec, error) {
+	msgCodecsMu.RLock()
+	defer msgCodecsMu.RUnlock()
+	codec, ok := msgCodecs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown msg codec %s", name)
+	}
+	return codec, nil
+}
+
+// getMsgCodecOf returns the codec of the message with properties
+func getMsgCodecOf(properties map[string]string) (MsgCodec, error) {
+	name, ok := properties[msgCodecKey]
+	if !ok {
+		return protoCodec{}, nil
+	}
+	return GetMsgCodec(name)
+}
+
+// protoCodec marshals messages by their proto, it's the default codec
+type protoCodec struct{}
+
+func (protoCodec) Name() string {
+	return MsgCodecProto
+}
+
+func (protoCodec) Marshal(msg TsMsg) ([]byte, error) {
+	mb, err := msg.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return convertToByteArray(mb)
+}
+
+func (protoCodec) Unmarshal(dispatcher UnmarshalDispatcher, payload []byte, msgType commonpb.MsgType) (TsMsg, error) {
+	return dispatcher.Unmarshal(payload, msgType)
+}
+
+// columnarCodec appends the float vectors of insert messages to the proto as raw little-endian columns,
+// which avoids encoding them element by element. The other messages are marshaled by proto
+type columnarCodec struct{}
+
+func (columnarCodec) Name() string {
+	return MsgCodecColumnar
+}
+
+func (columnarCodec) Marshal(msg TsMsg) ([]byte, error) {
+	insertMsg, ok := msg.(*InsertMsg)
+	if !ok {
+		return protoCodec{}.Marshal(msg)
+	}
+
+	req := insertMsg.InsertRequest
+	req.FieldsData = make([]*schemapb.FieldData, len(insertMsg.FieldsData))
+	var columns []byte
+	for i, fieldData := range insertMsg.FieldsData {
+		data := fieldData.GetVectors().GetFloatVector().GetData()
+		if len(data) == 0 {
+			req.FieldsData[i] = fieldData
+			continue
+		}
+		stripped := *fieldData
+		stripped.Field = &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  fieldData.GetVectors().GetDim(),
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{}},
+			},
+		}
+		req.FieldsData[i] = &stripped
+
+		column := protowire.AppendVarint(nil, uint64(i))
+		column = append(column, float32sAsBytes(data)...)
+		columns = protowire.AppendTag(columns, columnarVectorField, protowire.BytesType)
+		columns = protowire.AppendBytes(columns, column)
+	}
+
+	payload, err := proto.Marshal(&req)
+	if err != nil {
+		return nil, err
+	}
+	return append(payload, columns...), nil
+}
+
+func (columnarCodec) Unmarshal(dispatcher UnmarshalDispatcher, payload []byte, msgType commonpb.MsgType) (TsMsg, error) {
+	msg, err := dispatcher.Unmarshal(payload, msgType)
+	if err != nil {
+		return nil, err
+	}
+	insertMsg, ok := msg.(*InsertMsg)
+	if !ok {
+		return msg, nil
+	}
+
+	unknown := insertMsg.XXX_unrecognized
+	insertMsg.XXX_unrecognized = nil
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+		if num != columnarVectorField || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, unknown)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			insertMsg.XXX_unrecognized = append(insertMsg.XXX_unrecognized, unknown[:n]...)
+			unknown = unknown[n:]
+			continue
+		}
+
+		column, n := protowire.ConsumeBytes(unknown)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+		if err := setFloatVectorColumn(insertMsg.FieldsData, column); err != nil {
+			return nil, err
+		}
+	}
+	return insertMsg, nil
+}
+
+// setFloatVectorColumn sets the raw float vector column back to the field it was stripped from
+func setFloatVectorColumn(fieldsData []*schemapb.FieldData, column []byte) error {
+	index, n := protowire.ConsumeVarint(column)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	if index >= uint64(len(fieldsData)) {
+		return fmt.Errorf("float vector column of field index %d out of range %d", index, len(fieldsData))
+	}
+	floatVector := fieldsData[index].GetVectors().GetFloatVector()
+	if floatVector == nil {
+		return fmt.Errorf("field index %d is not a float vector", index)
+	}
+	raw := column[n:]
+	if len(raw)%4 != 0 {
+		return errors.New("invalid float vector column size")
+	}
+	floatVector.Data = make([]float32, len(raw)/4)
+	copy(float32sAsBytes(floatVector.Data), raw)
+	return nil
+}
+
+// float32sAsBytes returns the little-endian bytes of data without copy, all platforms supported are little-endian
+func float32sAsBytes(data []float32) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&data[0])), len(data)*4)
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func newCodecTestInsertMsg() *InsertMsg {
+	msg := getTsMsg(commonpb.MsgType_Insert, 1).(*InsertMsg)
+	msg.FieldsData = []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				},
+			},
+		},
+		{
+			Type:    schemapb.DataType_FloatVector,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  2,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{0.1, 0.2, 0.3, 0.4}}},
+				},
+			},
+		},
+	}
+	return msg
+}
+
+func TestColumnarCodec(t *testing.T) {
+	codec, err := GetMsgCodec(MsgCodecColumnar)
+	require.NoError(t, err)
+	dispatcher := (&ProtoUDFactory{}).NewUnmarshalDispatcher()
+
+	msg := newCodecTestInsertMsg()
+	payload, err := codec.Marshal(msg)
+	require.NoError(t, err)
+	// the original message is untouched
+	assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, msg.FieldsData[1].GetVectors().GetFloatVector().GetData())
+
+	// the header is still parsable
+	header := commonpb.MsgHeader{}
+	require.NoError(t, proto.Unmarshal(payload, &header))
+	assert.Equal(t, commonpb.MsgType_Insert, header.GetBase().GetMsgType())
+
+	decoded, err := codec.Unmarshal(dispatcher, payload, commonpb.MsgType_Insert)
+	require.NoError(t, err)
+	insertMsg := decoded.(*InsertMsg)
+	assert.Empty(t, insertMsg.XXX_unrecognized)
+	assert.True(t, proto.Equal(&msg.InsertRequest, &insertMsg.InsertRequest))
+
+	// decoded by proto codec, the float vectors are missing
+	decoded, err = protoCodec{}.Unmarshal(dispatcher, payload, commonpb.MsgType_Insert)
+	require.NoError(t, err)
+	assert.Empty(t, decoded.(*InsertMsg).FieldsData[1].GetVectors().GetFloatVector().GetData())
+
+	// the other messages are marshaled by proto
+	deleteMsg := getTsMsg(commonpb.MsgType_Delete, 1)
+	payload, err = codec.Marshal(deleteMsg)
+	require.NoError(t, err)
+	protoPayload, err := protoCodec{}.Marshal(deleteMsg)
+	require.NoError(t, err)
+	assert.Equal(t, protoPayload, payload)
+	_, err = codec.Unmarshal(dispatcher, payload, commonpb.MsgType_Delete)
+	assert.NoError(t, err)
+}
+
+func TestColumnarCodec_InvalidColumn(t *testing.T) {
+	dispatcher := (&ProtoUDFactory{}).NewUnmarshalDispatcher()
+	msg := newCodecTestInsertMsg()
+	payload, err := protoCodec{}.Marshal(msg)
+	require.NoError(t, err)
+
+	// column of the scalar field
+	err = setFloatVectorColumn(msg.FieldsData, []byte{0, 0, 0, 0, 0})
+	assert.Error(t, err)
+	// index out of range
+	err = setFloatVectorColumn(msg.FieldsData, []byte{5, 0, 0, 0, 0})
+	assert.Error(t, err)
+	// truncated float
+	err = setFloatVectorColumn(msg.FieldsData, []byte{1, 0, 0})
+	assert.Error(t, err)
+
+	// unknown fields other than the columns are kept
+	payload = append(payload, 0xf8, 0xff, 0x03, 0x01)
+	decoded, err := columnarCodec{}.Unmarshal(dispatcher, payload, commonpb.MsgType_Insert)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xf8, 0xff, 0x03, 0x01}, decoded.(*InsertMsg).XXX_unrecognized)
+}
+
+func TestGetMsgCodec(t *testing.T) {
+	codec, err := getMsgCodecOf(map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, MsgCodecProto, codec.Name())
+
+	codec, err = getMsgCodecOf(map[string]string{msgCodecKey: MsgCodecColumnar})
+	assert.NoError(t, err)
+	assert.Equal(t, MsgCodecColumnar, codec.Name())
+
+	_, err = getMsgCodecOf(map[string]string{msgCodecKey: "unknown"})
+	assert.Error(t, err)
+}
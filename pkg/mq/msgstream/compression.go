@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/compressor"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// codecKey is the property recording the codec of a compressed message payload
+	codecKey = "_codec"
+
+	codecNone = "none"
+	codecZstd = "zstd"
+)
+
+// compressionCodec returns the codec to compress the produced message payloads with,
+// none if the compression is turned off by the feature flag.
+func compressionCodec(params *paramtable.ComponentParam) string {
+	codec := params.MQCfg.CompressionType.GetValue()
+	if codec == "" || codec == codecNone || !params.FeatureFlagCfg.MQPayloadCompression.Enabled() {
+		return codecNone
+	}
+	return codec
+}
+
+// compressMessage compresses the payload of msg with codec if it's larger than minSize,
+// the message is returned as is if the codec is none or the payload is not shrunk.
+func compressMessage(msg *mqwrapper.ProducerMessage, codec string, minSize int) (*mqwrapper.ProducerMessage, error) {
+	if codec == "" || codec == codecNone || len(msg.Payload) <= minSize {
+		return msg, nil
+	}
+	var payload []byte
+	switch codec {
+	case codecZstd:
+		payload = compressor.ZstdCompressBytes(msg.Payload, nil)
+	default:
+		return nil, fmt.Errorf("unsupported mq compression type %s", codec)
+	}
+	if len(payload) >= len(msg.Payload) {
+		return msg, nil
+	}
+
+	properties := make(map[string]string, len(msg.Properties)+1)
+	for k, v := range msg.Properties {
+		properties[k] = v
+	}
+	properties[codecKey] = codec
+	return &mqwrapper.ProducerMessage{Payload: payload, Properties: properties}, nil
+}
+
+// decompressedMessage is a consumed message whose payload is decompressed
+type decompressedMessage struct {
+	mqwrapper.Message
+	properties map[string]string
+	payload    []byte
+}
+
+func (m *decompressedMessage) Properties() map[string]string {
+	return m.properties
+}
+
+func (m *decompressedMessage) Payload() []byte {
+	return m.payload
+}
+
+// decompressMessage decompresses the payload of msg by the codec recorded in its properties,
+// the message is returned as is if it's not compressed.
+func decompressMessage(msg mqwrapper.Message) (mqwrapper.Message, error) {
+	codec, ok := msg.Properties()[codecKey]
+	if !ok {
+		return msg, nil
+	}
+	var payload []byte
+	var err error
+	switch codec {
+	case codecZstd:
+		payload, err = compressor.ZstdDecompressBytes(msg.Payload(), nil)
+	default:
+		err = fmt.Errorf("unsupported mq compression type %s", codec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]string, len(msg.Properties()))
+	for k, v := range msg.Properties() {
+		if k != codecKey {
+			properties[k] = v
+		}
+	}
+	return &decompressedMessage{Message: msg, properties: properties, payload: payload}, nil
+}
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCompressMessage(t *testing.T) {
+	payload := bytes.Repeat([]byte("milvus"), 1024)
+	msg := &mqwrapper.ProducerMessage{Payload: payload, Properties: map[string]string{"key": "value"}}
+
+	t.Run("no compression", func(t *testing.T) {
+		res, err := compressMessage(msg, codecNone, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, msg, res)
+
+		res, err = compressMessage(msg, "", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, msg, res)
+
+		res, err = compressMessage(msg, codecZstd, len(payload))
+		assert.NoError(t, err)
+		assert.Equal(t, msg, res)
+	})
+
+	t.Run("not shrunk", func(t *testing.T) {
+		small := &mqwrapper.ProducerMessage{Payload: []byte("a"), Properties: map[string]string{}}
+		res, err := compressMessage(small, codecZstd, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, small, res)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := compressMessage(msg, "lz4", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("zstd", func(t *testing.T) {
+		res, err := compressMessage(msg, codecZstd, 0)
+		assert.NoError(t, err)
+		assert.Less(t, len(res.Payload), len(payload))
+		assert.Equal(t, codecZstd, res.Properties[codecKey])
+		assert.Equal(t, "value", res.Properties["key"])
+		assert.NotContains(t, msg.Properties, codecKey)
+
+		decompressed, err := decompressMessage(&chunkTestMessage{ProducerMessage: res, id: chunkTestID(1)})
+		assert.NoError(t, err)
+		assert.Equal(t, payload, decompressed.Payload())
+		assert.Equal(t, map[string]string{"key": "value"}, decompressed.Properties())
+		assert.Equal(t, chunkTestID(1), decompressed.ID())
+		assert.Equal(t, "topic", decompressed.Topic())
+	})
+
+	t.Run("zstd with chunks", func(t *testing.T) {
+		res, err := compressMessage(msg, codecZstd, 0)
+		assert.NoError(t, err)
+		a := newChunkAssembler()
+		var assembled mqwrapper.Message
+		for _, m := range toChunkTestMessages(splitMessage(res, 8), 1) {
+			if r, ok := a.assemble(m); ok {
+				assembled = r
+			}
+		}
+		assert.NotNil(t, assembled)
+		decompressed, err := decompressMessage(assembled)
+		assert.NoError(t, err)
+		assert.Equal(t, payload, decompressed.Payload())
+	})
+}
+
+func TestCompressionCodec(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	assert.Equal(t, codecNone, compressionCodec(params))
+
+	params.Save(params.MQCfg.CompressionType.Key, codecZstd)
+	defer params.Reset(params.MQCfg.CompressionType.Key)
+	assert.Equal(t, codecZstd, compressionCodec(params))
+
+	params.Save(params.FeatureFlagCfg.MQPayloadCompression.Key, "false")
+	defer params.Reset(params.FeatureFlagCfg.MQPayloadCompression.Key)
+	assert.Equal(t, codecNone, compressionCodec(params))
+}
+
+func TestDecompressMessage(t *testing.T) {
+	msg := &chunkTestMessage{ProducerMessage: &mqwrapper.ProducerMessage{Payload: []byte("payload"), Properties: map[string]string{}}}
+	res, err := decompressMessage(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, res)
+
+	msg.ProducerMessage.Properties[codecKey] = codecZstd
+	_, err = decompressMessage(msg)
+	assert.Error(t, err)
+
+	msg.ProducerMessage.Properties[codecKey] = "lz4"
+	_, err = decompressMessage(msg)
+	assert.Error(t, err)
+}
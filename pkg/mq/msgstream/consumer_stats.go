@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// consumerStatsRegistry tracks the consumers reporting statistics of all msgstreams in the process,
+// so that the node metrics show how each channel is consumed
+var consumerStatsRegistry = struct {
+	sync.RWMutex
+	channels map[mqwrapper.StatsConsumer]string
+}{
+	channels: make(map[mqwrapper.StatsConsumer]string),
+}
+
+func registerConsumerStats(channel string, consumer mqwrapper.Consumer) {
+	sc, ok := consumer.(mqwrapper.StatsConsumer)
+	if !ok {
+		return
+	}
+	consumerStatsRegistry.Lock()
+	defer consumerStatsRegistry.Unlock()
+	consumerStatsRegistry.channels[sc] = channel
+}
+
+func unregisterConsumerStats(consumer mqwrapper.Consumer) {
+	sc, ok := consumer.(mqwrapper.StatsConsumer)
+	if !ok {
+		return
+	}
+	consumerStatsRegistry.Lock()
+	defer consumerStatsRegistry.Unlock()
+	delete(consumerStatsRegistry.channels, sc)
+}
+
+// GetConsumerMetrics returns the statistics of the msgstream consumers in the process,
+// only the consumers implementing mqwrapper.StatsConsumer are reported
+func GetConsumerMetrics() []metricsinfo.ConsumerMetrics {
+	consumerStatsRegistry.RLock()
+	defer consumerStatsRegistry.RUnlock()
+	ret := make([]metricsinfo.ConsumerMetrics, 0, len(consumerStatsRegistry.channels))
+	for consumer, channel := range consumerStatsRegistry.channels {
+		stats := consumer.Stats()
+		m := metricsinfo.ConsumerMetrics{
+			Channel:      channel,
+			Subscription: stats.Subscription,
+			Received:     stats.Received,
+			Acked:        stats.Acked,
+			Pending:      stats.Pending,
+		}
+		if !stats.LastDispatchTime.IsZero() {
+			m.LastDispatchTime = stats.LastDispatchTime.Format(time.RFC3339Nano)
+		}
+		ret = append(ret, m)
+	}
+	return ret
+}
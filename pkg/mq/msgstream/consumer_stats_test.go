@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+type statsTestConsumer struct {
+	mqwrapper.Consumer
+	stats mqwrapper.ConsumerStats
+}
+
+func (c *statsTestConsumer) Stats() mqwrapper.ConsumerStats {
+	return c.stats
+}
+
+func TestGetConsumerMetrics(t *testing.T) {
+	dispatched := time.Now()
+	consumer := &statsTestConsumer{stats: mqwrapper.ConsumerStats{
+		Topic:            "topic",
+		Subscription:     "sub",
+		Received:         10,
+		Acked:            8,
+		Pending:          2,
+		LastDispatchTime: dispatched,
+	}}
+	idle := &statsTestConsumer{stats: mqwrapper.ConsumerStats{Topic: "idle", Subscription: "sub"}}
+
+	// consumers without statistics are ignored
+	registerConsumerStats("ignored", struct{ mqwrapper.Consumer }{})
+	registerConsumerStats("channel", consumer)
+	registerConsumerStats("idle", idle)
+	defer unregisterConsumerStats(idle)
+
+	metrics := make(map[string]metricsinfo.ConsumerMetrics)
+	for _, m := range GetConsumerMetrics() {
+		metrics[m.Channel] = m
+	}
+	assert.NotContains(t, metrics, "ignored")
+	m, ok := metrics["channel"]
+	assert.True(t, ok)
+	assert.Equal(t, "sub", m.Subscription)
+	assert.EqualValues(t, 10, m.Received)
+	assert.EqualValues(t, 8, m.Acked)
+	assert.Equal(t, 2, m.Pending)
+	assert.Equal(t, dispatched.Format(time.RFC3339Nano), m.LastDispatchTime)
+	m, ok = metrics["idle"]
+	assert.True(t, ok)
+	assert.Empty(t, m.LastDispatchTime)
+
+	unregisterConsumerStats(consumer)
+	for _, m := range GetConsumerMetrics() {
+		assert.NotEqual(t, "channel", m.Channel)
+	}
+}
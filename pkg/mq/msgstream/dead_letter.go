@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+const (
+	// DeadLetterSinkNone drops the messages failed to be consumed after logging them
+	DeadLetterSinkNone = "none"
+	// DeadLetterSinkTopic produces the messages failed to be consumed into a dead letter topic
+	DeadLetterSinkTopic = "topic"
+	// DeadLetterSinkObjectStorage writes the messages failed to be consumed into object storage
+	DeadLetterSinkObjectStorage = "objectstorage"
+
+	// properties attached to the messages in dead letter topic
+	deadLetterChannelKey = "_dead_letter_channel"
+	deadLetterMsgIDKey   = "_dead_letter_msg_id"
+	deadLetterReasonKey  = "_dead_letter_reason"
+)
+
+// DeadLetter is a raw message failed to be consumed
+type DeadLetter struct {
+	Channel    string            `json:"channel"`
+	MsgID      []byte            `json:"msg_id"`
+	Payload    []byte            `json:"payload"`
+	Properties map[string]string `json:"properties"`
+	Reason     string            `json:"reason"`
+}
+
+// DeadLetterSink stores the messages failed to be consumed, so the consumer could skip them
+type DeadLetterSink interface {
+	Send(ctx context.Context, letter *DeadLetter) error
+	Close()
+}
+
+// topicDeadLetterSink produces dead letters of a channel into topic `<channel><suffix>`
+type topicDeadLetterSink struct {
+	client    mqwrapper.Client
+	suffix    string
+	mu        sync.Mutex
+	producers map[string]mqwrapper.Producer
+}
+
+// NewTopicDeadLetterSink creates a DeadLetterSink producing dead letters into topics by client
+func NewTopicDeadLetterSink(client mqwrapper.Client, suffix string) DeadLetterSink {
+	return &topicDeadLetterSink{
+		client:    client,
+		suffix:    suffix,
+		producers: make(map[string]mqwrapper.Producer),
+	}
+}
+
+func (s *topicDeadLetterSink) Send(ctx context.Context, letter *DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	topic := letter.Channel + s.suffix
+	producer, ok := s.producers[topic]
+	if !ok {
+		var err error
+		producer, err = s.client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+		if err != nil {
+			return err
+		}
+		s.producers[topic] = producer
+	}
+
+	properties := make(map[string]string, len(letter.Properties)+3)
+	for k, v := range letter.Properties {
+		properties[k] = v
+	}
+	properties[deadLetterChannelKey] = letter.Channel
+	properties[deadLetterMsgIDKey] = hex.EncodeToString(letter.MsgID)
+	properties[deadLetterReasonKey] = letter.Reason
+	_, err := producer.Send(ctx, &mqwrapper.ProducerMessage{Payload: letter.Payload, Properties: properties})
+	return err
+}
+
+func (s *topicDeadLetterSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, producer := range s.producers {
+		producer.Close()
+	}
+	s.producers = make(map[string]mqwrapper.Producer)
+}
+
+// ObjectWriter writes an object to the file path, it's implemented by the chunk managers of storage
+type ObjectWriter interface {
+	Write(ctx context.Context, filePath string, content []byte) error
+}
+
+// objectDeadLetterSink writes each dead letter as a json object at `<rootPath>/<channel>/<msgID in hex>`
+type objectDeadLetterSink struct {
+	writer   ObjectWriter
+	rootPath string
+}
+
+// NewObjectDeadLetterSink creates a DeadLetterSink writing dead letters into object storage
+func NewObjectDeadLetterSink(writer ObjectWriter, rootPath string) DeadLetterSink {
+	return &objectDeadLetterSink{
+		writer:   writer,
+		rootPath: rootPath,
+	}
+}
+
+func (s *objectDeadLetterSink) Send(ctx context.Context, letter *DeadLetter) error {
+	content, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	return s.writer.Write(ctx, path.Join(s.rootPath, letter.Channel, hex.EncodeToString(letter.MsgID)), content)
+}
+
+func (s *objectDeadLetterSink) Close() {}
+
+// SetDeadLetterSink sets the sink of the messages failed to be consumed by ms,
+// false is returned if ms is not a msgstream based on mq.
+func SetDeadLetterSink(ms MsgStream, sink DeadLetterSink) bool {
+	switch s := ms.(type) {
+	case *mqMsgStream:
+		s.setDeadLetterSink(sink)
+	case *MqTtMsgStream:
+		s.setDeadLetterSink(sink)
+	case *MirrorMsgStream:
+		return SetDeadLetterSink(s.primary, sink) && SetDeadLetterSink(s.mirror, sink)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+type deadLetterTestWriter struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (w *deadLetterTestWriter) Write(ctx context.Context, filePath string, content []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.objects[filePath] = content
+	return nil
+}
+
+type deadLetterTestSink struct {
+	letters []*DeadLetter
+	closed  bool
+}
+
+func (s *deadLetterTestSink) Send(ctx context.Context, letter *DeadLetter) error {
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+func (s *deadLetterTestSink) Close() {
+	s.closed = true
+}
+
+func TestObjectDeadLetterSink(t *testing.T) {
+	writer := &deadLetterTestWriter{objects: make(map[string][]byte)}
+	sink := NewObjectDeadLetterSink(writer, "root/dead_letter")
+	defer sink.Close()
+
+	letter := &DeadLetter{
+		Channel:    "channel",
+		MsgID:      []byte{1, 2},
+		Payload:    []byte("payload"),
+		Properties: map[string]string{"key": "value"},
+		Reason:     "bad message",
+	}
+	err := sink.Send(context.Background(), letter)
+	assert.NoError(t, err)
+	content, ok := writer.objects["root/dead_letter/channel/0102"]
+	assert.True(t, ok)
+	got := &DeadLetter{}
+	assert.NoError(t, json.Unmarshal(content, got))
+	assert.Equal(t, letter, got)
+
+	writer.err = errors.New("mock error")
+	err = sink.Send(context.Background(), letter)
+	assert.Error(t, err)
+}
+
+func TestMqMsgStream_SendDeadLetter(t *testing.T) {
+	ms := &mqMsgStream{ctx: context.Background()}
+	msg := &chunkTestMessage{
+		ProducerMessage: &mqwrapper.ProducerMessage{Payload: []byte("malformed"), Properties: map[string]string{"key": "value"}},
+		id:              chunkTestID(3),
+	}
+
+	// no sink, the message is only logged
+	ms.sendDeadLetter(msg, errors.New("mock error"))
+
+	sink := &deadLetterTestSink{}
+	assert.True(t, SetDeadLetterSink(ms, sink))
+	_, err := ms.getTsMsgFromConsumerMsg(msg)
+	assert.Error(t, err)
+	ms.sendDeadLetter(msg, err)
+	assert.Equal(t, 1, len(sink.letters))
+	assert.Equal(t, "topic", sink.letters[0].Channel)
+	assert.Equal(t, []byte{3}, sink.letters[0].MsgID)
+	assert.Equal(t, []byte("malformed"), sink.letters[0].Payload)
+	assert.Equal(t, "value", sink.letters[0].Properties["key"])
+	assert.Equal(t, err.Error(), sink.letters[0].Reason)
+
+	// the previous sink is closed when replaced
+	assert.True(t, SetDeadLetterSink(ms, &deadLetterTestSink{}))
+	assert.True(t, sink.closed)
+
+	assert.True(t, SetDeadLetterSink(&MqTtMsgStream{mqMsgStream: ms}, &deadLetterTestSink{}))
+	assert.False(t, SetDeadLetterSink(&WastedMockMsgStream{}, sink))
+}
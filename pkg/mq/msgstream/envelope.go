@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+const (
+	// envelopeVersionKey is the property recording the envelope version of a message payload
+	envelopeVersionKey = "_envelope_version"
+	// schemaHashKey is the property recording the hash of the proto layout of a message payload
+	schemaHashKey = "_schema_hash"
+
+	// legacyEnvelopeVersion is the version of the messages produced without envelope version
+	legacyEnvelopeVersion = 1
+
+	// EnvelopeVersion is the envelope version of the messages produced by this build. Bump it on incompatible
+	// changes of the msg proto layouts, and register a PayloadConverter from the previous version, so that
+	// the messages produced by the old build are still consumable during a rolling upgrade.
+	EnvelopeVersion = 1
+)
+
+// PayloadConverter converts a message payload of msgType into the layout of the next envelope version
+type PayloadConverter func(msgType commonpb.MsgType, payload []byte) ([]byte, error)
+
+var (
+	convertersMu sync.RWMutex
+	// converters maps the envelope version to the converter upgrading payloads from it
+	converters = make(map[int]PayloadConverter)
+
+	// schemaHashes caches the schema hash by the type of TsMsg
+	schemaHashes sync.Map
+)
+
+// RegisterPayloadConverter registers the converter upgrading payloads from envelope version from to from+1
+func RegisterPayloadConverter(from int, converter PayloadConverter) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	converters[from] = converter
+}
+
+// setEnvelope records the envelope version and the schema hash of msg in properties
+func setEnvelope(msg TsMsg, properties map[string]string) {
+	properties[envelopeVersionKey] = strconv.Itoa(EnvelopeVersion)
+	if hash := schemaHash(msg); hash != "" {
+		properties[schemaHashKey] = hash
+	}
+}
+
+// envelopeVersion returns the envelope version recorded in properties
+func envelopeVersion(properties map[string]string) (int, error) {
+	value, ok := properties[envelopeVersionKey]
+	if !ok {
+		return legacyEnvelopeVersion, nil
+	}
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid envelope version %s", value)
+	}
+	return version, nil
+}
+
+// upgradePayload converts payload of an older envelope version into the layout of EnvelopeVersion
+// through the registered converters, the payload of EnvelopeVersion is returned as is
+func upgradePayload(properties map[string]string, msgType commonpb.MsgType, payload []byte) ([]byte, error) {
+	version, err := envelopeVersion(properties)
+	if err != nil {
+		return nil, err
+	}
+	if version > EnvelopeVersion {
+		return nil, fmt.Errorf("envelope version %d is newer than the supported version %d", version, EnvelopeVersion)
+	}
+
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+	for ; version < EnvelopeVersion; version++ {
+		converter, ok := converters[version]
+		if !ok {
+			return nil, fmt.Errorf("no payload converter from envelope version %d", version)
+		}
+		payload, err = converter(msgType, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert payload from envelope version %d, err %s", version, err.Error())
+		}
+	}
+	return payload, nil
+}
+
+// schemaHash returns the hash of the proto layout of msg, it's empty if msg doesn't embed a proto message
+func schemaHash(msg TsMsg) string {
+	t := reflect.TypeOf(msg)
+	if hash, ok := schemaHashes.Load(t); ok {
+		return hash.(string)
+	}
+	var hash string
+	if m := embeddedProto(msg); m != nil {
+		h := fnv.New32a()
+		writeDescriptor(h, proto.MessageReflect(m).Descriptor(), make(map[protoreflect.FullName]struct{}))
+		hash = strconv.FormatUint(uint64(h.Sum32()), 16)
+	}
+	schemaHashes.Store(t, hash)
+	return hash
+}
+
+// embeddedProto returns the proto message embedded in msg, e.g. msgpb.InsertRequest of InsertMsg
+func embeddedProto(msg TsMsg) proto.Message {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).Anonymous {
+			continue
+		}
+		if m, ok := v.Field(i).Addr().Interface().(proto.Message); ok {
+			return m
+		}
+	}
+	return nil
+}
+
+// writeDescriptor writes the fields of md recursively, so that any added, removed, renumbered
+// or retyped field changes the output
+func writeDescriptor(w io.Writer, md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]struct{}) {
+	if _, ok := visited[md.FullName()]; ok {
+		fmt.Fprintf(w, "%s;", md.FullName())
+		return
+	}
+	visited[md.FullName()] = struct{}{}
+	fmt.Fprintf(w, "%s{", md.FullName())
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		fmt.Fprintf(w, "%d:%s:%s:%s;", f.Number(), f.Name(), f.Kind(), f.Cardinality())
+		if f.Message() != nil {
+			writeDescriptor(w, f.Message(), visited)
+		}
+	}
+	fmt.Fprint(w, "}")
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+func TestSetEnvelope(t *testing.T) {
+	properties := map[string]string{}
+	insertMsg := getTsMsg(commonpb.MsgType_Insert, 1)
+	setEnvelope(insertMsg, properties)
+	assert.Equal(t, "1", properties[envelopeVersionKey])
+	assert.NotEmpty(t, properties[schemaHashKey])
+
+	// the hash depends on the proto layout only
+	assert.Equal(t, properties[schemaHashKey], schemaHash(getTsMsg(commonpb.MsgType_Insert, 2)))
+	assert.NotEqual(t, properties[schemaHashKey], schemaHash(getTsMsg(commonpb.MsgType_Delete, 1)))
+}
+
+func TestUpgradePayload(t *testing.T) {
+	payload := []byte("payload")
+
+	// messages produced without envelope are of the legacy version
+	upgraded, err := upgradePayload(map[string]string{}, commonpb.MsgType_Insert, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, upgraded)
+
+	upgraded, err = upgradePayload(map[string]string{envelopeVersionKey: "1"}, commonpb.MsgType_Insert, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, upgraded)
+
+	_, err = upgradePayload(map[string]string{envelopeVersionKey: "2"}, commonpb.MsgType_Insert, payload)
+	assert.Error(t, err)
+
+	_, err = upgradePayload(map[string]string{envelopeVersionKey: "x"}, commonpb.MsgType_Insert, payload)
+	assert.Error(t, err)
+
+	// no converter from version 0
+	_, err = upgradePayload(map[string]string{envelopeVersionKey: "0"}, commonpb.MsgType_Insert, payload)
+	assert.Error(t, err)
+
+	RegisterPayloadConverter(0, func(msgType commonpb.MsgType, payload []byte) ([]byte, error) {
+		assert.Equal(t, commonpb.MsgType_Insert, msgType)
+		return append(payload, '!'), nil
+	})
+	defer func() {
+		convertersMu.Lock()
+		delete(converters, 0)
+		convertersMu.Unlock()
+	}()
+	upgraded, err = upgradePayload(map[string]string{envelopeVersionKey: "0"}, commonpb.MsgType_Insert, []byte("payload"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload!"), upgraded)
+}
@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// mirrorSwitchCheckInterval is the interval to check whether consumers are configured to switch to the mirror
+const mirrorSwitchCheckInterval = time.Second
+
+var _ MsgStream = (*MirrorMsgStream)(nil)
+
+// MirrorMsgStream produces messages to both the primary and the mirror stream, and consumes from one of them.
+// Consumers start on the primary stream and switch to the mirror stream by SwitchConsumer or when mq.mirror.consume
+// is enabled, so the mq backend could be migrated online: mirror the writes, switch all consumers, then drop the
+// primary backend from the config.
+// The switch happens between two msg packs, and the mirror stream continues after the timestamp of the last
+// delivered pack, so no message is lost or delivered twice. The positions of the msg packs after switching
+// belong to the mirror backend, while the positions to seek after switching may still belong to the primary one,
+// e.g. the checkpoints saved before switching, so they are translated by the timestamps and the mirror backend
+// must support seeking by time.
+type MirrorMsgStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	primary MsgStream
+	mirror  MsgStream
+
+	// consuming is the stream that consumers read from, it's only changed by the forward loop once consuming
+	consuming   MsgStream
+	switched    bool
+	switchedTs  Timestamp
+	lastTs      Timestamp
+	channels    []string
+	subName     string
+	position    mqwrapper.SubscriptionInitialPosition
+	mu          sync.RWMutex
+	switchReqCh chan chan error
+
+	receiveBuf chan *MsgPack
+	onceChan   sync.Once
+	closeOnce  sync.Once
+	wg         sync.WaitGroup
+}
+
+// NewMirrorMsgStream creates a MirrorMsgStream on the primary and mirror streams, it takes over both of them
+func NewMirrorMsgStream(ctx context.Context, primary MsgStream, mirror MsgStream) *MirrorMsgStream {
+	ctx, cancel := context.WithCancel(ctx)
+	return &MirrorMsgStream{
+		ctx:         ctx,
+		cancel:      cancel,
+		primary:     primary,
+		mirror:      mirror,
+		consuming:   primary,
+		switchReqCh: make(chan chan error),
+		receiveBuf:  make(chan *MsgPack, paramtable.Get().MQCfg.ReceiveBufSize.GetAsInt64()),
+	}
+}
+
+// Close closes both the primary and the mirror stream, and the chan of the delivered msg packs
+func (ms *MirrorMsgStream) Close() {
+	ms.closeOnce.Do(func() {
+		ms.cancel()
+		ms.wg.Wait()
+		ms.primary.Close()
+		ms.mirror.Close()
+		close(ms.receiveBuf)
+	})
+}
+
+func (ms *MirrorMsgStream) AsProducer(channels []string) {
+	ms.primary.AsProducer(channels)
+	ms.mirror.AsProducer(channels)
+}
+
+// Produce produces the msg pack to the primary stream then the mirror stream.
+// Only the failure of the primary stream is returned, since retrying the msg pack would write it to the primary
+// stream twice. The msg packs failed to be mirrored are logged and counted by the mirror_produce op of
+// msgstream_op_count, which must stay at zero failures before switching consumers to the mirror stream.
+func (ms *MirrorMsgStream) Produce(msgPack *MsgPack) error {
+	if err := ms.primary.Produce(msgPack); err != nil {
+		return err
+	}
+	ms.observeMirror(ms.mirror.Produce(msgPack), "produce")
+	return nil
+}
+
+func (ms *MirrorMsgStream) SetRepackFunc(repackFunc RepackFunc) {
+	ms.primary.SetRepackFunc(repackFunc)
+	ms.mirror.SetRepackFunc(repackFunc)
+}
+
+func (ms *MirrorMsgStream) GetProduceChannels() []string {
+	return ms.primary.GetProduceChannels()
+}
+
+// Broadcast broadcasts the msg pack to the primary stream then the mirror stream,
+// the message ids of the primary stream are returned. The failure of the mirror stream is not returned like Produce.
+func (ms *MirrorMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, error) {
+	ids, err := ms.primary.Broadcast(msgPack)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ms.mirror.Broadcast(msgPack)
+	ms.observeMirror(err, "broadcast")
+	return ids, nil
+}
+
+// observeMirror counts the write to the mirror stream, and logs it if failed
+func (ms *MirrorMsgStream) observeMirror(err error, op string) {
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.TotalLabel).Inc()
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.FailLabel).Inc()
+		log.Warn("failed to write to mirror stream, the mirror stream misses the msg pack",
+			zap.String("op", op), zap.Strings("channels", ms.primary.GetProduceChannels()), zap.Error(err))
+		return
+	}
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.SuccessLabel).Inc()
+}
+
+func (ms *MirrorMsgStream) AsConsumer(ctx context.Context, channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	// consumers have been switched before, e.g. the node restarts during migration
+	if !ms.switched && paramtable.Get().MQCfg.MirrorConsume.GetAsBool() {
+		ms.consuming = ms.mirror
+		ms.switched = true
+	}
+	if err := ms.consuming.AsConsumer(ctx, channels, subName, position); err != nil {
+		return err
+	}
+	ms.channels = append(ms.channels, channels...)
+	ms.subName = subName
+	ms.position = position
+	return nil
+}
+
+func (ms *MirrorMsgStream) Chan() <-chan *MsgPack {
+	ms.onceChan.Do(func() {
+		ms.wg.Add(1)
+		go ms.forward()
+	})
+	return ms.receiveBuf
+}
+
+// Seek seeks the consuming stream to the positions, the positions are translated to their timestamps
+// after switching since they may belong to the primary backend.
+func (ms *MirrorMsgStream) Seek(ctx context.Context, offset []*MsgPosition) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if !ms.switched {
+		if err := ms.primary.Seek(ctx, offset); err != nil {
+			return err
+		}
+	} else {
+		for _, pos := range offset {
+			// the channel may be not subscribed if the stream seeks without AsConsumer
+			if err := ms.mirror.AsConsumer(ctx, []string{pos.GetChannelName()}, pos.GetMsgGroup(), mqwrapper.SubscriptionPositionUnknown); err != nil {
+				return err
+			}
+			if err := ms.mirror.SeekByTime(ctx, pos.GetChannelName(), pos.GetTimestamp()); err != nil {
+				return errors.Wrapf(err, "failed to translate the position of channel %s for the mirror stream", pos.GetChannelName())
+			}
+		}
+	}
+	// keep the channels to switch
+	for _, pos := range offset {
+		if !lo.Contains(ms.channels, pos.GetChannelName()) {
+			ms.channels = append(ms.channels, pos.GetChannelName())
+			ms.subName = pos.GetMsgGroup()
+			ms.position = mqwrapper.SubscriptionPositionUnknown
+		}
+	}
+	return nil
+}
+
+func (ms *MirrorMsgStream) SeekByTime(ctx context.Context, channel string, ts Timestamp) error {
+	return ms.getConsuming().SeekByTime(ctx, channel, ts)
+}
+
+func (ms *MirrorMsgStream) GetLatestMsgID(channel string) (MessageID, error) {
+	return ms.getConsuming().GetLatestMsgID(channel)
+}
+
+func (ms *MirrorMsgStream) CheckTopicValid(channel string) error {
+	return ms.getConsuming().CheckTopicValid(channel)
+}
+
+// SwitchConsumer switches consumers to the mirror stream, it's a no-op if they have been switched.
+// It's only available after Chan is called, since the switch is done between delivering msg packs.
+func (ms *MirrorMsgStream) SwitchConsumer(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	select {
+	case ms.switchReqCh <- errCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ms.ctx.Done():
+		return ms.ctx.Err()
+	}
+	return <-errCh
+}
+
+func (ms *MirrorMsgStream) getConsuming() MsgStream {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return ms.consuming
+}
+
+// forward delivers the msg packs of the consuming stream, and switches it to the mirror stream on request
+func (ms *MirrorMsgStream) forward() {
+	defer ms.wg.Done()
+	ticker := time.NewTicker(mirrorSwitchCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ms.ctx.Done():
+			return
+		case errCh := <-ms.switchReqCh:
+			errCh <- ms.switchConsumer()
+		case <-ticker.C:
+			if paramtable.Get().MQCfg.MirrorConsume.GetAsBool() {
+				if err := ms.switchConsumer(); err != nil {
+					log.Warn("failed to switch consumers to mirror stream", zap.Error(err))
+				}
+			}
+		case pack, ok := <-ms.getConsuming().Chan():
+			if !ok {
+				return
+			}
+			// the mirror stream may deliver messages consumed before switching if it seeks coarsely
+			if pack != nil && ms.switchedTs > 0 && pack.EndTs <= ms.switchedTs {
+				continue
+			}
+			if pack != nil {
+				ms.lastTs = pack.EndTs
+			}
+			select {
+			case ms.receiveBuf <- pack:
+			case <-ms.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (ms *MirrorMsgStream) switchConsumer() error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.switched {
+		return nil
+	}
+	if len(ms.channels) > 0 {
+		if err := ms.mirror.AsConsumer(ms.ctx, ms.channels, ms.subName, ms.position); err != nil {
+			return err
+		}
+		if ms.lastTs > 0 {
+			for _, channel := range ms.channels {
+				if err := ms.mirror.SeekByTime(ms.ctx, channel, ms.lastTs+1); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	log.Info("switch consumers to mirror stream", zap.Strings("channels", ms.channels), zap.Uint64("lastTs", ms.lastTs))
+	ms.consuming = ms.mirror
+	ms.switched = true
+	ms.switchedTs = ms.lastTs
+	return nil
+}
+
+// mirrorFactory creates MirrorMsgStreams producing to the streams of both primary and mirror factories
+type mirrorFactory struct {
+	primary Factory
+	mirror  Factory
+}
+
+// NewMirrorFactory creates a Factory of MirrorMsgStream
+func NewMirrorFactory(primary Factory, mirror Factory) Factory {
+	return &mirrorFactory{
+		primary: primary,
+		mirror:  mirror,
+	}
+}
+
+func (f *mirrorFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.newMirrorMsgStream(ctx, Factory.NewMsgStream)
+}
+
+func (f *mirrorFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.newMirrorMsgStream(ctx, Factory.NewTtMsgStream)
+}
+
+func (f *mirrorFactory) newMirrorMsgStream(ctx context.Context, newStream func(Factory, context.Context) (MsgStream, error)) (MsgStream, error) {
+	primary, err := newStream(f.primary, ctx)
+	if err != nil {
+		return nil, err
+	}
+	mirror, err := newStream(f.mirror, ctx)
+	if err != nil {
+		primary.Close()
+		return nil, errors.Wrap(err, "failed to create mirror stream")
+	}
+	return NewMirrorMsgStream(ctx, primary, mirror), nil
+}
+
+func (f *mirrorFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	primary := f.primary.NewMsgStreamDisposer(ctx)
+	mirror := f.mirror.NewMsgStreamDisposer(ctx)
+	return func(channels []string, subName string) error {
+		return merr.Combine(primary(channels, subName), mirror(channels, subName))
+	}
+}
@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+func TestMirrorMsgStream_Produce(t *testing.T) {
+	primary := NewMockMsgStream(t)
+	mirror := NewMockMsgStream(t)
+	ms := NewMirrorMsgStream(context.Background(), primary, mirror)
+
+	pack := &MsgPack{}
+	primary.EXPECT().AsProducer([]string{"ch"}).Return()
+	mirror.EXPECT().AsProducer([]string{"ch"}).Return()
+	ms.AsProducer([]string{"ch"})
+
+	primary.EXPECT().Produce(pack).Return(nil).Once()
+	mirror.EXPECT().Produce(pack).Return(nil).Once()
+	assert.NoError(t, ms.Produce(pack))
+
+	// the failure of the mirror stream is counted but not returned, so the msg pack isn't produced to the primary twice
+	failed := testutil.ToFloat64(metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.FailLabel))
+	primary.EXPECT().Produce(pack).Return(nil).Once()
+	mirror.EXPECT().Produce(pack).Return(errors.New("mock error")).Once()
+	primary.EXPECT().GetProduceChannels().Return([]string{"ch"})
+	assert.NoError(t, ms.Produce(pack))
+	assert.Equal(t, failed+1, testutil.ToFloat64(metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.FailLabel)))
+
+	primary.EXPECT().Produce(pack).Return(errors.New("mock error")).Once()
+	assert.Error(t, ms.Produce(pack))
+
+	ids := map[string][]MessageID{"ch": {chunkTestID(1)}}
+	primary.EXPECT().Broadcast(pack).Return(ids, nil).Once()
+	mirror.EXPECT().Broadcast(pack).Return(map[string][]MessageID{"ch": {chunkTestID(2)}}, nil).Once()
+	got, err := ms.Broadcast(pack)
+	assert.NoError(t, err)
+	assert.Equal(t, ids, got)
+
+	primary.EXPECT().Broadcast(pack).Return(ids, nil).Once()
+	mirror.EXPECT().Broadcast(pack).Return(nil, errors.New("mock error")).Once()
+	got, err = ms.Broadcast(pack)
+	assert.NoError(t, err)
+	assert.Equal(t, ids, got)
+	assert.Equal(t, failed+2, testutil.ToFloat64(metrics.MsgStreamOpCounter.WithLabelValues(metrics.MirrorProduceLabel, metrics.FailLabel)))
+
+	primary.EXPECT().Broadcast(pack).Return(nil, errors.New("mock error")).Once()
+	_, err = ms.Broadcast(pack)
+	assert.Error(t, err)
+
+	primary.EXPECT().Close().Return()
+	mirror.EXPECT().Close().Return()
+	ms.Close()
+}
+
+func TestMirrorMsgStream_SwitchConsumer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	primary := NewMockMsgStream(t)
+	mirror := NewMockMsgStream(t)
+	ms := NewMirrorMsgStream(ctx, primary, mirror)
+
+	primary.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return(nil)
+	assert.NoError(t, ms.AsConsumer(ctx, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown))
+
+	primaryCh := make(chan *MsgPack, 1)
+	primary.EXPECT().Chan().Return(primaryCh)
+	primaryCh <- &MsgPack{EndTs: 10}
+	pack := <-ms.Chan()
+	assert.Equal(t, Timestamp(10), pack.EndTs)
+
+	mirrorCh := make(chan *MsgPack, 2)
+	mirror.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return(nil).Once()
+	mirror.EXPECT().SeekByTime(mock.Anything, "ch", Timestamp(11)).Return(nil).Once()
+	mirror.EXPECT().Chan().Return(mirrorCh)
+	assert.NoError(t, ms.SwitchConsumer(ctx))
+	// switching twice is a no-op
+	assert.NoError(t, ms.SwitchConsumer(ctx))
+
+	// the pack consumed from primary is skipped
+	mirrorCh <- &MsgPack{EndTs: 10}
+	mirrorCh <- &MsgPack{EndTs: 20}
+	pack = <-ms.Chan()
+	assert.Equal(t, Timestamp(20), pack.EndTs)
+
+	mirror.EXPECT().CheckTopicValid("ch").Return(nil).Once()
+	assert.NoError(t, ms.CheckTopicValid("ch"))
+
+	// the positions are translated to the timestamps after switching
+	pos := &MsgPosition{ChannelName: "ch", MsgID: chunkTestID(1).Serialize(), MsgGroup: "sub", Timestamp: 30}
+	mirror.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return(nil).Once()
+	mirror.EXPECT().SeekByTime(mock.Anything, "ch", Timestamp(30)).Return(nil).Once()
+	assert.NoError(t, ms.Seek(ctx, []*MsgPosition{pos}))
+	mirror.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionUnknown).Return(nil).Once()
+	mirror.EXPECT().SeekByTime(mock.Anything, "ch", Timestamp(30)).Return(mqwrapper.ErrTimeSeekNotSupported).Once()
+	assert.ErrorIs(t, ms.Seek(ctx, []*MsgPosition{pos}), mqwrapper.ErrTimeSeekNotSupported)
+
+	primary.EXPECT().Close().Return().Once()
+	mirror.EXPECT().Close().Return().Once()
+	ms.Close()
+	ms.Close()
+	_, ok := <-ms.Chan()
+	assert.False(t, ok)
+}
+
+func TestMirrorMsgStream_Seek(t *testing.T) {
+	ctx := context.Background()
+	primary := NewMockMsgStream(t)
+	mirror := NewMockMsgStream(t)
+	ms := NewMirrorMsgStream(ctx, primary, mirror)
+
+	// the positions belong to the primary stream before switching
+	pos := &MsgPosition{ChannelName: "ch", MsgID: chunkTestID(1).Serialize(), MsgGroup: "sub", Timestamp: 30}
+	primary.EXPECT().Seek(mock.Anything, []*MsgPosition{pos}).Return(nil).Once()
+	assert.NoError(t, ms.Seek(ctx, []*MsgPosition{pos}))
+	assert.Equal(t, []string{"ch"}, ms.channels)
+	assert.Equal(t, "sub", ms.subName)
+
+	primary.EXPECT().Close().Return()
+	mirror.EXPECT().Close().Return()
+	ms.Close()
+}
+
+func TestMirrorMsgStream_SwitchConsumerFailed(t *testing.T) {
+	ctx := context.Background()
+	primary := NewMockMsgStream(t)
+	mirror := NewMockMsgStream(t)
+	ms := NewMirrorMsgStream(ctx, primary, mirror)
+
+	primary.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionLatest).Return(nil)
+	assert.NoError(t, ms.AsConsumer(ctx, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionLatest))
+	primary.EXPECT().Chan().Return(make(chan *MsgPack))
+	ms.Chan()
+
+	mirror.EXPECT().AsConsumer(mock.Anything, []string{"ch"}, "sub", mqwrapper.SubscriptionPositionLatest).Return(errors.New("mock error")).Once()
+	assert.Error(t, ms.SwitchConsumer(ctx))
+
+	// consumers stay on primary stream
+	primary.EXPECT().GetLatestMsgID("ch").Return(chunkTestID(1), nil).Once()
+	_, err := ms.GetLatestMsgID("ch")
+	assert.NoError(t, err)
+
+	primary.EXPECT().Close().Return()
+	mirror.EXPECT().Close().Return()
+	ms.Close()
+}
+
+func TestMirrorFactory(t *testing.T) {
+	ctx := context.Background()
+	primary := NewMockMqFactory()
+	mirror := NewMockMqFactory()
+	primary.NewMsgStreamFunc = func(ctx context.Context) (MsgStream, error) {
+		stream := NewMockMsgStream(t)
+		// primary stream is closed if failed to create mirror stream
+		stream.EXPECT().Close().Return().Once()
+		return stream, nil
+	}
+	mirror.NewMsgStreamFunc = func(ctx context.Context) (MsgStream, error) {
+		return nil, errors.New("mock error")
+	}
+	f := NewMirrorFactory(primary, mirror)
+	_, err := f.NewMsgStream(ctx)
+	assert.Error(t, err)
+}
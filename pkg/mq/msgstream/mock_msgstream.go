@@ -455,6 +455,50 @@ func (_c *MockMsgStream_Seek_Call) RunAndReturn(run func(context.Context, []*msg
 	return _c
 }
 
+// SeekByTime provides a mock function with given fields: ctx, channel, ts
+func (_m *MockMsgStream) SeekByTime(ctx context.Context, channel string, ts uint64) error {
+	ret := _m.Called(ctx, channel, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
+		r0 = rf(ctx, channel, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMsgStream_SeekByTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeekByTime'
+type MockMsgStream_SeekByTime_Call struct {
+	*mock.Call
+}
+
+// SeekByTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - ts uint64
+func (_e *MockMsgStream_Expecter) SeekByTime(ctx interface{}, channel interface{}, ts interface{}) *MockMsgStream_SeekByTime_Call {
+	return &MockMsgStream_SeekByTime_Call{Call: _e.mock.On("SeekByTime", ctx, channel, ts)}
+}
+
+func (_c *MockMsgStream_SeekByTime_Call) Run(run func(ctx context.Context, channel string, ts uint64)) *MockMsgStream_SeekByTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockMsgStream_SeekByTime_Call) Return(_a0 error) *MockMsgStream_SeekByTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMsgStream_SeekByTime_Call) RunAndReturn(run func(context.Context, string, uint64) error) *MockMsgStream_SeekByTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRepackFunc provides a mock function with given fields: repackFunc
 func (_m *MockMsgStream) SetRepackFunc(repackFunc RepackFunc) {
 	_m.Called(repackFunc)
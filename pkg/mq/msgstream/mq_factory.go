@@ -32,8 +32,11 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	kafkawrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	kinesiswrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kinesis"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/nmq"
+	pubsubwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pubsub"
 	pulsarmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pulsar"
+	rabbitmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/rabbitmq"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -232,3 +235,39 @@ func NewNatsmqFactory() Factory {
 		MQBufSize:         paramtable.MQCfg.MQBufSize.GetAsInt64(),
 	}
 }
+
+// NewKinesisFactory create a new kinesis factory.
+func NewKinesisFactory(config *paramtable.ServiceParam) Factory {
+	return &CommonFactory{
+		Newer: func(ctx context.Context) (mqwrapper.Client, error) {
+			return kinesiswrapper.NewClientWithConfig(ctx, &config.KinesisCfg)
+		},
+		DispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    config.MQCfg.ReceiveBufSize.GetAsInt64(),
+		MQBufSize:         config.MQCfg.MQBufSize.GetAsInt64(),
+	}
+}
+
+// NewPubsubFactory create a new google pubsub factory.
+func NewPubsubFactory(config *paramtable.ServiceParam) Factory {
+	return &CommonFactory{
+		Newer: func(ctx context.Context) (mqwrapper.Client, error) {
+			return pubsubwrapper.NewClientWithConfig(ctx, &config.PubsubCfg)
+		},
+		DispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    config.MQCfg.ReceiveBufSize.GetAsInt64(),
+		MQBufSize:         config.MQCfg.MQBufSize.GetAsInt64(),
+	}
+}
+
+// NewRabbitmqFactory create a new rabbitmq streams factory.
+func NewRabbitmqFactory(config *paramtable.ServiceParam) Factory {
+	return &CommonFactory{
+		Newer: func(ctx context.Context) (mqwrapper.Client, error) {
+			return rabbitmqwrapper.NewClientWithConfig(ctx, &config.RabbitmqCfg)
+		},
+		DispatcherFactory: ProtoUDFactory{},
+		ReceiveBufSize:    config.MQCfg.ReceiveBufSize.GetAsInt64(),
+		MQBufSize:         config.MQCfg.MQBufSize.GetAsInt64(),
+	}
+}
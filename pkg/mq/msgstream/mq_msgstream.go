@@ -27,11 +27,13 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -42,6 +44,9 @@ import (
 
 var _ MsgStream = (*mqMsgStream)(nil)
 
+// produceRetryAttempts is the number of attempts to resend a message failed to be produced
+const produceRetryAttempts = 3
+
 type mqMsgStream struct {
 	ctx              context.Context
 	client           mqwrapper.Client
@@ -49,6 +54,12 @@ type mqMsgStream struct {
 	producerChannels []string
 	consumers        map[string]mqwrapper.Consumer
 	consumerChannels []string
+	lagProbes        map[mqwrapper.Consumer]*mqwrapper.LagProbe
+	deadLetterSink   DeadLetterSink
+	producerEpoch    int64
+	codec            MsgCodec
+	// backpressureBuffered is the size of the messages held by backpressure
+	backpressureBuffered int64
 
 	repackFunc   RepackFunc
 	unmarshal    UnmarshalDispatcher
@@ -83,6 +94,7 @@ func NewMqMsgStream(ctx context.Context,
 		producerChannels: producerChannels,
 		consumers:        consumers,
 		consumerChannels: consumerChannels,
+		lagProbes:        make(map[mqwrapper.Consumer]*mqwrapper.LagProbe),
 
 		unmarshal:    unmarshal,
 		bufSize:      bufSize,
@@ -93,10 +105,65 @@ func NewMqMsgStream(ctx context.Context,
 		closeRWMutex: &sync.RWMutex{},
 		closed:       0,
 	}
+	if paramtable.Get().MQCfg.DeadLetterSink.GetValue() == DeadLetterSinkTopic {
+		stream.deadLetterSink = NewTopicDeadLetterSink(client, paramtable.Get().MQCfg.DeadLetterTopicSuffix.GetValue())
+	}
+	codec, err := GetMsgCodec(paramtable.Get().MQCfg.MsgCodec.GetValue())
+	if err != nil {
+		log.Warn("fallback to proto msg codec", zap.Error(err))
+		codec = protoCodec{}
+	}
+	stream.codec = codec
 
 	return stream, nil
 }
 
+// marshal marshals msg by the codec of stream, and records the codec in properties unless it's proto
+func (ms *mqMsgStream) marshal(msg TsMsg, properties map[string]string) ([]byte, error) {
+	payload, err := ms.codec.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if ms.codec.Name() != MsgCodecProto {
+		properties[msgCodecKey] = ms.codec.Name()
+	}
+	return payload, nil
+}
+
+func (ms *mqMsgStream) setDeadLetterSink(sink DeadLetterSink) {
+	if ms.deadLetterSink != nil {
+		ms.deadLetterSink.Close()
+	}
+	ms.deadLetterSink = sink
+}
+
+// sendDeadLetter sends the message failed to be decoded into the dead letter sink, so it could be skipped
+// without losing it. The message is dropped after logging if no sink is set or the sink fails.
+func (ms *mqMsgStream) sendDeadLetter(msg mqwrapper.Message, reason error) {
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.DeadLetterLabel, metrics.TotalLabel).Inc()
+	channel := filepath.Base(msg.Topic())
+	log.Warn("skip the message failed to be decoded",
+		zap.String("channel", channel),
+		zap.Binary("msgID", msg.ID().Serialize()),
+		zap.Error(reason))
+	if ms.deadLetterSink == nil {
+		return
+	}
+	err := ms.deadLetterSink.Send(ms.ctx, &DeadLetter{
+		Channel:    channel,
+		MsgID:      msg.ID().Serialize(),
+		Payload:    msg.Payload(),
+		Properties: msg.Properties(),
+		Reason:     reason.Error(),
+	})
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.DeadLetterLabel, metrics.FailLabel).Inc()
+		log.Warn("failed to send dead letter", zap.String("channel", channel), zap.Error(err))
+		return
+	}
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.DeadLetterLabel, metrics.SuccessLabel).Inc()
+}
+
 // AsProducer create producer to send message to channels
 func (ms *mqMsgStream) AsProducer(channels []string) {
 	for _, channel := range channels {
@@ -106,7 +173,7 @@ func (ms *mqMsgStream) AsProducer(channels []string) {
 		}
 
 		fn := func() error {
-			pp, err := ms.client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel, EnableCompression: true})
+			pp, err := ms.client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel, EnableCompression: true, Epoch: ms.producerEpoch})
 			if err != nil {
 				return err
 			}
@@ -128,6 +195,22 @@ func (ms *mqMsgStream) AsProducer(channels []string) {
 	}
 }
 
+// SetProducerEpoch sets the fencing epoch of the producers created by AsProducer afterwards,
+// false is returned if ms is not a msgstream based on mq.
+func SetProducerEpoch(ms MsgStream, epoch int64) bool {
+	switch s := ms.(type) {
+	case *mqMsgStream:
+		s.producerEpoch = epoch
+	case *MqTtMsgStream:
+		s.producerEpoch = epoch
+	case *MirrorMsgStream:
+		return SetProducerEpoch(s.primary, epoch) && SetProducerEpoch(s.mirror, epoch)
+	default:
+		return false
+	}
+	return true
+}
+
 func (ms *mqMsgStream) GetLatestMsgID(channel string) (MessageID, error) {
 	lastMsg, err := ms.consumers[channel].GetLatestMsgID()
 	if err != nil {
@@ -170,6 +253,8 @@ func (ms *mqMsgStream) AsConsumer(ctx context.Context, channels []string, subNam
 			defer ms.consumerLock.Unlock()
 			ms.consumers[channel] = pc
 			ms.consumerChannels = append(ms.consumerChannels, channel)
+			ms.addLagProbe(channel, pc)
+			registerConsumerStats(channel, pc)
 			return nil
 		}
 
@@ -187,6 +272,29 @@ func (ms *mqMsgStream) AsConsumer(ctx context.Context, channels []string, subNam
 	return nil
 }
 
+// addLagProbe starts to report the lag metrics of consumer, the consumers whose GetLatestMsgID
+// is costly are not probed. It must be called with consumerLock held.
+func (ms *mqMsgStream) addLagProbe(channel string, consumer mqwrapper.Consumer) {
+	if _, ok := ms.lagProbes[consumer]; ok {
+		return
+	}
+	if c, ok := consumer.(mqwrapper.CostlyLatestConsumer); ok && c.LatestMsgIDCostly() {
+		return
+	}
+	probe := mqwrapper.NewLagProbe(channel, consumer)
+	probe.Start(func() time.Duration {
+		return paramtable.Get().MQCfg.LagProbeInterval.GetAsDuration(time.Second)
+	})
+	ms.lagProbes[consumer] = probe
+}
+
+// lagProbe returns the lag probe of consumer, nil if it's not probed
+func (ms *mqMsgStream) lagProbe(consumer mqwrapper.Consumer) *mqwrapper.LagProbe {
+	ms.consumerLock.Lock()
+	defer ms.consumerLock.Unlock()
+	return ms.lagProbes[consumer]
+}
+
 func (ms *mqMsgStream) SetRepackFunc(repackFunc RepackFunc) {
 	ms.repackFunc = repackFunc
 }
@@ -206,11 +314,20 @@ func (ms *mqMsgStream) Close() {
 			producer.Close()
 		}
 	}
+	ms.consumerLock.Lock()
+	for _, probe := range ms.lagProbes {
+		probe.Close()
+	}
+	ms.consumerLock.Unlock()
 	for _, consumer := range ms.consumers {
 		if consumer != nil {
+			unregisterConsumerStats(consumer)
 			consumer.Close()
 		}
 	}
+	if ms.deadLetterSink != nil {
+		ms.deadLetterSink.Close()
+	}
 
 	ms.client.Close()
 	close(ms.receiveBuf)
@@ -271,35 +388,137 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 	}
 	for k, v := range result {
 		channel := ms.producerChannels[k]
-		for i := 0; i < len(v.Msgs); i++ {
-			spanCtx, sp := MsgSpanFromCtx(v.Msgs[i].TraceCtx(), v.Msgs[i])
-			defer sp.End()
+		if err := ms.produceToChannel(ms.producers[channel], v.Msgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			mb, err := v.Msgs[i].Marshal(v.Msgs[i])
-			if err != nil {
-				return err
-			}
+// pendingMsg is a message sent by produceToChannel and the error of its send
+type pendingMsg struct {
+	ctx  context.Context
+	span trace.Span
+	msg  *mqwrapper.ProducerMessage
+	err  error
+}
 
-			m, err := convertToByteArray(mb)
-			if err != nil {
-				return err
-			}
+// produceToChannel sends the messages by the producer without waiting for each of them. If any send fails,
+// the messages from the first failed one are resent synchronously in order, including the ones after it which
+// may have been sent, so the channel keeps the produce order of the messages, e.g. the time ticks after DMLs,
+// at the cost of duplicated messages.
+func (ms *mqMsgStream) produceToChannel(producer mqwrapper.Producer, tsMsgs []TsMsg) error {
+	pendings := make([]*pendingMsg, 0, len(tsMsgs))
+	defer func() {
+		for _, pending := range pendings {
+			pending.span.End()
+		}
+	}()
+	for _, tsMsg := range tsMsgs {
+		spanCtx, sp := MsgSpanFromCtx(tsMsg.TraceCtx(), tsMsg)
+		pending := &pendingMsg{ctx: spanCtx, span: sp}
+		pendings = append(pendings, pending)
+
+		properties := map[string]string{}
+		m, err := ms.marshal(tsMsg, properties)
+		if err != nil {
+			return err
+		}
 
-			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
-			InjectCtx(spanCtx, msg.Properties)
+		pending.msg = &mqwrapper.ProducerMessage{Payload: m, Properties: properties}
+		InjectCtx(spanCtx, pending.msg.Properties)
+		setEnvelope(tsMsg, pending.msg.Properties)
+	}
 
-			ms.producerLock.Lock()
-			if _, err := ms.producers[channel].Send(spanCtx, msg); err != nil {
-				ms.producerLock.Unlock()
-				sp.RecordError(err)
-				return err
-			}
-			ms.producerLock.Unlock()
+	ms.producerLock.Lock()
+	defer ms.producerLock.Unlock()
+	wg := &sync.WaitGroup{}
+	for _, pending := range pendings {
+		pending := pending
+		wg.Add(1)
+		ms.sendAsync(pending.ctx, producer, pending.msg, func(_ MessageID, err error) {
+			pending.err = err
+			wg.Done()
+		})
+	}
+	wg.Wait()
+
+	failed := -1
+	for i, pending := range pendings {
+		if pending.err != nil {
+			failed = i
+			break
+		}
+	}
+	if failed < 0 {
+		return nil
+	}
+	log.Warn("failed to produce message, resend the messages from it in order",
+		zap.Int("resend", len(pendings)-failed), zap.Error(pendings[failed].err))
+	for _, pending := range pendings[failed:] {
+		if err := ms.resend(producer, pending); err != nil {
+			pending.span.RecordError(err)
+			return err
 		}
 	}
 	return nil
 }
 
+// resend sends the message synchronously, the message is held while the mq applies backpressure,
+// and retried on the other errors.
+func (ms *mqMsgStream) resend(producer mqwrapper.Producer, pending *pendingMsg) error {
+	if errors.Is(pending.err, mqwrapper.ErrBackpressure) {
+		pending.err = ms.resendUnderBackpressure(producer, pending)
+		if pending.err == nil || errors.Is(pending.err, mqwrapper.ErrBackpressure) {
+			return pending.err
+		}
+	}
+	return retry.Do(ms.ctx, func() error {
+		_, err := ms.send(pending.ctx, producer, pending.msg)
+		if errors.Is(err, mqwrapper.ErrProducerFenced) {
+			return retry.Unrecoverable(err)
+		}
+		return err
+	}, retry.Attempts(produceRetryAttempts), retry.Sleep(50*time.Millisecond))
+}
+
+// resendUnderBackpressure holds the message while the mq applies backpressure and resends it after the retry-after.
+// The held messages are bounded by size and the wait is bounded by time, beyond which the backpressure error is returned
+// for the callers to push flow control upstream.
+func (ms *mqMsgStream) resendUnderBackpressure(producer mqwrapper.Producer, pending *pendingMsg) error {
+	params := paramtable.Get()
+	size := int64(len(pending.msg.Payload))
+	if atomic.AddInt64(&ms.backpressureBuffered, size) > params.MQCfg.BackpressureMaxBufferSize.GetAsInt64() {
+		atomic.AddInt64(&ms.backpressureBuffered, -size)
+		return pending.err
+	}
+	defer atomic.AddInt64(&ms.backpressureBuffered, -size)
+
+	deadline := time.Now().Add(params.MQCfg.BackpressureMaxWait.GetAsDuration(time.Millisecond))
+	err := pending.err
+	for {
+		retryAfter, ok := mqwrapper.RetryAfter(err)
+		if !ok {
+			return err
+		}
+		if retryAfter <= 0 {
+			retryAfter = 100 * time.Millisecond
+		}
+		if time.Now().Add(retryAfter).After(deadline) {
+			return err
+		}
+		log.RatedWarn(10, "mq applies backpressure, resend message later", zap.Duration("retryAfter", retryAfter), zap.Error(err))
+		select {
+		case <-ms.ctx.Done():
+			return ms.ctx.Err()
+		case <-time.After(retryAfter):
+		}
+		if _, err = ms.send(pending.ctx, producer, pending.msg); err == nil {
+			return nil
+		}
+	}
+}
+
 // BroadcastMark broadcast msg pack to all producers and returns corresponding msg id
 // the returned message id serves as marking
 func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, error) {
@@ -310,22 +529,19 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, erro
 	for _, v := range msgPack.Msgs {
 		spanCtx, sp := MsgSpanFromCtx(v.TraceCtx(), v)
 
-		mb, err := v.Marshal(v)
-		if err != nil {
-			return ids, err
-		}
-
-		m, err := convertToByteArray(mb)
+		properties := map[string]string{}
+		m, err := ms.marshal(v, properties)
 		if err != nil {
 			return ids, err
 		}
 
-		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: properties}
 		InjectCtx(spanCtx, msg.Properties)
+		setEnvelope(v, msg.Properties)
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
-			id, err := producer.Send(spanCtx, msg)
+			id, err := ms.send(spanCtx, producer, msg)
 			if err != nil {
 				ms.producerLock.Unlock()
 				sp.RecordError(err)
@@ -340,6 +556,69 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, erro
 	return ids, nil
 }
 
+// send sends the message by producer, the message is compressed if compression is enabled,
+// and split into chunks if it's larger than chunk size.
+// The id of the first chunk is returned for a chunked message.
+func (ms *mqMsgStream) send(ctx context.Context, producer mqwrapper.Producer, msg *mqwrapper.ProducerMessage) (MessageID, error) {
+	params := paramtable.Get()
+	msg, err := compressMessage(msg, compressionCodec(params), params.MQCfg.CompressionMinSize.GetAsInt())
+	if err != nil {
+		return nil, err
+	}
+	var firstID MessageID
+	for i, chunk := range splitMessage(msg, params.MQCfg.ChunkSize.GetAsInt()) {
+		id, err := producer.Send(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			firstID = id
+		}
+	}
+	return firstID, nil
+}
+
+// sendAsync is the asynchronous version of send, callback is called once all the chunks of the message
+// are acknowledged, with the id of the first chunk or the first error of them.
+func (ms *mqMsgStream) sendAsync(ctx context.Context, producer mqwrapper.Producer, msg *mqwrapper.ProducerMessage, callback func(MessageID, error)) {
+	params := paramtable.Get()
+	msg, err := compressMessage(msg, compressionCodec(params), params.MQCfg.CompressionMinSize.GetAsInt())
+	if err != nil {
+		callback(nil, err)
+		return
+	}
+	chunks := splitMessage(msg, params.MQCfg.ChunkSize.GetAsInt())
+	var (
+		mu       sync.Mutex
+		pending  = len(chunks)
+		firstID  MessageID
+		firstErr error
+	)
+	for i, chunk := range chunks {
+		i := i
+		mqwrapper.SendAsync(ctx, producer, chunk, func(id MessageID, _ *mqwrapper.ProducerMessage, err error) {
+			mu.Lock()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			if i == 0 {
+				firstID = id
+			}
+			pending--
+			done := pending == 0
+			mu.Unlock()
+			if !done {
+				return
+			}
+			if firstErr != nil {
+				callback(nil, firstErr)
+				return
+			}
+			callback(firstID, nil)
+		})
+	}
+}
+
 func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, error) {
 	header := commonpb.MsgHeader{}
 	if msg.Payload() == nil {
@@ -352,10 +631,23 @@ func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqwrapper.Message) (TsMsg, er
 	if header.Base == nil {
 		return nil, fmt.Errorf("failed to unmarshal message, header is uncomplete")
 	}
-	tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), header.Base.MsgType)
+	payload, err := upgradePayload(msg.Properties(), header.Base.MsgType, msg.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade message payload, err %s", err.Error())
+	}
+	codec, err := getMsgCodecOf(msg.Properties())
+	if err != nil {
+		return nil, err
+	}
+	tsMsg, err := codec.Unmarshal(ms.unmarshal, payload, header.Base.MsgType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
 	}
+	if hash, ok := msg.Properties()[schemaHashKey]; ok && hash != schemaHash(tsMsg) {
+		log.RatedWarn(60, "message schema differs from the local one within the same envelope version",
+			zap.String("msgType", header.Base.MsgType.String()),
+			zap.String("schemaHash", hash))
+	}
 
 	// set msg info to tsMsg
 	tsMsg.SetPosition(&MsgPosition{
@@ -373,6 +665,8 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 		return
 	}
 
+	assembler := newChunkAssembler()
+	probe := ms.lagProbe(consumer)
 	for {
 		select {
 		case <-ms.ctx.Done():
@@ -382,13 +676,24 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				return
 			}
 			consumer.Ack(msg)
+			probe.Observe(msg)
 			if msg.Payload() == nil {
 				log.Warn("MqMsgStream get msg whose payload is nil")
 				continue
 			}
+			msg, ok = assembler.assemble(msg)
+			if !ok {
+				continue
+			}
+			decompressed, err := decompressMessage(msg)
+			if err != nil {
+				ms.sendDeadLetter(msg, err)
+				continue
+			}
+			msg = decompressed
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.sendDeadLetter(msg, err)
 				continue
 			}
 			pos := tsMsg.Position()
@@ -399,8 +704,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				Timestamp:   tsMsg.BeginTs(),
 			})
 
-			ctx, _ := ExtractCtx(tsMsg, msg.Properties())
+			ctx, sp := ExtractCtx(tsMsg, msg.Properties())
 			tsMsg.SetTraceCtx(ctx)
+			sp.End()
 
 			msgPack := MsgPack{
 				Msgs:           []TsMsg{tsMsg},
@@ -452,6 +758,31 @@ func (ms *mqMsgStream) Seek(ctx context.Context, msgPositions []*msgpb.MsgPositi
 	return nil
 }
 
+// SeekByTime resets the subscription of the channel to the first message published not before ts,
+// the position may be earlier than ts if the mq records publish time coarsely.
+func (ms *mqMsgStream) SeekByTime(ctx context.Context, channel string, ts Timestamp) error {
+	consumer, ok := ms.consumers[channel]
+	if !ok {
+		return fmt.Errorf("channel %s not subscribed", channel)
+	}
+	return seekConsumerByTime(consumer, channel, ts)
+}
+
+func seekConsumerByTime(consumer mqwrapper.Consumer, channel string, ts Timestamp) error {
+	seeker, ok := consumer.(mqwrapper.TimeSeekableConsumer)
+	if !ok {
+		return mqwrapper.ErrTimeSeekNotSupported
+	}
+	t := tsoutil.PhysicalTime(ts)
+	log.Info("MsgStream seek by time begin", zap.String("channel", channel), zap.Uint64("ts", ts), zap.Time("time", t))
+	if err := seeker.SeekByTime(t); err != nil {
+		log.Warn("Failed to seek by time", zap.String("channel", channel), zap.Error(err))
+		return err
+	}
+	log.Info("MsgStream seek by time finished", zap.String("channel", channel))
+	return nil
+}
+
 var _ MsgStream = (*MqTtMsgStream)(nil)
 
 // MqTtMsgStream is a msgstream that contains timeticks
@@ -461,6 +792,7 @@ type MqTtMsgStream struct {
 	chanMsgPos         map[mqwrapper.Consumer]*msgpb.MsgPosition
 	chanStopChan       map[mqwrapper.Consumer]chan bool
 	chanTtMsgTime      map[mqwrapper.Consumer]Timestamp
+	chanChunkAssembler map[mqwrapper.Consumer]*chunkAssembler
 	chanMsgBufMutex    *sync.Mutex
 	chanTtMsgTimeMutex *sync.RWMutex
 	chanWaitGroup      *sync.WaitGroup
@@ -483,6 +815,7 @@ func NewMqTtMsgStream(ctx context.Context,
 	chanMsgPos := make(map[mqwrapper.Consumer]*msgpb.MsgPosition)
 	chanStopChan := make(map[mqwrapper.Consumer]chan bool)
 	chanTtMsgTime := make(map[mqwrapper.Consumer]Timestamp)
+	chanChunkAssembler := make(map[mqwrapper.Consumer]*chunkAssembler)
 	syncConsumer := make(chan int, 1)
 
 	return &MqTtMsgStream{
@@ -491,6 +824,7 @@ func NewMqTtMsgStream(ctx context.Context,
 		chanMsgPos:         chanMsgPos,
 		chanStopChan:       chanStopChan,
 		chanTtMsgTime:      chanTtMsgTime,
+		chanChunkAssembler: chanChunkAssembler,
 		chanMsgBufMutex:    &sync.Mutex{},
 		chanTtMsgTimeMutex: &sync.RWMutex{},
 		chanWaitGroup:      &sync.WaitGroup{},
@@ -512,6 +846,9 @@ func (ms *MqTtMsgStream) addConsumer(consumer mqwrapper.Consumer, channel string
 	}
 	ms.chanStopChan[consumer] = make(chan bool)
 	ms.chanTtMsgTime[consumer] = 0
+	ms.chanChunkAssembler[consumer] = newChunkAssembler()
+	ms.addLagProbe(channel, consumer)
+	registerConsumerStats(channel, consumer)
 }
 
 // AsConsumerWithPosition subscribes channels as consumer for a MsgStream and seeks to a certain position.
@@ -719,7 +1056,8 @@ func (ms *MqTtMsgStream) bufMsgPackToChannel() {
 	}
 }
 
-// Save all msgs into chanMsgBuf[] till receive one ttMsg
+// Save all msgs into chanMsgBuf[] till receive one ttMsg,
+// the caller holds consumerLock until it returns.
 func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 	defer ms.chanWaitGroup.Done()
 	for {
@@ -734,16 +1072,31 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				return
 			}
 			consumer.Ack(msg)
+			ms.lagProbes[consumer].Observe(msg)
 
 			if msg.Payload() == nil {
 				log.Warn("MqTtMsgStream get msg whose payload is nil")
 				continue
 			}
+			msg, ok = ms.chanChunkAssembler[consumer].assemble(msg)
+			if !ok {
+				continue
+			}
+			decompressed, err := decompressMessage(msg)
+			if err != nil {
+				ms.sendDeadLetter(msg, err)
+				continue
+			}
+			msg = decompressed
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
 			if err != nil {
-				log.Error("Failed to getTsMsgFromConsumerMsg", zap.Error(err))
+				ms.sendDeadLetter(msg, err)
 				continue
 			}
+			// continue the trace of producer, so the flowgraphs consuming the tt stream are traced end to end
+			ctx, sp := ExtractCtx(tsMsg, msg.Properties())
+			tsMsg.SetTraceCtx(ctx)
+			sp.End()
 
 			ms.chanMsgBufMutex.Lock()
 			ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
@@ -836,46 +1189,101 @@ func (ms *MqTtMsgStream) Seek(ctx context.Context, msgPositions []*msgpb.MsgPosi
 		ms.chanMsgPos[consumer] = (proto.Clone(mp)).(*MsgPosition)
 
 		// skip all data before current tt
-		runLoop := true
-		for runLoop {
-			select {
-			case <-ms.ctx.Done():
-				return ms.ctx.Err()
-			case <-ctx.Done():
-				return ctx.Err()
-			case msg, ok := <-consumer.Chan():
-				if !ok {
-					return fmt.Errorf("consumer closed")
-				}
-				consumer.Ack(msg)
+		if _, err = ms.bufferMsgsAfterTs(ctx, consumer, mp.Timestamp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-				headerMsg := commonpb.MsgHeader{}
-				err := proto.Unmarshal(msg.Payload(), &headerMsg)
-				if err != nil {
-					return fmt.Errorf("failed to unmarshal message header, err %s", err.Error())
-				}
-				tsMsg, err := ms.unmarshal.Unmarshal(msg.Payload(), headerMsg.Base.MsgType)
-				if err != nil {
-					return fmt.Errorf("failed to unmarshal tsMsg, err %s", err.Error())
-				}
-				if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= mp.Timestamp {
-					runLoop = false
-				} else if tsMsg.BeginTs() > mp.Timestamp {
-					ctx, _ := ExtractCtx(tsMsg, msg.Properties())
-					tsMsg.SetTraceCtx(ctx)
-
-					tsMsg.SetPosition(&MsgPosition{
-						ChannelName: filepath.Base(msg.Topic()),
-						MsgID:       msg.ID().Serialize(),
-					})
-					ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
-				}
-			}
+// SeekByTime resets the subscription of the channel to the first message published not before ts,
+// the messages before ts are skipped until a time tick not earlier than ts is received.
+func (ms *MqTtMsgStream) SeekByTime(ctx context.Context, channel string, ts Timestamp) error {
+	ms.consumerLock.Lock()
+	defer ms.consumerLock.Unlock()
+
+	consumer, ok := ms.consumers[channel]
+	if !ok {
+		return fmt.Errorf("please subcribe the channel, channel name =%s", channel)
+	}
+	fn := func() error {
+		err := seekConsumerByTime(consumer, channel, ts)
+		if errors.Is(err, merr.ErrMqTopicNotFound) || errors.Is(err, mqwrapper.ErrTimeSeekNotSupported) {
+			return retry.Unrecoverable(err)
 		}
+		return err
+	}
+	err := retry.Do(ctx, fn, retry.Attempts(20), retry.Sleep(time.Millisecond*200), retry.MaxSleepTime(5*time.Second))
+	if err != nil {
+		return fmt.Errorf("failed to seek by time, error %s", err.Error())
+	}
+	ms.addConsumer(consumer, channel)
+
+	// messages after ts - 1 are kept, so the start position replays the same messages when seeking to it
+	startTs := ts
+	if startTs > 0 {
+		startTs--
+	}
+	firstMsgID, err := ms.bufferMsgsAfterTs(ctx, consumer, startTs)
+	if err != nil {
+		return err
+	}
+	ms.chanMsgPos[consumer] = &MsgPosition{
+		ChannelName: channel,
+		MsgID:       firstMsgID,
+		Timestamp:   startTs,
 	}
 	return nil
 }
 
+// bufferMsgsAfterTs consumes messages until a time tick not earlier than ts is received,
+// the messages after ts are buffered into chanMsgBuf, and the id of the first consumed message is returned.
+// It must be called with consumerLock held.
+func (ms *MqTtMsgStream) bufferMsgsAfterTs(ctx context.Context, consumer mqwrapper.Consumer, ts Timestamp) ([]byte, error) {
+	var firstMsgID []byte
+	for {
+		select {
+		case <-ms.ctx.Done():
+			return nil, ms.ctx.Err()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case msg, ok := <-consumer.Chan():
+			if !ok {
+				return nil, fmt.Errorf("consumer closed")
+			}
+			consumer.Ack(msg)
+			ms.lagProbes[consumer].Observe(msg)
+			msg, ok = ms.chanChunkAssembler[consumer].assemble(msg)
+			if !ok {
+				continue
+			}
+			if firstMsgID == nil {
+				firstMsgID = msg.ID().Serialize()
+			}
+			decompressed, err := decompressMessage(msg)
+			if err != nil {
+				ms.sendDeadLetter(msg, err)
+				continue
+			}
+			msg = decompressed
+			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
+			if err != nil {
+				ms.sendDeadLetter(msg, err)
+				continue
+			}
+			if tsMsg.Type() == commonpb.MsgType_TimeTick && tsMsg.BeginTs() >= ts {
+				return firstMsgID, nil
+			} else if tsMsg.BeginTs() > ts {
+				ctx, sp := ExtractCtx(tsMsg, msg.Properties())
+				tsMsg.SetTraceCtx(ctx)
+				sp.End()
+
+				ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], tsMsg)
+			}
+		}
+	}
+}
+
 func (ms *MqTtMsgStream) Chan() <-chan *MsgPack {
 	ms.onceChan.Do(func() {
 		if ms.consumers != nil {
@@ -22,6 +22,7 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sync"
 	"testing"
 	"time"
 	"unsafe"
@@ -1130,6 +1131,109 @@ func (p *mockSendFailProducer) Send(_ context.Context, _ *mqwrapper.ProducerMess
 	return nil, errors.New("mocked error")
 }
 
+var _ mqwrapper.AsyncProducer = (*mockAsyncProducer)(nil)
+
+// mockAsyncProducer fails the async sends of the messages whose index is in failed
+type mockAsyncProducer struct {
+	mqwrapper.Producer
+	asyncSent int
+	failed    map[int]bool
+	sent      [][]byte
+}
+
+func (p *mockAsyncProducer) Send(_ context.Context, msg *mqwrapper.ProducerMessage) (MessageID, error) {
+	p.sent = append(p.sent, msg.Payload)
+	return chunkTestID(len(p.sent)), nil
+}
+
+func (p *mockAsyncProducer) SendAsync(ctx context.Context, msg *mqwrapper.ProducerMessage, callback mqwrapper.SendCallback) {
+	idx := p.asyncSent
+	p.asyncSent++
+	if p.failed[idx] {
+		go callback(nil, msg, errors.New("mocked error"))
+		return
+	}
+	id, _ := p.Send(ctx, msg)
+	go callback(id, msg, nil)
+}
+
+// mockBackpressureProducer applies backpressure to the first pressured sends
+type mockBackpressureProducer struct {
+	mqwrapper.Producer
+	pressured int
+	sent      int
+}
+
+func (p *mockBackpressureProducer) Send(_ context.Context, _ *mqwrapper.ProducerMessage) (MessageID, error) {
+	if p.pressured > 0 {
+		p.pressured--
+		return nil, mqwrapper.NewBackpressureError(errors.New("queue is full"), 10*time.Millisecond)
+	}
+	p.sent++
+	return chunkTestID(p.sent), nil
+}
+
+func TestMqMsgStream_ProduceUnderBackpressure(t *testing.T) {
+	ms := &mqMsgStream{ctx: context.Background(), producerLock: &sync.Mutex{}, codec: protoCodec{}}
+	msgs := []TsMsg{getTsMsg(commonpb.MsgType_Insert, 1)}
+
+	// resent once backpressure is released
+	producer := &mockBackpressureProducer{pressured: 3}
+	err := ms.produceToChannel(producer, msgs)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, producer.sent)
+
+	// backpressure error is returned after max wait
+	defer Params.Save(Params.ServiceParam.MQCfg.BackpressureMaxWait.Key, Params.ServiceParam.MQCfg.BackpressureMaxWait.DefaultValue)
+	Params.Save(Params.ServiceParam.MQCfg.BackpressureMaxWait.Key, "50")
+	producer = &mockBackpressureProducer{pressured: 100}
+	err = ms.produceToChannel(producer, msgs)
+	assert.ErrorIs(t, err, mqwrapper.ErrBackpressure)
+	assert.Zero(t, producer.sent)
+
+	// fails without waiting beyond max buffer size
+	defer Params.Save(Params.ServiceParam.MQCfg.BackpressureMaxBufferSize.Key, Params.ServiceParam.MQCfg.BackpressureMaxBufferSize.DefaultValue)
+	Params.Save(Params.ServiceParam.MQCfg.BackpressureMaxBufferSize.Key, "1")
+	producer = &mockBackpressureProducer{pressured: 100}
+	err = ms.produceToChannel(producer, msgs)
+	assert.ErrorIs(t, err, mqwrapper.ErrBackpressure)
+	assert.Equal(t, 99, producer.pressured)
+	assert.Zero(t, ms.backpressureBuffered)
+}
+
+func TestMqMsgStream_ProduceAsync(t *testing.T) {
+	ms := &mqMsgStream{ctx: context.Background(), producerLock: &sync.Mutex{}, codec: protoCodec{}}
+	msgs := []TsMsg{getTsMsg(commonpb.MsgType_Insert, 1), getTsMsg(commonpb.MsgType_Insert, 2), getTsMsg(commonpb.MsgType_Insert, 3)}
+	payloads := make([][]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		mb, err := msg.Marshal(msg)
+		assert.NoError(t, err)
+		payload, err := convertToByteArray(mb)
+		assert.NoError(t, err)
+		payloads = append(payloads, payload)
+	}
+
+	// the messages from the first failed one are resent in order
+	producer := &mockAsyncProducer{failed: map[int]bool{1: true}}
+	err := ms.produceToChannel(producer, msgs)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{payloads[0], payloads[2], payloads[1], payloads[2]}, producer.sent)
+
+	// the error is returned if resending fails
+	err = ms.produceToChannel(&mockSendFailProducer{}, msgs)
+	assert.Error(t, err)
+
+	// the callback is called before returning if the producer doesn't support async send
+	called := 0
+	for _, payload := range payloads {
+		ms.sendAsync(context.Background(), &mockSendFailProducer{}, &mqwrapper.ProducerMessage{Payload: payload}, func(id MessageID, err error) {
+			assert.Error(t, err)
+			called++
+		})
+	}
+	assert.Equal(t, 3, called)
+}
+
 /* ========================== Utility functions ========================== */
 func repackFunc(msgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, error) {
 	result := make(map[int32]*MsgPack)
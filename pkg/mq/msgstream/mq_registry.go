@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	kafkawrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	kinesiswrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kinesis"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/nmq"
+	pubsubwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pubsub"
+	pulsarmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pulsar"
+	rabbitmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/rabbitmq"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// MQBackend describes a message queue backend which is selected by mq.type
+type MQBackend struct {
+	// Name is the value of mq.type to select the backend
+	Name string
+	// StandaloneOnly indicates the backend is embedded and can't be shared by the nodes of a cluster
+	StandaloneOnly bool
+	// NewFactory creates the msgstream factory of the backend
+	NewFactory func(params *paramtable.ComponentParam) (Factory, error)
+	// UnmarshalMessageID validates and deserializes the message id of the backend without connecting to it
+	UnmarshalMessageID func(msgID []byte) (MessageID, error)
+}
+
+var (
+	mqBackendsMu sync.RWMutex
+	mqBackends   = make(map[string]MQBackend)
+)
+
+func init() {
+	RegisterMQBackend(MQBackend{
+		Name: "pulsar",
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewPmsFactory(&params.ServiceParam), nil
+		},
+		UnmarshalMessageID: pulsarmqwrapper.UnmarshalPulsarMsgID,
+	})
+	RegisterMQBackend(MQBackend{
+		Name: "kafka",
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewKmsFactory(&params.ServiceParam), nil
+		},
+		UnmarshalMessageID: kafkawrapper.UnmarshalKafkaID,
+	})
+	RegisterMQBackend(MQBackend{
+		Name:           "natsmq",
+		StandaloneOnly: true,
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewNatsmqFactory(), nil
+		},
+		UnmarshalMessageID: nmq.UnmarshalNmqID,
+	})
+	RegisterMQBackend(MQBackend{
+		Name: "kinesis",
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewKinesisFactory(&params.ServiceParam), nil
+		},
+		UnmarshalMessageID: kinesiswrapper.UnmarshalKinesisID,
+	})
+	RegisterMQBackend(MQBackend{
+		Name: "pubsub",
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewPubsubFactory(&params.ServiceParam), nil
+		},
+		UnmarshalMessageID: pubsubwrapper.UnmarshalPubsubID,
+	})
+	RegisterMQBackend(MQBackend{
+		Name: "rabbitmq",
+		NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+			return NewRabbitmqFactory(&params.ServiceParam), nil
+		},
+		UnmarshalMessageID: rabbitmqwrapper.UnmarshalRabbitmqID,
+	})
+}
+
+// RegisterMQBackend makes a mq backend available by its name,
+// out-of-tree backends are supposed to call it in the init function of their packages.
+// It panics if the backend is registered twice or the NewFactory is nil.
+func RegisterMQBackend(backend MQBackend) {
+	mqBackendsMu.Lock()
+	defer mqBackendsMu.Unlock()
+	if backend.NewFactory == nil {
+		panic(fmt.Sprintf("mq backend %s is registered without factory constructor", backend.Name))
+	}
+	if _, ok := mqBackends[backend.Name]; ok {
+		panic(fmt.Sprintf("mq backend %s is registered twice", backend.Name))
+	}
+	mqBackends[backend.Name] = backend
+}
+
+// GetMQBackend returns the registered mq backend by name
+func GetMQBackend(name string) (MQBackend, bool) {
+	mqBackendsMu.RLock()
+	defer mqBackendsMu.RUnlock()
+	backend, ok := mqBackends[name]
+	return backend, ok
+}
+
+// RegisteredMQBackends returns the sorted names of all the registered mq backends
+func RegisteredMQBackends() []string {
+	mqBackendsMu.RLock()
+	defer mqBackendsMu.RUnlock()
+	names := make([]string, 0, len(mqBackends))
+	for name := range mqBackends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UnmarshalMessageID deserializes the message id of the mq type, an error wrapping mqwrapper.ErrInvalidMessageID
+// is returned if the bytes are not a valid message id of the mq type, e.g. the position is persisted by another mq.
+func UnmarshalMessageID(mqType string, msgID []byte) (MessageID, error) {
+	backend, ok := GetMQBackend(mqType)
+	if !ok {
+		return nil, errors.Newf("mq type %s is not registered", mqType)
+	}
+	if backend.UnmarshalMessageID == nil {
+		return nil, errors.Newf("mq type %s doesn't support unmarshaling message id", mqType)
+	}
+	return backend.UnmarshalMessageID(msgID)
+}
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	kafkawrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	kinesiswrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kinesis"
+	pubsubwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pubsub"
+	pulsarmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pulsar"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMQBackendRegistry(t *testing.T) {
+	t.Run("builtin backends", func(t *testing.T) {
+		for _, name := range []string{"pulsar", "kafka", "natsmq", "kinesis", "pubsub", "rabbitmq"} {
+			backend, ok := GetMQBackend(name)
+			assert.True(t, ok)
+			assert.Equal(t, name, backend.Name)
+		}
+		backend, _ := GetMQBackend("natsmq")
+		assert.True(t, backend.StandaloneOnly)
+		backend, _ = GetMQBackend("pulsar")
+		assert.False(t, backend.StandaloneOnly)
+	})
+
+	t.Run("register", func(t *testing.T) {
+		_, ok := GetMQBackend("test_registry")
+		assert.False(t, ok)
+
+		factory := NewMockMqFactory()
+		RegisterMQBackend(MQBackend{
+			Name: "test_registry",
+			NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+				return factory, nil
+			},
+		})
+		defer func() {
+			mqBackendsMu.Lock()
+			delete(mqBackends, "test_registry")
+			mqBackendsMu.Unlock()
+		}()
+
+		backend, ok := GetMQBackend("test_registry")
+		assert.True(t, ok)
+		f, err := backend.NewFactory(paramtable.Get())
+		assert.NoError(t, err)
+		assert.Equal(t, factory, f)
+		assert.Contains(t, RegisteredMQBackends(), "test_registry")
+
+		assert.Panics(t, func() {
+			RegisterMQBackend(MQBackend{Name: "test_registry", NewFactory: backend.NewFactory})
+		})
+		assert.Panics(t, func() {
+			RegisterMQBackend(MQBackend{Name: "test_registry_nil"})
+		})
+	})
+
+	t.Run("sorted names", func(t *testing.T) {
+		names := RegisteredMQBackends()
+		for i := 1; i < len(names); i++ {
+			assert.Less(t, names[i-1], names[i])
+		}
+	})
+
+	t.Run("unmarshal message id", func(t *testing.T) {
+		kafkaID := kafkawrapper.SerializeKafkaID(5)
+		pulsarID := pulsarmqwrapper.SerializePulsarMsgID(pulsar.EarliestMessageID())
+		pubsubID := pubsubwrapper.SerializePubsubID(5, "10")
+		kinesisID := kinesiswrapper.SerializeKinesisID("shardId-000000000000", "49590338271490256608559692538361571095921575989136588898")
+
+		for mqType, msgID := range map[string][]byte{
+			"kafka":    kafkaID,
+			"pulsar":   pulsarID,
+			"pubsub":   pubsubID,
+			"kinesis":  kinesisID,
+			"natsmq":   kafkaID,
+			"rabbitmq": kafkaID,
+		} {
+			id, err := UnmarshalMessageID(mqType, msgID)
+			assert.NoError(t, err, mqType)
+			assert.Equal(t, msgID, id.Serialize(), mqType)
+		}
+
+		// positions written by another mq type
+		for mqType, msgID := range map[string][]byte{
+			"kafka":    pulsarID,
+			"pulsar":   kafkaID,
+			"pubsub":   []byte{1, 2, 3},
+			"kinesis":  pubsubID,
+			"natsmq":   pubsubID,
+			"rabbitmq": pulsarID,
+		} {
+			_, err := UnmarshalMessageID(mqType, msgID)
+			assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID, mqType)
+		}
+
+		// ids of the right size but not encoded by the mq
+		for mqType, msgID := range map[string][]byte{
+			"kafka":    kafkawrapper.SerializeKafkaID(-3),
+			"natsmq":   kafkawrapper.SerializeKafkaID(0),
+			"rabbitmq": kafkawrapper.SerializeKafkaID(-2),
+			"pubsub":   pubsubwrapper.SerializePubsubID(-1, "10"),
+		} {
+			_, err := UnmarshalMessageID(mqType, msgID)
+			assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID, mqType)
+		}
+		_, err := UnmarshalMessageID("pubsub", append(kafkawrapper.SerializeKafkaID(5), 0xff))
+		assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
+
+		_, err = UnmarshalMessageID("unknown", kafkaID)
+		assert.Error(t, err)
+
+		RegisterMQBackend(MQBackend{
+			Name: "test_unmarshal",
+			NewFactory: func(params *paramtable.ComponentParam) (Factory, error) {
+				return NewMockMqFactory(), nil
+			},
+		})
+		defer func() {
+			mqBackendsMu.Lock()
+			delete(mqBackends, "test_unmarshal")
+			mqBackendsMu.Unlock()
+		}()
+		_, err = UnmarshalMessageID("test_unmarshal", kafkaID)
+		assert.Error(t, err)
+	})
+}
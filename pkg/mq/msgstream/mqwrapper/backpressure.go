@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrBackpressure is returned by Send if the mq is saturated, the producer should slow down and retry later
+var ErrBackpressure = errors.New("mq backpressure")
+
+// backpressureError carries the cause of backpressure and how long to wait before retry
+type backpressureError struct {
+	cause      error
+	retryAfter time.Duration
+}
+
+// NewBackpressureError returns an error matching ErrBackpressure and cause,
+// the retryAfter is the suggested duration to wait before resending
+func NewBackpressureError(cause error, retryAfter time.Duration) error {
+	return &backpressureError{cause: cause, retryAfter: retryAfter}
+}
+
+func (e *backpressureError) Error() string {
+	return fmt.Sprintf("%s, retry after %s: %s", ErrBackpressure.Error(), e.retryAfter, e.cause.Error())
+}
+
+func (e *backpressureError) Is(target error) bool {
+	return target == ErrBackpressure
+}
+
+func (e *backpressureError) Unwrap() error {
+	return e.cause
+}
+
+// RetryAfter returns the suggested duration to wait before resending if err is caused by backpressure
+func RetryAfter(err error) (time.Duration, bool) {
+	var bp *backpressureError
+	if errors.As(err, &bp) {
+		return bp.retryAfter, true
+	}
+	return 0, errors.Is(err, ErrBackpressure)
+}
@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackpressureError(t *testing.T) {
+	cause := errors.New("queue is full")
+	err := NewBackpressureError(cause, time.Second)
+	assert.ErrorIs(t, err, ErrBackpressure)
+	assert.ErrorIs(t, err, cause)
+
+	retryAfter, ok := RetryAfter(errors.Wrap(err, "send failed"))
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+
+	retryAfter, ok = RetryAfter(ErrBackpressure)
+	assert.True(t, ok)
+	assert.Zero(t, retryAfter)
+
+	_, ok = RetryAfter(cause)
+	assert.False(t, ok)
+	_, ok = RetryAfter(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import "time"
+
+// BatchChan reads messages from ch and yields them in batches of at most maxMessages,
+// a batch is yielded once it's full or maxWait has elapsed since its first message arrived.
+// The returned channel is closed after the pending batch is yielded when ch is closed, or when done is closed.
+func BatchChan(ch <-chan Message, done <-chan struct{}, maxMessages int, maxWait time.Duration) <-chan []Message {
+	if maxMessages <= 0 {
+		maxMessages = 1
+	}
+	batchCh := make(chan []Message)
+	go func() {
+		defer close(batchCh)
+		for {
+			var batch []Message
+			select {
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				batch = append(make([]Message, 0, maxMessages), msg)
+			case <-done:
+				return
+			}
+
+			closed := false
+			timer := time.NewTimer(maxWait)
+		fill:
+			for len(batch) < maxMessages {
+				select {
+				case msg, ok := <-ch:
+					if !ok {
+						closed = true
+						break fill
+					}
+					batch = append(batch, msg)
+				case <-timer.C:
+					break fill
+				case <-done:
+					timer.Stop()
+					return
+				}
+			}
+			timer.Stop()
+
+			select {
+			case batchCh <- batch:
+			case <-done:
+				return
+			}
+			if closed {
+				return
+			}
+		}
+	}()
+	return batchCh
+}
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchChan(t *testing.T) {
+	t.Run("full batch", func(t *testing.T) {
+		ch := make(chan Message, 5)
+		for i := 0; i < 5; i++ {
+			ch <- &lagTestMessage{id: lagTestID(i)}
+		}
+		batchCh := BatchChan(ch, make(chan struct{}), 2, time.Hour)
+		assert.Equal(t, 2, len(<-batchCh))
+		assert.Equal(t, 2, len(<-batchCh))
+
+		// the last message is yielded after ch is closed
+		close(ch)
+		batch := <-batchCh
+		assert.Equal(t, 1, len(batch))
+		assert.Equal(t, lagTestID(4), batch[0].ID())
+		_, ok := <-batchCh
+		assert.False(t, ok)
+	})
+
+	t.Run("max wait", func(t *testing.T) {
+		ch := make(chan Message, 5)
+		ch <- &lagTestMessage{id: lagTestID(1)}
+		batchCh := BatchChan(ch, make(chan struct{}), 10, 10*time.Millisecond)
+		batch := <-batchCh
+		assert.Equal(t, 1, len(batch))
+	})
+
+	t.Run("non-positive max messages", func(t *testing.T) {
+		ch := make(chan Message, 5)
+		ch <- &lagTestMessage{id: lagTestID(1)}
+		ch <- &lagTestMessage{id: lagTestID(2)}
+		batchCh := BatchChan(ch, make(chan struct{}), 0, time.Hour)
+		assert.Equal(t, 1, len(<-batchCh))
+		assert.Equal(t, 1, len(<-batchCh))
+	})
+
+	t.Run("done", func(t *testing.T) {
+		ch := make(chan Message, 5)
+		done := make(chan struct{})
+		batchCh := BatchChan(ch, done, 10, time.Hour)
+		ch <- &lagTestMessage{id: lagTestID(1)}
+		close(done)
+		_, ok := <-batchCh
+		assert.False(t, ok)
+	})
+}
@@ -16,6 +16,12 @@
 
 package mqwrapper
 
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
 // SubscriptionInitialPosition is the type of a subscription initial position
 type SubscriptionInitialPosition int
 
@@ -74,3 +80,49 @@ type Consumer interface {
 	// check created topic whether vaild or not
 	CheckTopicValid(channel string) error
 }
+
+// TimeSeekableConsumer is the interface of Consumer that supports seeking by publish time
+type TimeSeekableConsumer interface {
+	Consumer
+
+	// SeekByTime resets the consumer to the first message published not before the given time,
+	// the position may be earlier than the time if the mq records publish time coarsely
+	SeekByTime(t time.Time) error
+}
+
+// ConsumerStats is the statistics of a consumer
+type ConsumerStats struct {
+	Topic        string
+	Subscription string
+	// Received is the number of messages received from mq
+	Received int64
+	// Acked is the number of messages acked
+	Acked int64
+	// Pending is the number of messages buffered but not read from Chan yet
+	Pending int
+	// LastDispatchTime is the time when a message was dispatched into Chan last time, zero if never
+	LastDispatchTime time.Time
+}
+
+// StatsConsumer is the interface of Consumer that reports its statistics
+type StatsConsumer interface {
+	Consumer
+
+	// Stats returns the statistics of the consumer
+	Stats() ConsumerStats
+}
+
+// CostlyLatestConsumer is the interface of Consumer whose GetLatestMsgID is too costly to be called periodically,
+// such as scanning the topic, the lag of these consumers is not probed
+type CostlyLatestConsumer interface {
+	Consumer
+
+	// LatestMsgIDCostly returns true if GetLatestMsgID is too costly to be called periodically
+	LatestMsgIDCostly() bool
+}
+
+// ErrTimeSeekNotSupported is returned if the consumer doesn't implement TimeSeekableConsumer
+var ErrTimeSeekNotSupported = errors.New("consumer doesn't support seek by time")
+
+// ErrLatestMsgIDNotSupported is returned by GetLatestMsgID if the mq can't tell the latest message of a topic
+var ErrLatestMsgIDNotSupported = errors.New("consumer doesn't support getting the latest message id")
@@ -16,6 +16,12 @@
 
 package mqwrapper
 
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
 // MessageID is the interface that provides operations of message is
 type MessageID interface {
 	// Serialize the message id into a sequence of bytes that can be stored somewhere else
@@ -27,3 +33,59 @@ type MessageID interface {
 
 	Equal(msgID []byte) (bool, error)
 }
+
+// RangeMessageID is the interface of MessageID that supports position arithmetic,
+// it's implemented by the message queues whose message ids are sequential integers.
+type RangeMessageID interface {
+	MessageID
+
+	// Distance returns the number of positions from current id to the given id,
+	// the result is negative if the given id is before current id
+	Distance(msgID []byte) (int64, error)
+
+	// Add returns the id which is n positions after current id
+	Add(n int64) MessageID
+}
+
+// ErrRangeNotSupported is returned if the message id doesn't implement RangeMessageID
+var ErrRangeNotSupported = errors.New("message id doesn't support range arithmetic")
+
+// Distance returns the number of positions between from and to,
+// ErrRangeNotSupported is returned if from is not a RangeMessageID
+func Distance(from MessageID, to MessageID) (int64, error) {
+	rid, ok := from.(RangeMessageID)
+	if !ok {
+		return 0, ErrRangeNotSupported
+	}
+	return rid.Distance(to.Serialize())
+}
+
+// Add returns the id which is n positions after msgID,
+// ErrRangeNotSupported is returned if msgID is not a RangeMessageID
+func Add(msgID MessageID, n int64) (MessageID, error) {
+	rid, ok := msgID.(RangeMessageID)
+	if !ok {
+		return nil, ErrRangeNotSupported
+	}
+	return rid.Add(n), nil
+}
+
+// ErrInvalidMessageID is returned if the bytes are not a message id of the mq,
+// it usually means the position is persisted by another mq type.
+var ErrInvalidMessageID = errors.New("invalid message id")
+
+// UnmarshalInt64MessageID deserializes the message id of the mq encoded as an 8 bytes integer by common.Endian,
+// it checks the size and that the value is not less than min, e.g. the earliest id of the mq.
+// The ids of the other mq types encoded as integers may still pass the check.
+func UnmarshalInt64MessageID(mqType string, msgID []byte, min int64) (int64, error) {
+	if len(msgID) != 8 {
+		return 0, errors.Wrapf(ErrInvalidMessageID, "%s message id should be 8 bytes but got %d bytes, "+
+			"check whether the position is written by another mq type", mqType, len(msgID))
+	}
+	id := int64(common.Endian.Uint64(msgID))
+	if id < min {
+		return 0, errors.Wrapf(ErrInvalidMessageID, "%s message id should be at least %d but got %d, "+
+			"check whether the position is written by another mq type", mqType, min, id)
+	}
+	return id, nil
+}
@@ -229,8 +229,7 @@ func (kc *kafkaClient) specialExtraConfig(current *kafka.ConfigMap, special kafk
 }
 
 func (kc *kafkaClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
-	offset := DeserializeKafkaID(id)
-	return &kafkaID{messageID: offset}, nil
+	return UnmarshalKafkaID(id)
 }
 
 func (kc *kafkaClient) Close() {
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -28,6 +29,8 @@ type Consumer struct {
 	wg         sync.WaitGroup
 }
 
+var _ mqwrapper.TimeSeekableConsumer = (*Consumer)(nil)
+
 const timeout = 3000
 
 func newKafkaConsumer(config *kafka.ConfigMap, bufSize int64, topic string, groupID string, position mqwrapper.SubscriptionInitialPosition) (*Consumer, error) {
@@ -209,6 +212,35 @@ func (kc *Consumer) internalSeek(offset kafka.Offset, inclusive bool) error {
 	return nil
 }
 
+// SeekByTime assigns the consumer to the first offset whose timestamp is not before the given time
+func (kc *Consumer) SeekByTime(t time.Time) error {
+	if kc.hasAssign {
+		return errors.New("kafka consumer is already assigned, can not seek again")
+	}
+
+	partitions, err := kc.c.OffsetsForTimes([]kafka.TopicPartition{{
+		Topic:     &kc.topic,
+		Partition: mqwrapper.DefaultPartitionIdx,
+		Offset:    kafka.Offset(t.UnixMilli()),
+	}}, timeout)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf("kafka consumer failed to get offset by time, topic %s", kc.topic)
+	}
+	if partitions[0].Error != nil {
+		return partitions[0].Error
+	}
+
+	offset := partitions[0].Offset
+	// all messages are published before the time
+	if offset < 0 {
+		offset = kafka.OffsetEnd
+	}
+	return kc.internalSeek(offset, true)
+}
+
 func (kc *Consumer) Ack(message mqwrapper.Message) {
 	// Do nothing
 	// Kafka retention mechanism only depends on retention configuration,
@@ -1,6 +1,8 @@
 package kafka
 
 import (
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
@@ -9,7 +11,7 @@ type kafkaID struct {
 	messageID int64
 }
 
-var _ mqwrapper.MessageID = &kafkaID{}
+var _ mqwrapper.RangeMessageID = &kafkaID{}
 
 func (kid *kafkaID) Serialize() []byte {
 	return SerializeKafkaID(kid.messageID)
@@ -27,6 +29,17 @@ func (kid *kafkaID) LessOrEqualThan(msgID []byte) (bool, error) {
 	return kid.messageID <= DeserializeKafkaID(msgID), nil
 }
 
+// Distance returns the offset distance to the given id, which is the message number between them
+func (kid *kafkaID) Distance(msgID []byte) (int64, error) {
+	return DeserializeKafkaID(msgID) - kid.messageID, nil
+}
+
+func (kid *kafkaID) Add(n int64) mqwrapper.MessageID {
+	return &kafkaID{
+		messageID: kid.messageID + n,
+	}
+}
+
 func SerializeKafkaID(messageID int64) []byte {
 	b := make([]byte, 8)
 	common.Endian.PutUint64(b, uint64(messageID))
@@ -36,3 +49,12 @@ func SerializeKafkaID(messageID int64) []byte {
 func DeserializeKafkaID(messageID []byte) int64 {
 	return int64(common.Endian.Uint64(messageID))
 }
+
+// UnmarshalKafkaID validates and deserializes the message id of kafka, which is an offset not less than OffsetBeginning
+func UnmarshalKafkaID(messageID []byte) (mqwrapper.MessageID, error) {
+	offset, err := mqwrapper.UnmarshalInt64MessageID("kafka", messageID, int64(kafka.OffsetBeginning))
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
@@ -71,3 +71,27 @@ func Test_DeserializeKafkaID(t *testing.T) {
 	id := DeserializeKafkaID(bin)
 	assert.Equal(t, id, int64(5))
 }
+
+func TestKafkaID_Distance(t *testing.T) {
+	id1 := &kafkaID{messageID: 5}
+	id2 := &kafkaID{messageID: 15}
+
+	d, err := id1.Distance(id2.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), d)
+
+	d, err = id2.Distance(id1.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), d)
+}
+
+func TestKafkaID_Add(t *testing.T) {
+	id := &kafkaID{messageID: 5}
+
+	next := id.Add(10)
+	assert.Equal(t, int64(15), DeserializeKafkaID(next.Serialize()))
+	assert.Equal(t, int64(5), id.messageID)
+
+	prev := id.Add(-2)
+	assert.Equal(t, int64(3), DeserializeKafkaID(prev.Serialize()))
+}
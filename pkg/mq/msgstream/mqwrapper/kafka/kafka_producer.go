@@ -16,6 +16,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
+var _ mqwrapper.AsyncProducer = (*kafkaProducer)(nil)
+
 type kafkaProducer struct {
 	p            *kafka.Producer
 	topic        string
@@ -31,6 +33,7 @@ func (kp *kafkaProducer) Topic() string {
 func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
 	start := timerecord.NewTimeRecorder("send msg to stream")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
 
 	if kp.isClosed {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
@@ -45,12 +48,13 @@ func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMe
 	}
 	err := kp.p.Produce(&kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: mqwrapper.DefaultPartitionIdx},
+		Key:            orderingKey(message),
 		Value:          message.Payload,
 		Headers:        headers,
 	}, kp.deliveryChan)
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
-		return nil, err
+		return nil, checkBackpressure(err)
 	}
 
 	e, ok := <-kp.deliveryChan
@@ -73,6 +77,69 @@ func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMe
 	return &kafkaID{messageID: int64(m.TopicPartition.Offset)}, nil
 }
 
+// SendAsync publishes the message without waiting for the delivery report,
+// each message has its own delivery channel so it doesn't interfere with Send.
+func (kp *kafkaProducer) SendAsync(ctx context.Context, message *mqwrapper.ProducerMessage, callback mqwrapper.SendCallback) {
+	start := timerecord.NewTimeRecorder("send msg to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
+
+	if kp.isClosed {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		callback(nil, message, common.NewIgnorableError(fmt.Errorf("kafka producer is closed")))
+		return
+	}
+
+	headers := make([]kafka.Header, 0, len(message.Properties))
+	for key, value := range message.Properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	deliveryChan := make(chan kafka.Event, 1)
+	err := kp.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: mqwrapper.DefaultPartitionIdx},
+		Key:            orderingKey(message),
+		Value:          message.Payload,
+		Headers:        headers,
+	}, deliveryChan)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		callback(nil, message, checkBackpressure(err))
+		return
+	}
+
+	go func() {
+		m := (<-deliveryChan).(*kafka.Message)
+		if m.TopicPartition.Error != nil {
+			metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+			callback(nil, message, m.TopicPartition.Error)
+			return
+		}
+		metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Inc()
+		callback(&kafkaID{messageID: int64(m.TopicPartition.Offset)}, message, nil)
+	}()
+}
+
+// orderingKey returns the ordering key of the message as kafka message key, nil if it's not set
+func orderingKey(message *mqwrapper.ProducerMessage) []byte {
+	key := mqwrapper.GetOrderingKey(message.Properties)
+	if key == "" {
+		return nil
+	}
+	return []byte(key)
+}
+
+// backpressureRetryAfter is the suggested wait before resending once the local producer queue is full
+const backpressureRetryAfter = 100 * time.Millisecond
+
+// checkBackpressure converts the error of full producer queue into backpressure
+func checkBackpressure(err error) error {
+	if kerr, ok := err.(kafka.Error); ok && kerr.Code() == kafka.ErrQueueFull {
+		return mqwrapper.NewBackpressureError(err, backpressureRetryAfter)
+	}
+	return err
+}
+
 func (kp *kafkaProducer) Close() {
 	kp.closeOnce.Do(func() {
 		kp.isClosed = true
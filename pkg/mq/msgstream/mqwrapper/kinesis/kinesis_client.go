@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// kinesisClient maps each topic to a kinesis data stream created with a single shard, and the records are put
+// with the topic as partition key, so that the records of a topic are totally ordered like the other mq.
+// The consumers read all the shards following their lineage, in case the stream is resharded.
+type kinesisClient struct {
+	client kinesisiface.KinesisAPI
+}
+
+var _ mqwrapper.Client = &kinesisClient{}
+
+// NewClient creates a kinesisClient with the given kinesis api
+func NewClient(client kinesisiface.KinesisAPI) *kinesisClient {
+	return &kinesisClient{client: client}
+}
+
+// NewClientWithConfig creates a kinesisClient with the kinesis config of paramtable
+func NewClientWithConfig(ctx context.Context, config *paramtable.KinesisConfig) (*kinesisClient, error) {
+	awsConfig := aws.NewConfig().WithRegion(config.Region.GetValue())
+	if endpoint := config.Endpoint.GetValue(); endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint)
+	}
+	accessKeyID, secretAccessKey := config.AccessKeyID.GetValue(), config.SecretAccessKey.GetValue()
+	if (accessKeyID == "") != (secretAccessKey == "") {
+		return nil, errors.New("kinesis accessKeyID and secretAccessKey should be configured at the same time")
+	}
+	if accessKeyID != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""))
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		log.Error("create kinesis session failed", zap.Error(err))
+		return nil, err
+	}
+	log.Info("init kinesis client", zap.String("region", config.Region.GetValue()), zap.String("endpoint", config.Endpoint.GetValue()))
+	return NewClient(kinesis.New(sess)), nil
+}
+
+func (kc *kinesisClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	start := timerecord.NewTimeRecorder("create producer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.TotalLabel).Inc()
+
+	if err := ensureStream(context.TODO(), kc.client, options.Topic); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateProducerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.SuccessLabel).Inc()
+	return &kinesisProducer{client: kc.client, topic: options.Topic}, nil
+}
+
+func (kc *kinesisClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	start := timerecord.NewTimeRecorder("create consumer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.TotalLabel).Inc()
+
+	// some implementation try to consume a non-exist topic, such as dataCoordTimeTick,
+	// so the stream is created on subscribing to keep compatible with other mq.
+	if err := ensureStream(context.TODO(), kc.client, options.Topic); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	consumer, err := newKinesisConsumer(kc.client, options.BufSize, options.Topic, options.SubscriptionName, options.SubscriptionInitialPosition)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateConsumerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.SuccessLabel).Inc()
+	return consumer, nil
+}
+
+func (kc *kinesisClient) EarliestMessageID() mqwrapper.MessageID {
+	return &kinesisID{sequenceNumber: ""}
+}
+
+// StringToMsgID parses the id in the format of "shardID/sequenceNumber", the shard id part is optional
+func (kc *kinesisClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	shardID, sequenceNumber, err := DeserializeKinesisID([]byte(id))
+	if err != nil {
+		return nil, err
+	}
+	if sequenceNumber == "" {
+		return nil, errors.Newf("invalid kinesis sequence number %s", id)
+	}
+	return &kinesisID{shardID: shardID, sequenceNumber: sequenceNumber}, nil
+}
+
+func (kc *kinesisClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	return UnmarshalKinesisID(id)
+}
+
+func (kc *kinesisClient) Close() {
+}
+
+// ensureStream creates the stream of topic with a single shard if not exist, and waits until it's active
+func ensureStream(ctx context.Context, client kinesisiface.KinesisAPI, topic string) error {
+	_, err := client.CreateStreamWithContext(ctx, &kinesis.CreateStreamInput{
+		StreamName: aws.String(topic),
+		ShardCount: aws.Int64(1),
+	})
+	if err != nil && !isErrorCode(err, kinesis.ErrCodeResourceInUseException) {
+		log.Warn("create kinesis stream failed", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+	return client.WaitUntilStreamExistsWithContext(ctx, &kinesis.DescribeStreamInput{
+		StreamName: aws.String(topic),
+	})
+}
+
+// listShards returns the shards of the topic stream, including the closed ones still in the retention period
+func listShards(ctx context.Context, client kinesisiface.KinesisAPI, topic string) ([]*kinesis.Shard, error) {
+	var shards []*kinesis.Shard
+	input := &kinesis.DescribeStreamInput{StreamName: aws.String(topic)}
+	for {
+		output, err := client.DescribeStreamWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, output.StreamDescription.Shards...)
+		if !aws.BoolValue(output.StreamDescription.HasMoreShards) || len(output.StreamDescription.Shards) == 0 {
+			break
+		}
+		input.ExclusiveStartShardId = shards[len(shards)-1].ShardId
+	}
+	if len(shards) == 0 {
+		return nil, errors.Newf("no shard found in kinesis stream %s", topic)
+	}
+	return shards, nil
+}
+
+// isShardOpen checks whether the shard may have new records, a closed shard has an ending sequence number
+func isShardOpen(shard *kinesis.Shard) bool {
+	return shard.SequenceNumberRange == nil || shard.SequenceNumberRange.EndingSequenceNumber == nil
+}
+
+// shardParents returns the parents of the shard which are still listed, the ones out of the retention period are ignored
+func shardParents(shard *kinesis.Shard, listed map[string]*kinesis.Shard) []string {
+	var parents []string
+	for _, parent := range []*string{shard.ParentShardId, shard.AdjacentParentShardId} {
+		if _, ok := listed[aws.StringValue(parent)]; ok {
+			parents = append(parents, aws.StringValue(parent))
+		}
+	}
+	return parents
+}
+
+// shardMap returns the shards by id
+func shardMap(shards []*kinesis.Shard) map[string]*kinesis.Shard {
+	listed := make(map[string]*kinesis.Shard, len(shards))
+	for _, shard := range shards {
+		listed[aws.StringValue(shard.ShardId)] = shard
+	}
+	return listed
+}
+
+// isErrorCode checks whether err is an aws error with the given code
+func isErrorCode(err error, code string) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == code
+}
@@ -0,0 +1,732 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const seqBase = "49590338271490256608559692538361571095921575989"
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().KinesisCfg.PollInterval.Key, "10")
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+// mockKinesis is an in-memory kinesis, a stream has the open shards and the closed ones resharded before,
+// the records are put to the last shard unless putTo is used,
+// the shard iterator is encoded as "stream/shard/index of next record".
+type mockKinesis struct {
+	kinesisiface.KinesisAPI
+	mu      sync.Mutex
+	streams map[string]*mockStream
+	// read is the number of the records returned by GetRecords
+	read int
+}
+
+type mockStream struct {
+	shards []*mockShard
+	// next is the index of the next sequence number of the stream
+	next int
+}
+
+type mockShard struct {
+	id      string
+	parent  string
+	records []*kinesis.Record
+	start   string
+	// end is set once the shard is closed
+	end string
+}
+
+func newMockKinesis() *mockKinesis {
+	return &mockKinesis{streams: make(map[string]*mockStream)}
+}
+
+func sequenceNumber(idx int) string {
+	return fmt.Sprintf("%s%d", seqBase, 1000000+idx)
+}
+
+func (s *mockStream) open() *mockShard {
+	return s.shards[len(s.shards)-1]
+}
+
+func (s *mockStream) shard(id string) *mockShard {
+	for _, shard := range s.shards {
+		if shard.id == id {
+			return shard
+		}
+	}
+	return nil
+}
+
+func (s *mockStream) newShard(parent string) {
+	s.shards = append(s.shards, &mockShard{
+		id:     fmt.Sprintf("shardId-%012d", len(s.shards)),
+		parent: parent,
+		start:  sequenceNumber(s.next),
+	})
+}
+
+// reshard closes the open shard of the stream and creates its child
+func (m *mockKinesis) reshard(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stream := m.streams[name]
+	open := stream.open()
+	open.end = sequenceNumber(stream.next - 1)
+	stream.newShard(open.id)
+}
+
+// split closes the last shard of the stream and creates its two children
+func (m *mockKinesis) split(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stream := m.streams[name]
+	open := stream.open()
+	open.end = sequenceNumber(stream.next - 1)
+	stream.newShard(open.id)
+	stream.newShard(open.id)
+}
+
+// putTo puts a record to the shard of the stream
+func (m *mockKinesis) putTo(t *testing.T, name string, shardID string, payload string) mqwrapper.MessageID {
+	data, err := encodeRecordData(&mqwrapper.ProducerMessage{
+		Payload:    []byte(payload),
+		Properties: map[string]string{"payload": payload},
+	})
+	assert.NoError(t, err)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stream := m.streams[name]
+	seq := sequenceNumber(stream.next)
+	stream.next++
+	shard := stream.shard(shardID)
+	shard.records = append(shard.records, &kinesis.Record{
+		SequenceNumber:              aws.String(seq),
+		Data:                        data,
+		ApproximateArrivalTimestamp: aws.Time(time.Now()),
+	})
+	return &kinesisID{shardID: shardID, sequenceNumber: seq}
+}
+
+// age moves the arrival time of the records of the stream backward
+func (m *mockKinesis) age(name string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, shard := range m.streams[name].shards {
+		for _, record := range shard.records {
+			record.ApproximateArrivalTimestamp = aws.Time(record.ApproximateArrivalTimestamp.Add(-d))
+		}
+	}
+}
+
+func (m *mockKinesis) CreateStreamWithContext(ctx aws.Context, input *kinesis.CreateStreamInput, opts ...request.Option) (*kinesis.CreateStreamOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := aws.StringValue(input.StreamName)
+	if _, ok := m.streams[name]; ok {
+		return nil, awserr.New(kinesis.ErrCodeResourceInUseException, "stream exists", nil)
+	}
+	stream := &mockStream{}
+	stream.newShard("")
+	m.streams[name] = stream
+	return &kinesis.CreateStreamOutput{}, nil
+}
+
+func (m *mockKinesis) WaitUntilStreamExistsWithContext(ctx aws.Context, input *kinesis.DescribeStreamInput, opts ...request.WaiterOption) error {
+	_, err := m.DescribeStreamWithContext(ctx, input)
+	return err
+}
+
+func (m *mockKinesis) DescribeStreamWithContext(ctx aws.Context, input *kinesis.DescribeStreamInput, opts ...request.Option) (*kinesis.DescribeStreamOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := aws.StringValue(input.StreamName)
+	stream, ok := m.streams[name]
+	if !ok {
+		return nil, awserr.New(kinesis.ErrCodeResourceNotFoundException, "stream not found", nil)
+	}
+	// a shard per page to cover the pagination
+	idx := 0
+	if input.ExclusiveStartShardId != nil {
+		for i, shard := range stream.shards {
+			if shard.id == aws.StringValue(input.ExclusiveStartShardId) {
+				idx = i + 1
+			}
+		}
+	}
+	var shards []*kinesis.Shard
+	if idx < len(stream.shards) {
+		shard := stream.shards[idx]
+		described := &kinesis.Shard{
+			ShardId:             aws.String(shard.id),
+			SequenceNumberRange: &kinesis.SequenceNumberRange{StartingSequenceNumber: aws.String(shard.start)},
+		}
+		if shard.parent != "" {
+			described.ParentShardId = aws.String(shard.parent)
+		}
+		if shard.end != "" {
+			described.SequenceNumberRange.EndingSequenceNumber = aws.String(shard.end)
+		}
+		shards = append(shards, described)
+	}
+	return &kinesis.DescribeStreamOutput{StreamDescription: &kinesis.StreamDescription{
+		StreamName:    input.StreamName,
+		StreamStatus:  aws.String(kinesis.StreamStatusActive),
+		Shards:        shards,
+		HasMoreShards: aws.Bool(idx+1 < len(stream.shards)),
+	}}, nil
+}
+
+func (m *mockKinesis) PutRecordWithContext(ctx aws.Context, input *kinesis.PutRecordInput, opts ...request.Option) (*kinesis.PutRecordOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := aws.StringValue(input.StreamName)
+	stream, ok := m.streams[name]
+	if !ok {
+		return nil, awserr.New(kinesis.ErrCodeResourceNotFoundException, "stream not found", nil)
+	}
+	seq := sequenceNumber(stream.next)
+	stream.next++
+	open := stream.open()
+	open.records = append(open.records, &kinesis.Record{
+		SequenceNumber:              aws.String(seq),
+		Data:                        input.Data,
+		ApproximateArrivalTimestamp: aws.Time(time.Now()),
+	})
+	return &kinesis.PutRecordOutput{SequenceNumber: aws.String(seq), ShardId: aws.String(open.id)}, nil
+}
+
+func (m *mockKinesis) GetShardIteratorWithContext(ctx aws.Context, input *kinesis.GetShardIteratorInput, opts ...request.Option) (*kinesis.GetShardIteratorOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := aws.StringValue(input.StreamName)
+	stream, ok := m.streams[name]
+	if !ok {
+		return nil, awserr.New(kinesis.ErrCodeResourceNotFoundException, "stream not found", nil)
+	}
+	shard := stream.shard(aws.StringValue(input.ShardId))
+	if shard == nil {
+		return nil, awserr.New(kinesis.ErrCodeResourceNotFoundException, "shard not found", nil)
+	}
+	records := shard.records
+	idx := 0
+	switch aws.StringValue(input.ShardIteratorType) {
+	case kinesis.ShardIteratorTypeLatest:
+		idx = len(records)
+	case kinesis.ShardIteratorTypeAtTimestamp:
+		idx = len(records)
+		for i, record := range records {
+			if !record.ApproximateArrivalTimestamp.Before(aws.TimeValue(input.Timestamp)) {
+				idx = i
+				break
+			}
+		}
+	case kinesis.ShardIteratorTypeAtSequenceNumber, kinesis.ShardIteratorTypeAfterSequenceNumber:
+		seq := aws.StringValue(input.StartingSequenceNumber)
+		for i, record := range records {
+			if aws.StringValue(record.SequenceNumber) == seq {
+				idx = i
+			}
+		}
+		if aws.StringValue(input.ShardIteratorType) == kinesis.ShardIteratorTypeAfterSequenceNumber {
+			idx++
+		}
+	}
+	return &kinesis.GetShardIteratorOutput{ShardIterator: aws.String(fmt.Sprintf("%s/%s/%d", name, shard.id, idx))}, nil
+}
+
+func (m *mockKinesis) GetRecordsWithContext(ctx aws.Context, input *kinesis.GetRecordsInput, opts ...request.Option) (*kinesis.GetRecordsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	parts := strings.Split(aws.StringValue(input.ShardIterator), "/")
+	idx, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	shard := m.streams[parts[0]].shard(parts[1])
+	records := shard.records
+	end := idx + int(aws.Int64Value(input.Limit))
+	if end > len(records) {
+		end = len(records)
+	}
+	m.read += end - idx
+	output := &kinesis.GetRecordsOutput{
+		Records:            records[idx:end],
+		MillisBehindLatest: aws.Int64(int64(len(records) - end)),
+	}
+	// the iterator of a closed shard read to the end is nil
+	if shard.end == "" || end < len(records) {
+		output.NextShardIterator = aws.String(fmt.Sprintf("%s/%s/%d", parts[0], shard.id, end))
+	}
+	return output, nil
+}
+
+func produce(t *testing.T, producer mqwrapper.Producer, payloads ...string) []mqwrapper.MessageID {
+	ids := make([]mqwrapper.MessageID, 0, len(payloads))
+	for _, payload := range payloads {
+		id, err := producer.Send(context.Background(), &mqwrapper.ProducerMessage{
+			Payload:    []byte(payload),
+			Properties: map[string]string{"payload": payload},
+		})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consume(t *testing.T, consumer mqwrapper.Consumer, n int) []mqwrapper.Message {
+	msgs := make([]mqwrapper.Message, 0, n)
+	for len(msgs) < n {
+		select {
+		case msg := <-consumer.Chan():
+			assert.Equal(t, string(msg.Payload()), msg.Properties()["payload"])
+			consumer.Ack(msg)
+			msgs = append(msgs, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("consume timeout")
+		}
+	}
+	return msgs
+}
+
+func TestKinesisClient_ProduceConsume(t *testing.T) {
+	client := NewClient(newMockKinesis())
+	defer client.Close()
+	topic := "kinesis_produce_consume"
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+	ids := produce(t, producer, "a", "b", "c")
+
+	t.Run("earliest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "earliest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+		assert.Equal(t, "earliest", consumer.Subscription())
+
+		msgs := consume(t, consumer, 3)
+		for i, msg := range msgs {
+			ret, err := msg.ID().Equal(ids[i].Serialize())
+			assert.NoError(t, err)
+			assert.True(t, ret)
+		}
+	})
+
+	t.Run("latest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "latest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		produce(t, producer, "d")
+		msgs := consume(t, consumer, 1)
+		assert.Equal(t, "d", string(msgs[0].Payload()))
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+		assert.Panics(t, func() { consumer.Chan() })
+
+		err = consumer.Seek(ids[1], false)
+		assert.NoError(t, err)
+		err = consumer.Seek(ids[1], true)
+		assert.Error(t, err)
+		msgs := consume(t, consumer, 1)
+		assert.Equal(t, "c", string(msgs[0].Payload()))
+
+		inclusive, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek_inclusive",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer inclusive.Close()
+		err = inclusive.Seek(ids[1], true)
+		assert.NoError(t, err)
+		msgs = consume(t, inclusive, 1)
+		assert.Equal(t, "b", string(msgs[0].Payload()))
+	})
+}
+
+func TestKinesisClient_MsgID(t *testing.T) {
+	client := NewClient(newMockKinesis())
+
+	earliest := client.EarliestMessageID()
+	assert.True(t, earliest.AtEarliestPosition())
+
+	id, err := client.StringToMsgID("shardId-000000000000/" + sequenceNumber(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "shardId-000000000000", id.(*kinesisID).shardID)
+	assert.Equal(t, sequenceNumber(1), id.(*kinesisID).sequenceNumber)
+	_, err = client.StringToMsgID("invalid")
+	assert.Error(t, err)
+	_, err = client.StringToMsgID("")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(SerializeKinesisID("shardId-000000000001", sequenceNumber(2)))
+	assert.NoError(t, err)
+	assert.Equal(t, "shardId-000000000001", id.(*kinesisID).shardID)
+	assert.Equal(t, sequenceNumber(2), id.(*kinesisID).sequenceNumber)
+}
+
+func TestKinesisConsumer_CheckTopicValid(t *testing.T) {
+	mock := newMockKinesis()
+	client := NewClient(mock)
+	topic := "kinesis_check_topic"
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "check",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	err = consumer.CheckTopicValid(topic)
+	assert.NoError(t, err)
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	assert.True(t, latest.AtEarliestPosition())
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	ids := produce(t, producer, "a", "b")
+	producer.Close()
+	_, err = producer.Send(context.Background(), &mqwrapper.ProducerMessage{Payload: []byte("c")})
+	assert.Error(t, err)
+
+	latest, err = consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	ret, err := latest.Equal(ids[1].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+	err = consumer.CheckTopicValid(topic)
+	assert.True(t, errors.Is(err, merr.ErrMqTopicNotEmpty))
+
+	mock.mu.Lock()
+	delete(mock.streams, topic)
+	mock.mu.Unlock()
+	err = consumer.CheckTopicValid(topic)
+	assert.True(t, errors.Is(err, merr.ErrMqTopicNotFound))
+}
+
+func TestKinesisConsumer_Reshard(t *testing.T) {
+	mock := newMockKinesis()
+	client := NewClient(mock)
+	topic := "kinesis_reshard"
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+	ids := produce(t, producer, "a", "b")
+	mock.reshard(topic)
+	ids = append(ids, produce(t, producer, "c")...)
+	mock.reshard(topic)
+	ids = append(ids, produce(t, producer, "d")...)
+
+	t.Run("earliest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "earliest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		// the children are read after their parents
+		msgs := consume(t, consumer, 4)
+		for i, msg := range msgs {
+			ret, err := msg.ID().Equal(ids[i].Serialize())
+			assert.NoError(t, err)
+			assert.True(t, ret)
+		}
+	})
+
+	t.Run("latest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "latest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		produce(t, producer, "e")
+		msgs := consume(t, consumer, 1)
+		assert.Equal(t, "e", string(msgs[0].Payload()))
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		err = consumer.Seek(ids[0], false)
+		assert.NoError(t, err)
+		msgs := consume(t, consumer, 3)
+		assert.Equal(t, "b", string(msgs[0].Payload()))
+		assert.Equal(t, "c", string(msgs[1].Payload()))
+		assert.Equal(t, "d", string(msgs[2].Payload()))
+
+		missing, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek_missing",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer missing.Close()
+		err = missing.Seek(&kinesisID{sequenceNumber: sequenceNumber(1)}, false)
+		assert.Error(t, err)
+		err = missing.Seek(&kinesisID{shardID: "shardId-999999999999", sequenceNumber: sequenceNumber(1)}, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("latest msg id", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "latest_msg_id",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		// the open shard is empty after resharding, the latest one is in the closed shards
+		mock.reshard(topic)
+		latest, err := consumer.GetLatestMsgID()
+		assert.NoError(t, err)
+		ret, err := latest.Equal(ids[3].Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	})
+}
+
+func TestKinesisConsumer_OpenShards(t *testing.T) {
+	mock := newMockKinesis()
+	client := NewClient(mock)
+	topic := "kinesis_open_shards"
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+	ids := produce(t, producer, "a")
+	// the sequence numbers of the two open children interleave
+	mock.split(topic)
+	left, right := "shardId-000000000001", "shardId-000000000002"
+	ids = append(ids,
+		mock.putTo(t, topic, left, "b"),
+		mock.putTo(t, topic, right, "c"),
+		mock.putTo(t, topic, left, "d"),
+		mock.putTo(t, topic, right, "e"),
+	)
+
+	t.Run("earliest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "earliest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		msgs := consume(t, consumer, 5)
+		assert.Equal(t, "a", string(msgs[0].Payload()))
+		payloads := make([]string, 0, 4)
+		for _, msg := range msgs[1:] {
+			payloads = append(payloads, string(msg.Payload()))
+		}
+		assert.ElementsMatch(t, []string{"b", "c", "d", "e"}, payloads)
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		// only the shard of the id is read, though the sibling has a greater sequence number
+		err = consumer.Seek(ids[1], false)
+		assert.NoError(t, err)
+		msgs := consume(t, consumer, 1)
+		assert.Equal(t, "d", string(msgs[0].Payload()))
+		ret, err := msgs[0].ID().Equal(ids[3].Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+		select {
+		case msg := <-consumer.Chan():
+			t.Fatalf("unexpected message %s", string(msg.Payload()))
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		inclusive, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek_inclusive",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer inclusive.Close()
+		err = inclusive.Seek(ids[2], true)
+		assert.NoError(t, err)
+		msgs = consume(t, inclusive, 2)
+		assert.Equal(t, "c", string(msgs[0].Payload()))
+		assert.Equal(t, "e", string(msgs[1].Payload()))
+	})
+
+	t.Run("latest msg id", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "latest_msg_id",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		// the latest record is the one arrived last, whichever shard it's in
+		latest, err := consumer.GetLatestMsgID()
+		assert.NoError(t, err)
+		ret, err := latest.Equal(ids[4].Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+
+		id := mock.putTo(t, topic, left, "f")
+		latest, err = consumer.GetLatestMsgID()
+		assert.NoError(t, err)
+		ret, err = latest.Equal(id.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	})
+}
+
+func TestKinesisConsumer_GetLatestMsgID(t *testing.T) {
+	mock := newMockKinesis()
+	client := NewClient(mock)
+	topic := "kinesis_latest_msg_id"
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "latest",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	payloads := make([]string, 100)
+	for i := range payloads {
+		payloads[i] = strconv.Itoa(i)
+	}
+	ids := produce(t, producer, payloads...)
+	mock.age(topic, 2*time.Hour)
+
+	// the old records are found from the trim horizon
+	latest, err := consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	ret, err := latest.Equal(ids[99].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+
+	// only the recent records are read once there is any
+	ids = produce(t, producer, "recent")
+	mock.mu.Lock()
+	mock.read = 0
+	mock.mu.Unlock()
+	latest, err = consumer.GetLatestMsgID()
+	assert.NoError(t, err)
+	ret, err = latest.Equal(ids[0].Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+	assert.Equal(t, 1, mock.read)
+}
+
+func TestKinesisClient_NewClientWithConfig(t *testing.T) {
+	config := &paramtable.Get().KinesisCfg
+	params := paramtable.Get()
+	params.Save(config.Region.Key, "us-west-2")
+	defer params.Reset(config.Region.Key)
+
+	client, err := NewClientWithConfig(context.Background(), config)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	params.Save(config.AccessKeyID.Key, "ak")
+	defer params.Reset(config.AccessKeyID.Key)
+	_, err = NewClientWithConfig(context.Background(), config)
+	assert.Error(t, err)
+
+	params.Save(config.SecretAccessKey.Key, "sk")
+	defer params.Reset(config.SecretAccessKey.Key)
+	client, err = NewClientWithConfig(context.Background(), config)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+}
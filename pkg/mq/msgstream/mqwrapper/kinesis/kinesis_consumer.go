@@ -0,0 +1,518 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// Consumer reads the records of all the shards of a kinesis stream, the shards are read following their lineage,
+// the children of a closed shard are read once all their parents are read to the end.
+// Kinesis has no server side subscription, so the read position is only kept in memory
+// and Ack does nothing, the retention only depends on the retention period of the stream.
+type Consumer struct {
+	client     kinesisiface.KinesisAPI
+	msgChannel chan mqwrapper.Message
+	hasAssign  bool
+	topic      string
+	subName    string
+
+	// readers are the shards being read, finished are the closed shards read to the end,
+	// and childrenPending is set once a shard is finished until its children are read.
+	readers         []*shardReader
+	finished        map[string]struct{}
+	childrenPending bool
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	chanOnce  sync.Once
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+// shardReader is the read position of a shard
+type shardReader struct {
+	shardID string
+	// iteratorType and sequenceNumber describe where to get the shard iterator again when it expires,
+	// the sequenceNumber is updated to the last consumed record with AFTER_SEQUENCE_NUMBER type.
+	iteratorType   string
+	sequenceNumber string
+	iterator       *string
+}
+
+var (
+	_ mqwrapper.Consumer             = &Consumer{}
+	_ mqwrapper.CostlyLatestConsumer = &Consumer{}
+)
+
+// latestLookbacks are the windows to look for the latest record of a shard in, widened until a record is found,
+// so the latest record of an active shard is found by reading the last seconds instead of the whole shard.
+var latestLookbacks = []time.Duration{10 * time.Second, time.Minute, 10 * time.Minute, time.Hour}
+
+func newKinesisConsumer(client kinesisiface.KinesisAPI, bufSize int64, topic string, subName string, position mqwrapper.SubscriptionInitialPosition) (*Consumer, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	kc := &Consumer{
+		client:     client,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		topic:      topic,
+		subName:    subName,
+		finished:   make(map[string]struct{}),
+		ctx:        ctx,
+		cancel:     cancel,
+		closeCh:    make(chan struct{}),
+	}
+
+	// if it's unknown, we leave the assign to seek
+	if position != mqwrapper.SubscriptionPositionUnknown {
+		var err error
+		if position == mqwrapper.SubscriptionPositionEarliest {
+			err = kc.assignEarliest()
+		} else {
+			err = kc.assignLatest()
+		}
+		if err != nil {
+			log.Error("kinesis consumer assign failed", zap.String("topic", topic), zap.Any("position", position), zap.Error(err))
+			cancel()
+			return nil, err
+		}
+	}
+	return kc, nil
+}
+
+// assignEarliest reads the shards without listed parents from the trim horizon, the others are read as their children
+func (kc *Consumer) assignEarliest() error {
+	shards, err := listShards(kc.ctx, kc.client, kc.topic)
+	if err != nil {
+		return err
+	}
+	listed := shardMap(shards)
+	var readers []*shardReader
+	for _, shard := range shards {
+		if len(shardParents(shard, listed)) == 0 {
+			readers = append(readers, &shardReader{
+				shardID:      aws.StringValue(shard.ShardId),
+				iteratorType: kinesis.ShardIteratorTypeTrimHorizon,
+			})
+		}
+	}
+	return kc.assign(readers)
+}
+
+// assignLatest reads the open shards from the latest
+func (kc *Consumer) assignLatest() error {
+	shards, err := listShards(kc.ctx, kc.client, kc.topic)
+	if err != nil {
+		return err
+	}
+	var readers []*shardReader
+	for _, shard := range shards {
+		if isShardOpen(shard) {
+			readers = append(readers, &shardReader{
+				shardID:      aws.StringValue(shard.ShardId),
+				iteratorType: kinesis.ShardIteratorTypeLatest,
+			})
+		}
+	}
+	return kc.assign(readers)
+}
+
+// assign gets the shard iterators of the readers and marks the consumer as assigned
+func (kc *Consumer) assign(readers []*shardReader) error {
+	for _, reader := range readers {
+		if err := kc.refreshIterator(reader); err != nil {
+			return err
+		}
+	}
+	kc.readers = readers
+	kc.hasAssign = true
+	return nil
+}
+
+func (kc *Consumer) refreshIterator(reader *shardReader) error {
+	input := &kinesis.GetShardIteratorInput{
+		StreamName:        aws.String(kc.topic),
+		ShardId:           aws.String(reader.shardID),
+		ShardIteratorType: aws.String(reader.iteratorType),
+	}
+	if reader.sequenceNumber != "" {
+		input.StartingSequenceNumber = aws.String(reader.sequenceNumber)
+	}
+	output, err := kc.client.GetShardIteratorWithContext(kc.ctx, input)
+	if err != nil {
+		return err
+	}
+	reader.iterator = output.ShardIterator
+	return nil
+}
+
+func (kc *Consumer) Subscription() string {
+	return kc.subName
+}
+
+// Chan provides a channel to read consumed message,
+// the records are pulled by GetRecords since kinesis has no push based api for a single shard.
+func (kc *Consumer) Chan() <-chan mqwrapper.Message {
+	if !kc.hasAssign {
+		log.Error("can not chan with not assigned channel", zap.String("topic", kc.topic), zap.String("subName", kc.subName))
+		panic("failed to chan a kinesis consumer without assign")
+	}
+	kc.chanOnce.Do(func() {
+		kc.wg.Add(1)
+		go kc.poll()
+	})
+	return kc.msgChannel
+}
+
+func (kc *Consumer) poll() {
+	defer kc.wg.Done()
+	defer close(kc.msgChannel)
+	for {
+		select {
+		case <-kc.closeCh:
+			log.Info("close consumer ", zap.String("topic", kc.topic), zap.String("subName", kc.subName))
+			return
+		default:
+		}
+
+		params := paramtable.Get()
+		pollInterval := params.KinesisCfg.PollInterval.GetAsDuration(time.Millisecond)
+		if kc.childrenPending {
+			if err := kc.readChildren(); err != nil {
+				log.Warn("kinesis read children shards failed", zap.String("topic", kc.topic), zap.Error(err))
+			} else {
+				kc.childrenPending = false
+			}
+		}
+		if len(kc.readers) == 0 {
+			// all the shards are read to the end, there won't be any new record
+			log.Warn("kinesis shards are all closed", zap.String("topic", kc.topic))
+			kc.wait(pollInterval)
+			continue
+		}
+
+		received := 0
+		for _, reader := range append([]*shardReader(nil), kc.readers...) {
+			n, ok := kc.read(reader, params.KinesisCfg.ReadLimit.GetAsInt64(), pollInterval)
+			if !ok {
+				return
+			}
+			received += n
+		}
+		if received == 0 {
+			kc.wait(pollInterval)
+		}
+	}
+}
+
+// read pulls a batch of records of the shard into the channel, it returns the number of records,
+// and false if the consumer is closed meanwhile.
+func (kc *Consumer) read(reader *shardReader, limit int64, pollInterval time.Duration) (int, bool) {
+	output, err := kc.client.GetRecordsWithContext(kc.ctx, &kinesis.GetRecordsInput{
+		ShardIterator: reader.iterator,
+		Limit:         aws.Int64(limit),
+	})
+	if err != nil {
+		if kc.ctx.Err() != nil {
+			return 0, true
+		}
+		switch {
+		case isErrorCode(err, kinesis.ErrCodeExpiredIteratorException):
+			log.Info("kinesis shard iterator expired, refresh it", zap.String("topic", kc.topic),
+				zap.String("shardID", reader.shardID), zap.String("sequenceNumber", reader.sequenceNumber))
+			if err := kc.refreshIterator(reader); err != nil {
+				log.Warn("kinesis refresh shard iterator failed", zap.String("topic", kc.topic), zap.String("shardID", reader.shardID), zap.Error(err))
+				kc.wait(pollInterval)
+			}
+		case isErrorCode(err, kinesis.ErrCodeProvisionedThroughputExceededException):
+			log.Warn("kinesis read throughput exceeded, back off", zap.String("topic", kc.topic), zap.String("shardID", reader.shardID))
+			kc.wait(time.Second)
+		default:
+			log.Warn("consume msg failed", zap.String("topic", kc.topic), zap.String("subName", kc.subName), zap.Error(err))
+			kc.wait(pollInterval)
+		}
+		return 0, true
+	}
+
+	for _, record := range output.Records {
+		msg, err := newKinesisMessage(kc.topic, reader.shardID, record)
+		if err != nil {
+			log.Warn("skip invalid kinesis record", zap.String("topic", kc.topic),
+				zap.String("sequenceNumber", aws.StringValue(record.SequenceNumber)), zap.Error(err))
+			continue
+		}
+		select {
+		case kc.msgChannel <- msg:
+		case <-kc.closeCh:
+			return 0, false
+		}
+		reader.iteratorType = kinesis.ShardIteratorTypeAfterSequenceNumber
+		reader.sequenceNumber = aws.StringValue(record.SequenceNumber)
+	}
+	reader.iterator = output.NextShardIterator
+	if reader.iterator == nil {
+		// the shard is closed and read to the end, its children are read then
+		log.Info("kinesis shard is read to the end", zap.String("topic", kc.topic), zap.String("shardID", reader.shardID))
+		kc.finished[reader.shardID] = struct{}{}
+		for i, r := range kc.readers {
+			if r == reader {
+				kc.readers = append(kc.readers[:i], kc.readers[i+1:]...)
+				break
+			}
+		}
+		kc.childrenPending = true
+	}
+	return len(output.Records), true
+}
+
+// readChildren starts reading the children shards whose listed parents are all read to the end
+func (kc *Consumer) readChildren() error {
+	shards, err := listShards(kc.ctx, kc.client, kc.topic)
+	if err != nil {
+		return err
+	}
+	listed := shardMap(shards)
+	reading := make(map[string]struct{}, len(kc.readers))
+	for _, reader := range kc.readers {
+		reading[reader.shardID] = struct{}{}
+	}
+	for _, shard := range shards {
+		shardID := aws.StringValue(shard.ShardId)
+		if _, ok := kc.finished[shardID]; ok {
+			continue
+		}
+		if _, ok := reading[shardID]; ok {
+			continue
+		}
+		parents := shardParents(shard, listed)
+		if len(parents) == 0 || !kc.allFinished(parents) {
+			continue
+		}
+		reader := &shardReader{shardID: shardID, iteratorType: kinesis.ShardIteratorTypeTrimHorizon}
+		if err := kc.refreshIterator(reader); err != nil {
+			return err
+		}
+		log.Info("kinesis read the child shard", zap.String("topic", kc.topic), zap.String("shardID", shardID), zap.Strings("parents", parents))
+		kc.readers = append(kc.readers, reader)
+		reading[shardID] = struct{}{}
+	}
+	return nil
+}
+
+func (kc *Consumer) allFinished(shardIDs []string) bool {
+	for _, shardID := range shardIDs {
+		if _, ok := kc.finished[shardID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// wait sleeps for the interval unless the consumer is closed
+func (kc *Consumer) wait(interval time.Duration) {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-kc.closeCh:
+	}
+}
+
+// Seek reads the shard of id from its sequence number, and then the children of the shard,
+// the empty id is read from the earliest.
+func (kc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	if kc.hasAssign {
+		return errors.New("kinesis consumer is already assigned, can not seek again")
+	}
+
+	kid := id.(*kinesisID)
+	log.Info("kinesis consumer seek start", zap.String("topic", kc.topic), zap.String("shardID", kid.shardID),
+		zap.String("sequenceNumber", kid.sequenceNumber), zap.Bool("inclusive", inclusive))
+
+	err := kc.seek(kid, inclusive)
+	if err != nil {
+		log.Warn("kinesis consumer seek failed", zap.String("topic", kc.topic), zap.String("shardID", kid.shardID),
+			zap.String("sequenceNumber", kid.sequenceNumber), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Consumer) seek(id *kinesisID, inclusive bool) error {
+	if id.sequenceNumber == "" {
+		return kc.assignEarliest()
+	}
+	// the sequence numbers are only unique in a shard, the record can't be located without the shard
+	if id.shardID == "" {
+		return errors.Newf("kinesis message id %s has no shard id", id.sequenceNumber)
+	}
+	reader := &shardReader{
+		shardID:        id.shardID,
+		iteratorType:   kinesis.ShardIteratorTypeAfterSequenceNumber,
+		sequenceNumber: id.sequenceNumber,
+	}
+	if inclusive {
+		reader.iteratorType = kinesis.ShardIteratorTypeAtSequenceNumber
+	}
+	return kc.assign([]*shardReader{reader})
+}
+
+func (kc *Consumer) Ack(message mqwrapper.Message) {
+	// Do nothing
+	// Kinesis retention mechanism only depends on the retention period of the stream.
+}
+
+// LatestMsgIDCostly returns true since GetLatestMsgID reads the records of the shards, which shares the read quota
+// of the shards with the consumers, the lag of the consumer is not probed
+func (kc *Consumer) LatestMsgIDCostly() bool {
+	return true
+}
+
+// GetLatestMsgID returns the id of the latest record since kinesis doesn't provide it directly.
+// The records of a shard are read from a recent timestamp, the window is widened up to the trim horizon
+// only if nothing is found. The sequence numbers of different shards aren't comparable, so the latest record
+// of the shards is the one arrived last. The closed shards are read only if the open ones are empty,
+// and an empty id is returned if all the shards are empty.
+func (kc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	shards, err := listShards(kc.ctx, kc.client, kc.topic)
+	if err != nil {
+		return nil, err
+	}
+	var open, closed []*kinesis.Shard
+	for _, shard := range shards {
+		if isShardOpen(shard) {
+			open = append(open, shard)
+		} else {
+			closed = append(closed, shard)
+		}
+	}
+
+	latest := &kinesisID{}
+	var latestArrival time.Time
+	for _, group := range [][]*kinesis.Shard{open, closed} {
+		for _, shard := range group {
+			shardID := aws.StringValue(shard.ShardId)
+			record, err := kc.lastRecord(shardID)
+			if err != nil {
+				return nil, err
+			}
+			if record == nil {
+				continue
+			}
+			if arrival := aws.TimeValue(record.ApproximateArrivalTimestamp); latest.AtEarliestPosition() || arrival.After(latestArrival) {
+				latest = &kinesisID{shardID: shardID, sequenceNumber: aws.StringValue(record.SequenceNumber)}
+				latestArrival = arrival
+			}
+		}
+		if !latest.AtEarliestPosition() {
+			break
+		}
+	}
+
+	log.Info("get latest msg ID ", zap.String("topic", kc.topic), zap.String("shardID", latest.shardID),
+		zap.String("sequenceNumber", latest.sequenceNumber))
+	return latest, nil
+}
+
+// lastRecord returns the last record of the shard, nil if the shard is empty
+func (kc *Consumer) lastRecord(shardID string) (*kinesis.Record, error) {
+	now := time.Now()
+	for _, lookback := range latestLookbacks {
+		record, err := kc.scanLast(&kinesis.GetShardIteratorInput{
+			StreamName:        aws.String(kc.topic),
+			ShardId:           aws.String(shardID),
+			ShardIteratorType: aws.String(kinesis.ShardIteratorTypeAtTimestamp),
+			Timestamp:         aws.Time(now.Add(-lookback)),
+		})
+		if err != nil || record != nil {
+			return record, err
+		}
+	}
+	return kc.scanLast(&kinesis.GetShardIteratorInput{
+		StreamName:        aws.String(kc.topic),
+		ShardId:           aws.String(shardID),
+		ShardIteratorType: aws.String(kinesis.ShardIteratorTypeTrimHorizon),
+	})
+}
+
+// scanLast reads the shard from the iterator to the latest, and returns the last record read
+func (kc *Consumer) scanLast(input *kinesis.GetShardIteratorInput) (*kinesis.Record, error) {
+	output, err := kc.client.GetShardIteratorWithContext(kc.ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *kinesis.Record
+	iterator := output.ShardIterator
+	for iterator != nil {
+		records, err := kc.client.GetRecordsWithContext(kc.ctx, &kinesis.GetRecordsInput{
+			ShardIterator: iterator,
+			Limit:         aws.Int64(paramtable.Get().KinesisCfg.ReadLimit.GetAsInt64()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(records.Records) > 0 {
+			last = records.Records[len(records.Records)-1]
+		}
+		if len(records.Records) == 0 && aws.Int64Value(records.MillisBehindLatest) == 0 {
+			break
+		}
+		iterator = records.NextShardIterator
+	}
+	return last, nil
+}
+
+func (kc *Consumer) CheckTopicValid(topic string) error {
+	latestMsgID, err := kc.GetLatestMsgID()
+	// check topic is existed
+	if err != nil {
+		if isErrorCode(err, kinesis.ErrCodeResourceNotFoundException) {
+			return merr.WrapErrMqTopicNotFound(topic, err.Error())
+		}
+		return merr.WrapErrMqInternal(err)
+	}
+
+	// check topic is empty
+	if !latestMsgID.AtEarliestPosition() {
+		return merr.WrapErrMqTopicNotEmpty(topic, "topic is not empty")
+	}
+	log.Info("created topic is empty", zap.String("topic", topic))
+
+	return nil
+}
+
+func (kc *Consumer) Close() {
+	kc.closeOnce.Do(func() {
+		close(kc.closeCh)
+		kc.cancel()
+		kc.wg.Wait()
+	})
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// shardSeparator separates the shard id and the sequence number in the serialized kinesisID
+const shardSeparator = "/"
+
+// kinesisID wraps the shard id and the sequence number of kinesis record,
+// the sequence number is a decimal string of up to 128 bits which increases over time in a shard,
+// it's only unique in its shard, so the shard id is kept to seek the record.
+// The shard id is empty for the earliest position.
+type kinesisID struct {
+	shardID        string
+	sequenceNumber string
+}
+
+var _ mqwrapper.MessageID = &kinesisID{}
+
+func (kid *kinesisID) Serialize() []byte {
+	return SerializeKinesisID(kid.shardID, kid.sequenceNumber)
+}
+
+// AtEarliestPosition returns true if it's the position before the first record,
+// which is represented by an empty sequence number.
+func (kid *kinesisID) AtEarliestPosition() bool {
+	return kid.sequenceNumber == ""
+}
+
+// LessOrEqualThan compares the sequence numbers, which is meaningful for the records of a shard
+// and the ones of its children, since the records of a topic are put with the same partition key,
+// the children's sequence numbers are greater than their parents'.
+func (kid *kinesisID) LessOrEqualThan(msgID []byte) (bool, error) {
+	_, sequenceNumber, err := DeserializeKinesisID(msgID)
+	if err != nil {
+		return false, err
+	}
+	ret, err := compareSequenceNumber(kid.sequenceNumber, sequenceNumber)
+	if err != nil {
+		return false, err
+	}
+	return ret <= 0, nil
+}
+
+func (kid *kinesisID) Equal(msgID []byte) (bool, error) {
+	shardID, sequenceNumber, err := DeserializeKinesisID(msgID)
+	if err != nil {
+		return false, err
+	}
+	ret, err := compareSequenceNumber(kid.sequenceNumber, sequenceNumber)
+	if err != nil {
+		return false, err
+	}
+	return ret == 0 && kid.shardID == shardID, nil
+}
+
+// SerializeKinesisID serializes the id in the format of "shardID/sequenceNumber",
+// the earliest position without shard id is serialized as the empty sequence number.
+func SerializeKinesisID(shardID string, sequenceNumber string) []byte {
+	if shardID == "" {
+		return []byte(sequenceNumber)
+	}
+	return []byte(shardID + shardSeparator + sequenceNumber)
+}
+
+// DeserializeKinesisID returns the shard id and the sequence number of the serialized id
+func DeserializeKinesisID(messageID []byte) (string, string, error) {
+	shardID, sequenceNumber := "", string(messageID)
+	if idx := strings.LastIndex(sequenceNumber, shardSeparator); idx >= 0 {
+		shardID, sequenceNumber = sequenceNumber[:idx], sequenceNumber[idx+1:]
+		if shardID == "" {
+			return "", "", errors.Newf("invalid kinesis message id %s, the shard id is empty", string(messageID))
+		}
+	}
+	if _, err := parseSequenceNumber(sequenceNumber); err != nil {
+		return "", "", err
+	}
+	return shardID, sequenceNumber, nil
+}
+
+// UnmarshalKinesisID validates and deserializes the message id of kinesis, which is a shard id and a decimal sequence number
+func UnmarshalKinesisID(messageID []byte) (mqwrapper.MessageID, error) {
+	shardID, sequenceNumber, err := DeserializeKinesisID(messageID)
+	if err != nil {
+		return nil, errors.Wrapf(mqwrapper.ErrInvalidMessageID, "%s, check whether the position is written by another mq type", err.Error())
+	}
+	return &kinesisID{shardID: shardID, sequenceNumber: sequenceNumber}, nil
+}
+
+// parseSequenceNumber parses the decimal sequence number, the empty one is parsed as -1
+func parseSequenceNumber(sequenceNumber string) (*big.Int, error) {
+	if sequenceNumber == "" {
+		return big.NewInt(-1), nil
+	}
+	n, ok := new(big.Int).SetString(sequenceNumber, 10)
+	if !ok {
+		return nil, errors.Newf("invalid kinesis sequence number %s", sequenceNumber)
+	}
+	return n, nil
+}
+
+// compareSequenceNumber returns -1, 0 or 1 if a is less than, equal to or greater than b
+func compareSequenceNumber(a, b string) (int, error) {
+	x, err := parseSequenceNumber(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := parseSequenceNumber(b)
+	if err != nil {
+		return 0, err
+	}
+	return x.Cmp(y), nil
+}
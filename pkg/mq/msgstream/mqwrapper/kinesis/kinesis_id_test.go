@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+func TestKinesisID_Serialize(t *testing.T) {
+	kid := &kinesisID{shardID: "shardId-000000000000", sequenceNumber: "49590338271490256608559692538361571095921575989136588898"}
+	bin := kid.Serialize()
+	assert.Equal(t, "shardId-000000000000/49590338271490256608559692538361571095921575989136588898", string(bin))
+
+	kid = &kinesisID{sequenceNumber: ""}
+	assert.Empty(t, kid.Serialize())
+}
+
+func TestKinesisID_AtEarliestPosition(t *testing.T) {
+	kid := &kinesisID{sequenceNumber: "8"}
+	assert.False(t, kid.AtEarliestPosition())
+
+	kid = &kinesisID{sequenceNumber: ""}
+	assert.True(t, kid.AtEarliestPosition())
+}
+
+func TestKinesisID_LessOrEqualThan(t *testing.T) {
+	{
+		kid1 := &kinesisID{sequenceNumber: "49590338271490256608559692538361571095921575989136588898"}
+		kid2 := &kinesisID{sequenceNumber: "49590338271490256608559692540925702759324208523137515618"}
+		ret, err := kid1.LessOrEqualThan(kid2.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+
+		ret, err = kid2.LessOrEqualThan(kid1.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		kid1 := &kinesisID{sequenceNumber: ""}
+		kid2 := &kinesisID{sequenceNumber: "0"}
+		ret, err := kid1.LessOrEqualThan(kid2.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+
+		ret, err = kid2.LessOrEqualThan(kid1.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		kid := &kinesisID{sequenceNumber: "1"}
+		_, err := kid.LessOrEqualThan([]byte("invalid"))
+		assert.Error(t, err)
+	}
+}
+
+func TestKinesisID_Equal(t *testing.T) {
+	kid1 := &kinesisID{shardID: "shardId-000000000000", sequenceNumber: "10"}
+	kid2 := &kinesisID{shardID: "shardId-000000000000", sequenceNumber: "11"}
+	kid3 := &kinesisID{shardID: "shardId-000000000001", sequenceNumber: "10"}
+
+	{
+		ret, err := kid1.Equal(kid1.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	}
+
+	{
+		ret, err := kid1.Equal(kid2.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		// the same sequence number in another shard is another record
+		ret, err := kid1.Equal(kid3.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		_, err := kid1.Equal([]byte("invalid"))
+		assert.Error(t, err)
+	}
+}
+
+func Test_DeserializeKinesisID(t *testing.T) {
+	bin := SerializeKinesisID("shardId-000000000001", "5")
+	shardID, sequenceNumber, err := DeserializeKinesisID(bin)
+	assert.NoError(t, err)
+	assert.Equal(t, "shardId-000000000001", shardID)
+	assert.Equal(t, "5", sequenceNumber)
+
+	shardID, sequenceNumber, err = DeserializeKinesisID(SerializeKinesisID("", ""))
+	assert.NoError(t, err)
+	assert.Empty(t, shardID)
+	assert.Empty(t, sequenceNumber)
+
+	_, _, err = DeserializeKinesisID([]byte("/5"))
+	assert.Error(t, err)
+	_, _, err = DeserializeKinesisID([]byte("shardId-000000000001/x"))
+	assert.Error(t, err)
+
+	_, err = UnmarshalKinesisID([]byte("shardId-000000000001/x"))
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// propertiesLenSize is the size of properties length header in record data
+const propertiesLenSize = 4
+
+// kinesisMessage wraps a kinesis record of the shard and the properties unpacked from it
+type kinesisMessage struct {
+	topic      string
+	shardID    string
+	record     *kinesis.Record
+	properties map[string]string
+	payload    []byte
+}
+
+var _ mqwrapper.Message = &kinesisMessage{}
+
+func newKinesisMessage(topic string, shardID string, record *kinesis.Record) (*kinesisMessage, error) {
+	properties, payload, err := decodeRecordData(record.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &kinesisMessage{
+		topic:      topic,
+		shardID:    shardID,
+		record:     record,
+		properties: properties,
+		payload:    payload,
+	}, nil
+}
+
+func (km *kinesisMessage) Topic() string {
+	return km.topic
+}
+
+func (km *kinesisMessage) Properties() map[string]string {
+	return km.properties
+}
+
+func (km *kinesisMessage) Payload() []byte {
+	return km.payload
+}
+
+func (km *kinesisMessage) ID() mqwrapper.MessageID {
+	return &kinesisID{shardID: km.shardID, sequenceNumber: aws.StringValue(km.record.SequenceNumber)}
+}
+
+// encodeRecordData packs properties and payload into the data of a kinesis record since kinesis has no record header,
+// the layout is | properties length (4 bytes) | properties in json | payload |
+func encodeRecordData(message *mqwrapper.ProducerMessage) ([]byte, error) {
+	var properties []byte
+	if len(message.Properties) > 0 {
+		var err error
+		properties, err = json.Marshal(message.Properties)
+		if err != nil {
+			return nil, err
+		}
+	}
+	data := make([]byte, propertiesLenSize+len(properties)+len(message.Payload))
+	common.Endian.PutUint32(data, uint32(len(properties)))
+	copy(data[propertiesLenSize:], properties)
+	copy(data[propertiesLenSize+len(properties):], message.Payload)
+	return data, nil
+}
+
+// decodeRecordData unpacks the properties and payload from the data of a kinesis record
+func decodeRecordData(data []byte) (map[string]string, []byte, error) {
+	if len(data) < propertiesLenSize {
+		return nil, nil, errors.Newf("invalid kinesis record data, length %d", len(data))
+	}
+	propertiesLen := int(common.Endian.Uint32(data))
+	if len(data) < propertiesLenSize+propertiesLen {
+		return nil, nil, errors.Newf("invalid kinesis record data, length %d, properties length %d", len(data), propertiesLen)
+	}
+	properties := make(map[string]string)
+	if propertiesLen > 0 {
+		if err := json.Unmarshal(data[propertiesLenSize:propertiesLenSize+propertiesLen], &properties); err != nil {
+			return nil, nil, err
+		}
+	}
+	return properties, data[propertiesLenSize+propertiesLen:], nil
+}
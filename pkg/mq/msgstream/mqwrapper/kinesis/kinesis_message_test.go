@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+func TestKinesisMessage_All(t *testing.T) {
+	data, err := encodeRecordData(&mqwrapper.ProducerMessage{
+		Payload:    []byte("payload"),
+		Properties: map[string]string{"key": "value"},
+	})
+	assert.NoError(t, err)
+
+	record := &kinesis.Record{SequenceNumber: aws.String("10"), Data: data}
+	km, err := newKinesisMessage("t", "shardId-000000000000", record)
+	assert.NoError(t, err)
+	assert.Equal(t, "t", km.Topic())
+	assert.Equal(t, "10", km.ID().(*kinesisID).sequenceNumber)
+	assert.Equal(t, "shardId-000000000000", km.ID().(*kinesisID).shardID)
+	assert.Equal(t, []byte("payload"), km.Payload())
+	assert.Equal(t, map[string]string{"key": "value"}, km.Properties())
+}
+
+func TestKinesisMessage_RecordData(t *testing.T) {
+	t.Run("without properties", func(t *testing.T) {
+		data, err := encodeRecordData(&mqwrapper.ProducerMessage{Payload: []byte("payload")})
+		assert.NoError(t, err)
+		properties, payload, err := decodeRecordData(data)
+		assert.NoError(t, err)
+		assert.Empty(t, properties)
+		assert.Equal(t, []byte("payload"), payload)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, _, err := decodeRecordData([]byte{1})
+		assert.Error(t, err)
+
+		_, _, err = decodeRecordData([]byte{8, 0, 0, 0, 1})
+		assert.Error(t, err)
+
+		_, _, err = decodeRecordData([]byte{1, 0, 0, 0, 'x'})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+type kinesisProducer struct {
+	client kinesisiface.KinesisAPI
+	topic  string
+	// mu serializes the sends so the records are ordered strictly in the shard
+	mu sync.Mutex
+	// lastSequenceNumber is the sequence number of the last record sent by this producer
+	lastSequenceNumber string
+	closeOnce          sync.Once
+	isClosed           bool
+}
+
+var _ mqwrapper.Producer = &kinesisProducer{}
+
+func (kp *kinesisProducer) Topic() string {
+	return kp.topic
+}
+
+func (kp *kinesisProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	start := timerecord.NewTimeRecorder("send msg to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
+
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	if kp.isClosed {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Error("kinesis produce message fail because the producer has been closed", zap.String("topic", kp.topic))
+		return nil, common.NewIgnorableError(fmt.Errorf("kinesis producer is closed"))
+	}
+
+	data, err := encodeRecordData(message)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	input := &kinesis.PutRecordInput{
+		StreamName:   aws.String(kp.topic),
+		PartitionKey: aws.String(kp.topic),
+		Data:         data,
+	}
+	if kp.lastSequenceNumber != "" {
+		input.SequenceNumberForOrdering = aws.String(kp.lastSequenceNumber)
+	}
+	output, err := kp.client.PutRecordWithContext(ctx, input)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Warn("kinesis put record failed", zap.String("topic", kp.topic), zap.Error(err))
+		return nil, err
+	}
+	kp.lastSequenceNumber = aws.StringValue(output.SequenceNumber)
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Inc()
+
+	return &kinesisID{shardID: aws.StringValue(output.ShardId), sequenceNumber: kp.lastSequenceNumber}, nil
+}
+
+func (kp *kinesisProducer) Close() {
+	kp.closeOnce.Do(func() {
+		kp.mu.Lock()
+		defer kp.mu.Unlock()
+		kp.isClosed = true
+	})
+}
@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// lagProbeRecheckInterval is the interval to recheck the probe interval while the lag probe is disabled
+const lagProbeRecheckInterval = time.Minute
+
+// LagProbe periodically reports the lag of a consumer into metrics, the lag is
+// the distance from the last consumed message to the latest message of the topic in messages,
+// and the time since the consumer caught up with the latest message last time in seconds.
+// The lag in messages is only reported if the message id implements RangeMessageID,
+// and the probe stops if the consumer doesn't support GetLatestMsgID.
+type LagProbe struct {
+	channel  string
+	consumer Consumer
+
+	mu       sync.Mutex
+	position MessageID
+	caughtUp time.Time
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewLagProbe creates a LagProbe for the consumer of channel
+func NewLagProbe(channel string, consumer Consumer) *LagProbe {
+	return &LagProbe{
+		channel:  channel,
+		consumer: consumer,
+		caughtUp: time.Now(),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+// Start reports the lag in background, the interval is read before each probe so that it's refreshable,
+// the probe is paused while the interval is non-positive
+func (p *LagProbe) Start(interval func() time.Duration) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		<-timer.C
+		for {
+			d := interval()
+			enabled := d > 0
+			if !enabled {
+				d = lagProbeRecheckInterval
+			}
+			timer.Reset(d)
+			select {
+			case <-p.closeCh:
+				return
+			case <-timer.C:
+				if enabled && !p.probe() {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Observe records the position of the consumed message, it's a no-op on a nil probe
+func (p *LagProbe) Observe(msg Message) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.position = msg.ID()
+}
+
+// probe reports the lag once, returns false if the consumer doesn't support the probe
+func (p *LagProbe) probe() bool {
+	messages, seconds, err := p.lag()
+	if errors.Is(err, ErrLatestMsgIDNotSupported) {
+		log.Info("stop the lag probe since the consumer doesn't support getting the latest message id", zap.String("channel", p.channel))
+		return false
+	}
+	if err != nil {
+		log.Debug("lag probe failed", zap.String("channel", p.channel), zap.Error(err))
+		return true
+	}
+	if messages >= 0 {
+		metrics.MsgStreamConsumerLagMessages.WithLabelValues(p.channel).Set(float64(messages))
+	}
+	metrics.MsgStreamConsumerLagSeconds.WithLabelValues(p.channel).Set(seconds)
+	return true
+}
+
+// lag returns the lag of consumer in messages and seconds,
+// the lag in messages is -1 if it's unknown since the message id doesn't support range arithmetic
+func (p *LagProbe) lag() (int64, float64, error) {
+	latest, err := p.consumer.GetLatestMsgID()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var caughtUp bool
+	if p.position == nil {
+		caughtUp = latest.AtEarliestPosition()
+	} else {
+		caughtUp, err = latest.LessOrEqualThan(p.position.Serialize())
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	now := time.Now()
+	if caughtUp {
+		p.caughtUp = now
+		return 0, 0, nil
+	}
+	messages := int64(-1)
+	if p.position != nil {
+		messages, err = Distance(p.position, latest)
+		if errors.Is(err, ErrRangeNotSupported) {
+			messages = -1
+		} else if err != nil {
+			return 0, 0, err
+		}
+	}
+	return messages, now.Sub(p.caughtUp).Seconds(), nil
+}
+
+// Close stops the probe and removes the lag metrics of the channel
+func (p *LagProbe) Close() {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+		p.wg.Wait()
+		metrics.MsgStreamConsumerLagMessages.DeleteLabelValues(p.channel)
+		metrics.MsgStreamConsumerLagSeconds.DeleteLabelValues(p.channel)
+	})
+}
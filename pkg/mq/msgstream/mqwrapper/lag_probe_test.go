@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type lagTestID int64
+
+func (id lagTestID) Serialize() []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(id))
+	return b
+}
+
+func (id lagTestID) AtEarliestPosition() bool { return id < 0 }
+
+func (id lagTestID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return int64(id) <= int64(binary.LittleEndian.Uint64(msgID)), nil
+}
+
+func (id lagTestID) Equal(msgID []byte) (bool, error) {
+	return int64(id) == int64(binary.LittleEndian.Uint64(msgID)), nil
+}
+
+func (id lagTestID) Distance(msgID []byte) (int64, error) {
+	return int64(binary.LittleEndian.Uint64(msgID)) - int64(id), nil
+}
+
+func (id lagTestID) Add(n int64) MessageID { return id + lagTestID(n) }
+
+// lagTestNoRangeID doesn't support range arithmetic
+type lagTestNoRangeID struct {
+	id lagTestID
+}
+
+func (id lagTestNoRangeID) Serialize() []byte        { return id.id.Serialize() }
+func (id lagTestNoRangeID) AtEarliestPosition() bool { return id.id.AtEarliestPosition() }
+
+func (id lagTestNoRangeID) LessOrEqualThan(msgID []byte) (bool, error) {
+	return id.id.LessOrEqualThan(msgID)
+}
+
+func (id lagTestNoRangeID) Equal(msgID []byte) (bool, error) {
+	return id.id.Equal(msgID)
+}
+
+type lagTestConsumer struct {
+	Consumer
+	latest MessageID
+	err    error
+}
+
+func (c *lagTestConsumer) GetLatestMsgID() (MessageID, error) {
+	return c.latest, c.err
+}
+
+type lagTestMessage struct {
+	Message
+	id MessageID
+}
+
+func (m *lagTestMessage) ID() MessageID { return m.id }
+
+func TestLagProbe(t *testing.T) {
+	t.Run("nil probe", func(t *testing.T) {
+		var p *LagProbe
+		p.Observe(&lagTestMessage{id: lagTestID(1)})
+	})
+
+	t.Run("empty topic", func(t *testing.T) {
+		p := NewLagProbe("channel", &lagTestConsumer{latest: lagTestID(-1)})
+		messages, seconds, err := p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), messages)
+		assert.Equal(t, float64(0), seconds)
+	})
+
+	t.Run("get latest failed", func(t *testing.T) {
+		p := NewLagProbe("channel", &lagTestConsumer{err: errors.New("mock")})
+		_, _, err := p.lag()
+		assert.Error(t, err)
+	})
+
+	t.Run("range id", func(t *testing.T) {
+		c := &lagTestConsumer{latest: lagTestID(10)}
+		p := NewLagProbe("channel", c)
+		p.caughtUp = time.Now().Add(-time.Minute)
+
+		// nothing consumed
+		messages, seconds, err := p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), messages)
+		assert.GreaterOrEqual(t, seconds, float64(60))
+
+		p.Observe(&lagTestMessage{id: lagTestID(4)})
+		messages, seconds, err = p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), messages)
+		assert.GreaterOrEqual(t, seconds, float64(60))
+
+		p.Observe(&lagTestMessage{id: lagTestID(10)})
+		messages, seconds, err = p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), messages)
+		assert.Equal(t, float64(0), seconds)
+
+		c.latest = lagTestID(12)
+		messages, seconds, err = p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), messages)
+		assert.Less(t, seconds, float64(60))
+	})
+
+	t.Run("no range id", func(t *testing.T) {
+		p := NewLagProbe("channel", &lagTestConsumer{latest: lagTestNoRangeID{id: 10}})
+		p.Observe(&lagTestMessage{id: lagTestNoRangeID{id: 4}})
+		messages, _, err := p.lag()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), messages)
+	})
+
+	t.Run("start and close", func(t *testing.T) {
+		p := NewLagProbe("channel", &lagTestConsumer{latest: lagTestID(10)})
+		p.Observe(&lagTestMessage{id: lagTestID(4)})
+		p.Start(func() time.Duration { return time.Millisecond })
+		time.Sleep(10 * time.Millisecond)
+		p.Close()
+		p.Close()
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := &lagTestConsumer{err: errors.New("mock")}
+		p := NewLagProbe("channel", c)
+		p.Start(func() time.Duration { return 0 })
+		time.Sleep(10 * time.Millisecond)
+		p.Close()
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		p := NewLagProbe("channel", &lagTestConsumer{err: ErrLatestMsgIDNotSupported})
+		assert.False(t, p.probe())
+		p = NewLagProbe("channel", &lagTestConsumer{err: errors.New("mock")})
+		assert.True(t, p.probe())
+
+		// the probe stops by itself
+		p = NewLagProbe("channel", &lagTestConsumer{err: ErrLatestMsgIDNotSupported})
+		p.Start(func() time.Duration { return time.Millisecond })
+		p.wg.Wait()
+		p.Close()
+	})
+}
@@ -191,8 +191,7 @@ func (nc *nmqClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
 
 // BytesToMsgID converts a byte array to messageID
 func (nc *nmqClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
-	rID := DeserializeNmqID(id)
-	return &nmqID{messageID: rID}, nil
+	return UnmarshalNmqID(id)
 }
 
 func (nc *nmqClient) Close() {
@@ -30,7 +30,7 @@ type nmqID struct {
 }
 
 // Check if nmqID implements MessageID interface
-var _ mqwrapper.MessageID = &nmqID{}
+var _ mqwrapper.RangeMessageID = &nmqID{}
 
 // NewNmqID creates and returns a new instance of the nmqID struct with the given MessageID.
 func NewNmqID(id MessageIDType) mqwrapper.MessageID {
@@ -56,6 +56,17 @@ func (nid *nmqID) Equal(msgID []byte) (bool, error) {
 	return nid.messageID == DeserializeNmqID(msgID), nil
 }
 
+// Distance returns the stream sequence distance to the given id, which is the message number between them
+func (nid *nmqID) Distance(msgID []byte) (int64, error) {
+	return int64(DeserializeNmqID(msgID)) - int64(nid.messageID), nil
+}
+
+func (nid *nmqID) Add(n int64) mqwrapper.MessageID {
+	return &nmqID{
+		messageID: uint64(int64(nid.messageID) + n),
+	}
+}
+
 // SerializeNmqID is used to serialize a message ID to byte array
 func SerializeNmqID(messageID MessageIDType) []byte {
 	b := make([]byte, 8)
@@ -67,3 +78,12 @@ func SerializeNmqID(messageID MessageIDType) []byte {
 func DeserializeNmqID(messageID []byte) MessageIDType {
 	return common.Endian.Uint64(messageID)
 }
+
+// UnmarshalNmqID validates and deserializes the message id of natsmq, which is a stream sequence starting from 1
+func UnmarshalNmqID(messageID []byte) (mqwrapper.MessageID, error) {
+	seq, err := mqwrapper.UnmarshalInt64MessageID("natsmq", messageID, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &nmqID{messageID: MessageIDType(seq)}, nil
+}
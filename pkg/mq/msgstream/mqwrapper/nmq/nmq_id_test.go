@@ -99,3 +99,27 @@ func Test_DeserializeNmqID(t *testing.T) {
 	id := DeserializeNmqID(bin)
 	assert.Equal(t, id, MessageIDType(5))
 }
+
+func Test_Distance(t *testing.T) {
+	id1 := &nmqID{messageID: 5}
+	id2 := &nmqID{messageID: 15}
+
+	d, err := id1.Distance(id2.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), d)
+
+	d, err = id2.Distance(id1.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), d)
+}
+
+func Test_Add(t *testing.T) {
+	id := &nmqID{messageID: 5}
+
+	next := id.Add(10)
+	assert.Equal(t, uint64(15), DeserializeNmqID(next.Serialize()))
+	assert.Equal(t, uint64(5), id.messageID)
+
+	prev := id.Add(-2)
+	assert.Equal(t, uint64(3), DeserializeNmqID(prev.Serialize()))
+}
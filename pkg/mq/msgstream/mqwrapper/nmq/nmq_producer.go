@@ -45,6 +45,7 @@ func (np *nmqProducer) Topic() string {
 func (np *nmqProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
 	start := timerecord.NewTimeRecorder("send msg to stream")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
 
 	// Encode message
 	msg := &nats.Msg{
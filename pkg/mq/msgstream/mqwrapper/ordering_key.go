@@ -0,0 +1,34 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+// orderingKeyProperty is the property carrying the ordering key of a message
+const orderingKeyProperty = "_ordering_key"
+
+// SetOrderingKey sets the ordering key of the message, the messages of a topic with the same ordering key
+// are consumed in the order they are produced. Message queues supporting message keys also get it natively.
+func SetOrderingKey(message *ProducerMessage, key string) {
+	if message.Properties == nil {
+		message.Properties = make(map[string]string)
+	}
+	message.Properties[orderingKeyProperty] = key
+}
+
+// GetOrderingKey returns the ordering key in the properties of a message, empty if it's not set
+func GetOrderingKey(properties map[string]string) string {
+	return properties[orderingKeyProperty]
+}
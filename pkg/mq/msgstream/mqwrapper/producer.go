@@ -16,7 +16,11 @@
 
 package mqwrapper
 
-import "context"
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+)
 
 // ProducerOptions contains the options of a producer
 type ProducerOptions struct {
@@ -26,8 +30,17 @@ type ProducerOptions struct {
 	// Enable compression
 	// For Pulsar, this enables ZSTD compression with default compression level
 	EnableCompression bool
+
+	// Epoch is the fencing epoch of the producer, once a producer with higher epoch publishes to the topic,
+	// the messages of the producers with lower epoch are rejected by the mq with ErrProducerFenced.
+	// 0 disables fencing, and it's ignored by the mqs not supporting fencing.
+	// For Pulsar, the producers with epoch share a producer name and require the broker deduplication enabled
+	Epoch int64
 }
 
+// ErrProducerFenced is returned if the producer is fenced by another producer of higher epoch
+var ErrProducerFenced = errors.New("producer is fenced by a producer of higher epoch")
+
 // ProducerMessage contains the messages of a producer
 type ProducerMessage struct {
 	// Payload get the payload of the message
@@ -47,3 +60,25 @@ type Producer interface {
 
 	Close()
 }
+
+// SendCallback is called with the id of the message once it's persisted, or the error if it failed to be sent
+type SendCallback func(id MessageID, message *ProducerMessage, err error)
+
+// AsyncProducer is the interface of Producer that publishes messages without waiting for acknowledgement
+type AsyncProducer interface {
+	Producer
+
+	// SendAsync publishes the message asynchronously, callback is called exactly once for each message
+	SendAsync(ctx context.Context, message *ProducerMessage, callback SendCallback)
+}
+
+// SendAsync publishes the message asynchronously if the producer is an AsyncProducer,
+// otherwise the message is sent synchronously and callback is called before returning.
+func SendAsync(ctx context.Context, producer Producer, message *ProducerMessage, callback SendCallback) {
+	if ap, ok := producer.(AsyncProducer); ok {
+		ap.SendAsync(ctx, message, callback)
+		return
+	}
+	id, err := producer.Send(ctx, message)
+	callback(id, message, err)
+}
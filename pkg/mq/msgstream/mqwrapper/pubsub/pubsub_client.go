@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+type pubsubClient struct {
+	client *pubsub.Client
+}
+
+var _ mqwrapper.Client = &pubsubClient{}
+
+// NewClient creates a pubsubClient with the given pubsub client
+func NewClient(client *pubsub.Client) *pubsubClient {
+	return &pubsubClient{client: client}
+}
+
+// NewClientWithConfig creates a pubsubClient with the pubsub config of paramtable
+func NewClientWithConfig(ctx context.Context, config *paramtable.PubsubConfig) (*pubsubClient, error) {
+	var opts []option.ClientOption
+	if endpoint := config.Endpoint.GetValue(); endpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+	if credentialsFile := config.CredentialsFile.GetValue(); credentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialsFile))
+	}
+	client, err := pubsub.NewClient(ctx, config.ProjectID.GetValue(), opts...)
+	if err != nil {
+		log.Error("create pubsub client failed", zap.String("projectID", config.ProjectID.GetValue()), zap.Error(err))
+		return nil, err
+	}
+	log.Info("init pubsub client", zap.String("projectID", config.ProjectID.GetValue()), zap.String("endpoint", config.Endpoint.GetValue()))
+	return NewClient(client), nil
+}
+
+// subscriptionID returns the id of the subscription for a consumer,
+// which is unique in the project as the pubsub subscriptions are not scoped by topic.
+func subscriptionID(topic string, subName string) string {
+	return fmt.Sprintf("%s-%s", topic, subName)
+}
+
+// ensureTopic creates the topic if not exist
+func (pc *pubsubClient) ensureTopic(ctx context.Context, topic string) (*pubsub.Topic, error) {
+	t, err := pc.client.CreateTopic(ctx, topic)
+	if status.Code(err) == codes.AlreadyExists {
+		return pc.client.Topic(topic), nil
+	}
+	if err != nil {
+		log.Warn("create pubsub topic failed", zap.String("topic", topic), zap.Error(err))
+		return nil, err
+	}
+	return t, nil
+}
+
+// ensureSubscription creates the subscription with message ordering enabled if not exist,
+// the acked messages are retained so the subscription is able to seek back.
+func (pc *pubsubClient) ensureSubscription(ctx context.Context, t *pubsub.Topic, subName string) (*pubsub.Subscription, error) {
+	params := paramtable.Get()
+	id := subscriptionID(t.ID(), subName)
+	sub, err := pc.client.CreateSubscription(ctx, id, pubsub.SubscriptionConfig{
+		Topic:                 t,
+		AckDeadline:           params.PubsubCfg.AckDeadline.GetAsDuration(time.Second),
+		RetainAckedMessages:   true,
+		RetentionDuration:     params.PubsubCfg.RetentionDuration.GetAsDuration(time.Hour),
+		EnableMessageOrdering: true,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return pc.client.Subscription(id), nil
+	}
+	if err != nil {
+		log.Warn("create pubsub subscription failed", zap.String("topic", t.ID()), zap.String("subscription", id), zap.Error(err))
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (pc *pubsubClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	start := timerecord.NewTimeRecorder("create producer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.TotalLabel).Inc()
+
+	t, err := pc.ensureTopic(context.TODO(), options.Topic)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	t.EnableMessageOrdering = true
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateProducerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.SuccessLabel).Inc()
+	return &pubsubProducer{t: t, topic: options.Topic, orderingKey: options.Topic}, nil
+}
+
+func (pc *pubsubClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	start := timerecord.NewTimeRecorder("create consumer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.TotalLabel).Inc()
+
+	// some implementation try to consume a non-exist topic, such as dataCoordTimeTick,
+	// so the topic is created on subscribing to keep compatible with other mq.
+	t, err := pc.ensureTopic(context.TODO(), options.Topic)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	sub, err := pc.ensureSubscription(context.TODO(), t, options.SubscriptionName)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	consumer, err := newPubsubConsumer(pc.client, sub, options.BufSize, options.Topic, options.SubscriptionName, options.SubscriptionInitialPosition)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateConsumerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.SuccessLabel).Inc()
+	return consumer, nil
+}
+
+func (pc *pubsubClient) EarliestMessageID() mqwrapper.MessageID {
+	return &pubsubID{}
+}
+
+func (pc *pubsubClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	return parsePubsubID(id)
+}
+
+func (pc *pubsubClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	return UnmarshalPubsubID(id)
+}
+
+func (pc *pubsubClient) Close() {
+	if err := pc.client.Close(); err != nil {
+		log.Warn("close pubsub client failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+// newTestClient creates a pubsubClient connected to an in-memory pubsub server
+func newTestClient(t *testing.T) *pubsubClient {
+	srv := pstest.NewServer()
+	t.Cleanup(func() { srv.Close() })
+	conn, err := grpc.Dial(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	client, err := pubsub.NewClient(context.Background(), "milvus", option.WithGRPCConn(conn))
+	assert.NoError(t, err)
+	return NewClient(client)
+}
+
+func produce(t *testing.T, producer mqwrapper.Producer, payloads ...string) []mqwrapper.MessageID {
+	ids := make([]mqwrapper.MessageID, 0, len(payloads))
+	for _, payload := range payloads {
+		id, err := producer.Send(context.Background(), &mqwrapper.ProducerMessage{
+			Payload:    []byte(payload),
+			Properties: map[string]string{"payload": payload},
+		})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func consume(t *testing.T, consumer mqwrapper.Consumer, n int) []mqwrapper.Message {
+	msgs := make([]mqwrapper.Message, 0, n)
+	for len(msgs) < n {
+		select {
+		case msg := <-consumer.Chan():
+			assert.Equal(t, string(msg.Payload()), msg.Properties()["payload"])
+			consumer.Ack(msg)
+			msgs = append(msgs, msg)
+		case <-time.After(10 * time.Second):
+			t.Fatal("consume timeout")
+		}
+	}
+	return msgs
+}
+
+func TestPubsubClient_ProduceConsume(t *testing.T) {
+	client := newTestClient(t)
+	defer client.Close()
+	topic := "pubsub_produce_consume"
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: topic})
+	assert.NoError(t, err)
+	defer producer.Close()
+	produce(t, producer, "a", "b", "c")
+
+	var msgs []mqwrapper.Message
+	t.Run("earliest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "earliest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+		assert.Equal(t, "earliest", consumer.Subscription())
+
+		msgs = consume(t, consumer, 3)
+		for i, payload := range []string{"a", "b", "c"} {
+			assert.Equal(t, payload, string(msgs[i].Payload()))
+		}
+		ret, err := msgs[0].ID().LessOrEqualThan(msgs[1].ID().Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	})
+
+	t.Run("latest", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "latest",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionLatest,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+
+		produce(t, producer, "d")
+		res := consume(t, consumer, 1)
+		assert.Equal(t, "d", string(res[0].Payload()))
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer consumer.Close()
+		assert.Panics(t, func() { consumer.Chan() })
+
+		err = consumer.Seek(msgs[1].ID(), false)
+		assert.NoError(t, err)
+		err = consumer.Seek(msgs[1].ID(), true)
+		assert.Error(t, err)
+		res := consume(t, consumer, 1)
+		assert.Equal(t, "c", string(res[0].Payload()))
+
+		inclusive, err := client.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            "seek_inclusive",
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		assert.NoError(t, err)
+		defer inclusive.Close()
+		err = inclusive.Seek(msgs[1].ID(), true)
+		assert.NoError(t, err)
+		res = consume(t, inclusive, 1)
+		assert.Equal(t, "b", string(res[0].Payload()))
+	})
+}
+
+func TestPubsubClient_MsgID(t *testing.T) {
+	client := newTestClient(t)
+	defer client.Close()
+
+	earliest := client.EarliestMessageID()
+	assert.True(t, earliest.AtEarliestPosition())
+
+	id, err := client.StringToMsgID("5/10")
+	assert.NoError(t, err)
+	assert.Equal(t, &pubsubID{publishTime: 5, messageID: "10"}, id)
+	_, err = client.StringToMsgID("invalid")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(SerializePubsubID(5, "10"))
+	assert.NoError(t, err)
+	assert.Equal(t, &pubsubID{publishTime: 5, messageID: "10"}, id)
+	_, err = client.BytesToMsgID(nil)
+	assert.Error(t, err)
+}
+
+func TestPubsubConsumer_CheckTopicValid(t *testing.T) {
+	client := newTestClient(t)
+	defer client.Close()
+	topic := "pubsub_check_topic"
+
+	consumer, err := client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "check",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+	})
+	assert.NoError(t, err)
+	defer consumer.Close()
+
+	err = consumer.CheckTopicValid(topic)
+	assert.NoError(t, err)
+	err = consumer.CheckTopicValid("pubsub_not_exist")
+	assert.True(t, errors.Is(err, merr.ErrMqTopicNotFound))
+
+	_, err = consumer.GetLatestMsgID()
+	assert.ErrorIs(t, err, mqwrapper.ErrLatestMsgIDNotSupported)
+}
+
+func TestPubsubProducer_Close(t *testing.T) {
+	client := newTestClient(t)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: "pubsub_producer_close"})
+	assert.NoError(t, err)
+	producer.Close()
+	_, err = producer.Send(context.Background(), &mqwrapper.ProducerMessage{Payload: []byte("a")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// seekClockSkew is the tolerance of the clock skew between the producers and pubsub server,
+// the subscription is sought before the publish time of an id by it since pubsub seeks by the server time.
+const seekClockSkew = time.Minute
+
+// Consumer receives the messages of a topic by a subscription with message ordering enabled.
+// Pubsub seeks a subscription by publish time, the messages published before the seek position
+// at the same time are skipped by comparing the message id on receiving.
+type Consumer struct {
+	client     *pubsub.Client
+	sub        *pubsub.Subscription
+	msgChannel chan mqwrapper.Message
+	hasAssign  bool
+	topic      string
+	subName    string
+
+	// seekID is the position of the last seek, messages before it are skipped
+	seekID    *pubsubID
+	inclusive bool
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	chanOnce  sync.Once
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+var _ mqwrapper.Consumer = &Consumer{}
+
+func newPubsubConsumer(client *pubsub.Client, sub *pubsub.Subscription, bufSize int64, topic string, subName string, position mqwrapper.SubscriptionInitialPosition) (*Consumer, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// deliver the messages one by one to keep them in order
+	sub.ReceiveSettings.NumGoroutines = 1
+	sub.ReceiveSettings.MaxOutstandingMessages = int(bufSize)
+	pc := &Consumer{
+		client:     client,
+		sub:        sub,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		topic:      topic,
+		subName:    subName,
+		ctx:        ctx,
+		cancel:     cancel,
+		closeCh:    make(chan struct{}),
+	}
+
+	// if it's unknown, we leave the assign to seek
+	if position != mqwrapper.SubscriptionPositionUnknown {
+		seekTime := time.Now()
+		if position == mqwrapper.SubscriptionPositionEarliest {
+			seekTime = time.Unix(0, 0)
+		}
+		if err := sub.SeekToTime(ctx, seekTime); err != nil {
+			log.Error("pubsub consumer seek failed", zap.String("topic", topic), zap.Any("position", position), zap.Error(err))
+			cancel()
+			return nil, err
+		}
+		pc.hasAssign = true
+	}
+	return pc, nil
+}
+
+func (pc *Consumer) Subscription() string {
+	return pc.subName
+}
+
+// Chan provides a channel to read consumed message.
+func (pc *Consumer) Chan() <-chan mqwrapper.Message {
+	if !pc.hasAssign {
+		log.Error("can not chan with not assigned channel", zap.String("topic", pc.topic), zap.String("subName", pc.subName))
+		panic("failed to chan a pubsub consumer without assign")
+	}
+	pc.chanOnce.Do(func() {
+		pc.wg.Add(1)
+		go pc.receive()
+	})
+	return pc.msgChannel
+}
+
+func (pc *Consumer) receive() {
+	defer pc.wg.Done()
+	defer close(pc.msgChannel)
+	for {
+		// Receive blocks until the context is done or a non-retryable error occurs
+		err := pc.sub.Receive(pc.ctx, func(ctx context.Context, m *pubsub.Message) {
+			msg := &pubsubMessage{topic: pc.topic, msg: m}
+			if pc.skip(msg.ID().(*pubsubID)) {
+				m.Ack()
+				return
+			}
+			select {
+			case pc.msgChannel <- msg:
+			case <-ctx.Done():
+				m.Nack()
+			}
+		})
+		select {
+		case <-pc.closeCh:
+			log.Info("close consumer ", zap.String("topic", pc.topic), zap.String("subName", pc.subName))
+			return
+		default:
+		}
+		log.Warn("pubsub receive failed, retry later", zap.String("topic", pc.topic), zap.String("subName", pc.subName), zap.Error(err))
+		select {
+		case <-time.After(time.Second):
+		case <-pc.closeCh:
+		}
+	}
+}
+
+// skip checks whether the message is before the seek position,
+// it's only called by the receiving callback in sequence.
+func (pc *Consumer) skip(id *pubsubID) bool {
+	if pc.seekID == nil {
+		return false
+	}
+	ret, err := id.compare(pc.seekID.Serialize())
+	if err != nil {
+		return false
+	}
+	if ret < 0 || (ret == 0 && !pc.inclusive) {
+		return true
+	}
+	pc.seekID = nil
+	return false
+}
+
+func (pc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	if pc.hasAssign {
+		return errors.New("pubsub consumer is already assigned, can not seek again")
+	}
+
+	pid := id.(*pubsubID)
+	log.Info("pubsub consumer seek start", zap.String("topic", pc.topic),
+		zap.Stringer("msgID", pid), zap.Bool("inclusive", inclusive))
+	seekTime := time.Unix(0, 0)
+	if !pid.AtEarliestPosition() {
+		seekTime = time.Unix(0, pid.publishTime).Add(-seekClockSkew)
+	}
+	if err := pc.sub.SeekToTime(pc.ctx, seekTime); err != nil {
+		log.Warn("pubsub consumer seek failed", zap.String("topic", pc.topic), zap.Stringer("msgID", pid), zap.Error(err))
+		return err
+	}
+	if !pid.AtEarliestPosition() {
+		pc.seekID = pid
+		pc.inclusive = inclusive
+	}
+	pc.hasAssign = true
+	return nil
+}
+
+func (pc *Consumer) Ack(message mqwrapper.Message) {
+	message.(*pubsubMessage).msg.Ack()
+}
+
+// GetLatestMsgID is not supported since pubsub can't tell the latest message of a topic without consuming it.
+func (pc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	return nil, errors.Wrapf(mqwrapper.ErrLatestMsgIDNotSupported, "pubsub topic %s", pc.topic)
+}
+
+// CheckTopicValid only checks whether the topic exists,
+// pubsub can't tell whether a topic is empty without consuming it.
+func (pc *Consumer) CheckTopicValid(topic string) error {
+	exist, err := pc.client.Topic(topic).Exists(pc.ctx)
+	if err != nil {
+		return merr.WrapErrMqInternal(err)
+	}
+	if !exist {
+		return merr.WrapErrMqTopicNotFound(topic)
+	}
+	return nil
+}
+
+func (pc *Consumer) Close() {
+	pc.closeOnce.Do(func() {
+		close(pc.closeCh)
+		pc.cancel()
+		pc.wg.Wait()
+	})
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// publishTimeSize is the size of publish time in serialized pubsubID
+const publishTimeSize = 8
+
+// pubsubID maps the pubsub message to a position of the topic,
+// pubsub can only seek a subscription by time, so the publish time is kept with the message id,
+// the message id breaks the tie of messages published at the same time.
+// The publish time is the local time of the producer before publishing, which is carried by the
+// publishTimeKey attribute, so that the ids returned by producer and the ones of consumed messages are comparable.
+type pubsubID struct {
+	publishTime int64
+	messageID   string
+}
+
+var _ mqwrapper.MessageID = &pubsubID{}
+
+func (pid *pubsubID) Serialize() []byte {
+	return SerializePubsubID(pid.publishTime, pid.messageID)
+}
+
+func (pid *pubsubID) AtEarliestPosition() bool {
+	return pid.publishTime == 0 && pid.messageID == ""
+}
+
+func (pid *pubsubID) LessOrEqualThan(msgID []byte) (bool, error) {
+	ret, err := pid.compare(msgID)
+	if err != nil {
+		return false, err
+	}
+	return ret <= 0, nil
+}
+
+func (pid *pubsubID) Equal(msgID []byte) (bool, error) {
+	ret, err := pid.compare(msgID)
+	if err != nil {
+		return false, err
+	}
+	return ret == 0, nil
+}
+
+func (pid *pubsubID) String() string {
+	return fmt.Sprintf("%d/%s", pid.publishTime, pid.messageID)
+}
+
+// compare returns -1, 0 or 1 if pid is less than, equal to or greater than msgID
+func (pid *pubsubID) compare(msgID []byte) (int, error) {
+	publishTime, messageID, err := DeserializePubsubID(msgID)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case pid.publishTime < publishTime:
+		return -1, nil
+	case pid.publishTime > publishTime:
+		return 1, nil
+	}
+	return compareMessageID(pid.messageID, messageID), nil
+}
+
+// compareMessageID compares the message ids assigned by pubsub, which are decimal integers in practice,
+// the ids are compared as strings if they are not numeric, an empty id is less than any other id.
+func compareMessageID(a, b string) int {
+	x, ok1 := new(big.Int).SetString(a, 10)
+	y, ok2 := new(big.Int).SetString(b, 10)
+	if ok1 && ok2 {
+		return x.Cmp(y)
+	}
+	return strings.Compare(a, b)
+}
+
+func SerializePubsubID(publishTime int64, messageID string) []byte {
+	b := make([]byte, publishTimeSize+len(messageID))
+	common.Endian.PutUint64(b, uint64(publishTime))
+	copy(b[publishTimeSize:], messageID)
+	return b
+}
+
+func DeserializePubsubID(messageID []byte) (int64, string, error) {
+	if len(messageID) < publishTimeSize {
+		return 0, "", errors.Wrapf(mqwrapper.ErrInvalidMessageID, "pubsub message id should be at least %d bytes but got %d bytes, "+
+			"check whether the position is written by another mq type", publishTimeSize, len(messageID))
+	}
+	publishTime := int64(common.Endian.Uint64(messageID))
+	id := string(messageID[publishTimeSize:])
+	if publishTime < 0 || !utf8.ValidString(id) {
+		return 0, "", errors.Wrapf(mqwrapper.ErrInvalidMessageID, "pubsub message id should be a publish time and a string but got %v, "+
+			"check whether the position is written by another mq type", messageID)
+	}
+	return publishTime, id, nil
+}
+
+// UnmarshalPubsubID validates and deserializes the message id of pubsub
+func UnmarshalPubsubID(messageID []byte) (mqwrapper.MessageID, error) {
+	publishTime, id, err := DeserializePubsubID(messageID)
+	if err != nil {
+		return nil, err
+	}
+	return &pubsubID{publishTime: publishTime, messageID: id}, nil
+}
+
+// parsePubsubID parses the id string in the format of "publishTime/messageID",
+// the message id part is optional.
+func parsePubsubID(id string) (*pubsubID, error) {
+	parts := strings.SplitN(id, "/", 2)
+	publishTime, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	pid := &pubsubID{publishTime: publishTime}
+	if len(parts) == 2 {
+		pid.messageID = parts[1]
+	}
+	return pid, nil
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPubsubID_Serialize(t *testing.T) {
+	pid := &pubsubID{publishTime: 100, messageID: "8"}
+	bin := pid.Serialize()
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+}
+
+func TestPubsubID_AtEarliestPosition(t *testing.T) {
+	pid := &pubsubID{publishTime: 100, messageID: "8"}
+	assert.False(t, pid.AtEarliestPosition())
+
+	pid = &pubsubID{}
+	assert.True(t, pid.AtEarliestPosition())
+}
+
+func TestPubsubID_LessOrEqualThan(t *testing.T) {
+	{
+		pid1 := &pubsubID{publishTime: 100, messageID: "9"}
+		pid2 := &pubsubID{publishTime: 101, messageID: "8"}
+		ret, err := pid1.LessOrEqualThan(pid2.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+
+		ret, err = pid2.LessOrEqualThan(pid1.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		// the message id breaks the tie of publish time
+		pid1 := &pubsubID{publishTime: 100, messageID: "9"}
+		pid2 := &pubsubID{publishTime: 100, messageID: "10"}
+		ret, err := pid1.LessOrEqualThan(pid2.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+
+		ret, err = pid2.LessOrEqualThan(pid1.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		pid1 := &pubsubID{publishTime: 100}
+		pid2 := &pubsubID{publishTime: 100, messageID: "1"}
+		ret, err := pid1.LessOrEqualThan(pid2.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	}
+
+	{
+		pid := &pubsubID{publishTime: 100}
+		_, err := pid.LessOrEqualThan([]byte{1})
+		assert.Error(t, err)
+	}
+}
+
+func TestPubsubID_Equal(t *testing.T) {
+	pid1 := &pubsubID{publishTime: 100, messageID: "1"}
+	pid2 := &pubsubID{publishTime: 100, messageID: "2"}
+
+	{
+		ret, err := pid1.Equal(pid1.Serialize())
+		assert.NoError(t, err)
+		assert.True(t, ret)
+	}
+
+	{
+		ret, err := pid1.Equal(pid2.Serialize())
+		assert.NoError(t, err)
+		assert.False(t, ret)
+	}
+
+	{
+		_, err := pid1.Equal(nil)
+		assert.Error(t, err)
+	}
+}
+
+func Test_DeserializePubsubID(t *testing.T) {
+	bin := SerializePubsubID(5, "10")
+	publishTime, messageID, err := DeserializePubsubID(bin)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), publishTime)
+	assert.Equal(t, "10", messageID)
+}
+
+func Test_ParsePubsubID(t *testing.T) {
+	pid, err := parsePubsubID("5/10")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), pid.publishTime)
+	assert.Equal(t, "10", pid.messageID)
+	assert.Equal(t, "5/10", pid.String())
+
+	pid, err = parsePubsubID("5")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), pid.publishTime)
+	assert.Equal(t, "", pid.messageID)
+
+	_, err = parsePubsubID("invalid")
+	assert.Error(t, err)
+}
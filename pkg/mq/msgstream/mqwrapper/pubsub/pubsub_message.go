@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"strconv"
+
+	"cloud.google.com/go/pubsub"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// publishTimeKey is the attribute of the publish time set by pubsubProducer in unix nanoseconds
+const publishTimeKey = "_pubsub_publish_time"
+
+type pubsubMessage struct {
+	topic string
+	msg   *pubsub.Message
+}
+
+var _ mqwrapper.Message = &pubsubMessage{}
+
+func (pm *pubsubMessage) Topic() string {
+	return pm.topic
+}
+
+func (pm *pubsubMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(pm.msg.Attributes))
+	for key, value := range pm.msg.Attributes {
+		if key != publishTimeKey {
+			properties[key] = value
+		}
+	}
+	return properties
+}
+
+func (pm *pubsubMessage) Payload() []byte {
+	return pm.msg.Data
+}
+
+// ID returns the position of the message by the publish time set by pubsubProducer,
+// the publish time assigned by pubsub server is used for the messages published by others.
+func (pm *pubsubMessage) ID() mqwrapper.MessageID {
+	publishTime, err := strconv.ParseInt(pm.msg.Attributes[publishTimeKey], 10, 64)
+	if err != nil {
+		publishTime = pm.msg.PublishTime.UnixNano()
+	}
+	return &pubsubID{publishTime: publishTime, messageID: pm.msg.ID}
+}
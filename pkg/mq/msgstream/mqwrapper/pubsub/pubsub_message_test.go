@@ -0,0 +1,41 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPubsubMessage_All(t *testing.T) {
+	publishTime := time.Now()
+	msg := &pubsub.Message{ID: "1", Data: []byte("payload"), PublishTime: publishTime}
+	pm := &pubsubMessage{topic: "t", msg: msg}
+	assert.Equal(t, "t", pm.Topic())
+	assert.Equal(t, []byte("payload"), pm.Payload())
+	assert.Equal(t, map[string]string{}, pm.Properties())
+	assert.Equal(t, publishTime.UnixNano(), pm.ID().(*pubsubID).publishTime)
+	assert.Equal(t, "1", pm.ID().(*pubsubID).messageID)
+
+	// the publish time set by producer is preferred
+	msg.Attributes = map[string]string{"key": "value", publishTimeKey: "100"}
+	assert.Equal(t, map[string]string{"key": "value"}, pm.Properties())
+	assert.Equal(t, int64(100), pm.ID().(*pubsubID).publishTime)
+}
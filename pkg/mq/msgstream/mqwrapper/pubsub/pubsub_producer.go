@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// pubsubProducer publishes all the messages of a topic with the same ordering key,
+// which is the topic name, so that the messages of the vchannels sharing the topic are delivered
+// in the publishing order to a subscription with message ordering enabled.
+type pubsubProducer struct {
+	t           *pubsub.Topic
+	topic       string
+	orderingKey string
+	closeOnce   sync.Once
+	isClosed    atomic.Bool
+}
+
+var _ mqwrapper.Producer = &pubsubProducer{}
+
+func (pp *pubsubProducer) Topic() string {
+	return pp.topic
+}
+
+func (pp *pubsubProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	start := timerecord.NewTimeRecorder("send msg to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
+
+	if pp.isClosed.Load() {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Error("pubsub produce message fail because the producer has been closed", zap.String("topic", pp.topic))
+		return nil, common.NewIgnorableError(fmt.Errorf("pubsub producer is closed"))
+	}
+
+	publishTime := time.Now().UnixNano()
+	attributes := make(map[string]string, len(message.Properties)+1)
+	for key, value := range message.Properties {
+		attributes[key] = value
+	}
+	attributes[publishTimeKey] = strconv.FormatInt(publishTime, 10)
+	result := pp.t.Publish(ctx, &pubsub.Message{
+		Data:        message.Payload,
+		Attributes:  attributes,
+		OrderingKey: pp.orderingKey,
+	})
+	messageID, err := result.Get(ctx)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Warn("pubsub publish message failed", zap.String("topic", pp.topic), zap.Error(err))
+		// publishing of the ordering key is paused after a failure, resume it so the caller can retry
+		pp.t.ResumePublish(pp.orderingKey)
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Inc()
+
+	return &pubsubID{publishTime: publishTime, messageID: messageID}, nil
+}
+
+func (pp *pubsubProducer) Close() {
+	pp.closeOnce.Do(func() {
+		pp.isClosed.Store(true)
+		// flush the outstanding messages
+		pp.t.Stop()
+	})
+}
@@ -79,6 +79,10 @@ func (pc *pulsarClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwra
 		opts.CompressionType = pulsar.ZSTD
 		opts.CompressionLevel = pulsar.Faster
 	}
+	if options.Epoch > 0 {
+		// the producers share the name so that broker deduplicates their messages by sequence id
+		opts.Name = fencedProducerName(options.Topic)
+	}
 	// disable automatic batching
 	opts.DisableBatching = true
 	// change the batching max publish delay higher to avoid extra cpu consumption
@@ -96,7 +100,7 @@ func (pc *pulsarClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwra
 	elapsed := start.ElapseSpan()
 	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateProducerLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.SuccessLabel).Inc()
-	producer := &pulsarProducer{p: pp}
+	producer := &pulsarProducer{p: pp, epoch: options.Epoch}
 	return producer, nil
 }
 
@@ -181,11 +185,7 @@ func (pc *pulsarClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
 
 // BytesToMsgID converts []byte id to MessageID type
 func (pc *pulsarClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
-	pID, err := DeserializePulsarMsgID(id)
-	if err != nil {
-		return nil, err
-	}
-	return &pulsarID{messageID: pID}, nil
+	return UnmarshalPulsarMsgID(id)
 }
 
 // Close closes the pulsar client
@@ -44,6 +44,8 @@ type Consumer struct {
 	closeOnce  sync.Once
 }
 
+var _ mqwrapper.TimeSeekableConsumer = (*Consumer)(nil)
+
 // Subscription get a subscription for the consumer
 func (pc *Consumer) Subscription() string {
 	return pc.c.Subscription()
@@ -109,6 +111,16 @@ func (pc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
 	return err
 }
 
+// SeekByTime seek consume position to the first message published not before the given time
+func (pc *Consumer) SeekByTime(t time.Time) error {
+	err := pc.c.SeekByTime(t)
+	if err == nil {
+		pc.hasSeek = true
+		pc.skip = false
+	}
+	return err
+}
+
 // Ack the consumption of a single message
 func (pc *Consumer) Ack(message mqwrapper.Message) {
 	pm := message.(*pulsarMessage)
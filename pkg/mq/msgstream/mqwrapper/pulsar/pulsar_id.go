@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 )
@@ -85,6 +86,16 @@ func DeserializePulsarMsgID(messageID []byte) (pulsar.MessageID, error) {
 	return pulsar.DeserializeMessageID(messageID)
 }
 
+// UnmarshalPulsarMsgID validates and deserializes the message id of pulsar
+func UnmarshalPulsarMsgID(messageID []byte) (mqwrapper.MessageID, error) {
+	pID, err := DeserializePulsarMsgID(messageID)
+	if err != nil {
+		return nil, errors.Wrapf(mqwrapper.ErrInvalidMessageID, "failed to deserialize pulsar message id, %s, "+
+			"check whether the position is written by another mq type", err.Error())
+	}
+	return &pulsarID{messageID: pID}, nil
+}
+
 // msgIDToString is used to convert a message ID to string
 func msgIDToString(messageID pulsar.MessageID) string {
 	return strings.ToValidUTF8(string(messageID.Serialize()), "")
@@ -18,8 +18,11 @@ package pulsar
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
@@ -27,10 +30,51 @@ import (
 )
 
 // implementation assertion
-var _ mqwrapper.Producer = (*pulsarProducer)(nil)
+var _ mqwrapper.AsyncProducer = (*pulsarProducer)(nil)
 
 type pulsarProducer struct {
 	p pulsar.Producer
+	// epoch is the fencing epoch, the sequence ids of messages are prefixed by it if it's positive
+	epoch int64
+	seq   int64
+}
+
+// fencedProducerName returns the producer name shared by the fenced producers of the topic
+func fencedProducerName(topic string) string {
+	return topic + "-fenced-producer"
+}
+
+// nextSequenceID returns the sequence id of next message, which is higher than any message of lower epochs,
+// so the broker deduplication drops the messages from the producers of lower epochs.
+func (pp *pulsarProducer) nextSequenceID() *int64 {
+	if pp.epoch <= 0 {
+		return nil
+	}
+	seqID := pp.epoch<<32 | atomic.AddInt64(&pp.seq, 1)
+	return &seqID
+}
+
+// checkFenced returns ErrProducerFenced if the message is dropped by broker deduplication
+func (pp *pulsarProducer) checkFenced(pmID pulsar.MessageID) error {
+	if pp.epoch > 0 && pmID != nil && pmID.LedgerID() < 0 && pmID.EntryID() < 0 {
+		return errors.Wrapf(mqwrapper.ErrProducerFenced, "topic %s, epoch %d", pp.p.Topic(), pp.epoch)
+	}
+	return nil
+}
+
+// backpressureRetryAfter is the suggested wait before resending once pulsar applies backpressure
+const backpressureRetryAfter = time.Second
+
+// checkBackpressure converts the errors of full producer queue and exceeded backlog quota into backpressure
+func checkBackpressure(err error) error {
+	var perr *pulsar.Error
+	if errors.As(err, &perr) {
+		switch perr.Result() {
+		case pulsar.ProducerQueueIsFull, pulsar.ProducerBlockedQuotaExceededError, pulsar.ProducerBlockedQuotaExceededException:
+			return mqwrapper.NewBackpressureError(err, backpressureRetryAfter)
+		}
+	}
+	return err
 }
 
 // Topic returns the topic name of pulsar producer
@@ -41,12 +85,21 @@ func (pp *pulsarProducer) Topic() string {
 func (pp *pulsarProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
 	start := timerecord.NewTimeRecorder("send msg to stream")
 	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
 
-	ppm := &pulsar.ProducerMessage{Payload: message.Payload, Properties: message.Properties}
+	ppm := &pulsar.ProducerMessage{
+		Payload:    message.Payload,
+		Properties: message.Properties,
+		Key:        mqwrapper.GetOrderingKey(message.Properties),
+		SequenceID: pp.nextSequenceID(),
+	}
 	pmID, err := pp.p.Send(ctx, ppm)
+	if err == nil {
+		err = pp.checkFenced(pmID)
+	}
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
-		return &pulsarID{messageID: pmID}, err
+		return &pulsarID{messageID: pmID}, checkBackpressure(err)
 	}
 
 	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
@@ -54,6 +107,33 @@ func (pp *pulsarProducer) Send(ctx context.Context, message *mqwrapper.ProducerM
 	return &pulsarID{messageID: pmID}, nil
 }
 
+// SendAsync publishes the message by the async send of pulsar producer, the callbacks are called in order
+func (pp *pulsarProducer) SendAsync(ctx context.Context, message *mqwrapper.ProducerMessage, callback mqwrapper.SendCallback) {
+	start := timerecord.NewTimeRecorder("send msg to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	message = mqwrapper.WithTraceContext(ctx, message)
+
+	ppm := &pulsar.ProducerMessage{
+		Payload:    message.Payload,
+		Properties: message.Properties,
+		Key:        mqwrapper.GetOrderingKey(message.Properties),
+		SequenceID: pp.nextSequenceID(),
+	}
+	pp.p.SendAsync(ctx, ppm, func(pmID pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+		if err == nil {
+			err = pp.checkFenced(pmID)
+		}
+		if err != nil {
+			metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+			callback(nil, message, checkBackpressure(err))
+			return
+		}
+		metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Inc()
+		callback(&pulsarID{messageID: pmID}, message, nil)
+	})
+}
+
 func (pp *pulsarProducer) Close() {
 	pp.p.Close()
 }
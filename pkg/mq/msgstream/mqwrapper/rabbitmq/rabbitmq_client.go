@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/stream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// rabbitmqClient maps each topic to a rabbitmq stream, the retention of the streams
+// is done by rabbitmq server according to the stream options declared by milvus.
+type rabbitmqClient struct {
+	env     *stream.Environment
+	options *stream.StreamOptions
+}
+
+var _ mqwrapper.Client = &rabbitmqClient{}
+
+// NewClientWithConfig creates a rabbitmqClient with the rabbitmq config of paramtable
+func NewClientWithConfig(ctx context.Context, config *paramtable.RabbitmqConfig) (*rabbitmqClient, error) {
+	env, err := stream.NewEnvironment(stream.NewEnvironmentOptions().SetUri(config.URI.GetValue()))
+	if err != nil {
+		log.Error("create rabbitmq stream environment failed", zap.Error(err))
+		return nil, err
+	}
+
+	options := &stream.StreamOptions{
+		MaxSegmentSizeBytes: stream.ByteCapacity{}.MB(config.MaxSegmentInMB.GetAsInt64()),
+	}
+	if maxLength := config.MaxLengthInMB.GetAsInt64(); maxLength > 0 {
+		options.MaxLengthBytes = stream.ByteCapacity{}.MB(maxLength)
+	}
+	if maxAge := config.MaxAgeInHours.GetAsDuration(time.Hour); maxAge > 0 {
+		options.MaxAge = maxAge
+	}
+	return &rabbitmqClient{env: env, options: options}, nil
+}
+
+// ensureStream declares the stream of topic if not exist
+func (rc *rabbitmqClient) ensureStream(topic string) error {
+	err := rc.env.DeclareStream(topic, rc.options)
+	if err != nil && !errors.Is(err, stream.StreamAlreadyExists) {
+		log.Warn("declare rabbitmq stream failed", zap.String("topic", topic), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (rc *rabbitmqClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	start := timerecord.NewTimeRecorder("create producer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.TotalLabel).Inc()
+
+	if err := rc.ensureStream(options.Topic); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	producer, err := newRabbitmqProducer(rc.env, options.Topic)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateProducerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateProducerLabel, metrics.SuccessLabel).Inc()
+	return producer, nil
+}
+
+func (rc *rabbitmqClient) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	start := timerecord.NewTimeRecorder("create consumer")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.TotalLabel).Inc()
+
+	// some implementation try to consume a non-exist topic, such as dataCoordTimeTick,
+	// so the stream is declared on subscribing to keep compatible with other mq.
+	if err := rc.ensureStream(options.Topic); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	consumer, err := newRabbitmqConsumer(rc.env, options.BufSize, options.Topic, options.SubscriptionName, options.SubscriptionInitialPosition)
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.CreateConsumerLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.CreateConsumerLabel, metrics.SuccessLabel).Inc()
+	return consumer, nil
+}
+
+func (rc *rabbitmqClient) EarliestMessageID() mqwrapper.MessageID {
+	return &rabbitmqID{offset: -1}
+}
+
+func (rc *rabbitmqClient) StringToMsgID(id string) (mqwrapper.MessageID, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &rabbitmqID{offset: offset}, nil
+}
+
+func (rc *rabbitmqClient) BytesToMsgID(id []byte) (mqwrapper.MessageID, error) {
+	return UnmarshalRabbitmqID(id)
+}
+
+func (rc *rabbitmqClient) Close() {
+	if err := rc.env.Close(); err != nil {
+		log.Warn("close rabbitmq stream environment failed", zap.Error(err))
+	}
+}
+
+// committedOffset returns the first offset of the last committed chunk of the stream,
+// -1 is returned if the stream is empty.
+func committedOffset(env *stream.Environment, topic string) (int64, error) {
+	stats, err := env.StreamStats(topic)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := stats.CommittedChunkId()
+	if err != nil {
+		// there is no committed chunk in an empty stream
+		return -1, nil
+	}
+	return offset, nil
+}
@@ -0,0 +1,235 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/amqp"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/stream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// scanIdleTimeout is the time to wait for more messages when scanning the tail of a stream
+const scanIdleTimeout = 200 * time.Millisecond
+
+// Consumer consumes a rabbitmq stream from an offset specification,
+// the stream consumer is created on assigning since rabbitmq starts delivering once it's created.
+type Consumer struct {
+	env        *stream.Environment
+	c          *stream.Consumer
+	msgChannel chan mqwrapper.Message
+	hasAssign  bool
+	topic      string
+	subName    string
+	// mu protects msgChannel from being closed when the handler is sending
+	mu        sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+	closeCh   chan struct{}
+}
+
+var (
+	_ mqwrapper.Consumer             = &Consumer{}
+	_ mqwrapper.CostlyLatestConsumer = &Consumer{}
+)
+
+func newRabbitmqConsumer(env *stream.Environment, bufSize int64, topic string, subName string, position mqwrapper.SubscriptionInitialPosition) (*Consumer, error) {
+	rc := &Consumer{
+		env:        env,
+		msgChannel: make(chan mqwrapper.Message, bufSize),
+		topic:      topic,
+		subName:    subName,
+		closeCh:    make(chan struct{}),
+	}
+
+	// if it's unknown, we leave the assign to seek
+	if position != mqwrapper.SubscriptionPositionUnknown {
+		offset := stream.OffsetSpecification{}.Next()
+		if position == mqwrapper.SubscriptionPositionEarliest {
+			offset = stream.OffsetSpecification{}.First()
+		}
+		if err := rc.assign(offset); err != nil {
+			log.Error("rabbitmq consumer assign failed", zap.String("topic", topic), zap.Any("position", position), zap.Error(err))
+			return nil, err
+		}
+	}
+	return rc, nil
+}
+
+func (rc *Consumer) assign(offset stream.OffsetSpecification) error {
+	c, err := rc.env.NewConsumer(rc.topic, rc.handle, stream.NewConsumerOptions().
+		SetConsumerName(rc.subName).
+		SetOffset(offset))
+	if err != nil {
+		return err
+	}
+	rc.c = c
+	rc.hasAssign = true
+	return nil
+}
+
+// handle is called by the stream client in sequence for each message
+func (rc *Consumer) handle(consumerContext stream.ConsumerContext, msg *amqp.Message) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+	if rc.closed {
+		return
+	}
+	select {
+	case rc.msgChannel <- &rabbitmqMessage{topic: rc.topic, offset: consumerContext.Consumer.GetOffset(), msg: msg}:
+	case <-rc.closeCh:
+	}
+}
+
+func (rc *Consumer) Subscription() string {
+	return rc.subName
+}
+
+// Chan provides a channel to read consumed message.
+func (rc *Consumer) Chan() <-chan mqwrapper.Message {
+	if !rc.hasAssign {
+		log.Error("can not chan with not assigned channel", zap.String("topic", rc.topic), zap.String("subName", rc.subName))
+		panic("failed to chan a rabbitmq consumer without assign")
+	}
+	return rc.msgChannel
+}
+
+func (rc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	if rc.hasAssign {
+		return errors.New("rabbitmq consumer is already assigned, can not seek again")
+	}
+
+	offset := id.(*rabbitmqID).offset
+	log.Info("rabbitmq consumer seek start", zap.String("topic", rc.topic),
+		zap.Int64("offset", offset), zap.Bool("inclusive", inclusive))
+
+	if !inclusive {
+		offset++
+	}
+	spec := stream.OffsetSpecification{}.Offset(offset)
+	if offset < 0 {
+		spec = stream.OffsetSpecification{}.First()
+	}
+	if err := rc.assign(spec); err != nil {
+		log.Warn("rabbitmq consumer seek failed", zap.String("topic", rc.topic), zap.Int64("offset", offset), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (rc *Consumer) Ack(message mqwrapper.Message) {
+	// Do nothing
+	// Rabbitmq stream retention only depends on the retention configuration of the stream.
+}
+
+// LatestMsgIDCostly returns true since it scans the last chunk of the stream, the lag of the consumer is not probed
+func (rc *Consumer) LatestMsgIDCostly() bool {
+	return true
+}
+
+// GetLatestMsgID scans the last committed chunk of the stream to find the offset of the last message,
+// since the stream stats only tell the first offset of the chunk.
+func (rc *Consumer) GetLatestMsgID() (mqwrapper.MessageID, error) {
+	chunkOffset, err := committedOffset(rc.env, rc.topic)
+	if err != nil {
+		return nil, err
+	}
+	if chunkOffset < 0 {
+		return &rabbitmqID{offset: chunkOffset}, nil
+	}
+
+	offsetCh := make(chan int64, 1024)
+	done := make(chan struct{})
+	c, err := rc.env.NewConsumer(rc.topic, func(consumerContext stream.ConsumerContext, msg *amqp.Message) {
+		select {
+		case offsetCh <- consumerContext.Consumer.GetOffset():
+		case <-done:
+		}
+	}, stream.NewConsumerOptions().SetOffset(stream.OffsetSpecification{}.Offset(chunkOffset)))
+	if err != nil {
+		return nil, err
+	}
+
+	latest := chunkOffset
+	timer := time.NewTimer(scanIdleTimeout)
+	defer timer.Stop()
+	for scanning := true; scanning; {
+		select {
+		case offset := <-offsetCh:
+			if offset > latest {
+				latest = offset
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(scanIdleTimeout)
+		case <-timer.C:
+			scanning = false
+		}
+	}
+	close(done)
+	if err := c.Close(); err != nil {
+		log.Warn("close rabbitmq scanning consumer failed", zap.String("topic", rc.topic), zap.Error(err))
+	}
+
+	log.Info("get latest msg ID ", zap.String("topic", rc.topic), zap.Int64("offset", latest))
+	return &rabbitmqID{offset: latest}, nil
+}
+
+func (rc *Consumer) CheckTopicValid(topic string) error {
+	exist, err := rc.env.StreamExists(topic)
+	if err != nil {
+		return merr.WrapErrMqInternal(err)
+	}
+	if !exist {
+		return merr.WrapErrMqTopicNotFound(topic)
+	}
+
+	offset, err := committedOffset(rc.env, topic)
+	if err != nil {
+		return merr.WrapErrMqInternal(err)
+	}
+	// check topic is empty
+	if offset >= 0 {
+		return merr.WrapErrMqTopicNotEmpty(topic, "topic is not empty")
+	}
+	log.Info("created topic is empty", zap.String("topic", topic))
+
+	return nil
+}
+
+func (rc *Consumer) Close() {
+	rc.closeOnce.Do(func() {
+		close(rc.closeCh)
+		if rc.c != nil {
+			if err := rc.c.Close(); err != nil {
+				log.Warn("close rabbitmq consumer failed", zap.String("topic", rc.topic), zap.Error(err))
+			}
+		}
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		rc.closed = true
+		close(rc.msgChannel)
+	})
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// rabbitmqID wraps the offset of a message in rabbitmq stream,
+// a negative offset stands for the position before the first message.
+type rabbitmqID struct {
+	offset int64
+}
+
+var _ mqwrapper.RangeMessageID = &rabbitmqID{}
+
+func (rid *rabbitmqID) Serialize() []byte {
+	return SerializeRabbitmqID(rid.offset)
+}
+
+func (rid *rabbitmqID) AtEarliestPosition() bool {
+	return rid.offset < 0
+}
+
+func (rid *rabbitmqID) Equal(msgID []byte) (bool, error) {
+	offset, err := unmarshalRabbitmqOffset(msgID)
+	if err != nil {
+		return false, err
+	}
+	return rid.offset == offset, nil
+}
+
+func (rid *rabbitmqID) LessOrEqualThan(msgID []byte) (bool, error) {
+	offset, err := unmarshalRabbitmqOffset(msgID)
+	if err != nil {
+		return false, err
+	}
+	return rid.offset <= offset, nil
+}
+
+// Distance returns the offset distance to the given id, which is the message number between them
+func (rid *rabbitmqID) Distance(msgID []byte) (int64, error) {
+	offset, err := unmarshalRabbitmqOffset(msgID)
+	if err != nil {
+		return 0, err
+	}
+	return offset - rid.offset, nil
+}
+
+func (rid *rabbitmqID) Add(n int64) mqwrapper.MessageID {
+	return &rabbitmqID{
+		offset: rid.offset + n,
+	}
+}
+
+func SerializeRabbitmqID(offset int64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func DeserializeRabbitmqID(messageID []byte) int64 {
+	return int64(common.Endian.Uint64(messageID))
+}
+
+// unmarshalRabbitmqOffset validates and deserializes the stream offset, -1 is the earliest position
+func unmarshalRabbitmqOffset(messageID []byte) (int64, error) {
+	return mqwrapper.UnmarshalInt64MessageID("rabbitmq", messageID, -1)
+}
+
+// UnmarshalRabbitmqID validates and deserializes the message id of rabbitmq
+func UnmarshalRabbitmqID(messageID []byte) (mqwrapper.MessageID, error) {
+	offset, err := unmarshalRabbitmqOffset(messageID)
+	if err != nil {
+		return nil, err
+	}
+	return &rabbitmqID{offset: offset}, nil
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+func TestRabbitmqID_Serialize(t *testing.T) {
+	rid := &rabbitmqID{offset: 8}
+	bin := rid.Serialize()
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+}
+
+func TestRabbitmqID_AtEarliestPosition(t *testing.T) {
+	rid := &rabbitmqID{offset: 0}
+	assert.False(t, rid.AtEarliestPosition())
+
+	rid = &rabbitmqID{offset: -1}
+	assert.True(t, rid.AtEarliestPosition())
+}
+
+func TestRabbitmqID_LessOrEqualThan(t *testing.T) {
+	rid1 := &rabbitmqID{offset: 8}
+	rid2 := &rabbitmqID{offset: -1}
+	ret, err := rid1.LessOrEqualThan(rid2.Serialize())
+	assert.NoError(t, err)
+	assert.False(t, ret)
+
+	ret, err = rid2.LessOrEqualThan(rid1.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+
+	ret, err = rid1.LessOrEqualThan(rid1.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+}
+
+func TestRabbitmqID_Equal(t *testing.T) {
+	rid1 := &rabbitmqID{offset: 0}
+	rid2 := &rabbitmqID{offset: 1}
+
+	ret, err := rid1.Equal(rid1.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ret)
+
+	ret, err = rid1.Equal(rid2.Serialize())
+	assert.NoError(t, err)
+	assert.False(t, ret)
+
+	_, err = rid1.Equal([]byte{1, 2})
+	assert.ErrorIs(t, err, mqwrapper.ErrInvalidMessageID)
+}
+
+func Test_DeserializeRabbitmqID(t *testing.T) {
+	bin := SerializeRabbitmqID(5)
+	id := DeserializeRabbitmqID(bin)
+	assert.Equal(t, int64(5), id)
+}
+
+func TestRabbitmqID_Distance(t *testing.T) {
+	id1 := &rabbitmqID{offset: 5}
+	id2 := &rabbitmqID{offset: 15}
+
+	d, err := id1.Distance(id2.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), d)
+
+	d, err = id2.Distance(id1.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-10), d)
+}
+
+func TestRabbitmqID_Add(t *testing.T) {
+	id := &rabbitmqID{offset: 5}
+
+	next := id.Add(10)
+	assert.Equal(t, int64(15), DeserializeRabbitmqID(next.Serialize()))
+	assert.Equal(t, int64(5), id.offset)
+
+	prev := id.Add(-2)
+	assert.Equal(t, int64(3), DeserializeRabbitmqID(prev.Serialize()))
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"fmt"
+
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/amqp"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+type rabbitmqMessage struct {
+	topic  string
+	offset int64
+	msg    *amqp.Message
+}
+
+var _ mqwrapper.Message = &rabbitmqMessage{}
+
+func (rm *rabbitmqMessage) Topic() string {
+	return rm.topic
+}
+
+// Properties returns the application properties of amqp message,
+// the values are always strings since they are set by rabbitmqProducer.
+func (rm *rabbitmqMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(rm.msg.ApplicationProperties))
+	for key, value := range rm.msg.ApplicationProperties {
+		if key == sendIDKey {
+			continue
+		}
+		properties[key] = fmt.Sprint(value)
+	}
+	return properties
+}
+
+// Payload returns the first data section of amqp message, which is the only one set by rabbitmqProducer
+func (rm *rabbitmqMessage) Payload() []byte {
+	if len(rm.msg.Data) == 0 {
+		return nil
+	}
+	return rm.msg.Data[0]
+}
+
+func (rm *rabbitmqMessage) ID() mqwrapper.MessageID {
+	return &rabbitmqID{offset: rm.offset}
+}
+
+// newAMQPMessage converts the producer message to amqp message
+func newAMQPMessage(message *mqwrapper.ProducerMessage) *amqp.AMQP10 {
+	msg := amqp.NewMessage(message.Payload)
+	if len(message.Properties) > 0 {
+		msg.ApplicationProperties = make(map[string]interface{}, len(message.Properties))
+		for key, value := range message.Properties {
+			msg.ApplicationProperties[key] = value
+		}
+	}
+	return msg
+}
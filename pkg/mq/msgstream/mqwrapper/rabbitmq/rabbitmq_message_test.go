@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"testing"
+
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+func TestRabbitmqMessage_All(t *testing.T) {
+	msg := newAMQPMessage(&mqwrapper.ProducerMessage{
+		Payload:    []byte("payload"),
+		Properties: map[string]string{"key": "value"},
+	})
+	rm := &rabbitmqMessage{
+		topic:  "t",
+		offset: 10,
+		msg:    &amqp.Message{Data: [][]byte{[]byte("payload")}, ApplicationProperties: msg.ApplicationProperties},
+	}
+	assert.Equal(t, "t", rm.Topic())
+	assert.Equal(t, int64(10), rm.ID().(*rabbitmqID).offset)
+	assert.Equal(t, []byte("payload"), rm.Payload())
+	assert.Equal(t, map[string]string{"key": "value"}, rm.Properties())
+
+	rm.msg = &amqp.Message{}
+	assert.Nil(t, rm.Payload())
+	assert.Equal(t, map[string]string{}, rm.Properties())
+}
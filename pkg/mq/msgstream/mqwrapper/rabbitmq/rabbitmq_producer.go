@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/amqp"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/message"
+	"github.com/rabbitmq/rabbitmq-stream-go-client/pkg/stream"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// sendIDKey is the application property identifying a sent message of a producer,
+// the confirmations of rabbitmq stream only carry the publishing ids, so the offsets of
+// the sent messages are resolved by reading them back from the stream.
+const sendIDKey = "_rabbitmq_send_id"
+
+type rabbitmqProducer struct {
+	env       *stream.Environment
+	p         *stream.Producer
+	confirmCh stream.ChannelPublishConfirm
+	// resolver reads the stream from the creation of the producer to resolve the offsets of the sent messages
+	resolver *stream.Consumer
+	topic    string
+	name     string
+	seq      int64
+	// mu serializes the sends, so each send waits for the confirmation and offset of its own message
+	mu sync.Mutex
+	// pendingMu guards the send id and the offset chan of the pending send
+	pendingMu sync.Mutex
+	pendingID string
+	offsetCh  chan int64
+	closeOnce sync.Once
+	isClosed  bool
+}
+
+var _ mqwrapper.Producer = &rabbitmqProducer{}
+
+func newRabbitmqProducer(env *stream.Environment, topic string) (*rabbitmqProducer, error) {
+	rp := &rabbitmqProducer{
+		env:   env,
+		topic: topic,
+		name:  funcutil.RandomString(16),
+	}
+	resolver, err := env.NewConsumer(topic, rp.resolve, stream.NewConsumerOptions().
+		SetOffset(stream.OffsetSpecification{}.Next()))
+	if err != nil {
+		return nil, err
+	}
+	p, err := env.NewProducer(topic, stream.NewProducerOptions())
+	if err != nil {
+		if err := resolver.Close(); err != nil {
+			log.Warn("close rabbitmq offset resolver failed", zap.String("topic", topic), zap.Error(err))
+		}
+		return nil, err
+	}
+	rp.resolver = resolver
+	rp.p = p
+	rp.confirmCh = p.NotifyPublishConfirmation()
+	return rp, nil
+}
+
+// resolve is called by the stream client in sequence for each message published after the producer is created,
+// it hands the offset of the pending send over to Send
+func (rp *rabbitmqProducer) resolve(consumerContext stream.ConsumerContext, msg *amqp.Message) {
+	sendID, ok := msg.ApplicationProperties[sendIDKey].(string)
+	if !ok {
+		return
+	}
+	rp.pendingMu.Lock()
+	defer rp.pendingMu.Unlock()
+	if rp.offsetCh != nil && sendID == rp.pendingID {
+		rp.offsetCh <- consumerContext.Consumer.GetOffset()
+		rp.offsetCh = nil
+	}
+}
+
+// pend registers the send id of the pending send, and returns the chan of its offset
+func (rp *rabbitmqProducer) pend(sendID string) <-chan int64 {
+	rp.pendingMu.Lock()
+	defer rp.pendingMu.Unlock()
+	rp.pendingID = sendID
+	rp.offsetCh = make(chan int64, 1)
+	return rp.offsetCh
+}
+
+func (rp *rabbitmqProducer) unpend() {
+	rp.pendingMu.Lock()
+	defer rp.pendingMu.Unlock()
+	rp.pendingID = ""
+	rp.offsetCh = nil
+}
+
+func (rp *rabbitmqProducer) Topic() string {
+	return rp.topic
+}
+
+// Send publishes the message, waits for the confirmation and returns the offset of the message.
+func (rp *rabbitmqProducer) Send(ctx context.Context, pm *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	start := timerecord.NewTimeRecorder("send msg to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Inc()
+	pm = mqwrapper.WithTraceContext(ctx, pm)
+
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	if rp.isClosed {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Error("rabbitmq produce message fail because the producer has been closed", zap.String("topic", rp.topic))
+		return nil, common.NewIgnorableError(fmt.Errorf("rabbitmq producer is closed"))
+	}
+
+	rp.seq++
+	sendID := rp.name + "-" + strconv.FormatInt(rp.seq, 10)
+	msg := newAMQPMessage(pm)
+	if msg.ApplicationProperties == nil {
+		msg.ApplicationProperties = make(map[string]interface{}, 1)
+	}
+	msg.ApplicationProperties[sendIDKey] = sendID
+	offsetCh := rp.pend(sendID)
+	defer rp.unpend()
+
+	if err := rp.p.BatchSend([]message.StreamMessage{msg}); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Warn("rabbitmq send message failed", zap.String("topic", rp.topic), zap.Error(err))
+		return nil, err
+	}
+	if err := rp.waitConfirm(ctx, msg); err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Warn("rabbitmq message is not confirmed", zap.String("topic", rp.topic), zap.Error(err))
+		return nil, err
+	}
+	var offset int64
+	select {
+	case <-ctx.Done():
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
+		log.Warn("rabbitmq message offset is not resolved", zap.String("topic", rp.topic), zap.Error(ctx.Err()))
+		return nil, ctx.Err()
+	case offset = <-offsetCh:
+	}
+
+	elapsed := start.ElapseSpan()
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Inc()
+
+	return &rabbitmqID{offset: offset}, nil
+}
+
+// waitConfirm waits for the confirmation of msg, the stale confirmations of timeout sends are dropped
+func (rp *rabbitmqProducer) waitConfirm(ctx context.Context, msg message.StreamMessage) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case statuses, ok := <-rp.confirmCh:
+			if !ok {
+				return common.NewIgnorableError(fmt.Errorf("confirm chan of rabbitmq producer is closed"))
+			}
+			for _, status := range statuses {
+				if status.GetMessage() != msg {
+					continue
+				}
+				if !status.IsConfirmed() {
+					return fmt.Errorf("rabbitmq message is rejected, code %d, err %v", status.GetErrorCode(), status.GetError())
+				}
+				return nil
+			}
+		}
+	}
+}
+
+func (rp *rabbitmqProducer) Close() {
+	rp.closeOnce.Do(func() {
+		rp.mu.Lock()
+		defer rp.mu.Unlock()
+		rp.isClosed = true
+		if err := rp.p.Close(); err != nil {
+			log.Warn("close rabbitmq producer failed", zap.String("topic", rp.topic), zap.Error(err))
+		}
+		if err := rp.resolver.Close(); err != nil {
+			log.Warn("close rabbitmq offset resolver failed", zap.String("topic", rp.topic), zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// WithTraceContext returns the message with the W3C trace context of ctx injected into its properties,
+// so the trace is propagated through mq by any implementation carrying the properties.
+// The given message is not modified, it's returned directly if ctx has no trace to propagate.
+func WithTraceContext(ctx context.Context, message *ProducerMessage) *ProducerMessage {
+	if ctx == nil {
+		return message
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return message
+	}
+	properties := make(map[string]string, len(message.Properties)+len(carrier))
+	for key, value := range message.Properties {
+		properties[key] = value
+	}
+	for key, value := range carrier {
+		properties[key] = value
+	}
+	return &ProducerMessage{Payload: message.Payload, Properties: properties}
+}
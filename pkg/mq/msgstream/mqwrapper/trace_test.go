@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqwrapper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContext(t *testing.T) {
+	origin := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(origin)
+
+	message := &ProducerMessage{Payload: []byte("payload"), Properties: map[string]string{"key": "value"}}
+
+	t.Run("no trace", func(t *testing.T) {
+		assert.Equal(t, message, WithTraceContext(context.Background(), message))
+	})
+
+	t.Run("propagate", func(t *testing.T) {
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3},
+			SpanID:     trace.SpanID{4, 5, 6},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		traced := WithTraceContext(ctx, message)
+		assert.Equal(t, message.Payload, traced.Payload)
+		assert.Equal(t, "value", traced.Properties["key"])
+		assert.Contains(t, traced.Properties, "traceparent")
+		// the origin message is not modified
+		assert.NotContains(t, message.Properties, "traceparent")
+
+		extracted := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(traced.Properties))
+		assert.Equal(t, sc.TraceID(), trace.SpanContextFromContext(extracted).TraceID())
+		assert.Equal(t, sc.SpanID(), trace.SpanContextFromContext(extracted).SpanID())
+
+		extracted = otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(message.Properties))
+		assert.False(t, trace.SpanContextFromContext(extracted).IsValid())
+	})
+}
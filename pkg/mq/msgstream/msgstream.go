@@ -64,6 +64,8 @@ type MsgStream interface {
 	AsConsumer(ctx context.Context, channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) error
 	Chan() <-chan *MsgPack
 	Seek(ctx context.Context, offset []*MsgPosition) error
+	// SeekByTime resets the consumer of channel to the first message published not before ts
+	SeekByTime(ctx context.Context, channel string, ts Timestamp) error
 
 	GetLatestMsgID(channel string) (MessageID, error)
 	CheckTopicValid(channel string) error
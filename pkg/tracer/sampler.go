@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// methodSampler samples the traces by the fraction of the rpc method, e.g. all the CreateJob calls but few of
+// the QueryJobs calls which are polled constantly, the default fraction is used by the other spans.
+// The spans whose parent isn't sampled are recorded without being sampled if recordUnsampled is set,
+// so they can still be exported by the tail sampling if any of them fails.
+type methodSampler struct {
+	defaultSampler  sdk.Sampler
+	methodSamplers  map[string]sdk.Sampler
+	recordUnsampled bool
+	description     string
+}
+
+// newMethodSampler creates a methodSampler, the keys of fractions are either the full rpc method names,
+// e.g. "index.IndexNode/CreateJob", or the method names only, e.g. "CreateJob".
+func newMethodSampler(defaultFraction float64, fractions map[string]string, recordUnsampled bool) *methodSampler {
+	s := &methodSampler{
+		defaultSampler:  sdk.TraceIDRatioBased(defaultFraction),
+		methodSamplers:  make(map[string]sdk.Sampler, len(fractions)),
+		recordUnsampled: recordUnsampled,
+	}
+	methods := make([]string, 0, len(fractions))
+	for method, value := range fractions {
+		fraction, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.Warn("invalid trace sample fraction of method, ignore it",
+				zap.String("method", method), zap.String("fraction", value), zap.Error(err))
+			continue
+		}
+		s.methodSamplers[strings.TrimPrefix(method, "/")] = sdk.TraceIDRatioBased(fraction)
+		methods = append(methods, fmt.Sprintf("%s:%g", method, fraction))
+	}
+	sort.Strings(methods)
+	s.description = fmt.Sprintf("MethodSampler{default:%g,methods:[%s],recordUnsampled:%t}",
+		defaultFraction, strings.Join(methods, ","), recordUnsampled)
+	return s
+}
+
+// samplerOf returns the sampler of the span name, nil if there is no specific one.
+func (s *methodSampler) samplerOf(name string) sdk.Sampler {
+	name = strings.TrimPrefix(name, "/")
+	if sampler, ok := s.methodSamplers[name]; ok {
+		return sampler
+	}
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		if sampler, ok := s.methodSamplers[name[idx+1:]]; ok {
+			return sampler
+		}
+	}
+	return nil
+}
+
+func (s *methodSampler) ShouldSample(p sdk.SamplingParameters) sdk.SamplingResult {
+	parent := trace.SpanContextFromContext(p.ParentContext)
+	var result sdk.SamplingResult
+	switch {
+	case parent.IsValid() && parent.IsSampled():
+		// keep the sampled trace complete
+		result = sdk.SamplingResult{Decision: sdk.RecordAndSample, Tracestate: parent.TraceState()}
+	case parent.IsValid() && !parent.IsRemote():
+		result = sdk.SamplingResult{Decision: sdk.Drop, Tracestate: parent.TraceState()}
+	default:
+		// the root span, or the rpc whose caller doesn't sample it, which is sampled only if the method asks for it
+		sampler := s.samplerOf(p.Name)
+		switch {
+		case sampler != nil:
+			result = sampler.ShouldSample(p)
+		case parent.IsValid():
+			result = sdk.SamplingResult{Decision: sdk.Drop, Tracestate: parent.TraceState()}
+		default:
+			result = s.defaultSampler.ShouldSample(p)
+		}
+	}
+	if result.Decision == sdk.Drop && s.recordUnsampled {
+		result.Decision = sdk.RecordOnly
+	}
+	return result
+}
+
+func (s *methodSampler) Description() string {
+	return s.description
+}
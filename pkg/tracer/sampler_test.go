@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMethodSampler(t *testing.T) {
+	sampler := newMethodSampler(0, map[string]string{
+		"CreateJob":                 "1",
+		"/index.IndexNode/DropJobs": "1",
+		"QueryJobs":                 "0",
+		"GetJobStats":               "invalid",
+	}, false)
+	assert.Contains(t, sampler.Description(), "CreateJob:1")
+
+	decide := func(parent context.Context, name string) sdk.SamplingDecision {
+		return sampler.ShouldSample(sdk.SamplingParameters{
+			ParentContext: parent,
+			TraceID:       trace.TraceID{1},
+			Name:          name,
+		}).Decision
+	}
+	ctx := context.Background()
+	assert.Equal(t, sdk.RecordAndSample, decide(ctx, "index.IndexNode/CreateJob"))
+	assert.Equal(t, sdk.RecordAndSample, decide(ctx, "index.IndexNode/DropJobs"))
+	assert.Equal(t, sdk.Drop, decide(ctx, "index.IndexNode/QueryJobs"))
+	// fall back to the default fraction
+	assert.Equal(t, sdk.Drop, decide(ctx, "index.IndexNode/GetJobStats"))
+	assert.Equal(t, sdk.Drop, decide(ctx, "IndexBuildTask-Execute"))
+
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}, TraceFlags: trace.FlagsSampled})
+	unsampled := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}})
+	// the sampled parent is always followed
+	assert.Equal(t, sdk.RecordAndSample, decide(trace.ContextWithSpanContext(ctx, sampled), "index.IndexNode/QueryJobs"))
+	// the unsampled local parent is always followed
+	assert.Equal(t, sdk.Drop, decide(trace.ContextWithSpanContext(ctx, unsampled), "index.IndexNode/CreateJob"))
+	// the unsampled remote parent is overridden only by the method fraction
+	remote := trace.ContextWithRemoteSpanContext(ctx, unsampled)
+	assert.Equal(t, sdk.RecordAndSample, decide(remote, "index.IndexNode/CreateJob"))
+	assert.Equal(t, sdk.Drop, decide(remote, "index.IndexNode/GetJobStats"))
+
+	sampler = newMethodSampler(0, nil, true)
+	assert.Equal(t, sdk.RecordOnly, decide(ctx, "index.IndexNode/QueryJobs"))
+}
+
+func TestTailSamplingProcessor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdk.NewTracerProvider(
+		sdk.WithSampler(newMethodSampler(0, nil, true)),
+		sdk.WithSpanProcessor(newTailSamplingProcessor(recorder, 1, time.Hour)),
+	)
+	tracer := tp.Tracer("test")
+
+	// the succeeded trace is dropped
+	ctx, root := tracer.Start(context.Background(), "root")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+	root.End()
+	assert.Empty(t, recorder.Ended())
+
+	// the failed trace is exported completely
+	ctx, root = tracer.Start(context.Background(), "root")
+	_, child = tracer.Start(ctx, "child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	root.End()
+	ended := recorder.Ended()
+	assert.Len(t, ended, 2)
+	for _, span := range ended {
+		assert.True(t, span.SpanContext().IsSampled())
+	}
+
+	// the spans of the traces exceeding the limit are dropped
+	ctx1, root1 := tracer.Start(context.Background(), "root1")
+	_, child1 := tracer.Start(ctx1, "child1")
+	child1.End()
+	ctx2, root2 := tracer.Start(context.Background(), "root2")
+	_, child2 := tracer.Start(ctx2, "child2")
+	child2.SetStatus(codes.Error, "failed")
+	child2.End()
+	root2.SetStatus(codes.Error, "failed")
+	root2.End()
+	root1.End()
+	assert.Len(t, recorder.Ended(), 3)
+	assert.Equal(t, "root2", recorder.Ended()[2].Name())
+
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingProcessorTTL(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	processor := newTailSamplingProcessor(recorder, 1, time.Millisecond)
+	tp := sdk.NewTracerProvider(
+		sdk.WithSampler(newMethodSampler(0, nil, true)),
+		sdk.WithSpanProcessor(processor),
+	)
+	tracer := tp.Tracer("test")
+
+	// the failed span ended after its root is buffered until the trace expires
+	ctx, root := tracer.Start(context.Background(), "root")
+	_, late := tracer.Start(ctx, "late")
+	root.End()
+	late.SetStatus(codes.Error, "failed")
+	late.End()
+	assert.Len(t, processor.pending, 1)
+
+	// the expired trace doesn't block the others
+	time.Sleep(pendingSweepInterval)
+	ctx, root = tracer.Start(context.Background(), "root")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+	root.End()
+	assert.Empty(t, processor.pending)
+	assert.Len(t, recorder.Ended(), 1)
+	assert.Equal(t, "late", recorder.Ended()[0].Name())
+	assert.True(t, recorder.Ended()[0].SpanContext().IsSampled())
+
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// maxSpansPerTrace is the maximum number of the unsampled spans buffered for a trace
+	maxSpansPerTrace = 1024
+	// pendingSweepInterval is the minimal interval between the sweeps of the expired pending traces
+	pendingSweepInterval = time.Second
+)
+
+// tailSamplingProcessor buffers the ended spans which are recorded but not sampled, until the local root span
+// of the trace ends. If any of them ends with error status, all of them are exported as sampled,
+// otherwise they are dropped. The sampled spans are passed to the next processor directly.
+// A pending trace expires after ttl if its local root span doesn't end, e.g. the spans ended after the root.
+type tailSamplingProcessor struct {
+	next      sdk.SpanProcessor
+	maxTraces int
+	ttl       time.Duration
+
+	mu        sync.Mutex
+	pending   map[trace.TraceID]*pendingTrace
+	lastSweep time.Time
+}
+
+type pendingTrace struct {
+	spans   []sdk.ReadOnlySpan
+	failed  bool
+	created time.Time
+}
+
+// sampledSpan marks a recorded but unsampled span as sampled, so that it's exported by the next processor.
+type sampledSpan struct {
+	sdk.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() trace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
+
+func newTailSamplingProcessor(next sdk.SpanProcessor, maxTraces int, ttl time.Duration) *tailSamplingProcessor {
+	return &tailSamplingProcessor{
+		next:      next,
+		maxTraces: maxTraces,
+		ttl:       ttl,
+		pending:   make(map[trace.TraceID]*pendingTrace),
+		lastSweep: time.Now(),
+	}
+}
+
+func (p *tailSamplingProcessor) OnStart(parent context.Context, s sdk.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p *tailSamplingProcessor) OnEnd(s sdk.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+
+	traceID := s.SpanContext().TraceID()
+	failed := s.Status().Code == codes.Error
+	localRoot := !s.Parent().IsValid() || s.Parent().IsRemote()
+
+	p.mu.Lock()
+	expired := p.sweepExpired()
+	defer p.exportFailed(expired...)
+	pt, ok := p.pending[traceID]
+	if !ok {
+		if !localRoot && len(p.pending) >= p.maxTraces {
+			p.mu.Unlock()
+			log.RatedWarn(60, "too many pending traces for tail sampling, drop the span",
+				zap.Int("maxTraces", p.maxTraces), zap.String("traceID", traceID.String()))
+			return
+		}
+		pt = &pendingTrace{created: time.Now()}
+		p.pending[traceID] = pt
+	}
+	if len(pt.spans) < maxSpansPerTrace {
+		pt.spans = append(pt.spans, s)
+	}
+	pt.failed = pt.failed || failed
+	if !localRoot {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.pending, traceID)
+	p.mu.Unlock()
+
+	p.exportFailed(pt)
+}
+
+// sweepExpired removes the pending traces older than ttl, at most once per pendingSweepInterval.
+// The mu must be held by caller.
+func (p *tailSamplingProcessor) sweepExpired() []*pendingTrace {
+	now := time.Now()
+	if p.ttl <= 0 || now.Sub(p.lastSweep) < pendingSweepInterval {
+		return nil
+	}
+	p.lastSweep = now
+	var expired []*pendingTrace
+	for traceID, pt := range p.pending {
+		if now.Sub(pt.created) >= p.ttl {
+			delete(p.pending, traceID)
+			expired = append(expired, pt)
+		}
+	}
+	if len(expired) > 0 {
+		log.RatedInfo(60, "pending traces of tail sampling expired", zap.Int("expired", len(expired)))
+	}
+	return expired
+}
+
+// exportFailed exports the spans of the failed traces as sampled, the others are dropped.
+func (p *tailSamplingProcessor) exportFailed(traces ...*pendingTrace) {
+	for _, pt := range traces {
+		if !pt.failed {
+			continue
+		}
+		for _, span := range pt.spans {
+			p.next.OnEnd(sampledSpan{span})
+		}
+	}
+}
+
+func (p *tailSamplingProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	p.pending = make(map[trace.TraceID]*pendingTrace)
+	p.mu.Unlock()
+	return p.next.Shutdown(ctx)
+}
+
+func (p *tailSamplingProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
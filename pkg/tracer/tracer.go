@@ -18,6 +18,7 @@ package tracer
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.opentelemetry.io/otel"
@@ -55,18 +56,25 @@ func Init() {
 		log.Warn("Init tracer faield", zap.Error(err))
 		return
 	}
+	tailSampling := params.TraceCfg.TailSamplingEnabled.GetAsBool()
+	var processor sdk.SpanProcessor = sdk.NewBatchSpanProcessor(exp)
+	if tailSampling {
+		processor = newTailSamplingProcessor(processor, params.TraceCfg.TailSamplingMaxTraces.GetAsInt(),
+			params.TraceCfg.TailSamplingTTL.GetAsDuration(time.Second))
+	}
+	sampler := newMethodSampler(params.TraceCfg.SampleFraction.GetAsFloat(),
+		params.TraceCfg.MethodSampleFractions.GetAsJSONMap(), tailSampling)
 	tp := sdk.NewTracerProvider(
-		sdk.WithBatcher(exp),
+		sdk.WithSpanProcessor(processor),
 		sdk.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(paramtable.GetRole()),
 			attribute.Int64("NodeID", paramtable.GetNodeID()),
 		)),
-		sdk.WithSampler(sdk.ParentBased(
-			sdk.TraceIDRatioBased(params.TraceCfg.SampleFraction.GetAsFloat()),
-		)),
+		sdk.WithSampler(sampler),
 	)
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
-	log.Info("Init tracer finished", zap.String("Exporter", params.TraceCfg.Exporter.GetValue()))
+	log.Info("Init tracer finished", zap.String("Exporter", params.TraceCfg.Exporter.GetValue()),
+		zap.String("Sampler", sampler.Description()))
 }
@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crashreport recovers the panics of the long-running goroutines, e.g. the workers of the task schedulers
+// and the mq dispatch loops, and writes the stack, the latest log lines and the identity of the task into
+// a crash report file, so that a panic of a task fails the task instead of killing the loop silently.
+package crashreport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// ErrPanicked is the error of a recovered panic.
+var ErrPanicked = errors.New("panicked")
+
+const reportSuffix = ".crash"
+
+// Report is the crash report of a recovered panic.
+type Report struct {
+	Time      time.Time
+	Component string
+	// Identity identifies the task which panicked, e.g. the build id and the cluster id
+	Identity map[string]string
+	Panic    interface{}
+	Stack    []byte
+	LogTail  []string
+}
+
+func (r *Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "time: %s\n", r.Time.Format(time.RFC3339Nano))
+	fmt.Fprintf(&sb, "component: %s\n", r.Component)
+	keys := make([]string, 0, len(r.Identity))
+	for key := range r.Identity {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&sb, "%s: %s\n", key, r.Identity[key])
+	}
+	fmt.Fprintf(&sb, "panic: %v\n\n", r.Panic)
+	fmt.Fprintf(&sb, "stack:\n%s\n", r.Stack)
+	fmt.Fprintf(&sb, "recent logs:\n")
+	for _, line := range r.LogTail {
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// Recover recovers the panic of the goroutine, writes its crash report, and calls onPanic with an error
+// wrapping ErrPanicked, e.g. to mark the task failed. It must be deferred directly, e.g.
+//
+//	defer crashreport.Recover("indexnode-build", map[string]string{"buildID": "1"}, func(err error) { ... })
+//
+// The goroutine continues after the deferred calls once the panic is recovered.
+func Recover(component string, identity map[string]string, onPanic func(err error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	report := &Report{
+		Time:      time.Now(),
+		Component: component,
+		Identity:  identity,
+		Panic:     r,
+		Stack:     debug.Stack(),
+		LogTail:   log.Tail(paramtable.Get().CommonCfg.CrashReportLogTailSize.GetAsInt()),
+	}
+	path, err := write(report)
+	if err != nil {
+		log.Warn("failed to write crash report", zap.String("component", component), zap.Error(err))
+	}
+	log.Error("panic recovered", zap.String("component", component), zap.Any("identity", identity),
+		zap.Any("panic", r), zap.String("crashReport", path))
+	if onPanic != nil {
+		onPanic(errors.Wrapf(ErrPanicked, "%s: %v, crash report: %s", component, r, path))
+	}
+}
+
+// Dir returns the directory of the crash reports.
+func Dir() string {
+	params := paramtable.Get()
+	if path := params.CommonCfg.CrashReportPath.GetValue(); path != "" {
+		return path
+	}
+	return filepath.Join(params.LocalStorageCfg.Path.GetValue(), "crash_reports")
+}
+
+// writeMu serializes the writes and the removals of the reports
+var writeMu sync.Mutex
+
+// write writes the report into the directory of the crash reports, and removes the oldest reports over the limit,
+// returns the path of the report.
+func write(report *Report) (string, error) {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	dir := Dir()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%d%s", report.Component, report.Time.UnixNano(), reportSuffix)
+	path := filepath.Join(dir, name)
+	// the stack and the logs may carry the data of the requests
+	if err := os.WriteFile(path, []byte(report.String()), 0o600); err != nil {
+		return "", err
+	}
+	prune(dir, paramtable.Get().CommonCfg.CrashReportMaxReports.GetAsInt())
+	return path, nil
+}
+
+// prune removes the oldest reports in dir over maxReports.
+func prune(dir string, maxReports int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	type reportFile struct {
+		name    string
+		modTime time.Time
+	}
+	reports := make([]reportFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), reportSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		reports = append(reports, reportFile{name: entry.Name(), modTime: info.ModTime()})
+	}
+	// the latest report is always retained
+	if maxReports < 1 {
+		maxReports = 1
+	}
+	if len(reports) <= maxReports {
+		return
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].modTime.Before(reports[j].modTime)
+	})
+	for _, report := range reports[:len(reports)-maxReports] {
+		if err := os.Remove(filepath.Join(dir, report.name)); err != nil {
+			log.Warn("failed to remove crash report", zap.String("name", report.name), zap.Error(err))
+		}
+	}
+}
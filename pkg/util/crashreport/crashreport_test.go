@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crashreport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestRecover(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	dir := t.TempDir()
+	params.Save(params.CommonCfg.CrashReportPath.Key, dir)
+	defer params.Reset(params.CommonCfg.CrashReportPath.Key)
+	params.Save(params.CommonCfg.CrashReportMaxReports.Key, "2")
+	defer params.Reset(params.CommonCfg.CrashReportMaxReports.Key)
+	assert.Equal(t, dir, Dir())
+
+	run := func(fn func()) error {
+		var panicErr error
+		func() {
+			defer Recover("test", map[string]string{"buildID": "1", "clusterID": "c"}, func(err error) {
+				panicErr = err
+			})
+			fn()
+		}()
+		return panicErr
+	}
+
+	// nothing happens without panic
+	assert.NoError(t, run(func() {}))
+
+	err := run(func() { panic("build failed") })
+	assert.ErrorIs(t, err, ErrPanicked)
+	assert.Contains(t, err.Error(), "build failed")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasPrefix(entries[0].Name(), "test-"))
+	info, err := entries[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	report := string(data)
+	for _, s := range []string{"component: test", "buildID: 1", "clusterID: c", "panic: build failed", "stack:", "TestRecover", "recent logs:"} {
+		assert.Contains(t, report, s)
+	}
+
+	// the oldest reports are removed
+	for i := 0; i < 3; i++ {
+		assert.Error(t, run(func() { panic(i) }))
+	}
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// a nil callback only writes the report
+	func() {
+		defer Recover("test", nil, nil)
+		panic("no callback")
+	}()
+}
@@ -18,6 +18,7 @@ import (
 	"sync"
 
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/mem"
 	"go.uber.org/automaxprocs/maxprocs"
 	"go.uber.org/zap"
@@ -150,6 +151,15 @@ func GetDiskUsage() uint64 {
 	return 2 * 1024 * 1024
 }
 
+// GetPathDiskUsage returns the total and free bytes of the file system where the path located.
+func GetPathDiskUsage(path string) (uint64, uint64, error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	return usage.Total, usage.Free, nil
+}
+
 func GetMemoryUseRatio() float64 {
 	usedMemory := GetUsedMemoryCount()
 	totalMemory := GetMemoryCount()
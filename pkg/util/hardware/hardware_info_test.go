@@ -52,6 +52,16 @@ func Test_GetDiskUsage(t *testing.T) {
 		zap.Uint64("DiskUsage", GetDiskUsage()))
 }
 
+func Test_GetPathDiskUsage(t *testing.T) {
+	total, free, err := GetPathDiskUsage(t.TempDir())
+	assert.NoError(t, err)
+	assert.NotZero(t, total)
+	assert.LessOrEqual(t, free, total)
+
+	_, _, err = GetPathDiskUsage("/path/not/exist")
+	assert.Error(t, err)
+}
+
 func Test_GetMemoryUsageRatio(t *testing.T) {
 	log.Info("TestGetMemoryUsageRatio",
 		zap.Float64("Memory usage ratio", GetMemoryUseRatio()))
@@ -0,0 +1,33 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+// ProcessUsage is the cumulative resource usage of the current process.
+type ProcessUsage struct {
+	// CPUSeconds is the user and system cpu time consumed
+	CPUSeconds float64
+	// RSSBytes is the current resident set size
+	RSSBytes int64
+	// DiskReadBytes and DiskWriteBytes are the bytes read from and written to the storage layer,
+	// zero if the io accounting of the process is unavailable
+	DiskReadBytes  int64
+	DiskWriteBytes int64
+	// NetRecvBytes and NetSendBytes are the bytes transferred by the non-loopback interfaces of the network
+	// namespace, which is usually the container of the process
+	NetRecvBytes int64
+	NetSendBytes int64
+}
+
+// GetProcessUsage returns the resource usage of the current process.
+func GetProcessUsage() (*ProcessUsage, error) {
+	return getProcessUsage()
+}
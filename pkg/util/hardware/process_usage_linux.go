@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// clockTicksPerSecond is USER_HZ, the unit of the cpu times in /proc/self/stat
+const clockTicksPerSecond = 100
+
+// getProcessUsage returns the resource usage of the current process and error
+func getProcessUsage() (*ProcessUsage, error) {
+	usage := &ProcessUsage{}
+	var err error
+	if usage.CPUSeconds, err = readProcessCPUSeconds("/proc/self/stat"); err != nil {
+		return nil, err
+	}
+	if usage.RSSBytes, err = readProcessRSS("/proc/self/status"); err != nil {
+		return nil, err
+	}
+	// the io accounting may be unavailable in restricted containers, and the network stats are best-effort
+	usage.DiskReadBytes, usage.DiskWriteBytes, _ = readProcessIO("/proc/self/io")
+	usage.NetRecvBytes, usage.NetSendBytes, _ = readNetDev("/proc/self/net/dev")
+	return usage, nil
+}
+
+func readProcessCPUSeconds(path string) (float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	// the command name in parentheses may contain spaces, the fields after it start from the state
+	idx := bytes.LastIndexByte(data, ')')
+	if idx < 0 {
+		return 0, errors.Newf("malformed %s", path)
+	}
+	fields := strings.Fields(string(data[idx+1:]))
+	// utime and stime are the 14th and 15th fields, the state is the 3rd
+	if len(fields) < 13 {
+		return 0, errors.Newf("malformed %s", path)
+	}
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return float64(utime+stime) / clockTicksPerSecond, nil
+}
+
+func readProcessRSS(path string) (int64, error) {
+	var rss int64
+	found := false
+	err := scanLines(path, func(line string) error {
+		if !strings.HasPrefix(line, "VmRSS:") {
+			return nil
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return errors.Newf("malformed VmRSS in %s", path)
+		}
+		kb, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		rss = kb * 1024
+		found = true
+		return nil
+	})
+	if err == nil && !found {
+		err = errors.Newf("no VmRSS in %s", path)
+	}
+	return rss, err
+}
+
+func readProcessIO(path string) (int64, int64, error) {
+	var readBytes, writeBytes int64
+	err := scanLines(path, func(line string) error {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil
+		}
+		var err error
+		switch key {
+		case "read_bytes":
+			readBytes, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		case "write_bytes":
+			writeBytes, err = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		}
+		return err
+	})
+	return readBytes, writeBytes, err
+}
+
+func readNetDev(path string) (int64, int64, error) {
+	var recvBytes, sendBytes int64
+	err := scanLines(path, func(line string) error {
+		iface, stats, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(iface) == "lo" {
+			// the headers have no colon
+			return nil
+		}
+		fields := strings.Fields(stats)
+		// the received bytes is the 1st field, and the transmitted bytes is the 9th
+		if len(fields) < 9 {
+			return errors.Newf("malformed %s", path)
+		}
+		recv, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		send, err := strconv.ParseInt(fields[8], 10, 64)
+		if err != nil {
+			return err
+		}
+		recvBytes += recv
+		sendBytes += send
+		return nil
+	})
+	return recvBytes, sendBytes, err
+}
+
+func scanLines(path string, fn func(line string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if err := fn(scanner.Text()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProcessUsage(t *testing.T) {
+	usage, err := GetProcessUsage()
+	require.NoError(t, err)
+	assert.Greater(t, usage.RSSBytes, int64(0))
+	assert.GreaterOrEqual(t, usage.CPUSeconds, float64(0))
+}
+
+func TestParseProcFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	stat := write("stat", "42 (index node) S 1 42 42 0 -1 4194560 100 0 0 0 250 50 0 0 20 0 8 0 100 1000 10\n")
+	cpu, err := readProcessCPUSeconds(stat)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, cpu)
+	_, err = readProcessCPUSeconds(write("bad_stat", "42 (x) S 1"))
+	assert.Error(t, err)
+
+	status := write("status", "Name:\tmilvus\nVmPeak:\t 2048 kB\nVmRSS:\t 1024 kB\n")
+	rss, err := readProcessRSS(status)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024*1024), rss)
+	_, err = readProcessRSS(write("no_rss", "Name:\tmilvus\n"))
+	assert.Error(t, err)
+
+	io := write("io", "rchar: 100\nwchar: 200\nread_bytes: 4096\nwrite_bytes: 8192\ncancelled_write_bytes: 0\n")
+	readBytes, writeBytes, err := readProcessIO(io)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4096), readBytes)
+	assert.Equal(t, int64(8192), writeBytes)
+
+	netDev := write("dev", `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:     500       5    0    0    0     0          0         0      500       5    0    0    0     0       0          0
+  eth0:    1000      10    0    0    0     0          0         0     2000      20    0    0    0     0       0          0
+  eth1:     100       1    0    0    0     0          0         0      200       2    0    0    0     0       0          0
+`)
+	recv, send, err := readNetDev(netDev)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1100), recv)
+	assert.Equal(t, int64(2200), send)
+}
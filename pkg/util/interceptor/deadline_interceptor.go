@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// GetMethodDeadlinesFunc returns the timeout and the minimum deadline of the rpc method by its name,
+// e.g. "QueryJobs", 0 means not limited.
+type GetMethodDeadlinesFunc func(method string) (timeout, minDeadline time.Duration)
+
+// DeadlineUnaryServerInterceptor returns a new unary server interceptor that rejects the requests whose deadlines
+// are shorter than the minimum of their methods, and cancels the handlers once their methods time out,
+// so that the handlers blocked by a slow dependency don't pile up.
+// The errors are the grpc status errors carrying the merr codes, see merr.FromGRPCError.
+func DeadlineUnaryServerInterceptor(fn GetMethodDeadlinesFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		timeout, minDeadline := fn(method)
+		deadline, hasDeadline := ctx.Deadline()
+		if hasDeadline && minDeadline > 0 {
+			if remaining := time.Until(deadline); remaining < minDeadline {
+				metrics.GRPCDeadlineEnforcements.WithLabelValues(info.FullMethod, metrics.DeadlineTooShortLabel).Inc()
+				return nil, merr.GRPCError(codes.FailedPrecondition, merr.WrapErrServiceDeadlineTooShort(method, remaining, minDeadline))
+			}
+		}
+		// the deadline of the caller is kept if it's earlier
+		if timeout <= 0 || (hasDeadline && time.Until(deadline) <= timeout) {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp, err := handler(ctx, req)
+		// the result of a handler completed right at the timeout is kept
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			metrics.GRPCDeadlineEnforcements.WithLabelValues(info.FullMethod, metrics.TimeoutLabel).Inc()
+			return nil, merr.GRPCError(codes.DeadlineExceeded, merr.WrapErrServiceTimeout(method, timeout))
+		}
+		return resp, err
+	}
+}
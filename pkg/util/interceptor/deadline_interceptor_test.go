@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestDeadlineInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.index.IndexNode/QueryJobs"}
+	interceptor := DeadlineUnaryServerInterceptor(func(method string) (time.Duration, time.Duration) {
+		if method == "QueryJobs" {
+			return 50 * time.Millisecond, time.Second
+		}
+		return 0, 0
+	})
+	var handlerDeadline time.Time
+	var hasDeadline bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerDeadline, hasDeadline = ctx.Deadline()
+		return "resp", nil
+	}
+
+	t.Run("not limited", func(t *testing.T) {
+		resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.index.IndexNode/CreateJob"}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "resp", resp)
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("deadline too short", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := interceptor(ctx, nil, info, handler)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		err, ok := merr.FromGRPCError(err)
+		assert.True(t, ok)
+		assert.ErrorIs(t, err, merr.ErrServiceDeadlineTooShort)
+	})
+
+	t.Run("timeout applied", func(t *testing.T) {
+		resp, err := interceptor(context.Background(), nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "resp", resp)
+		assert.True(t, hasDeadline)
+		assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), handlerDeadline, 50*time.Millisecond)
+
+		// the earlier deadline of the caller is kept, as long as it's not shorter than the minimum
+		short := DeadlineUnaryServerInterceptor(func(string) (time.Duration, time.Duration) {
+			return time.Hour, 0
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		expected, _ := ctx.Deadline()
+		_, err = short(ctx, nil, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, handlerDeadline)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		blocked := func(ctx context.Context, req interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		_, err := interceptor(context.Background(), nil, info, blocked)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		err, ok := merr.FromGRPCError(err)
+		assert.True(t, ok)
+		assert.ErrorIs(t, err, merr.ErrServiceTimeout)
+
+		// the result of the handler completed after the timeout is kept
+		slow := func(ctx context.Context, req interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return "resp", nil
+		}
+		resp, err := interceptor(context.Background(), nil, info, slow)
+		assert.NoError(t, err)
+		assert.Equal(t, "resp", resp)
+	})
+}
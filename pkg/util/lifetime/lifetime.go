@@ -79,6 +79,20 @@ func (l *lifetime[T]) GetState() T {
 	return l.state
 }
 
+// update changes the state to the one returned by fn under the lock of state,
+// the state is kept if fn returns an error.
+func (l *lifetime[T]) update(fn func(from T) (T, error)) error {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	to, err := fn(l.state)
+	if err != nil {
+		return err
+	}
+	l.state = to
+	return nil
+}
+
 // Add records a task is running, returns false if the lifetime is not healthy.
 func (l *lifetime[T]) Add(isHealthy IsHealthy[T]) bool {
 	l.mut.RLock()
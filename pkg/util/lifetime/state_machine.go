@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifetime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// State is the state of a component in its lifetime.
+type State int32
+
+const (
+	// Stopped is the state of a component not running, i.e. created but not initialized yet, or stopped.
+	Stopped State = iota
+	// Initializing is the state of a component being initialized and started.
+	Initializing
+	// Healthy is the state of a component serving all the requests.
+	Healthy
+	// Suspended is the state of a component not accepting new tasks, but still serving the existing ones,
+	// e.g. under maintenance.
+	Suspended
+	// Stopping is the state of a component draining the existing tasks before stopped.
+	Stopping
+)
+
+var stateNames = map[State]string{
+	Stopped:      "Stopped",
+	Initializing: "Initializing",
+	Healthy:      "Healthy",
+	Suspended:    "Suspended",
+	Stopping:     "Stopping",
+}
+
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", s)
+}
+
+// transitions are the valid transitions from each state,
+// Stopped -> Initializing -> Healthy <-> Suspended -> Stopping -> Stopped.
+var transitions = map[State][]State{
+	Stopped:      {Initializing},
+	Initializing: {Healthy, Stopping},
+	Healthy:      {Suspended, Stopping},
+	Suspended:    {Healthy, Stopping},
+	Stopping:     {Stopped},
+}
+
+// CanTransit returns whether the transition from one state to another is valid.
+func CanTransit(from, to State) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsTasks returns whether the state accepts the requests creating new tasks, only Healthy does.
+func AcceptsTasks(s State) bool {
+	return s == Healthy
+}
+
+// AcceptsRequests returns whether the state accepts the requests on the existing tasks, e.g. querying or dropping them,
+// which are served until the component is stopped.
+func AcceptsRequests(s State) bool {
+	return s == Healthy || s == Suspended || s == Stopping
+}
+
+// TransitionHook is called on the transition from one state to another.
+type TransitionHook func(from, to State)
+
+// StateMachine is the lifetime of a component, which moves through the states by the valid transitions,
+// calls the hooks registered on entering and exiting the states, and records the time in the states.
+// The tasks are recorded by the lifetime as usual, and SetState is a transition whose error is logged.
+type StateMachine struct {
+	*lifetime[State]
+	role string
+
+	// transitMu serializes the transitions with their hooks
+	transitMu sync.Mutex
+	enteredAt atomic.Time
+
+	hookMu     sync.RWMutex
+	enterHooks map[State][]TransitionHook
+	exitHooks  map[State][]TransitionHook
+}
+
+var _ Lifetime[State] = (*StateMachine)(nil)
+
+// NewStateMachine creates a stopped state machine of the component of role.
+func NewStateMachine(role string) *StateMachine {
+	sm := &StateMachine{
+		lifetime:   NewLifetime(Stopped).(*lifetime[State]),
+		role:       role,
+		enterHooks: make(map[State][]TransitionHook),
+		exitHooks:  make(map[State][]TransitionHook),
+	}
+	now := time.Now()
+	sm.enteredAt.Store(now)
+	metrics.ComponentStateSince.WithLabelValues(role, Stopped.String()).Set(float64(now.Unix()))
+	return sm
+}
+
+// OnEnter registers the hook called on entering the state.
+func (sm *StateMachine) OnEnter(state State, hook TransitionHook) {
+	sm.hookMu.Lock()
+	defer sm.hookMu.Unlock()
+	sm.enterHooks[state] = append(sm.enterHooks[state], hook)
+}
+
+// OnExit registers the hook called on exiting the state.
+func (sm *StateMachine) OnExit(state State, hook TransitionHook) {
+	sm.hookMu.Lock()
+	defer sm.hookMu.Unlock()
+	sm.exitHooks[state] = append(sm.exitHooks[state], hook)
+}
+
+// TimeInState returns how long the state machine has been in current state.
+func (sm *StateMachine) TimeInState() time.Duration {
+	return time.Since(sm.enteredAt.Load())
+}
+
+// SetState transits the state machine to the state, the invalid transition is logged and ignored.
+func (sm *StateMachine) SetState(state State) {
+	if err := sm.Transit(state); err != nil {
+		log.Warn("lifetime transition ignored", zap.String("role", sm.role), zap.Error(err))
+	}
+}
+
+// Transit moves the state machine to the state, the exit hooks of current state and then the enter hooks
+// of the new state are called after the state is changed. Transiting to current state does nothing,
+// and an invalid transition returns an error. The hooks must not call Transit.
+func (sm *StateMachine) Transit(to State) error {
+	sm.transitMu.Lock()
+	defer sm.transitMu.Unlock()
+
+	var from State
+	changed := false
+	err := sm.update(func(state State) (State, error) {
+		from = state
+		if from == to {
+			return from, nil
+		}
+		if !CanTransit(from, to) {
+			return from, fmt.Errorf("invalid lifetime transition of %s from %s to %s", sm.role, from, to)
+		}
+		changed = true
+		return to, nil
+	})
+	if err != nil || !changed {
+		return err
+	}
+	now := time.Now()
+	metrics.ComponentStateDuration.WithLabelValues(sm.role, from.String()).Observe(now.Sub(sm.enteredAt.Load()).Seconds())
+	metrics.ComponentStateSince.DeleteLabelValues(sm.role, from.String())
+	metrics.ComponentStateSince.WithLabelValues(sm.role, to.String()).Set(float64(now.Unix()))
+	sm.enteredAt.Store(now)
+
+	sm.hookMu.RLock()
+	hooks := make([]TransitionHook, 0, len(sm.exitHooks[from])+len(sm.enterHooks[to]))
+	hooks = append(hooks, sm.exitHooks[from]...)
+	hooks = append(hooks, sm.enterHooks[to]...)
+	sm.hookMu.RUnlock()
+	for _, hook := range hooks {
+		hook(from, to)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifetime
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+type StateMachineSuite struct {
+	suite.Suite
+}
+
+func (s *StateMachineSuite) TestTransit() {
+	sm := NewStateMachine("test_transit")
+	s.Equal(Stopped, sm.GetState())
+
+	var transitions []string
+	record := func(from, to State) {
+		transitions = append(transitions, from.String()+"->"+to.String())
+	}
+	sm.OnExit(Healthy, record)
+	sm.OnEnter(Suspended, record)
+	sm.OnEnter(Healthy, func(from, to State) {
+		// the state is changed before the hooks are called
+		s.Equal(Healthy, sm.GetState())
+	})
+
+	s.Error(sm.Transit(Healthy))
+	s.Equal(Stopped, sm.GetState())
+	s.NoError(sm.Transit(Initializing))
+	s.NoError(sm.Transit(Initializing))
+	s.NoError(sm.Transit(Healthy))
+	s.NoError(sm.Transit(Suspended))
+	s.NoError(sm.Transit(Healthy))
+	s.Equal([]string{"Healthy->Suspended", "Healthy->Suspended"}, transitions)
+
+	s.Error(sm.Transit(Stopped))
+	s.NoError(sm.Transit(Stopping))
+	s.NoError(sm.Transit(Stopped))
+	s.Equal("State(100)", State(100).String())
+
+	// the time in the states is observed on leaving them
+	m := &dto.Metric{}
+	s.NoError(metrics.ComponentStateDuration.WithLabelValues("test_transit", Healthy.String()).(prometheus.Histogram).Write(m))
+	s.EqualValues(2, m.GetHistogram().GetSampleCount())
+	s.Greater(testutil.ToFloat64(metrics.ComponentStateSince.WithLabelValues("test_transit", Stopped.String())), float64(0))
+}
+
+func (s *StateMachineSuite) TestAdd() {
+	sm := NewStateMachine("test_add")
+	s.False(sm.Add(AcceptsRequests))
+
+	s.NoError(sm.Transit(Initializing))
+	s.NoError(sm.Transit(Healthy))
+	s.True(sm.Add(AcceptsTasks))
+	s.NoError(sm.Transit(Suspended))
+	s.False(sm.Add(AcceptsTasks))
+	s.True(sm.Add(AcceptsRequests))
+	s.NoError(sm.Transit(Stopping))
+	s.False(sm.Add(AcceptsTasks))
+	s.True(sm.Add(AcceptsRequests))
+	s.NoError(sm.Transit(Stopped))
+	s.False(sm.Add(AcceptsRequests))
+
+	sm.Done()
+	sm.Done()
+	sm.Done()
+	sm.Wait()
+}
+
+func (s *StateMachineSuite) TestSetState() {
+	var l Lifetime[State] = NewStateMachine("test_set_state")
+
+	// the invalid transition is ignored
+	l.SetState(Healthy)
+	s.Equal(Stopped, l.GetState())
+	l.SetState(Initializing)
+	l.SetState(Healthy)
+	s.Equal(Healthy, l.GetState())
+}
+
+func TestStateMachine(t *testing.T) {
+	suite.Run(t, new(StateMachineSuite))
+}
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merr
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Fault is the domain an error is caused in, tells whether the error is fixed by the caller or by Milvus.
+type Fault int
+
+const (
+	// FaultUnknown is the domain of nil and the errors not classified, e.g. the ones not defined by merr
+	FaultUnknown Fault = iota
+	// FaultUser is the domain of the errors caused by the request, retrying the same request never succeeds
+	// unless the request or the data it refers to is changed, e.g. a collection not found or an invalid parameter
+	FaultUser
+	// FaultSystem is the domain of the errors caused by Milvus or its dependencies, e.g. a node offline or an IO failure
+	FaultSystem
+)
+
+func (f Fault) String() string {
+	switch f {
+	case FaultUser:
+		return "user"
+	case FaultSystem:
+		return "system"
+	default:
+		return "unknown"
+	}
+}
+
+type classification struct {
+	fault Fault
+	// transient marks the errors not retriable by the code, but may disappear by retrying later,
+	// e.g. an IO failure, the code is kept for compatibility
+	transient bool
+}
+
+// baseCode returns the code of e without the retryable flag
+func baseCode(e milvusError) int32 {
+	return e.code() &^ retryableFlag
+}
+
+// classifications are keyed by the codes without the retryable flag
+var classifications = map[int32]classification{
+	baseCode(ErrServiceNotReady):             {fault: FaultSystem},
+	baseCode(ErrServiceUnavailable):          {fault: FaultSystem},
+	baseCode(ErrServiceMemoryLimitExceeded):  {fault: FaultSystem, transient: true},
+	baseCode(ErrServiceRequestLimitExceeded): {fault: FaultUser},
+	baseCode(ErrServiceInternal):             {fault: FaultSystem},
+	baseCode(ErrServiceCrossClusterRouting):  {fault: FaultSystem},
+	baseCode(ErrServiceDiskLimitExceeded):    {fault: FaultSystem}, // the disk isn't released by retrying on the same node
+	baseCode(ErrServiceRateLimit):            {fault: FaultUser},
+	baseCode(ErrServiceForceDeny):            {fault: FaultUser},
+	baseCode(ErrServiceDeadlineTooShort):     {fault: FaultUser},
+	baseCode(ErrServiceTimeout):              {fault: FaultSystem},
+
+	baseCode(ErrCollectionNotFound):         {fault: FaultUser},
+	baseCode(ErrCollectionNotLoaded):        {fault: FaultUser},
+	baseCode(ErrCollectionNumLimitExceeded): {fault: FaultUser},
+	baseCode(ErrCollectionNotFullyLoaded):   {fault: FaultSystem},
+
+	baseCode(ErrPartitionNotFound):       {fault: FaultUser},
+	baseCode(ErrPartitionNotLoaded):      {fault: FaultUser},
+	baseCode(ErrPartitionNotFullyLoaded): {fault: FaultSystem},
+
+	baseCode(ErrResourceGroupNotFound): {fault: FaultUser},
+
+	baseCode(ErrReplicaNotFound):     {fault: FaultSystem},
+	baseCode(ErrReplicaNotAvailable): {fault: FaultSystem, transient: true},
+
+	baseCode(ErrChannelNotFound):     {fault: FaultSystem},
+	baseCode(ErrChannelLack):         {fault: FaultSystem},
+	baseCode(ErrChannelReduplicate):  {fault: FaultSystem},
+	baseCode(ErrChannelNotAvailable): {fault: FaultSystem, transient: true},
+
+	baseCode(ErrSegmentNotFound):    {fault: FaultSystem},
+	baseCode(ErrSegmentNotLoaded):   {fault: FaultSystem},
+	baseCode(ErrSegmentLack):        {fault: FaultSystem},
+	baseCode(ErrSegmentReduplicate): {fault: FaultSystem},
+
+	baseCode(ErrIndexNotFound): {fault: FaultUser},
+
+	baseCode(ErrDatabaseNotFound):         {fault: FaultUser},
+	baseCode(ErrDatabaseNumLimitExceeded): {fault: FaultUser},
+	baseCode(ErrDatabaseInvalidName):      {fault: FaultUser},
+
+	baseCode(ErrNodeNotFound):     {fault: FaultSystem},
+	baseCode(ErrNodeOffline):      {fault: FaultSystem, transient: true},
+	baseCode(ErrNodeLack):         {fault: FaultSystem},
+	baseCode(ErrNodeNotMatch):     {fault: FaultSystem},
+	baseCode(ErrNodeNotAvailable): {fault: FaultSystem, transient: true},
+
+	baseCode(ErrIoKeyNotFound): {fault: FaultSystem},
+	baseCode(ErrIoFailed):      {fault: FaultSystem, transient: true},
+
+	baseCode(ErrParameterInvalid): {fault: FaultUser},
+
+	baseCode(ErrMetricNotFound): {fault: FaultUser},
+
+	baseCode(ErrMqTopicNotFound): {fault: FaultUser},
+	baseCode(ErrMqTopicNotEmpty): {fault: FaultUser},
+	baseCode(ErrMqInternal):      {fault: FaultSystem},
+
+	baseCode(ErrFieldNotFound): {fault: FaultUser},
+
+	baseCode(ErrNeedAuthenticate):          {fault: FaultUser},
+	baseCode(ErrIncorrectParameterFormat):  {fault: FaultUser},
+	baseCode(ErrMissingRequiredParameters): {fault: FaultUser},
+	baseCode(ErrMarshalCollectionSchema):   {fault: FaultUser},
+	baseCode(ErrInvalidInsertData):         {fault: FaultUser},
+	baseCode(ErrInvalidSearchResult):       {fault: FaultSystem},
+	baseCode(ErrCheckPrimaryKey):           {fault: FaultUser},
+
+	baseCode(ErrSegcore): {fault: FaultSystem},
+}
+
+func classify(err error) (classification, bool) {
+	cause, ok := errors.Cause(err).(milvusError)
+	if !ok {
+		return classification{}, false
+	}
+	c, ok := classifications[baseCode(cause)]
+	return c, ok
+}
+
+// IsRetriable returns whether the request failed with err may succeed by retrying it later,
+// i.e. err is retryable by its code, transient, or a timeout.
+// Use this instead of matching the messages or the reasons of the status.
+func IsRetriable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsRetryableErr(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	c, ok := classify(err)
+	return ok && c.transient
+}
+
+// FaultDomain returns the domain err is caused in, a timeout is a system fault,
+// and a cancellation or an error not defined by merr is unknown.
+func FaultDomain(err error) Fault {
+	if err == nil {
+		return FaultUnknown
+	}
+	if c, ok := classify(err); ok {
+		return c.fault
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return FaultSystem
+	}
+	return FaultUnknown
+}
@@ -42,6 +42,8 @@ var (
 	ErrServiceDiskLimitExceeded    = newMilvusError("disk limit exceeded", 7, false)
 	ErrServiceRateLimit            = newMilvusError("rate limit exceeded", 8, true)
 	ErrServiceForceDeny            = newMilvusError("force deny", 9, false)
+	ErrServiceDeadlineTooShort     = newMilvusError("deadline too short", 10, false)
+	ErrServiceTimeout              = newMilvusError("service timeout", 11, true)
 
 	// Collection related
 	ErrCollectionNotFound         = newMilvusError("collection not found", 100, false)
@@ -19,9 +19,12 @@ package merr
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -68,6 +71,45 @@ func (s *ErrSuite) TestStatusWithCode() {
 	s.Equal(int32(0), StatusWithErrorCode(nil, commonpb.ErrorCode_CollectionNotExists).Code)
 }
 
+func (s *ErrSuite) TestGRPCError() {
+	err := GRPCError(codes.DeadlineExceeded, WrapErrServiceTimeout("QueryJobs", time.Second))
+	s.Equal(codes.DeadlineExceeded, grpcstatus.Code(err))
+	merr, ok := FromGRPCError(err)
+	s.True(ok)
+	s.ErrorIs(merr, ErrServiceTimeout)
+	s.True(IsRetryableErr(merr))
+
+	_, ok = FromGRPCError(grpcstatus.Error(codes.Unavailable, "unavailable"))
+	s.False(ok)
+	_, ok = FromGRPCError(errors.New("not grpc"))
+	s.False(ok)
+}
+
+func (s *ErrSuite) TestClassification() {
+	s.False(IsRetriable(nil))
+	s.True(IsRetriable(WrapErrServiceUnavailable("test")))
+	s.True(IsRetriable(WrapErrIoFailed("key", "read failed")))
+	s.True(IsRetriable(errors.Wrap(context.DeadlineExceeded, "load")))
+	s.True(IsRetriable(Error(Status(WrapErrServiceNotReady("init")))))
+	s.False(IsRetriable(WrapErrCollectionNotFound(1)))
+	s.False(IsRetriable(WrapErrServiceDiskLimitExceeded(110, 100)))
+	s.False(IsRetriable(context.Canceled))
+	s.False(IsRetriable(errors.New("unknown")))
+
+	s.Equal(FaultUnknown, FaultDomain(nil))
+	s.Equal(FaultUser, FaultDomain(WrapErrParameterInvalidMsg("invalid")))
+	s.Equal(FaultUser, FaultDomain(errors.Wrap(WrapErrCollectionNotFound(1), "failed to get collection")))
+	s.Equal(FaultUser, FaultDomain(WrapErrServiceRateLimit(1)))
+	s.Equal(FaultUser, FaultDomain(Error(Status(WrapErrMqTopicNotFound("topic")))))
+	s.Equal(FaultSystem, FaultDomain(WrapErrNodeOffline(1)))
+	s.Equal(FaultSystem, FaultDomain(WrapErrServiceTimeout("method", time.Second)))
+	s.Equal(FaultSystem, FaultDomain(context.DeadlineExceeded))
+	s.Equal(FaultUnknown, FaultDomain(context.Canceled))
+	s.Equal(FaultUnknown, FaultDomain(errors.New("unknown")))
+	s.Equal(FaultUnknown, FaultDomain(errUnexpected))
+	s.Equal("user", FaultUser.String())
+}
+
 func (s *ErrSuite) TestWrap() {
 	// Service related
 	s.ErrorIs(WrapErrServiceNotReady("init", "test init..."), ErrServiceNotReady)
@@ -77,6 +119,8 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrServiceInternal("never throw out"), ErrServiceInternal)
 	s.ErrorIs(WrapErrServiceCrossClusterRouting("ins-0", "ins-1"), ErrServiceCrossClusterRouting)
 	s.ErrorIs(WrapErrServiceDiskLimitExceeded(110, 100, "DLE"), ErrServiceDiskLimitExceeded)
+	s.ErrorIs(WrapErrServiceDeadlineTooShort("QueryJobs", time.Millisecond, time.Second), ErrServiceDeadlineTooShort)
+	s.ErrorIs(WrapErrServiceTimeout("QueryJobs", time.Second), ErrServiceTimeout)
 	s.ErrorIs(WrapErrNodeNotMatch(0, 1, "SIM"), ErrNodeNotMatch)
 
 	// Collection related
@@ -20,8 +20,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -181,6 +184,30 @@ func Error(status *commonpb.Status) error {
 	return newMilvusError(status.GetReason(), code, code&retryableFlag != 0)
 }
 
+// GRPCError returns the grpc status error of err carrying the status of err in the details,
+// so that the code of err survives the rpc, e.g. returned by the server interceptors, see FromGRPCError.
+func GRPCError(code codes.Code, err error) error {
+	st, detailErr := grpcstatus.New(code, err.Error()).WithDetails(Status(err))
+	if detailErr != nil {
+		return grpcstatus.Error(code, err.Error())
+	}
+	return st.Err()
+}
+
+// FromGRPCError returns the error of the status carried by the grpc status error err, see GRPCError.
+func FromGRPCError(err error) (error, bool) {
+	st, ok := grpcstatus.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if status, ok := detail.(*commonpb.Status); ok {
+			return Error(status), true
+		}
+	}
+	return nil, false
+}
+
 // CheckHealthy checks whether the state is healthy,
 // returns nil if healthy,
 // otherwise returns ErrServiceNotReady wrapped with current state
@@ -266,6 +293,16 @@ func WrapErrServiceForceDeny(op string, reason error, method string) error {
 	return err
 }
 
+func WrapErrServiceDeadlineTooShort(method string, remaining, minimum time.Duration) error {
+	err := errors.Wrapf(ErrServiceDeadlineTooShort, "method=%s, remaining=%s, minimum=%s", method, remaining, minimum)
+	return err
+}
+
+func WrapErrServiceTimeout(method string, timeout time.Duration) error {
+	err := errors.Wrapf(ErrServiceTimeout, "method=%s, timeout=%s", method, timeout)
+	return err
+}
+
 // database related
 func WrapErrDatabaseNotFound(database any, msg ...string) error {
 	err := wrapWithField(ErrDatabaseNotFound, "database", database)
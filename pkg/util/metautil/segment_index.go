@@ -2,11 +2,15 @@ package metautil
 
 import (
 	"path"
+	"strings"
 
 	"github.com/milvus-io/milvus/pkg/common"
 )
 
 func BuildSegmentIndexFilePath(rootPath string, buildID, indexVersion, partID, segID int64, fileKey string) string {
+	if IsContentAddressedIndexFileKey(fileKey) {
+		return BuildContentAddressedIndexFilePath(rootPath, fileKey)
+	}
 	k := JoinIDPath(buildID, indexVersion, partID, segID)
 	return path.Join(rootPath, common.SegmentIndexPath, k, fileKey)
 }
@@ -19,3 +23,33 @@ func BuildSegmentIndexFilePaths(rootPath string, buildID, indexVersion, partID,
 	}
 	return paths
 }
+
+// BuildSegmentIndexManifestPath returns the path of the manifest of a build with content-addressed index files.
+func BuildSegmentIndexManifestPath(rootPath string, buildID, indexVersion, partID, segID int64) string {
+	k := JoinIDPath(buildID, indexVersion, partID, segID)
+	return path.Join(rootPath, common.SegmentIndexPath, k, common.SegmentIndexManifestKey)
+}
+
+// BuildSegmentIndexUploadManifestPath returns the path of the manifest declaring the uploaded index files of a build.
+func BuildSegmentIndexUploadManifestPath(rootPath string, buildID, indexVersion, partID, segID int64) string {
+	k := JoinIDPath(buildID, indexVersion, partID, segID)
+	return path.Join(rootPath, common.SegmentIndexPath, k, common.SegmentIndexUploadManifestKey)
+}
+
+// BuildContentAddressedIndexFileKey returns the file key of the index file named fileName with content hash,
+// the file name is kept as the last element so that the index engine still recognizes the file.
+func BuildContentAddressedIndexFileKey(hash string, fileName string) string {
+	return path.Join(hash, fileName)
+}
+
+// BuildContentAddressedIndexFilePath returns the path of the content-addressed index file, which is shared by
+// all the builds producing the same content.
+func BuildContentAddressedIndexFilePath(rootPath string, fileKey string) string {
+	return path.Join(rootPath, common.SegmentIndexCASPath, fileKey)
+}
+
+// IsContentAddressedIndexFileKey returns whether fileKey is a content-addressed one, the plain file keys are
+// the bare file names.
+func IsContentAddressedIndexFileKey(fileKey string) bool {
+	return strings.Contains(fileKey, "/")
+}
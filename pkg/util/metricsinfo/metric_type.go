@@ -27,6 +27,29 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// IndexOrphanFiles means users request for the index files not used by any build, which are deletable.
+	IndexOrphanFiles = "index_orphan_files"
+
+	// SlowLogMetrics means users request for the latest slow operations recorded by the node.
+	SlowLogMetrics = "slow_log"
+
+	// GoroutineSnapshotMetrics means users request for the compressed goroutine dump of the node,
+	// the goroutines can be filtered by the build id in SnapshotBuildIDKey of the request.
+	GoroutineSnapshotMetrics = "goroutine_snapshot"
+
+	// HeapSnapshotMetrics means users request for the compressed heap profile summary of the node.
+	HeapSnapshotMetrics = "heap_snapshot"
+
+	// SnapshotBuildIDKey is the key of the build id in the request of GoroutineSnapshotMetrics.
+	SnapshotBuildIDKey = "build_id"
+
+	// ConfigChangesMetrics means users request for the latest config changes of the node, from the oldest to the newest,
+	// the changes can be filtered by the key prefix in ConfigKeyPrefixKey of the request.
+	ConfigChangesMetrics = "config_changes"
+
+	// ConfigKeyPrefixKey is the key of the config key prefix in the request of ConfigChangesMetrics.
+	ConfigKeyPrefixKey = "key_prefix"
 )
 
 // ParseMetricType returns the metric type of req
@@ -43,6 +66,16 @@ func ParseMetricType(req string) (string, error) {
 	return metricType.(string), nil
 }
 
+// ParseMetricParam returns the string param of key in req, empty if it's absent or not a string
+func ParseMetricParam(req string, key string) string {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(req), &m); err != nil {
+		return ""
+	}
+	value, _ := m[key].(string)
+	return value
+}
+
 // ConstructRequestByMetricType constructs a request according to the metric type
 func ConstructRequestByMetricType(metricType string) (*milvuspb.GetMetricsRequest, error) {
 	m := make(map[string]interface{})
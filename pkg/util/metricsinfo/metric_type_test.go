@@ -77,3 +77,13 @@ func Test_ConstructRequestByMetricType(t *testing.T) {
 		}
 	}
 }
+
+func Test_ParseMetricParam(t *testing.T) {
+	req, err := ConstructRequestByMetricType(GoroutineSnapshotMetrics)
+	assert.NoError(t, err)
+	assert.Equal(t, "", ParseMetricParam(req.GetRequest(), SnapshotBuildIDKey))
+
+	assert.Equal(t, "10", ParseMetricParam(`{"metric_type": "goroutine_snapshot", "build_id": "10"}`, SnapshotBuildIDKey))
+	assert.Equal(t, "", ParseMetricParam(`{"build_id": 10}`, SnapshotBuildIDKey))
+	assert.Equal(t, "", ParseMetricParam("not in json format", SnapshotBuildIDKey))
+}
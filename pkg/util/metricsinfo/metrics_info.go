@@ -99,6 +99,7 @@ type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
 	QuotaMetrics         *QueryNodeQuotaMetrics `json:"quota_metrics"`
+	ConsumerMetrics      []ConsumerMetrics      `json:"consumer_metrics"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -160,6 +161,7 @@ type DataNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations DataNodeConfiguration `json:"system_configurations"`
 	QuotaMetrics         *DataNodeQuotaMetrics `json:"quota_metrics"`
+	ConsumerMetrics      []ConsumerMetrics     `json:"consumer_metrics"`
 }
 
 // DataCoordConfiguration records the configuration of DataCoord.
@@ -184,3 +186,39 @@ type RootCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations RootCoordConfiguration `json:"system_configurations"`
 }
+
+// ConsumerMetrics records the statistics of a msgstream consumer.
+type ConsumerMetrics struct {
+	Channel          string `json:"channel"`
+	Subscription     string `json:"subscription"`
+	Received         int64  `json:"received"`
+	Acked            int64  `json:"acked"`
+	Pending          int    `json:"pending"`
+	LastDispatchTime string `json:"last_dispatch_time"`
+}
+
+// IndexOrphanFilesInfos records the index files not used by any build, found by DataCoord.
+type IndexOrphanFilesInfos struct {
+	Files []string `json:"files"`
+}
+
+// ProfileSnapshot records a goroutine dump or heap profile summary of a node, the profile is the gzip compressed
+// text of debug level 1, which is base64 encoded in json.
+type ProfileSnapshot struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	CreatedTime string `json:"created_time"`
+	Profile     []byte `json:"profile,omitempty"`
+	RawSize     int    `json:"raw_size"`
+	Goroutines  int    `json:"goroutines,omitempty"`
+	HeapAlloc   uint64 `json:"heap_alloc,omitempty"`
+	HeapInuse   uint64 `json:"heap_inuse,omitempty"`
+	HeapObjects uint64 `json:"heap_objects,omitempty"`
+	NumGC       uint32 `json:"num_gc,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ProfileSnapshots records the snapshots collected from the nodes by a coordinator.
+type ProfileSnapshots struct {
+	Snapshots []ProfileSnapshot `json:"snapshots"`
+}
@@ -55,7 +55,7 @@ const (
 
 // Const of Global Config List
 func globalConfigPrefixs() []string {
-	return []string{"metastore", "localStorage", "etcd", "tikv", "minio", "pulsar", "kafka", "rocksmq", "pebblemq", "log", "grpc", "common", "quotaAndLimits"}
+	return []string{"metastore", "localStorage", "etcd", "tikv", "minio", "hdfs", "pulsar", "kafka", "rocksmq", "pebblemq", "log", "grpc", "common", "quotaAndLimits", "featureFlags"}
 }
 
 var defaultYaml = []string{"milvus.yaml"}
@@ -143,6 +143,7 @@ func (bt *BaseTable) init() {
 		}
 	}
 	bt.initConfigsFromLocal()
+	bt.initConfigsFromPreset()
 	if !bt.config.skipRemote {
 		bt.initConfigsFromRemote()
 	}
@@ -226,6 +227,12 @@ func (bt *BaseTable) UpdateSourceOptions(opts ...config.Option) {
 	bt.mgr.UpdateSourceOptions(opts...)
 }
 
+// ConfigChanges returns the latest changes of the configs with the key prefix from the oldest to the newest,
+// all the kept changes are returned if the prefix is empty.
+func (bt *BaseTable) ConfigChanges(prefix string) []config.ChangeRecord {
+	return bt.mgr.ConfigChanges(prefix)
+}
+
 // Load loads an object with @key.
 func (bt *BaseTable) Load(key string) (string, error) {
 	return bt.mgr.GetConfig(key)
@@ -59,6 +59,8 @@ type ComponentParam struct {
 	QuotaConfig     quotaConfig
 	AutoIndexConfig autoIndexConfig
 	TraceCfg        traceConfig
+	MetricsCfg      metricsConfig
+	FeatureFlagCfg  featureFlagConfig
 
 	RootCoordCfg  rootCoordConfig
 	ProxyCfg      proxyConfig
@@ -106,6 +108,8 @@ func (p *ComponentParam) init(bt *BaseTable) {
 	p.QuotaConfig.init(bt)
 	p.AutoIndexConfig.init(bt)
 	p.TraceCfg.init(bt)
+	p.MetricsCfg.init(bt)
+	p.FeatureFlagCfg.init(bt)
 
 	p.RootCoordCfg.init(bt)
 	p.ProxyCfg.init(bt)
@@ -154,6 +158,17 @@ func (p *ComponentParam) WatchKeyPrefix(keyPrefix string, watcher config.EventHa
 	p.baseTable.mgr.Dispatcher.RegisterForKeyPrefix(keyPrefix, watcher)
 }
 
+// Unwatch unregisters the watcher of the key by its identifier.
+func (p *ComponentParam) Unwatch(key string, watcher config.EventHandler) {
+	p.baseTable.mgr.Dispatcher.Unregister(key, watcher)
+}
+
+// ReloadFiles re-reads the yaml files, the changes are applied to the refreshable params via the watchers,
+// and the events of the changes are returned.
+func (p *ComponentParam) ReloadFiles() ([]*config.Event, error) {
+	return p.baseTable.mgr.ReloadFiles()
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -216,6 +231,37 @@ type commonConfig struct {
 	EnableLockMetrics        ParamItem `refreshable:"false"`
 	LockSlowLogInfoThreshold ParamItem `refreshable:"true"`
 	LockSlowLogWarnThreshold ParamItem `refreshable:"true"`
+
+	// slow log related params
+	SlowLogEnabled             ParamItem `refreshable:"true"`
+	SlowLogFilePath            ParamItem `refreshable:"true"`
+	SlowLogMaxSize             ParamItem `refreshable:"false"`
+	SlowLogMaxBackups          ParamItem `refreshable:"false"`
+	SlowLogTailSize            ParamItem `refreshable:"false"`
+	SlowLogRPCThreshold        ParamItem `refreshable:"true"`
+	SlowLogBuildPhaseThreshold ParamItem `refreshable:"true"`
+	SlowLogProduceThreshold    ParamItem `refreshable:"true"`
+	SlowLogKVThreshold         ParamItem `refreshable:"true"`
+
+	// health report related params
+	HealthReportEnabled     ParamItem `refreshable:"false"`
+	HealthReportInterval    ParamItem `refreshable:"false"`
+	HealthReportMaxInterval ParamItem `refreshable:"true"`
+	HealthReportTTL         ParamItem `refreshable:"true"`
+	HealthReportScoreDelta  ParamItem `refreshable:"true"`
+
+	// stuck watchdog related params
+	StuckWatchdogEnabled     ParamItem `refreshable:"false"`
+	StuckWatchdogInterval    ParamItem `refreshable:"false"`
+	StuckWatchdogFactor      ParamItem `refreshable:"true"`
+	StuckWatchdogMinDuration ParamItem `refreshable:"true"`
+
+	// crash report related params
+	CrashReportPath        ParamItem `refreshable:"true"`
+	CrashReportMaxReports  ParamItem `refreshable:"true"`
+	CrashReportLogTailSize ParamItem `refreshable:"true"`
+
+	DeploymentPreset ParamItem `refreshable:"false"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -468,7 +514,7 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 		Key:          "common.storageType",
 		Version:      "2.0.0",
 		DefaultValue: "minio",
-		Doc:          "please adjust in embedded Milvus: local",
+		Doc:          "please adjust in embedded Milvus: local, use hdfs to store data in HDFS",
 		Export:       true,
 	}
 	p.StorageType.Init(base.mgr)
@@ -612,13 +658,220 @@ like the old password verification when updating the credential`,
 		Export:       true,
 	}
 	p.LockSlowLogWarnThreshold.Init(base.mgr)
+
+	p.SlowLogEnabled = ParamItem{
+		Key:          "common.slowLog.enable",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether to record the operations slower than the thresholds in the slow log",
+		Export:       true,
+	}
+	p.SlowLogEnabled.Init(base.mgr)
+
+	p.SlowLogFilePath = ParamItem{
+		Key:          "common.slowLog.filePath",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "file to write the slow log as json lines, empty means the slow log is only kept in memory",
+		Export:       true,
+	}
+	p.SlowLogFilePath.Init(base.mgr)
+
+	p.SlowLogMaxSize = ParamItem{
+		Key:          "common.slowLog.maxSize",
+		Version:      "2.3.3",
+		DefaultValue: "100",
+		Doc:          "maximum size in MB of the slow log file before it gets rotated",
+		Export:       true,
+	}
+	p.SlowLogMaxSize.Init(base.mgr)
+
+	p.SlowLogMaxBackups = ParamItem{
+		Key:          "common.slowLog.maxBackups",
+		Version:      "2.3.3",
+		DefaultValue: "5",
+		Doc:          "maximum number of the rotated slow log files to retain",
+		Export:       true,
+	}
+	p.SlowLogMaxBackups.Init(base.mgr)
+
+	p.SlowLogTailSize = ParamItem{
+		Key:          "common.slowLog.tailSize",
+		Version:      "2.3.3",
+		DefaultValue: "256",
+		Doc:          "number of the latest slow log entries kept in memory and returned by GetMetrics",
+		Export:       true,
+	}
+	p.SlowLogTailSize.Init(base.mgr)
+
+	p.SlowLogRPCThreshold = ParamItem{
+		Key:          "common.slowLog.threshold.rpc",
+		Version:      "2.3.3",
+		DefaultValue: "1000",
+		Doc:          "minimum milliseconds for recording a rpc in the slow log, 0 disables it",
+		Export:       true,
+	}
+	p.SlowLogRPCThreshold.Init(base.mgr)
+
+	p.SlowLogBuildPhaseThreshold = ParamItem{
+		Key:          "common.slowLog.threshold.buildPhase",
+		Version:      "2.3.3",
+		DefaultValue: "60000",
+		Doc:          "minimum milliseconds for recording an index build phase in the slow log, 0 disables it",
+		Export:       true,
+	}
+	p.SlowLogBuildPhaseThreshold.Init(base.mgr)
+
+	p.SlowLogProduceThreshold = ParamItem{
+		Key:          "common.slowLog.threshold.produce",
+		Version:      "2.3.3",
+		DefaultValue: "200",
+		Doc:          "minimum milliseconds for recording a mq produce in the slow log, 0 disables it",
+		Export:       true,
+	}
+	p.SlowLogProduceThreshold.Init(base.mgr)
+
+	p.SlowLogKVThreshold = ParamItem{
+		Key:          "common.slowLog.threshold.kv",
+		Version:      "2.3.3",
+		DefaultValue: "500",
+		Doc:          "minimum milliseconds for recording a kv operation in the slow log, 0 disables it",
+		Export:       true,
+	}
+	p.SlowLogKVThreshold.Init(base.mgr)
+
+	p.HealthReportEnabled = ParamItem{
+		Key:          "common.healthReport.enable",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether the nodes push their health to the coordinators on change",
+		Export:       true,
+	}
+	p.HealthReportEnabled.Init(base.mgr)
+
+	p.HealthReportInterval = ParamItem{
+		Key:          "common.healthReport.interval",
+		Version:      "2.3.3",
+		DefaultValue: "1000",
+		Doc:          "interval in milliseconds to check whether the health changes",
+		Export:       true,
+	}
+	p.HealthReportInterval.Init(base.mgr)
+
+	p.HealthReportMaxInterval = ParamItem{
+		Key:          "common.healthReport.maxInterval",
+		Version:      "2.3.3",
+		DefaultValue: "30",
+		Doc:          "maximum interval in seconds to push the health even if it doesn't change",
+		Export:       true,
+	}
+	p.HealthReportMaxInterval.Init(base.mgr)
+
+	p.HealthReportTTL = ParamItem{
+		Key:          "common.healthReport.ttl",
+		Version:      "2.3.3",
+		DefaultValue: "10",
+		Doc:          "ttl in seconds of the pushed health, it expires after the node fails for the ttl",
+		Export:       true,
+	}
+	p.HealthReportTTL.Init(base.mgr)
+
+	p.HealthReportScoreDelta = ParamItem{
+		Key:          "common.healthReport.scoreDelta",
+		Version:      "2.3.3",
+		DefaultValue: "0.05",
+		Doc:          "minimum change of the health score or watermarks to push the health",
+		Export:       true,
+	}
+	p.HealthReportScoreDelta.Init(base.mgr)
+
+	p.StuckWatchdogEnabled = ParamItem{
+		Key:          "common.stuckWatchdog.enable",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether to detect the index builds and mq retention cycles running much longer than their historical durations",
+		Export:       true,
+	}
+	p.StuckWatchdogEnabled.Init(base.mgr)
+
+	p.StuckWatchdogInterval = ParamItem{
+		Key:          "common.stuckWatchdog.interval",
+		Version:      "2.3.3",
+		DefaultValue: "30",
+		Doc:          "interval in seconds to check the running operations",
+		Export:       true,
+	}
+	p.StuckWatchdogInterval.Init(base.mgr)
+
+	p.StuckWatchdogFactor = ParamItem{
+		Key:          "common.stuckWatchdog.factor",
+		Version:      "2.3.3",
+		DefaultValue: "5",
+		Doc:          "an operation is considered stuck once it runs longer than factor times its historical duration",
+		Export:       true,
+	}
+	p.StuckWatchdogFactor.Init(base.mgr)
+
+	p.StuckWatchdogMinDuration = ParamItem{
+		Key:          "common.stuckWatchdog.minDuration",
+		Version:      "2.3.3",
+		DefaultValue: "300",
+		Doc:          "minimum seconds an operation runs before it's considered stuck, to tolerate the jitter of the short operations",
+		Export:       true,
+	}
+	p.StuckWatchdogMinDuration.Init(base.mgr)
+
+	p.CrashReportPath = ParamItem{
+		Key:          "common.crashReport.path",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "directory of the crash reports of the panics recovered in the task goroutines, <localStorage.path>/crash_reports if it's empty",
+		Export:       true,
+	}
+	p.CrashReportPath.Init(base.mgr)
+
+	p.CrashReportMaxReports = ParamItem{
+		Key:          "common.crashReport.maxReports",
+		Version:      "2.3.3",
+		DefaultValue: "32",
+		Doc:          "maximum number of the crash reports to retain, the oldest ones are removed",
+		Export:       true,
+		Constraint:   MinInt(1, ""),
+	}
+	p.CrashReportMaxReports.Init(base.mgr)
+
+	p.CrashReportLogTailSize = ParamItem{
+		Key:          "common.crashReport.logTailSize",
+		Version:      "2.3.3",
+		DefaultValue: "100",
+		Doc:          "number of the latest log lines written into a crash report, at most 256",
+		Export:       true,
+		Constraint:   IntRange(0, 256, "lines"),
+	}
+	p.CrashReportLogTailSize.Init(base.mgr)
+
+	p.DeploymentPreset = ParamItem{
+		Key:          deploymentPresetKey,
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc: `preset profile of the standalone deployment, one of laptop, small-server and production.
+The preset derives the pebble cache, buildParallel, retention and compaction interval of pebblemq from the host resources,
+the derived values only serve as the defaults, the yaml files, env and config center still override them`,
+		Export:     true,
+		Constraint: OneOf(PresetLaptop, PresetSmallServer, PresetProduction),
+	}
+	p.DeploymentPreset.Init(base.mgr)
 }
 
 type traceConfig struct {
-	Exporter       ParamItem `refreshable:"false"`
-	SampleFraction ParamItem `refreshable:"false"`
-	JaegerURL      ParamItem `refreshable:"false"`
-	OtlpEndpoint   ParamItem `refreshable:"false"`
+	Exporter              ParamItem `refreshable:"false"`
+	SampleFraction        ParamItem `refreshable:"false"`
+	MethodSampleFractions ParamItem `refreshable:"false"`
+	TailSamplingEnabled   ParamItem `refreshable:"false"`
+	TailSamplingMaxTraces ParamItem `refreshable:"false"`
+	TailSamplingTTL       ParamItem `refreshable:"false"`
+	JaegerURL             ParamItem `refreshable:"false"`
+	OtlpEndpoint          ParamItem `refreshable:"false"`
 }
 
 func (t *traceConfig) init(base *BaseTable) {
@@ -642,6 +895,43 @@ Fractions >= 1 will always sample. Fractions < 0 are treated as zero.`,
 	}
 	t.SampleFraction.Init(base.mgr)
 
+	t.MethodSampleFractions = ParamItem{
+		Key:          "trace.methodSampleFractions",
+		Version:      "2.3.3",
+		DefaultValue: "{}",
+		Doc: `fractions of traceID based sampler of the rpc methods in json, which override sampleFraction,
+the keys are either the method names or the full method names, e.g. {"CreateJob": "1", "QueryJobs": "0.001"}`,
+		Export: true,
+	}
+	t.MethodSampleFractions.Init(base.mgr)
+
+	t.TailSamplingEnabled = ParamItem{
+		Key:          "trace.tailSampling.enable",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "whether to export the unsampled traces which end with error status, which records all the spans",
+		Export:       true,
+	}
+	t.TailSamplingEnabled.Init(base.mgr)
+
+	t.TailSamplingMaxTraces = ParamItem{
+		Key:          "trace.tailSampling.maxTraces",
+		Version:      "2.3.3",
+		DefaultValue: "10000",
+		Doc:          "maximum number of the unsampled traces buffered for the tail sampling",
+		Export:       true,
+	}
+	t.TailSamplingMaxTraces.Init(base.mgr)
+
+	t.TailSamplingTTL = ParamItem{
+		Key:          "trace.tailSampling.ttl",
+		Version:      "2.3.3",
+		DefaultValue: "60",
+		Doc:          "seconds to buffer the spans of an unsampled trace whose local root span doesn't end, e.g. the spans ended after the root, the trace is exported if it failed and dropped otherwise once expired",
+		Export:       true,
+	}
+	t.TailSamplingTTL.Init(base.mgr)
+
 	t.JaegerURL = ParamItem{
 		Key:     "trace.jaeger.url",
 		Version: "2.3.0",
@@ -657,6 +947,73 @@ Fractions >= 1 will always sample. Fractions < 0 are treated as zero.`,
 	t.OtlpEndpoint.Init(base.mgr)
 }
 
+type metricsConfig struct {
+	Exporter     ParamItem `refreshable:"false"`
+	OtlpEndpoint ParamItem `refreshable:"false"`
+	OtlpInsecure ParamItem `refreshable:"false"`
+	OtlpInterval ParamItem `refreshable:"false"`
+	OtlpTimeout  ParamItem `refreshable:"false"`
+
+	ExemplarEnabled ParamItem `refreshable:"false"`
+}
+
+func (m *metricsConfig) init(base *BaseTable) {
+	m.Exporter = ParamItem{
+		Key:          "metrics.exporter",
+		Version:      "2.3.3",
+		DefaultValue: "prometheus",
+		Doc: `metrics exporter type, the prometheus endpoint is always served,
+optional values: ['prometheus', 'otlp'], otlp pushes the metrics to an OpenTelemetry collector in addition`,
+		Export: true,
+	}
+	m.Exporter.Init(base.mgr)
+
+	m.OtlpEndpoint = ParamItem{
+		Key:     "metrics.otlp.endpoint",
+		Version: "2.3.3",
+		Doc:     "when exporter is otlp should set the grpc endpoint of the OpenTelemetry collector, e.g. 127.0.0.1:4317",
+		Export:  true,
+	}
+	m.OtlpEndpoint.Init(base.mgr)
+
+	m.OtlpInsecure = ParamItem{
+		Key:          "metrics.otlp.insecure",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether to connect the OpenTelemetry collector without tls",
+		Export:       true,
+	}
+	m.OtlpInsecure.Init(base.mgr)
+
+	m.OtlpInterval = ParamItem{
+		Key:          "metrics.otlp.interval",
+		Version:      "2.3.3",
+		DefaultValue: "15",
+		Doc:          "interval in seconds to push the metrics",
+		Export:       true,
+	}
+	m.OtlpInterval.Init(base.mgr)
+
+	m.OtlpTimeout = ParamItem{
+		Key:          "metrics.otlp.timeout",
+		Version:      "2.3.3",
+		DefaultValue: "10",
+		Doc:          "timeout in seconds of pushing the metrics once",
+		Export:       true,
+	}
+	m.OtlpTimeout.Init(base.mgr)
+
+	m.ExemplarEnabled = ParamItem{
+		Key:          "metrics.exemplar.enable",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc: `whether to serve the metrics in the OpenMetrics format if the scraper accepts it,
+which carries the trace id exemplars of the latency histograms, e.g. the index build and pebblemq produce latency`,
+		Export: true,
+	}
+	m.ExemplarEnabled.Init(base.mgr)
+}
+
 type logConfig struct {
 	Level        ParamItem `refreshable:"false"`
 	RootPath     ParamItem `refreshable:"false"`
@@ -666,6 +1023,7 @@ type logConfig struct {
 	Format       ParamItem `refreshable:"false"`
 	Stdout       ParamItem `refreshable:"false"`
 	GrpcLogLevel ParamItem `refreshable:"false"`
+	RedactKeys   ParamItem `refreshable:"false"`
 }
 
 func (l *logConfig) init(base *BaseTable) {
@@ -737,6 +1095,16 @@ func (l *logConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	l.GrpcLogLevel.Init(base.mgr)
+
+	l.RedactKeys = ParamItem{
+		Key:          "log.redactKeys",
+		DefaultValue: "",
+		Version:      "2.3.3",
+		Doc: `keys of the log fields to redact besides the credentials, e.g. accessKeyID, secretAccessKey and password,
+which are always redacted, the keys are matched case-insensitively`,
+		Export: true,
+	}
+	l.RedactKeys.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1968,6 +2336,9 @@ type dataCoordConfig struct {
 	GCDropTolerance         ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
 
+	// Index archive
+	IndexArchiveCheckInterval ParamItem `refreshable:"false"`
+
 	BindIndexNodeMode          ParamItem `refreshable:"false"`
 	IndexNodeAddress           ParamItem `refreshable:"false"`
 	WithCredential             ParamItem `refreshable:"false"`
@@ -2267,6 +2638,15 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCDropTolerance.Init(base.mgr)
 
+	p.IndexArchiveCheckInterval = ParamItem{
+		Key:          "dataCoord.indexArchive.checkInterval",
+		Version:      "2.3.3",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to check the progress of index files being restored from the archive storage class",
+		Export:       true,
+	}
+	p.IndexArchiveCheckInterval.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -2518,13 +2898,52 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 // /////////////////////////////////////////////////////////////////////////////
 // --- indexnode ---
 type indexNodeConfig struct {
-	BuildParallel ParamItem `refreshable:"false"`
+	BuildParallel         ParamItem `refreshable:"true"`
+	SchedulePolicy        ParamItem `refreshable:"false"`
+	PriorityAgingInterval ParamItem `refreshable:"true"`
+	TaskWaitSLO           ParamItem `refreshable:"true"`
+
+	TaskRetryMaxAttempts ParamItem `refreshable:"true"`
+	TaskRetryBackoff     ParamItem `refreshable:"true"`
+
+	SmallJobMaxRows  ParamItem `refreshable:"true"`
+	SmallJobParallel ParamItem `refreshable:"false"`
+	// JobRateLimitNode and JobRateLimitCluster limit the jobs created per second on the node and by a cluster
+	JobRateLimitNode    ParamItem `refreshable:"true"`
+	JobRateLimitCluster ParamItem `refreshable:"true"`
 	// enable disk
 	EnableDisk             ParamItem `refreshable:"false"`
 	DiskCapacityLimit      ParamItem `refreshable:"true"`
 	MaxDiskUsagePercentage ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"false"`
+
+	ObjectTaggingEnabled ParamItem `refreshable:"true"`
+
+	StorageHealthCheckInterval         ParamItem `refreshable:"false"`
+	StorageHealthCheckFailureThreshold ParamItem `refreshable:"true"`
+
+	StreamBuildBatchFiles ParamItem `refreshable:"true"`
+
+	ContentAddressableStorage ParamItem `refreshable:"true"`
+
+	RemoteBuildEnabled    ParamItem `refreshable:"false"`
+	RemoteBuildAddress    ParamItem `refreshable:"false"`
+	RemoteBuildIndexTypes ParamItem `refreshable:"false"`
+	RemoteBuildTimeout    ParamItem `refreshable:"true"`
+	RemoteBuildCaPemPath  ParamItem `refreshable:"false"`
+	RemoteBuildPemPath    ParamItem `refreshable:"false"`
+	RemoteBuildKeyPath    ParamItem `refreshable:"false"`
+
+	TempDirPath           ParamItem `refreshable:"false"`
+	TempDirQuota          ParamItem `refreshable:"true"`
+	TempDirCleanOnStartup ParamItem `refreshable:"false"`
+
+	ThrottleMemoryWatermark ParamItem `refreshable:"true"`
+	ThrottleDiskWatermark   ParamItem `refreshable:"true"`
+
+	MetricsClusterIDLabel ParamItem `refreshable:"true"`
+	MetricsLabelLimit     ParamItem `refreshable:"true"`
 }
 
 func (p *indexNodeConfig) init(base *BaseTable) {
@@ -2533,9 +2952,99 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		Version:      "2.0.0",
 		DefaultValue: "1",
 		Export:       true,
+		Constraint:   MinInt(1, ""),
 	}
 	p.BuildParallel.Init(base.mgr)
 
+	p.SchedulePolicy = ParamItem{
+		Key:          "indexNode.scheduler.policy",
+		Version:      "2.3.3",
+		DefaultValue: "fifo",
+		Doc:          "policy to issue the queued jobs, fifo, priority (by the priority of the job set by the collection property collection.index.buildPriority), sjf (the shortest estimated duration first minus the time waited, estimated from the history builds of the cluster) or fairShare (the cluster which consumed the least estimated build time first)",
+		Export:       true,
+		Constraint:   OneOf("fifo", "priority", "sjf", "fairShare"),
+	}
+	p.SchedulePolicy.Init(base.mgr)
+
+	p.PriorityAgingInterval = ParamItem{
+		Key:          "indexNode.scheduler.priorityAgingInterval",
+		Version:      "2.3.3",
+		DefaultValue: "60",
+		Doc:          "interval in seconds a queued job waits per which it's promoted by one priority level under the priority policy, aging is disabled if it's not positive",
+		Export:       true,
+	}
+	p.PriorityAgingInterval.Init(base.mgr)
+
+	p.TaskWaitSLO = ParamItem{
+		Key:          "indexNode.scheduler.waitSLO",
+		Version:      "2.3.3",
+		DefaultValue: "600",
+		Doc:          "SLO in seconds of the time a job waits in the queue before it's started, a warning event is emitted for the jobs exceeding it, disabled if it's not positive",
+		Export:       true,
+		Constraint:   Float("seconds"),
+	}
+	p.TaskWaitSLO.Init(base.mgr)
+
+	p.TaskRetryMaxAttempts = ParamItem{
+		Key:          "indexNode.scheduler.retry.maxAttempts",
+		Version:      "2.3.3",
+		DefaultValue: "2",
+		Doc:          "max times a job failed with a transient error (e.g. storage blips, OOM) is retried on the node before the failure is reported to the coordinator, disabled if it's not positive",
+		Export:       true,
+		Constraint:   Int(""),
+	}
+	p.TaskRetryMaxAttempts.Init(base.mgr)
+
+	p.TaskRetryBackoff = ParamItem{
+		Key:          "indexNode.scheduler.retry.backoff",
+		Version:      "2.3.3",
+		DefaultValue: "5",
+		Doc:          "base backoff in seconds before a job is retried on the node, doubled per attempt with a random jitter of up to half of it",
+		Export:       true,
+		Constraint:   MinFloat(0, "seconds"),
+	}
+	p.TaskRetryBackoff.Init(base.mgr)
+
+	p.SmallJobMaxRows = ParamItem{
+		Key:          "indexNode.scheduler.smallJob.maxRows",
+		Version:      "2.3.3",
+		DefaultValue: "10000",
+		Doc:          "index builds of the segments with less rows bypass the build queue and run on the dedicated workers of small jobs, disabled if it's not positive",
+		Export:       true,
+		Constraint:   Int(""),
+	}
+	p.SmallJobMaxRows.Init(base.mgr)
+
+	p.SmallJobParallel = ParamItem{
+		Key:          "indexNode.scheduler.smallJob.parallel",
+		Version:      "2.3.3",
+		DefaultValue: "1",
+		Doc:          "number of the dedicated workers of small jobs, the small jobs go to the build queue if it's not positive",
+		Export:       true,
+		Constraint:   Int(""),
+	}
+	p.SmallJobParallel.Init(base.mgr)
+
+	p.JobRateLimitNode = ParamItem{
+		Key:          "indexNode.scheduler.jobRateLimit.node",
+		Version:      "2.3.3",
+		DefaultValue: "-1",
+		Doc:          "max number of the jobs created per second on the node, the jobs over the rate are rejected with a retriable rate limit error, no limit if it's not positive",
+		Export:       true,
+		Constraint:   Float(""),
+	}
+	p.JobRateLimitNode.Init(base.mgr)
+
+	p.JobRateLimitCluster = ParamItem{
+		Key:          "indexNode.scheduler.jobRateLimit.cluster",
+		Version:      "2.3.3",
+		DefaultValue: "-1",
+		Doc:          "max number of the jobs created per second on the node by a cluster, so that a cluster flooding the node doesn't starve the others, no limit if it's not positive",
+		Export:       true,
+		Constraint:   Float(""),
+	}
+	p.JobRateLimitCluster.Init(base.mgr)
+
 	p.EnableDisk = ParamItem{
 		Key:          "indexNode.enableDisk",
 		Version:      "2.2.0",
@@ -2543,6 +3052,7 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		PanicIfEmpty: true,
 		Doc:          "enable index node build disk vector index",
 		Export:       true,
+		Constraint:   Bool(),
 	}
 	p.EnableDisk.Init(base.mgr)
 
@@ -2571,7 +3081,8 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		Formatter: func(v string) string {
 			return fmt.Sprintf("%f", getAsFloat(v)/100)
 		},
-		Export: true,
+		Export:     true,
+		Constraint: FloatRange(0, 100, "%"),
 	}
 	p.MaxDiskUsagePercentage.Init(base.mgr)
 
@@ -2580,8 +3091,234 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		Version:      "2.2.1",
 		FallbackKeys: []string{"common.gracefulStopTimeout"},
 		Export:       true,
+		Constraint:   MinFloat(0, "seconds"),
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.ObjectTaggingEnabled = ParamItem{
+		Key:          "indexNode.objectTaggingEnabled",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "tag the uploaded index files with clusterID, collectionID, buildID and indexVersion for lifecycle rules and cost attribution, only S3 compatible object storage is supported",
+		Export:       true,
+		Constraint:   Bool(),
+	}
+	p.ObjectTaggingEnabled.Init(base.mgr)
+
+	p.StorageHealthCheckInterval = ParamItem{
+		Key:          "indexNode.storageHealthCheck.interval",
+		Version:      "2.3.3",
+		DefaultValue: "10",
+		Doc:          "interval in seconds of probing the object storages used by the jobs, the probes are disabled if it's not positive",
+		Export:       true,
+		Constraint:   Float("seconds"),
+	}
+	p.StorageHealthCheckInterval.Init(base.mgr)
+
+	p.StorageHealthCheckFailureThreshold = ParamItem{
+		Key:          "indexNode.storageHealthCheck.failureThreshold",
+		Version:      "2.3.3",
+		DefaultValue: "3",
+		Doc:          "the new jobs on a storage fail fast after this many consecutive failed probes, until a probe succeeds again. The jobs never fail fast if it's not positive",
+		Export:       true,
+		Constraint:   MinInt(1, ""),
+	}
+	p.StorageHealthCheckFailureThreshold.Init(base.mgr)
+
+	p.StreamBuildBatchFiles = ParamItem{
+		Key:          "indexNode.streamBuild.batchFiles",
+		Version:      "2.3.3",
+		DefaultValue: "0",
+		Doc:          "number of binlogs loaded per batch when building in-memory vector indexes, the index is trained on the first batch and the others are appended, the whole field is loaded at once if it's not positive",
+		Export:       true,
+		Constraint:   Int(""),
+	}
+	p.StreamBuildBatchFiles.Init(base.mgr)
+
+	p.ContentAddressableStorage = ParamItem{
+		Key:          "indexNode.contentAddressableStorage",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "name the index files by the sha256 of their contents with a manifest per build, the identical files of the rebuilds are stored once, the files of the disk indexes are named as usual",
+		Export:       true,
+		Constraint:   Bool(),
+	}
+	p.ContentAddressableStorage.Init(base.mgr)
+
+	p.RemoteBuildEnabled = ParamItem{
+		Key:          "indexNode.remoteBuild.enabled",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "delegate the builds of indexNode.remoteBuild.indexTypes to the external builder service, e.g. a shared GPU farm, the index files are validated and re-uploaded by index node",
+		Export:       true,
+		Constraint:   Bool(),
+	}
+	p.RemoteBuildEnabled.Init(base.mgr)
+
+	p.RemoteBuildAddress = ParamItem{
+		Key:          "indexNode.remoteBuild.address",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "grpc address of the external builder service",
+		Export:       true,
+	}
+	p.RemoteBuildAddress.Init(base.mgr)
+
+	p.RemoteBuildIndexTypes = ParamItem{
+		Key:          "indexNode.remoteBuild.indexTypes",
+		Version:      "2.3.3",
+		DefaultValue: "GPU_IVF_FLAT,GPU_IVF_PQ",
+		Doc:          "comma separated index types delegated to the external builder service",
+		Export:       true,
+	}
+	p.RemoteBuildIndexTypes.Init(base.mgr)
+
+	p.RemoteBuildTimeout = ParamItem{
+		Key:          "indexNode.remoteBuild.timeout",
+		Version:      "2.3.3",
+		DefaultValue: "3600",
+		Doc:          "timeout in seconds of a remote build",
+		Export:       true,
+		Constraint:   GreaterThan(0, "seconds"),
+	}
+	p.RemoteBuildTimeout.Init(base.mgr)
+
+	p.RemoteBuildCaPemPath = ParamItem{
+		Key:          "indexNode.remoteBuild.caPemPath",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "path of the CA certificate to verify the external builder service, the system roots are used if it's empty",
+		Export:       true,
+	}
+	p.RemoteBuildCaPemPath.Init(base.mgr)
+
+	p.RemoteBuildPemPath = ParamItem{
+		Key:          "indexNode.remoteBuild.pemPath",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "path of the client certificate presented to the external builder service for mutual TLS, optional",
+		Export:       true,
+	}
+	p.RemoteBuildPemPath.Init(base.mgr)
+
+	p.RemoteBuildKeyPath = ParamItem{
+		Key:          "indexNode.remoteBuild.keyPath",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "path of the private key of indexNode.remoteBuild.pemPath",
+		Export:       true,
+	}
+	p.RemoteBuildKeyPath.Init(base.mgr)
+
+	p.TempDirPath = ParamItem{
+		Key:          "indexNode.tempDir.path",
+		Version:      "2.3.3",
+		DefaultValue: "",
+		Doc:          "root directory of the temporary files of the disk index builds, <localStorage.path>/indexnode if it's empty",
+		Export:       true,
+	}
+	p.TempDirPath.Init(base.mgr)
+
+	p.TempDirQuota = ParamItem{
+		Key:          "indexNode.tempDir.quota",
+		Version:      "2.3.3",
+		DefaultValue: "0",
+		Doc:          "quota in MB of the temporary files of the concurrent disk index builds, a build exceeding it fails fast, diskCapacityLimit * maxDiskUsagePercentage if it's not positive",
+		Export:       true,
+		Constraint:   Int("MB"),
+	}
+	p.TempDirQuota.Init(base.mgr)
+
+	p.TempDirCleanOnStartup = ParamItem{
+		Key:          "indexNode.tempDir.cleanOnStartup",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "remove the temporary files left by the builds of a crashed index node on startup",
+		Export:       true,
+		Constraint:   Bool(),
+	}
+	p.TempDirCleanOnStartup.Init(base.mgr)
+
+	p.ThrottleMemoryWatermark = ParamItem{
+		Key:          "indexNode.throttle.memoryWatermark",
+		Version:      "2.3.3",
+		DefaultValue: "0",
+		Doc:          "the node reports itself throttled to the coordinator if the ratio of the memory used by the process to the memory of the node or container reaches it, disabled if it's not positive",
+		Export:       true,
+		Constraint:   MaxFloat(1, ""),
+	}
+	p.ThrottleMemoryWatermark.Init(base.mgr)
+
+	p.ThrottleDiskWatermark = ParamItem{
+		Key:          "indexNode.throttle.diskWatermark",
+		Version:      "2.3.3",
+		DefaultValue: "0.9",
+		Doc:          "the node reports itself throttled to the coordinator if the ratio of the local disk reserved by the disk builds to indexNode.tempDir.quota reaches it, disabled if it's not positive",
+		Export:       true,
+		Constraint:   MaxFloat(1, ""),
+	}
+	p.ThrottleDiskWatermark.Init(base.mgr)
+
+	p.MetricsClusterIDLabel = ParamItem{
+		Key:          "indexNode.metrics.clusterIDLabel",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "label the task metrics with the clusterID of the jobs for the per tenant dashboards, the label is empty if it's disabled",
+		Export:       true,
+		Constraint:   Bool(),
+	}
+	p.MetricsClusterIDLabel.Init(base.mgr)
+
+	p.MetricsLabelLimit = ParamItem{
+		Key:          "indexNode.metrics.labelLimit",
+		Version:      "2.3.3",
+		DefaultValue: "10",
+		Doc:          "max number of the clusterIDs labeled in the task metrics, the clusterIDs with the most jobs are kept and the others are labeled as other",
+		Export:       true,
+		Constraint:   MinInt(0, ""),
+	}
+	p.MetricsLabelLimit.Init(base.mgr)
+}
+
+// Validate checks the values and the dependencies of the configs, it's called on the startup of index node.
+func (p *indexNodeConfig) Validate() error {
+	errs := []error{
+		p.BuildParallel.Validate(),
+		p.SchedulePolicy.Validate(),
+		p.TaskWaitSLO.Validate(),
+		p.TaskRetryMaxAttempts.Validate(),
+		p.TaskRetryBackoff.Validate(),
+		p.SmallJobMaxRows.Validate(),
+		p.SmallJobParallel.Validate(),
+		p.EnableDisk.Validate(),
+		p.MaxDiskUsagePercentage.Validate(),
+		p.GracefulStopTimeout.Validate(),
+		p.ObjectTaggingEnabled.Validate(),
+		p.StorageHealthCheckInterval.Validate(),
+		p.StorageHealthCheckFailureThreshold.Validate(),
+		p.StreamBuildBatchFiles.Validate(),
+		p.ContentAddressableStorage.Validate(),
+		p.RemoteBuildEnabled.Validate(),
+		p.RemoteBuildTimeout.Validate(),
+		p.TempDirQuota.Validate(),
+		p.TempDirCleanOnStartup.Validate(),
+		p.ThrottleMemoryWatermark.Validate(),
+		p.ThrottleDiskWatermark.Validate(),
+		p.MetricsClusterIDLabel.Validate(),
+		p.MetricsLabelLimit.Validate(),
+	}
+	if p.RemoteBuildEnabled.GetAsBool() {
+		if p.RemoteBuildAddress.GetValue() == "" {
+			errs = append(errs, fmt.Errorf("%s is required if %s is true", p.RemoteBuildAddress.Key, p.RemoteBuildEnabled.Key))
+		}
+		if p.RemoteBuildIndexTypes.GetValue() == "" {
+			errs = append(errs, fmt.Errorf("%s is required if %s is true", p.RemoteBuildIndexTypes.Key, p.RemoteBuildEnabled.Key))
+		}
+		if (p.RemoteBuildPemPath.GetValue() == "") != (p.RemoteBuildKeyPath.GetValue() == "") {
+			errs = append(errs, fmt.Errorf("%s and %s should be set together", p.RemoteBuildPemPath.Key, p.RemoteBuildKeyPath.Key))
+		}
+	}
+	return checkConstraints(errs...)
 }
 
 type integrationTestConfig struct {
@@ -16,9 +16,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/metrics"
 )
 
 func shouldPanic(t *testing.T, name string, f func()) {
@@ -108,6 +110,73 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("common.preCreatedTopic.timeticker", "timeticker")
 		assert.Equal(t, []string{"timeticker"}, Params.TimeTicker.GetAsStrings())
+
+		assert.True(t, Params.SlowLogEnabled.GetAsBool())
+		assert.Equal(t, "", Params.SlowLogFilePath.GetValue())
+		assert.Equal(t, 256, Params.SlowLogTailSize.GetAsInt())
+		assert.Equal(t, time.Second, Params.SlowLogRPCThreshold.GetAsDuration(time.Millisecond))
+		assert.Equal(t, time.Minute, Params.SlowLogBuildPhaseThreshold.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 200*time.Millisecond, Params.SlowLogProduceThreshold.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 500*time.Millisecond, Params.SlowLogKVThreshold.GetAsDuration(time.Millisecond))
+
+		assert.True(t, Params.HealthReportEnabled.GetAsBool())
+		assert.Equal(t, time.Second, Params.HealthReportInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 30*time.Second, Params.HealthReportMaxInterval.GetAsDuration(time.Second))
+		assert.Equal(t, int64(10), Params.HealthReportTTL.GetAsInt64())
+		assert.Equal(t, 0.05, Params.HealthReportScoreDelta.GetAsFloat())
+
+		assert.True(t, Params.StuckWatchdogEnabled.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.StuckWatchdogInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 5.0, Params.StuckWatchdogFactor.GetAsFloat())
+		assert.Equal(t, 5*time.Minute, Params.StuckWatchdogMinDuration.GetAsDuration(time.Second))
+
+		assert.Equal(t, "", Params.DeploymentPreset.GetValue())
+		params.Save(Params.DeploymentPreset.Key, "laptop")
+		assert.NoError(t, Params.DeploymentPreset.Validate())
+		params.Save(Params.DeploymentPreset.Key, "large")
+		assert.Error(t, Params.DeploymentPreset.Validate())
+		params.Reset(Params.DeploymentPreset.Key)
+	})
+
+	t.Run("test traceConfig", func(t *testing.T) {
+		Params := &params.TraceCfg
+
+		assert.Empty(t, Params.MethodSampleFractions.GetAsJSONMap())
+		params.Save("trace.methodSampleFractions", `{"CreateJob": 1, "QueryJobs": "0.001"}`)
+		assert.Equal(t, map[string]string{"CreateJob": "1", "QueryJobs": "0.001"}, Params.MethodSampleFractions.GetAsJSONMap())
+		params.Reset("trace.methodSampleFractions")
+
+		assert.False(t, Params.TailSamplingEnabled.GetAsBool())
+		assert.Equal(t, 10000, Params.TailSamplingMaxTraces.GetAsInt())
+		assert.Equal(t, time.Minute, Params.TailSamplingTTL.GetAsDuration(time.Second))
+	})
+
+	t.Run("test metricsConfig", func(t *testing.T) {
+		Params := &params.MetricsCfg
+
+		assert.Equal(t, "prometheus", Params.Exporter.GetValue())
+		assert.Equal(t, "", Params.OtlpEndpoint.GetValue())
+		assert.True(t, Params.OtlpInsecure.GetAsBool())
+		assert.Equal(t, 15*time.Second, Params.OtlpInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.OtlpTimeout.GetAsDuration(time.Second))
+		assert.True(t, Params.ExemplarEnabled.GetAsBool())
+	})
+
+	t.Run("test featureFlagConfig", func(t *testing.T) {
+		Params := &params.FeatureFlagCfg
+
+		assert.Len(t, Params.Flags(), 2)
+		assert.True(t, Params.MQPayloadCompression.Enabled())
+		assert.True(t, Params.PebblemqCompression.Enabled())
+		assert.Equal(t, "mqPayloadCompression", Params.MQPayloadCompression.Name())
+		assert.Equal(t, &Params.PebblemqCompression, Params.Get("pebblemqCompression"))
+		assert.Nil(t, Params.Get("notExist"))
+
+		before := testutil.ToFloat64(metrics.FeatureFlagEvaluations.WithLabelValues("mqPayloadCompression", "false"))
+		params.Save(Params.MQPayloadCompression.Key, "false")
+		defer params.Reset(Params.MQPayloadCompression.Key)
+		assert.False(t, Params.MQPayloadCompression.Enabled())
+		assert.Equal(t, before+1, testutil.ToFloat64(metrics.FeatureFlagEvaluations.WithLabelValues("mqPayloadCompression", "false")))
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -347,6 +416,7 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.Equal(t, time.Minute, Params.IndexArchiveCheckInterval.GetAsDuration(time.Second))
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -380,8 +450,63 @@ func TestComponentParam(t *testing.T) {
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
 		Params := &params.IndexNodeCfg
+		assert.Equal(t, "fifo", Params.SchedulePolicy.GetValue())
+		assert.Equal(t, 60*time.Second, Params.PriorityAgingInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Minute, Params.TaskWaitSLO.GetAsDuration(time.Second))
+		assert.Equal(t, 2, Params.TaskRetryMaxAttempts.GetAsInt())
+		assert.Equal(t, 5*time.Second, Params.TaskRetryBackoff.GetAsDuration(time.Second))
+		assert.Equal(t, int64(10000), Params.SmallJobMaxRows.GetAsInt64())
+		assert.Equal(t, 1, Params.SmallJobParallel.GetAsInt())
+
 		params.Save(Params.GracefulStopTimeout.Key, "50")
 		assert.Equal(t, Params.GracefulStopTimeout.GetAsInt64(), int64(50))
+
+		assert.False(t, Params.ObjectTaggingEnabled.GetAsBool())
+		params.Save(Params.ObjectTaggingEnabled.Key, "true")
+		assert.True(t, Params.ObjectTaggingEnabled.GetAsBool())
+
+		assert.Equal(t, int64(0), Params.StreamBuildBatchFiles.GetAsInt64())
+		params.Save(Params.StreamBuildBatchFiles.Key, "8")
+		assert.Equal(t, int64(8), Params.StreamBuildBatchFiles.GetAsInt64())
+
+		assert.False(t, Params.ContentAddressableStorage.GetAsBool())
+		params.Save(Params.ContentAddressableStorage.Key, "true")
+		assert.True(t, Params.ContentAddressableStorage.GetAsBool())
+
+		assert.False(t, Params.RemoteBuildEnabled.GetAsBool())
+		assert.Equal(t, "", Params.RemoteBuildAddress.GetValue())
+		assert.Equal(t, []string{"GPU_IVF_FLAT", "GPU_IVF_PQ"}, Params.RemoteBuildIndexTypes.GetAsStrings())
+		assert.Equal(t, time.Hour, Params.RemoteBuildTimeout.GetAsDuration(time.Second))
+		params.Save(Params.RemoteBuildTimeout.Key, "60")
+		assert.Equal(t, time.Minute, Params.RemoteBuildTimeout.GetAsDuration(time.Second))
+
+		assert.Equal(t, "", Params.TempDirPath.GetValue())
+		assert.Equal(t, int64(0), Params.TempDirQuota.GetAsInt64())
+		params.Save(Params.TempDirQuota.Key, "1024")
+		assert.Equal(t, int64(1024), Params.TempDirQuota.GetAsInt64())
+		assert.True(t, Params.TempDirCleanOnStartup.GetAsBool())
+
+		assert.Equal(t, 0.0, Params.ThrottleMemoryWatermark.GetAsFloat())
+		assert.Equal(t, 0.9, Params.ThrottleDiskWatermark.GetAsFloat())
+
+		assert.False(t, Params.MetricsClusterIDLabel.GetAsBool())
+		assert.Equal(t, 10, Params.MetricsLabelLimit.GetAsInt())
+
+		assert.NoError(t, Params.Validate())
+		params.Save(Params.BuildParallel.Key, "0")
+		params.Save(Params.SchedulePolicy.Key, "lifo")
+		err := Params.Validate()
+		assert.ErrorContains(t, err, Params.BuildParallel.Key)
+		assert.ErrorContains(t, err, Params.SchedulePolicy.Key)
+		params.Reset(Params.BuildParallel.Key)
+		params.Reset(Params.SchedulePolicy.Key)
+
+		params.Save(Params.RemoteBuildEnabled.Key, "true")
+		assert.ErrorContains(t, Params.Validate(), Params.RemoteBuildAddress.Key)
+		params.Save(Params.RemoteBuildAddress.Key, "localhost:19530")
+		assert.NoError(t, Params.Validate())
+		params.Reset(Params.RemoteBuildEnabled.Key)
+		params.Reset(Params.RemoteBuildAddress.Key)
 	})
 
 	t.Run("channel config priority", func(t *testing.T) {
@@ -403,3 +528,15 @@ func TestForbiddenItem(t *testing.T) {
 	})
 	assert.Equal(t, "by-dev", params.CommonCfg.ClusterPrefix.GetValue())
 }
+
+func TestInvalidItemUpdate(t *testing.T) {
+	Init()
+	params := Get()
+
+	params.baseTable.mgr.OnEvent(&config.Event{
+		Key:       params.IndexNodeCfg.BuildParallel.Key,
+		Value:     "-1",
+		EventType: config.CreateType,
+	})
+	assert.Equal(t, 1, params.IndexNodeCfg.BuildParallel.GetAsInt())
+}
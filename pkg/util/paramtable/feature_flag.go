@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+const featureFlagPrefix = "featureFlags."
+
+// FeatureFlag gates a subsystem by the boolean config "featureFlags.<name>", so that the subsystem can be
+// rolled out to part of the nodes first, and turned off at runtime without a release.
+// A new subsystem is disabled by default, and enabled by default once it's rolled out.
+type FeatureFlag struct {
+	ParamItem
+}
+
+// Name returns the name of the flag, i.e. the key without the prefix.
+func (f *FeatureFlag) Name() string {
+	return strings.TrimPrefix(f.Key, featureFlagPrefix)
+}
+
+// Enabled evaluates the flag, the evaluations are counted by the result.
+func (f *FeatureFlag) Enabled() bool {
+	enabled := f.GetAsBool()
+	metrics.FeatureFlagEvaluations.WithLabelValues(f.Name(), strconv.FormatBool(enabled)).Inc()
+	return enabled
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- feature flags ---
+type featureFlagConfig struct {
+	MQPayloadCompression FeatureFlag `refreshable:"true"`
+	PebblemqCompression  FeatureFlag `refreshable:"false"`
+}
+
+func (p *featureFlagConfig) init(base *BaseTable) {
+	p.MQPayloadCompression = FeatureFlag{ParamItem{
+		Key:          featureFlagPrefix + "mqPayloadCompression",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether msgstream compresses the message payloads by mq.compressionType",
+		Export:       true,
+		Constraint:   Bool(),
+	}}
+	p.MQPayloadCompression.Init(base.mgr)
+
+	p.PebblemqCompression = FeatureFlag{ParamItem{
+		Key:          featureFlagPrefix + "pebblemqCompression",
+		Version:      "2.3.3",
+		DefaultValue: "true",
+		Doc:          "whether pebblemq compresses the levels by pebblemq.compressionTypes, takes effect on restart",
+		Export:       true,
+		Constraint:   Bool(),
+	}}
+	p.PebblemqCompression.Init(base.mgr)
+}
+
+// Flags returns all the feature flags.
+func (p *featureFlagConfig) Flags() []*FeatureFlag {
+	return []*FeatureFlag{
+		&p.MQPayloadCompression,
+		&p.PebblemqCompression,
+	}
+}
+
+// Get returns the feature flag by the name, nil if not found.
+func (p *featureFlagConfig) Get(name string) *FeatureFlag {
+	for _, flag := range p.Flags() {
+		if flag.Name() == name {
+			return flag
+		}
+	}
+	return nil
+}
@@ -14,7 +14,9 @@ package paramtable
 import (
 	"fmt"
 	"strconv"
+	"time"
 
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
@@ -136,6 +138,45 @@ type GrpcServerConfig struct {
 
 	ServerMaxSendSize ParamItem `refreshable:"false"`
 	ServerMaxRecvSize ParamItem `refreshable:"false"`
+
+	MethodTimeouts     ParamItem `refreshable:"true"`
+	MethodMinDeadlines ParamItem `refreshable:"true"`
+
+	// the parsed durations of the methods, re-parsed once the raw values change
+	methodTimeouts     atomic.Pointer[methodDurations]
+	methodMinDeadlines atomic.Pointer[methodDurations]
+}
+
+// methodDurations is the parsed json of the durations in milliseconds by the method names.
+type methodDurations struct {
+	raw       string
+	durations map[string]time.Duration
+}
+
+func parseMethodDurations(raw string) *methodDurations {
+	parsed := &methodDurations{raw: raw, durations: make(map[string]time.Duration)}
+	durations, err := funcutil.JSONToMap(raw)
+	if err != nil {
+		log.Warn("failed to parse the durations of the grpc methods", zap.String("value", raw), zap.Error(err))
+		return parsed
+	}
+	for method, v := range durations {
+		ms, err := strconv.ParseFloat(v, 64)
+		if err != nil || ms <= 0 {
+			continue
+		}
+		parsed.durations[method] = time.Duration(ms * float64(time.Millisecond))
+	}
+	return parsed
+}
+
+// get returns the duration of the method, or of "*" if the method isn't listed, 0 means not limited.
+func (d *methodDurations) get(method string) time.Duration {
+	v, ok := d.durations[method]
+	if !ok {
+		v = d.durations["*"]
+	}
+	return v
 }
 
 func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
@@ -184,6 +225,45 @@ func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
 		Export: true,
 	}
 	p.ServerMaxRecvSize.Init(base.mgr)
+
+	p.MethodTimeouts = ParamItem{
+		Key:          p.Domain + ".grpc.methodTimeouts",
+		Version:      "2.3.3",
+		DefaultValue: "{}",
+		FallbackKeys: []string{"grpc.methodTimeouts"},
+		Doc: `The timeouts in milliseconds of the rpcs by the method names in json, e.g. {"QueryJobs": 10000},
+the handlers are cancelled once timed out. "*" sets the timeout of the methods not listed, no timeout by default`,
+		Export: true,
+	}
+	p.MethodTimeouts.Init(base.mgr)
+
+	p.MethodMinDeadlines = ParamItem{
+		Key:          p.Domain + ".grpc.methodMinDeadlines",
+		Version:      "2.3.3",
+		DefaultValue: "{}",
+		FallbackKeys: []string{"grpc.methodMinDeadlines"},
+		Doc: `The minimum deadlines in milliseconds of the rpcs by the method names in json, e.g. {"CreateJob": 1000},
+the requests whose remaining deadlines are shorter are rejected before handled. "*" sets the minimum of the methods not listed`,
+		Export: true,
+	}
+	p.MethodMinDeadlines.Init(base.mgr)
+}
+
+// MethodDeadlines returns the timeout and the minimum deadline of the rpc method, 0 means not limited.
+// It's called by every rpc, so the json is only parsed once it changes.
+func (p *GrpcServerConfig) MethodDeadlines(method string) (timeout, minDeadline time.Duration) {
+	return loadMethodDurations(&p.MethodTimeouts, &p.methodTimeouts).get(method),
+		loadMethodDurations(&p.MethodMinDeadlines, &p.methodMinDeadlines).get(method)
+}
+
+func loadMethodDurations(item *ParamItem, cache *atomic.Pointer[methodDurations]) *methodDurations {
+	raw := item.GetValue()
+	if parsed := cache.Load(); parsed != nil && parsed.raw == raw {
+		return parsed
+	}
+	parsed := parseMethodDurations(raw)
+	cache.Store(parsed)
+	return parsed
 }
 
 // GrpcClientConfig is configuration for grpc client.
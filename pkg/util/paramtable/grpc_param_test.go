@@ -61,6 +61,21 @@ func TestGrpcServerParams(t *testing.T) {
 
 	base.Save("grpc.serverMaxSendSize", "a")
 	assert.Equal(t, serverConfig.ServerMaxSendSize.GetAsInt(), DefaultServerMaxSendSize)
+
+	timeout, minDeadline := serverConfig.MethodDeadlines("QueryJobs")
+	assert.Zero(t, timeout)
+	assert.Zero(t, minDeadline)
+
+	base.Save("grpc.methodTimeouts", `{"QueryJobs": 10000, "*": 500}`)
+	base.Save(role+".grpc.methodMinDeadlines", `{"QueryJobs": 100, "CreateJob": "invalid"}`)
+	defer base.Reset("grpc.methodTimeouts")
+	defer base.Reset(role + ".grpc.methodMinDeadlines")
+	timeout, minDeadline = serverConfig.MethodDeadlines("QueryJobs")
+	assert.Equal(t, 10*time.Second, timeout)
+	assert.Equal(t, 100*time.Millisecond, minDeadline)
+	timeout, minDeadline = serverConfig.MethodDeadlines("CreateJob")
+	assert.Equal(t, 500*time.Millisecond, timeout)
+	assert.Zero(t, minDeadline)
 }
 
 func TestGrpcClientParams(t *testing.T) {
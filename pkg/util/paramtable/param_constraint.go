@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Constraint checks the raw value of a ParamItem, i.e. the value before the Formatter,
+// the error tells what the value should be.
+type Constraint func(value string) error
+
+// Bool requires a boolean value.
+func Bool() Constraint {
+	return func(value string) error {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("should be true or false")
+		}
+		return nil
+	}
+}
+
+// IntRange requires an integer in [min, max], the unit is only used in the error message.
+func IntRange(min, max int64, unit string) Constraint {
+	return func(value string) error {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v < min || v > max {
+			return rangeError("an integer", formatIntBound(min), formatIntBound(max), unit)
+		}
+		return nil
+	}
+}
+
+// Int requires an integer.
+func Int(unit string) Constraint {
+	return IntRange(math.MinInt64, math.MaxInt64, unit)
+}
+
+// MinInt requires an integer not less than min.
+func MinInt(min int64, unit string) Constraint {
+	return IntRange(min, math.MaxInt64, unit)
+}
+
+// FloatRange requires a number in [min, max], the unit is only used in the error message.
+func FloatRange(min, max float64, unit string) Constraint {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(v) || v < min || v > max {
+			return rangeError("a number", formatFloatBound(min), formatFloatBound(max), unit)
+		}
+		return nil
+	}
+}
+
+// Float requires a number.
+func Float(unit string) Constraint {
+	return FloatRange(math.Inf(-1), math.Inf(1), unit)
+}
+
+// MinFloat requires a number not less than min.
+func MinFloat(min float64, unit string) Constraint {
+	return FloatRange(min, math.Inf(1), unit)
+}
+
+// MaxFloat requires a number not greater than max.
+func MaxFloat(max float64, unit string) Constraint {
+	return FloatRange(math.Inf(-1), max, unit)
+}
+
+// GreaterThan requires a number greater than min, e.g. the interval of a ticker.
+func GreaterThan(min float64, unit string) Constraint {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(v) || v <= min {
+			return fmt.Errorf("should be a number greater than %s%s", formatFloatBound(min), formatUnit(unit))
+		}
+		return nil
+	}
+}
+
+// NoLimit accepts -1, which means no limit, besides the values accepted by c.
+func NoLimit(c Constraint) Constraint {
+	return func(value string) error {
+		if value == "-1" {
+			return nil
+		}
+		if err := c(value); err != nil {
+			return fmt.Errorf("%s, or -1 for no limit", err.Error())
+		}
+		return nil
+	}
+}
+
+// OneOf requires one of the values.
+func OneOf(values ...string) Constraint {
+	return func(value string) error {
+		for _, v := range values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("should be one of [%s]", strings.Join(values, ", "))
+	}
+}
+
+// IntList requires a non-empty comma separated list of the integers.
+func IntList(values ...int64) Constraint {
+	allowed := make([]string, 0, len(values))
+	for _, v := range values {
+		allowed = append(allowed, strconv.FormatInt(v, 10))
+	}
+	one := OneOf(allowed...)
+	return func(value string) error {
+		for _, v := range strings.Split(value, ",") {
+			if err := one(strings.TrimSpace(v)); err != nil {
+				return fmt.Errorf("should be a comma separated list of [%s]", strings.Join(allowed, ", "))
+			}
+		}
+		return nil
+	}
+}
+
+// checkConstraints returns the errors of the items in a single error, nil if all of them are valid.
+func checkConstraints(errs ...error) error {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configs: %s", strings.Join(msgs, "; "))
+}
+
+func rangeError(kind, min, max, unit string) error {
+	if min == "-inf" && max == "+inf" {
+		return fmt.Errorf("should be %s%s", kind, formatUnit(unit))
+	}
+	return fmt.Errorf("should be %s in [%s, %s]%s", kind, min, max, formatUnit(unit))
+}
+
+func formatIntBound(v int64) string {
+	switch v {
+	case math.MinInt64:
+		return "-inf"
+	case math.MaxInt64:
+		return "+inf"
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+func formatFloatBound(v float64) string {
+	switch {
+	case math.IsInf(v, -1):
+		return "-inf"
+	case math.IsInf(v, 1):
+		return "+inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func formatUnit(unit string) string {
+	if unit == "" {
+		return ""
+	}
+	return " " + unit
+}
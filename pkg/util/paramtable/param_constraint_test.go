@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/config"
+)
+
+func TestConstraints(t *testing.T) {
+	cases := []struct {
+		constraint Constraint
+		valid      []string
+		invalid    []string
+		msg        string
+	}{
+		{Bool(), []string{"true", "false"}, []string{"yes"}, "should be true or false"},
+		{MinInt(1, "MB"), []string{"1", "1024"}, []string{"0", "-1", "1.5", "a"}, "should be an integer in [1, +inf] MB"},
+		{Int(""), []string{"-1", "0"}, []string{"a"}, "should be an integer"},
+		{FloatRange(0, 1, ""), []string{"0", "0.5", "1"}, []string{"-0.1", "1.1", "NaN"}, "should be a number in [0, 1]"},
+		{MaxFloat(1, ""), []string{"-1", "1"}, []string{"2"}, "should be a number in [-inf, 1]"},
+		{GreaterThan(0, "seconds"), []string{"0.1", "60"}, []string{"0", "-1"}, "should be a number greater than 0 seconds"},
+		{NoLimit(MinInt(0, "MB")), []string{"-1", "0"}, []string{"-2"}, "should be an integer in [0, +inf] MB, or -1 for no limit"},
+		{OneOf("a", "b"), []string{"a"}, []string{"c"}, "should be one of [a, b]"},
+		{IntList(0, 1, 7), []string{"0", "0, 7,7"}, []string{"0,2", "a", "0,"}, "should be a comma separated list of [0, 1, 7]"},
+	}
+	for _, c := range cases {
+		for _, v := range c.valid {
+			assert.NoError(t, c.constraint(v), v)
+		}
+		for _, v := range c.invalid {
+			assert.EqualError(t, c.constraint(v), c.msg, v)
+		}
+	}
+}
+
+func TestParamItemValidate(t *testing.T) {
+	manager := config.NewManager()
+	item := ParamItem{
+		Key:          "test.pageSize",
+		FallbackKeys: []string{"test.oldPageSize"},
+		DefaultValue: "10",
+		Constraint:   MinInt(1, "bytes"),
+	}
+	item.Init(manager)
+	assert.NoError(t, item.Validate())
+
+	manager.SetConfig(item.Key, "-10")
+	assert.EqualError(t, item.Validate(), `invalid test.pageSize: should be an integer in [1, +inf] bytes`)
+
+	// an empty value is treated as unset
+	manager.SetConfig(item.Key, "")
+	assert.NoError(t, item.Validate())
+
+	// the updates of the fallback keys are validated too
+	manager.OnEvent(&config.Event{EventSource: "test", EventType: config.CreateType, Key: "test.oldPageSize", Value: "-1"})
+	_, err := manager.GetConfig("test.oldPageSize")
+	assert.Error(t, err)
+
+	assert.NoError(t, checkConstraints(nil, nil))
+	assert.EqualError(t, checkConstraints(nil, item.check("0"), item.check("a")),
+		`invalid configs: invalid test.pageSize: should be an integer in [1, +inf] bytes; `+
+			`invalid test.pageSize: should be an integer in [1, +inf] bytes`)
+}
@@ -33,6 +33,8 @@ type ParamItem struct {
 
 	Formatter func(originValue string) string
 	Forbidden bool
+	// Constraint checks the value at startup by Validate, and the dynamic updates by the manager
+	Constraint Constraint
 
 	manager *config.Manager
 
@@ -45,6 +47,33 @@ func (pi *ParamItem) Init(manager *config.Manager) {
 	if pi.Forbidden {
 		pi.manager.ForbidUpdate(pi.Key)
 	}
+	if pi.Constraint != nil {
+		pi.manager.SetValidator(pi.Key, pi.check)
+		// the value may come from the fallback keys
+		for _, key := range pi.FallbackKeys {
+			pi.manager.SetValidator(key, pi.check)
+		}
+	}
+}
+
+// Validate checks the current value by the Constraint, an empty value is treated as unset.
+func (pi *ParamItem) Validate() error {
+	if pi.Constraint == nil {
+		return nil
+	}
+	v, _ := pi.getRaw()
+	return pi.check(v)
+}
+
+func (pi *ParamItem) check(value string) error {
+	if value == "" {
+		return nil
+	}
+	if err := pi.Constraint(value); err != nil {
+		// the value isn't put into the error, which may be a secret
+		return fmt.Errorf("invalid %s: %s", pi.Key, err.Error())
+	}
+	return nil
 }
 
 // Get original value with error
@@ -54,6 +83,23 @@ func (pi *ParamItem) get() (string, error) {
 		return *s, nil
 	}
 
+	ret, err := pi.getRaw()
+	if pi.Formatter != nil {
+		ret = pi.Formatter(ret)
+	}
+	if ret == "" && pi.PanicIfEmpty {
+		panic(fmt.Sprintf("%s is empty", pi.Key))
+	}
+	return ret, err
+}
+
+// getRaw returns the value before the Formatter, the default value if it's not set.
+func (pi *ParamItem) getRaw() (string, error) {
+	// For unittest.
+	if s := pi.tempValue.Load(); s != nil {
+		return *s, nil
+	}
+
 	if pi.manager == nil {
 		panic(fmt.Sprintf("manager is nil %s", pi.Key))
 	}
@@ -69,12 +115,6 @@ func (pi *ParamItem) get() (string, error) {
 	if err != nil {
 		ret = pi.DefaultValue
 	}
-	if pi.Formatter != nil {
-		ret = pi.Formatter(ret)
-	}
-	if ret == "" && pi.PanicIfEmpty {
-		panic(fmt.Sprintf("%s is empty", pi.Key))
-	}
 	return ret, err
 }
 
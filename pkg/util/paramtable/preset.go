@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+)
+
+const (
+	// PresetLaptop keeps the footprint small for the development on a laptop
+	PresetLaptop = "laptop"
+	// PresetSmallServer fits a standalone deployment sharing a small server with other services
+	PresetSmallServer = "small-server"
+	// PresetProduction uses the resources of a dedicated host
+	PresetProduction = "production"
+
+	deploymentPresetKey = "common.deploymentPreset"
+	pebblemqPathKey     = "pebblemq.path"
+)
+
+// presetProfile derives the configs of a preset from the host resources.
+type presetProfile struct {
+	// the pebble cache is cacheRatio of the memory, up to maxCacheInMB
+	cacheRatio   float64
+	maxCacheInMB uint64
+	// the memtable is memTableRatio of the cache, in [minMemTableInMB, maxMemTableInMB]
+	memTableRatio   float64
+	minMemTableInMB uint64
+	maxMemTableInMB uint64
+	// an index build runs per cpusPerBuild cpus
+	cpusPerBuild int
+	// the retention size is diskRatio of the disk of pebblemq, up to maxRetentionInMB
+	diskRatio              float64
+	maxRetentionInMB       uint64
+	retentionTimeInMinutes int
+	// compactionInterval in seconds, a small disk is compacted more frequently to reclaim the space
+	compactionInterval int
+}
+
+var presetProfiles = map[string]presetProfile{
+	PresetLaptop: {
+		cacheRatio:             0.03,
+		maxCacheInMB:           256,
+		memTableRatio:          0.25,
+		minMemTableInMB:        8,
+		maxMemTableInMB:        32,
+		cpusPerBuild:           0,
+		diskRatio:              0.05,
+		maxRetentionInMB:       2048,
+		retentionTimeInMinutes: 24 * 60,
+		compactionInterval:     3600,
+	},
+	PresetSmallServer: {
+		cacheRatio:             0.06,
+		maxCacheInMB:           2048,
+		memTableRatio:          0.125,
+		minMemTableInMB:        16,
+		maxMemTableInMB:        64,
+		cpusPerBuild:           8,
+		diskRatio:              0.1,
+		maxRetentionInMB:       8192,
+		retentionTimeInMinutes: 3 * 24 * 60,
+		compactionInterval:     6 * 3600,
+	},
+	PresetProduction: {
+		cacheRatio:             0.1,
+		maxCacheInMB:           8192,
+		memTableRatio:          0.0625,
+		minMemTableInMB:        64,
+		maxMemTableInMB:        256,
+		cpusPerBuild:           4,
+		diskRatio:              0.2,
+		maxRetentionInMB:       65536,
+		retentionTimeInMinutes: 3 * 24 * 60,
+		compactionInterval:     24 * 3600,
+	},
+}
+
+// hostResources are the resources the presets are derived from.
+type hostResources struct {
+	memory uint64
+	cpus   int
+	// disk is the size of the file system of pebblemq
+	disk uint64
+}
+
+// getHostResources returns the resources of the host, replaced in tests.
+var getHostResources = func(pebblemqPath string) hostResources {
+	res := hostResources{
+		memory: hardware.GetMemoryCount(),
+		cpus:   hardware.GetCPUNum(),
+	}
+	// the path may not be created yet, the nearest existing parent is on the same file system
+	for dir := pebblemqPath; dir != ""; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(dir); err == nil {
+			res.disk, _, _ = hardware.GetPathDiskUsage(dir)
+			break
+		}
+		if dir == filepath.Dir(dir) {
+			break
+		}
+	}
+	return res
+}
+
+// presetConfigs returns the configs derived by the preset from the host resources.
+func presetConfigs(name string, res hostResources) (map[string]string, error) {
+	profile, ok := presetProfiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown deployment preset %q, should be one of [%s, %s, %s]",
+			name, PresetLaptop, PresetSmallServer, PresetProduction)
+	}
+	configs := make(map[string]string)
+	if res.memory > 0 {
+		memoryInMB := float64(res.memory) / 1024 / 1024
+		cacheInMB := clamp(memoryInMB*profile.cacheRatio, 0, float64(profile.maxCacheInMB))
+		configs["pebblemq.lrucacheratio"] = strconv.FormatFloat(cacheInMB/memoryInMB, 'f', -1, 64)
+		memTableInMB := clamp(cacheInMB*profile.memTableRatio, float64(profile.minMemTableInMB), float64(profile.maxMemTableInMB))
+		configs["pebblemq.memTableSizeInMB"] = strconv.Itoa(int(memTableInMB))
+	}
+	buildParallel := 1
+	if profile.cpusPerBuild > 0 && res.cpus/profile.cpusPerBuild > 1 {
+		buildParallel = res.cpus / profile.cpusPerBuild
+	}
+	configs["indexNode.scheduler.buildParallel"] = strconv.Itoa(buildParallel)
+	if res.disk > 0 {
+		retentionInMB := clamp(float64(res.disk)/1024/1024*profile.diskRatio, 1, float64(profile.maxRetentionInMB))
+		configs["pebblemq.retentionSizeInMB"] = strconv.Itoa(int(retentionInMB))
+	}
+	configs["pebblemq.retentionTimeInMinutes"] = strconv.Itoa(profile.retentionTimeInMinutes)
+	configs["pebblemq.compactionInterval"] = strconv.Itoa(profile.compactionInterval)
+	return configs, nil
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// initConfigsFromPreset adds the configs of the deployment preset selected by the yaml files or env,
+// they only serve as the defaults of the configs not set by the yaml files, env or config center.
+func (bt *BaseTable) initConfigsFromPreset() {
+	name, err := bt.mgr.GetConfig(deploymentPresetKey)
+	if err != nil || name == "" {
+		return
+	}
+	path, _ := bt.mgr.GetConfig(pebblemqPathKey)
+	configs, err := presetConfigs(name, getHostResources(path))
+	if err != nil {
+		log.Warn("ignore the deployment preset", zap.Error(err))
+		return
+	}
+	if err := bt.mgr.AddSource(config.NewPresetSource(name, configs)); err != nil {
+		log.Warn("init baseTable with preset failed", zap.String("preset", name), zap.Error(err))
+		return
+	}
+	log.Info("init baseTable with preset", zap.String("preset", name), zap.Any("configs", configs))
+}
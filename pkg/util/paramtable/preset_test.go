@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	gb = 1024 * 1024 * 1024
+	tb = 1024 * gb
+)
+
+func TestPresetConfigs(t *testing.T) {
+	_, err := presetConfigs("large", hostResources{})
+	assert.Error(t, err)
+
+	configs, err := presetConfigs(PresetLaptop, hostResources{memory: 16 * gb, cpus: 8, disk: 512 * gb})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"pebblemq.lrucacheratio":            "0.015625",
+		"pebblemq.memTableSizeInMB":         "32",
+		"indexNode.scheduler.buildParallel": "1",
+		"pebblemq.retentionSizeInMB":        "2048",
+		"pebblemq.retentionTimeInMinutes":   "1440",
+		"pebblemq.compactionInterval":       "3600",
+	}, configs)
+
+	configs, err = presetConfigs(PresetSmallServer, hostResources{memory: 16 * gb, cpus: 16, disk: 50 * gb})
+	require.NoError(t, err)
+	ratio, err := strconv.ParseFloat(configs["pebblemq.lrucacheratio"], 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.06, ratio, 1e-9)
+	assert.Equal(t, "64", configs["pebblemq.memTableSizeInMB"])
+	assert.Equal(t, "2", configs["indexNode.scheduler.buildParallel"])
+	assert.Equal(t, "5120", configs["pebblemq.retentionSizeInMB"])
+	assert.Equal(t, "21600", configs["pebblemq.compactionInterval"])
+
+	configs, err = presetConfigs(PresetProduction, hostResources{memory: 128 * gb, cpus: 64, disk: 2 * tb})
+	require.NoError(t, err)
+	assert.Equal(t, "0.0625", configs["pebblemq.lrucacheratio"])
+	assert.Equal(t, "256", configs["pebblemq.memTableSizeInMB"])
+	assert.Equal(t, "16", configs["indexNode.scheduler.buildParallel"])
+	assert.Equal(t, "65536", configs["pebblemq.retentionSizeInMB"])
+	assert.Equal(t, "4320", configs["pebblemq.retentionTimeInMinutes"])
+	assert.Equal(t, "86400", configs["pebblemq.compactionInterval"])
+
+	// the sizes are left to the yaml files if the resources are unknown
+	configs, err = presetConfigs(PresetProduction, hostResources{cpus: 2})
+	require.NoError(t, err)
+	assert.NotContains(t, configs, "pebblemq.lrucacheratio")
+	assert.NotContains(t, configs, "pebblemq.retentionSizeInMB")
+	assert.Equal(t, "1", configs["indexNode.scheduler.buildParallel"])
+
+	// the derived configs are valid
+	for name := range presetProfiles {
+		configs, err = presetConfigs(name, hostResources{memory: 4 * gb, cpus: 1, disk: 1024 * 1024})
+		require.NoError(t, err)
+		params := ComponentParam{}
+		bt := NewBaseTable(SkipRemote(true), skipEnv(true))
+		params.init(bt)
+		for key, value := range configs {
+			bt.Save(key, value)
+		}
+		assert.NoError(t, params.PebblemqCfg.Validate(), name)
+		assert.NoError(t, params.IndexNodeCfg.Validate(), name)
+	}
+}
+
+func TestInitConfigsFromPreset(t *testing.T) {
+	getHostResourcesBak := getHostResources
+	defer func() { getHostResources = getHostResourcesBak }()
+	getHostResources = func(string) hostResources {
+		return hostResources{memory: 16 * gb, cpus: 8, disk: 512 * gb}
+	}
+
+	bt := NewBaseTable(SkipRemote(true), skipEnv(true))
+	_, err := bt.Load("pebblemq.compactionInterval")
+	assert.Error(t, err)
+
+	// unknown presets are ignored
+	bt.Save(deploymentPresetKey, "large")
+	bt.initConfigsFromPreset()
+	_, err = bt.Load("pebblemq.compactionInterval")
+	assert.Error(t, err)
+
+	bt.Save(deploymentPresetKey, PresetLaptop)
+	bt.initConfigsFromPreset()
+	v, _ := bt.Load("pebblemq.compactionInterval")
+	assert.Equal(t, "3600", v)
+	v, _ = bt.Load("pebblemq.retentionTimeInMinutes")
+	assert.Equal(t, "1440", v)
+
+	// the runtime configs still override the preset
+	bt.Save("pebblemq.compactionInterval", "600")
+	v, _ = bt.Load("pebblemq.compactionInterval")
+	assert.Equal(t, "600", v)
+}
@@ -152,6 +152,13 @@ func TestServiceParam(t *testing.T) {
 		assert.Equal(t, "60", Params.RequestTimeout.GetValue())
 	})
 
+	t.Run("test mqConfig", func(t *testing.T) {
+		Params := &SParams.MQCfg
+
+		// chunking is disabled until the consumers are upgraded
+		assert.Equal(t, 0, Params.ChunkSize.GetAsInt())
+	})
+
 	t.Run("test rocksmqConfig", func(t *testing.T) {
 		Params := &SParams.RocksmqCfg
 
@@ -168,6 +175,29 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("pebblemq path = %s", Params.Path.GetValue())
 		assert.NotNil(t, Params.Enable.GetAsBool())
 		t.Logf("pebblemq enable = %t", Params.Enable.GetAsBool())
+
+		assert.NoError(t, Params.Validate())
+		bt.Save(Params.PageSize.Key, "-1")
+		bt.Save(Params.CompressionTypes.Key, "0,2")
+		err := Params.Validate()
+		assert.ErrorContains(t, err, Params.PageSize.Key)
+		assert.ErrorContains(t, err, Params.CompressionTypes.Key)
+		bt.Reset(Params.PageSize.Key)
+		bt.Reset(Params.CompressionTypes.Key)
+
+		// the retention requires the compaction
+		bt.Save(Params.CompactionInterval.Key, "0")
+		assert.ErrorContains(t, Params.Validate(), Params.CompactionInterval.Key)
+		bt.Save(Params.RetentionSizeInMB.Key, "-1")
+		bt.Save(Params.RetentionTimeInMinutes.Key, "-1")
+		assert.NoError(t, Params.Validate())
+		bt.Reset(Params.CompactionInterval.Key)
+		bt.Reset(Params.RetentionSizeInMB.Key)
+		bt.Reset(Params.RetentionTimeInMinutes.Key)
+
+		bt.Save(Params.DiskRejectFreeRatio.Key, "0.2")
+		assert.ErrorContains(t, Params.Validate(), Params.DiskRejectFreeRatio.Key)
+		bt.Reset(Params.DiskRejectFreeRatio.Key)
 	})
 
 	t.Run("test kafkaConfig", func(t *testing.T) {
@@ -203,6 +233,12 @@ func TestServiceParam(t *testing.T) {
 
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
 
+		assert.False(t, Params.RequesterPays.GetAsBool())
+		assert.False(t, Params.UseDualStackEndpoint.GetAsBool())
+		assert.False(t, Params.UseFIPSEndpoint.GetAsBool())
+		assert.Empty(t, Params.CustomHeaders.GetAsJSONMap())
+		assert.Equal(t, "GLACIER", Params.ArchiveStorageClass.GetValue())
+
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// HierarchicalLimiter is a tree of token buckets with a level per depth, e.g. node -> tenant -> topic.
+// The root bucket is shared by all the events, and an event of the path [tenant, topic] takes the tokens from
+// the buckets of the node, the tenant and the topic, it's allowed only if all of them allow it,
+// otherwise the tokens taken are refunded. The buckets are Limiters, so an event larger than the burst
+// is allowed as long as the buckets aren't in debt.
+// The refill rates are set per level and may be updated at runtime, the buckets are created on the first events.
+type HierarchicalLimiter struct {
+	name   string
+	levels []string
+
+	mu     sync.RWMutex
+	limits []Limit
+	root   *limiterNode
+}
+
+type limiterNode struct {
+	limiter  *Limiter
+	children map[string]*limiterNode
+}
+
+// NewHierarchicalLimiter creates an unlimited HierarchicalLimiter, name labels its metrics,
+// and levels names the levels from the root, there's at least the root level.
+func NewHierarchicalLimiter(name string, levels ...string) *HierarchicalLimiter {
+	if len(levels) == 0 {
+		levels = []string{"root"}
+	}
+	limits := make([]Limit, len(levels))
+	for i, level := range levels {
+		limits[i] = Inf
+		metrics.RateLimiterLimit.WithLabelValues(name, level).Set(-1)
+	}
+	return &HierarchicalLimiter{
+		name:   name,
+		levels: levels,
+		limits: limits,
+		root:   newLimiterNode(Inf),
+	}
+}
+
+func newLimiterNode(limit Limit) *limiterNode {
+	limiter := NewLimiter(Inf, 0)
+	limiter.SetLimit(limit)
+	// a new bucket starts full
+	limiter.fill()
+	return &limiterNode{
+		limiter:  limiter,
+		children: make(map[string]*limiterNode),
+	}
+}
+
+// Levels returns the names of the levels from the root.
+func (l *HierarchicalLimiter) Levels() []string {
+	return l.levels
+}
+
+// LevelLimit returns the refill rate of the buckets of the level.
+func (l *HierarchicalLimiter) LevelLimit(level int) Limit {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.limits[level]
+}
+
+// SetLevelLimit updates the refill rate per second of the buckets of the level, not positive means unlimited.
+// The burst of a bucket is a second of its rate, nothing changes if the rate isn't changed.
+func (l *HierarchicalLimiter) SetLevelLimit(level int, limit Limit) {
+	if limit <= 0 {
+		limit = Inf
+	}
+	// it's called on every event by the callers refreshing the rates from the params
+	if l.LevelLimit(level) == limit {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limits[level] == limit {
+		return
+	}
+	unlimited := l.limits[level] == Inf
+	l.limits[level] = limit
+	nodes := []*limiterNode{l.root}
+	for i := 0; i < level; i++ {
+		var children []*limiterNode
+		for _, node := range nodes {
+			for _, child := range node.children {
+				children = append(children, child)
+			}
+		}
+		nodes = children
+	}
+	for _, node := range nodes {
+		node.limiter.SetLimit(limit)
+		// the bucket starts full once it turns from unlimited to limited
+		if unlimited {
+			node.limiter.fill()
+		}
+	}
+	if limit == Inf {
+		metrics.RateLimiterLimit.WithLabelValues(l.name, l.levels[level]).Set(-1)
+	} else {
+		metrics.RateLimiterLimit.WithLabelValues(l.name, l.levels[level]).Set(float64(limit))
+	}
+}
+
+// Allow reports whether an event of the path may happen now.
+func (l *HierarchicalLimiter) Allow(path ...string) bool {
+	return l.AllowN(time.Now(), 1, path...)
+}
+
+// AllowN reports whether n events of the path may happen at time now, the path is the keys of the buckets
+// below the root, and the keys deeper than the levels are ignored.
+func (l *HierarchicalLimiter) AllowN(now time.Time, n int, path ...string) bool {
+	chain := l.chain(path)
+	for i, node := range chain {
+		if node.limiter.AllowN(now, n) {
+			continue
+		}
+		for _, taken := range chain[:i] {
+			taken.limiter.Cancel(n)
+		}
+		metrics.RateLimiterEvents.WithLabelValues(l.name, l.levels[i], metrics.RateLimitRejectedLabel).Inc()
+		return false
+	}
+	metrics.RateLimiterEvents.WithLabelValues(l.name, l.levels[len(chain)-1], metrics.RateLimitAllowedLabel).Inc()
+	return true
+}
+
+// chain returns the buckets of the path from the root, the missing ones are created.
+func (l *HierarchicalLimiter) chain(path []string) []*limiterNode {
+	if len(path) > len(l.levels)-1 {
+		path = path[:len(l.levels)-1]
+	}
+	chain := make([]*limiterNode, 0, len(path)+1)
+
+	l.mu.RLock()
+	node := l.root
+	chain = append(chain, node)
+	for _, key := range path {
+		child, ok := node.children[key]
+		if !ok {
+			break
+		}
+		node = child
+		chain = append(chain, node)
+	}
+	l.mu.RUnlock()
+	if len(chain) == len(path)+1 {
+		return chain
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	node = l.root
+	chain = chain[:1]
+	for i, key := range path {
+		child, ok := node.children[key]
+		if !ok {
+			child = newLimiterNode(l.limits[i+1])
+			node.children[key] = child
+		}
+		node = child
+		chain = append(chain, node)
+	}
+	return chain
+}
+
+// Remove removes the bucket of the path and the ones below it, e.g. once the topic is dropped,
+// the root bucket is never removed.
+func (l *HierarchicalLimiter) Remove(path ...string) {
+	if len(path) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	node := l.root
+	for _, key := range path[:len(path)-1] {
+		child, ok := node.children[key]
+		if !ok {
+			return
+		}
+		node = child
+	}
+	delete(node.children, path[len(path)-1])
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+func TestHierarchicalLimiter(t *testing.T) {
+	name := "test_hierarchical_limiter"
+	l := NewHierarchicalLimiter(name, "node", "tenant", "topic")
+	assert.Equal(t, []string{"node", "tenant", "topic"}, l.Levels())
+	assert.Equal(t, Inf, l.LevelLimit(0))
+
+	now := time.Now()
+	assert.True(t, l.AllowN(now, 1<<30, "t1", "a"))
+	assert.True(t, l.Allow())
+
+	t.Run("topic", func(t *testing.T) {
+		l.SetLevelLimit(2, 10)
+		assert.Equal(t, Limit(10), l.LevelLimit(2))
+		// the bucket starts full once it turns from unlimited to limited
+		assert.EqualValues(t, 10, l.root.children["t1"].children["a"].limiter.getTokens())
+		assert.Equal(t, 10.0, testutil.ToFloat64(metrics.RateLimiterLimit.WithLabelValues(name, "topic")))
+
+		assert.True(t, l.AllowN(now, 10, "t1", "a"))
+		// the bucket in debt rejects the events until it's refilled
+		assert.True(t, l.AllowN(now, 1, "t1", "a"))
+		assert.False(t, l.AllowN(now, 1, "t1", "a"))
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RateLimiterEvents.WithLabelValues(name, "topic", metrics.RateLimitRejectedLabel)))
+		assert.True(t, l.AllowN(now, 1, "t1", "b"))
+		assert.True(t, l.AllowN(now.Add(time.Second), 1, "t1", "a"))
+	})
+
+	t.Run("tenant", func(t *testing.T) {
+		l.SetLevelLimit(1, 5)
+		assert.True(t, l.AllowN(now, 5, "t2", "c"))
+		assert.True(t, l.AllowN(now, 1, "t2", "d"))
+		assert.False(t, l.AllowN(now, 1, "t2", "e"))
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RateLimiterEvents.WithLabelValues(name, "tenant", metrics.RateLimitRejectedLabel)))
+	})
+
+	t.Run("refund", func(t *testing.T) {
+		l.SetLevelLimit(1, 100)
+		l.SetLevelLimit(2, 1)
+		assert.True(t, l.AllowN(now, 1, "t3", "x"))
+		assert.True(t, l.AllowN(now, 1, "t3", "x"))
+		// the tokens taken from the tenant are refunded once the topic rejects
+		assert.False(t, l.AllowN(now, 1, "t3", "x"))
+		assert.EqualValues(t, 98, l.root.children["t3"].limiter.getTokens())
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		l.SetLevelLimit(1, 0)
+		l.SetLevelLimit(2, -1)
+		assert.Equal(t, Inf, l.LevelLimit(2))
+		assert.Equal(t, -1.0, testutil.ToFloat64(metrics.RateLimiterLimit.WithLabelValues(name, "topic")))
+		assert.True(t, l.AllowN(now, 1<<30, "t3", "x"))
+		assert.True(t, l.AllowN(now, 1<<30, "t3", "x"))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		l.Remove("t1", "a")
+		assert.NotContains(t, l.root.children["t1"].children, "a")
+		l.Remove("t1")
+		assert.NotContains(t, l.root.children, "t1")
+		l.Remove("t4", "a")
+		l.Remove()
+
+		// the keys deeper than the levels are ignored
+		assert.True(t, l.Allow("t4", "a", "ignored"))
+		assert.Len(t, l.root.children["t4"].children["a"].children, 0)
+	})
+}
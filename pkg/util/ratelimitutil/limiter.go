@@ -121,6 +121,14 @@ func (lim *Limiter) SetLimit(newLimit Limit) {
 	}
 }
 
+// fill refills the bucket up to the burst.
+func (lim *Limiter) fill() {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.last = time.Now()
+	lim.tokens = lim.burst
+}
+
 // Cancel the AllowN operation and refund the tokens that have already been deducted by the limiter.
 func (lim *Limiter) Cancel(n int) {
 	lim.mu.Lock()
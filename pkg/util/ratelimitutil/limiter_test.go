@@ -154,6 +154,15 @@ func TestLimit(t *testing.T) {
 		runWithoutCheckToken(t, lim, []allow{{t2, 10, true, 0}})
 	})
 
+	t.Run("test SetLimit from unlimited", func(t *testing.T) {
+		// the bucket isn't refilled once it turns from unlimited to limited
+		lim := NewLimiter(Inf, 0)
+		lim.SetLimit(10)
+		if lim.getTokens() != 0 {
+			t.Fatalf("expected no tokens, got %v", lim.getTokens())
+		}
+	})
+
 	t.Run("test no truncation error", func(t *testing.T) {
 		if !NewLimiter(0.7692307692307693, 1).AllowN(time.Now(), 1) {
 			t.Fatal("expected true")
@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slowlog records the operations slower than the configured thresholds, e.g. rpcs, index build phases,
+// mq produces and kv operations, as json lines into a rotated file and keeps the latest ones in memory, so that
+// there is a single place to look when the node is slow.
+package slowlog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// Kind is the kind of the recorded operation, each kind has its own threshold.
+type Kind string
+
+const (
+	KindRPC        Kind = "rpc"
+	KindBuildPhase Kind = "build_phase"
+	KindProduce    Kind = "mq_produce"
+	KindKV         Kind = "kv"
+)
+
+// Entry is a slow operation, written to the slow log as a json line.
+type Entry struct {
+	Time        time.Time         `json:"time"`
+	Kind        Kind              `json:"kind"`
+	Op          string            `json:"op"`
+	DurationMs  int64             `json:"durationMs"`
+	ThresholdMs int64             `json:"thresholdMs"`
+	TraceID     string            `json:"traceID,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// Field is an extra key-value pair describing the operation.
+type Field struct {
+	Key   string
+	Value string
+}
+
+// F constructs a Field.
+func F(key, value string) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Threshold returns the threshold of the kind, 0 means the kind isn't recorded.
+func Threshold(kind Kind) time.Duration {
+	params := &paramtable.Get().CommonCfg
+	switch kind {
+	case KindRPC:
+		return params.SlowLogRPCThreshold.GetAsDuration(time.Millisecond)
+	case KindBuildPhase:
+		return params.SlowLogBuildPhaseThreshold.GetAsDuration(time.Millisecond)
+	case KindProduce:
+		return params.SlowLogProduceThreshold.GetAsDuration(time.Millisecond)
+	case KindKV:
+		return params.SlowLogKVThreshold.GetAsDuration(time.Millisecond)
+	default:
+		return 0
+	}
+}
+
+// slowLogger writes the entries to the file and keeps the latest ones in a ring.
+type slowLogger struct {
+	mu       sync.Mutex
+	filePath string
+	writer   io.WriteCloser
+	tail     []Entry
+	next     int
+	tailSize int
+}
+
+var (
+	globalOnce   sync.Once
+	globalLogger *slowLogger
+)
+
+func getLogger() *slowLogger {
+	globalOnce.Do(func() {
+		globalLogger = newSlowLogger(paramtable.Get().CommonCfg.SlowLogTailSize.GetAsInt())
+	})
+	return globalLogger
+}
+
+func newSlowLogger(tailSize int) *slowLogger {
+	if tailSize < 0 {
+		tailSize = 0
+	}
+	return &slowLogger{
+		tail:     make([]Entry, 0, tailSize),
+		tailSize: tailSize,
+	}
+}
+
+// Record records the operation if it's enabled and slower than the threshold of its kind,
+// returns whether the operation is recorded.
+func Record(ctx context.Context, kind Kind, op string, elapsed time.Duration, fields ...Field) bool {
+	// the paramtable may be not initialized if the kv or mq is used standalone, e.g. by tools
+	if paramtable.GetBaseTable() == nil || !paramtable.Get().CommonCfg.SlowLogEnabled.GetAsBool() {
+		return false
+	}
+	threshold := Threshold(kind)
+	if threshold <= 0 || elapsed < threshold {
+		return false
+	}
+
+	entry := Entry{
+		Time:        time.Now(),
+		Kind:        kind,
+		Op:          op,
+		DurationMs:  elapsed.Milliseconds(),
+		ThresholdMs: threshold.Milliseconds(),
+	}
+	if traceID := trace.SpanContextFromContext(ctx).TraceID(); traceID.IsValid() {
+		entry.TraceID = traceID.String()
+	}
+	if len(fields) > 0 {
+		entry.Fields = make(map[string]string, len(fields))
+		for _, field := range fields {
+			entry.Fields[field.Key] = field.Value
+		}
+	}
+	getLogger().append(entry, paramtable.Get().CommonCfg.SlowLogFilePath.GetValue())
+	return true
+}
+
+// Tail returns the latest n entries kept in memory from the oldest to the newest, all of them if n <= 0.
+func Tail(n int) []Entry {
+	return getLogger().latest(n)
+}
+
+func (l *slowLogger) append(entry Entry, filePath string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.tailSize > 0 {
+		if len(l.tail) < l.tailSize {
+			l.tail = append(l.tail, entry)
+		} else {
+			l.tail[l.next] = entry
+			l.next = (l.next + 1) % l.tailSize
+		}
+	}
+
+	writer := l.getWriter(filePath)
+	if writer == nil {
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("failed to marshal slow log entry", zap.Error(err))
+		return
+	}
+	if _, err := writer.Write(append(line, '\n')); err != nil {
+		log.RatedWarn(60, "failed to write slow log", zap.String("path", filePath), zap.Error(err))
+	}
+}
+
+// getWriter returns the rotated writer of the file, the writer is reopened if the path is changed.
+func (l *slowLogger) getWriter(filePath string) io.Writer {
+	if filePath != l.filePath {
+		if l.writer != nil {
+			l.writer.Close()
+			l.writer = nil
+		}
+		l.filePath = filePath
+		if filePath != "" {
+			l.writer = &lumberjack.Logger{
+				Filename:   filePath,
+				MaxSize:    paramtable.Get().CommonCfg.SlowLogMaxSize.GetAsInt(),
+				MaxBackups: paramtable.Get().CommonCfg.SlowLogMaxBackups.GetAsInt(),
+				LocalTime:  true,
+			}
+		}
+	}
+	if l.writer == nil {
+		return nil
+	}
+	return l.writer
+}
+
+func (l *slowLogger) latest(n int) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make([]Entry, 0, len(l.tail))
+	entries = append(entries, l.tail[l.next:]...)
+	entries = append(entries, l.tail[:l.next]...)
+	if n > 0 && n < len(entries) {
+		entries = entries[len(entries)-n:]
+	}
+	return entries
+}
+
+// UnaryServerInterceptor records the unary rpcs slower than the rpc threshold.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	Record(ctx, KindRPC, info.FullMethod, time.Since(start))
+	return resp, err
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+func TestRecord(t *testing.T) {
+	params := paramtable.Get()
+	filePath := filepath.Join(t.TempDir(), "slow.log")
+	params.Save(params.CommonCfg.SlowLogFilePath.Key, filePath)
+	defer params.Reset(params.CommonCfg.SlowLogFilePath.Key)
+
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID}))
+
+	// faster than the threshold
+	assert.False(t, Record(ctx, KindProduce, "topic-fast", 10*time.Millisecond))
+	// unknown kind has no threshold
+	assert.False(t, Record(ctx, Kind("unknown"), "op", time.Hour))
+	assert.True(t, Record(ctx, KindProduce, "topic-slow", time.Second, F("msgCount", "10")))
+
+	entries := Tail(1)
+	require.Len(t, entries, 1)
+	assert.Equal(t, KindProduce, entries[0].Kind)
+	assert.Equal(t, "topic-slow", entries[0].Op)
+	assert.Equal(t, int64(1000), entries[0].DurationMs)
+	assert.Equal(t, int64(200), entries[0].ThresholdMs)
+	assert.Equal(t, traceID.String(), entries[0].TraceID)
+	assert.Equal(t, map[string]string{"msgCount": "10"}, entries[0].Fields)
+
+	file, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+	written := Entry{}
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &written))
+	assert.Equal(t, "topic-slow", written.Op)
+	assert.Equal(t, traceID.String(), written.TraceID)
+
+	// disabled
+	params.Save(params.CommonCfg.SlowLogEnabled.Key, "false")
+	defer params.Reset(params.CommonCfg.SlowLogEnabled.Key)
+	assert.False(t, Record(ctx, KindProduce, "topic-slow", time.Second))
+}
+
+func TestSlowLoggerTail(t *testing.T) {
+	logger := newSlowLogger(3)
+	for i := 0; i < 5; i++ {
+		logger.append(Entry{DurationMs: int64(i)}, "")
+	}
+	entries := logger.latest(0)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		assert.Equal(t, int64(i+2), entry.DurationMs)
+	}
+
+	entries = logger.latest(2)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(3), entries[0].DurationMs)
+	assert.Equal(t, int64(4), entries[1].DurationMs)
+
+	assert.Empty(t, newSlowLogger(0).latest(0))
+}
@@ -13,20 +13,37 @@ package timerecord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
 )
 
-// TimeRecorder provides methods to record time duration
+// Checkpoint is a named time point recorded by TimeRecorder
+type Checkpoint struct {
+	Name string
+	At   time.Time
+	// Span is the duration from the previous record
+	Span time.Duration
+	// Elapsed is the duration from the beginning
+	Elapsed time.Duration
+}
+
+// TimeRecorder provides methods to record time duration,
+// the named records are kept as checkpoints if it's created by NewTimeRecorderWithCheckpoints,
+// which may be exported as span events or JSON.
+// TimeRecorder is not thread safe.
 type TimeRecorder struct {
-	header string
-	start  time.Time
-	last   time.Time
+	header          string
+	start           time.Time
+	last            time.Time
+	keepCheckpoints bool
+	checkpoints     []Checkpoint
 }
 
 // NewTimeRecorder creates a new TimeRecorder
@@ -38,6 +55,14 @@ func NewTimeRecorder(header string) *TimeRecorder {
 	}
 }
 
+// NewTimeRecorderWithCheckpoints creates a new TimeRecorder keeping the named records as checkpoints,
+// it's meant for the recorders of the bounded tasks, e.g. the jobs of index node, as the checkpoints are never released.
+func NewTimeRecorderWithCheckpoints(header string) *TimeRecorder {
+	tr := NewTimeRecorder(header)
+	tr.keepCheckpoints = true
+	return tr
+}
+
 // NewTimeRecorderWithCtx creates a new TimeRecorder with context's traceID,
 func NewTimeRecorderWithTrace(ctx context.Context, header string) *TimeRecorder {
 	traceID := trace.SpanFromContext(ctx).SpanContext().TraceID()
@@ -64,15 +89,22 @@ func (tr *TimeRecorder) ElapseSpan() time.Duration {
 	return span
 }
 
+// RecordCheckpoint returns the duration from last record without printing it, and keeps it as a checkpoint if enabled
+func (tr *TimeRecorder) RecordCheckpoint(name string) time.Duration {
+	span := tr.RecordSpan()
+	tr.addCheckpoint(name, span)
+	return span
+}
+
 // Record calculates the time span from previous Record call
 func (tr *TimeRecorder) Record(msg string) time.Duration {
-	span := tr.RecordSpan()
+	span := tr.RecordCheckpoint(msg)
 	tr.printTimeRecord(context.TODO(), msg, span)
 	return span
 }
 
 func (tr *TimeRecorder) CtxRecord(ctx context.Context, msg string) time.Duration {
-	span := tr.RecordSpan()
+	span := tr.RecordCheckpoint(msg)
 	tr.printTimeRecord(ctx, msg, span)
 	return span
 }
@@ -80,16 +112,79 @@ func (tr *TimeRecorder) CtxRecord(ctx context.Context, msg string) time.Duration
 // Elapse calculates the time span from the beginning of this TimeRecorder
 func (tr *TimeRecorder) Elapse(msg string) time.Duration {
 	span := tr.ElapseSpan()
+	tr.addCheckpoint(msg, span)
 	tr.printTimeRecord(context.TODO(), msg, span)
 	return span
 }
 
 func (tr *TimeRecorder) CtxElapse(ctx context.Context, msg string) time.Duration {
 	span := tr.ElapseSpan()
+	tr.addCheckpoint(msg, span)
 	tr.printTimeRecord(ctx, msg, span)
 	return span
 }
 
+func (tr *TimeRecorder) addCheckpoint(name string, span time.Duration) {
+	if !tr.keepCheckpoints {
+		return
+	}
+	tr.checkpoints = append(tr.checkpoints, Checkpoint{
+		Name:    name,
+		At:      tr.last,
+		Span:    span,
+		Elapsed: tr.last.Sub(tr.start),
+	})
+}
+
+// Checkpoints returns the checkpoints recorded in order.
+func (tr *TimeRecorder) Checkpoints() []Checkpoint {
+	return append([]Checkpoint(nil), tr.checkpoints...)
+}
+
+// ExportSpanEvents adds the checkpoints to the span as events at the time they're recorded,
+// e.g. the span of a task whose checkpoints are recorded without the context.
+func (tr *TimeRecorder) ExportSpanEvents(span trace.Span) {
+	for _, cp := range tr.checkpoints {
+		span.AddEvent(cp.Name, trace.WithTimestamp(cp.At), trace.WithAttributes(
+			attribute.Int64("span_us", cp.Span.Microseconds()),
+			attribute.Int64("elapsed_us", cp.Elapsed.Microseconds()),
+		))
+	}
+}
+
+type checkpointJSON struct {
+	Name      string `json:"name"`
+	At        int64  `json:"at"`
+	SpanUs    int64  `json:"span_us"`
+	ElapsedUs int64  `json:"elapsed_us"`
+}
+
+type timeRecordsJSON struct {
+	Header      string           `json:"header"`
+	Start       int64            `json:"start"`
+	Checkpoints []checkpointJSON `json:"checkpoints"`
+}
+
+// MarshalJSON encodes the header, the start time and the checkpoints, the times are in unix microseconds and
+// the durations are in microseconds, e.g.
+// {"header":"h","start":1,"checkpoints":[{"name":"load done","at":11,"span_us":10,"elapsed_us":10}]}
+func (tr *TimeRecorder) MarshalJSON() ([]byte, error) {
+	records := timeRecordsJSON{
+		Header:      tr.header,
+		Start:       tr.start.UnixMicro(),
+		Checkpoints: make([]checkpointJSON, 0, len(tr.checkpoints)),
+	}
+	for _, cp := range tr.checkpoints {
+		records.Checkpoints = append(records.Checkpoints, checkpointJSON{
+			Name:      cp.Name,
+			At:        cp.At.UnixMicro(),
+			SpanUs:    cp.Span.Microseconds(),
+			ElapsedUs: cp.Elapsed.Microseconds(),
+		})
+	}
+	return json.Marshal(records)
+}
+
 func (tr *TimeRecorder) printTimeRecord(ctx context.Context, msg string, span time.Duration) {
 	ts := trace.SpanFromContext(ctx)
 	ts.AddEvent(fmt.Sprintf("%s, cost %s", msg, span.String()))
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timerecord
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTimeRecorderCheckpoints(t *testing.T) {
+	// the checkpoints are only kept if enabled
+	plain := NewTimeRecorder("plain")
+	plain.RecordCheckpoint("load")
+	plain.Elapse("done")
+	assert.Empty(t, plain.Checkpoints())
+
+	tr := NewTimeRecorderWithCheckpoints("test")
+	// the unnamed spans aren't kept
+	tr.RecordSpan()
+	time.Sleep(time.Millisecond)
+	load := tr.RecordCheckpoint("load")
+	tr.CtxRecord(context.Background(), "build")
+	tr.Elapse("done")
+
+	checkpoints := tr.Checkpoints()
+	require.Len(t, checkpoints, 3)
+	assert.Equal(t, []string{"load", "build", "done"}, []string{checkpoints[0].Name, checkpoints[1].Name, checkpoints[2].Name})
+	assert.Equal(t, load, checkpoints[0].Span)
+	assert.GreaterOrEqual(t, checkpoints[0].Span, time.Millisecond)
+	assert.Equal(t, checkpoints[2].Span, checkpoints[2].Elapsed)
+	assert.GreaterOrEqual(t, checkpoints[1].Elapsed, checkpoints[0].Elapsed)
+	// the returned checkpoints are copied
+	checkpoints[0].Name = "modified"
+	assert.Equal(t, "load", tr.Checkpoints()[0].Name)
+
+	t.Run("span events", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdk.NewTracerProvider(sdk.WithSpanProcessor(recorder))
+		_, span := tp.Tracer("test").Start(context.Background(), "task")
+		tr.ExportSpanEvents(span)
+		span.End()
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+		events := ended[0].Events()
+		require.Len(t, events, 3)
+		assert.Equal(t, "load", events[0].Name)
+		assert.True(t, tr.Checkpoints()[0].At.Equal(events[0].Time))
+		assert.Contains(t, events[0].Attributes, attribute.Int64("span_us", load.Microseconds()))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(tr)
+		require.NoError(t, err)
+		records := timeRecordsJSON{}
+		require.NoError(t, json.Unmarshal(data, &records))
+		assert.Equal(t, "test", records.Header)
+		assert.Equal(t, tr.start.UnixMicro(), records.Start)
+		require.Len(t, records.Checkpoints, 3)
+		assert.Equal(t, "build", records.Checkpoints[1].Name)
+		assert.Equal(t, tr.Checkpoints()[1].At.UnixMicro(), records.Checkpoints[1].At)
+		assert.Equal(t, load.Microseconds(), records.Checkpoints[0].SpanUs)
+
+		data, err = json.Marshal(NewTimeRecorder("empty"))
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"checkpoints":[]`)
+	})
+}
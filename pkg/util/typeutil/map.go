@@ -41,6 +41,11 @@ type ConcurrentMap[K comparable, V any] struct {
 	inner sync.Map
 	// Self-managed Len(), see: https://github.com/golang/go/issues/20680.
 	len atomic.Uint64
+
+	// the mutations hold the read lock, so that Snapshot holding the write lock sees none of them partially
+	mu sync.RWMutex
+	// computeMu serializes the computations of GetOrCompute
+	computeMu sync.Mutex
 }
 
 func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
@@ -57,6 +62,8 @@ func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
 
 // Insert inserts the key-value pair to the concurrent map
 func (m *ConcurrentMap[K, V]) Insert(key K, value V) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	_, loaded := m.inner.LoadOrStore(key, value)
 	if !loaded {
 		m.len.Inc()
@@ -83,6 +90,8 @@ func (m *ConcurrentMap[K, V]) Contain(key K) bool {
 // If the key already exists, return the value and set `loaded` to true.
 // If the key does not exist, insert the given `key` and `value` to map, return the value and set `loaded` to false.
 func (m *ConcurrentMap[K, V]) GetOrInsert(key K, value V) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	stored, loaded := m.inner.LoadOrStore(key, value)
 	if !loaded {
 		m.len.Inc()
@@ -91,7 +100,26 @@ func (m *ConcurrentMap[K, V]) GetOrInsert(key K, value V) (V, bool) {
 	return stored.(V), true
 }
 
+// GetOrCompute returns the value on the given `key` and true if the key exists,
+// otherwise inserts the value computed by `fn` and returns it with false.
+// `fn` is called at most once for an absent key even if GetOrCompute is called concurrently,
+// it must not call GetOrCompute of the same map.
+func (m *ConcurrentMap[K, V]) GetOrCompute(key K, fn func() V) (V, bool) {
+	if value, ok := m.Get(key); ok {
+		return value, true
+	}
+	m.computeMu.Lock()
+	defer m.computeMu.Unlock()
+	if value, ok := m.Get(key); ok {
+		return value, true
+	}
+	// the key may be inserted by Insert or GetOrInsert meanwhile, the inserted value wins
+	return m.GetOrInsert(key, fn())
+}
+
 func (m *ConcurrentMap[K, V]) GetAndRemove(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	var zeroValue V
 	value, loaded := m.inner.LoadAndDelete(key)
 	if !loaded {
@@ -104,6 +132,8 @@ func (m *ConcurrentMap[K, V]) GetAndRemove(key K) (V, bool) {
 // Remove removes the `key`, `value` set if `key` is in the map,
 // does nothing if `key` not in the map.
 func (m *ConcurrentMap[K, V]) Remove(key K) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	if _, loaded := m.inner.LoadAndDelete(key); loaded {
 		m.len.Dec()
 	}
@@ -112,3 +142,16 @@ func (m *ConcurrentMap[K, V]) Remove(key K) {
 func (m *ConcurrentMap[K, V]) Len() int {
 	return int(m.len.Load())
 }
+
+// Snapshot returns a copy of the map at a point in time, which never sees a mutation partially like Range does,
+// use it to process the pairs without blocking or racing with the concurrent mutations.
+func (m *ConcurrentMap[K, V]) Snapshot() map[K]V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot := make(map[K]V, m.len.Load())
+	m.inner.Range(func(key, value any) bool {
+		snapshot[key.(K)] = value.(V)
+		return true
+	})
+	return snapshot
+}
@@ -1,9 +1,11 @@
 package typeutil
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
 )
 
 type MapUtilSuite struct {
@@ -146,6 +148,58 @@ func (suite *MapUtilSuite) TestConcurrentMap() {
 	})
 }
 
+func (suite *MapUtilSuite) TestConcurrentMapGetOrCompute() {
+	currMap := NewConcurrentMap[int64, string]()
+
+	calls := atomic.NewInt32(0)
+	compute := func() string {
+		calls.Inc()
+		return "v-100"
+	}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, _ := currMap.GetOrCompute(100, compute)
+			suite.Equal("v-100", v)
+		}()
+	}
+	wg.Wait()
+	suite.EqualValues(1, calls.Load())
+	suite.Equal(1, currMap.Len())
+
+	v, loaded := currMap.GetOrCompute(100, func() string { return "new-v" })
+	suite.Equal("v-100", v)
+	suite.True(loaded)
+	v, loaded = currMap.GetOrCompute(200, func() string { return "v-200" })
+	suite.Equal("v-200", v)
+	suite.False(loaded)
+	suite.Equal(2, currMap.Len())
+}
+
+func (suite *MapUtilSuite) TestConcurrentMapSnapshot() {
+	currMap := NewConcurrentMap[int64, string]()
+	suite.Empty(currMap.Snapshot())
+
+	currMap.Insert(100, "v-100")
+	currMap.Insert(200, "v-200")
+	snapshot := currMap.Snapshot()
+	suite.Equal(map[int64]string{100: "v-100", 200: "v-200"}, snapshot)
+
+	// the snapshot isn't affected by the later mutations
+	currMap.Insert(300, "v-300")
+	currMap.Remove(100)
+	suite.Equal(map[int64]string{100: "v-100", 200: "v-200"}, snapshot)
+
+	// mutating during the iteration over the snapshot never blocks
+	for k := range currMap.Snapshot() {
+		currMap.Insert(k+1, "new-v")
+	}
+	suite.Equal(4, currMap.Len())
+	suite.Len(currMap.Snapshot(), currMap.Len())
+}
+
 func TestMapUtil(t *testing.T) {
 	suite.Run(t, new(MapUtilSuite))
 }
@@ -0,0 +1,258 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watchdog detects the operations running much longer than their historical durations, which are usually
+// stuck by a deadlock or a hanging call and otherwise just look like pending forever. The goroutines of a stuck
+// operation are found by its pprof label, their stacks are logged and a structured event is recorded.
+package watchdog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// StuckEvent describes a stuck operation, it's recorded in the event log as json.
+type StuckEvent struct {
+	Watchdog   string `json:"watchdog"`
+	Op         string `json:"op"`
+	LabelKey   string `json:"labelKey"`
+	LabelValue string `json:"labelValue"`
+	ElapsedMs  int64  `json:"elapsedMs"`
+	ExpectedMs int64  `json:"expectedMs"`
+	Goroutines int    `json:"goroutines"`
+}
+
+type operation struct {
+	op         string
+	labelKey   string
+	labelValue string
+	start      time.Time
+	expected   time.Duration
+	reported   bool
+}
+
+// Watchdog watches the running operations of a component, each operation is reported at most once.
+type Watchdog struct {
+	name    string
+	enabled bool
+
+	mu     sync.Mutex
+	ops    map[int64]*operation
+	nextID int64
+
+	// goroutineProfile returns the goroutine profile of debug level 1, replaced in tests
+	goroutineProfile func() ([]byte, error)
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// New creates a watchdog named by the watching component.
+func New(name string) *Watchdog {
+	return &Watchdog{
+		name:             name,
+		enabled:          paramtable.Get().CommonCfg.StuckWatchdogEnabled.GetAsBool(),
+		ops:              make(map[int64]*operation),
+		goroutineProfile: goroutineProfile,
+		closeCh:          make(chan struct{}),
+	}
+}
+
+// Start starts checking the running operations periodically, it does nothing if the watchdog is disabled.
+func (w *Watchdog) Start() {
+	if !w.enabled {
+		return
+	}
+	w.wg.Add(1)
+	go w.loop()
+}
+
+// Stop stops checking the running operations.
+func (w *Watchdog) Stop() {
+	w.closeOnce.Do(func() {
+		close(w.closeCh)
+		w.wg.Wait()
+	})
+}
+
+// Watch starts watching an operation expected to take the historical duration, the goroutines running it should
+// carry the pprof label labelKey=labelValue. The operation isn't checked if it has no history yet,
+// i.e. the expected duration is zero. The returned function must be called once the operation is done.
+func (w *Watchdog) Watch(op string, expected time.Duration, labelKey, labelValue string) func() {
+	if !w.enabled {
+		return func() {}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	id := w.nextID
+	w.nextID++
+	w.ops[id] = &operation{
+		op:         op,
+		labelKey:   labelKey,
+		labelValue: labelValue,
+		start:      time.Now(),
+		expected:   expected,
+	}
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.ops, id)
+	}
+}
+
+func (w *Watchdog) loop() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(paramtable.Get().CommonCfg.StuckWatchdogInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.closeCh:
+			return
+		case now := <-ticker.C:
+			w.check(now)
+		}
+	}
+}
+
+// stuck returns the operations exceeding the threshold and not reported yet, they're marked as reported.
+func (w *Watchdog) stuck(now time.Time) []operation {
+	params := &paramtable.Get().CommonCfg
+	factor := params.StuckWatchdogFactor.GetAsFloat()
+	minDuration := params.StuckWatchdogMinDuration.GetAsDuration(time.Second)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var ret []operation
+	for _, o := range w.ops {
+		if o.reported || o.expected <= 0 {
+			continue
+		}
+		threshold := time.Duration(float64(o.expected) * factor)
+		if threshold < minDuration {
+			threshold = minDuration
+		}
+		if now.Sub(o.start) > threshold {
+			o.reported = true
+			ret = append(ret, *o)
+		}
+	}
+	return ret
+}
+
+// check reports the stuck operations, and returns their events.
+func (w *Watchdog) check(now time.Time) []*StuckEvent {
+	ops := w.stuck(now)
+	if len(ops) == 0 {
+		return nil
+	}
+	profile, err := w.goroutineProfile()
+	if err != nil {
+		log.Warn("failed to dump the goroutines of the stuck operations", zap.String("watchdog", w.name), zap.Error(err))
+	}
+	events := make([]*StuckEvent, 0, len(ops))
+	for _, o := range ops {
+		goroutines, count := FilterGoroutineProfile(profile, o.labelKey, o.labelValue)
+		event := &StuckEvent{
+			Watchdog:   w.name,
+			Op:         o.op,
+			LabelKey:   o.labelKey,
+			LabelValue: o.labelValue,
+			ElapsedMs:  now.Sub(o.start).Milliseconds(),
+			ExpectedMs: o.expected.Milliseconds(),
+			Goroutines: count,
+		}
+		log.Warn("operation runs much longer than its historical duration, it may be stuck",
+			zap.String("watchdog", w.name),
+			zap.String("op", o.op),
+			zap.String(o.labelKey, o.labelValue),
+			zap.Duration("elapsed", now.Sub(o.start)),
+			zap.Duration("expected", o.expected),
+			zap.Int("goroutines", count),
+			zap.ByteString("stacks", goroutines))
+		data, _ := json.Marshal(event)
+		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn, string(data)))
+		events = append(events, event)
+	}
+	return events
+}
+
+func goroutineProfile() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FilterGoroutineProfile keeps the header line and the goroutine records labeled by key=value in a goroutine
+// profile of debug level 1, the records are separated by blank lines. It also returns the number of goroutines kept.
+func FilterGoroutineProfile(profile []byte, key, value string) ([]byte, int) {
+	label := fmt.Sprintf("%q:%q", key, value)
+	var out bytes.Buffer
+	count := 0
+	scanner := bufio.NewScanner(bytes.NewReader(profile))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(profile)+1)
+	if scanner.Scan() {
+		out.WriteString(scanner.Text())
+		out.WriteString("\n")
+	}
+	var record []string
+	flush := func() {
+		if len(record) > 0 && containsLabel(record, label) {
+			out.WriteString("\n")
+			out.WriteString(strings.Join(record, "\n"))
+			out.WriteString("\n")
+			// the record starts with the number of the goroutines sharing the stack, e.g. "2 @ 0x1 0x2"
+			var n int
+			if _, err := fmt.Sscanf(record[0], "%d", &n); err == nil {
+				count += n
+			}
+		}
+		record = record[:0]
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		record = append(record, line)
+	}
+	flush()
+	return out.Bytes(), count
+}
+
+func containsLabel(record []string, label string) bool {
+	for _, line := range record {
+		if strings.HasPrefix(line, "# labels:") && strings.Contains(line, label) {
+			return true
+		}
+	}
+	return false
+}
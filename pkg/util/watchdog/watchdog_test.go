@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"context"
+	"os"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+func TestFilterGoroutineProfile(t *testing.T) {
+	profile := "goroutine profile: total 4\n" +
+		"2 @ 0x1 0x2\n# labels: {\"buildID\":\"10\", \"phase\":\"execute\"}\n#\t0x1\tbuild+0x1\n\n" +
+		"1 @ 0x3 0x4\n# labels: {\"buildID\":\"11\", \"phase\":\"prepare\"}\n#\t0x3\tload+0x1\n\n" +
+		"1 @ 0x5 0x6\n#\t0x5\tidle+0x1\n\n"
+	filtered, count := FilterGoroutineProfile([]byte(profile), "buildID", "10")
+	assert.Contains(t, string(filtered), "goroutine profile: total 4")
+	assert.Contains(t, string(filtered), "build+0x1")
+	assert.NotContains(t, string(filtered), "load+0x1")
+	assert.NotContains(t, string(filtered), "idle+0x1")
+	assert.Equal(t, 2, count)
+}
+
+func TestWatchdog(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.StuckWatchdogFactor.Key, "2")
+	defer params.Reset(params.CommonCfg.StuckWatchdogFactor.Key)
+	params.Save(params.CommonCfg.StuckWatchdogMinDuration.Key, "1")
+	defer params.Reset(params.CommonCfg.StuckWatchdogMinDuration.Key)
+
+	started, done := make(chan struct{}), make(chan struct{})
+	go pprof.Do(context.Background(), pprof.Labels("buildID", "10"), func(context.Context) {
+		close(started)
+		<-done
+	})
+	defer close(done)
+	<-started
+
+	w := New("test")
+	stuckDone := w.Watch("build", time.Second, "buildID", "10")
+	defer stuckDone()
+	w.Watch("build", 0, "buildID", "11")
+	finished := w.Watch("build", time.Second, "buildID", "12")
+	finished()
+
+	now := time.Now()
+	// within the threshold of factor times the historical duration
+	assert.Empty(t, w.check(now.Add(time.Second)))
+
+	events := w.check(now.Add(3 * time.Second))
+	require.Len(t, events, 1)
+	assert.Equal(t, "test", events[0].Watchdog)
+	assert.Equal(t, "10", events[0].LabelValue)
+	assert.Equal(t, int64(1000), events[0].ExpectedMs)
+	assert.GreaterOrEqual(t, events[0].Goroutines, 1)
+
+	// reported only once, and the operations without history are never reported
+	assert.Empty(t, w.check(now.Add(time.Hour)))
+}
+
+func TestWatchdog_Disabled(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.StuckWatchdogEnabled.Key, "false")
+	defer params.Reset(params.CommonCfg.StuckWatchdogEnabled.Key)
+
+	w := New("test")
+	w.Start()
+	defer w.Stop()
+	w.Watch("build", time.Second, "buildID", "10")
+	assert.Empty(t, w.check(time.Now().Add(time.Hour)))
+}